@@ -0,0 +1,863 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/AutumnsGrove/codequest/internal/ai"
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/digest"
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/github"
+	"github.com/AutumnsGrove/codequest/internal/publish"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/timesheet"
+	"github.com/AutumnsGrove/codequest/internal/ui"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
+)
+
+// runCommand dispatches headless CLI verbs (e.g. `codequest quests start`,
+// `codequest publish`, `codequest dump`, `codequest digest`, `codequest staged`, `codequest storage`,
+// `codequest suggest-commit-message`, `codequest timesheet`, `codequest audit`).
+// These run without launching the full TUI and exit when done.
+//
+// Parameters:
+//   - args: Positional arguments after flags (args[0] is the command)
+//   - cfg: Loaded application configuration
+//   - storageClient: Storage client for the configured backend
+//
+// Returns:
+//   - error: An error if the command is unknown or fails
+func runCommand(args []string, cfg *config.Config, storageClient *storage.Client) error {
+	switch args[0] {
+	case "quests", "quest":
+		return runQuestsCommand(args[1:], cfg, storageClient)
+	case "publish":
+		return runPublishCommand(args[1:], cfg, storageClient)
+	case "dump":
+		return runDumpCommand(args[1:], cfg, storageClient)
+	case "digest":
+		return runDigestCommand(args[1:], cfg, storageClient)
+	case "staged":
+		return runStagedCommand(cfg, storageClient)
+	case "storage":
+		return runStorageCommand(args[1:], cfg, storageClient)
+	case "suggest-commit-message":
+		return runSuggestCommitMessageCommand(args[1:], cfg)
+	case "timesheet":
+		return runTimesheetCommand(args[1:], cfg, storageClient)
+	case "audit":
+		return runAuditCommand(cfg, storageClient)
+	case "analytics":
+		return runAnalyticsCommand(args[1:], cfg)
+	default:
+		return fmt.Errorf("unknown command %q (run with --help for usage)", args[0])
+	}
+}
+
+// runQuestsCommand handles `codequest quests <list|start|reconcile> [quest-id]`.
+func runQuestsCommand(args []string, cfg *config.Config, storageClient storage.Storage) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: codequest quests <list|start|reconcile> [quest-id]")
+	}
+
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+
+	quests, err := storageClient.LoadQuests()
+	if err != nil {
+		return fmt.Errorf("failed to load quests: %w", err)
+	}
+	quests, _ = game.SeedQuestTemplates(quests)
+
+	switch args[0] {
+	case "list", "ls":
+		return listQuests(character, quests)
+	case "start":
+		questID := ""
+		if len(args) > 1 {
+			questID = args[1]
+		}
+		return startQuest(questID, character, quests, cfg, storageClient)
+	case "reconcile":
+		return reconcileQuests(character, quests, cfg, storageClient)
+	default:
+		return fmt.Errorf("unknown quests subcommand %q (expected list, start or reconcile)", args[0])
+	}
+}
+
+// listQuests prints every quest with its status.
+func listQuests(character *game.Character, quests []*game.Quest) error {
+	if len(quests) == 0 {
+		fmt.Println("No quests yet.")
+		return nil
+	}
+
+	for _, quest := range quests {
+		status := string(quest.Status)
+		if quest.Status == game.QuestAvailable {
+			if reasons := quest.LockReasons(character); len(reasons) > 0 {
+				status = "locked: " + strings.Join(reasons, "; ")
+			}
+		}
+		fmt.Printf("%-10s %-28s %s\n", shortID(quest.ID), quest.Title, status)
+	}
+	return nil
+}
+
+// startQuest starts a quest by ID (or ID prefix). When no ID is given and
+// stdin is a terminal, an interactive picker of available quests is shown.
+func startQuest(questID string, character *game.Character, quests []*game.Quest, cfg *config.Config, storageClient storage.Storage) error {
+	if questID == "" {
+		picked, err := pickAvailableQuest(character, quests)
+		if err != nil {
+			return err
+		}
+		if picked == "" {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		questID = picked
+	}
+
+	quest := game.FindQuest(quests, questID)
+	if quest == nil {
+		return fmt.Errorf("quest not found: %s", questID)
+	}
+
+	handler, err := game.NewGameEventHandler(character, quests, game.NewEventBus(), storageClient, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create game event handler: %w", err)
+	}
+
+	repoPath, baseSHA := currentRepoHead()
+	if err := handler.StartQuest(quest.ID, repoPath, baseSHA); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Quest started: %s\n", quest.Title)
+	return nil
+}
+
+// reconcileQuests recounts active lines quests from git history, fixing
+// progress missed while CodeQuest wasn't running.
+func reconcileQuests(character *game.Character, quests []*game.Quest, cfg *config.Config, storageClient storage.Storage) error {
+	handler, err := game.NewGameEventHandler(character, quests, game.NewEventBus(), storageClient, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create game event handler: %w", err)
+	}
+
+	results := handler.ReconcileLinesQuests(watcher.LinesChangedSince)
+	if len(results) == 0 {
+		fmt.Println("No active lines quests to reconcile.")
+		return nil
+	}
+
+	for _, result := range results {
+		quest := result.Quest
+		switch {
+		case errors.Is(result.Err, game.ErrNotReconcilable):
+			fmt.Printf("- %s: skipped (started without a git repository)\n", quest.Title)
+		case result.Err != nil:
+			fmt.Printf("⚠ %s: %v\n", quest.Title, result.Err)
+		case quest.Status == game.QuestCompleted:
+			fmt.Printf("✓ %s: %d → %d/%d lines, quest complete!\n", quest.Title, result.Before, result.After, quest.Target)
+		case result.Changed():
+			fmt.Printf("✓ %s: %d → %d/%d lines\n", quest.Title, result.Before, result.After, quest.Target)
+		default:
+			fmt.Printf("✓ %s: %d/%d lines (already up to date)\n", quest.Title, result.After, quest.Target)
+		}
+	}
+	return nil
+}
+
+// gistFilename is the file the profile page is stored as in a gist.
+const gistFilename = "codequest-profile.html"
+
+// runPublishCommand handles `codequest publish [--out FILE]`: it renders the
+// public profile page and publishes it to the configured gh-pages branch or
+// gist, or writes it to a local file for a preview.
+func runPublishCommand(args []string, cfg *config.Config, storageClient storage.Storage) error {
+	flags := flag.NewFlagSet("publish", flag.ContinueOnError)
+	out := flags.String("out", "", "write the page to this file instead of publishing")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("usage: codequest publish [--out FILE]")
+	}
+
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+
+	profile := publish.BuildProfile(character, cfg.Publish.Privacy, game.StreakClockFromConfig(cfg), time.Now())
+	page, err := publish.RenderPage(profile)
+	if err != nil {
+		return err
+	}
+
+	if *out != "" {
+		if err := os.WriteFile(*out, page, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", *out, err)
+		}
+		fmt.Printf("✓ Profile page written to %s\n", *out)
+		return nil
+	}
+
+	switch cfg.Publish.Target {
+	case "gh-pages":
+		return publishToPages(page, cfg.Publish)
+	case "gist":
+		return publishToGist(page, cfg.Publish)
+	default:
+		return fmt.Errorf("publishing isn't set up: set target in the [publish] config section to gh-pages or gist (or preview with --out FILE)")
+	}
+}
+
+// runDigestCommand handles `codequest digest [--out FILE]`, delivering this
+// week's digest right away (over SMTP, or as an .eml file) whatever the
+// schedule, or writing its HTML to a file for a preview.
+func runDigestCommand(args []string, cfg *config.Config, storageClient storage.Storage) error {
+	flags := flag.NewFlagSet("digest", flag.ContinueOnError)
+	out := flags.String("out", "", "write the digest's HTML to this file instead of sending it")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("usage: codequest digest [--out FILE]")
+	}
+
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+	quests, err := storageClient.LoadQuests()
+	if err != nil {
+		return fmt.Errorf("failed to load quests: %w", err)
+	}
+
+	d := digest.Build(character, quests, game.StreakClockFromConfig(cfg), time.Now())
+	if *out != "" {
+		html, err := digest.RenderHTML(d)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*out, html, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", *out, err)
+		}
+		fmt.Printf("✓ Digest written to %s\n", *out)
+		return nil
+	}
+
+	sentTo, err := digest.Deliver(d, cfg.Digest)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Weekly digest delivered to %s\n", sentTo)
+	return nil
+}
+
+// runTimesheetCommand handles `codequest timesheet [--format toggl|clockify]
+// [--since YYYY-MM-DD] [--email ADDR] [--out FILE]`, writing the session
+// timer's time log as CSV that Toggl Track or Clockify can import.
+func runTimesheetCommand(args []string, cfg *config.Config, storageClient storage.Storage) error {
+	const usage = "usage: codequest timesheet [--format toggl|clockify] [--since YYYY-MM-DD] [--email ADDR] [--out FILE]"
+	defaultEmail := ""
+	if len(cfg.Git.AuthorEmails) > 0 {
+		defaultEmail = cfg.Git.AuthorEmails[0]
+	}
+	flags := flag.NewFlagSet("timesheet", flag.ContinueOnError)
+	formatName := flags.String("format", string(timesheet.FormatToggl), "tracker to import into: toggl or clockify")
+	sinceDate := flags.String("since", "", "only include time from this day on (YYYY-MM-DD)")
+	email := flags.String("email", defaultEmail, "email of the tracker account the entries belong to")
+	out := flags.String("out", "", "write the CSV to this file instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf(usage)
+	}
+
+	format, err := timesheet.ParseFormat(*formatName)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	if *sinceDate != "" {
+		since, err = time.ParseInLocation(time.DateOnly, *sinceDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid --since date %q (%s)", *sinceDate, usage)
+		}
+	}
+
+	entries, err := storageClient.LoadTimeLog()
+	if err != nil {
+		return fmt.Errorf("failed to load time log: %w", err)
+	}
+	rows := timesheet.Rows(entries, since, cfg.ProjectForRepo)
+
+	if *out == "" {
+		return timesheet.WriteCSV(os.Stdout, rows, format, *email, time.Local)
+	}
+	file, err := os.Create(*out)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *out, err)
+	}
+	defer file.Close()
+	if err := timesheet.WriteCSV(file, rows, format, *email, time.Local); err != nil {
+		return err
+	}
+	fmt.Printf("✓ %d time entries written to %s\n", len(rows), *out)
+	return nil
+}
+
+// runDumpCommand handles `codequest dump --screen dashboard|character|quests`,
+// printing a screen's information as plain text with no colors or box
+// drawing, for screen readers and scripts.
+func runDumpCommand(args []string, cfg *config.Config, storageClient storage.Storage) error {
+	const usage = "usage: codequest dump --screen dashboard|character|quests"
+	flags := flag.NewFlagSet("dump", flag.ContinueOnError)
+	screen := flags.String("screen", "dashboard", "screen to print: dashboard, character or quests")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf(usage)
+	}
+
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+	quests, err := storageClient.LoadQuests()
+	if err != nil {
+		return fmt.Errorf("failed to load quests: %w", err)
+	}
+	quests, _ = game.SeedQuestTemplates(quests)
+
+	now := time.Now()
+	switch *screen {
+	case "dashboard":
+		data := screens.DashboardData{
+			Character: character,
+			Quests:    quests,
+			Budget:    game.XPBudgetFromConfig(cfg),
+			Clock:     game.StreakClockFromConfig(cfg),
+			Now:       now,
+		}
+		fmt.Print(screens.DumpDashboard(data, cfg.UI.EnabledDashboardWidgets()))
+	case "character":
+		projects := game.SummarizeProjects(character, quests, cfg.ProjectNames())
+		fmt.Print(screens.DumpCharacter(character, projects, now))
+	case "quests":
+		fmt.Print(screens.DumpQuests(character, quests))
+	default:
+		return fmt.Errorf("unknown screen %q (%s)", *screen, usage)
+	}
+	return nil
+}
+
+// runStagedCommand handles `codequest staged`, listing the staged but
+// uncommitted changes in every watched repository (and the one in the
+// current directory) with the XP committing them now would earn.
+func runStagedCommand(cfg *config.Config, storageClient storage.Storage) error {
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+
+	repoPaths, err := config.ExpandPaths(cfg.Git.WatchPaths)
+	if err != nil {
+		return fmt.Errorf("failed to expand watch paths: %w", err)
+	}
+	if cwd, head := currentRepoHead(); head != "" {
+		repoPaths = append(repoPaths, cwd)
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool)
+	staged := 0
+	for _, repoPath := range repoPaths {
+		changes, err := watcher.ScanStaged(repoPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			continue
+		}
+		if seen[changes.Repo] || len(changes.Files) == 0 {
+			continue
+		}
+		seen[changes.Repo] = true
+		staged++
+
+		estimate := game.EstimateCommitXP(changes.LinesAdded, changes.LinesRemoved, cfg, character, now)
+		fmt.Printf("%s\n", changes.Repo)
+		for _, file := range changes.Files {
+			if file.Binary {
+				fmt.Printf("  %-40s binary\n", file.Path)
+				continue
+			}
+			fmt.Printf("  %-40s +%d -%d\n", file.Path, file.Added, file.Removed)
+		}
+		fmt.Printf("  Projected XP: %d (%s)\n", estimate.Total, estimate)
+		if estimate.LinesCapped() {
+			fmt.Println("  The lines bonus is maxed out - committing smaller steps more often earns more")
+		}
+		fmt.Println()
+	}
+
+	if staged == 0 {
+		fmt.Println("Nothing staged in your watched repositories - stage changes with git add to see what committing them would earn.")
+	}
+	return nil
+}
+
+// commitMsgHookMarker identifies the prepare-commit-msg hook CodeQuest
+// installs, so it is only ever replaced by itself.
+const commitMsgHookMarker = "# Installed by codequest suggest-commit-message --install-hook"
+
+// commitMsgHook fills in a suggested message when git opens the editor
+// without one (plain `git commit`); -m, -F, merges, squashes and amends
+// keep their own message. Without a suggestion the commit goes on as usual.
+const commitMsgHook = `#!/bin/sh
+` + commitMsgHookMarker + `
+[ -z "$2" ] || exit 0
+suggestion=$(codequest suggest-commit-message 2>/dev/null) || exit 0
+{ printf '%s\n' "$suggestion"; cat "$1"; } > "$1.codequest" && mv "$1.codequest" "$1"
+`
+
+// runSuggestCommitMessageCommand handles `codequest suggest-commit-message
+// [--install-hook]`: the mentor reads the current repository's staged diff
+// and suggests a conventional commit message, printed alone on stdout for
+// `git commit -e -m "$(codequest suggest-commit-message)"`. --install-hook
+// adds a prepare-commit-msg hook that does this for every plain git commit.
+func runSuggestCommitMessageCommand(args []string, cfg *config.Config) error {
+	flags := flag.NewFlagSet("suggest-commit-message", flag.ContinueOnError)
+	installHook := flags.Bool("install-hook", false, "install a prepare-commit-msg hook in the current repository")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("usage: codequest suggest-commit-message [--install-hook]")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+	if *installHook {
+		return installCommitMsgHook(cwd)
+	}
+
+	changes, err := watcher.ScanStaged(cwd)
+	if err != nil {
+		return err
+	}
+	if len(changes.Files) == 0 {
+		return fmt.Errorf("nothing staged - stage changes with git add first")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	message, err := ai.SuggestCommitMessage(ctx, newCLIAIManager(cfg), changes.Diff(ai.MaxCommitDiffBytes))
+	if err != nil {
+		return fmt.Errorf("the mentor couldn't suggest a message: %w", err)
+	}
+
+	fmt.Println(message)
+	if !game.IsConventionalCommit(message) {
+		fmt.Fprintln(os.Stderr, "Warning: the suggestion isn't a well-formed conventional commit - edit it before committing")
+	}
+	return nil
+}
+
+// newCLIAIManager sets up the AI providers available outside the TUI:
+// Crush when $OPENROUTER_API_KEY is set, then Mods and Claude Code when
+// installed.
+func newCLIAIManager(cfg *config.Config) *ai.AIManager {
+	manager := ai.NewAIManager(cfg)
+	if apiKey := os.Getenv("OPENROUTER_API_KEY"); apiKey != "" {
+		manager.RegisterProvider(ai.NewCrushProvider(apiKey, &cfg.AI))
+	}
+	manager.RegisterProvider(ai.NewModsProvider(&cfg.AI))
+	manager.RegisterProvider(ai.NewClaudeProvider(&cfg.AI))
+	return manager
+}
+
+// installCommitMsgHook writes the prepare-commit-msg hook into the
+// repository containing path. An existing hook CodeQuest didn't install is
+// left alone.
+func installCommitMsgHook(path string) error {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return fmt.Errorf("not in a git repository: %w", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to access worktree: %w", err)
+	}
+
+	hooksDir := filepath.Join(worktree.Filesystem.Root(), ".git", "hooks")
+	hookPath := filepath.Join(hooksDir, "prepare-commit-msg")
+	if existing, err := os.ReadFile(hookPath); err == nil && !strings.Contains(string(existing), commitMsgHookMarker) {
+		return fmt.Errorf("%s already exists - add `codequest suggest-commit-message` to it by hand", hookPath)
+	}
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+	if err := os.WriteFile(hookPath, []byte(commitMsgHook), 0755); err != nil {
+		return fmt.Errorf("failed to write hook: %w", err)
+	}
+	fmt.Printf("✓ Installed %s - git commit now opens with a suggested message\n", hookPath)
+	return nil
+}
+
+// runStorageCommand handles `codequest storage <status|push|pull>` for the
+// ssh backend: status lists changes saved while offline, push sends them
+// (--force overwrites the server copy on a conflict), and pull replaces them
+// with the server copy.
+func runStorageCommand(args []string, cfg *config.Config, storageClient *storage.Client) error {
+	const usage = "usage: codequest storage status|push [--force]|pull"
+	if len(args) == 0 {
+		return fmt.Errorf(usage)
+	}
+	remote, ok := storageClient.SSH()
+	if !ok {
+		return fmt.Errorf("game data isn't stored over ssh - set backend = \"ssh\" in the [storage] config section to sync with a server")
+	}
+
+	switch args[0] {
+	case "status":
+		fmt.Printf("Backend: ssh (%s:%s)\n", cfg.Storage.SSH.Host, cfg.Storage.SSH.Path)
+		pending, err := remote.PendingChanges()
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			fmt.Println("✓ Everything is saved on the server")
+			return nil
+		}
+		fmt.Println("Not on the server yet:")
+		for _, key := range pending {
+			fmt.Printf("  %s\n", key)
+		}
+		fmt.Println("Run codequest storage push to send them, or codequest storage pull to keep the server copy.")
+	case "push":
+		flags := flag.NewFlagSet("storage push", flag.ContinueOnError)
+		force := flags.Bool("force", false, "overwrite the server copy on a conflict")
+		if err := flags.Parse(args[1:]); err != nil {
+			return fmt.Errorf(usage)
+		}
+		if err := remote.Push(*force); err != nil {
+			if errors.Is(err, storage.ErrConflict) {
+				return fmt.Errorf("%w\nRun codequest storage push --force to keep this machine's copy, or codequest storage pull to keep the server's", err)
+			}
+			return err
+		}
+		fmt.Println("✓ Local changes pushed to the server")
+	case "pull":
+		if err := remote.Pull(); err != nil {
+			return err
+		}
+		fmt.Println("✓ Local copy replaced with the server's")
+	default:
+		return fmt.Errorf("unknown storage command %q (%s)", args[0], usage)
+	}
+	return nil
+}
+
+// runAuditCommand handles `codequest audit`: it recomputes XP and level from
+// the ledger in the event log, reports where the stored character differs
+// and why, and offers to reconcile them. Either choice records an audit
+// entry in the ledger, so the same gaps aren't reported again.
+func runAuditCommand(cfg *config.Config, storageClient storage.Storage) error {
+	if !cfg.EventLog.Enabled {
+		return fmt.Errorf("the XP ledger is kept in the event log - set enabled = true in the [event_log] config section, then audit once it has recorded some XP")
+	}
+
+	character, err := storageClient.LoadCharacter()
+	if err != nil {
+		return fmt.Errorf("no character found - run codequest once to create one")
+	}
+	path, err := eventlog.PathFromConfig(cfg.EventLog)
+	if err != nil {
+		return err
+	}
+	events, err := eventlog.ReadAll(path)
+	if err != nil {
+		return err
+	}
+
+	entries := game.LedgerFromEvents(events)
+	audit := game.AuditXP(entries, character)
+	fmt.Printf("Ledger: %d XP entries in %s\n", audit.Entries, path)
+	fmt.Printf("Expected: level %d, %d total XP\n", audit.ExpectedLevel, audit.ExpectedXP)
+	fmt.Printf("Stored:   level %d, %d total XP\n", audit.StoredLevel, audit.StoredXP)
+	if audit.Entries == 0 {
+		fmt.Println("Nothing to check yet - XP changes are recorded as you play.")
+		return nil
+	}
+	if audit.Clean() {
+		fmt.Println("✓ Ledger and character agree")
+		return nil
+	}
+
+	fmt.Printf("\n⚠ %d discrepancies:\n", len(audit.Discrepancies))
+	for _, d := range audit.Discrepancies {
+		when := "now"
+		if !d.At.IsZero() {
+			when = d.At.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Printf("  %s  %-13s  %s\n", when, d.Cause, d.Detail)
+	}
+
+	fmt.Println()
+	fmt.Printf("  1) Restore the character from the ledger (level %d, %d total XP)\n", audit.ExpectedLevel, audit.ExpectedXP)
+	fmt.Printf("  2) Keep the saved character and record the difference in the ledger (%+d XP)\n", audit.StoredXP-audit.ExpectedXP)
+	fmt.Print("Reconcile? (1/2, Enter to leave as is): ")
+
+	var input string
+	fmt.Scanln(&input)
+	var entry game.Event
+	switch strings.TrimSpace(input) {
+	case "1":
+		character.SetTotalXP(audit.ExpectedXP)
+		if err := storageClient.SaveCharacter(character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		entry = game.NewXPChangedEvent(0, game.XPSourceAudit, audit.ExpectedXP)
+	case "2":
+		entry = game.NewXPChangedEvent(audit.StoredXP-audit.ExpectedXP, game.XPSourceAudit, audit.StoredXP)
+	default:
+		fmt.Println("Left as is.")
+		return nil
+	}
+
+	eventLog, err := eventlog.OpenFromConfig(cfg.EventLog)
+	if err != nil {
+		return err
+	}
+	defer eventLog.Close()
+	if err := eventLog.Write(entry); err != nil {
+		return err
+	}
+	fmt.Printf("✓ Reconciled: level %d, %d total XP\n", character.Level, character.TotalXP())
+	return nil
+}
+
+// runAnalyticsCommand handles `codequest analytics [import|export]`:
+// import backfills the analytics database from the event log, export
+// writes XP per day as CSV, and without arguments it prints the last 30
+// days' event counts and XP per day.
+func runAnalyticsCommand(args []string, cfg *config.Config) error {
+	const usage = "usage: codequest analytics [import | export [--since YYYY-MM-DD] [--out FILE]]"
+	if !cfg.EventLog.Enabled || !cfg.EventLog.Analytics {
+		return fmt.Errorf("the analytics database is off - set enabled = true and analytics = true in the [event_log] config section")
+	}
+
+	analytics, err := openAnalytics(cfg)
+	if err != nil {
+		return err
+	}
+	defer analytics.Close()
+
+	if len(args) == 0 {
+		return printAnalytics(analytics)
+	}
+	switch args[0] {
+	case "import":
+		path, err := eventlog.PathFromConfig(cfg.EventLog)
+		if err != nil {
+			return err
+		}
+		events, err := eventlog.ReadAll(path)
+		if err != nil {
+			return err
+		}
+		stored, err := analytics.Import(events)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ Imported %d new events (%d in %s)\n", stored, len(events), path)
+		return nil
+	case "export":
+		flags := flag.NewFlagSet("analytics export", flag.ContinueOnError)
+		sinceDate := flags.String("since", "", "only include XP from this day on (YYYY-MM-DD)")
+		out := flags.String("out", "", "write the CSV to this file instead of stdout")
+		if err := flags.Parse(args[1:]); err != nil {
+			return fmt.Errorf(usage)
+		}
+		var since time.Time
+		if *sinceDate != "" {
+			since, err = time.ParseInLocation(time.DateOnly, *sinceDate, time.Local)
+			if err != nil {
+				return fmt.Errorf("invalid --since date %q (%s)", *sinceDate, usage)
+			}
+		}
+
+		days, err := analytics.XPByDay(since)
+		if err != nil {
+			return err
+		}
+		if *out == "" {
+			return eventlog.WriteXPCSV(os.Stdout, days)
+		}
+		file, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", *out, err)
+		}
+		defer file.Close()
+		if err := eventlog.WriteXPCSV(file, days); err != nil {
+			return err
+		}
+		fmt.Printf("✓ %d days written to %s\n", len(days), *out)
+		return nil
+	default:
+		return fmt.Errorf(usage)
+	}
+}
+
+// printAnalytics prints the last 30 days' event counts and XP per day.
+func printAnalytics(analytics *eventlog.Analytics) error {
+	since := time.Now().AddDate(0, 0, -30)
+	counts, err := analytics.EventCounts(since)
+	if err != nil {
+		return err
+	}
+	days, err := analytics.XPByDay(since)
+	if err != nil {
+		return err
+	}
+	if len(counts) == 0 {
+		fmt.Println("No events in the last 30 days - run codequest analytics import to backfill from the event log.")
+		return nil
+	}
+
+	fmt.Println("Events (last 30 days):")
+	for _, count := range counts {
+		fmt.Printf("  %-20s %6d\n", count.Type, count.Count)
+	}
+	fmt.Println()
+	fmt.Println("XP per day:")
+	for _, day := range days {
+		fmt.Printf("  %s  %+6d\n", day.Day, day.XP)
+	}
+	return nil
+}
+
+// openAnalytics opens the analytics database the config describes.
+func openAnalytics(cfg *config.Config) (*eventlog.Analytics, error) {
+	path, err := eventlog.AnalyticsPathFromConfig(cfg.EventLog)
+	if err != nil {
+		return nil, err
+	}
+	return eventlog.OpenAnalytics(path, game.StreakClockFromConfig(cfg))
+}
+
+// publishToPages commits the page to the pages branch and pushes it.
+func publishToPages(page []byte, settings config.PublishConfig) error {
+	repoPath, err := config.ExpandPath(settings.Repo)
+	if err != nil {
+		return fmt.Errorf("invalid publish.repo: %w", err)
+	}
+	branch := settings.Branch
+	if branch == "" {
+		branch = "gh-pages"
+	}
+	remote := settings.Remote
+	if remote == "" {
+		remote = "origin"
+	}
+
+	changed, err := publish.CommitPage(repoPath, branch, map[string][]byte{publish.PageFile: page}, "Update CodeQuest profile", time.Now())
+	if err != nil {
+		return err
+	}
+	// Push even when unchanged, in case an earlier push failed
+	if err := publish.PushBranch(repoPath, remote, branch); err != nil {
+		return err
+	}
+
+	if !changed {
+		fmt.Printf("✓ Profile unchanged; %s is up to date on %s\n", branch, remote)
+		return nil
+	}
+	fmt.Printf("✓ Profile published to %s (%s)\n", branch, remote)
+	return nil
+}
+
+// publishToGist creates or updates the profile gist.
+func publishToGist(page []byte, settings config.PublishConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	gist, err := github.NewClientFromEnv().PublishGist(ctx, settings.GistID, "CodeQuest profile", gistFilename, string(page))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Profile published to %s\n", gist.URL)
+	if settings.GistID == "" {
+		fmt.Printf("  Set gist_id = %q in the [publish] config section to keep updating this gist.\n", gist.ID)
+	}
+	return nil
+}
+
+// pickAvailableQuest shows a picker of quests the character can start.
+// Returns an empty ID if the user cancelled.
+func pickAvailableQuest(character *game.Character, quests []*game.Quest) (string, error) {
+	items := make([]ui.PickerItem, 0)
+	for _, quest := range quests {
+		if !quest.IsAvailable(character) {
+			continue
+		}
+		items = append(items, ui.PickerItem{
+			Value:  quest.ID,
+			Label:  quest.Title,
+			Detail: fmt.Sprintf("%s · %d XP", quest.Description, quest.XPReward),
+		})
+	}
+
+	if len(items) == 0 {
+		return "", fmt.Errorf("no available quests to start")
+	}
+	if !ui.IsInteractive() {
+		return "", fmt.Errorf("quest ID required: codequest quests start <quest-id>")
+	}
+
+	picked, err := ui.RunPicker("Start which quest?", items)
+	if err != nil || picked == nil {
+		return "", err
+	}
+	return picked.Value, nil
+}
+
+// shortID returns the first 8 characters of an ID for display.
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+// currentRepoHead returns the root of the git repository the working
+// directory is in and its HEAD SHA. Outside a repository (or without a
+// HEAD) the working directory is returned with an empty SHA.
+func currentRepoHead() (string, string) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", ""
+	}
+
+	repo, err := git.PlainOpenWithOptions(cwd, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return cwd, ""
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return cwd, ""
+	}
+	if worktree, err := repo.Worktree(); err == nil {
+		return worktree.Filesystem.Root(), head.Hash().String()
+	}
+	return cwd, head.Hash().String()
+}
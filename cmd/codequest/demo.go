@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/demo"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui"
+)
+
+// demoEnv lists the variables pointed into the demo's sandbox, so nothing
+// it saves (config, game data, Skate's database) touches the real ones.
+var demoEnv = []string{"HOME", "XDG_CONFIG_HOME", "XDG_DATA_HOME", "XDG_CACHE_HOME", "APPDATA", "LOCALAPPDATA", "CHARM_DATA_DIR"}
+
+// runDemo runs `codequest demo`: the full TUI on a synthetic character and
+// quest log while scripted commits arrive on timers (see package demo), for
+// recording GIFs and giving talks. It runs in a throwaway directory with the
+// default config, never reads the real config, game data or repositories,
+// and needs neither Skate nor git.
+//
+// Parameters:
+//   - args: Arguments after "demo"
+//
+// Returns:
+//   - error: An error if the flags are invalid or the demo can't start
+func runDemo(args []string) error {
+	flags := flag.NewFlagSet("demo", flag.ContinueOnError)
+	speed := flags.Float64("speed", 1, "play the script faster (2) or slower (0.5)")
+	if err := flags.Parse(args); err != nil || *speed <= 0 {
+		return fmt.Errorf("usage: codequest demo [--speed N]")
+	}
+
+	sandbox, err := os.MkdirTemp("", "codequest-demo-")
+	if err != nil {
+		return fmt.Errorf("failed to create demo directory: %w", err)
+	}
+	defer os.RemoveAll(sandbox)
+	for _, name := range demoEnv {
+		if err := os.Setenv(name, sandbox); err != nil {
+			return fmt.Errorf("failed to set %s: %w", name, err)
+		}
+	}
+	log.SetOutput(io.Discard) // The game handler's log lines would draw over the TUI
+
+	cfg := config.DefaultConfig()
+	storageClient, err := storage.NewLocalClient(filepath.Join(sandbox, "data"))
+	if err != nil {
+		return err
+	}
+
+	// Seed the synthetic game, which the UI loads from storage
+	now := time.Now()
+	character := demo.Character(cfg, now)
+	quests := demo.Quests(now)
+	if err := storageClient.SaveCharacter(character); err != nil {
+		return fmt.Errorf("failed to save demo character: %w", err)
+	}
+	if err := storageClient.SaveQuests(quests); err != nil {
+		return fmt.Errorf("failed to save demo quests: %w", err)
+	}
+
+	// The game handler and UI share one bus, so scripted commits show up live
+	eventBus := game.NewEventBus()
+	gameHandler, err := game.NewGameEventHandler(character, quests, eventBus, storageClient, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create game event handler: %w", err)
+	}
+	if err := gameHandler.Start(); err != nil {
+		return fmt.Errorf("failed to start game event handler: %w", err)
+	}
+	defer gameHandler.Stop()
+
+	ui.ApplyPalette(cfg.UI.Palette)
+	model := ui.NewModel(storageClient, cfg, Version)
+	model.SetEventBus(eventBus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go demo.Run(ctx, eventBus, demo.Script(), *speed)
+
+	program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	if _, err := program.Run(); err != nil {
+		return fmt.Errorf("error running the demo: %w", err)
+	}
+	return nil
+}
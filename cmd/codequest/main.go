@@ -6,17 +6,31 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/crash"
+	"github.com/AutumnsGrove/codequest/internal/digest"
+	"github.com/AutumnsGrove/codequest/internal/discord"
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/github"
+	"github.com/AutumnsGrove/codequest/internal/habits"
+	"github.com/AutumnsGrove/codequest/internal/power"
 	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/termimage"
 	"github.com/AutumnsGrove/codequest/internal/ui"
 	"github.com/AutumnsGrove/codequest/internal/watcher"
 )
@@ -32,12 +46,19 @@ var (
 var (
 	showVersion = flag.Bool("version", false, "Show version information and exit")
 	showHelp    = flag.Bool("help", false, "Show help message and exit")
+	energy      = flag.Int("energy", 0, "Record today's energy level (1-5) at session start")
+	openTarget  = flag.String("open", "", "Open the TUI on a screen or quest: "+ui.DeepLinkTargets)
+	quick       = flag.Bool("quick", false, "Skip all prompts: name the character from git, watch the current repository")
 )
 
 func main() {
 	// Step 1: Parse CLI flags
 	flag.Parse()
 
+	// Keep recent log lines for crash diagnostics bundles
+	logRing := crash.NewLogRing(200)
+	log.SetOutput(io.MultiWriter(os.Stderr, logRing))
+
 	// Handle --version flag
 	if *showVersion {
 		fmt.Printf("CodeQuest %s\n", Version)
@@ -52,41 +73,203 @@ func main() {
 		os.Exit(0)
 	}
 
+	// The demo runs on synthetic data, without the config or storage
+	if flag.Arg(0) == "demo" {
+		if err := runDemo(flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Validate --open before doing any setup, so typos fail fast
+	var deepLink *ui.DeepLink
+	if *openTarget != "" {
+		link, err := ui.ParseDeepLink(*openTarget)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Invalid --open target: %v\n", err)
+			os.Exit(1)
+		}
+		deepLink = &link
+	}
+
 	// Step 2: Load or create default configuration
 	cfg, err := config.Load()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "❌ Failed to load configuration: %v\n", err)
-		fmt.Fprintf(os.Stderr, "   Config file: ~/.config/codequest/config.toml\n")
+		if configPath, pathErr := config.ConfigPath(); pathErr == nil {
+			fmt.Fprintf(os.Stderr, "   Config file: %s\n", configPath)
+		}
 		os.Exit(1)
 	}
 
-	// Step 3: Initialize Skate storage (graceful error if missing)
-	storageClient, err := storage.NewSkateClient()
+	// Quick mode watches the repository around the working directory
+	var quickRepo watcher.DetectedRepository
+	if *quick {
+		quickRepo, err = detectQuickRepo()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ --quick needs to run inside a git repository: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Step 3: Initialize storage (Skate, local files or a server over SSH).
+	// Without Skate installed, game data is kept in local files instead.
+	storageClient, err := storage.NewClient(cfg)
+	if err != nil && (cfg.Storage.Backend == "" || cfg.Storage.Backend == "skate") {
+		storageClient, err = localStorageFallback()
+	}
 	if err != nil {
-		showSkateInstallInstructions()
+		if cfg.Storage.Backend == "ssh" {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		} else {
+			showSkateInstallInstructions()
+		}
 		os.Exit(1)
 	}
 
+	// Headless CLI verbs (e.g. `codequest quests start`) run without the TUI
+	if flag.NArg() > 0 {
+		if err := runCommand(flag.Args(), cfg, storageClient); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Step 4: Load or create character
 	character, err := storageClient.LoadCharacter()
 	if err != nil {
-		// First run - create new character
-		character = promptForCharacterCreation(cfg)
+		// First run - create new character (named from git in quick mode)
+		if *quick {
+			character = quickCharacter(cfg, quickRepo)
+		} else {
+			character = promptForCharacterCreation(cfg)
+		}
 		if err := storageClient.SaveCharacter(character); err != nil {
 			fmt.Fprintf(os.Stderr, "❌ Failed to save new character: %v\n", err)
 			os.Exit(1)
 		}
 	}
 
+	// Step 4b: Record energy check-in (flag takes priority over prompt)
+	if *energy != 0 {
+		if err := character.RecordEnergy(*energy); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Invalid energy rating: %v\n", err)
+			os.Exit(1)
+		}
+	} else if cfg.Tracking.EnergyCheckIn && character.TodayEnergy() == nil && !*quick {
+		promptForEnergyCheckIn(character)
+	}
+	if character.TodayEnergy() != nil {
+		if err := storageClient.SaveCharacter(character); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save energy check-in: %v\n", err)
+		}
+	}
+
+	// Step 4c: Prune history past the retention limits
+	if report := character.PruneHistory(game.RetentionPolicyFromConfig(cfg), time.Now()); report.Changed() {
+		if err := storageClient.SaveCharacter(character); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save pruned history: %v\n", err)
+		}
+	}
+
+	// Step 4d: Neglecting the companion pet for days costs it a stage
+	adopted := character.Pet.LastCared.IsZero()
+	lost := character.CheckPetNeglect(time.Now(), game.StreakClockFromConfig(cfg))
+	if lost > 0 {
+		fmt.Printf("🥚 Your companion missed you and shrank back to a %s.\n", game.PetStageName(character.Pet.Stage))
+	}
+	if lost > 0 || adopted {
+		if err := storageClient.SaveCharacter(character); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save companion: %v\n", err)
+		}
+	}
+
+	// Step 4e: Opt-in stat decay after long absences (warned a week ahead)
+	decay := game.StatDecayFromConfig(cfg)
+	if lost := character.ApplyStatDecay(decay, time.Now()); len(lost) > 0 {
+		fmt.Printf("🍂 While you were away: %s. Finish The Comeback quest to win them back.\n", game.DecayMessage(lost))
+		if err := storageClient.SaveCharacter(character); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save stat decay: %v\n", err)
+		}
+	} else if days, warn := decay.DaysUntilDecay(character, time.Now()); warn {
+		fmt.Printf("🍂 Your Code Power and Agility start fading in %d days without a commit.\n", days)
+	}
+
 	// Step 5: Load quests
 	quests, err := storageClient.LoadQuests()
 	if err != nil {
 		// Non-fatal - start with empty quests
 		quests = []*game.Quest{}
+	} else if seeded, added := game.SeedQuestTemplates(quests); added {
+		// Add built-in quest templates missing from the quest log (never
+		// when loading failed, so saved quests aren't overwritten)
+		quests = seeded
+		if err := storageClient.SaveQuests(quests); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save built-in quests: %v\n", err)
+		}
+	}
+	if err == nil {
+		if withComeback, added := game.EnsureComebackQuest(quests, character); added {
+			quests = withComeback
+			if err := storageClient.SaveQuests(quests); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save comeback quest: %v\n", err)
+			}
+		}
+	}
+	// Custom quest templates: quests for new files, updates for changed ones
+	// (problems are reported by the template watcher once the UI is up)
+	questTemplateDir, questTemplateDirErr := config.QuestTemplateDir()
+	if err == nil && questTemplateDirErr == nil {
+		templates, _ := watcher.LoadQuestTemplates(questTemplateDir)
+		if merged, added, updated := game.MergeQuestTemplates(quests, templates); added+updated > 0 {
+			quests = merged
+			if err := storageClient.SaveQuests(quests); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to save custom quests: %v\n", err)
+			}
+		}
+	}
+	if deepLink != nil && deepLink.QuestID != "" && game.FindQuest(quests, deepLink.QuestID) == nil {
+		fmt.Fprintf(os.Stderr, "❌ Quest not found: %s (run `codequest quests list` for IDs)\n", deepLink.QuestID)
+		os.Exit(1)
 	}
 
 	// Step 6: Create EventBus and register GameEventHandler
 	eventBus := game.NewEventBus()
+	if cfg.Debug.Enabled && cfg.Debug.LogLevel == "debug" {
+		eventBus.Use(game.LoggingMiddleware(log.Printf))
+	}
+	if cfg.EventLog.Enabled {
+		eventLog, err := eventlog.OpenFromConfig(cfg.EventLog)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to open event log: %v\n", err)
+		} else {
+			defer eventLog.Close()
+			eventBus.Use(eventLog.Middleware(log.Printf)) // One JSON line per game event
+		}
+	}
+	var analytics *eventlog.Analytics
+	if cfg.EventLog.Enabled && cfg.EventLog.Analytics {
+		analytics, err = openAnalytics(cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to open analytics database: %v\n", err)
+		} else {
+			defer analytics.Close()
+			eventBus.Use(analytics.Middleware(log.Printf)) // Indexed in SQLite for aggregate queries
+		}
+	}
+
+	var discordNotifier *discord.Notifier
+	if cfg.Discord.Enabled {
+		discordNotifier = discord.New(cfg.Discord, character.Name, log.Printf)
+		eventBus.Subscribe(game.EventQuestDone, discordNotifier.Handler()) // Rich embeds in a Discord channel
+		eventBus.Subscribe(game.EventLevelUp, discordNotifier.Handler())
+	}
+	if cfg.Habits.Enabled {
+		pusher := habits.New(cfg.Habits, log.Printf)
+		eventBus.Subscribe(game.EventQuestDone, pusher.Handler()) // Quest completions to Habitica or a webhook
+	}
 
 	// Create and start game event handler
 	gameHandler, err := game.NewGameEventHandler(character, quests, eventBus, storageClient, cfg)
@@ -103,13 +286,57 @@ func main() {
 	// Step 7: Create context for managing watcher lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	if discordNotifier != nil {
+		go discordNotifier.Run(ctx) // Posts queued embeds, spaced out for Discord's rate limit
+	}
+
+	// Step 8: Create Bubble Tea Model before the watcher starts, so it can
+	// show progress replaying missed commits (SessionTracker is initialized
+	// inside ui.NewModel())
+	ui.ApplyPalette(cfg.UI.Palette)
+	// Avatar and badge images where the terminal draws them, detected once per terminal
+	imageCache := ""
+	if dataDir, err := config.DataDir(); err == nil {
+		imageCache = filepath.Join(dataDir, "terminal-images.json")
+	}
+	ui.ApplyImageProtocol(termimage.Resolve(cfg.UI.Images, imageCache, os.Getenv, termimage.QuerySixel))
+	model := ui.NewModel(storageClient, cfg, Version)
+
+	// Low-power mode (on battery, or forced on/off in config), shared by
+	// the UI and the watcher
+	powerMonitor := power.NewMonitor(cfg.Power.LowPower)
+	go powerMonitor.Run(ctx) // Switches modes when the laptop is plugged in or unplugged
+	model.SetPowerMonitor(powerMonitor)
+	model.SetEventBus(eventBus) // Toasts for the game handler's events; Quest Board arrangements go to the handler
+	if analytics != nil {
+		model.SetAnalytics(analytics) // Recent totals on the character sheet
+	}
 
 	// Start GitWatcher with context
-	watcherManager, err := watcher.NewWatcherManager(eventBus, cfg)
+	watcherConfig := cfg
+	if *quick {
+		watcherConfig = withWatchPath(cfg, quickRepo.Root) // Watch the current repository this session only
+	}
+	watcherManager, err := watcher.NewWatcherManager(eventBus, watcherConfig)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "❌ Failed to create watcher manager: %v\n", err)
 		os.Exit(1)
 	}
+	watcherManager.SetCheckpointStore(storageClient)             // Enables replay of commits made while offline
+	watcherManager.SetReplayProgress(model.ReportReplayProgress) // Import progress on the loading screen and footer
+	watcherManager.SetPowerMonitor(powerMonitor)                 // Slower polling in low-power mode
+	if questTemplateDirErr == nil {
+		watcherManager.SetQuestTemplateDir(questTemplateDir) // Hot-loads custom quest templates
+	}
+	if cfg.Github.Enabled {
+		githubClient := github.NewClientFromEnv()
+		if cfg.Github.SplitSquashMerges {
+			watcherManager.SetPullRequestLookup(githubClient) // PR commit counts for squash merges
+		}
+		if githubClient.Authenticated() {
+			watcherManager.SetReviewLookup(githubClient) // PR approvals for review quests
+		}
+	}
 
 	if err := watcherManager.Start(ctx); err != nil {
 		fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to start git watcher: %v\n", err)
@@ -117,11 +344,38 @@ func main() {
 		// Non-fatal - continue without git watching
 	}
 
-	// Step 8: SessionTracker is initialized inside ui.NewModel()
-	// (already handled by the UI layer)
-
-	// Step 9: Create Bubble Tea Model
-	model := ui.NewModel(storageClient, cfg, Version)
+	// Day rollover jobs, run at startup and whenever a new day begins
+	clock := game.StreakClockFromConfig(cfg)
+	scheduler := game.NewDayScheduler(clock)
+	if cfg.Catalog.Enabled {
+		scheduler.Add(gameHandler.RefreshGeneratedQuests) // Daily and weekly quests, so the Quest Board is never empty
+	}
+	if cfg.Digest.Enabled {
+		// Weekly digest email
+		scheduler.Add(func(now time.Time) {
+			sentTo, err := digest.SendIfDue(cfg.Digest, storageClient, clock, now)
+			if err != nil {
+				log.Printf("Warning: Failed to send weekly digest: %v", err)
+			} else if sentTo != "" {
+				log.Printf("Weekly digest sent to %s", sentTo)
+			}
+		})
+	}
+	go scheduler.Run(ctx)
+
+	// Step 9: Connect the model to the running watcher
+	model.SetWatcherMetrics(watcherManager.Metrics) // Watcher telemetry in the settings debug section
+	if cfg.Debug.Enabled && cfg.Debug.MetricsAddr != "" {
+		// The same telemetry for Prometheus or curl, until the app exits
+		if addr, err := watcherManager.ServeMetrics(ctx, cfg.Debug.MetricsAddr); err != nil {
+			log.Printf("Warning: Failed to serve watcher metrics: %v", err)
+		} else {
+			log.Printf("Serving watcher metrics at http://%s/metrics", addr)
+		}
+	}
+	if deepLink != nil {
+		model.OpenOnStart(*deepLink) // Start on the --open screen instead of the dashboard
+	}
 
 	// Step 10: Setup graceful shutdown
 	// Create a channel to listen for OS signals
@@ -149,15 +403,16 @@ func main() {
 		os.Exit(0)
 	}()
 
-	// Step 11: Run Bubble Tea program
+	// Step 11: Run Bubble Tea program (panics become a crash screen with a diagnostics bundle)
 	program := tea.NewProgram(
-		model,
+		ui.NewCrashGuard(model, cfg, Version, logRing),
 		tea.WithAltScreen(),       // Use alternate screen buffer
 		tea.WithMouseCellMotion(), // Enable mouse support
 	)
 
 	// Run the program and handle any errors
-	if _, err := program.Run(); err != nil {
+	final, err := program.Run()
+	if err != nil {
 		// Ensure cleanup on error
 		cancel()
 		gameHandler.Stop()
@@ -171,7 +426,16 @@ func main() {
 	cancel()
 	gameHandler.Stop()
 	watcherManager.Stop()
-	model.Cleanup()
+
+	if guard, ok := final.(ui.CrashGuard); ok {
+		guard.Cleanup()
+		if bundlePath, crashed := guard.Crashed(); crashed {
+			if bundlePath != "" {
+				fmt.Fprintf(os.Stderr, "❌ CodeQuest crashed - diagnostics bundle: %s\n", bundlePath)
+			}
+			os.Exit(1)
+		}
+	}
 }
 
 // showHelpMessage displays usage information and available commands.
@@ -187,10 +451,29 @@ func showHelpMessage() {
 	fmt.Println()
 	fmt.Println("USAGE:")
 	fmt.Println("  codequest [flags]")
+	fmt.Println("  codequest <command> [args]")
+	fmt.Println()
+	fmt.Println("COMMANDS:")
+	fmt.Println("  quests list            List all quests")
+	fmt.Println("  quests start [id]      Start a quest (interactive picker if id omitted)")
+	fmt.Println("  quests reconcile       Recount lines quest progress from git history")
+	fmt.Println("  publish [--out FILE]   Publish your profile page (gh-pages or gist, see [publish] config)")
+	fmt.Println("  dump [--screen S]      Print dashboard, character or quests as plain text")
+	fmt.Println("  digest [--out FILE]    Send this week's digest email now (see [digest] config)")
+	fmt.Println("  staged                 Show staged changes and the XP committing them would earn")
+	fmt.Println("  storage status|push|pull  Sync game data with the server (ssh storage backend)")
+	fmt.Println("  suggest-commit-message [--install-hook]  Ask the mentor for a commit message for the staged diff")
+	fmt.Println("  timesheet [--format toggl|clockify] [--since DATE] [--out FILE]  Export timer hours as CSV")
+	fmt.Println("  audit                  Check XP and level against the event log's XP ledger")
+	fmt.Println("  analytics [import|export]  Show, backfill or export (CSV) totals from the SQLite analytics database")
+	fmt.Println("  demo [--speed N]       Play a scripted demo on synthetic data (for GIFs and talks)")
 	fmt.Println()
 	fmt.Println("FLAGS:")
 	fmt.Println("  --version    Show version information")
 	fmt.Println("  --help       Show this help message")
+	fmt.Println("  --energy N   Record today's energy level (1-5)")
+	fmt.Println("  --open T     Start on a screen: quest/<id>, quests, stats, mentor, settings")
+	fmt.Println("  --quick      No prompts: name from git config, watch the current repository")
 	fmt.Println()
 	fmt.Println("KEYBOARD SHORTCUTS:")
 	fmt.Println("  Dashboard:")
@@ -198,6 +481,11 @@ func showHelpMessage() {
 	fmt.Println("    C - Character Sheet")
 	fmt.Println("    M - AI Mentor")
 	fmt.Println("    S - Settings")
+	fmt.Println("    Y - Copy last commit SHA")
+	fmt.Println("    P - Feed your companion a trophy item")
+	fmt.Println()
+	fmt.Println("  Mentor:")
+	fmt.Println("    Ctrl+Y - Copy last response (code blocks only if present)")
 	fmt.Println()
 	fmt.Println("  Global:")
 	fmt.Println("    Alt+D - Dashboard")
@@ -211,6 +499,27 @@ func showHelpMessage() {
 	fmt.Println(helpStyle.Render("For more information, visit: https://github.com/AutumnsGrove/codequest"))
 }
 
+// localStorageFallback stores game data in local JSON files when Skate isn't
+// installed, so CodeQuest still runs. A note says where the data goes and
+// how to make it permanent.
+//
+// Returns:
+//   - *storage.Client: A client storing local files
+//   - error: An error if the local directory can't be created
+func localStorageFallback() (*storage.Client, error) {
+	dir, err := storage.FallbackDir()
+	if err != nil {
+		return nil, err
+	}
+	client, err := storage.NewLocalClient(dir)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "⚠️  Skate not found - saving game data locally in %s\n", dir)
+	fmt.Fprintln(os.Stderr, "   Set backend = \"local\" under [storage] to silence this, or install Skate to sync.")
+	return client, nil
+}
+
 // showSkateInstallInstructions displays helpful instructions for installing Skate.
 func showSkateInstallInstructions() {
 	errorStyle := lipgloss.NewStyle().
@@ -289,3 +598,90 @@ func promptForCharacterCreation(cfg *config.Config) *game.Character {
 
 	return character
 }
+
+// detectQuickRepo finds the repository around the working directory.
+//
+// Returns:
+//   - watcher.DetectedRepository: The repository and its git user
+//   - error: An error if the working directory isn't in a repository
+func detectQuickRepo() (watcher.DetectedRepository, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return watcher.DetectedRepository{}, fmt.Errorf("failed to get working directory: %w", err)
+	}
+	return watcher.DetectRepository(cwd)
+}
+
+// withWatchPath returns a copy of the config that also watches a
+// repository, so quick mode's repository is watched this session without
+// ending up in the config file when settings are saved.
+//
+// Parameters:
+//   - cfg: Loaded configuration (not modified)
+//   - repoPath: Repository to watch
+//
+// Returns:
+//   - *config.Config: The config to start the watcher with
+func withWatchPath(cfg *config.Config, repoPath string) *config.Config {
+	if watched, err := config.ExpandPaths(cfg.Git.WatchPaths); err == nil && slices.Contains(watched, repoPath) {
+		return cfg
+	}
+	watcherConfig := *cfg
+	watcherConfig.Git.WatchPaths = append(slices.Clone(cfg.Git.WatchPaths), repoPath)
+	return &watcherConfig
+}
+
+// quickCharacter creates a character without prompting, named after the
+// git user (then the configured name, then the usual default).
+//
+// Parameters:
+//   - cfg: Loaded configuration
+//   - repo: The repository quick mode watches
+//
+// Returns:
+//   - *game.Character: The new character
+func quickCharacter(cfg *config.Config, repo watcher.DetectedRepository) *game.Character {
+	name := repo.UserName
+	if name == "" {
+		name = cfg.Character.Name
+	}
+	if name == "" {
+		name = "CodeWarrior"
+	}
+	character := game.NewCharacter(name)
+	fmt.Printf("✓ Character '%s' created, watching %s\n", character.Name, repo.Root)
+	return character
+}
+
+// promptForEnergyCheckIn asks the player how energetic they feel today.
+// An empty or invalid answer skips the check-in; it is entirely optional.
+//
+// Parameters:
+//   - character: The character to record the check-in on
+func promptForEnergyCheckIn(character *game.Character) {
+	promptStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117"))
+
+	fmt.Print(promptStyle.Render(fmt.Sprintf("⚡ How's your energy today? (%d-%d, Enter to skip): ",
+		game.MinEnergyRating, game.MaxEnergyRating)))
+
+	var input string
+	fmt.Scanln(&input)
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return
+	}
+
+	rating, err := strconv.Atoi(input)
+	if err != nil {
+		fmt.Println("Skipping check-in (not a number)")
+		return
+	}
+
+	if err := character.RecordEnergy(rating); err != nil {
+		fmt.Printf("Skipping check-in: %v\n", err)
+		return
+	}
+
+	fmt.Printf("✓ Energy %d/%d recorded\n", rating, game.MaxEnergyRating)
+}
@@ -0,0 +1,226 @@
+package ai
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Answer cache tuning
+const (
+	// minAnswerSimilarity is the cosine similarity a question must reach to
+	// reuse a cached answer (1.0 = same words)
+	minAnswerSimilarity = 0.8
+
+	// maxCachedAnswers caps the FAQ so it stays small enough to persist
+	maxCachedAnswers = 200
+)
+
+// stopWords are ignored when comparing questions, so "how do I ..." and
+// "how can I ..." match on the words that matter.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "i": true, "my": true, "me": true,
+	"do": true, "does": true, "can": true, "could": true, "should": true,
+	"is": true, "are": true, "to": true, "in": true, "of": true, "for": true,
+	"on": true, "with": true, "and": true, "or": true, "it": true, "this": true,
+	"that": true, "please": true, "you": true, "what": true, "how": true,
+}
+
+// AnswerStore persists cached answers between runs.
+// Every storage backend implements this interface.
+type AnswerStore interface {
+	LoadAIAnswers() ([]CachedAnswer, error)
+	SaveAIAnswers(answers []CachedAnswer) error
+}
+
+// CachedAnswer is a previously answered question kept for the offline FAQ.
+type CachedAnswer struct {
+	Key       string    `json:"key"`        // Hash of the normalized question + persona
+	Persona   string    `json:"persona"`    // Hash of the system prompt (answers don't cross personas)
+	Question  string    `json:"question"`   // Original question text
+	Answer    string    `json:"answer"`     // The AI's answer
+	Provider  string    `json:"provider"`   // Provider that answered
+	CreatedAt time.Time `json:"created_at"` // When the answer was generated
+	Hits      int       `json:"hits"`       // Times the answer was reused
+}
+
+// AnswerCache is a persistent FAQ of AI answers. Unlike ResponseCache it
+// never expires entries and also matches similar (not just identical)
+// questions, so repeat questions are answered instantly and offline.
+type AnswerCache struct {
+	answers []CachedAnswer
+	store   AnswerStore // nil = in-memory only
+	mu      sync.Mutex
+}
+
+// NewAnswerCache creates an empty, in-memory answer cache.
+func NewAnswerCache() *AnswerCache {
+	return &AnswerCache{}
+}
+
+// SetStore loads saved answers from the store and persists future ones to it.
+//
+// Parameters:
+//   - store: Persistence for cached answers
+//
+// Returns:
+//   - error: Failure loading saved answers (the cache stays usable)
+func (c *AnswerCache) SetStore(store AnswerStore) error {
+	answers, err := store.LoadAIAnswers()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store = store
+	if err != nil {
+		return err
+	}
+	c.answers = answers
+	return nil
+}
+
+// Find returns the cached answer closest to the request's question, if it is
+// similar enough. An exact match on the normalized question always wins.
+//
+// Parameters:
+//   - req: The request about to be sent
+//
+// Returns:
+//   - CachedAnswer: The best match
+//   - float64: Similarity between the questions (0-1)
+//   - bool: True if a match was found
+func (c *AnswerCache) Find(req *Request) (CachedAnswer, float64, bool) {
+	// Answers about attached code only apply to that code
+	if req.Context != "" {
+		return CachedAnswer{}, 0, false
+	}
+
+	normalized := NormalizeQuestion(req.Prompt)
+	key := answerKey(normalized, req)
+	persona := hashString(req.SystemPrompt)
+	tokens := questionTokens(normalized)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	best, bestScore := -1, 0.0
+	for i, answer := range c.answers {
+		if answer.Key == key {
+			best, bestScore = i, 1.0
+			break
+		}
+		if answer.Persona != persona {
+			continue
+		}
+		if score := cosineSimilarity(tokens, questionTokens(NormalizeQuestion(answer.Question))); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+
+	if best < 0 || bestScore < minAnswerSimilarity {
+		return CachedAnswer{}, bestScore, false
+	}
+
+	c.answers[best].Hits++
+	return c.answers[best], bestScore, true
+}
+
+// Remember stores (or replaces) the answer to a question and persists the FAQ.
+// The least recently created answers are dropped beyond maxCachedAnswers.
+//
+// Parameters:
+//   - req: The request that was answered
+//   - resp: The provider's response
+func (c *AnswerCache) Remember(req *Request, resp *Response) {
+	normalized := NormalizeQuestion(req.Prompt)
+	if normalized == "" || req.Context != "" || resp.Content == "" {
+		return
+	}
+	answer := CachedAnswer{
+		Key:       answerKey(normalized, req),
+		Persona:   hashString(req.SystemPrompt),
+		Question:  req.Prompt,
+		Answer:    resp.Content,
+		Provider:  resp.Provider,
+		CreatedAt: time.Now(),
+	}
+
+	c.mu.Lock()
+	kept := make([]CachedAnswer, 0, len(c.answers)+1)
+	for _, existing := range c.answers {
+		if existing.Key != answer.Key {
+			kept = append(kept, existing)
+		}
+	}
+	kept = append(kept, answer)
+	if len(kept) > maxCachedAnswers {
+		kept = kept[len(kept)-maxCachedAnswers:]
+	}
+	c.answers = kept
+
+	store := c.store
+	snapshot := append([]CachedAnswer(nil), kept...)
+	c.mu.Unlock()
+
+	if store != nil {
+		// Best effort: a failed save only loses the answer for the next run
+		_ = store.SaveAIAnswers(snapshot)
+	}
+}
+
+// NormalizeQuestion lowercases a question and reduces it to its words, so
+// punctuation, spacing and case don't stop a repeat question from matching.
+//
+// Parameters:
+//   - question: The question as typed
+//
+// Returns:
+//   - string: Space-separated lowercase words
+func NormalizeQuestion(question string) string {
+	words := strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// questionTokens counts the meaningful words of a normalized question.
+func questionTokens(normalized string) map[string]int {
+	tokens := make(map[string]int)
+	for _, word := range strings.Fields(normalized) {
+		if !stopWords[word] {
+			tokens[word]++
+		}
+	}
+	return tokens
+}
+
+// cosineSimilarity compares two word-count vectors (0 = nothing shared, 1 = same).
+func cosineSimilarity(a, b map[string]int) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for word, count := range a {
+		dot += float64(count * b[word])
+		normA += float64(count * count)
+	}
+	for _, count := range b {
+		normB += float64(count * count)
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// answerKey identifies a normalized question asked of a persona.
+func answerKey(normalized string, req *Request) string {
+	return hashString(normalized + "\x00" + req.SystemPrompt)
+}
+
+// hashString returns the hex SHA-256 of s.
+func hashString(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,113 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// fakeProvider answers with a fixed reply, or fails when offline.
+type fakeProvider struct {
+	reply   string
+	offline bool
+	calls   int
+}
+
+func (p *fakeProvider) Ask(ctx context.Context, req *Request) (*Response, error) {
+	p.calls++
+	if p.offline {
+		return nil, errors.New("network unreachable")
+	}
+	return &Response{Content: p.reply}, nil
+}
+
+func (p *fakeProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *fakeProvider) GetName() string                      { return "Fake" }
+func (p *fakeProvider) GetPriority() int                     { return 1 }
+func (p *fakeProvider) GetRateLimiter() *RateLimiter         { return nil }
+
+// fakeAnswerStore keeps saved answers in memory.
+type fakeAnswerStore struct {
+	saved []CachedAnswer
+}
+
+func (s *fakeAnswerStore) LoadAIAnswers() ([]CachedAnswer, error) { return s.saved, nil }
+func (s *fakeAnswerStore) SaveAIAnswers(answers []CachedAnswer) error {
+	s.saved = answers
+	return nil
+}
+
+// TestAnswerCacheFind tests matching repeat and similar questions.
+func TestAnswerCacheFind(t *testing.T) {
+	cache := NewAnswerCache()
+	cache.Remember(&Request{Prompt: "How do I reverse a slice in Go?"}, &Response{Content: "Use slices.Reverse", Provider: "Fake"})
+
+	tests := []struct {
+		name   string
+		req    *Request
+		wantOK bool
+	}{
+		{name: "same question", req: &Request{Prompt: "How do I reverse a slice in Go?"}, wantOK: true},
+		{name: "different case and punctuation", req: &Request{Prompt: "how do i REVERSE a slice in go"}, wantOK: true},
+		{name: "similar wording", req: &Request{Prompt: "How can I reverse a Go slice?"}, wantOK: true},
+		{name: "different question", req: &Request{Prompt: "How do I sort a map in Go?"}, wantOK: false},
+		{name: "different persona", req: &Request{Prompt: "How do I reverse a slice in Go?", SystemPrompt: "You are a pirate."}, wantOK: false},
+		{name: "question about attached code", req: &Request{Prompt: "How do I reverse a slice in Go?", Context: "func main() {}"}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, similarity, ok := cache.Find(tt.req)
+			if ok != tt.wantOK {
+				t.Fatalf("Find() ok = %v (similarity %.2f), want %v", ok, similarity, tt.wantOK)
+			}
+			if ok && answer.Answer != "Use slices.Reverse" {
+				t.Errorf("Find() answer = %q", answer.Answer)
+			}
+		})
+	}
+}
+
+// TestAIManagerAnswerCache tests that answers are persisted, served offline
+// and skipped when regenerating.
+func TestAIManagerAnswerCache(t *testing.T) {
+	store := &fakeAnswerStore{}
+	provider := &fakeProvider{reply: "Use slices.Reverse"}
+
+	manager := NewAIManager(config.DefaultConfig())
+	manager.RegisterProvider(provider)
+	if err := manager.SetAnswerStore(store); err != nil {
+		t.Fatalf("SetAnswerStore() error = %v", err)
+	}
+
+	if _, err := manager.Ask(context.Background(), &Request{Prompt: "How do I reverse a slice?"}); err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+	if len(store.saved) != 1 {
+		t.Fatalf("saved answers = %d, want 1", len(store.saved))
+	}
+
+	// A new session loads the FAQ and answers a similar question offline
+	offline := NewAIManager(config.DefaultConfig())
+	offline.RegisterProvider(&fakeProvider{offline: true})
+	if err := offline.SetAnswerStore(store); err != nil {
+		t.Fatalf("SetAnswerStore() error = %v", err)
+	}
+	resp, err := offline.Ask(context.Background(), &Request{Prompt: "how can I reverse a slice"})
+	if err != nil || !resp.Cached || resp.Content != "Use slices.Reverse" {
+		t.Fatalf("offline Ask() = %+v, %v; want the cached answer", resp, err)
+	}
+
+	// Regenerating goes to the provider even though an answer is cached
+	provider.reply = "Use slices.Reverse (Go 1.21+)"
+	req := &Request{Prompt: "How do I reverse a slice?", Metadata: map[string]string{"regenerate": "true"}}
+	resp, err = manager.Ask(context.Background(), req)
+	if err != nil || resp.Cached || provider.calls != 2 {
+		t.Fatalf("regenerate Ask() = %+v, %v (calls %d); want a fresh answer", resp, err, provider.calls)
+	}
+	if store.saved[0].Answer != "Use slices.Reverse (Go 1.21+)" {
+		t.Errorf("saved answer = %q, want the regenerated one", store.saved[0].Answer)
+	}
+}
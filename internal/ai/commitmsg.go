@@ -0,0 +1,102 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// MaxCommitDiffBytes bounds the staged diff sent with a commit message
+// request; bigger diffs list the remaining files without their lines.
+const MaxCommitDiffBytes = 12000
+
+// commitMessageSystemPrompt tells the mentor how to write commit messages.
+const commitMessageSystemPrompt = `You write git commit messages in the Conventional Commits format.
+Reply with the commit message only: no explanations, quotes or code fences.
+The first line is "type(scope): description" where type is one of feat, fix, docs, style, refactor, perf, test, build, ci, chore or revert; the scope is optional.
+Keep the first line under 72 characters, in the imperative mood, without a trailing period.
+Add a body after a blank line only when the change needs explaining, wrapped at 72 characters.`
+
+// ErrEmptyDiff is returned when there is nothing staged to describe.
+var ErrEmptyDiff = errors.New("nothing staged")
+
+// NewCommitMessageRequest builds a request asking for a conventional
+// commit message describing a staged diff. Answers are neither cached nor
+// looked up in the FAQ: every diff needs its own message.
+//
+// Parameters:
+//   - diff: The staged changes (see watcher.StagedChanges.Diff)
+//
+// Returns:
+//   - *Request: The request, ready for AIManager.Ask
+func NewCommitMessageRequest(diff string) *Request {
+	return &Request{
+		Prompt:       "Write the commit message for these staged changes.",
+		Context:      diff,
+		SystemPrompt: commitMessageSystemPrompt,
+		Temperature:  0.2,
+		Complexity:   "simple",
+		Metadata:     map[string]string{"no_cache": "true", "regenerate": "true"},
+	}
+}
+
+// SuggestCommitMessage asks the mentor for a commit message for a staged
+// diff.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout
+//   - manager: AI manager with providers registered
+//   - diff: The staged changes
+//
+// Returns:
+//   - string: The suggested message, cleaned up (see CleanCommitMessage)
+//   - error: ErrEmptyDiff, or an error if no provider answered
+func SuggestCommitMessage(ctx context.Context, manager *AIManager, diff string) (string, error) {
+	if strings.TrimSpace(diff) == "" {
+		return "", ErrEmptyDiff
+	}
+	resp, err := manager.Ask(ctx, NewCommitMessageRequest(diff))
+	if err != nil {
+		return "", err
+	}
+	message := CleanCommitMessage(resp.Content)
+	if message == "" {
+		return "", errors.New("the mentor's answer didn't contain a commit message")
+	}
+	return message, nil
+}
+
+// CleanCommitMessage strips what models tend to wrap commit messages in:
+// code fences, surrounding quotes, a "Commit message:" label and trailing
+// whitespace.
+//
+// Parameters:
+//   - content: The mentor's answer
+//
+// Returns:
+//   - string: The bare commit message
+func CleanCommitMessage(content string) string {
+	message := strings.TrimSpace(content)
+	if strings.HasPrefix(message, "```") {
+		message = strings.TrimPrefix(message, "```")
+		_, message, _ = strings.Cut(message, "\n") // Drop the fence's language
+		message, _, _ = strings.Cut(message, "```")
+		message = strings.TrimSpace(message)
+	}
+	for _, label := range []string{"commit message:", "message:"} {
+		if len(message) >= len(label) && strings.EqualFold(message[:len(label)], label) {
+			message = strings.TrimSpace(message[len(label):])
+		}
+	}
+	for _, quote := range []string{`"`, "'", "`"} {
+		if len(message) >= 2 && strings.HasPrefix(message, quote) && strings.HasSuffix(message, quote) {
+			message = strings.TrimSpace(message[1 : len(message)-1])
+		}
+	}
+
+	lines := strings.Split(message, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.Join(lines, "\n")
+}
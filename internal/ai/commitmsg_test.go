@@ -0,0 +1,61 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestCleanCommitMessage tests stripping what models wrap messages in.
+func TestCleanCommitMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "bare", content: "fix(auth): handle expired tokens\n", want: "fix(auth): handle expired tokens"},
+		{name: "code fence", content: "```text\nfeat: add export\n\nExports quests as CSV.\n```", want: "feat: add export\n\nExports quests as CSV."},
+		{name: "label and quotes", content: "Commit message: \"docs: fix typo\"", want: "docs: fix typo"},
+		{name: "trailing spaces", content: "chore: bump deps  \n\nBody line \t", want: "chore: bump deps\n\nBody line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanCommitMessage(tt.content); got != tt.want {
+				t.Errorf("CleanCommitMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSuggestCommitMessage tests asking for a message, and that suggestions
+// are never cached: the same diff asks again.
+func TestSuggestCommitMessage(t *testing.T) {
+	provider := &fakeProvider{reply: "`fix: guard nil quest`"}
+	manager := NewAIManager(config.DefaultConfig())
+	manager.RegisterProvider(provider)
+
+	if _, err := SuggestCommitMessage(context.Background(), manager, " \n"); !errors.Is(err, ErrEmptyDiff) {
+		t.Errorf("SuggestCommitMessage() with nothing staged error = %v, want ErrEmptyDiff", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		message, err := SuggestCommitMessage(context.Background(), manager, "--- quest.go (+1 -0)\n+if q == nil { return }\n")
+		if err != nil {
+			t.Fatalf("SuggestCommitMessage() error = %v", err)
+		}
+		if message != "fix: guard nil quest" {
+			t.Errorf("SuggestCommitMessage() = %q", message)
+		}
+	}
+	if provider.calls != 2 {
+		t.Errorf("provider calls = %d, want 2 (suggestions aren't cached)", provider.calls)
+	}
+
+	provider.offline = true
+	if _, err := SuggestCommitMessage(context.Background(), manager, "--- quest.go (+1 -0)\n"); err == nil {
+		t.Error("SuggestCommitMessage() offline error = nil, want an error")
+	}
+}
@@ -101,6 +101,9 @@ func (c *CrushProvider) Ask(ctx context.Context, req *Request) (*Response, error
 
 	// Build system message
 	systemMsg := "You are Crush, a helpful AI mentor for CodeQuest. Provide clear, concise answers to help developers with their coding questions."
+	if req.SystemPrompt != "" {
+		systemMsg = req.SystemPrompt
+	}
 
 	// Combine prompt and context
 	userContent := req.Prompt
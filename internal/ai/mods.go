@@ -61,6 +61,9 @@ func (m *ModsProvider) Ask(ctx context.Context, req *Request) (*Response, error)
 	if req.Context != "" {
 		prompt = fmt.Sprintf("Context:\n%s\n\nQuestion: %s", req.Context, req.Prompt)
 	}
+	if req.SystemPrompt != "" {
+		prompt = req.SystemPrompt + "\n\n" + prompt
+	}
 
 	// Build command arguments
 	args := []string{
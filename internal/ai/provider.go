@@ -54,6 +54,9 @@ type Request struct {
 	// Context provides optional context like code snippets, error messages, etc.
 	Context string
 
+	// SystemPrompt overrides the provider's default instructions (e.g. a mentor persona)
+	SystemPrompt string
+
 	// MaxTokens is the maximum response length (0 = provider default)
 	MaxTokens int
 
@@ -104,6 +107,9 @@ type AIManager struct {
 	// cache is the optional response cache
 	cache *ResponseCache
 
+	// answers is the persistent FAQ of past answers (similar-question matches)
+	answers *AnswerCache
+
 	// mu protects the providers slice for thread-safe operations
 	mu sync.RWMutex
 
@@ -129,6 +135,7 @@ func NewAIManager(cfg *config.Config) *AIManager {
 		providers: make([]AIProvider, 0),
 		config:    cfg,
 		cache:     NewResponseCache(15 * time.Minute), // 15-minute cache TTL
+		answers:   NewAnswerCache(),
 		stats:     make(map[string]*ProviderStats),
 	}
 }
@@ -165,8 +172,37 @@ func (m *AIManager) sortProviders() {
 	}
 }
 
+// SetAnswerStore persists the answer FAQ and loads previously saved answers.
+//
+// Parameters:
+//   - store: Persistence for cached answers
+//
+// Returns:
+//   - error: Failure loading saved answers (caching still works in memory)
+func (m *AIManager) SetAnswerStore(store AnswerStore) error {
+	return m.answers.SetStore(store)
+}
+
+// FindCachedAnswer looks for a previous answer to the same or a similar
+// question, so it can be offered instantly instead of asking a provider.
+//
+// Parameters:
+//   - req: The request about to be sent
+//
+// Returns:
+//   - CachedAnswer: The closest previous answer
+//   - float64: How similar the questions are (0-1)
+//   - bool: True if a close enough answer exists
+func (m *AIManager) FindCachedAnswer(req *Request) (CachedAnswer, float64, bool) {
+	return m.answers.Find(req)
+}
+
 // Ask sends a request through the fallback chain until a provider succeeds.
 // It tries each provider in priority order, checking availability and rate limits.
+//
+// Successful answers are added to the answer FAQ. If every provider fails
+// (e.g. offline), a cached answer to a similar question is returned instead.
+// Set Metadata["regenerate"] = "true" to skip cached responses and ask anew.
 func (m *AIManager) Ask(ctx context.Context, req *Request) (*Response, error) {
 	// Validate request
 	if err := m.validateRequest(req); err != nil {
@@ -174,7 +210,7 @@ func (m *AIManager) Ask(ctx context.Context, req *Request) (*Response, error) {
 	}
 
 	// Check cache first
-	if cacheKey := m.getCacheKey(req); cacheKey != "" {
+	if cacheKey := m.getCacheKey(req); cacheKey != "" && req.Metadata["regenerate"] != "true" {
 		if cached, found := m.cache.Get(cacheKey); found {
 			cached.Cached = true
 			return cached, nil
@@ -223,12 +259,23 @@ func (m *AIManager) Ask(ctx context.Context, req *Request) (*Response, error) {
 		// Cache the response
 		if cacheKey := m.getCacheKey(req); cacheKey != "" {
 			m.cache.Set(cacheKey, resp)
+			m.answers.Remember(req, resp)
 		}
 
 		return resp, nil
 	}
 
-	// All providers failed
+	// All providers failed - fall back to the FAQ for repeat questions
+	if req.Metadata["regenerate"] != "true" {
+		if answer, _, found := m.answers.Find(req); found {
+			return &Response{
+				Content:  answer.Answer,
+				Provider: answer.Provider,
+				Cached:   true,
+			}, nil
+		}
+	}
+
 	if lastErr != nil {
 		return nil, fmt.Errorf("%w: %v", ErrNoProvidersAvailable, lastErr)
 	}
@@ -366,6 +413,7 @@ func (m *AIManager) getCacheKey(req *Request) string {
 	hasher := sha256.New()
 	hasher.Write([]byte(req.Prompt))
 	hasher.Write([]byte(req.Context))
+	hasher.Write([]byte(req.SystemPrompt))
 	hasher.Write([]byte(req.Complexity))
 	return hex.EncodeToString(hasher.Sum(nil))
 }
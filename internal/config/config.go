@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
 
 // Config represents the complete application configuration.
-// It is loaded from ~/.config/codequest/config.toml and validated on startup.
+// It is loaded from ConfigPath() (~/.config/codequest/config.toml, or
+// %AppData%\codequest\config.toml on Windows) and validated on startup.
 type Config struct {
 	Character CharacterConfig `toml:"character"`
 	Game      GameConfig      `toml:"game"`
@@ -20,6 +24,33 @@ type Config struct {
 	Github    GithubConfig    `toml:"github"`
 	Keybinds  KeybindsConfig  `toml:"keybinds"`
 	Debug     DebugConfig     `toml:"debug"`
+	Retention RetentionConfig `toml:"retention"`
+	Anomaly   AnomalyConfig   `toml:"anomaly"`
+	EventLog  EventLogConfig  `toml:"event_log"`
+	Todos     TodoScanConfig  `toml:"todos"`
+	TaskList  TaskListConfig  `toml:"task_list"`
+	WIP       WIPConfig       `toml:"wip"`
+	Catalog   CatalogConfig   `toml:"quest_catalog"`
+	Publish   PublishConfig   `toml:"publish"`
+	Digest    DigestConfig    `toml:"digest"`
+	Habits    HabitsConfig    `toml:"habits"`
+	Discord   DiscordConfig   `toml:"discord"`
+	Limits    LimitsConfig    `toml:"limits"`
+	Power     PowerConfig     `toml:"power"`
+	Decay     DecayConfig     `toml:"decay"`
+	Storage   StorageConfig   `toml:"storage"`
+	Projects  []ProjectConfig `toml:"projects"`
+	XPRules   []XPRuleConfig  `toml:"xp_rules"` // custom commit XP rules, applied in order
+
+	// Experimental feature flags by ID (see Features); unset means off
+	Experimental map[string]bool `toml:"experimental"`
+}
+
+// ProjectConfig groups repositories into a named project (e.g. work, oss)
+// so stats and quests can be rolled up and filtered per project.
+type ProjectConfig struct {
+	Name  string   `toml:"name"`
+	Repos []string `toml:"repos"` // repository paths, or directories containing repositories
 }
 
 // CharacterConfig contains character-specific settings.
@@ -29,17 +60,51 @@ type CharacterConfig struct {
 
 // GameConfig contains game mechanics settings.
 type GameConfig struct {
-	AutoStartQuests bool   `toml:"auto_start_quests"`
-	ShowTips        bool   `toml:"show_tips"`
-	Difficulty      string `toml:"difficulty"` // easy, normal, hard
+	AutoStartQuests   bool    `toml:"auto_start_quests"`
+	ShowTips          bool    `toml:"show_tips"`
+	Difficulty        string  `toml:"difficulty"`           // easy, normal, hard
+	DailyXPCap        int     `toml:"daily_xp_cap"`         // max commit XP per day (0 = unlimited)
+	FullRateCommits   int     `toml:"full_rate_commits"`    // commits per day at full XP before diminishing returns (0 = never)
+	RestBonusRate     float64 `toml:"rest_bonus_rate"`      // fraction of unspent daily budget carried over as rested XP (0-1)
+	Timezone          string  `toml:"timezone"`             // home timezone for streaks, e.g. "Europe/Berlin" ("auto" = system timezone)
+	StreakGraceHours  int     `toml:"streak_grace_hours"`   // commits this many hours after midnight count toward the previous day (0-12)
+	RewardChoiceMinXP int     `toml:"reward_choice_min_xp"` // quests worth at least this much XP let you pick a reward (0 = always fixed XP)
 }
 
 // UIConfig contains user interface preferences.
 type UIConfig struct {
-	Theme            string `toml:"theme"` // dark, light, auto
-	ShowAnimations   bool   `toml:"show_animations"`
-	CompactMode      bool   `toml:"compact_mode"`
-	ShowKeybindHints bool   `toml:"show_keybind_hints"`
+	Theme            string   `toml:"theme"`   // dark, light, auto
+	Palette          string   `toml:"palette"` // default, deuteranopia, protanopia (color-blind safe status colors)
+	Images           string   `toml:"images"`  // auto, off, kitty, iterm2, sixel (inline avatar and badge images)
+	ShowAnimations   bool     `toml:"show_animations"`
+	ReducedMotion    bool     `toml:"reduced_motion"` // disables all transitions (accessibility, slow terminals)
+	CompactMode      bool     `toml:"compact_mode"`
+	ShowKeybindHints bool     `toml:"show_keybind_hints"`
+	LevelUpFanfare   bool     `toml:"level_up_fanfare"`  // full-screen celebration on level-up (false = a toast)
+	DashboardWidgets []string `toml:"dashboard_widgets"` // enabled dashboard widgets, in display order (unset = all)
+}
+
+// ColorPalettes lists the status color palettes (see screens.Palettes).
+var ColorPalettes = []string{"default", "deuteranopia", "protanopia"}
+
+// ImageModes lists the inline image settings: detect, never, or force a
+// protocol (see termimage.Resolve).
+var ImageModes = []string{"auto", "off", "kitty", "iterm2", "sixel"}
+
+// DashboardWidgetIDs lists every dashboard widget, in default display order.
+var DashboardWidgetIDs = []string{"character", "active_quest", "today", "streak_heatmap", "activity_feed", "tips", "pet", "repo_map"}
+
+// EnabledDashboardWidgets returns the dashboard widgets to show, in order.
+// A config written before widgets existed (no dashboard_widgets key) shows
+// all of them; an explicit empty list hides them all.
+//
+// Returns:
+//   - []string: Widget IDs in display order
+func (u UIConfig) EnabledDashboardWidgets() []string {
+	if u.DashboardWidgets == nil {
+		return append([]string(nil), DashboardWidgetIDs...)
+	}
+	return u.DashboardWidgets
 }
 
 // TrackingConfig contains activity tracking settings.
@@ -47,6 +112,12 @@ type TrackingConfig struct {
 	SessionTimerEnabled bool   `toml:"session_timer_enabled"`
 	SessionHotkey       string `toml:"session_hotkey"`
 	WakatimeEnabled     bool   `toml:"wakatime_enabled"`
+	EnergyCheckIn       bool   `toml:"energy_check_in"`        // prompt for a 1-5 energy rating at startup
+	BreakReminders      bool   `toml:"break_reminders"`        // remind to take a break during long sessions
+	BreakIntervalMins   int    `toml:"break_interval_minutes"` // active session minutes between break reminders (10-240)
+	BreakSnoozeMins     int    `toml:"break_snooze_minutes"`   // how long a snoozed reminder waits (1-60)
+	LateNightWarnings   bool   `toml:"late_night_warnings"`    // warn about habitual commits after bedtime and track the Well Rested achievement
+	BedtimeHour         int    `toml:"bedtime_hour"`           // hour (home timezone) commits start counting as late-night (18-23, or 0-4 after midnight)
 }
 
 // AIConfig contains all AI-related configuration.
@@ -63,6 +134,24 @@ type AIMentorConfig struct {
 	ModelComplexOffline string  `toml:"model_complex_offline"`
 	ModelSimpleOffline  string  `toml:"model_simple_offline"`
 	Temperature         float64 `toml:"temperature"`
+	RunTimeoutSecs      int     `toml:"run_timeout_secs"` // time limit for running Go snippets from answers (Ctrl+E, 0 = default)
+
+	Personas  []MentorPersona  `toml:"personas"`  // selectable mentor personalities (first is the default)
+	Templates []PromptTemplate `toml:"templates"` // quick prompts offered in the Mentor screen
+}
+
+// MentorPersona describes a mentor personality the user can pick per chat thread.
+type MentorPersona struct {
+	Name         string `toml:"name"`          // shown in the persona dropdown
+	SystemPrompt string `toml:"system_prompt"` // instructions sent to the AI with every question
+	Tone         string `toml:"tone"`          // e.g. "encouraging", "blunt" (optional)
+	Focus        string `toml:"focus"`         // preferred language/framework, e.g. "Go" (optional)
+}
+
+// PromptTemplate is a reusable quick prompt for the Mentor screen.
+type PromptTemplate struct {
+	Name   string `toml:"name"`   // shown in the template dropdown
+	Prompt string `toml:"prompt"` // text inserted into the input field
 }
 
 // AIReviewConfig contains AI code review (Mods) settings.
@@ -76,13 +165,156 @@ type AIReviewConfig struct {
 
 // GitConfig contains Git integration settings.
 type GitConfig struct {
-	AutoDetectRepos bool     `toml:"auto_detect_repos"`
-	WatchPaths      []string `toml:"watch_paths"`
+	AutoDetectRepos  bool     `toml:"auto_detect_repos"`
+	WatchPaths       []string `toml:"watch_paths"`
+	ReplayOnStartup  bool     `toml:"replay_on_startup"`  // award XP for commits made while CodeQuest wasn't running
+	ReplayWindowDays int      `toml:"replay_window_days"` // only replay commits from the last N days
+	ReplayXPRate     float64  `toml:"replay_xp_rate"`     // fraction of normal XP for replayed commits (0-1)
+	AuthorEmails     []string `toml:"author_emails"`      // only commits by these emails earn XP (empty = every author)
+	BotAuthors       []string `toml:"bot_authors"`        // author name/email patterns of bot commits ("*" wildcard)
+	BotMessages      []string `toml:"bot_messages"`       // commit subject patterns of bot commits ("*" wildcard)
+	CountBotCommits  bool     `toml:"count_bot_commits"`  // count bot commits at bot_xp_rate instead of skipping them
+	BotXPRate        float64  `toml:"bot_xp_rate"`        // fraction of normal XP for counted bot commits (0-1)
+	OwnAccounts      []string `toml:"own_accounts"`       // GitHub users/orgs whose repos are yours; commits elsewhere are open source contributions (empty = off)
+
+	WatchMode  string            `toml:"watch_mode"`  // auto, notify, poll: how new commits are noticed
+	WatchModes map[string]string `toml:"watch_modes"` // watch_mode per repository or parent directory (~ allowed)
+}
+
+// WatchModes lists how the watcher can notice new commits: filesystem
+// notifications, falling back to polling when they're unavailable (auto),
+// notifications only, or polling only (for NFS and other network mounts,
+// where notifications don't see other machines' changes).
+var WatchModes = []string{"auto", "notify", "poll"}
+
+// WatchModeFor returns the watch mode for a repository: the watch_modes
+// entry for it or the closest directory containing it, else watch_mode.
+//
+// Parameters:
+//   - repoPath: Absolute repository path
+//
+// Returns:
+//   - string: "auto", "notify" or "poll"
+func (g GitConfig) WatchModeFor(repoPath string) string {
+	repoPath = NormalizePath(repoPath)
+	mode, longest := g.WatchMode, -1
+	for path, pathMode := range g.WatchModes {
+		expanded, err := ExpandPath(path)
+		if err != nil {
+			continue
+		}
+		if dir := NormalizePath(expanded); pathWithin(repoPath, dir) && len(dir) > longest {
+			mode, longest = pathMode, len(dir)
+		}
+	}
+	if mode == "" {
+		return "auto"
+	}
+	return mode
+}
+
+// IsOwnAuthor reports whether a commit author email belongs to the player.
+// Emails are compared case-insensitively. With no author emails configured,
+// every commit counts.
+//
+// Parameters:
+//   - email: Commit author email
+//
+// Returns:
+//   - bool: True if commits by this author should earn XP
+func (g GitConfig) IsOwnAuthor(email string) bool {
+	if len(g.AuthorEmails) == 0 {
+		return true
+	}
+	email = strings.TrimSpace(email)
+	for _, own := range g.AuthorEmails {
+		if strings.EqualFold(strings.TrimSpace(own), email) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwnAccount reports whether a GitHub user or organization is one of the
+// player's own accounts. Names are compared case-insensitively. With no
+// accounts configured nothing matches, since open source contributions
+// can't be told apart from the player's own repositories.
+//
+// Parameters:
+//   - owner: Repository owner from the origin remote
+//
+// Returns:
+//   - bool: True if repositories owned by this account are the player's
+func (g GitConfig) IsOwnAccount(owner string) bool {
+	owner = strings.TrimSpace(owner)
+	for _, own := range g.OwnAccounts {
+		if strings.EqualFold(strings.TrimSpace(own), owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBotCommit reports whether a commit was made by a bot (dependency
+// updaters, CI auto-formatters): its author name or email matches one of
+// BotAuthors, or its subject line matches one of BotMessages. Patterns are
+// case-insensitive and "*" matches any run of characters; every other
+// character, brackets included, is literal.
+//
+// Parameters:
+//   - author: Commit author name
+//   - email: Commit author email
+//   - message: Full commit message
+//
+// Returns:
+//   - bool: True if the commit looks automated
+func (g GitConfig) IsBotCommit(author, email, message string) bool {
+	for _, pattern := range g.BotAuthors {
+		if matchWildcard(pattern, author) || matchWildcard(pattern, email) {
+			return true
+		}
+	}
+	subject, _, _ := strings.Cut(message, "\n")
+	for _, pattern := range g.BotMessages {
+		if matchWildcard(pattern, subject) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWildcard reports whether s matches a case-insensitive pattern in
+// which "*" matches any run of characters. Blank patterns match nothing.
+func matchWildcard(pattern, s string) bool {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	s = strings.ToLower(strings.TrimSpace(s))
+	if pattern == "" {
+		return false
+	}
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return s == pattern
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
 }
 
 // GithubConfig contains GitHub integration settings.
 type GithubConfig struct {
-	Enabled bool `toml:"enabled"`
+	Enabled           bool `toml:"enabled"`
+	SplitSquashMerges bool `toml:"split_squash_merges"` // score squash merges as their constituent commits
 }
 
 // KeybindsConfig contains keyboard shortcut mappings.
@@ -97,16 +329,496 @@ type DebugConfig struct {
 	Enabled  bool   `toml:"enabled"`
 	LogLevel string `toml:"log_level"` // debug, info, warn, error
 	LogFile  string `toml:"log_file"`  // empty means no file logging
+
+	// MetricsAddr serves watcher metrics at /metrics in the Prometheus text
+	// format while debug mode is on, e.g. "127.0.0.1:9464" (empty = off)
+	MetricsAddr string `toml:"metrics_addr"`
+}
+
+// StorageConfig chooses where game data is kept: Skate (Charm Cloud), JSON
+// files on this machine or your own server over SSH.
+type StorageConfig struct {
+	Backend string           `toml:"backend"` // skate, local or ssh ("" = skate)
+	SSH     SSHStorageConfig `toml:"ssh"`
+}
+
+// SSHStorageConfig keeps game data on your own server. The system ssh
+// command is used, so ~/.ssh/config, keys and the agent all apply.
+type SSHStorageConfig struct {
+	Host         string `toml:"host"`          // user@host, or a Host alias from ~/.ssh/config
+	Path         string `toml:"path"`          // remote directory for save files ("" = ~/.codequest)
+	Port         int    `toml:"port"`          // SSH port (0 = ssh's default)
+	IdentityFile string `toml:"identity_file"` // private key to use ("" = ssh's default)
+}
+
+// PublishConfig controls `codequest publish`, which renders a static
+// profile page and publishes it to a gh-pages branch or a GitHub gist.
+type PublishConfig struct {
+	Target  string               `toml:"target"`  // gh-pages or gist ("" = publishing not set up)
+	Repo    string               `toml:"repo"`    // local repository whose branch hosts the page (gh-pages)
+	Branch  string               `toml:"branch"`  // branch the page is committed to (gh-pages, "" = "gh-pages")
+	Remote  string               `toml:"remote"`  // remote the branch is pushed to (gh-pages, "" = "origin")
+	GistID  string               `toml:"gist_id"` // gist to update (gist, "" = create one and print its ID)
+	Privacy PublishPrivacyConfig `toml:"privacy"`
+}
+
+// PublishPrivacyConfig chooses which stats appear on the public profile.
+// Everything is hidden unless switched on.
+type PublishPrivacyConfig struct {
+	ShowName      bool `toml:"show_name"`      // character name (otherwise "Anonymous Adventurer")
+	ShowProfile   bool `toml:"show_profile"`   // avatar, pronouns, motto and favorite language
+	ShowLevel     bool `toml:"show_level"`     // level and XP
+	ShowStreak    bool `toml:"show_streak"`    // current and longest streak
+	ShowTotals    bool `toml:"show_totals"`    // lifetime commits, lines and quests
+	ShowBadges    bool `toml:"show_badges"`    // achievements and trophy items
+	ShowHeatmap   bool `toml:"show_heatmap"`   // commits per day over the last year
+	ShowLanguages bool `toml:"show_languages"` // top languages by changed files
+}
+
+// DigestConfig controls the optional weekly digest: the past week's stats,
+// achievements and upcoming challenges as an HTML email, sent over SMTP or
+// written as an .eml file when no SMTP server is set.
+type DigestConfig struct {
+	Enabled   bool             `toml:"enabled"`
+	Weekday   string           `toml:"weekday"`    // day the digest goes out, e.g. "sunday" ("" = monday)
+	To        string           `toml:"to"`         // recipient address (required for SMTP)
+	From      string           `toml:"from"`       // sender address ("" = the recipient)
+	OutputDir string           `toml:"output_dir"` // where .eml files go without SMTP ("" = digests/ in the data directory)
+	SMTP      DigestSMTPConfig `toml:"smtp"`
+}
+
+// DigestSMTPConfig is the mail server the digest is sent through. The
+// password is read from an environment variable, never from the config.
+type DigestSMTPConfig struct {
+	Host        string `toml:"host"`         // SMTP server ("" = write an .eml file instead)
+	Port        int    `toml:"port"`         // 0 = 587 (STARTTLS when offered)
+	Username    string `toml:"username"`     // "" = no authentication
+	PasswordEnv string `toml:"password_env"` // environment variable holding the password ("" = CODEQUEST_SMTP_PASSWORD)
+}
+
+// Digest defaults, used when a setting is unset.
+const (
+	DefaultDigestSMTPPort    = 587
+	DefaultDigestPasswordEnv = "CODEQUEST_SMTP_PASSWORD"
+)
+
+// WithDefaults returns the digest settings with unset values replaced by
+// their defaults.
+//
+// Returns:
+//   - DigestConfig: Settings with every value filled in
+func (d DigestConfig) WithDefaults() DigestConfig {
+	if d.Weekday == "" {
+		d.Weekday = "monday"
+	}
+	if d.From == "" {
+		d.From = d.To
+	}
+	if d.SMTP.Port == 0 {
+		d.SMTP.Port = DefaultDigestSMTPPort
+	}
+	if d.SMTP.PasswordEnv == "" {
+		d.SMTP.PasswordEnv = DefaultDigestPasswordEnv
+	}
+	return d
+}
+
+// SendDay returns the weekday the digest goes out.
+//
+// Returns:
+//   - time.Weekday: The configured day (Monday when unset)
+//   - bool: False if the weekday isn't a day name
+func (d DigestConfig) SendDay() (time.Weekday, bool) {
+	if d.Weekday == "" {
+		return time.Monday, true
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(d.Weekday, day.String()) {
+			return day, true
+		}
+	}
+	return time.Monday, false
+}
+
+// HabitsConfig pushes quest completions to habit trackers the player
+// already uses, so CodeQuest can feed an existing habit system instead of
+// competing with it. Completed daily quests (and any quest mapped to a
+// habit) go to the webhook; mapped quests score their habit in Habitica.
+type HabitsConfig struct {
+	Enabled    bool                 `toml:"enabled"`
+	WebhookURL string               `toml:"webhook_url"` // POSTed a JSON payload per completion ("" = off)
+	Habitica   HabitsHabiticaConfig `toml:"habitica"`
+
+	// Habit or task ID by quest ID or quest template ID
+	Quests map[string]string `toml:"quests"`
+}
+
+// HabitsHabiticaConfig is the Habitica account mapped quests score. The API
+// token is read from an environment variable, never from the config.
+type HabitsHabiticaConfig struct {
+	UserID   string `toml:"user_id"`   // Habitica user ID ("" = off)
+	TokenEnv string `toml:"token_env"` // environment variable holding the API token ("" = CODEQUEST_HABITICA_TOKEN)
+}
+
+// DefaultHabiticaTokenEnv holds the Habitica API token when token_env is unset.
+const DefaultHabiticaTokenEnv = "CODEQUEST_HABITICA_TOKEN"
+
+// HabitFor returns the habit a quest is mapped to, by quest ID first, then
+// by the template it was created from.
+//
+// Parameters:
+//   - questID: The quest's ID
+//   - template: The quest's template ID ("" if none)
+//
+// Returns:
+//   - string: The mapped habit ID ("" if the quest isn't mapped)
+func (h HabitsConfig) HabitFor(questID, template string) string {
+	if habit := h.Quests[questID]; habit != "" {
+		return habit
+	}
+	if template != "" {
+		return h.Quests[template]
+	}
+	return ""
+}
+
+// DiscordConfig shares quest completions and level-ups to a Discord channel
+// as rich embeds, through the channel's webhook.
+type DiscordConfig struct {
+	Enabled         bool     `toml:"enabled"`
+	WebhookURL      string   `toml:"webhook_url"`       // channel webhook (Server Settings → Integrations → Webhooks)
+	Events          []string `toml:"events"`            // events to share: quest_done, level_up (empty = both)
+	MinIntervalSecs int      `toml:"min_interval_secs"` // minimum seconds between posts (0 = 2, Discord's webhook limit)
+}
+
+// Discord events that can be shared, and the default spacing between posts.
+const (
+	DiscordEventQuestDone     = "quest_done"
+	DiscordEventLevelUp       = "level_up"
+	DefaultDiscordMinInterval = 2
+	discordMaxMinIntervalSecs = 3600
+)
+
+// DiscordEvents lists the events Discord sharing supports.
+var DiscordEvents = []string{DiscordEventQuestDone, DiscordEventLevelUp}
+
+// WithDefaults fills in unset Discord settings.
+//
+// Returns:
+//   - DiscordConfig: Settings with every value filled in
+func (d DiscordConfig) WithDefaults() DiscordConfig {
+	if len(d.Events) == 0 {
+		d.Events = slices.Clone(DiscordEvents)
+	}
+	if d.MinIntervalSecs == 0 {
+		d.MinIntervalSecs = DefaultDiscordMinInterval
+	}
+	return d
+}
+
+// Shares reports whether an event is shared to Discord.
+//
+// Parameters:
+//   - event: Event name (quest_done or level_up)
+//
+// Returns:
+//   - bool: True if the event is in the filter (or the filter is empty)
+func (d DiscordConfig) Shares(event string) bool {
+	return len(d.Events) == 0 || slices.Contains(d.Events, event)
+}
+
+// RetentionConfig controls how much history is kept. Older data is pruned
+// (or rolled up into daily totals) once at startup.
+type RetentionConfig struct {
+	RawEventDays        int `toml:"raw_event_days"`        // keep individual break records this many days, then roll them up per day (0 = 90)
+	RollupDays          int `toml:"rollup_days"`           // keep daily history (heatmap, break and energy logs) this many days (0 = 400)
+	ChatHistoryMessages int `toml:"chat_history_messages"` // mentor chat messages kept (0 = 200)
+}
+
+// Default retention limits, used when a retention setting is unset (0).
+const (
+	DefaultRawEventDays        = 90
+	DefaultRollupDays          = 400
+	DefaultChatHistoryMessages = 200
+)
+
+// WithDefaults returns the retention settings with unset (0) values
+// replaced by their defaults, so configs written before retention existed
+// keep sensible limits.
+//
+// Returns:
+//   - RetentionConfig: Settings with every limit filled in
+func (r RetentionConfig) WithDefaults() RetentionConfig {
+	if r.RawEventDays == 0 {
+		r.RawEventDays = DefaultRawEventDays
+	}
+	if r.RollupDays == 0 {
+		r.RollupDays = DefaultRollupDays
+	}
+	if r.ChatHistoryMessages == 0 {
+		r.ChatHistoryMessages = DefaultChatHistoryMessages
+	}
+	return r
+}
+
+// AnomalyConfig controls anomaly detection: commit XP that looks suspicious
+// (an absurd line count, a burst far above the usual daily pace, or a diff
+// identical to a recent one) is held for review instead of being awarded.
+type AnomalyConfig struct {
+	Enabled        bool    `toml:"enabled"`
+	MaxCommitLines int     `toml:"max_commit_lines"` // lines changed in one commit before it's flagged (0 = 5000)
+	BurstSigma     float64 `toml:"burst_sigma"`      // flag days this many standard deviations above the daily average (0 = 4)
+}
+
+// Default anomaly thresholds, used when a threshold is unset (0).
+const (
+	DefaultMaxCommitLines = 5000
+	DefaultBurstSigma     = 4.0
+)
+
+// WithDefaults returns the anomaly settings with unset (0) thresholds
+// replaced by their defaults.
+//
+// Returns:
+//   - AnomalyConfig: Settings with every threshold filled in
+func (a AnomalyConfig) WithDefaults() AnomalyConfig {
+	if a.MaxCommitLines == 0 {
+		a.MaxCommitLines = DefaultMaxCommitLines
+	}
+	if a.BurstSigma == 0 {
+		a.BurstSigma = DefaultBurstSigma
+	}
+	return a
+}
+
+// EventLogConfig controls the opt-in event log: every game event written as
+// one line of JSON, for piping into jq, SQLite or other tooling. The file is
+// rotated once it reaches the size limit. Analytics also indexes every event
+// in an embedded SQLite database for aggregate queries, in builds made with
+// -tags sqlite.
+type EventLogConfig struct {
+	Enabled       bool   `toml:"enabled"`
+	Path          string `toml:"path"`           // log file ("" = events.jsonl in the data directory)
+	MaxSizeMB     int    `toml:"max_size_mb"`    // rotate once the file reaches this size (0 = 10)
+	MaxFiles      int    `toml:"max_files"`      // rotated files kept besides the current one (0 = 5)
+	Analytics     bool   `toml:"analytics"`      // also index events in SQLite (needs a -tags sqlite build)
+	AnalyticsPath string `toml:"analytics_path"` // database file ("" = analytics.db in the data directory)
+}
+
+// Default event log limits, used when a limit is unset (0).
+const (
+	DefaultEventLogSizeMB = 10
+	DefaultEventLogFiles  = 5
+)
+
+// WithDefaults returns the event log settings with unset (0) limits
+// replaced by their defaults.
+//
+// Returns:
+//   - EventLogConfig: Settings with every limit filled in
+func (e EventLogConfig) WithDefaults() EventLogConfig {
+	if e.MaxSizeMB == 0 {
+		e.MaxSizeMB = DefaultEventLogSizeMB
+	}
+	if e.MaxFiles == 0 {
+		e.MaxFiles = DefaultEventLogFiles
+	}
+	return e
+}
+
+// TodoScanConfig controls quest suggestions from TODO/FIXME comments: the
+// tracked files of watched repositories are scanned periodically, and the
+// comments found can be turned into quests from the quest board.
+type TodoScanConfig struct {
+	Enabled             bool `toml:"enabled"`
+	ScanIntervalMinutes int  `toml:"scan_interval_minutes"` // time between scans (0 = 60)
+	MaxFiles            int  `toml:"max_files"`             // files read per repository and scan (0 = 2000)
+}
+
+// TaskListConfig syncs quests with a markdown task list in each watched
+// repository: every unchecked "- [ ] item" becomes a quest, ticking the item
+// completes its quest, and completing the quest ticks the item, in a commit
+// or as a patch to apply.
+type TaskListConfig struct {
+	Enabled             bool   `toml:"enabled"`
+	File                string `toml:"file"`                  // task list path relative to the repository root ("" = TODO.md)
+	Mode                string `toml:"mode"`                  // how a completed quest ticks its item: patch (write a patch to apply) or commit (commit the change)
+	SyncIntervalMinutes int    `toml:"sync_interval_minutes"` // time between reads of the task lists (0 = 5)
+}
+
+// WIPConfig controls work-in-progress detection: the working trees of
+// watched repositories are checked periodically, and uncommitted changes
+// left sitting for days bring a nudge and a quest to commit or stash them.
+type WIPConfig struct {
+	Enabled              bool `toml:"enabled"`
+	MinLines             int  `toml:"min_lines"`              // uncommitted lines (added + removed) worth a nudge (0 = 200)
+	MinDays              int  `toml:"min_days"`               // days the changes sit before the nudge (0 = 2)
+	CheckIntervalMinutes int  `toml:"check_interval_minutes"` // time between checks (0 = 60)
+}
+
+// CatalogConfig controls the quest catalog: each day and each week a few
+// quests (commits, lines, test files, streaks) are drawn for the character's
+// level, and expire when their day or week is over.
+type CatalogConfig struct {
+	Enabled bool `toml:"enabled"`
+	Daily   int  `toml:"daily"`  // quests drawn each day (0 = 2)
+	Weekly  int  `toml:"weekly"` // quests drawn each week (0 = 1)
+}
+
+// TaskListModes lists how a completed quest can tick its task list item.
+var TaskListModes = []string{"patch", "commit"}
+
+// LimitsConfig keeps the gamification in check for players who want less of
+// it: fewer commit celebrations per day, quiet hours without celebrations,
+// or only a weekly summary. XP is still earned either way.
+type LimitsConfig struct {
+	MaxCelebratedCommits int  `toml:"max_celebrated_commits"` // commit XP toasts per day; later commits earn XP silently (0 = no limit)
+	QuietHoursStart      int  `toml:"quiet_hours_start"`      // hour (0-23) celebrations stop; equal to quiet_hours_end turns quiet hours off
+	QuietHoursEnd        int  `toml:"quiet_hours_end"`        // hour (0-23) celebrations resume (may be past midnight)
+	WeeklySummaryOnly    bool `toml:"weekly_summary_only"`    // no per-event celebrations; one summary of the past week instead
+}
+
+// PowerConfig controls low-power mode, which saves battery on laptops: the
+// watcher polls less often, animations are off, and the UI wakes up less to
+// redraw timers.
+type PowerConfig struct {
+	LowPower string `toml:"low_power"` // auto (low power while on battery), on or off ("" = auto)
+}
+
+// LowPowerModes lists the low_power settings.
+var LowPowerModes = []string{"auto", "on", "off"}
+
+// DecayConfig controls the opt-in stat decay: after a long absence,
+// CodePower and Agility drift back toward their base value (level and XP
+// never drop), and a comeback quest wins the faded points back.
+type DecayConfig struct {
+	Enabled      bool `toml:"enabled"`
+	GraceDays    int  `toml:"grace_days"`     // days without commits before stats start fading (0 = 21)
+	DaysPerPoint int  `toml:"days_per_point"` // days per point each stat loses after that (0 = 7)
+}
+
+// Default stat decay pace, used when a setting is unset (0).
+const (
+	DefaultDecayGraceDays    = 21
+	DefaultDecayDaysPerPoint = 7
+)
+
+// WithDefaults returns the decay settings with unset (0) values replaced by
+// their defaults.
+//
+// Returns:
+//   - DecayConfig: Settings with every value filled in
+func (d DecayConfig) WithDefaults() DecayConfig {
+	if d.GraceDays == 0 {
+		d.GraceDays = DefaultDecayGraceDays
+	}
+	if d.DaysPerPoint == 0 {
+		d.DaysPerPoint = DefaultDecayDaysPerPoint
+	}
+	return d
 }
 
-// ConfigPath returns the full path to the config file.
-// It expands ~ to the user's home directory.
+// Default TODO scan limits, used when a limit is unset (0).
+const (
+	DefaultTodoScanIntervalMinutes = 60
+	DefaultTodoScanFiles           = 2000
+)
+
+// WithDefaults returns the TODO scan settings with unset (0) limits
+// replaced by their defaults.
+//
+// Returns:
+//   - TodoScanConfig: Settings with every limit filled in
+func (t TodoScanConfig) WithDefaults() TodoScanConfig {
+	if t.ScanIntervalMinutes == 0 {
+		t.ScanIntervalMinutes = DefaultTodoScanIntervalMinutes
+	}
+	if t.MaxFiles == 0 {
+		t.MaxFiles = DefaultTodoScanFiles
+	}
+	return t
+}
+
+// Default work-in-progress detection settings, used when a setting is
+// unset (0).
+const (
+	DefaultWIPMinLines             = 200
+	DefaultWIPMinDays              = 2
+	DefaultWIPCheckIntervalMinutes = 60
+)
+
+// WithDefaults returns the work-in-progress settings with unset (0) values
+// replaced by their defaults.
+//
+// Returns:
+//   - WIPConfig: Settings with every value filled in
+func (w WIPConfig) WithDefaults() WIPConfig {
+	if w.MinLines == 0 {
+		w.MinLines = DefaultWIPMinLines
+	}
+	if w.MinDays == 0 {
+		w.MinDays = DefaultWIPMinDays
+	}
+	if w.CheckIntervalMinutes == 0 {
+		w.CheckIntervalMinutes = DefaultWIPCheckIntervalMinutes
+	}
+	return w
+}
+
+// Default quest catalog draws, used when a setting is unset (0).
+const (
+	DefaultCatalogDaily  = 2
+	DefaultCatalogWeekly = 1
+)
+
+// WithDefaults returns the quest catalog settings with unset (0) values
+// replaced by their defaults.
+//
+// Returns:
+//   - CatalogConfig: Settings with every value filled in
+func (c CatalogConfig) WithDefaults() CatalogConfig {
+	if c.Daily == 0 {
+		c.Daily = DefaultCatalogDaily
+	}
+	if c.Weekly == 0 {
+		c.Weekly = DefaultCatalogWeekly
+	}
+	return c
+}
+
+// Task list sync defaults, used when a setting is unset.
+const (
+	DefaultTaskListFile            = "TODO.md"
+	DefaultTaskListMode            = "patch"
+	DefaultTaskListIntervalMinutes = 5
+)
+
+// WithDefaults returns the task list settings with unset values replaced by
+// their defaults.
+//
+// Returns:
+//   - TaskListConfig: Settings with every value filled in
+func (t TaskListConfig) WithDefaults() TaskListConfig {
+	if t.File == "" {
+		t.File = DefaultTaskListFile
+	}
+	if t.Mode == "" {
+		t.Mode = DefaultTaskListMode
+	}
+	if t.SyncIntervalMinutes == 0 {
+		t.SyncIntervalMinutes = DefaultTaskListIntervalMinutes
+	}
+	return t
+}
+
+// ConfigPath returns the full path to the config file
+// (~/.config/codequest/config.toml, or %AppData%\codequest\config.toml on Windows).
 func ConfigPath() (string, error) {
-	home, err := os.UserHomeDir()
+	dir, err := ConfigDir()
 	if err != nil {
-		return "", fmt.Errorf("getting home directory: %w", err)
+		return "", err
 	}
-	return filepath.Join(home, ".config", "codequest", "config.toml"), nil
+	return filepath.Join(dir, "config.toml"), nil
 }
 
 // Load reads the config file from the standard location.
@@ -168,6 +880,7 @@ func (c *Config) Save() error {
 }
 
 // ExpandPath expands ~ in a path to the user's home directory.
+// Both ~/code and ~\code work on Windows.
 // If the path doesn't start with ~, it returns the path unchanged.
 func ExpandPath(path string) (string, error) {
 	if len(path) == 0 || path[0] != '~' {
@@ -187,6 +900,47 @@ func ExpandPath(path string) (string, error) {
 	return filepath.Join(home, path[1:]), nil
 }
 
+// ProjectForRepo returns the project a repository belongs to.
+// A repo matches a project if its path equals, or is inside, one of the
+// project's repo paths; the most specific (longest) match wins.
+//
+// Parameters:
+//   - repoPath: Absolute path to the repository
+//
+// Returns:
+//   - string: The project name, or "" if the repo isn't in any project
+func (c *Config) ProjectForRepo(repoPath string) string {
+	if repoPath == "" {
+		return ""
+	}
+	repoPath = NormalizePath(repoPath)
+
+	best, bestLen := "", -1
+	for _, project := range c.Projects {
+		for _, path := range project.Repos {
+			expanded, err := ExpandPath(path)
+			if err != nil {
+				continue
+			}
+			expanded = NormalizePath(expanded)
+
+			if pathWithin(repoPath, expanded) && len(expanded) > bestLen {
+				best, bestLen = project.Name, len(expanded)
+			}
+		}
+	}
+	return best
+}
+
+// ProjectNames returns the configured project names in config order.
+func (c *Config) ProjectNames() []string {
+	names := make([]string, 0, len(c.Projects))
+	for _, project := range c.Projects {
+		names = append(names, project.Name)
+	}
+	return names
+}
+
 // ExpandPaths expands ~ in all paths in a slice.
 func ExpandPaths(paths []string) ([]string, error) {
 	expanded := make([]string, len(paths))
@@ -86,6 +86,19 @@ func TestValidate_ValidConfig(t *testing.T) {
 				Debug: DebugConfig{LogLevel: "error"},
 			},
 		},
+		{
+			name: "color-blind palette",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark", Palette: "deuteranopia"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -105,74 +118,513 @@ func TestValidate_InvalidConfig(t *testing.T) {
 		wantField string
 	}{
 		{
-			name: "invalid difficulty",
+			name: "invalid difficulty",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "impossible"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "game.difficulty",
+		},
+		{
+			name: "invalid theme",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "rainbow"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ui.theme",
+		},
+		{
+			name: "invalid palette",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark", Palette: "sepia"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ui.palette",
+		},
+		{
+			name: "invalid AI provider",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "gpt-4", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ai.mentor.provider",
+		},
+		{
+			name: "temperature too high",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 3.0},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ai.mentor.temperature",
+		},
+		{
+			name: "temperature negative",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: -0.5},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ai.mentor.temperature",
+		},
+		{
+			name: "snippet run timeout too long",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7, RunTimeoutSecs: 600},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ai.mentor.run_timeout_secs",
+		},
+		{
+			name: "unknown publish target",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Publish: PublishConfig{Target: "netlify"},
+			},
+			wantField: "publish.target",
+		},
+		{
+			name: "gh-pages without a repository",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Publish: PublishConfig{Target: "gh-pages"},
+			},
+			wantField: "publish.repo",
+		},
+		{
+			name: "unknown digest weekday",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:  DebugConfig{LogLevel: "info"},
+				Digest: DigestConfig{Weekday: "someday"},
+			},
+			wantField: "digest.weekday",
+		},
+		{
+			name: "digest over SMTP without a recipient",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:  DebugConfig{LogLevel: "info"},
+				Digest: DigestConfig{SMTP: DigestSMTPConfig{Host: "smtp.example.com"}},
+			},
+			wantField: "digest.to",
+		},
+		{
+			name: "habits webhook without a scheme",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:  DebugConfig{LogLevel: "info"},
+				Habits: HabitsConfig{WebhookURL: "hooks.example.com/codequest"},
+			},
+			wantField: "habits.webhook_url",
+		},
+		{
+			name: "unknown discord event",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Discord: DiscordConfig{WebhookURL: "https://discord.com/api/webhooks/1/abc", Events: []string{"commit"}},
+			},
+			wantField: "discord.events",
+		},
+		{
+			name: "discord enabled without a webhook",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Discord: DiscordConfig{Enabled: true},
+			},
+			wantField: "discord.webhook_url",
+		},
+		{
+			name: "negative chat history limit",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:     DebugConfig{LogLevel: "info"},
+				Retention: RetentionConfig{ChatHistoryMessages: -1},
+			},
+			wantField: "retention.chat_history_messages",
+		},
+		{
+			name: "rollups kept shorter than raw events",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:     DebugConfig{LogLevel: "info"},
+				Retention: RetentionConfig{RawEventDays: 120, RollupDays: 30},
+			},
+			wantField: "retention.rollup_days",
+		},
+		{
+			name: "negative anomaly burst sigma",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Anomaly: AnomalyConfig{Enabled: true, BurstSigma: -1},
+			},
+			wantField: "anomaly.burst_sigma",
+		},
+		{
+			name: "negative event log file count",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:    DebugConfig{LogLevel: "info"},
+				EventLog: EventLogConfig{Enabled: true, MaxFiles: -1},
+			},
+			wantField: "event_log.max_files",
+		},
+		{
+			name: "negative todo scan interval",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+				Todos: TodoScanConfig{Enabled: true, ScanIntervalMinutes: -5},
+			},
+			wantField: "todos.scan_interval_minutes",
+		},
+		{
+			name: "negative wip min lines",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+				WIP:   WIPConfig{Enabled: true, MinLines: -1},
+			},
+			wantField: "wip.min_lines",
+		},
+		{
+			name: "negative quest catalog weekly",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Catalog: CatalogConfig{Enabled: true, Weekly: -1},
+			},
+			wantField: "quest_catalog.weekly",
+		},
+		{
+			name: "quiet hours past midnight",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:  DebugConfig{LogLevel: "info"},
+				Limits: LimitsConfig{QuietHoursStart: 22, QuietHoursEnd: 24},
+			},
+			wantField: "limits.quiet_hours_end",
+		},
+		{
+			name: "unknown low power mode",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+				Power: PowerConfig{LowPower: "battery"},
+			},
+			wantField: "power.low_power",
+		},
+		{
+			name: "negative decay grace days",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+				Decay: DecayConfig{Enabled: true, GraceDays: -1},
+			},
+			wantField: "decay.grace_days",
+		},
+		{
+			name: "ssh storage without host",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:   DebugConfig{LogLevel: "info"},
+				Storage: StorageConfig{Backend: "ssh"},
+			},
+			wantField: "storage.ssh.host",
+		},
+		{
+			name: "unknown feature flag",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:        DebugConfig{LogLevel: "info"},
+				Experimental: map[string]bool{"time_travel": true},
+			},
+			wantField: "experimental.time_travel",
+		},
+		{
+			name: "replay xp rate above one",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Git:   GitConfig{ReplayXPRate: 1.5},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "git.replay_xp_rate",
+		},
+		{
+			name: "negative bot xp rate",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Git:   GitConfig{BotXPRate: -0.1},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "git.bot_xp_rate",
+		},
+		{
+			name: "invalid watch mode",
 			cfg: &Config{
 				Character: CharacterConfig{Name: "Test"},
-				Game:      GameConfig{Difficulty: "impossible"},
+				Game:      GameConfig{Difficulty: "normal"},
 				UI:        UIConfig{Theme: "dark"},
 				AI: AIConfig{
 					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
 					Review: AIReviewConfig{Provider: "mods"},
 				},
+				Git:   GitConfig{WatchMode: "inotify"},
 				Debug: DebugConfig{LogLevel: "info"},
 			},
-			wantField: "game.difficulty",
+			wantField: "git.watch_mode",
 		},
 		{
-			name: "invalid theme",
+			name: "invalid per-path watch mode",
 			cfg: &Config{
 				Character: CharacterConfig{Name: "Test"},
 				Game:      GameConfig{Difficulty: "normal"},
-				UI:        UIConfig{Theme: "rainbow"},
+				UI:        UIConfig{Theme: "dark"},
 				AI: AIConfig{
 					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
 					Review: AIReviewConfig{Provider: "mods"},
 				},
+				Git:   GitConfig{WatchModes: map[string]string{"/mnt/nfs": "sometimes"}},
 				Debug: DebugConfig{LogLevel: "info"},
 			},
-			wantField: "ui.theme",
+			wantField: `git.watch_modes["/mnt/nfs"]`,
 		},
 		{
-			name: "invalid AI provider",
+			name: "unparseable XP rule condition",
 			cfg: &Config{
 				Character: CharacterConfig{Name: "Test"},
 				Game:      GameConfig{Difficulty: "normal"},
 				UI:        UIConfig{Theme: "dark"},
 				AI: AIConfig{
-					Mentor: AIMentorConfig{Provider: "gpt-4", Temperature: 0.7},
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				XPRules: []XPRuleConfig{{Name: "work", When: []string{"repo in ~/work", "hour ~ 9"}}},
+				Debug:   DebugConfig{LogLevel: "info"},
+			},
+			wantField: "xp_rules[0].when[1]",
+		},
+		{
+			name: "author email without @",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
 					Review: AIReviewConfig{Provider: "mods"},
 				},
+				Git:   GitConfig{AuthorEmails: []string{"me@example.com", "teammate"}},
 				Debug: DebugConfig{LogLevel: "info"},
 			},
-			wantField: "ai.mentor.provider",
+			wantField: "git.author_emails[1]",
 		},
 		{
-			name: "temperature too high",
+			name: "duplicate mentor persona",
 			cfg: &Config{
 				Character: CharacterConfig{Name: "Test"},
 				Game:      GameConfig{Difficulty: "normal"},
 				UI:        UIConfig{Theme: "dark"},
 				AI: AIConfig{
-					Mentor: AIMentorConfig{Provider: "crush", Temperature: 3.0},
+					Mentor: AIMentorConfig{
+						Provider:    "crush",
+						Temperature: 0.7,
+						Personas:    []MentorPersona{{Name: "Gopher"}, {Name: "Gopher"}},
+					},
 					Review: AIReviewConfig{Provider: "mods"},
 				},
 				Debug: DebugConfig{LogLevel: "info"},
 			},
-			wantField: "ai.mentor.temperature",
+			wantField: "ai.mentor.personas[1].name",
 		},
 		{
-			name: "temperature negative",
+			name: "prompt template without prompt",
 			cfg: &Config{
 				Character: CharacterConfig{Name: "Test"},
 				Game:      GameConfig{Difficulty: "normal"},
 				UI:        UIConfig{Theme: "dark"},
 				AI: AIConfig{
-					Mentor: AIMentorConfig{Provider: "crush", Temperature: -0.5},
+					Mentor: AIMentorConfig{
+						Provider:    "crush",
+						Temperature: 0.7,
+						Templates:   []PromptTemplate{{Name: "Review this"}},
+					},
 					Review: AIReviewConfig{Provider: "mods"},
 				},
 				Debug: DebugConfig{LogLevel: "info"},
 			},
-			wantField: "ai.mentor.temperature",
+			wantField: "ai.mentor.templates[0].prompt",
 		},
 		{
 			name: "invalid log level",
@@ -188,6 +640,20 @@ func TestValidate_InvalidConfig(t *testing.T) {
 			},
 			wantField: "debug.log_level",
 		},
+		{
+			name: "metrics address without port",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info", MetricsAddr: "localhost"},
+			},
+			wantField: "debug.metrics_addr",
+		},
 		{
 			name: "empty character name",
 			cfg: &Config{
@@ -202,6 +668,93 @@ func TestValidate_InvalidConfig(t *testing.T) {
 			},
 			wantField: "character.name",
 		},
+		{
+			name: "unknown timezone",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal", Timezone: "Mars/Olympus"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "game.timezone",
+		},
+		{
+			name: "negative reward choice threshold",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal", RewardChoiceMinXP: -1},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "game.reward_choice_min_xp",
+		},
+		{
+			name: "unknown dashboard widget",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark", DashboardWidgets: []string{"character", "weather"}},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "ui.dashboard_widgets[1]",
+		},
+		{
+			name: "break interval too short",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				Tracking:  TrackingConfig{BreakReminders: true, BreakIntervalMins: 5, BreakSnoozeMins: 10},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "tracking.break_interval_minutes",
+		},
+		{
+			name: "bedtime in the afternoon",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				Tracking:  TrackingConfig{LateNightWarnings: true, BedtimeHour: 15},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug: DebugConfig{LogLevel: "info"},
+			},
+			wantField: "tracking.bedtime_hour",
+		},
+		{
+			name: "project without repos",
+			cfg: &Config{
+				Character: CharacterConfig{Name: "Test"},
+				Game:      GameConfig{Difficulty: "normal"},
+				UI:        UIConfig{Theme: "dark"},
+				AI: AIConfig{
+					Mentor: AIMentorConfig{Provider: "crush", Temperature: 0.7},
+					Review: AIReviewConfig{Provider: "mods"},
+				},
+				Debug:    DebugConfig{LogLevel: "info"},
+				Projects: []ProjectConfig{{Name: "work"}},
+			},
+			wantField: "projects[0].repos",
+		},
 	}
 
 	for _, tt := range tests {
@@ -225,6 +778,221 @@ func TestValidate_InvalidConfig(t *testing.T) {
 	}
 }
 
+// TestProjectForRepo tests matching repositories to configured projects.
+func TestProjectForRepo(t *testing.T) {
+	cfg := &Config{
+		Projects: []ProjectConfig{
+			{Name: "work", Repos: []string{"/src/work"}},
+			{Name: "oss", Repos: []string{"/src/oss/codequest", "/src/work/forks"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		repoPath string
+		want     string
+	}{
+		{"exact repo path", "/src/oss/codequest", "oss"},
+		{"repo inside project directory", "/src/work/api", "work"},
+		{"most specific match wins", "/src/work/forks/bubbletea", "oss"},
+		{"similar prefix is not inside", "/src/workshop", ""},
+		{"repo outside all projects", "/home/me/dotfiles", ""},
+		{"empty path", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.ProjectForRepo(tt.repoPath); got != tt.want {
+				t.Errorf("ProjectForRepo(%q) = %q, want %q", tt.repoPath, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsOwnAuthor tests filtering commits by the configured author emails.
+func TestIsOwnAuthor(t *testing.T) {
+	tests := []struct {
+		name   string
+		emails []string
+		email  string
+		want   bool
+	}{
+		{"no emails configured counts everyone", nil, "teammate@example.com", true},
+		{"matching email", []string{"me@work.com", "me@home.org"}, "me@home.org", true},
+		{"case-insensitive match", []string{"Me@Work.com"}, "me@work.COM", true},
+		{"surrounding whitespace ignored", []string{" me@work.com "}, "me@work.com", true},
+		{"foreign email", []string{"me@work.com"}, "teammate@work.com", false},
+		{"empty email", []string{"me@work.com"}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			git := GitConfig{AuthorEmails: tt.emails}
+			if got := git.IsOwnAuthor(tt.email); got != tt.want {
+				t.Errorf("IsOwnAuthor(%q) = %v, want %v", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsOwnAccount tests matching repository owners against own_accounts.
+func TestIsOwnAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		accounts []string
+		owner    string
+		want     bool
+	}{
+		{"no accounts configured matches nothing", nil, "ada", false},
+		{"own user", []string{"ada", "ada-labs"}, "ada", true},
+		{"case-insensitive match", []string{" Ada-Labs "}, "ada-labs", true},
+		{"someone else", []string{"ada"}, "golang", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			git := GitConfig{OwnAccounts: tt.accounts}
+			if got := git.IsOwnAccount(tt.owner); got != tt.want {
+				t.Errorf("IsOwnAccount(%q) = %v, want %v", tt.owner, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsBotCommit tests classifying commits by the bot author and message patterns.
+func TestIsBotCommit(t *testing.T) {
+	git := DefaultConfig().Git
+
+	tests := []struct {
+		name    string
+		author  string
+		email   string
+		message string
+		want    bool
+	}{
+		{"dependabot app", "dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com", "Bump lodash from 4.17.20 to 4.17.21", true},
+		{"bot email with a human name", "CI", "41898282+github-actions[bot]@users.noreply.github.com", "Format code", true},
+		{"self-hosted renovate", "Renovate", "renovate@example.com", "Update dependency go to v1.23", true},
+		{"dependency subject", "Build Server", "ci@example.com", "chore(deps): update module x to v2\n\nSigned-off-by: CI", true},
+		{"auto-commit action subject", "Ada", "ada@example.com", "Apply automatic changes", true},
+		{"pattern only checks the subject", "Ada", "ada@example.com", "Fix parser\n\nchore(deps) follow-up", false},
+		{"human commit", "Ada Lovelace", "ada@example.com", "Add bot detection", false},
+		{"brackets are literal", "bot", "b@example.com", "Tidy", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := git.IsBotCommit(tt.author, tt.email, tt.message); got != tt.want {
+				t.Errorf("IsBotCommit(%q, %q, %q) = %v, want %v", tt.author, tt.email, tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWatchModeFor tests picking a repository's watch mode from the
+// per-path overrides.
+func TestWatchModeFor(t *testing.T) {
+	git := GitConfig{
+		WatchMode: "notify",
+		WatchModes: map[string]string{
+			"/mnt/nfs":       "poll",
+			"/mnt/nfs/local": "auto",
+			"/src/app/.":     "poll",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		git      GitConfig
+		repoPath string
+		want     string
+	}{
+		{"repo inside an override", git, "/mnt/nfs/team/app", "poll"},
+		{"most specific override wins", git, "/mnt/nfs/local/tool", "auto"},
+		{"exact path, however it's spelled", git, "/src/app", "poll"},
+		{"similar prefix is not inside", git, "/mnt/nfs2/app", "notify"},
+		{"no override", git, "/home/me/dotfiles", "notify"},
+		{"unset defaults to auto", GitConfig{}, "/home/me/dotfiles", "auto"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.git.WatchModeFor(tt.repoPath); got != tt.want {
+				t.Errorf("WatchModeFor(%q) = %q, want %q", tt.repoPath, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseXPCondition tests parsing and checking XP rule conditions.
+func TestParseXPCondition(t *testing.T) {
+	tests := []struct {
+		condition string
+		want      XPCondition
+		wantErr   bool
+	}{
+		{"hour >= 9", XPCondition{Field: "hour", Operator: ">=", Value: "9"}, false},
+		{"message matches 'WIP *'", XPCondition{Field: "message", Operator: "matches", Value: "WIP *"}, false},
+		{"Weekday IN sat, sun", XPCondition{Field: "weekday", Operator: "in", Value: "sat, sun"}, false},
+		{"repo in ~/work", XPCondition{Field: "repo", Operator: "in", Value: "~/work"}, false},
+		{"hour", XPCondition{}, true},
+		{"branch = main", XPCondition{}, true},
+		{"hour matches 9*", XPCondition{}, true},
+		{"lines > many", XPCondition{}, true},
+		{"weekday = someday", XPCondition{}, true},
+		{"bot = maybe", XPCondition{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			got, err := ParseXPCondition(tt.condition)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseXPCondition(%q) error = %v, wantErr %v", tt.condition, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseXPCondition(%q) = %+v, want %+v", tt.condition, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestXPConditionMatches tests comparing commit field values with
+// conditions of each field kind.
+func TestXPConditionMatches(t *testing.T) {
+	tests := []struct {
+		condition string
+		value     string
+		want      bool
+	}{
+		{"hour >= 9", "9", true},
+		{"hour < 17", "17", false},
+		{"lines != 0", "12", true},
+		{"repo = /src/app/", "/src/app", true},
+		{"repo in /src/work, /src/oss", "/src/oss/codequest", true},
+		{"repo in /src/work", "/src/workshop", false},
+		{"repo != /src/app", "", true},
+		{"project = Work", "work", true},
+		{"author in ada, grace", "Grace", true},
+		{"message matches wip*", "WIP: spike", true},
+		{"weekday in saturday, sun", "Sunday", true},
+		{"weekday = mon", "Tuesday", false},
+		{"bot = false", "false", true},
+		{"retroactive != true", "true", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			condition, err := ParseXPCondition(tt.condition)
+			if err != nil {
+				t.Fatalf("ParseXPCondition(%q) error = %v", tt.condition, err)
+			}
+			if got := condition.Matches(tt.value); got != tt.want {
+				t.Errorf("%q Matches(%q) = %v, want %v", tt.condition, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
 // TestExpandPath tests path expansion with ~ for home directory.
 func TestExpandPath(t *testing.T) {
 	home, err := os.UserHomeDir()
@@ -509,3 +1277,111 @@ func TestValidationErrorMessage(t *testing.T) {
 		t.Errorf("expected error message %q, got %q", expectedMsg, errMsg)
 	}
 }
+
+// TestAppDir tests config/data directory resolution per OS.
+func TestAppDir(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %v", err)
+	}
+	windowsBase := func() (string, error) { return filepath.Join("C:", "Users", "me", "AppData", "Roaming"), nil }
+
+	tests := []struct {
+		name      string
+		goos      string
+		homeParts []string
+		want      string
+	}{
+		{"windows uses the user directory", "windows", []string{".config"}, filepath.Join("C:", "Users", "me", "AppData", "Roaming", "codequest")},
+		{"linux config", "linux", []string{".config"}, filepath.Join(home, ".config", "codequest")},
+		{"macOS data", "darwin", []string{".local", "share"}, filepath.Join(home, ".local", "share", "codequest")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appDir(tt.goos, windowsBase, tt.homeParts...)
+			if err != nil {
+				t.Fatalf("appDir() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("appDir(%q) = %q, want %q", tt.goos, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNormalizePath tests that spellings of the same path normalize alike.
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"empty", "", ""},
+		{"trailing separator", "/src/app/", filepath.FromSlash("/src/app")},
+		{"dot segments", "/src/./tools/../app", filepath.FromSlash("/src/app")},
+		{"forward slashes", "src/app", filepath.FromSlash("src/app")},
+	}
+	if filepath.VolumeName("C:") != "" {
+		// Drive letters only exist on Windows
+		tests = append(tests, struct {
+			name string
+			path string
+			want string
+		}{"lowercase drive", "c:/code/app", `C:\code\app`})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizePath(tt.path); got != tt.want {
+				t.Errorf("NormalizePath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProjectForRepoCaseInsensitive tests project matching on filesystems
+// that ignore case (Windows).
+func TestProjectForRepoCaseInsensitive(t *testing.T) {
+	defer func(saved bool) { caseInsensitivePaths = saved }(caseInsensitivePaths)
+	cfg := &Config{Projects: []ProjectConfig{{Name: "work", Repos: []string{"/Src/Work"}}}}
+
+	caseInsensitivePaths = false
+	if got := cfg.ProjectForRepo("/src/work/api"); got != "" {
+		t.Errorf("case-sensitive ProjectForRepo() = %q, want no match", got)
+	}
+
+	caseInsensitivePaths = true
+	if got := cfg.ProjectForRepo("/src/work/api"); got != "work" {
+		t.Errorf("case-insensitive ProjectForRepo() = %q, want %q", got, "work")
+	}
+}
+
+// TestFeatureFlags tests the experimental feature flag helpers.
+func TestFeatureFlags(t *testing.T) {
+	var missing *Config
+	if missing.FeatureEnabled(FeatureAIQuests) {
+		t.Error("a nil config should have every feature off")
+	}
+
+	cfg := DefaultConfig()
+	for _, id := range FeatureIDs() {
+		if cfg.FeatureEnabled(id) {
+			t.Errorf("feature %q should ship dark", id)
+		}
+	}
+
+	cfg.SetFeature(FeatureDaemon, true)
+	cfg.SetFeature(FeatureAIQuests, true)
+	if !cfg.FeatureEnabled(FeatureDaemon) {
+		t.Error("SetFeature() should switch the feature on")
+	}
+	if got := cfg.EnabledFeatures(); len(got) != 2 || got[0] != FeatureAIQuests || got[1] != FeatureDaemon {
+		t.Errorf("EnabledFeatures() = %v, want [ai_quests daemon]", got)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	(&Config{}).SetFeature(FeatureLeaderboard, true) // nil map is created
+}
@@ -9,20 +9,37 @@ func DefaultConfig() *Config {
 			Name: "CodeWarrior",
 		},
 		Game: GameConfig{
-			AutoStartQuests: false,
-			ShowTips:        true,
-			Difficulty:      "normal", // easy, normal, hard
+			AutoStartQuests:   false,
+			ShowTips:          true,
+			Difficulty:        "normal", // easy, normal, hard
+			DailyXPCap:        1000,
+			FullRateCommits:   20,
+			RestBonusRate:     0.1,
+			Timezone:          "auto", // system timezone
+			StreakGraceHours:  0,
+			RewardChoiceMinXP: 200,
 		},
 		UI: UIConfig{
-			Theme:            "dark", // dark, light, auto
+			Theme:            "dark",    // dark, light, auto
+			Palette:          "default", // default, deuteranopia, protanopia
+			Images:           "auto",    // auto, off, kitty, iterm2, sixel
 			ShowAnimations:   true,
+			ReducedMotion:    false,
 			CompactMode:      false,
 			ShowKeybindHints: true,
+			LevelUpFanfare:   true,
+			DashboardWidgets: append([]string(nil), DashboardWidgetIDs...),
 		},
 		Tracking: TrackingConfig{
 			SessionTimerEnabled: true,
 			SessionHotkey:       "ctrl+t",
 			WakatimeEnabled:     false,
+			EnergyCheckIn:       false,
+			BreakReminders:      true,
+			BreakIntervalMins:   50,
+			BreakSnoozeMins:     10,
+			LateNightWarnings:   false,
+			BedtimeHour:         0,
 		},
 		AI: AIConfig{
 			Mentor: AIMentorConfig{
@@ -32,6 +49,31 @@ func DefaultConfig() *Config {
 				ModelComplexOffline: "qwen3:30b",
 				ModelSimpleOffline:  "qwen3:4b",
 				Temperature:         0.7,
+				RunTimeoutSecs:      10,
+				Personas: []MentorPersona{
+					{
+						Name:         "Mentor",
+						SystemPrompt: "You are a patient coding mentor. Explain the reasoning behind your answers.",
+						Tone:         "encouraging",
+					},
+					{
+						Name:         "Reviewer",
+						SystemPrompt: "You are a senior engineer doing a code review. Point out bugs, risks and style issues.",
+						Tone:         "direct",
+					},
+					{
+						Name:         "Gopher",
+						SystemPrompt: "You are an experienced Go developer. Prefer idiomatic, standard-library solutions.",
+						Tone:         "concise",
+						Focus:        "Go",
+					},
+				},
+				Templates: []PromptTemplate{
+					{Name: "Review this", Prompt: "Review this code and suggest improvements: "},
+					{Name: "Explain like I'm new to Go", Prompt: "Explain this like I'm new to Go: "},
+					{Name: "Find the bug", Prompt: "Help me find the bug in this code: "},
+					{Name: "Write tests", Prompt: "Write table-driven tests for this code: "},
+				},
 			},
 			Review: AIReviewConfig{
 				Provider:       "mods",
@@ -42,11 +84,20 @@ func DefaultConfig() *Config {
 			},
 		},
 		Git: GitConfig{
-			AutoDetectRepos: true,
-			WatchPaths:      []string{"~/projects"},
+			AutoDetectRepos:  true,
+			WatchPaths:       []string{"~/projects"},
+			ReplayOnStartup:  true,
+			ReplayWindowDays: 7,
+			ReplayXPRate:     0.5,
+			BotAuthors:       []string{"*[bot]", "*[bot]@*", "dependabot*", "renovate*", "github-actions*"},
+			BotMessages:      []string{"chore(deps*", "build(deps*", "Bump * from * to *", "Apply automatic changes*"},
+			CountBotCommits:  false,
+			BotXPRate:        0.25,
+			WatchMode:        "auto", // notifications, polling where they're unavailable
 		},
 		Github: GithubConfig{
-			Enabled: false,
+			Enabled:           false,
+			SplitSquashMerges: false,
 		},
 		Keybinds: KeybindsConfig{
 			DashboardQuests:    "q",
@@ -54,9 +105,109 @@ func DefaultConfig() *Config {
 			GlobalTimer:        "ctrl+t",
 		},
 		Debug: DebugConfig{
-			Enabled:  false,
-			LogLevel: "info", // debug, info, warn, error
-			LogFile:  "",     // empty means no file logging
+			Enabled:     false,
+			LogLevel:    "info", // debug, info, warn, error
+			LogFile:     "",     // empty means no file logging
+			MetricsAddr: "",     // empty means no metrics listener
+		},
+		Retention: RetentionConfig{
+			RawEventDays:        DefaultRawEventDays,
+			RollupDays:          DefaultRollupDays,
+			ChatHistoryMessages: DefaultChatHistoryMessages,
+		},
+		Anomaly: AnomalyConfig{
+			Enabled:        true,
+			MaxCommitLines: DefaultMaxCommitLines,
+			BurstSigma:     DefaultBurstSigma,
+		},
+		EventLog: EventLogConfig{
+			Enabled:   false, // opt-in: writes every game event to events.jsonl
+			Path:      "",
+			MaxSizeMB: DefaultEventLogSizeMB,
+			MaxFiles:  DefaultEventLogFiles,
+			Analytics: false, // opt-in: needs a build with -tags sqlite
+		},
+		Todos: TodoScanConfig{
+			Enabled:             true,
+			ScanIntervalMinutes: DefaultTodoScanIntervalMinutes,
+			MaxFiles:            DefaultTodoScanFiles,
+		},
+		TaskList: TaskListConfig{
+			Enabled:             false, // opt-in: quests from each repository's TODO.md
+			File:                DefaultTaskListFile,
+			Mode:                DefaultTaskListMode,
+			SyncIntervalMinutes: DefaultTaskListIntervalMinutes,
+		},
+		WIP: WIPConfig{
+			Enabled:              true,
+			MinLines:             DefaultWIPMinLines,
+			MinDays:              DefaultWIPMinDays,
+			CheckIntervalMinutes: DefaultWIPCheckIntervalMinutes,
+		},
+		Catalog: CatalogConfig{
+			Enabled: true,
+			Daily:   DefaultCatalogDaily,
+			Weekly:  DefaultCatalogWeekly,
+		},
+		Power: PowerConfig{
+			LowPower: "auto", // low power while on battery
+		},
+		Limits: LimitsConfig{
+			MaxCelebratedCommits: 0, // celebrate every commit
+			QuietHoursStart:      0, // start == end: no quiet hours
+			QuietHoursEnd:        0,
+			WeeklySummaryOnly:    false,
+		},
+		Decay: DecayConfig{
+			Enabled:      false, // opt-in: stats never fade unless turned on
+			GraceDays:    DefaultDecayGraceDays,
+			DaysPerPoint: DefaultDecayDaysPerPoint,
+		},
+		Storage: StorageConfig{
+			Backend: "skate",
+			SSH: SSHStorageConfig{
+				Host: "", // set with backend = "ssh"
+				Path: "~/.codequest",
+			},
+		},
+		Publish: PublishConfig{
+			Target: "", // set to gh-pages or gist to enable `codequest publish`
+			Branch: "gh-pages",
+			Remote: "origin",
+			Privacy: PublishPrivacyConfig{
+				ShowName:      true,
+				ShowProfile:   true,
+				ShowLevel:     true,
+				ShowStreak:    true,
+				ShowTotals:    false,
+				ShowBadges:    true,
+				ShowHeatmap:   true,
+				ShowLanguages: true,
+			},
+		},
+		Digest: DigestConfig{
+			Enabled: false, // opt-in: no email unless turned on
+			Weekday: "monday",
+			SMTP: DigestSMTPConfig{
+				Port:        DefaultDigestSMTPPort,
+				PasswordEnv: DefaultDigestPasswordEnv,
+			},
+		},
+		Habits: HabitsConfig{
+			Enabled:  false, // opt-in: nothing leaves the machine unless turned on
+			Habitica: HabitsHabiticaConfig{TokenEnv: DefaultHabiticaTokenEnv},
+			Quests:   map[string]string{},
+		},
+		Discord: DiscordConfig{
+			Enabled:         false, // opt-in: nothing is posted unless turned on
+			Events:          []string{DiscordEventQuestDone, DiscordEventLevelUp},
+			MinIntervalSecs: DefaultDiscordMinInterval,
+		},
+		// Experimental features ship dark; listed so the config file shows them
+		Experimental: map[string]bool{
+			FeatureAIQuests:    false,
+			FeatureLeaderboard: false,
+			FeatureDaemon:      false,
 		},
 	}
 }
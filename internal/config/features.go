@@ -0,0 +1,77 @@
+package config
+
+import "sort"
+
+// Experimental feature flags. Unstable subsystems check these so they can
+// ship dark and be switched on per user from the [experimental] config
+// section (or Settings) without a separate build.
+const (
+	FeatureAIQuests    = "ai_quests"   // quests generated by the AI mentor
+	FeatureLeaderboard = "leaderboard" // comparing progress with other players
+	FeatureDaemon      = "daemon"      // watching repositories in the background without the TUI
+)
+
+// Feature describes an experimental feature flag.
+type Feature struct {
+	ID          string // Key under [experimental]
+	Name        string // Display name in Settings
+	Description string // What switching it on does
+}
+
+// Features is the feature flag registry, in the order Settings lists them.
+var Features = []Feature{
+	{ID: FeatureAIQuests, Name: "AI Quests", Description: "Let the AI mentor generate quests for you"},
+	{ID: FeatureLeaderboard, Name: "Leaderboard", Description: "Compare your progress with other players"},
+	{ID: FeatureDaemon, Name: "Daemon Mode", Description: "Keep earning XP in the background without the TUI open"},
+}
+
+// FeatureIDs returns the ID of every registered feature flag.
+//
+// Returns:
+//   - []string: Feature IDs in registry order
+func FeatureIDs() []string {
+	ids := make([]string, len(Features))
+	for i, feature := range Features {
+		ids[i] = feature.ID
+	}
+	return ids
+}
+
+// FeatureEnabled reports whether an experimental feature is switched on.
+// Features are off unless enabled under [experimental].
+//
+// Parameters:
+//   - id: Feature ID (e.g. FeatureAIQuests)
+//
+// Returns:
+//   - bool: True if the feature is enabled
+func (c *Config) FeatureEnabled(id string) bool {
+	return c != nil && c.Experimental[id]
+}
+
+// SetFeature switches an experimental feature on or off.
+//
+// Parameters:
+//   - id: Feature ID (e.g. FeatureAIQuests)
+//   - enabled: Whether the feature should be on
+func (c *Config) SetFeature(id string, enabled bool) {
+	if c.Experimental == nil {
+		c.Experimental = make(map[string]bool)
+	}
+	c.Experimental[id] = enabled
+}
+
+// EnabledFeatures lists the experimental features that are switched on.
+//
+// Returns:
+//   - []string: Enabled feature IDs, sorted
+func (c *Config) EnabledFeatures() []string {
+	var enabled []string
+	for id, on := range c.Experimental {
+		if on {
+			enabled = append(enabled, id)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// appName is the directory name used under the OS config and data roots.
+const appName = "codequest"
+
+// caseInsensitivePaths is true where the filesystem ignores case, so
+// C:\Code\app and c:\code\app are the same repository.
+var caseInsensitivePaths = runtime.GOOS == "windows"
+
+// ConfigDir returns the directory holding config.toml.
+//   - Windows: %AppData%\codequest (os.UserConfigDir)
+//   - Elsewhere: ~/.config/codequest
+//
+// Returns:
+//   - string: Absolute path to the config directory
+//   - error: An error if the base directory can't be determined
+func ConfigDir() (string, error) {
+	return appDir(runtime.GOOS, os.UserConfigDir, ".config")
+}
+
+// QuestTemplateDir returns the directory holding custom quest templates
+// (one .toml file per quest), inside ConfigDir.
+//
+// Returns:
+//   - string: Absolute path to the quest template directory
+//   - error: An error if the config directory can't be determined
+func QuestTemplateDir() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "quests"), nil
+}
+
+// DataDir returns the directory for local data such as fallback storage.
+//   - Windows: %LocalAppData%\codequest
+//   - Elsewhere: ~/.local/share/codequest
+//
+// Returns:
+//   - string: Absolute path to the data directory
+//   - error: An error if the base directory can't be determined
+func DataDir() (string, error) {
+	return appDir(runtime.GOOS, localAppData, ".local", "share")
+}
+
+// appDir resolves an application directory: under windowsBase on Windows,
+// otherwise under the home directory.
+//
+// Parameters:
+//   - goos: Target OS (runtime.GOOS)
+//   - windowsBase: Returns the per-user base directory on Windows
+//   - homeParts: Path below the home directory elsewhere
+//
+// Returns:
+//   - string: The application directory
+//   - error: An error if the base directory can't be determined
+func appDir(goos string, windowsBase func() (string, error), homeParts ...string) (string, error) {
+	if goos == "windows" {
+		base, err := windowsBase()
+		if err != nil {
+			return "", fmt.Errorf("getting user directory: %w", err)
+		}
+		return filepath.Join(base, appName), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	parts := append([]string{home}, homeParts...)
+	return filepath.Join(append(parts, appName)...), nil
+}
+
+// localAppData returns %LocalAppData%, the Windows home for machine-local data.
+func localAppData() (string, error) {
+	dir := os.Getenv("LocalAppData")
+	if dir == "" {
+		return "", fmt.Errorf("%%LocalAppData%% is not defined")
+	}
+	return dir, nil
+}
+
+// NormalizePath cleans a path for use as a key: separators are converted to
+// the OS form and, on Windows, the drive letter is upper-cased, so the same
+// repository always maps to the same watcher and checkpoint.
+//
+// Parameters:
+//   - path: Path as configured or reported
+//
+// Returns:
+//   - string: The normalized path ("" stays "")
+func NormalizePath(path string) string {
+	if path == "" {
+		return ""
+	}
+	path = filepath.Clean(filepath.FromSlash(path))
+	if volume := filepath.VolumeName(path); len(volume) == 2 && volume[1] == ':' {
+		path = strings.ToUpper(volume) + path[2:]
+	}
+	return path
+}
+
+// pathWithin reports whether path equals dir or is inside it. Both must
+// already be normalized; case is ignored where the filesystem ignores it.
+func pathWithin(path, dir string) bool {
+	if caseInsensitivePaths {
+		path, dir = strings.ToLower(path), strings.ToLower(dir)
+	}
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 // ValidationError represents a configuration validation error.
@@ -29,6 +33,91 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate Game.DailyXPCap and Game.FullRateCommits (must not be negative)
+	if c.Game.DailyXPCap < 0 {
+		return ValidationError{
+			Field:   "game.daily_xp_cap",
+			Value:   c.Game.DailyXPCap,
+			Message: "must not be negative",
+		}
+	}
+	if c.Game.FullRateCommits < 0 {
+		return ValidationError{
+			Field:   "game.full_rate_commits",
+			Value:   c.Game.FullRateCommits,
+			Message: "must not be negative",
+		}
+	}
+
+	// Validate Game.RestBonusRate (should be between 0 and 1)
+	if c.Game.RestBonusRate < 0 || c.Game.RestBonusRate > 1 {
+		return ValidationError{
+			Field:   "game.rest_bonus_rate",
+			Value:   c.Game.RestBonusRate,
+			Message: "must be between 0 and 1",
+		}
+	}
+
+	// Validate Game.Timezone (IANA name, or "auto" for the system timezone)
+	if c.Game.Timezone != "" && c.Game.Timezone != "auto" {
+		if _, err := time.LoadLocation(c.Game.Timezone); err != nil {
+			return ValidationError{
+				Field:   "game.timezone",
+				Value:   c.Game.Timezone,
+				Message: "must be \"auto\" or an IANA timezone name (e.g. \"America/New_York\")",
+			}
+		}
+	}
+
+	// Validate Game.StreakGraceHours (between 0 and 12)
+	if c.Game.StreakGraceHours < 0 || c.Game.StreakGraceHours > 12 {
+		return ValidationError{
+			Field:   "game.streak_grace_hours",
+			Value:   c.Game.StreakGraceHours,
+			Message: "must be between 0 and 12",
+		}
+	}
+
+	// Validate Game.RewardChoiceMinXP (non-negative)
+	if c.Game.RewardChoiceMinXP < 0 {
+		return ValidationError{
+			Field:   "game.reward_choice_min_xp",
+			Value:   c.Game.RewardChoiceMinXP,
+			Message: "must be non-negative (0 disables reward choices)",
+		}
+	}
+
+	// Validate Tracking break reminder timings (only when reminders are on)
+	if c.Tracking.BreakReminders {
+		if c.Tracking.BreakIntervalMins < 10 || c.Tracking.BreakIntervalMins > 240 {
+			return ValidationError{
+				Field:   "tracking.break_interval_minutes",
+				Value:   c.Tracking.BreakIntervalMins,
+				Message: "must be between 10 and 240",
+			}
+		}
+		if c.Tracking.BreakSnoozeMins < 1 || c.Tracking.BreakSnoozeMins > 60 {
+			return ValidationError{
+				Field:   "tracking.break_snooze_minutes",
+				Value:   c.Tracking.BreakSnoozeMins,
+				Message: "must be between 1 and 60",
+			}
+		}
+	}
+
+	// Validate Tracking bedtime (only when late-night warnings are on): an
+	// evening hour, or an hour after midnight before late nights end at 5
+	if c.Tracking.LateNightWarnings {
+		if c.Tracking.BedtimeHour < 0 || c.Tracking.BedtimeHour > 23 ||
+			(c.Tracking.BedtimeHour > 4 && c.Tracking.BedtimeHour < 18) {
+			return ValidationError{
+				Field:   "tracking.bedtime_hour",
+				Value:   c.Tracking.BedtimeHour,
+				Message: "must be between 18 and 23, or 0 and 4 for a bedtime after midnight",
+			}
+		}
+	}
+
 	// Validate UI.Theme
 	validThemes := []string{"dark", "light", "auto"}
 	if !contains(validThemes, c.UI.Theme) {
@@ -39,6 +128,50 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate UI.Palette (unset in configs written before palettes existed)
+	if c.UI.Palette != "" && !contains(ColorPalettes, c.UI.Palette) {
+		return ValidationError{
+			Field:   "ui.palette",
+			Value:   c.UI.Palette,
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(ColorPalettes, ", ")),
+		}
+	}
+
+	// Validate UI.Images (unset in configs written before inline images existed)
+	if c.UI.Images != "" && !contains(ImageModes, c.UI.Images) {
+		return ValidationError{
+			Field:   "ui.images",
+			Value:   c.UI.Images,
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(ImageModes, ", ")),
+		}
+	}
+
+	// Validate UI.DashboardWidgets (known widgets, each listed once)
+	for i, widget := range c.UI.DashboardWidgets {
+		field := fmt.Sprintf("ui.dashboard_widgets[%d]", i)
+		if !contains(DashboardWidgetIDs, widget) {
+			return ValidationError{
+				Field:   field,
+				Value:   widget,
+				Message: fmt.Sprintf("must be one of: %s", strings.Join(DashboardWidgetIDs, ", ")),
+			}
+		}
+		if contains(c.UI.DashboardWidgets[:i], widget) {
+			return ValidationError{Field: field, Value: widget, Message: "must not be listed twice"}
+		}
+	}
+
+	// Validate Experimental (known feature flags only)
+	for id := range c.Experimental {
+		if !contains(FeatureIDs(), id) {
+			return ValidationError{
+				Field:   "experimental." + id,
+				Value:   c.Experimental[id],
+				Message: fmt.Sprintf("unknown feature flag (known: %s)", strings.Join(FeatureIDs(), ", ")),
+			}
+		}
+	}
+
 	// Validate AI.Mentor.Provider
 	validAIProviders := []string{"crush", "mods", "claude-code"}
 	if !contains(validAIProviders, c.AI.Mentor.Provider) {
@@ -67,6 +200,369 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate AI.Mentor.RunTimeoutSecs (0 uses the default; snippets
+	// shouldn't hang the mentor)
+	if c.AI.Mentor.RunTimeoutSecs < 0 || c.AI.Mentor.RunTimeoutSecs > 120 {
+		return ValidationError{
+			Field:   "ai.mentor.run_timeout_secs",
+			Value:   c.AI.Mentor.RunTimeoutSecs,
+			Message: "must be between 0 and 120",
+		}
+	}
+
+	// Validate AI.Mentor.Personas (each needs a unique name)
+	personaNames := make(map[string]bool)
+	for i, persona := range c.AI.Mentor.Personas {
+		field := fmt.Sprintf("ai.mentor.personas[%d].name", i)
+		if strings.TrimSpace(persona.Name) == "" {
+			return ValidationError{Field: field, Value: persona.Name, Message: "must not be empty"}
+		}
+		if personaNames[persona.Name] {
+			return ValidationError{Field: field, Value: persona.Name, Message: "must be unique"}
+		}
+		personaNames[persona.Name] = true
+	}
+
+	// Validate AI.Mentor.Templates (each needs a name and a prompt)
+	for i, template := range c.AI.Mentor.Templates {
+		if strings.TrimSpace(template.Name) == "" {
+			return ValidationError{
+				Field:   fmt.Sprintf("ai.mentor.templates[%d].name", i),
+				Value:   template.Name,
+				Message: "must not be empty",
+			}
+		}
+		if strings.TrimSpace(template.Prompt) == "" {
+			return ValidationError{
+				Field:   fmt.Sprintf("ai.mentor.templates[%d].prompt", i),
+				Value:   template.Prompt,
+				Message: "must not be empty",
+			}
+		}
+	}
+
+	// Validate Retention limits (0 uses the default)
+	for _, limit := range []struct {
+		field string
+		value int
+	}{
+		{"retention.raw_event_days", c.Retention.RawEventDays},
+		{"retention.rollup_days", c.Retention.RollupDays},
+		{"retention.chat_history_messages", c.Retention.ChatHistoryMessages},
+	} {
+		if limit.value < 0 {
+			return ValidationError{
+				Field:   limit.field,
+				Value:   limit.value,
+				Message: "must be non-negative (0 uses the default)",
+			}
+		}
+	}
+	if retention := c.Retention.WithDefaults(); retention.RollupDays < retention.RawEventDays {
+		return ValidationError{
+			Field:   "retention.rollup_days",
+			Value:   c.Retention.RollupDays,
+			Message: fmt.Sprintf("must be at least raw_event_days (%d)", retention.RawEventDays),
+		}
+	}
+
+	// Validate Anomaly thresholds (0 uses the default)
+	if c.Anomaly.MaxCommitLines < 0 {
+		return ValidationError{
+			Field:   "anomaly.max_commit_lines",
+			Value:   c.Anomaly.MaxCommitLines,
+			Message: "must be non-negative (0 uses the default)",
+		}
+	}
+	if c.Anomaly.BurstSigma < 0 {
+		return ValidationError{
+			Field:   "anomaly.burst_sigma",
+			Value:   c.Anomaly.BurstSigma,
+			Message: "must be non-negative (0 uses the default)",
+		}
+	}
+
+	// Validate EventLog rotation, TODO scan, WIP, quest catalog and decay limits (0 uses the default)
+	for _, limit := range []struct {
+		field string
+		value int
+	}{
+		{"event_log.max_size_mb", c.EventLog.MaxSizeMB},
+		{"event_log.max_files", c.EventLog.MaxFiles},
+		{"todos.scan_interval_minutes", c.Todos.ScanIntervalMinutes},
+		{"todos.max_files", c.Todos.MaxFiles},
+		{"task_list.sync_interval_minutes", c.TaskList.SyncIntervalMinutes},
+		{"wip.min_lines", c.WIP.MinLines},
+		{"wip.min_days", c.WIP.MinDays},
+		{"wip.check_interval_minutes", c.WIP.CheckIntervalMinutes},
+		{"quest_catalog.daily", c.Catalog.Daily},
+		{"quest_catalog.weekly", c.Catalog.Weekly},
+		{"decay.grace_days", c.Decay.GraceDays},
+		{"decay.days_per_point", c.Decay.DaysPerPoint},
+	} {
+		if limit.value < 0 {
+			return ValidationError{
+				Field:   limit.field,
+				Value:   limit.value,
+				Message: "must be non-negative (0 uses the default)",
+			}
+		}
+	}
+
+	// Validate TaskList (unset mode uses the default; the file stays inside the repository)
+	if c.TaskList.Mode != "" && !contains(TaskListModes, c.TaskList.Mode) {
+		return ValidationError{
+			Field:   "task_list.mode",
+			Value:   c.TaskList.Mode,
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(TaskListModes, ", ")),
+		}
+	}
+	if file := filepath.Clean(c.TaskList.File); c.TaskList.File != "" && (filepath.IsAbs(file) || strings.HasPrefix(file, "..")) {
+		return ValidationError{
+			Field:   "task_list.file",
+			Value:   c.TaskList.File,
+			Message: "must be a path inside the repository",
+		}
+	}
+
+	// Validate Power (unset uses auto)
+	if c.Power.LowPower != "" && !contains(LowPowerModes, c.Power.LowPower) {
+		return ValidationError{
+			Field:   "power.low_power",
+			Value:   c.Power.LowPower,
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(LowPowerModes, ", ")),
+		}
+	}
+
+	// Validate Limits (non-negative cap, quiet hours on the clock)
+	if c.Limits.MaxCelebratedCommits < 0 {
+		return ValidationError{
+			Field:   "limits.max_celebrated_commits",
+			Value:   c.Limits.MaxCelebratedCommits,
+			Message: "must be non-negative (0 = no limit)",
+		}
+	}
+	for _, hour := range []struct {
+		field string
+		value int
+	}{
+		{"limits.quiet_hours_start", c.Limits.QuietHoursStart},
+		{"limits.quiet_hours_end", c.Limits.QuietHoursEnd},
+	} {
+		if hour.value < 0 || hour.value > 23 {
+			return ValidationError{
+				Field:   hour.field,
+				Value:   hour.value,
+				Message: "must be an hour between 0 and 23",
+			}
+		}
+	}
+
+	// Validate Publish target (gh-pages needs a repository to commit to)
+	validPublishTargets := []string{"", "gh-pages", "gist"}
+	if !contains(validPublishTargets, c.Publish.Target) {
+		return ValidationError{
+			Field:   "publish.target",
+			Value:   c.Publish.Target,
+			Message: "must be empty, \"gh-pages\" or \"gist\"",
+		}
+	}
+	if c.Publish.Target == "gh-pages" && strings.TrimSpace(c.Publish.Repo) == "" {
+		return ValidationError{
+			Field:   "publish.repo",
+			Value:   c.Publish.Repo,
+			Message: "must be set when publishing to gh-pages",
+		}
+	}
+
+	// Validate Digest schedule and mail server (SMTP needs a recipient)
+	if _, ok := c.Digest.SendDay(); !ok {
+		return ValidationError{
+			Field:   "digest.weekday",
+			Value:   c.Digest.Weekday,
+			Message: "must be a day of the week (e.g. \"monday\")",
+		}
+	}
+	if c.Digest.SMTP.Host != "" && strings.TrimSpace(c.Digest.To) == "" {
+		return ValidationError{
+			Field:   "digest.to",
+			Value:   c.Digest.To,
+			Message: "must be set when sending the digest over SMTP",
+		}
+	}
+	if c.Digest.SMTP.Port < 0 || c.Digest.SMTP.Port > 65535 {
+		return ValidationError{
+			Field:   "digest.smtp.port",
+			Value:   c.Digest.SMTP.Port,
+			Message: "must be between 0 and 65535 (0 = 587)",
+		}
+	}
+
+	// Validate Habits webhook (an absolute http(s) URL)
+	if c.Habits.WebhookURL != "" {
+		if u, err := url.Parse(c.Habits.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ValidationError{
+				Field:   "habits.webhook_url",
+				Value:   c.Habits.WebhookURL,
+				Message: "must be an http:// or https:// URL",
+			}
+		}
+	}
+
+	// Validate Discord webhook, event filter and rate limit
+	if c.Discord.Enabled && strings.TrimSpace(c.Discord.WebhookURL) == "" {
+		return ValidationError{
+			Field:   "discord.webhook_url",
+			Value:   c.Discord.WebhookURL,
+			Message: "must be set when Discord sharing is enabled",
+		}
+	}
+	if c.Discord.WebhookURL != "" {
+		if u, err := url.Parse(c.Discord.WebhookURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return ValidationError{
+				Field:   "discord.webhook_url",
+				Value:   c.Discord.WebhookURL,
+				Message: "must be an https:// webhook URL",
+			}
+		}
+	}
+	for _, event := range c.Discord.Events {
+		if !contains(DiscordEvents, event) {
+			return ValidationError{
+				Field:   "discord.events",
+				Value:   event,
+				Message: "must be \"quest_done\" or \"level_up\"",
+			}
+		}
+	}
+	if c.Discord.MinIntervalSecs < 0 || c.Discord.MinIntervalSecs > discordMaxMinIntervalSecs {
+		return ValidationError{
+			Field:   "discord.min_interval_secs",
+			Value:   c.Discord.MinIntervalSecs,
+			Message: "must be between 0 and 3600 (0 = 2)",
+		}
+	}
+
+	// Validate Storage backend (ssh needs a host to connect to)
+	validBackends := []string{"", "skate", "local", "ssh"}
+	if !contains(validBackends, c.Storage.Backend) {
+		return ValidationError{
+			Field:   "storage.backend",
+			Value:   c.Storage.Backend,
+			Message: "must be \"skate\", \"local\" or \"ssh\"",
+		}
+	}
+	if c.Storage.Backend == "ssh" && strings.TrimSpace(c.Storage.SSH.Host) == "" {
+		return ValidationError{
+			Field:   "storage.ssh.host",
+			Value:   c.Storage.SSH.Host,
+			Message: "must be set when storing over ssh",
+		}
+	}
+	if c.Storage.SSH.Port < 0 || c.Storage.SSH.Port > 65535 {
+		return ValidationError{
+			Field:   "storage.ssh.port",
+			Value:   c.Storage.SSH.Port,
+			Message: "must be a port between 0 and 65535 (0 uses ssh's default)",
+		}
+	}
+
+	// Validate Projects (unique names, at least one repo each)
+	projectNames := make(map[string]bool)
+	for i, project := range c.Projects {
+		field := fmt.Sprintf("projects[%d].name", i)
+		if strings.TrimSpace(project.Name) == "" {
+			return ValidationError{Field: field, Value: project.Name, Message: "must not be empty"}
+		}
+		if projectNames[project.Name] {
+			return ValidationError{Field: field, Value: project.Name, Message: "must be unique"}
+		}
+		projectNames[project.Name] = true
+
+		if len(project.Repos) == 0 {
+			return ValidationError{
+				Field:   fmt.Sprintf("projects[%d].repos", i),
+				Value:   project.Repos,
+				Message: "must list at least one repository",
+			}
+		}
+	}
+
+	// Validate XPRules (named, conditions parse, no negative multiplier)
+	for i, rule := range c.XPRules {
+		if strings.TrimSpace(rule.Name) == "" {
+			return ValidationError{Field: fmt.Sprintf("xp_rules[%d].name", i), Value: rule.Name, Message: "must not be empty"}
+		}
+		for j, condition := range rule.When {
+			if _, err := ParseXPCondition(condition); err != nil {
+				return ValidationError{Field: fmt.Sprintf("xp_rules[%d].when[%d]", i, j), Value: condition, Message: err.Error()}
+			}
+		}
+		if rule.Multiplier < 0 {
+			return ValidationError{
+				Field:   fmt.Sprintf("xp_rules[%d].multiplier", i),
+				Value:   rule.Multiplier,
+				Message: "must not be negative (0 leaves XP unchanged)",
+			}
+		}
+	}
+
+	// Validate Git.ReplayWindowDays (must not be negative)
+	if c.Git.ReplayWindowDays < 0 {
+		return ValidationError{
+			Field:   "git.replay_window_days",
+			Value:   c.Git.ReplayWindowDays,
+			Message: "must not be negative",
+		}
+	}
+
+	// Validate Git.ReplayXPRate (should be between 0 and 1)
+	if c.Git.ReplayXPRate < 0 || c.Git.ReplayXPRate > 1 {
+		return ValidationError{
+			Field:   "git.replay_xp_rate",
+			Value:   c.Git.ReplayXPRate,
+			Message: "must be between 0 and 1",
+		}
+	}
+
+	// Validate Git.BotXPRate (should be between 0 and 1)
+	if c.Git.BotXPRate < 0 || c.Git.BotXPRate > 1 {
+		return ValidationError{
+			Field:   "git.bot_xp_rate",
+			Value:   c.Git.BotXPRate,
+			Message: "must be between 0 and 1",
+		}
+	}
+
+	// Validate Git.AuthorEmails (each must look like an email address)
+	for i, email := range c.Git.AuthorEmails {
+		if !strings.Contains(strings.TrimSpace(email), "@") {
+			return ValidationError{
+				Field:   fmt.Sprintf("git.author_emails[%d]", i),
+				Value:   email,
+				Message: "must be an email address",
+			}
+		}
+	}
+
+	// Validate Git.WatchMode and Git.WatchModes (unset in configs written before polling existed)
+	if c.Git.WatchMode != "" && !contains(WatchModes, c.Git.WatchMode) {
+		return ValidationError{
+			Field:   "git.watch_mode",
+			Value:   c.Git.WatchMode,
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(WatchModes, ", ")),
+		}
+	}
+	for path, mode := range c.Git.WatchModes {
+		if !contains(WatchModes, mode) {
+			return ValidationError{
+				Field:   fmt.Sprintf("git.watch_modes[%q]", path),
+				Value:   mode,
+				Message: fmt.Sprintf("must be one of: %s", strings.Join(WatchModes, ", ")),
+			}
+		}
+	}
+
 	// Validate Debug.LogLevel
 	validLogLevels := []string{"debug", "info", "warn", "error"}
 	if !contains(validLogLevels, c.Debug.LogLevel) {
@@ -77,6 +573,17 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate Debug.MetricsAddr (host:port when set)
+	if c.Debug.MetricsAddr != "" {
+		if _, _, err := net.SplitHostPort(c.Debug.MetricsAddr); err != nil {
+			return ValidationError{
+				Field:   "debug.metrics_addr",
+				Value:   c.Debug.MetricsAddr,
+				Message: "must be host:port, e.g. 127.0.0.1:9464",
+			}
+		}
+	}
+
 	// Validate Character.Name (must not be empty)
 	if strings.TrimSpace(c.Character.Name) == "" {
 		return ValidationError{
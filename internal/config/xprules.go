@@ -0,0 +1,281 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// XPRuleConfig is a custom XP rule ([[xp_rules]]): when every condition
+// holds for a commit, the commit's XP is multiplied, the bonus added and
+// the flags attached. Rules apply in config order, after the built-in XP
+// steps and before the daily budget.
+type XPRuleConfig struct {
+	Name       string   `toml:"name"`
+	When       []string `toml:"when"`       // conditions that must all hold, e.g. "repo in ~/work", "hour >= 9"
+	Multiplier float64  `toml:"multiplier"` // XP multiplier (0 or unset = unchanged)
+	Bonus      int      `toml:"bonus"`      // XP added after the multiplier (negative takes XP away)
+	Flags      []string `toml:"flags"`      // labels for matching commits ("review" holds the XP for review)
+}
+
+// XP rule field kinds: which operators a field takes and how its values
+// compare.
+const (
+	XPFieldPath    = "path"    // Repository path: = and != compare paths, in checks directories
+	XPFieldText    = "text"    // Case-insensitive text; matches takes "*" wildcards
+	XPFieldNumber  = "number"  // Whole number: =, !=, <, <=, >, >=
+	XPFieldWeekday = "weekday" // Day of the week: mon, tue, ... sun
+	XPFieldBool    = "bool"    // true or false
+)
+
+// XPRuleField describes a commit field XP rule conditions can test.
+type XPRuleField struct {
+	Name        string // Field name in conditions
+	Kind        string // XPField constants
+	Description string // What the field holds
+}
+
+// XPRuleFields lists the fields XP rule conditions can test.
+var XPRuleFields = []XPRuleField{
+	{Name: "repo", Kind: XPFieldPath, Description: "repository path (~ allowed)"},
+	{Name: "project", Kind: XPFieldText, Description: "project the repository belongs to"},
+	{Name: "hour", Kind: XPFieldNumber, Description: "hour of the commit, 0-23, in the home timezone"},
+	{Name: "weekday", Kind: XPFieldWeekday, Description: "day of the commit in the home timezone"},
+	{Name: "lines", Kind: XPFieldNumber, Description: "lines added and removed"},
+	{Name: "lines_added", Kind: XPFieldNumber, Description: "lines added"},
+	{Name: "lines_removed", Kind: XPFieldNumber, Description: "lines removed"},
+	{Name: "files", Kind: XPFieldNumber, Description: "files changed"},
+	{Name: "message", Kind: XPFieldText, Description: "subject line of the commit message"},
+	{Name: "author", Kind: XPFieldText, Description: "author name"},
+	{Name: "email", Kind: XPFieldText, Description: "author email"},
+	{Name: "bot", Kind: XPFieldBool, Description: "counted bot commit"},
+	{Name: "retroactive", Kind: XPFieldBool, Description: "replayed after downtime"},
+}
+
+// XPRuleFlagReview is the flag that holds a matching commit's XP for review.
+const XPRuleFlagReview = "review"
+
+// xpRuleOperators lists the operators each field kind takes.
+var xpRuleOperators = map[string][]string{
+	XPFieldPath:    {"=", "!=", "in"},
+	XPFieldText:    {"=", "!=", "in", "matches"},
+	XPFieldNumber:  {"=", "!=", "<", "<=", ">", ">="},
+	XPFieldWeekday: {"=", "!=", "in"},
+	XPFieldBool:    {"=", "!="},
+}
+
+// weekdays are the weekday values, Sunday first like time.Weekday.
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// XPCondition is one parsed XP rule condition: "<field> <operator> <value>".
+// "in" takes a comma-separated list (of directories, for repo).
+type XPCondition struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+// ParseXPCondition parses and checks an XP rule condition such as
+// "hour >= 9" or "message matches 'WIP*'". Quotes around the value are
+// optional.
+//
+// Parameters:
+//   - condition: The condition text
+//
+// Returns:
+//   - XPCondition: The parsed condition
+//   - error: Unknown field, operator not valid for the field, or a bad value
+func ParseXPCondition(condition string) (XPCondition, error) {
+	parts := strings.Fields(condition)
+	if len(parts) < 3 {
+		return XPCondition{}, fmt.Errorf("%q is not \"<field> <operator> <value>\"", condition)
+	}
+	value := strings.TrimSpace(condition)
+	for _, part := range parts[:2] {
+		value = strings.TrimSpace(strings.TrimPrefix(value, part))
+	}
+	value = strings.Trim(value, `"'`)
+	c := XPCondition{Field: strings.ToLower(parts[0]), Operator: strings.ToLower(parts[1]), Value: value}
+
+	field, ok := xpRuleField(c.Field)
+	if !ok {
+		return XPCondition{}, fmt.Errorf("unknown field %q", c.Field)
+	}
+	if !slices.Contains(xpRuleOperators[field.Kind], c.Operator) {
+		return XPCondition{}, fmt.Errorf("%s takes %s, not %q", c.Field, strings.Join(xpRuleOperators[field.Kind], " "), c.Operator)
+	}
+	for _, item := range c.values() {
+		if err := checkXPValue(field.Kind, item); err != nil {
+			return XPCondition{}, fmt.Errorf("%s: %w", c.Field, err)
+		}
+	}
+	return c, nil
+}
+
+// xpRuleField looks up a field by name.
+func xpRuleField(name string) (XPRuleField, bool) {
+	for _, field := range XPRuleFields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return XPRuleField{}, false
+}
+
+// checkXPValue checks a value can be compared with a field of the kind.
+func checkXPValue(kind, value string) error {
+	switch kind {
+	case XPFieldNumber:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%q is not a whole number", value)
+		}
+	case XPFieldWeekday:
+		if !slices.Contains(weekdays, weekdayName(value)) {
+			return fmt.Errorf("%q is not a weekday (mon, tue, ... sun)", value)
+		}
+	case XPFieldBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+	case XPFieldPath:
+		if _, err := ExpandPath(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// weekdayName shortens a weekday to its lowercase three-letter name.
+func weekdayName(day string) string {
+	day = strings.ToLower(strings.TrimSpace(day))
+	if len(day) > 3 {
+		day = day[:3]
+	}
+	return day
+}
+
+// values returns the condition's value, split into a list for "in".
+func (c XPCondition) values() []string {
+	if c.Operator != "in" {
+		return []string{c.Value}
+	}
+	var values []string
+	for _, item := range strings.Split(c.Value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// Matches reports whether a commit field value satisfies the condition.
+// Numbers are decimal, weekdays their English name or abbreviation,
+// booleans "true" or "false" and paths absolute.
+//
+// Parameters:
+//   - value: The commit's value for the condition's field
+//
+// Returns:
+//   - bool: True if the condition holds
+func (c XPCondition) Matches(value string) bool {
+	field, _ := xpRuleField(c.Field)
+	switch c.Operator {
+	case "!=":
+		return !XPCondition{Field: c.Field, Operator: "=", Value: c.Value}.Matches(value)
+	case "in":
+		return slices.ContainsFunc(c.values(), func(item string) bool {
+			if field.Kind == XPFieldPath {
+				dir, err := ExpandPath(item)
+				return err == nil && value != "" && pathWithin(NormalizePath(value), NormalizePath(dir))
+			}
+			return XPCondition{Field: c.Field, Operator: "=", Value: item}.Matches(value)
+		})
+	case "matches":
+		return matchWildcard(c.Value, value)
+	}
+
+	switch field.Kind {
+	case XPFieldNumber:
+		got, err1 := strconv.Atoi(value)
+		want, err2 := strconv.Atoi(c.Value)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		switch c.Operator {
+		case "<":
+			return got < want
+		case "<=":
+			return got <= want
+		case ">":
+			return got > want
+		case ">=":
+			return got >= want
+		}
+		return got == want
+	case XPFieldWeekday:
+		return weekdayName(value) == weekdayName(c.Value)
+	case XPFieldBool:
+		got, err1 := strconv.ParseBool(value)
+		want, err2 := strconv.ParseBool(c.Value)
+		return err1 == nil && err2 == nil && got == want
+	case XPFieldPath:
+		path, err := ExpandPath(c.Value)
+		return err == nil && value != "" && NormalizePath(value) == NormalizePath(path)
+	}
+	return strings.EqualFold(strings.TrimSpace(value), strings.TrimSpace(c.Value))
+}
+
+// Conditions parses the rule's conditions.
+//
+// Returns:
+//   - []XPCondition: The conditions, in order
+//   - error: The first condition that doesn't parse
+func (r XPRuleConfig) Conditions() ([]XPCondition, error) {
+	conditions := make([]XPCondition, 0, len(r.When))
+	for _, text := range r.When {
+		condition, err := ParseXPCondition(text)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// Matches reports whether every condition holds for a commit. A rule
+// without conditions matches every commit; one that doesn't parse matches
+// none (Validate reports it).
+//
+// Parameters:
+//   - fields: The commit's field values by field name (see XPRuleFields)
+//
+// Returns:
+//   - bool: True if the rule applies
+func (r XPRuleConfig) Matches(fields map[string]string) bool {
+	conditions, err := r.Conditions()
+	if err != nil {
+		return false
+	}
+	for _, condition := range conditions {
+		if !condition.Matches(fields[condition.Field]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies the rule's multiplier and bonus to XP, never going below 0.
+//
+// Parameters:
+//   - xp: XP before the rule
+//
+// Returns:
+//   - int: XP after the rule
+func (r XPRuleConfig) Apply(xp int) int {
+	if r.Multiplier > 0 {
+		xp = int(math.Round(float64(xp) * r.Multiplier))
+	}
+	return max(xp+r.Bonus, 0)
+}
@@ -0,0 +1,264 @@
+// Package crash writes diagnostics bundles when CodeQuest panics: the panic
+// and stack trace, recent log lines, version details and the config, with
+// secrets and personal details (home directory, user name, emails, hosts)
+// redacted so the bundle can be attached to a public GitHub issue.
+package crash
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// DirName is the bundle directory's name in the data directory.
+const DirName = "crashes"
+
+// IssuesURL is where crash reports are filed.
+const IssuesURL = "https://github.com/AutumnsGrove/codequest/issues/new"
+
+// redacted replaces secret and personal values.
+const redacted = "<redacted>"
+
+var (
+	// emailPattern matches email addresses in logs, stacks and config
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+	// secretKeyPattern matches config keys whose values are never included
+	// (webhook URLs carry their token in the path)
+	secretKeyPattern = regexp.MustCompile(`(?i)(token|secret|password|api_key|email|host|identity|gist|repo|user|webhook|url)`)
+)
+
+// LogRing keeps the most recent log lines in memory for crash bundles.
+// Use it as (part of) the log output; it's safe for concurrent use.
+type LogRing struct {
+	mu      sync.Mutex
+	lines   []string
+	max     int
+	partial string // Unterminated last line, completed by the next write
+}
+
+// NewLogRing creates a log ring keeping the last size lines.
+//
+// Parameters:
+//   - size: Lines to keep
+//
+// Returns:
+//   - *LogRing: An empty ring
+func NewLogRing(size int) *LogRing {
+	return &LogRing{max: size}
+}
+
+// Write records log output, keeping only the most recent lines.
+func (r *LogRing) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	text := r.partial + string(p)
+	lines := strings.Split(text, "\n")
+	r.partial = lines[len(lines)-1]
+	r.lines = append(r.lines, lines[:len(lines)-1]...)
+	if extra := len(r.lines) - r.max; extra > 0 {
+		r.lines = append([]string(nil), r.lines[extra:]...)
+	}
+	return len(p), nil
+}
+
+// Lines returns the recorded lines, oldest first.
+//
+// Returns:
+//   - []string: Recent log lines
+func (r *LogRing) Lines() []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lines := append([]string(nil), r.lines...)
+	if r.partial != "" {
+		lines = append(lines, r.partial)
+	}
+	return lines[max(len(lines)-r.max, 0):]
+}
+
+// Report is what a diagnostics bundle records about a crash.
+type Report struct {
+	Panic    string    // The panic value
+	Stack    string    // Stack trace of the panicking goroutine
+	Version  string    // CodeQuest version
+	Time     time.Time // When it crashed
+	Logs     []string  // Recent log lines
+	Config   string    // Config as TOML, already redacted (see RedactConfig)
+	Features []string  // Experimental features switched on (see config.EnabledFeatures)
+}
+
+// Summary is the panic's first line, for titles.
+//
+// Returns:
+//   - string: e.g. "runtime error: index out of range [3] with length 3"
+func (r Report) Summary() string {
+	summary, _, _ := strings.Cut(Anonymize(r.Panic), "\n")
+	return summary
+}
+
+// Markdown renders the bundle as a Markdown document, anonymized.
+//
+// Returns:
+//   - string: The bundle's contents
+func (r Report) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# CodeQuest crash report\n\n")
+	fmt.Fprintf(&b, "- Version: %s\n", r.Version)
+	fmt.Fprintf(&b, "- Go: %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "- Time: %s\n", r.Time.UTC().Format(time.RFC3339))
+	features := "none"
+	if len(r.Features) > 0 {
+		features = strings.Join(r.Features, ", ")
+	}
+	fmt.Fprintf(&b, "- Experimental features: %s\n\n", features)
+	fmt.Fprintf(&b, "## Panic\n\n```\n%s\n```\n\n", Anonymize(r.Panic))
+	fmt.Fprintf(&b, "## Stack trace\n\n```\n%s\n```\n\n", strings.TrimSpace(Anonymize(r.Stack)))
+	fmt.Fprintf(&b, "## Recent log lines\n\n```\n%s\n```\n\n", Anonymize(strings.Join(r.Logs, "\n")))
+	fmt.Fprintf(&b, "## Config (redacted)\n\n```toml\n%s\n```\n", strings.TrimSpace(r.Config))
+	return b.String()
+}
+
+// WriteBundle writes the report to a new file in the directory.
+//
+// Parameters:
+//   - dir: Bundle directory (created if missing)
+//   - report: The crash report
+//
+// Returns:
+//   - string: Path of the bundle
+//   - error: An error if it couldn't be written
+func WriteBundle(dir string, report Report) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create crash directory: %w", err)
+	}
+	path := filepath.Join(dir, "crash-"+report.Time.Format("20060102-150405")+".md")
+	if err := os.WriteFile(path, []byte(report.Markdown()), 0600); err != nil {
+		return "", fmt.Errorf("failed to write crash bundle: %w", err)
+	}
+	return path, nil
+}
+
+// BundleDir returns where crash bundles are written.
+//
+// Returns:
+//   - string: <data dir>/crashes
+//   - error: An error if the data directory can't be found
+func BundleDir() (string, error) {
+	dir, err := config.DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, DirName), nil
+}
+
+// RedactConfig renders the config as TOML with secret and personal values
+// (emails, hosts, tokens, repositories, gists) replaced and paths anonymized.
+//
+// Parameters:
+//   - cfg: The config (nil gives "")
+//
+// Returns:
+//   - string: The redacted TOML
+func RedactConfig(cfg *config.Config) string {
+	if cfg == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		return fmt.Sprintf("# config unavailable: %v", err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	for i, line := range lines {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(value) == `""` || strings.TrimSpace(value) == "[]" {
+			continue
+		}
+		if secretKeyPattern.MatchString(key) {
+			lines[i] = key + `= "` + redacted + `"`
+		}
+	}
+	return Anonymize(strings.Join(lines, "\n"))
+}
+
+// Anonymize replaces the home directory with ~, the user name with <user>
+// and emails with <email>.
+//
+// Parameters:
+//   - text: Text to anonymize
+//
+// Returns:
+//   - string: The anonymized text
+func Anonymize(text string) string {
+	if home, err := os.UserHomeDir(); err == nil && len(home) > 1 {
+		text = strings.ReplaceAll(text, home, "~")
+	}
+	if current, err := user.Current(); err == nil && len(current.Username) > 2 {
+		text = regexp.MustCompile(`\b`+regexp.QuoteMeta(current.Username)+`\b`).ReplaceAllString(text, "<user>")
+	}
+	return emailPattern.ReplaceAllString(text, "<email>")
+}
+
+// IssueURL builds a link to a new GitHub issue prefilled with the crash
+// summary. Files can't be attached through a link, so the body asks for the
+// bundle to be dragged in.
+//
+// Parameters:
+//   - report: The crash report
+//   - bundlePath: Where the bundle was written
+//
+// Returns:
+//   - string: The new-issue URL
+func IssueURL(report Report, bundlePath string) string {
+	body := fmt.Sprintf("CodeQuest %s crashed:\n\n```\n%s\n```\n\n"+
+		"**What were you doing?**\n\n\n"+
+		"_Please attach the diagnostics bundle by dragging it here: `%s` (secrets and personal details are redacted, but have a look first)._\n",
+		report.Version, report.Summary(), filepath.Base(bundlePath))
+	query := url.Values{
+		"title":  {"Crash: " + report.Summary()},
+		"body":   {body},
+		"labels": {"bug,crash"},
+	}
+	return IssuesURL + "?" + query.Encode()
+}
+
+// Open opens a file or URL with the system's default application.
+//
+// Parameters:
+//   - target: File path or URL
+//
+// Returns:
+//   - error: An error if the opener couldn't be started
+func Open(target string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", target)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", target)
+	default:
+		cmd = exec.Command("xdg-open", target)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to open %s: %w", target, err)
+	}
+	go cmd.Wait()
+	return nil
+}
@@ -0,0 +1,100 @@
+package crash
+
+import (
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestLogRing tests keeping only the most recent lines, across writes that
+// split lines.
+func TestLogRing(t *testing.T) {
+	ring := NewLogRing(3)
+	for _, write := range []string{"one\ntwo\n", "thr", "ee\nfour\n", "fi"} {
+		if _, err := ring.Write([]byte(write)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if got, want := ring.Lines(), []string{"three", "four", "fi"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %q, want %q", got, want)
+	}
+	if lines := (*LogRing)(nil).Lines(); lines != nil {
+		t.Errorf("nil Lines() = %q, want none", lines)
+	}
+}
+
+// TestRedactConfig tests that secret and personal values are left out of
+// the bundle while the rest of the config is kept.
+func TestRedactConfig(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+	cfg := config.DefaultConfig()
+	cfg.Git.AuthorEmails = []string{"me@example.com"}
+	cfg.Git.WatchPaths = []string{home + "/code/app"}
+	cfg.Storage.SSH.Host = "me@homeserver"
+	cfg.Discord.WebhookURL = "https://discord.com/api/webhooks/123/discord-webhook-token"
+	cfg.Habits.WebhookURL = "https://hooks.example.com/habits-webhook-token"
+	cfg.Game.Difficulty = "hard"
+
+	redactedConfig := RedactConfig(cfg)
+	for _, leaked := range []string{"me@example.com", "homeserver", home + "/", "discord-webhook-token", "habits-webhook-token"} {
+		if strings.Contains(redactedConfig, leaked) {
+			t.Errorf("redacted config contains %q", leaked)
+		}
+	}
+	for _, kept := range []string{`difficulty = "hard"`, "~/code/app", redacted} {
+		if !strings.Contains(redactedConfig, kept) {
+			t.Errorf("redacted config is missing %q", kept)
+		}
+	}
+}
+
+// TestWriteBundle tests the bundle's contents and the prefilled issue link.
+func TestWriteBundle(t *testing.T) {
+	report := Report{
+		Panic:    "runtime error: index out of range [3] with length 3",
+		Stack:    "goroutine 1 [running]:\nmain.main()",
+		Version:  "v1.2.3",
+		Time:     time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC),
+		Logs:     []string{"Commit by ada@example.com"},
+		Config:   `difficulty = "hard"`,
+		Features: []string{"ai_quests"},
+	}
+
+	path, err := WriteBundle(t.TempDir(), report)
+	if err != nil {
+		t.Fatalf("WriteBundle() error = %v", err)
+	}
+	if !strings.HasSuffix(path, "crash-20260304-050607.md") {
+		t.Errorf("bundle path = %s, want crash-20260304-050607.md", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	bundle := string(data)
+	for _, want := range []string{"v1.2.3", "index out of range", "main.main()", "Commit by <email>", `difficulty = "hard"`, "Experimental features: ai_quests"} {
+		if !strings.Contains(bundle, want) {
+			t.Errorf("bundle is missing %q", want)
+		}
+	}
+	if strings.Contains(bundle, "ada@example.com") {
+		t.Error("bundle contains an email address")
+	}
+
+	link, err := url.Parse(IssueURL(report, path))
+	if err != nil {
+		t.Fatalf("IssueURL() isn't a URL: %v", err)
+	}
+	query := link.Query()
+	if query.Get("title") != "Crash: "+report.Panic || !strings.Contains(query.Get("body"), "crash-20260304-050607.md") {
+		t.Errorf("issue title %q body %q, want the panic and the bundle name", query.Get("title"), query.Get("body"))
+	}
+}
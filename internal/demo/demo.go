@@ -0,0 +1,173 @@
+// Package demo builds the synthetic game behind `codequest demo`: a made-up
+// character with a few weeks of history, a quest log with one quest a few
+// commits from done, and a script of commits that arrive on timers. The
+// commits go through the real game handler, so the second levels the
+// character up and the third completes the quest, without any personal
+// repositories on screen (for README GIFs, screenshots and talks).
+package demo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Synthetic data shown by the demo.
+const (
+	CharacterName = "Nova"                     // Demo character's name
+	RepoPath      = "/demo/projects/starlight" // Repository the scripted commits come from (never read)
+	QuestTitle    = "Ship the Star Map"        // Quest the script completes
+
+	startLevel  = 4  // Character level when the demo starts
+	historyDays = 90 // Days of made-up commit history for the heatmap
+)
+
+// Step is one scripted event.
+type Step struct {
+	After time.Duration // Wait after the previous step
+	Event game.Event    // Event published (stamped with the time it's sent)
+}
+
+// commit describes a scripted commit.
+type commit struct {
+	after   time.Duration
+	sha     string
+	message string
+	added   int
+	removed int
+	paths   []string
+}
+
+// commits is the script: the second commit levels the character up and the
+// third completes QuestTitle.
+var commits = []commit{
+	{3 * time.Second, "3f9a1c2e7b", "feat: render constellations on the star map", 148, 12, []string{"starmap/render.go", "starmap/render_test.go"}},
+	{6 * time.Second, "8d04be51a6", "fix: keep the star map centred on resize", 36, 14, []string{"starmap/viewport.go"}},
+	{7 * time.Second, "c51e7f0d93", "docs: explain the star map controls", 42, 3, []string{"README.md", "docs/controls.md"}},
+	{8 * time.Second, "0b6d2a94fe", "refactor: share the star catalogue loader", 64, 71, []string{"catalogue/load.go", "starmap/render.go"}},
+}
+
+// Script returns the demo's scripted events.
+//
+// Returns:
+//   - []Step: Commits from RepoPath, a few seconds apart
+func Script() []Step {
+	steps := make([]Step, 0, len(commits))
+	for _, c := range commits {
+		event := game.NewCommitEvent(c.sha, c.message, len(c.paths), c.added, c.removed)
+		event.Data["repo_path"] = RepoPath
+		event.Data["file_paths"] = c.paths
+		steps = append(steps, Step{After: c.after, Event: event})
+	}
+	return steps
+}
+
+// Character returns the demo character: level 4 with a streak and a few
+// weeks of history, just short of level 5 so the script's second commit
+// levels it up.
+//
+// Parameters:
+//   - cfg: Configuration the demo runs with (for commit XP and streak days)
+//   - now: When the demo starts
+//
+// Returns:
+//   - *game.Character: The character
+func Character(cfg *config.Config, now time.Time) *game.Character {
+	character := game.NewCharacter(CharacterName)
+	for character.Level < startLevel {
+		character.AddXP(character.XPToNextLevel - character.XP)
+	}
+	character.CreatedAt = now.AddDate(0, 0, -historyDays)
+	character.CodePower, character.Wisdom, character.Agility = 18, 15, 16
+
+	// A made-up history: most days have a few commits, ending yesterday
+	clock := game.StreakClockFromConfig(cfg)
+	for day := historyDays; day >= 1; day-- {
+		if day%6 == 0 && day > 12 {
+			continue
+		}
+		at := now.AddDate(0, 0, -day)
+		count := day%4 + 1
+		character.RecordActivityDay(at, count, clock)
+		character.RecordRepoActivity(RepoPath, at, count, clock)
+		character.TotalCommits += count
+	}
+	character.TotalLinesAdded, character.TotalLinesRemoved = 18420, 6315
+	character.QuestsCompleted = 7
+	character.CurrentStreak, character.LongestStreak = 12, 19
+	character.LastActiveDate = now.AddDate(0, 0, -1)
+	character.Pet.LastCared = now
+
+	// Leave less XP to the next level than the first two commits earn
+	first := Script()[0].Event
+	first.Timestamp = now
+	firstXP := game.NewRewardEngine(cfg).CommitXP(first, character, now).Total
+	character.XP = max(character.XPToNextLevel-firstXP-1, 0)
+	return character
+}
+
+// Quests returns the demo quest log: the built-in quests, QuestTitle under
+// way (done after three commits) and a lines quest partway there.
+//
+// Parameters:
+//   - now: When the demo starts
+//
+// Returns:
+//   - []*game.Quest: The quests
+func Quests(now time.Time) []*game.Quest {
+	quests, _ := game.SeedQuestTemplates(nil)
+
+	ship := game.NewQuestBuilder().
+		Title(QuestTitle).
+		Description("Land three commits on the star map feature").
+		Type(game.QuestTypeCommit).
+		Target(3).
+		XPReward(250).
+		MustBuild()
+	_ = ship.StartAt(RepoPath, "", now.Add(-time.Hour))
+
+	lines := game.NewQuestBuilder().
+		Title("Thousand Stars").
+		Description("Change 1,000 lines of code").
+		Type(game.QuestTypeLines).
+		Target(1000).
+		XPReward(400).
+		MustBuild()
+	_ = lines.StartAt(RepoPath, "", now.AddDate(0, 0, -3))
+	lines.SetProgress(420)
+
+	return append([]*game.Quest{ship, lines}, quests...)
+}
+
+// Run publishes the script's events on their timers, each stamped with the
+// time it's sent.
+//
+// Parameters:
+//   - ctx: Stops the script early when cancelled
+//   - bus: Event bus the game handler and UI listen on
+//   - script: Steps to play
+//   - speed: Playback speed (2 plays twice as fast)
+//
+// Returns:
+//   - error: ctx's error if it was cancelled before the script finished
+func Run(ctx context.Context, bus *game.EventBus, script []Step, speed float64) error {
+	if speed <= 0 {
+		return fmt.Errorf("demo speed must be positive (got %g)", speed)
+	}
+	for _, step := range script {
+		timer := time.NewTimer(time.Duration(float64(step.After) / speed))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		event := step.Event
+		event.Timestamp = time.Now()
+		bus.Publish(event)
+	}
+	return nil
+}
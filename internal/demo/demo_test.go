@@ -0,0 +1,114 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+)
+
+// TestScriptPlaysThroughGame tests that the script's commits level the demo
+// character up on the second commit and complete the demo quest on the third.
+func TestScriptPlaysThroughGame(t *testing.T) {
+	cfg := config.DefaultConfig()
+	now := time.Now()
+	character := Character(cfg, now)
+	quests := Quests(now)
+
+	client, err := storage.NewLocalClient(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalClient() error = %v", err)
+	}
+	bus := game.NewEventBus()
+	handler, err := game.NewGameEventHandler(character, quests, bus, client, cfg)
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+
+	// Which commit each level-up and quest completion followed (subscribed
+	// before the handler, so commits are counted before they're handled)
+	commitsSeen := 0
+	var levelUps, questsDone []int
+	bus.Subscribe(game.EventCommit, func(game.Event) { commitsSeen++ })
+	bus.Subscribe(game.EventLevelUp, func(game.Event) { levelUps = append(levelUps, commitsSeen) })
+	bus.Subscribe(game.EventQuestDone, func(event game.Event) {
+		if event.Data["quest_title"] == QuestTitle {
+			questsDone = append(questsDone, commitsSeen)
+		}
+	})
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	script := Script()
+	for i := range script {
+		script[i].After = 0
+	}
+	if err := Run(context.Background(), bus, script, 1); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if commitsSeen != len(script) {
+		t.Errorf("commits = %d, want %d", commitsSeen, len(script))
+	}
+	if len(levelUps) != 1 || levelUps[0] != 2 {
+		t.Errorf("level-ups after commits %v, want [2]", levelUps)
+	}
+	if len(questsDone) != 1 || questsDone[0] != 3 {
+		t.Errorf("%s completed after commits %v, want [3]", QuestTitle, questsDone)
+	}
+	if got := handler.GetCharacter().Level; got != startLevel+1 {
+		t.Errorf("level = %d, want %d", got, startLevel+1)
+	}
+}
+
+// TestRun tests stopping the script and rejecting bad speeds.
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		speed     float64
+		cancelled bool
+		wantErr   error
+		wantSent  int
+	}{
+		{name: "plays every step", speed: 1000, wantSent: 2},
+		{name: "cancelled", speed: 1, cancelled: true, wantErr: context.Canceled, wantSent: 0},
+		{name: "zero speed", speed: 0, wantSent: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := game.NewEventBus()
+			sent := 0
+			bus.Subscribe(game.EventCommit, func(game.Event) { sent++ })
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+			script := []Step{
+				{After: time.Millisecond, Event: game.NewCommitEvent("aaaaaaaaaa", "one", 1, 1, 0)},
+				{After: time.Millisecond, Event: game.NewCommitEvent("bbbbbbbbbb", "two", 1, 1, 0)},
+			}
+
+			err := Run(ctx, bus, script, tt.speed)
+			switch {
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("Run() error = %v, want %v", err, tt.wantErr)
+			case tt.speed <= 0 && err == nil:
+				t.Error("Run() error = nil, want an error for a bad speed")
+			case tt.speed > 0 && tt.wantErr == nil && err != nil:
+				t.Errorf("Run() error = %v", err)
+			}
+			if sent != tt.wantSent {
+				t.Errorf("sent %d events, want %d", sent, tt.wantSent)
+			}
+		})
+	}
+}
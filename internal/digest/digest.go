@@ -0,0 +1,133 @@
+// Package digest composes the optional weekly digest: the past week's stats,
+// achievements and upcoming challenges as an HTML email, for players who
+// like a record of their progress outside the app. The digest is sent over
+// SMTP, or written as an .eml file when no mail server is configured.
+package digest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// maxUpcoming is how many upcoming challenges the digest lists.
+const maxUpcoming = 5
+
+// Digest is everything the weekly email shows.
+type Digest struct {
+	Name        string    // Character name
+	Week        string    // ISO week key (e.g. "2025-W10")
+	From        time.Time // First day covered
+	To          time.Time // Last day covered (today)
+	GeneratedAt time.Time // When the digest was composed
+
+	Summary       game.WeeklySummary // Commits, active days, quests, level and streak
+	XP            int                // XP toward the next level
+	XPToNextLevel int                // XP the next level needs
+	LongestStreak int                // Best streak ever
+
+	Achievements []Achievement // What was earned this week
+	Upcoming     []Challenge   // Active quests first, then ones ready to start
+}
+
+// Achievement is something earned during the week.
+type Achievement struct {
+	Icon string // Emoji for the kind of achievement
+	Text string // One-line description
+}
+
+// Challenge is a quest in progress or ready to start.
+type Challenge struct {
+	Title    string
+	Type     game.QuestType
+	Current  int  // Progress so far
+	Target   int  // Progress needed
+	XPReward int  // XP for completing it
+	Active   bool // Whether it's already under way
+}
+
+// Percent returns how much of the challenge is done (0-100).
+func (c Challenge) Percent() int {
+	if c.Target <= 0 {
+		return 0
+	}
+	return min(c.Current*100/c.Target, 100)
+}
+
+// Build composes the digest for the week up to now.
+//
+// Parameters:
+//   - character: The player's character
+//   - quests: All quests
+//   - clock: Streak day boundaries (decide which days the week covers)
+//   - now: Current time
+//
+// Returns:
+//   - Digest: The week's digest
+func Build(character *game.Character, quests []*game.Quest, clock game.StreakClock, now time.Time) Digest {
+	since := game.WeekStart(now, clock)
+	return Digest{
+		Name:          character.Name,
+		Week:          game.WeekKey(now, clock),
+		From:          since,
+		To:            clock.Day(now),
+		GeneratedAt:   now,
+		Summary:       character.SummarizeWeek(quests, now, clock),
+		XP:            character.XP,
+		XPToNextLevel: character.XPToNextLevel,
+		LongestStreak: character.LongestStreak,
+		Achievements:  achievements(character, quests, since),
+		Upcoming:      upcoming(character, quests),
+	}
+}
+
+// achievements lists the quests completed, trophies claimed and personal
+// records set since the start of the week.
+func achievements(character *game.Character, quests []*game.Quest, since time.Time) []Achievement {
+	var list []Achievement
+	for _, quest := range quests {
+		if quest.Status == game.QuestCompleted && quest.CompletedAt != nil && !quest.CompletedAt.Before(since) {
+			list = append(list, Achievement{Icon: "🏆", Text: fmt.Sprintf("Completed %s (+%d XP)", quest.Title, quest.XPReward)})
+		}
+	}
+	for _, item := range character.Items {
+		if !item.AcquiredAt.Before(since) {
+			list = append(list, Achievement{Icon: "🎁", Text: "Claimed " + item.Name})
+		}
+	}
+
+	records := character.Records
+	for _, record := range []struct {
+		kind  game.RecordKind
+		at    time.Time
+		value string
+	}{
+		{game.RecordDayXP, records.MostXPDate, fmt.Sprintf("%d XP", records.MostXPInDay)},
+		{game.RecordLongestSession, records.LongestSessionAt, game.FormatRecordDuration(records.LongestSession)},
+		{game.RecordBiggestCommit, records.BiggestCommitAt, fmt.Sprintf("%d lines", records.BiggestCommit)},
+		{game.RecordFastestQuest, records.FastestQuestAt, game.FormatRecordDuration(records.FastestQuest)},
+	} {
+		if !record.at.IsZero() && !record.at.Before(since) {
+			list = append(list, Achievement{Icon: "📈", Text: fmt.Sprintf("New record - %s: %s", game.RecordLabel(record.kind), record.value)})
+		}
+	}
+	return list
+}
+
+// upcoming lists active quests, then quests the character can start.
+func upcoming(character *game.Character, quests []*game.Quest) []Challenge {
+	var active, available []Challenge
+	for _, quest := range quests {
+		challenge := Challenge{Title: quest.Title, Type: quest.Type, Current: quest.Current, Target: quest.Target, XPReward: quest.XPReward}
+		switch {
+		case quest.Status == game.QuestActive:
+			challenge.Active = true
+			active = append(active, challenge)
+		case quest.IsAvailable(character):
+			available = append(available, challenge)
+		}
+	}
+	list := append(active, available...)
+	return list[:min(len(list), maxUpcoming)]
+}
@@ -0,0 +1,103 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// testState returns a character and quests with a bit of everything the
+// digest shows: a completed quest, a trophy and a record from this week,
+// an active quest and one ready to start.
+func testState(t *testing.T, now time.Time, clock game.StreakClock) (*game.Character, []*game.Quest) {
+	t.Helper()
+	character := game.NewCharacter("Ada")
+	character.CurrentStreak = 5
+	character.RecordActivityDay(now, 4, clock)
+	character.RecordActivityDay(now.AddDate(0, 0, -2), 3, clock)
+	character.RecordActivityDay(now.AddDate(0, 0, -10), 9, clock) // Last week
+	character.Items = []game.Item{{Name: "Golden Keyboard", AcquiredAt: now.Add(-time.Hour)}, {Name: "Old Mug", AcquiredAt: now.AddDate(0, 0, -30)}}
+	character.Records.BiggestCommit = 420
+	character.Records.BiggestCommitAt = now.Add(-2 * time.Hour)
+
+	completedAt := now.Add(-24 * time.Hour)
+	done := game.NewQuest("Bug Squasher", "", game.QuestTypeCommit, 5, 150, 1)
+	done.Status = game.QuestCompleted
+	done.CompletedAt = &completedAt
+
+	active := game.NewQuest("Line Lord", "", game.QuestTypeLines, 500, 200, 1)
+	if err := active.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	active.Current = 250
+
+	ready := game.NewQuest("Test Tamer", "", game.QuestTypeTests, 3, 100, 1)
+	locked := game.NewQuest("Legend", "", game.QuestTypeCommit, 100, 1000, 50)
+	return character, []*game.Quest{done, active, ready, locked}
+}
+
+// TestBuild tests the week's stats, achievements and upcoming challenges.
+func TestBuild(t *testing.T) {
+	now := time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC) // a Monday
+	clock := game.StreakClock{Location: time.UTC}
+	character, quests := testState(t, now, clock)
+
+	d := Build(character, quests, clock, now)
+
+	if d.Week != "2025-W24" || !d.From.Equal(time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Week = %s from %v, want 2025-W24 from June 3", d.Week, d.From)
+	}
+	if d.Summary.Commits != 7 || d.Summary.ActiveDays != 2 || d.Summary.QuestsCompleted != 1 || d.Summary.Streak != 5 {
+		t.Errorf("Summary = %+v, want 7 commits on 2 days, 1 quest, 5-day streak", d.Summary)
+	}
+
+	var achievements []string
+	for _, achievement := range d.Achievements {
+		achievements = append(achievements, achievement.Text)
+	}
+	want := []string{"Completed Bug Squasher (+150 XP)", "Claimed Golden Keyboard", "New record - Biggest commit: 420 lines"}
+	if strings.Join(achievements, "|") != strings.Join(want, "|") {
+		t.Errorf("Achievements = %q, want %q", achievements, want)
+	}
+
+	if len(d.Upcoming) != 2 || d.Upcoming[0].Title != "Line Lord" || !d.Upcoming[0].Active || d.Upcoming[1].Title != "Test Tamer" || d.Upcoming[1].Active {
+		t.Errorf("Upcoming = %+v, want the active quest then the available one", d.Upcoming)
+	}
+	if got := d.Upcoming[0].Percent(); got != 50 {
+		t.Errorf("Percent() = %d, want 50", got)
+	}
+}
+
+// TestCompose tests the email's headers and HTML body.
+func TestCompose(t *testing.T) {
+	now := time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC)
+	clock := game.StreakClock{Location: time.UTC}
+	character, quests := testState(t, now, clock)
+	character.Name = "Zoë"
+
+	msg, err := Compose(Build(character, quests, clock, now), "", "ada@example.com")
+	if err != nil {
+		t.Fatalf("Compose() error = %v", err)
+	}
+	text := string(msg)
+
+	headers, body, found := strings.Cut(text, "\r\n\r\n")
+	if !found {
+		t.Fatal("message has no blank line between headers and body")
+	}
+	for _, want := range []string{"From: " + fallbackSender, "To: ada@example.com", "Subject: =?utf-8?q?", "Date: Mon, 09 Jun 2025 09:00:00 +0000", `Content-Type: text/html; charset="utf-8"`} {
+		if !strings.Contains(headers, want) {
+			t.Errorf("headers should contain %q:\n%s", want, headers)
+		}
+	}
+	for _, want := range []string{"Bug Squasher", "Line Lord", "Golden Keyboard", "ready to start"} {
+		if !strings.Contains(strings.ReplaceAll(body, "=\r\n", ""), want) {
+			t.Errorf("body should contain %q", want)
+		}
+	}
+	if strings.Contains(strings.ReplaceAll(text, "\r\n", ""), "\n") {
+		t.Error("message should use CRLF line endings throughout")
+	}
+}
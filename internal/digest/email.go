@@ -0,0 +1,192 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// fallbackSender is the From address of .eml files written with no
+// addresses configured.
+const fallbackSender = "codequest@localhost"
+
+// sendMail delivers a message over SMTP (replaced in tests).
+var sendMail = smtp.SendMail
+
+// emailTemplate is the digest email body. Styles are inline-friendly and
+// there are no scripts or remote images, so mail clients show it as is.
+var emailTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>CodeQuest weekly digest</title>
+</head>
+<body style="background: #1a1b26; color: #c0caf5; font-family: ui-monospace, Menlo, Consolas, monospace; margin: 0; padding: 2rem;">
+<div style="max-width: 40rem; margin: 0 auto;">
+<h1 style="color: #bb9af7; margin-bottom: 0.25rem;">⚔️ {{.Name}}'s week</h1>
+<p style="color: #565f89; margin-top: 0;">{{.From.Format "Mon Jan 2"}} – {{.To.Format "Mon Jan 2, 2006"}} · {{.Week}}</p>
+
+<h2 style="color: #7aa2f7; font-size: 1rem;">📊 Stats</h2>
+<table style="border-collapse: collapse;">
+  <tr><td style="padding: 0.25rem 1rem 0.25rem 0;">Commits</td><td style="color: #e0af68;"><strong>{{.Summary.Commits}}</strong> on {{.Summary.ActiveDays}} of 7 days</td></tr>
+  <tr><td style="padding: 0.25rem 1rem 0.25rem 0;">Quests completed</td><td style="color: #e0af68;"><strong>{{.Summary.QuestsCompleted}}</strong></td></tr>
+  <tr><td style="padding: 0.25rem 1rem 0.25rem 0;">Level</td><td style="color: #e0af68;"><strong>{{.Summary.Level}}</strong> ({{.XP}}/{{.XPToNextLevel}} XP to next level)</td></tr>
+  <tr><td style="padding: 0.25rem 1rem 0.25rem 0;">Streak</td><td style="color: #e0af68;"><strong>{{.Summary.Streak}} 🔥</strong> (best {{.LongestStreak}})</td></tr>
+</table>
+
+<h2 style="color: #7aa2f7; font-size: 1rem;">🏅 Achievements</h2>
+{{- if .Achievements}}
+<ul style="padding-left: 1.25rem;">
+{{- range .Achievements}}
+  <li>{{.Icon}} {{.Text}}</li>
+{{- end}}
+</ul>
+{{- else}}
+<p style="color: #565f89;">Nothing new this week - next week is a fresh start.</p>
+{{- end}}
+
+<h2 style="color: #7aa2f7; font-size: 1rem;">🎯 Upcoming challenges</h2>
+{{- if .Upcoming}}
+{{- range .Upcoming}}
+<p style="margin: 0.75rem 0 0.25rem;"><strong>{{.Title}}</strong> <span style="color: #565f89;">{{.Type}} · {{.XPReward}} XP{{if .Active}} · {{.Current}}/{{.Target}}{{else}} · ready to start{{end}}</span></p>
+<div style="background: #24283b; border-radius: 0.25rem; height: 0.5rem;"><div style="background: #7aa2f7; border-radius: 0.25rem; height: 0.5rem; width: {{.Percent}}%;"></div></div>
+{{- end}}
+{{- else}}
+<p style="color: #565f89;">No quests waiting - open the Quest Board to find one.</p>
+{{- end}}
+
+<p style="color: #565f89; font-size: 0.8rem; margin-top: 2rem;">Sent by CodeQuest on {{.GeneratedAt.Format "Jan 2, 2006 15:04"}}. Turn the digest off with enabled = false in the [digest] config section.</p>
+</div>
+</body>
+</html>
+`))
+
+// RenderHTML renders the digest as a self-contained HTML page.
+//
+// Parameters:
+//   - d: The digest
+//
+// Returns:
+//   - []byte: The HTML document
+//   - error: An error if rendering fails
+func RenderHTML(d Digest) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := emailTemplate.Execute(&buf, d); err != nil {
+		return nil, fmt.Errorf("rendering digest: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Compose builds the complete email (headers and quoted-printable HTML
+// body) with CRLF line endings, ready to send or save as an .eml file.
+//
+// Parameters:
+//   - d: The digest
+//   - from: Sender address ("" = fallbackSender)
+//   - to: Recipient address ("" leaves the To header out)
+//
+// Returns:
+//   - []byte: The RFC 5322 message
+//   - error: An error if rendering fails
+func Compose(d Digest, from, to string) ([]byte, error) {
+	html, err := RenderHTML(d)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		from = fallbackSender
+	}
+
+	var msg bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&msg, "%s: %s\r\n", name, value)
+	}
+	header("From", from)
+	if to != "" {
+		header("To", to)
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", fmt.Sprintf("CodeQuest weekly digest for %s (%s)", d.Name, d.Week)))
+	header("Date", d.GeneratedAt.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `text/html; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "quoted-printable")
+	msg.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&msg)
+	if _, err := body.Write(html); err != nil {
+		return nil, fmt.Errorf("encoding digest: %w", err)
+	}
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("encoding digest: %w", err)
+	}
+	return msg.Bytes(), nil
+}
+
+// Deliver sends the digest over SMTP, or writes it as an .eml file when no
+// SMTP host is configured. The SMTP password is read from the environment
+// variable the settings name.
+//
+// Parameters:
+//   - d: The digest
+//   - settings: Digest settings (addresses, mail server, output directory)
+//
+// Returns:
+//   - string: Where the digest went (the recipient or the file written)
+//   - error: An error if composing, sending or writing fails
+func Deliver(d Digest, settings config.DigestConfig) (string, error) {
+	settings = settings.WithDefaults()
+	msg, err := Compose(d, settings.From, settings.To)
+	if err != nil {
+		return "", err
+	}
+
+	if settings.SMTP.Host == "" {
+		return writeEML(msg, d.Week, settings.OutputDir)
+	}
+
+	smtpSettings := settings.SMTP
+	var auth smtp.Auth
+	if smtpSettings.Username != "" {
+		auth = smtp.PlainAuth("", smtpSettings.Username, os.Getenv(smtpSettings.PasswordEnv), smtpSettings.Host)
+	}
+	addr := net.JoinHostPort(smtpSettings.Host, strconv.Itoa(smtpSettings.Port))
+	if err := sendMail(addr, auth, settings.From, []string{settings.To}, msg); err != nil {
+		return "", fmt.Errorf("sending digest to %s via %s: %w", settings.To, addr, err)
+	}
+	return settings.To, nil
+}
+
+// writeEML saves the message as codequest-digest-<week>.eml in dir (""
+// uses digests/ in the data directory), replacing an earlier one for the
+// same week.
+func writeEML(msg []byte, week, dir string) (string, error) {
+	if dir == "" {
+		dataDir, err := config.DataDir()
+		if err != nil {
+			return "", fmt.Errorf("finding digest directory: %w", err)
+		}
+		dir = filepath.Join(dataDir, "digests")
+	}
+	dir, err := config.ExpandPath(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid digest.output_dir: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating digest directory: %w", err)
+	}
+
+	path := filepath.Join(dir, "codequest-digest-"+week+".eml")
+	if err := os.WriteFile(path, msg, 0600); err != nil {
+		return "", fmt.Errorf("writing digest: %w", err)
+	}
+	return path, nil
+}
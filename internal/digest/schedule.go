@@ -0,0 +1,110 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// stateFile remembers the week of the last digest sent, in the data directory.
+const stateFile = "digest-week"
+
+// Store loads the game state the digest is built from.
+type Store interface {
+	LoadCharacter() (*game.Character, error)
+	LoadQuests() ([]*game.Quest, error)
+}
+
+// Due reports whether this week's digest should go out: once per week, on
+// the configured weekday or, if CodeQuest wasn't open that day, on a later
+// day of the same week (weeks start on Monday).
+//
+// Parameters:
+//   - settings: Digest settings (the send day)
+//   - lastWeek: Week key of the last digest sent ("" if none)
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - bool: True if the digest should be sent now
+func Due(settings config.DigestConfig, lastWeek string, now time.Time, clock game.StreakClock) bool {
+	if game.WeekKey(now, clock) == lastWeek {
+		return false
+	}
+	sendDay, _ := settings.SendDay()
+	mondayFirst := func(day time.Weekday) int { return (int(day) + 6) % 7 }
+	return mondayFirst(clock.Day(now).Weekday()) >= mondayFirst(sendDay)
+}
+
+// SendIfDue builds and delivers this week's digest when it's due, and
+// remembers the week so it's only sent once. Register it with the
+// day-rollover scheduler so it runs at startup and each new day.
+//
+// Parameters:
+//   - settings: Digest settings
+//   - store: Where the character and quests are loaded from
+//   - clock: Streak day boundaries
+//   - now: Current time
+//
+// Returns:
+//   - string: Where the digest went ("" if it wasn't due)
+//   - error: An error if loading, delivering or recording the digest fails
+func SendIfDue(settings config.DigestConfig, store Store, clock game.StreakClock, now time.Time) (string, error) {
+	dataDir, err := config.DataDir()
+	if err != nil {
+		return "", fmt.Errorf("finding digest state: %w", err)
+	}
+	return sendIfDue(settings, store, filepath.Join(dataDir, stateFile), clock, now)
+}
+
+// sendIfDue is SendIfDue with the state file's path given.
+func sendIfDue(settings config.DigestConfig, store Store, statePath string, clock game.StreakClock, now time.Time) (string, error) {
+	lastWeek, err := readLastWeek(statePath)
+	if err != nil {
+		return "", err
+	}
+	if !Due(settings, lastWeek, now, clock) {
+		return "", nil
+	}
+
+	character, err := store.LoadCharacter()
+	if err != nil {
+		return "", fmt.Errorf("loading character: %w", err)
+	}
+	quests, err := store.LoadQuests()
+	if err != nil {
+		return "", fmt.Errorf("loading quests: %w", err)
+	}
+
+	d := Build(character, quests, clock, now)
+	sentTo, err := Deliver(d, settings)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		return sentTo, fmt.Errorf("recording digest week: %w", err)
+	}
+	if err := os.WriteFile(statePath, []byte(d.Week+"\n"), 0644); err != nil {
+		return sentTo, fmt.Errorf("recording digest week: %w", err)
+	}
+	return sentTo, nil
+}
+
+// readLastWeek reads the week of the last digest sent ("" if none yet).
+func readLastWeek(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading digest state: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
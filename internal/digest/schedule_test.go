@@ -0,0 +1,107 @@
+package digest
+
+import (
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// memoryStore serves a fixed character and quests.
+type memoryStore struct {
+	character *game.Character
+	quests    []*game.Quest
+}
+
+func (s memoryStore) LoadCharacter() (*game.Character, error) { return s.character, nil }
+func (s memoryStore) LoadQuests() ([]*game.Quest, error)      { return s.quests, nil }
+
+// TestDue tests the send day and once-per-week rule.
+func TestDue(t *testing.T) {
+	clock := game.StreakClock{Location: time.UTC}
+	wednesday := time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC) // 2025-W24
+
+	tests := []struct {
+		name     string
+		weekday  string
+		lastWeek string
+		want     bool
+	}{
+		{"default monday, later in the week", "", "", true},
+		{"send day today", "wednesday", "2025-W23", true},
+		{"send day still to come", "friday", "2025-W23", false},
+		{"already sent this week", "monday", "2025-W24", false},
+		{"sunday is the end of the week", "Sunday", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := config.DigestConfig{Weekday: tt.weekday}
+			if got := Due(settings, tt.lastWeek, wednesday, clock); got != tt.want {
+				t.Errorf("Due() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSendIfDue tests writing an .eml file once per week, and sending over
+// SMTP when a server is set.
+func TestSendIfDue(t *testing.T) {
+	now := time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC)
+	clock := game.StreakClock{Location: time.UTC}
+	character, quests := testState(t, now, clock)
+	store := memoryStore{character: character, quests: quests}
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state", stateFile)
+
+	t.Run("eml file", func(t *testing.T) {
+		settings := config.DigestConfig{Enabled: true, OutputDir: filepath.Join(dir, "out")}
+		path, err := sendIfDue(settings, store, statePath, clock, now)
+		if err != nil {
+			t.Fatalf("sendIfDue() error = %v", err)
+		}
+		if want := filepath.Join(dir, "out", "codequest-digest-2025-W24.eml"); path != want {
+			t.Errorf("digest written to %q, want %q", path, want)
+		}
+		if data, err := os.ReadFile(path); err != nil || !strings.Contains(string(data), "MIME-Version: 1.0") {
+			t.Errorf("eml file = %q (%v), want a MIME message", data, err)
+		}
+
+		again, err := sendIfDue(settings, store, statePath, clock, now.Add(time.Hour))
+		if err != nil || again != "" {
+			t.Errorf("second sendIfDue() = %q, %v; want nothing sent", again, err)
+		}
+	})
+
+	t.Run("smtp", func(t *testing.T) {
+		t.Setenv("DIGEST_TEST_PASSWORD", "hunter2")
+		var gotAddr, gotFrom string
+		var gotTo []string
+		sendMail = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+			gotAddr, gotFrom, gotTo = addr, from, to
+			if auth == nil {
+				t.Error("a username should authenticate")
+			}
+			return nil
+		}
+		defer func() { sendMail = smtp.SendMail }()
+
+		settings := config.DigestConfig{
+			Enabled: true,
+			To:      "ada@example.com",
+			SMTP:    config.DigestSMTPConfig{Host: "smtp.example.com", Username: "ada", PasswordEnv: "DIGEST_TEST_PASSWORD"},
+		}
+		sentTo, err := sendIfDue(settings, store, filepath.Join(dir, "smtp-state"), clock, now)
+		if err != nil {
+			t.Fatalf("sendIfDue() error = %v", err)
+		}
+		if sentTo != "ada@example.com" || gotAddr != "smtp.example.com:587" || gotFrom != "ada@example.com" || len(gotTo) != 1 {
+			t.Errorf("sent to %q via %s from %s to %v; want ada@example.com via port 587", sentTo, gotAddr, gotFrom, gotTo)
+		}
+	})
+}
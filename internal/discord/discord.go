@@ -0,0 +1,273 @@
+// Package discord shares quest completions and level-ups to a Discord
+// channel as rich embeds, through the channel's webhook. Posts are queued
+// and spaced out so bursts of events stay within Discord's webhook limits.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// requestTimeout bounds each post so a slow network can't stall the queue.
+const requestTimeout = 10 * time.Second
+
+// queueSize is how many embeds can wait to be posted; more are dropped.
+const queueSize = 20
+
+// Bounds on how long a rate-limited post waits before its retry
+const (
+	minRetryAfter = 250 * time.Millisecond
+	maxRetryAfter = time.Minute
+)
+
+// Embed colors (the app's Tokyo Night palette)
+const (
+	colorQuest   = 0x9ECE6A // Green
+	colorLevelUp = 0xBB9AF7 // Purple
+	colorTest    = 0x7AA2F7 // Blue
+)
+
+// Embed is a Discord rich embed.
+type Embed struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description,omitempty"`
+	Color       int         `json:"color"`
+	Timestamp   string      `json:"timestamp,omitempty"` // RFC 3339
+	Footer      EmbedFooter `json:"footer"`
+}
+
+// EmbedFooter is the small text under an embed.
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// webhookPayload is the JSON body posted to the webhook.
+type webhookPayload struct {
+	Username string  `json:"username"`
+	Embeds   []Embed `json:"embeds"`
+}
+
+// Notifier posts embeds to a Discord webhook.
+type Notifier struct {
+	settings   config.DiscordConfig
+	name       string       // Character name shown in embeds
+	httpClient *http.Client // Underlying HTTP client
+	logf       func(format string, args ...interface{})
+
+	queue    chan Embed    // Embeds waiting to be posted
+	interval time.Duration // Minimum time between posts
+
+	mu       sync.Mutex
+	lastPost time.Time // When the last post was sent
+}
+
+// New creates a notifier for the Discord settings.
+//
+// Parameters:
+//   - settings: Discord settings (webhook, event filter, rate limit)
+//   - name: Character name shown in embeds
+//   - logf: Printf-style logger for failed posts (e.g. log.Printf)
+//
+// Returns:
+//   - *Notifier: The notifier (call Run to start posting queued embeds)
+func New(settings config.DiscordConfig, name string, logf func(format string, args ...interface{})) *Notifier {
+	settings = settings.WithDefaults()
+	return &Notifier{
+		settings:   settings,
+		name:       name,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logf:       logf,
+		queue:      make(chan Embed, queueSize),
+		interval:   time.Duration(settings.MinIntervalSecs) * time.Second,
+	}
+}
+
+// EmbedForEvent builds the embed for a quest completion or level-up.
+//
+// Parameters:
+//   - event: An EventQuestDone or EventLevelUp event
+//   - name: Character name
+//
+// Returns:
+//   - Embed: The embed to post
+//   - bool: False for other events
+func EmbedForEvent(event game.Event, name string) (Embed, bool) {
+	embed := Embed{Footer: EmbedFooter{Text: "CodeQuest"}}
+	if !event.Timestamp.IsZero() {
+		embed.Timestamp = event.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	switch event.Type {
+	case game.EventQuestDone:
+		title, _ := event.Data["quest_title"].(string)
+		xp, _ := event.Data["xp_reward"].(int)
+		embed.Title = "🏆 Quest complete: " + title
+		embed.Color = colorQuest
+		if pending, _ := event.Data["reward_pending"].(bool); pending {
+			embed.Description = fmt.Sprintf("**%s** finished a quest and is picking a reward", name)
+		} else {
+			embed.Description = fmt.Sprintf("**%s** earned **%d XP**", name, xp)
+		}
+	case game.EventLevelUp:
+		oldLevel, _ := event.Data["old_level"].(int)
+		newLevel, _ := event.Data["new_level"].(int)
+		embed.Title = fmt.Sprintf("⬆️ Level %d!", newLevel)
+		embed.Color = colorLevelUp
+		embed.Description = fmt.Sprintf("**%s** leveled up from %d to %d", name, oldLevel, newLevel)
+	default:
+		return Embed{}, false
+	}
+	return embed, true
+}
+
+// Handler returns an event handler that queues embeds for the events the
+// settings share. When the queue is full the embed is dropped and logged,
+// so a burst of events never blocks the game.
+//
+// Returns:
+//   - game.EventHandler: The handler to subscribe to EventQuestDone and EventLevelUp
+func (n *Notifier) Handler() game.EventHandler {
+	return func(event game.Event) {
+		if !n.settings.Shares(string(event.Type)) {
+			return
+		}
+		embed, ok := EmbedForEvent(event, n.name)
+		if !ok {
+			return
+		}
+		select {
+		case n.queue <- embed:
+		default:
+			n.logf("Warning: Discord queue full, dropped %q", embed.Title)
+		}
+	}
+}
+
+// Run posts queued embeds, at most one per minimum interval, until the
+// context is cancelled.
+//
+// Parameters:
+//   - ctx: Context that stops the notifier
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case embed := <-n.queue:
+			if err := n.Post(ctx, embed); err != nil && ctx.Err() == nil {
+				n.logf("Warning: Failed to share %q to Discord: %v", embed.Title, err)
+			}
+		}
+	}
+}
+
+// SendTest posts a test embed, to check the webhook from Settings.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//
+// Returns:
+//   - error: An error if the post fails
+func (n *Notifier) SendTest(ctx context.Context) error {
+	return n.Post(ctx, Embed{
+		Title:       "👋 CodeQuest is connected",
+		Description: fmt.Sprintf("Quest completions and level-ups from **%s** will appear here", n.name),
+		Color:       colorTest,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Footer:      EmbedFooter{Text: "CodeQuest"},
+	})
+}
+
+// Post sends one embed, first waiting out the minimum interval since the
+// previous post. A rate-limited (429) post is retried once after the wait
+// Discord asks for.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - embed: The embed to post
+//
+// Returns:
+//   - error: An error if the post fails
+func (n *Notifier) Post(ctx context.Context, embed Embed) error {
+	if n.settings.WebhookURL == "" {
+		return fmt.Errorf("no Discord webhook configured (set discord.webhook_url)")
+	}
+	body, err := json.Marshal(webhookPayload{Username: "CodeQuest", Embeds: []Embed{embed}})
+	if err != nil {
+		return fmt.Errorf("encoding embed: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err := sleep(ctx, time.Until(n.lastPost.Add(n.interval))); err != nil {
+		return err
+	}
+	retryAfter, err := n.post(ctx, body)
+	if retryAfter > 0 {
+		if err := sleep(ctx, retryAfter); err != nil {
+			return err
+		}
+		_, err = n.post(ctx, body)
+	}
+	n.lastPost = time.Now()
+	return err
+}
+
+// post sends the payload once. A 429 response returns how long Discord
+// asks to wait.
+func (n *Notifier) post(ctx context.Context, body []byte) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.settings.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("posting to Discord: %w", err)
+	}
+	defer resp.Body.Close()
+
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		var limited struct {
+			RetryAfter float64 `json:"retry_after"` // Seconds
+		}
+		json.Unmarshal(detail, &limited)
+		wait := min(time.Duration(limited.RetryAfter*float64(time.Second)), maxRetryAfter)
+		return max(wait, minRetryAfter), fmt.Errorf("rate limited by Discord")
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return 0, fmt.Errorf("Discord returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return 0, nil
+}
+
+// sleep waits for d (nothing for d <= 0), or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestEmbedForEvent tests the embeds built for shared events.
+func TestEmbedForEvent(t *testing.T) {
+	pending := game.NewQuestRewardChoiceEvent("q1", "Big Refactor")
+
+	tests := []struct {
+		name      string
+		event     game.Event
+		wantOK    bool
+		wantTitle string
+		wantDesc  string
+	}{
+		{"quest done", game.NewQuestDoneEvent("q1", "Daily Grind", 120), true, "🏆 Quest complete: Daily Grind", "**Ada** earned **120 XP**"},
+		{"reward choice pending", pending, true, "🏆 Quest complete: Big Refactor", "**Ada** finished a quest and is picking a reward"},
+		{"level up", game.NewLevelUpEvent("c1", 5, 6), true, "⬆️ Level 6!", "**Ada** leveled up from 5 to 6"},
+		{"commit", game.NewCommitEvent("abc", "fix", 1, 1, 0), false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			embed, ok := EmbedForEvent(tt.event, "Ada")
+			if ok != tt.wantOK || embed.Title != tt.wantTitle || embed.Description != tt.wantDesc {
+				t.Errorf("EmbedForEvent() = %+v, %v; want %q / %q", embed, ok, tt.wantTitle, tt.wantDesc)
+			}
+			if ok && embed.Timestamp == "" {
+				t.Error("embeds should carry the event's timestamp")
+			}
+		})
+	}
+}
+
+// webhook is a fake Discord webhook that records posted embeds.
+type webhook struct {
+	mu        sync.Mutex
+	embeds    []Embed
+	limitNext bool // Answer the next post with 429
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.limitNext {
+		w.limitNext = false
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"message":"You are being rate limited.","retry_after":0.01}`))
+		return
+	}
+	var payload webhookPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.embeds = append(w.embeds, payload.Embeds...)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (w *webhook) titles() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var titles []string
+	for _, embed := range w.embeds {
+		titles = append(titles, embed.Title)
+	}
+	return titles
+}
+
+// TestNotifierFiltersAndPosts tests that only shared events are posted, in
+// order, spaced by the minimum interval.
+func TestNotifierFiltersAndPosts(t *testing.T) {
+	hook := &webhook{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	notifier := New(config.DiscordConfig{Enabled: true, WebhookURL: server.URL, Events: []string{config.DiscordEventLevelUp}}, "Ada", t.Logf)
+	notifier.interval = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go notifier.Run(ctx)
+
+	bus := game.NewEventBus()
+	bus.Subscribe(game.EventQuestDone, notifier.Handler())
+	bus.Subscribe(game.EventLevelUp, notifier.Handler())
+	bus.Publish(game.NewQuestDoneEvent("q1", "Daily Grind", 50))
+	bus.Publish(game.NewLevelUpEvent("c1", 1, 2))
+	bus.Publish(game.NewLevelUpEvent("c1", 2, 3))
+
+	start := time.Now()
+	for len(hook.titles()) < 2 && time.Since(start) < 2*time.Second {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := strings.Join(hook.titles(), ", "); got != "⬆️ Level 2!, ⬆️ Level 3!" {
+		t.Errorf("posted %q, want the two level-ups only", got)
+	}
+	if elapsed := time.Since(start); elapsed < notifier.interval {
+		t.Errorf("two posts took %v, want at least the %v interval between them", elapsed, notifier.interval)
+	}
+}
+
+// TestSendTestRetriesRateLimit tests that a rate-limited post is retried
+// after Discord's retry_after.
+func TestSendTestRetriesRateLimit(t *testing.T) {
+	hook := &webhook{limitNext: true}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	notifier := New(config.DiscordConfig{WebhookURL: server.URL}, "Ada", t.Logf)
+	if err := notifier.SendTest(context.Background()); err != nil {
+		t.Fatalf("SendTest() error = %v", err)
+	}
+	if titles := hook.titles(); len(titles) != 1 || !strings.Contains(titles[0], "connected") {
+		t.Errorf("posted %q, want the test embed once", titles)
+	}
+
+	if err := New(config.DiscordConfig{}, "Ada", t.Logf).SendTest(context.Background()); err == nil {
+		t.Error("SendTest() without a webhook should fail")
+	}
+}
@@ -0,0 +1,280 @@
+// Package eventlog writes game events as newline-delimited JSON: one compact
+// object per line with the event type, timestamp and data, so a player's
+// complete activity can be piped into jq, SQLite or observability tooling.
+// This file implements the optional analytics database: every event also
+// indexed in an embedded SQLite file, so the character sheet and CSV
+// exports can run aggregate queries instead of scanning the log (or making
+// one Skate call per key). SQLite comes from modernc.org/sqlite, which is
+// pure Go but large, so it's only linked into builds made with -tags sqlite
+// (see sqlite.go); other builds report ErrNoSQLite.
+package eventlog
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// AnalyticsFileName is the analytics database's name in the data directory.
+const AnalyticsFileName = "analytics.db"
+
+// sqliteDriver is the database/sql driver modernc.org/sqlite registers.
+const sqliteDriver = "sqlite"
+
+// ErrNoSQLite means this build has no SQLite driver linked in.
+var ErrNoSQLite = errors.New("this build has no SQLite support (rebuild with -tags sqlite)")
+
+// analyticsSchema creates the events table. Each event is stored once: the
+// same event imported from the log again is ignored.
+const analyticsSchema = `
+CREATE TABLE IF NOT EXISTS events (
+	id   INTEGER PRIMARY KEY,
+	type TEXT    NOT NULL,
+	at   INTEGER NOT NULL, -- Unix nanoseconds
+	day  TEXT    NOT NULL, -- Streak day (home timezone), YYYY-MM-DD
+	xp   INTEGER,          -- XP gained, for xp_changed events
+	data TEXT    NOT NULL, -- Event data as JSON
+	UNIQUE (type, at, data)
+);
+CREATE INDEX IF NOT EXISTS events_day ON events (day, type);`
+
+// Analytics is the SQLite analytics database. It's safe for concurrent use.
+type Analytics struct {
+	db    *sql.DB
+	clock game.StreakClock // Day boundaries for the day column
+}
+
+// EventCount is how many events of one type were recorded.
+type EventCount struct {
+	Type  game.EventType // Event type
+	Count int            // Events recorded
+}
+
+// DayXP is the XP gained on one day.
+type DayXP struct {
+	Day string // Streak day, YYYY-MM-DD
+	XP  int    // XP gained (less XP spent)
+}
+
+// OpenAnalytics opens (or creates) the analytics database.
+//
+// Parameters:
+//   - path: Database file (its directory is created if missing)
+//   - clock: Day boundaries in the home timezone, for per-day totals
+//
+// Returns:
+//   - *Analytics: The database
+//   - error: ErrNoSQLite without -tags sqlite, or an error if the file
+//     can't be opened or its schema created
+func OpenAnalytics(path string, clock game.StreakClock) (*Analytics, error) {
+	if !slices.Contains(sql.Drivers(), sqliteDriver) {
+		return nil, ErrNoSQLite
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("creating analytics directory: %w", err)
+	}
+
+	db, err := sql.Open(sqliteDriver, path)
+	if err != nil {
+		return nil, fmt.Errorf("opening analytics database: %w", err)
+	}
+	db.SetMaxOpenConns(1) // SQLite allows one writer at a time; queue instead of failing as busy
+	if _, err := db.Exec(analyticsSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating analytics schema: %w", err)
+	}
+	return &Analytics{db: db, clock: clock}, nil
+}
+
+// AnalyticsPathFromConfig returns the analytics database described by the
+// config. An empty path uses AnalyticsFileName in the data directory.
+//
+// Parameters:
+//   - cfg: Event log settings
+//
+// Returns:
+//   - string: Database file path
+//   - error: An error if the data directory can't be determined
+func AnalyticsPathFromConfig(cfg config.EventLogConfig) (string, error) {
+	if cfg.AnalyticsPath != "" {
+		return cfg.AnalyticsPath, nil
+	}
+	dir, err := config.DataDir()
+	if err != nil {
+		return "", fmt.Errorf("finding analytics directory: %w", err)
+	}
+	return filepath.Join(dir, AnalyticsFileName), nil
+}
+
+// Write stores an event.
+//
+// Parameters:
+//   - event: The event to store
+//
+// Returns:
+//   - error: An error if the event can't be encoded or stored
+func (a *Analytics) Write(event game.Event) error {
+	_, err := a.Import([]game.Event{event})
+	return err
+}
+
+// Import stores events in one transaction, skipping any already stored,
+// e.g. to backfill the database from the event log.
+//
+// Parameters:
+//   - events: Events to store
+//
+// Returns:
+//   - int: Events newly stored
+//   - error: An error if an event can't be encoded or stored (nothing is stored)
+func (a *Analytics) Import(events []game.Event) (int, error) {
+	tx, err := a.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("storing events: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`INSERT OR IGNORE INTO events (type, at, day, xp, data) VALUES (?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, fmt.Errorf("storing events: %w", err)
+	}
+	defer stmt.Close()
+
+	stored := 0
+	for _, event := range events {
+		data, err := json.Marshal(event.Data)
+		if err != nil {
+			return 0, fmt.Errorf("encoding %s event: %w", event.Type, err)
+		}
+		var xp sql.NullInt64
+		if entries := game.LedgerFromEvents([]game.Event{event}); len(entries) == 1 {
+			xp = sql.NullInt64{Int64: int64(entries[0].Amount), Valid: true}
+		}
+		day := a.clock.Day(event.Timestamp).Format(time.DateOnly)
+
+		result, err := stmt.Exec(string(event.Type), event.Timestamp.UnixNano(), day, xp, string(data))
+		if err != nil {
+			return 0, fmt.Errorf("storing %s event: %w", event.Type, err)
+		}
+		if n, err := result.RowsAffected(); err == nil {
+			stored += int(n)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("storing events: %w", err)
+	}
+	return stored, nil
+}
+
+// Middleware returns event bus middleware that stores every event. Failed
+// writes are logged with logf; the event is always passed on.
+//
+// Parameters:
+//   - logf: Printf-style logger for write errors (e.g. log.Printf)
+//
+// Returns:
+//   - game.EventMiddleware: The storing middleware
+func (a *Analytics) Middleware(logf func(format string, args ...interface{})) game.EventMiddleware {
+	return func(event game.Event) (game.Event, bool) {
+		if err := a.Write(event); err != nil {
+			logf("ERROR: Failed to store event for analytics: %v", err)
+		}
+		return event, true
+	}
+}
+
+// EventCounts counts the events of each type since a time, most frequent
+// first.
+//
+// Parameters:
+//   - since: Earliest event counted
+//
+// Returns:
+//   - []EventCount: Count per event type
+//   - error: An error if the query fails
+func (a *Analytics) EventCounts(since time.Time) ([]EventCount, error) {
+	rows, err := a.db.Query(`SELECT type, COUNT(*) FROM events WHERE at >= ? GROUP BY type ORDER BY COUNT(*) DESC, type`, since.UnixNano())
+	if err != nil {
+		return nil, fmt.Errorf("counting events: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []EventCount
+	for rows.Next() {
+		var count EventCount
+		if err := rows.Scan(&count.Type, &count.Count); err != nil {
+			return nil, fmt.Errorf("counting events: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+// XPByDay totals the XP gained on each day since a time, from the XP
+// ledger's xp_changed events, oldest first. Days without XP are left out.
+//
+// Parameters:
+//   - since: Earliest event counted
+//
+// Returns:
+//   - []DayXP: XP per day
+//   - error: An error if the query fails
+func (a *Analytics) XPByDay(since time.Time) ([]DayXP, error) {
+	rows, err := a.db.Query(`SELECT day, SUM(xp) FROM events WHERE xp IS NOT NULL AND at >= ? GROUP BY day ORDER BY day`, since.UnixNano())
+	if err != nil {
+		return nil, fmt.Errorf("totaling XP: %w", err)
+	}
+	defer rows.Close()
+
+	var days []DayXP
+	for rows.Next() {
+		var day DayXP
+		if err := rows.Scan(&day.Day, &day.XP); err != nil {
+			return nil, fmt.Errorf("totaling XP: %w", err)
+		}
+		days = append(days, day)
+	}
+	return days, rows.Err()
+}
+
+// WriteXPCSV writes XP per day as CSV with a day,xp header, for
+// spreadsheets and charting tools.
+//
+// Parameters:
+//   - w: Where to write the CSV
+//   - days: XP per day (see XPByDay)
+//
+// Returns:
+//   - error: An error if writing fails
+func WriteXPCSV(w io.Writer, days []DayXP) error {
+	out := csv.NewWriter(w)
+	out.Write([]string{"day", "xp"})
+	for _, day := range days {
+		out.Write([]string{day.Day, strconv.Itoa(day.XP)})
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	return nil
+}
+
+// Close closes the database.
+//
+// Returns:
+//   - error: An error if the database can't be closed
+func (a *Analytics) Close() error {
+	return a.db.Close()
+}
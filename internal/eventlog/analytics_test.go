@@ -0,0 +1,76 @@
+//go:build sqlite
+
+package eventlog
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestAnalyticsImport tests that imported events are stored once, and the
+// aggregate queries count them by type and total their XP by day.
+func TestAnalyticsImport(t *testing.T) {
+	analytics, err := OpenAnalytics(filepath.Join(t.TempDir(), AnalyticsFileName), game.StreakClock{Location: time.UTC})
+	if err != nil {
+		t.Fatalf("OpenAnalytics() error = %v", err)
+	}
+	defer analytics.Close()
+
+	at := func(day, hour int) time.Time { return time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC) }
+	xpChanged := func(amount, total int, ts time.Time) game.Event {
+		event := game.NewXPChangedEvent(amount, game.XPSourceCommit, total)
+		event.Timestamp = ts
+		return event
+	}
+	events := []game.Event{
+		xpChanged(10, 10, at(10, 9)),
+		xpChanged(20, 30, at(10, 17)),
+		xpChanged(5, 35, at(11, 9)),
+	}
+
+	stored, err := analytics.Import(events)
+	if err != nil || stored != 3 {
+		t.Fatalf("Import() = %d, %v, want 3 stored", stored, err)
+	}
+	// Importing the log again stores nothing new
+	if stored, err := analytics.Import(events); err != nil || stored != 0 {
+		t.Errorf("Import() again = %d, %v, want 0 stored", stored, err)
+	}
+
+	counts, err := analytics.EventCounts(at(1, 0))
+	if err != nil {
+		t.Fatalf("EventCounts() error = %v", err)
+	}
+	if len(counts) != 1 || counts[0].Type != game.EventXPChanged || counts[0].Count != 3 {
+		t.Errorf("EventCounts() = %+v, want 3 %s", counts, game.EventXPChanged)
+	}
+
+	tests := []struct {
+		name  string
+		since time.Time
+		want  []DayXP
+	}{
+		{"all days", at(1, 0), []DayXP{{"2025-03-10", 30}, {"2025-03-11", 5}}},
+		{"since the second day", at(11, 0), []DayXP{{"2025-03-11", 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, err := analytics.XPByDay(tt.since)
+			if err != nil {
+				t.Fatalf("XPByDay() error = %v", err)
+			}
+			if len(days) != len(tt.want) {
+				t.Fatalf("XPByDay() = %+v, want %+v", days, tt.want)
+			}
+			for i := range days {
+				if days[i] != tt.want[i] {
+					t.Errorf("XPByDay()[%d] = %+v, want %+v", i, days[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Package eventlog writes game events as newline-delimited JSON: one compact
+// object per line with the event type, timestamp and data, so a player's
+// complete activity can be piped into jq, SQLite or observability tooling.
+// The log is rotated by size (events.jsonl → events.jsonl.1 → ...).
+package eventlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// FileName is the log file's name in the data directory.
+const FileName = "events.jsonl"
+
+// Writer appends events to a log file, rotating it once it would grow past
+// the size limit. It's safe for concurrent use.
+type Writer struct {
+	path     string // Current log file
+	maxBytes int64  // Rotate before the file would grow past this size
+	maxFiles int    // Rotated files kept (path.1 is the newest)
+
+	mu   sync.Mutex
+	file *os.File // Open log file (nil after Close)
+	size int64    // Bytes in the open file
+}
+
+// Open opens (or creates) a log file for appending.
+//
+// Parameters:
+//   - path: Log file path (its directory is created if missing)
+//   - maxBytes: Rotate before the file would grow past this size
+//   - maxFiles: Rotated files to keep besides the current one
+//
+// Returns:
+//   - *Writer: The log writer
+//   - error: An error if the file can't be opened
+func Open(path string, maxBytes int64, maxFiles int) (*Writer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("creating event log directory: %w", err)
+	}
+	w := &Writer{path: path, maxBytes: maxBytes, maxFiles: maxFiles}
+	if err := w.openFile(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// OpenFromConfig opens the event log described by the config.
+//
+// Parameters:
+//   - cfg: Event log settings
+//
+// Returns:
+//   - *Writer: The log writer
+//   - error: An error if the data directory can't be found or the file opened
+func OpenFromConfig(cfg config.EventLogConfig) (*Writer, error) {
+	path, err := PathFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cfg = cfg.WithDefaults()
+	return Open(path, int64(cfg.MaxSizeMB)*1024*1024, cfg.MaxFiles)
+}
+
+// PathFromConfig returns the log file described by the config. An empty
+// path logs to FileName in the data directory.
+//
+// Parameters:
+//   - cfg: Event log settings
+//
+// Returns:
+//   - string: Log file path
+//   - error: An error if the data directory can't be found
+func PathFromConfig(cfg config.EventLogConfig) (string, error) {
+	if cfg.Path != "" {
+		return cfg.Path, nil
+	}
+	dir, err := config.DataDir()
+	if err != nil {
+		return "", fmt.Errorf("finding event log directory: %w", err)
+	}
+	return filepath.Join(dir, FileName), nil
+}
+
+// openFile opens the log file for appending and records its size.
+func (w *Writer) openFile() error {
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("opening event log: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("reading event log size: %w", err)
+	}
+	w.file, w.size = file, info.Size()
+	return nil
+}
+
+// Write appends one event as a line of JSON, rotating the file first if the
+// line would take it past the size limit.
+//
+// Parameters:
+//   - event: The event to log
+//
+// Returns:
+//   - error: An error if the event can't be encoded or written
+func (w *Writer) Write(event game.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding %s event: %w", event.Type, err)
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return errors.New("event log is closed")
+	}
+	if w.size > 0 && w.size+int64(len(line)) > w.maxBytes {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("writing event log: %w", err)
+	}
+	return nil
+}
+
+// rotate shifts path.N to path.N+1 (dropping the oldest), moves the current
+// file to path.1 and starts a new one. With no rotated files kept, the
+// current file is simply truncated.
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("closing event log: %w", err)
+	}
+	w.file = nil
+
+	if w.maxFiles <= 0 {
+		if err := os.Remove(w.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("truncating event log: %w", err)
+		}
+		return w.openFile()
+	}
+
+	os.Remove(w.rotatedPath(w.maxFiles))
+	for n := w.maxFiles - 1; n >= 1; n-- {
+		if err := os.Rename(w.rotatedPath(n), w.rotatedPath(n+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("rotating event log: %w", err)
+		}
+	}
+	if err := os.Rename(w.path, w.rotatedPath(1)); err != nil {
+		return fmt.Errorf("rotating event log: %w", err)
+	}
+	return w.openFile()
+}
+
+// rotatedPath returns the name of the nth rotated file.
+func (w *Writer) rotatedPath(n int) string {
+	return fmt.Sprintf("%s.%d", w.path, n)
+}
+
+// Middleware returns event bus middleware that logs every published event
+// (before any handler runs, so events appear in publish order) and passes
+// it on unchanged. Write errors are reported through logf and never stop
+// the event.
+//
+// Parameters:
+//   - logf: Printf-style logger for write errors (e.g. log.Printf)
+//
+// Returns:
+//   - game.EventMiddleware: The logging middleware
+func (w *Writer) Middleware(logf func(format string, args ...interface{})) game.EventMiddleware {
+	return func(event game.Event) (game.Event, bool) {
+		if err := w.Write(event); err != nil {
+			logf("ERROR: Failed to log event: %v", err)
+		}
+		return event, true
+	}
+}
+
+// ReadAll reads every event still on disk, oldest first: the rotated files
+// from the highest number down to path.1, then the current file. Missing
+// files are skipped, and so are lines that aren't valid events (a line cut
+// short by a crash, say).
+//
+// Parameters:
+//   - path: Current log file path
+//
+// Returns:
+//   - []game.Event: Logged events in publish order
+//   - error: An error if a file exists but can't be read
+func ReadAll(path string) ([]game.Event, error) {
+	rotated := 0
+	for {
+		if _, err := os.Stat(fmt.Sprintf("%s.%d", path, rotated+1)); err != nil {
+			break
+		}
+		rotated++
+	}
+
+	var events []game.Event
+	for n := rotated; n >= 0; n-- {
+		name := path
+		if n > 0 {
+			name = fmt.Sprintf("%s.%d", path, n)
+		}
+		file, err := os.Open(name)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("opening event log: %w", err)
+		}
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			var event game.Event
+			if json.Unmarshal(scanner.Bytes(), &event) == nil && event.Type != "" {
+				events = append(events, event)
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading event log: %w", err)
+		}
+	}
+	return events, nil
+}
+
+// Close closes the log file. Later writes fail.
+//
+// Returns:
+//   - error: An error if the file can't be closed
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
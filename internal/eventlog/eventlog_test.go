@@ -0,0 +1,234 @@
+package eventlog
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// readLines decodes every JSON line of a log file.
+func readLines(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening %s: %v", path, err)
+	}
+	defer file.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// TestMiddlewareLogsEvents tests that published events are logged in
+// publish order, one JSON object per line, and still delivered.
+func TestMiddlewareLogsEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", FileName)
+	w, err := Open(path, 1<<20, 3)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer w.Close()
+
+	bus := game.NewEventBus()
+	bus.Use(w.Middleware(t.Logf))
+	delivered := 0
+	bus.Subscribe(game.EventCommit, func(game.Event) {
+		delivered++
+		bus.Publish(game.NewLevelUpEvent("id", 1, 2)) // Secondary event from a handler
+	})
+
+	bus.Publish(game.NewCommitEvent("abc123", "Add parser", 2, 30, 4))
+
+	if delivered != 1 {
+		t.Errorf("delivered %d commit events, want 1", delivered)
+	}
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("logged %d lines, want 2", len(lines))
+	}
+	if lines[0]["type"] != "commit" || lines[1]["type"] != "level_up" {
+		t.Errorf("logged types %v, %v; want commit then level_up", lines[0]["type"], lines[1]["type"])
+	}
+	data, _ := lines[0]["data"].(map[string]interface{})
+	if data["sha"] != "abc123" || data["lines_added"] != float64(30) || lines[0]["timestamp"] == "" {
+		t.Errorf("commit line = %v, want its data and timestamp", lines[0])
+	}
+}
+
+// TestWriterRotates tests that the log rotates by size and keeps only the
+// configured number of rotated files.
+func TestWriterRotates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, FileName)
+	event := game.NewLevelUpEvent("id", 1, 2)
+	line, _ := json.Marshal(event)
+
+	// Room for two lines per file
+	w, err := Open(path, int64(2*(len(line)+1)), 2)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	for i := 0; i < 7; i++ {
+		if err := w.Write(event); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	w.Close()
+
+	if got := len(readLines(t, path)); got != 1 {
+		t.Errorf("current file has %d lines, want 1", got)
+	}
+	for _, rotated := range []string{path + ".1", path + ".2"} {
+		if got := len(readLines(t, rotated)); got != 2 {
+			t.Errorf("%s has %d lines, want 2", filepath.Base(rotated), got)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("only two rotated files should be kept")
+	}
+
+	// Reopening appends to the existing file
+	w, err = Open(path, 1<<20, 2)
+	if err != nil {
+		t.Fatalf("reopen error = %v", err)
+	}
+	w.Write(event)
+	w.Close()
+	if got := len(readLines(t, path)); got != 2 {
+		t.Errorf("after reopening, current file has %d lines, want 2", got)
+	}
+	if err := w.Write(event); err == nil {
+		t.Error("writing after Close should fail")
+	}
+}
+
+// TestReadAll tests reading events back across rotated files, oldest first.
+func TestReadAll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	// Fixed timestamps keep every line the same length, so rotation after
+	// two lines is exact
+	at := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	xpChanged := func(total int) game.Event {
+		event := game.NewXPChangedEvent(10, game.XPSourceCommit, total)
+		event.Timestamp = at
+		return event
+	}
+	line, _ := json.Marshal(xpChanged(10))
+
+	w, err := Open(path, int64(2*(len(line)+1)), 3)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	for total := 10; total <= 50; total += 10 {
+		if err := w.Write(xpChanged(total)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	w.Close()
+
+	// A line cut short by a crash is skipped
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	file.WriteString(`{"type":"xp_cha` + "\n")
+	file.Close()
+
+	events, err := ReadAll(path)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	entries := game.LedgerFromEvents(events)
+	if len(entries) != 5 {
+		t.Fatalf("ReadAll() found %d ledger entries, want 5", len(entries))
+	}
+	for i, entry := range entries {
+		if want := (i + 1) * 10; entry.TotalXP != want {
+			t.Errorf("entry %d total = %d, want %d", i, entry.TotalXP, want)
+		}
+	}
+
+	if events, err := ReadAll(filepath.Join(t.TempDir(), FileName)); err != nil || len(events) != 0 {
+		t.Errorf("ReadAll() on a missing log = %d events, %v, want none", len(events), err)
+	}
+}
+
+// TestAnalyticsNeedsSQLite tests that builds without -tags sqlite report
+// ErrNoSQLite instead of failing to open the database.
+func TestAnalyticsNeedsSQLite(t *testing.T) {
+	if slices.Contains(sql.Drivers(), sqliteDriver) {
+		t.Skip("built with SQLite")
+	}
+	path := filepath.Join(t.TempDir(), AnalyticsFileName)
+	if _, err := OpenAnalytics(path, game.StreakClock{}); !errors.Is(err, ErrNoSQLite) {
+		t.Errorf("OpenAnalytics() error = %v, want ErrNoSQLite", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("OpenAnalytics() created %s without SQLite", path)
+	}
+}
+
+// TestAnalyticsPathFromConfig tests that a configured analytics path is
+// used as is, and an empty one falls back to the data directory.
+func TestAnalyticsPathFromConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"configured", "/tmp/stats.db", "/tmp/stats.db"},
+		{"default", "", AnalyticsFileName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AnalyticsPathFromConfig(config.EventLogConfig{AnalyticsPath: tt.path})
+			if err != nil {
+				t.Fatalf("AnalyticsPathFromConfig() error = %v", err)
+			}
+			if got != tt.want && filepath.Base(got) != tt.want {
+				t.Errorf("AnalyticsPathFromConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWriteXPCSV tests that XP per day is exported with a header row, one
+// row per day.
+func TestWriteXPCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		days []DayXP
+		want string
+	}{
+		{"no days", nil, "day,xp\n"},
+		{"days", []DayXP{{"2025-03-10", 30}, {"2025-03-11", -5}}, "day,xp\n2025-03-10,30\n2025-03-11,-5\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			if err := WriteXPCSV(&out, tt.days); err != nil {
+				t.Fatalf("WriteXPCSV() error = %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("WriteXPCSV() = %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+//go:build sqlite
+
+// This file links the pure-Go SQLite driver into builds made with
+// -tags sqlite, enabling the analytics database.
+
+package eventlog
+
+import (
+	_ "modernc.org/sqlite" // Registers the "sqlite" database/sql driver
+)
@@ -0,0 +1,36 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import "time"
+
+// activityDayFormat keys ActivityDays (and other daily rollups) by calendar date
+const activityDayFormat = "2006-01-02"
+
+// RecordActivityDay adds commits to the streak day they count toward, for
+// the dashboard's streak heatmap. Old days are dropped by PruneHistory.
+//
+// Parameters:
+//   - at: When the commits were made
+//   - commits: Number of commits
+//   - clock: Streak clock (home timezone and grace window)
+func (c *Character) RecordActivityDay(at time.Time, commits int, clock StreakClock) {
+	if commits <= 0 {
+		return
+	}
+	if c.ActivityDays == nil {
+		c.ActivityDays = make(map[string]int)
+	}
+
+	c.ActivityDays[clock.Day(at).Format(activityDayFormat)] += commits
+}
+
+// CommitsOnDay returns the commits recorded for a streak day.
+//
+// Parameters:
+//   - day: The streak day (as returned by StreakClock.Day)
+//
+// Returns:
+//   - int: Commits made that day
+func (c *Character) CommitsOnDay(day time.Time) int {
+	return c.ActivityDays[day.Format(activityDayFormat)]
+}
@@ -0,0 +1,24 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRecordActivityDay tests per-day commit counts for the streak heatmap.
+func TestRecordActivityDay(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	day := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	c.RecordActivityDay(day, 2, clock)
+	c.RecordActivityDay(day.Add(2*time.Hour), 1, clock)
+	c.RecordActivityDay(day, 0, clock)
+
+	if got := c.CommitsOnDay(clock.Day(day)); got != 3 {
+		t.Errorf("CommitsOnDay() = %d, want 3", got)
+	}
+	if got := c.CommitsOnDay(clock.Day(day.AddDate(0, 0, -1))); got != 0 {
+		t.Errorf("CommitsOnDay(previous day) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,282 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements anomaly detection for commit XP: commits with an
+// absurd line count, days far above the player's usual commit pace, and
+// diffs identical to a recent one are flagged, and their XP is held until
+// the player accepts or discards it.
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+const (
+	// anomalyBaselineDays is how many days before today the daily commit
+	// baseline covers
+	anomalyBaselineDays = 30
+
+	// minBaselineDays is how many active days the baseline needs before
+	// bursts are judged, so new players aren't flagged on their first busy day
+	minBaselineDays = 7
+
+	// minBurstCommits is the fewest commits a day can be flagged at, so a
+	// very steady history doesn't turn an ordinary busy day into a burst
+	minBurstCommits = 10
+
+	// recentDiffLimit is how many diff fingerprints are remembered for
+	// repeated diff detection
+	recentDiffLimit = 50
+)
+
+// ErrFlaggedXPNotFound is returned when resolving flagged XP that isn't held.
+var ErrFlaggedXPNotFound = errors.New("flagged XP not found")
+
+// AnomalyKind names a rule that flagged a commit.
+type AnomalyKind string
+
+const (
+	AnomalyHugeCommit   AnomalyKind = "huge_commit"   // Too many lines changed in one commit
+	AnomalyBurst        AnomalyKind = "burst"         // Far more commits today than usual
+	AnomalyRepeatedDiff AnomalyKind = "repeated_diff" // Same change as a recent commit
+	AnomalyXPRule       AnomalyKind = "xp_rule"       // A custom XP rule flagged it for review
+)
+
+// Anomaly is one reason a commit was flagged.
+type Anomaly struct {
+	Kind   AnomalyKind `json:"kind"`   // Rule that fired
+	Detail string      `json:"detail"` // Explanation for the review modal
+}
+
+// AnomalyRules holds the thresholds commits are checked against.
+// A zero AnomalyRules disables detection.
+type AnomalyRules struct {
+	Enabled        bool        // Whether commits are checked at all
+	MaxCommitLines int         // Lines changed in one commit before it's flagged
+	BurstSigma     float64     // Standard deviations above the daily average before a day is a burst
+	Clock          StreakClock // Decides which day a commit counts toward
+}
+
+// AnomalyRulesFromConfig builds the anomaly rules from the config, filling
+// in defaults for unset thresholds.
+//
+// Parameters:
+//   - cfg: Application config (nil disables detection)
+//
+// Returns:
+//   - AnomalyRules: The rules to check commits with
+func AnomalyRulesFromConfig(cfg *config.Config) AnomalyRules {
+	if cfg == nil || !cfg.Anomaly.Enabled {
+		return AnomalyRules{}
+	}
+	anomaly := cfg.Anomaly.WithDefaults()
+	return AnomalyRules{
+		Enabled:        true,
+		MaxCommitLines: anomaly.MaxCommitLines,
+		BurstSigma:     anomaly.BurstSigma,
+		Clock:          StreakClockFromConfig(cfg),
+	}
+}
+
+// CommitSample is what anomaly detection knows about a commit.
+type CommitSample struct {
+	Lines    int       // Lines changed (added + removed)
+	Commits  int       // Commits the event covers
+	DiffHash string    // Fingerprint of the changed lines ("" if unknown)
+	At       time.Time // When the commit was made
+}
+
+// FlaggedXP is commit XP held back until the player reviews it.
+type FlaggedXP struct {
+	ID        string    `json:"id"`         // Unique identifier (UUID)
+	SHA       string    `json:"sha"`        // Flagged commit
+	Message   string    `json:"message"`    // First line of the commit message
+	XP        int       `json:"xp"`         // XP the commit would have awarded
+	Anomalies []Anomaly `json:"anomalies"`  // Why it was flagged
+	FlaggedAt time.Time `json:"flagged_at"` // When it was held
+
+	// Budget - What the award took from the daily XP budget, refunded on discard
+	BudgetXP  int       `json:"budget_xp,omitempty"`  // Commit XP counted against the day's cap
+	RestedXP  int       `json:"rested_xp,omitempty"`  // Rested XP paid out of the pool
+	BudgetDay time.Time `json:"budget_day,omitempty"` // Budget day it was counted on
+	Commits   int       `json:"commits,omitempty"`    // Commits counted toward diminishing returns (0 = 1, for older entries)
+}
+
+// DetectAnomalies checks a commit against the rules and remembers its diff
+// fingerprint for later repeat checks.
+//
+// Rules:
+//  1. Huge commit: more lines changed than MaxCommitLines
+//  2. Burst: today's commits (including this one) more than BurstSigma
+//     standard deviations above the average active day of the last 30 days
+//  3. Repeated diff: the same changes as one of the last 50 commits
+//
+// Parameters:
+//   - sample: The commit to check
+//   - rules: Thresholds to check against
+//
+// Returns:
+//   - []Anomaly: Why the commit looks suspicious (empty if it doesn't)
+func (c *Character) DetectAnomalies(sample CommitSample, rules AnomalyRules) []Anomaly {
+	if !rules.Enabled {
+		return nil
+	}
+
+	var anomalies []Anomaly
+	if rules.MaxCommitLines > 0 && sample.Lines > rules.MaxCommitLines {
+		anomalies = append(anomalies, Anomaly{
+			Kind:   AnomalyHugeCommit,
+			Detail: fmt.Sprintf("%d lines changed (limit %d)", sample.Lines, rules.MaxCommitLines),
+		})
+	}
+
+	today := rules.Clock.Day(sample.At)
+	commitsToday := c.ActivityDays[today.Format(activityDayFormat)] + max(1, sample.Commits)
+	if threshold, ok := c.burstThreshold(today, rules.BurstSigma); ok && float64(commitsToday) > threshold {
+		anomalies = append(anomalies, Anomaly{
+			Kind:   AnomalyBurst,
+			Detail: fmt.Sprintf("%d commits today (usually at most %.0f)", commitsToday, math.Floor(threshold)),
+		})
+	}
+
+	if sample.DiffHash != "" {
+		for _, hash := range c.RecentDiffs {
+			if hash == sample.DiffHash {
+				anomalies = append(anomalies, Anomaly{
+					Kind:   AnomalyRepeatedDiff,
+					Detail: "same changes as a recent commit",
+				})
+				break
+			}
+		}
+		c.RecentDiffs = append(c.RecentDiffs, sample.DiffHash)
+		if extra := len(c.RecentDiffs) - recentDiffLimit; extra > 0 {
+			c.RecentDiffs = append([]string(nil), c.RecentDiffs[extra:]...)
+		}
+	}
+
+	return anomalies
+}
+
+// burstThreshold returns the most commits a day can have before it's a
+// burst: the mean of the active days before today plus sigma standard
+// deviations, and never below minBurstCommits. There is no threshold until
+// minBaselineDays active days are on record.
+func (c *Character) burstThreshold(today time.Time, sigma float64) (float64, bool) {
+	if sigma <= 0 {
+		return 0, false
+	}
+
+	var counts []float64
+	for day := 1; day <= anomalyBaselineDays; day++ {
+		if commits := c.ActivityDays[today.AddDate(0, 0, -day).Format(activityDayFormat)]; commits > 0 {
+			counts = append(counts, float64(commits))
+		}
+	}
+	if len(counts) < minBaselineDays {
+		return 0, false
+	}
+
+	var sum float64
+	for _, count := range counts {
+		sum += count
+	}
+	mean := sum / float64(len(counts))
+	var variance float64
+	for _, count := range counts {
+		variance += (count - mean) * (count - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(counts)))
+
+	return math.Max(mean+sigma*stddev, minBurstCommits), true
+}
+
+// HoldXP puts a flagged commit's XP on hold for review. The XP has already
+// been through the daily budget (see ApplyXPBudget), so the held entry
+// remembers what the award took from it for DiscardFlaggedXP to give back.
+//
+// Parameters:
+//   - sha: Flagged commit
+//   - message: Commit message
+//   - budget: The commit's award after the daily budget
+//   - anomalies: Why it was flagged
+//   - now: Current time
+//
+// Returns:
+//   - FlaggedXP: The held entry
+func (c *Character) HoldXP(sha, message string, budget XPBudgetResult, anomalies []Anomaly, now time.Time) FlaggedXP {
+	flagged := FlaggedXP{
+		ID:        generateID(),
+		SHA:       sha,
+		Message:   strings.TrimSpace(strings.SplitN(message, "\n", 2)[0]),
+		XP:        budget.Awarded,
+		Anomalies: anomalies,
+		FlaggedAt: now,
+		BudgetXP:  budget.Awarded - budget.RestBonus,
+		RestedXP:  budget.RestBonus,
+		BudgetDay: c.BudgetDate,
+		Commits:   budget.Commits,
+	}
+	c.FlaggedXP = append(c.FlaggedXP, flagged)
+	return flagged
+}
+
+// AcceptFlaggedXP awards held XP after review. The XP was counted against
+// the daily cap when it was held, so it's awarded as held, without going
+// through the budget again.
+//
+// Parameters:
+//   - id: Flagged entry to accept
+//
+// Returns:
+//   - FlaggedXP: The accepted entry
+//   - bool: True if the XP caused a level-up
+//   - error: ErrFlaggedXPNotFound if nothing is held under id
+func (c *Character) AcceptFlaggedXP(id string) (FlaggedXP, bool, error) {
+	flagged, err := c.removeFlaggedXP(id)
+	if err != nil {
+		return FlaggedXP{}, false, err
+	}
+	return flagged, c.AddXP(flagged.XP), nil
+}
+
+// DiscardFlaggedXP drops held XP after review, giving back what it took
+// from the daily budget: rested XP returns to the pool, and on the same
+// budget day the XP and the commits it covered stop counting toward the cap
+// and diminishing returns. The commit's other stats (totals, streak, quest
+// progress) are kept.
+//
+// Parameters:
+//   - id: Flagged entry to discard
+//
+// Returns:
+//   - FlaggedXP: The discarded entry
+//   - error: ErrFlaggedXPNotFound if nothing is held under id
+func (c *Character) DiscardFlaggedXP(id string) (FlaggedXP, error) {
+	flagged, err := c.removeFlaggedXP(id)
+	if err != nil {
+		return FlaggedXP{}, err
+	}
+
+	c.RestBonusXP += flagged.RestedXP
+	if flagged.BudgetXP > 0 && !c.BudgetDate.IsZero() && sameDay(c.BudgetDate, flagged.BudgetDay) {
+		c.TodayCommitXP = max(c.TodayCommitXP-flagged.BudgetXP, 0)
+		c.TodayXPCommits = max(c.TodayXPCommits-max(flagged.Commits, 1), 0)
+	}
+	return flagged, nil
+}
+
+// removeFlaggedXP takes an entry off the held list.
+func (c *Character) removeFlaggedXP(id string) (FlaggedXP, error) {
+	for i, flagged := range c.FlaggedXP {
+		if flagged.ID == id {
+			c.FlaggedXP = append(c.FlaggedXP[:i:i], c.FlaggedXP[i+1:]...)
+			return flagged, nil
+		}
+	}
+	return FlaggedXP{}, ErrFlaggedXPNotFound
+}
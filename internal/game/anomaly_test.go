@@ -0,0 +1,279 @@
+package game
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestDetectAnomalies tests flagging huge commits, bursts above the daily
+// baseline and repeated diffs.
+func TestDetectAnomalies(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	rules := AnomalyRules{Enabled: true, MaxCommitLines: 1000, BurstSigma: 3, Clock: StreakClock{Location: time.UTC}}
+
+	// withBaseline gives the character a steady 4-6 commits a day for days
+	withBaseline := func(days int) *Character {
+		c := NewCharacter("Tester")
+		for day := 1; day <= days; day++ {
+			c.RecordActivityDay(now.AddDate(0, 0, -day), 4+day%3, rules.Clock)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		character *Character
+		rules     AnomalyRules
+		todayBusy int
+		sample    CommitSample
+		want      []AnomalyKind
+	}{
+		{
+			name:      "ordinary commit",
+			character: withBaseline(20),
+			rules:     rules,
+			sample:    CommitSample{Lines: 120, Commits: 1, DiffHash: "fresh", At: now},
+		},
+		{
+			name:      "absurd line count",
+			character: withBaseline(20),
+			rules:     rules,
+			sample:    CommitSample{Lines: 25000, Commits: 1, At: now},
+			want:      []AnomalyKind{AnomalyHugeCommit},
+		},
+		{
+			name:      "burst far above the baseline",
+			character: withBaseline(20),
+			rules:     rules,
+			todayBusy: 30,
+			sample:    CommitSample{Lines: 10, Commits: 1, At: now},
+			want:      []AnomalyKind{AnomalyBurst},
+		},
+		{
+			name:      "busy day without enough history",
+			character: withBaseline(3),
+			rules:     rules,
+			todayBusy: 30,
+			sample:    CommitSample{Lines: 10, Commits: 1, At: now},
+		},
+		{
+			name:      "repeated diff",
+			character: &Character{RecentDiffs: []string{"abc", "seen"}},
+			rules:     rules,
+			sample:    CommitSample{Lines: 10, Commits: 1, DiffHash: "seen", At: now},
+			want:      []AnomalyKind{AnomalyRepeatedDiff},
+		},
+		{
+			name:      "detection off",
+			character: &Character{RecentDiffs: []string{"seen"}},
+			sample:    CommitSample{Lines: 25000, Commits: 1, DiffHash: "seen", At: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.todayBusy > 0 {
+				tt.character.RecordActivityDay(now, tt.todayBusy, rules.Clock)
+			}
+			got := tt.character.DetectAnomalies(tt.sample, tt.rules)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DetectAnomalies() = %+v, want kinds %v", got, tt.want)
+			}
+			for i, anomaly := range got {
+				if anomaly.Kind != tt.want[i] || anomaly.Detail == "" {
+					t.Errorf("anomaly %d = %+v, want kind %s with a detail", i, anomaly, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestDetectAnomaliesRemembersDiffs tests that the same diff is flagged the
+// second time it's seen, and that only recent diffs are remembered.
+func TestDetectAnomaliesRemembersDiffs(t *testing.T) {
+	rules := AnomalyRules{Enabled: true, Clock: StreakClock{Location: time.UTC}}
+	c := NewCharacter("Tester")
+	sample := CommitSample{Lines: 5, Commits: 1, DiffHash: "same", At: time.Now()}
+
+	if got := c.DetectAnomalies(sample, rules); len(got) != 0 {
+		t.Fatalf("first sighting = %+v, want no anomalies", got)
+	}
+	if got := c.DetectAnomalies(sample, rules); len(got) != 1 || got[0].Kind != AnomalyRepeatedDiff {
+		t.Fatalf("second sighting = %+v, want a repeated diff", got)
+	}
+
+	for i := 0; i < recentDiffLimit; i++ {
+		c.DetectAnomalies(CommitSample{Commits: 1, DiffHash: string(rune('a' + i)), At: time.Now()}, rules)
+	}
+	if len(c.RecentDiffs) != recentDiffLimit {
+		t.Errorf("remembered %d diffs, want %d", len(c.RecentDiffs), recentDiffLimit)
+	}
+	if got := c.DetectAnomalies(sample, rules); len(got) != 0 {
+		t.Errorf("forgotten diff = %+v, want no anomalies", got)
+	}
+}
+
+// TestFlaggedXPReview tests holding, accepting and discarding flagged XP.
+func TestFlaggedXPReview(t *testing.T) {
+	c := NewCharacter("Tester")
+	now := time.Now()
+	anomalies := []Anomaly{{Kind: AnomalyHugeCommit, Detail: "too big"}}
+
+	accepted := c.HoldXP("sha1", "Vendor everything\n\nDetails", XPBudgetResult{Awarded: 150}, anomalies, now)
+	discarded := c.HoldXP("sha2", "Again", XPBudgetResult{Awarded: 60}, anomalies, now)
+	if accepted.Message != "Vendor everything" || len(c.FlaggedXP) != 2 {
+		t.Fatalf("held %+v (%d held), want first line of the message and 2 held", accepted, len(c.FlaggedXP))
+	}
+
+	flagged, leveledUp, err := c.AcceptFlaggedXP(accepted.ID)
+	if err != nil || flagged.XP != 150 || !leveledUp || c.Level != 2 {
+		t.Errorf("AcceptFlaggedXP() = %+v, %v, %v at level %d; want 150 XP and a level-up", flagged, leveledUp, err, c.Level)
+	}
+
+	xp := c.XP
+	if _, err := c.DiscardFlaggedXP(discarded.ID); err != nil || c.XP != xp {
+		t.Errorf("DiscardFlaggedXP() error = %v, XP %d → %d; want XP unchanged", err, xp, c.XP)
+	}
+	if len(c.FlaggedXP) != 0 {
+		t.Errorf("still holding %d entries", len(c.FlaggedXP))
+	}
+	if _, err := c.DiscardFlaggedXP(discarded.ID); !errors.Is(err, ErrFlaggedXPNotFound) {
+		t.Errorf("resolving twice error = %v, want ErrFlaggedXPNotFound", err)
+	}
+}
+
+// TestHandlerHoldsFlaggedXP tests that the commit handler holds a huge
+// commit's XP instead of awarding it.
+func TestHandlerHoldsFlaggedXP(t *testing.T) {
+	character := NewCharacter("Tester")
+	bus := NewEventBus()
+	var flaggedEvents []Event
+	bus.Subscribe(EventXPFlagged, func(e Event) { flaggedEvents = append(flaggedEvents, e) })
+
+	handler, err := NewGameEventHandler(character, []*Quest{}, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	bus.Publish(NewCommitEvent("0123456789abcdef", "Add generated fixtures", 40, 20000, 0))
+
+	if character.XP != 0 || character.Level != 1 {
+		t.Errorf("character has %d XP at level %d, want nothing awarded", character.XP, character.Level)
+	}
+	if len(character.FlaggedXP) != 1 || character.FlaggedXP[0].XP == 0 {
+		t.Fatalf("FlaggedXP = %+v, want the commit's XP held", character.FlaggedXP)
+	}
+	if character.TotalCommits != 1 {
+		t.Errorf("TotalCommits = %d, want the commit still counted", character.TotalCommits)
+	}
+	if len(flaggedEvents) != 1 || flaggedEvents[0].Data["xp"] != character.FlaggedXP[0].XP {
+		t.Errorf("flagged events = %+v, want one for the held XP", flaggedEvents)
+	}
+}
+
+// TestFlaggedXPBudget tests that held XP is counted against the daily cap
+// once: discarding it gives the budget, rested XP and every commit the event
+// covered back, accepting it awards it without charging the budget again.
+func TestFlaggedXPBudget(t *testing.T) {
+	tests := []struct {
+		name        string
+		commits     int
+		resolve     func(c *Character, id string) error
+		wantXP      int
+		wantSpent   int
+		wantCommits int
+		wantRested  int
+	}{
+		{
+			name:    "discard refunds the budget",
+			commits: 1,
+			resolve: func(c *Character, id string) error {
+				_, err := c.DiscardFlaggedXP(id)
+				return err
+			},
+			wantXP: 0, wantSpent: 0, wantCommits: 0, wantRested: 20,
+		},
+		{
+			name:    "accept keeps the budget spent",
+			commits: 1,
+			resolve: func(c *Character, id string) error {
+				_, _, err := c.AcceptFlaggedXP(id)
+				return err
+			},
+			wantXP: 70, wantSpent: 50, wantCommits: 1, wantRested: 0,
+		},
+		{
+			name:    "discard refunds every batched commit",
+			commits: 3,
+			resolve: func(c *Character, id string) error {
+				_, err := c.DiscardFlaggedXP(id)
+				return err
+			},
+			wantXP: 0, wantSpent: 0, wantCommits: 0, wantRested: 20,
+		},
+		{
+			name:    "accept keeps batched commits counted",
+			commits: 3,
+			resolve: func(c *Character, id string) error {
+				_, _, err := c.AcceptFlaggedXP(id)
+				return err
+			},
+			wantXP: 70, wantSpent: 50, wantCommits: 3, wantRested: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.DailyXPCap = 50
+			cfg.Game.FullRateCommits = 0
+			character := NewCharacter("Tester")
+			character.RestBonusXP = 20
+			handler, err := NewGameEventHandler(character, []*Quest{}, NewEventBus(), &memoryStorage{}, cfg)
+			if err != nil {
+				t.Fatalf("NewGameEventHandler() error = %v", err)
+			}
+			if err := handler.Start(); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer handler.Stop()
+
+			event := NewCommitEvent("0123456789abcdef", "Add generated fixtures", 40, 20000, 0)
+			event.Data["commit_count"] = tt.commits
+			handler.eventBus.Publish(event)
+			if len(character.FlaggedXP) != 1 || character.FlaggedXP[0].XP != 70 || character.TodayCommitXP != 50 || character.RestBonusXP != 0 {
+				t.Fatalf("held %+v with %d XP spent and %d rested, want 70 XP held (50 capped + 20 rested)",
+					character.FlaggedXP, character.TodayCommitXP, character.RestBonusXP)
+			}
+
+			if err := tt.resolve(character, character.FlaggedXP[0].ID); err != nil {
+				t.Fatalf("resolving error = %v", err)
+			}
+			if character.XP != tt.wantXP || character.TodayCommitXP != tt.wantSpent ||
+				character.TodayXPCommits != tt.wantCommits || character.RestBonusXP != tt.wantRested {
+				t.Errorf("XP %d, spent %d, commits %d, rested %d; want %d, %d, %d, %d",
+					character.XP, character.TodayCommitXP, character.TodayXPCommits, character.RestBonusXP,
+					tt.wantXP, tt.wantSpent, tt.wantCommits, tt.wantRested)
+			}
+		})
+	}
+}
+
+// TestAnomalyRulesFromConfig tests that detection is off without config and
+// unset thresholds use the defaults.
+func TestAnomalyRulesFromConfig(t *testing.T) {
+	if rules := AnomalyRulesFromConfig(nil); rules.Enabled {
+		t.Error("nil config should disable detection")
+	}
+	rules := AnomalyRulesFromConfig(&config.Config{Anomaly: config.AnomalyConfig{Enabled: true}})
+	if !rules.Enabled || rules.MaxCommitLines != config.DefaultMaxCommitLines || rules.BurstSigma != config.DefaultBurstSigma {
+		t.Errorf("rules = %+v, want defaults filled in", rules)
+	}
+}
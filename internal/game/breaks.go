@@ -0,0 +1,113 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// BreakOutcome is how the player answered a break reminder.
+type BreakOutcome string
+
+const (
+	BreakTaken   BreakOutcome = "taken"   // Stepped away from the keyboard
+	BreakSnoozed BreakOutcome = "snoozed" // Asked to be reminded a bit later
+	BreakSkipped BreakOutcome = "skipped" // Kept working through the break
+)
+
+const (
+	// BreakWellnessBonus is the Wellness gained for each break taken
+	BreakWellnessBonus = 1
+
+	// maxBreakLog caps the stored break history (oldest entries are dropped)
+	maxBreakLog = 500
+)
+
+// BreakRecord logs one answered break reminder.
+type BreakRecord struct {
+	At          time.Time     `json:"at"`           // When the reminder was answered
+	Outcome     BreakOutcome  `json:"outcome"`      // Taken, snoozed or skipped
+	SessionTime time.Duration `json:"session_time"` // Session length when the reminder fired
+}
+
+// BreakAdherence summarizes how often break reminders were followed.
+type BreakAdherence struct {
+	Taken   int     // Breaks taken
+	Snoozed int     // Reminders snoozed
+	Skipped int     // Breaks skipped
+	Rate    float64 // Taken / (taken + skipped), 0-1 (snoozes don't count either way)
+}
+
+// RecordBreak logs the answer to a break reminder. Taking a break raises
+// the Wellness stat.
+//
+// Parameters:
+//   - outcome: How the reminder was answered
+//   - sessionTime: Session length when the reminder fired
+//   - now: When it was answered
+//
+// Returns:
+//   - int: Wellness gained (0 unless the break was taken)
+func (c *Character) RecordBreak(outcome BreakOutcome, sessionTime time.Duration, now time.Time) int {
+	c.BreakLog = append(c.BreakLog, BreakRecord{At: now, Outcome: outcome, SessionTime: sessionTime})
+	if len(c.BreakLog) > maxBreakLog {
+		c.BreakLog = c.BreakLog[len(c.BreakLog)-maxBreakLog:]
+	}
+
+	if outcome != BreakTaken {
+		return 0
+	}
+	c.Wellness += BreakWellnessBonus
+	return BreakWellnessBonus
+}
+
+// BreakAdherenceSince summarizes break reminders answered since a point in time.
+// Daily totals rolled up by PruneHistory count when their day is on or after
+// since's date.
+//
+// Parameters:
+//   - since: Only count reminders answered at or after this time
+//
+// Returns:
+//   - BreakAdherence: Counts and the share of breaks taken
+func (c *Character) BreakAdherenceSince(since time.Time) BreakAdherence {
+	var adherence BreakAdherence
+	for _, record := range c.BreakLog {
+		if record.At.Before(since) {
+			continue
+		}
+		switch record.Outcome {
+		case BreakTaken:
+			adherence.Taken++
+		case BreakSnoozed:
+			adherence.Snoozed++
+		case BreakSkipped:
+			adherence.Skipped++
+		}
+	}
+
+	sinceKey := since.Format(activityDayFormat)
+	for day, tally := range c.BreakDays {
+		if day < sinceKey {
+			continue
+		}
+		adherence.Taken += tally.Taken
+		adherence.Snoozed += tally.Snoozed
+		adherence.Skipped += tally.Skipped
+	}
+	if answered := adherence.Taken + adherence.Skipped; answered > 0 {
+		adherence.Rate = float64(adherence.Taken) / float64(answered)
+	}
+	return adherence
+}
+
+// Summary describes the adherence in one line for the character sheet.
+//
+// Returns:
+//   - string: Human-readable adherence summary
+func (a BreakAdherence) Summary() string {
+	if a.Taken+a.Skipped == 0 {
+		return "No breaks answered yet"
+	}
+	return fmt.Sprintf("Took %d of %d breaks (%.0f%%)", a.Taken, a.Taken+a.Skipped, a.Rate*100)
+}
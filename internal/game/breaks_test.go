@@ -0,0 +1,75 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRecordBreak tests that only taken breaks award Wellness
+func TestRecordBreak(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome BreakOutcome
+		want    int
+	}{
+		{"taken", BreakTaken, BreakWellnessBonus},
+		{"snoozed", BreakSnoozed, 0},
+		{"skipped", BreakSkipped, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := NewCharacter("TestHero")
+			got := char.RecordBreak(tt.outcome, 50*time.Minute, time.Now())
+			if got != tt.want || char.Wellness != tt.want {
+				t.Errorf("RecordBreak(%s) = %d, Wellness = %d; want %d", tt.outcome, got, char.Wellness, tt.want)
+			}
+			if len(char.BreakLog) != 1 || char.BreakLog[0].Outcome != tt.outcome {
+				t.Errorf("BreakLog = %+v, want one %s record", char.BreakLog, tt.outcome)
+			}
+		})
+	}
+}
+
+// TestRecordBreakCapsLog tests that the oldest records are dropped
+func TestRecordBreakCapsLog(t *testing.T) {
+	char := NewCharacter("TestHero")
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < maxBreakLog+5; i++ {
+		char.RecordBreak(BreakSkipped, time.Hour, start.Add(time.Duration(i)*time.Hour))
+	}
+
+	if len(char.BreakLog) != maxBreakLog {
+		t.Fatalf("len(BreakLog) = %d, want %d", len(char.BreakLog), maxBreakLog)
+	}
+	if want := start.Add(5 * time.Hour); !char.BreakLog[0].At.Equal(want) {
+		t.Errorf("oldest record at %v, want %v", char.BreakLog[0].At, want)
+	}
+}
+
+// TestBreakAdherenceSince tests adherence counts, rate and the time window
+func TestBreakAdherenceSince(t *testing.T) {
+	char := NewCharacter("TestHero")
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	char.RecordBreak(BreakTaken, time.Hour, now.AddDate(0, 0, -30)) // Outside the window
+	char.RecordBreak(BreakTaken, time.Hour, now.Add(-3*time.Hour))
+	char.RecordBreak(BreakSnoozed, time.Hour, now.Add(-2*time.Hour))
+	char.RecordBreak(BreakTaken, time.Hour, now.Add(-time.Hour))
+	char.RecordBreak(BreakSkipped, time.Hour, now)
+
+	got := char.BreakAdherenceSince(now.AddDate(0, 0, -7))
+	if got.Taken != 2 || got.Snoozed != 1 || got.Skipped != 1 {
+		t.Errorf("BreakAdherenceSince() = %+v, want 2 taken, 1 snoozed, 1 skipped", got)
+	}
+	if want := 2.0 / 3.0; got.Rate != want {
+		t.Errorf("Rate = %v, want %v", got.Rate, want)
+	}
+	if summary := got.Summary(); summary != "Took 2 of 3 breaks (67%)" {
+		t.Errorf("Summary() = %q", summary)
+	}
+
+	if empty := (BreakAdherence{Snoozed: 2}); empty.Summary() != "No breaks answered yet" {
+		t.Errorf("Summary() with only snoozes = %q", empty.Summary())
+	}
+}
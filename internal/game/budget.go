@@ -0,0 +1,185 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"math"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+const (
+	// minDiminishedRate is the smallest fraction of XP a commit can earn once
+	// diminishing returns kick in, so late commits are never worth nothing
+	minDiminishedRate = 0.1
+
+	// maxRestBonusShare caps the rested XP pool as a share of the daily cap,
+	// keeping the carry-over a small bonus rather than a second budget
+	maxRestBonusShare = 0.25
+)
+
+// XPBudget holds the anti-grind rules that limit commit XP per day.
+// A zero XPBudget disables every limit.
+type XPBudget struct {
+	DailyCap        int     // Max commit XP per day (0 = unlimited)
+	FullRateCommits int     // Commits per day at full XP before diminishing returns (0 = never diminish)
+	RestBonusRate   float64 // Fraction of unspent budget carried over as rested XP (0-1)
+}
+
+// XPBudgetResult breaks down how the budget affected a single award.
+type XPBudgetResult struct {
+	Awarded          int // XP actually awarded (including rest bonus)
+	LostToDiminished int // XP removed by diminishing returns
+	LostToCap        int // XP removed by the daily cap
+	RestBonus        int // Extra XP paid out of the rested pool
+	Commits          int // Commits counted toward today's diminishing returns
+}
+
+// XPBudgetFromConfig builds the XP budget rules from game settings.
+//
+// Parameters:
+//   - cfg: Application configuration (nil disables all limits)
+//
+// Returns:
+//   - XPBudget: The configured anti-grind rules
+func XPBudgetFromConfig(cfg *config.Config) XPBudget {
+	if cfg == nil {
+		return XPBudget{}
+	}
+	return XPBudget{
+		DailyCap:        cfg.Game.DailyXPCap,
+		FullRateCommits: cfg.Game.FullRateCommits,
+		RestBonusRate:   cfg.Game.RestBonusRate,
+	}
+}
+
+// ApplyXPBudget applies daily anti-grind safeguards to commit XP and records
+// the award against today's budget.
+//
+// Rules, in order:
+//  1. Diminishing returns: the Nth commit past FullRateCommits earns 1/(N+1)
+//     of its XP (never less than 10%), discouraging commit-splitting
+//  2. Daily cap: commit XP stops once DailyCap is reached for the day
+//  3. Rest bonus: XP from the rested pool (unspent budget carried over from
+//     the last active day) doubles awards until the pool is used up
+//
+// Parameters:
+//   - xp: Commit XP after difficulty and wisdom multipliers
+//   - commits: Commits the award covers (a throttled watcher batches several)
+//   - budget: The anti-grind rules to apply
+//   - now: Current time (used for the daily rollover)
+//
+// Returns:
+//   - XPBudgetResult: XP to award and how the budget changed it
+func (c *Character) ApplyXPBudget(xp, commits int, budget XPBudget, now time.Time) XPBudgetResult {
+	c.rolloverXPBudget(budget, now)
+
+	result := XPBudgetResult{}
+	if xp <= 0 {
+		return result
+	}
+
+	result.Commits = max(commits, 1)
+	c.TodayXPCommits += result.Commits
+
+	// 1. Diminishing returns past the full-rate commit count
+	earned := xp
+	if budget.FullRateCommits > 0 && c.TodayXPCommits > budget.FullRateCommits {
+		extra := c.TodayXPCommits - budget.FullRateCommits
+		rate := math.Max(1/float64(extra+1), minDiminishedRate)
+		earned = int(math.Round(float64(xp) * rate))
+		result.LostToDiminished = xp - earned
+	}
+
+	// 2. Daily cap
+	if budget.DailyCap > 0 {
+		remaining := budget.DailyCap - c.TodayCommitXP
+		if remaining < 0 {
+			remaining = 0
+		}
+		if earned > remaining {
+			result.LostToCap = earned - remaining
+			earned = remaining
+		}
+	}
+	c.TodayCommitXP += earned
+
+	// 3. Rested XP pool
+	if c.RestBonusXP > 0 && earned > 0 {
+		result.RestBonus = earned
+		if result.RestBonus > c.RestBonusXP {
+			result.RestBonus = c.RestBonusXP
+		}
+		c.RestBonusXP -= result.RestBonus
+	}
+
+	result.Awarded = earned + result.RestBonus
+	return result
+}
+
+// XPBudgetRemaining returns how much commit XP can still be earned today
+// before the daily cap, plus any rested XP waiting to be paid out.
+//
+// Parameters:
+//   - budget: The anti-grind rules in effect
+//   - now: Current time
+//
+// Returns:
+//   - remaining: Commit XP left under today's cap (-1 if uncapped)
+//   - rested: Rested XP available as a bonus
+func (c *Character) XPBudgetRemaining(budget XPBudget, now time.Time) (remaining int, rested int) {
+	spent := c.TodayCommitXP
+	rested = c.RestBonusXP
+	if !sameDay(c.BudgetDate, now) {
+		spent = 0
+		rested = c.pendingRestBonus(budget, now)
+	}
+
+	if budget.DailyCap <= 0 {
+		return -1, rested
+	}
+	remaining = budget.DailyCap - spent
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, rested
+}
+
+// rolloverXPBudget resets the daily counters when a new day starts, turning
+// part of the previous day's unspent budget into rested XP.
+func (c *Character) rolloverXPBudget(budget XPBudget, now time.Time) {
+	if sameDay(c.BudgetDate, now) {
+		return
+	}
+
+	c.RestBonusXP = c.pendingRestBonus(budget, now)
+	c.TodayCommitXP = 0
+	c.TodayXPCommits = 0
+	c.BudgetDate = truncateToDay(now)
+}
+
+// pendingRestBonus calculates the rested XP a rollover at now would grant.
+// Only the last day with commit activity carries over; the bonus never
+// stacks across multiple idle days.
+func (c *Character) pendingRestBonus(budget XPBudget, now time.Time) int {
+	if budget.DailyCap <= 0 || budget.RestBonusRate <= 0 || c.BudgetDate.IsZero() || sameDay(c.BudgetDate, now) {
+		return c.RestBonusXP
+	}
+
+	unspent := budget.DailyCap - c.TodayCommitXP
+	if unspent < 0 {
+		unspent = 0
+	}
+	bonus := int(math.Round(float64(unspent) * math.Min(budget.RestBonusRate, 1)))
+
+	maxBonus := int(float64(budget.DailyCap) * maxRestBonusShare)
+	if bonus > maxBonus {
+		bonus = maxBonus
+	}
+	return bonus
+}
+
+// sameDay reports whether two times fall on the same calendar day.
+func sameDay(a, b time.Time) bool {
+	return truncateToDay(a).Equal(truncateToDay(b))
+}
@@ -0,0 +1,156 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestApplyXPBudget tests diminishing returns and the daily cap.
+func TestApplyXPBudget(t *testing.T) {
+	now := time.Date(2025, 3, 10, 14, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name          string
+		budget        XPBudget
+		priorCommits  int
+		priorXP       int
+		xp            int
+		wantAwarded   int
+		wantDiminish  int
+		wantCapped    int
+		wantTodayXP   int
+		wantTodayRuns int
+	}{
+		{
+			name:          "no limits",
+			budget:        XPBudget{},
+			xp:            50,
+			wantAwarded:   50,
+			wantTodayXP:   50,
+			wantTodayRuns: 1,
+		},
+		{
+			name:          "full rate before threshold",
+			budget:        XPBudget{FullRateCommits: 3},
+			priorCommits:  2,
+			xp:            50,
+			wantAwarded:   50,
+			wantTodayXP:   50,
+			wantTodayRuns: 3,
+		},
+		{
+			name:          "first commit past threshold earns half",
+			budget:        XPBudget{FullRateCommits: 3},
+			priorCommits:  3,
+			xp:            50,
+			wantAwarded:   25,
+			wantDiminish:  25,
+			wantTodayXP:   25,
+			wantTodayRuns: 4,
+		},
+		{
+			name:          "diminishing never drops below minimum rate",
+			budget:        XPBudget{FullRateCommits: 1},
+			priorCommits:  50,
+			xp:            100,
+			wantAwarded:   10,
+			wantDiminish:  90,
+			wantTodayXP:   10,
+			wantTodayRuns: 51,
+		},
+		{
+			name:          "cap trims award",
+			budget:        XPBudget{DailyCap: 100},
+			priorXP:       80,
+			priorCommits:  1,
+			xp:            50,
+			wantAwarded:   20,
+			wantCapped:    30,
+			wantTodayXP:   100,
+			wantTodayRuns: 2,
+		},
+		{
+			name:          "cap reached awards nothing",
+			budget:        XPBudget{DailyCap: 100},
+			priorXP:       100,
+			priorCommits:  3,
+			xp:            50,
+			wantAwarded:   0,
+			wantCapped:    50,
+			wantTodayXP:   100,
+			wantTodayRuns: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := NewCharacter("TestHero")
+			character.BudgetDate = truncateToDay(now)
+			character.TodayXPCommits = tt.priorCommits
+			character.TodayCommitXP = tt.priorXP
+
+			result := character.ApplyXPBudget(tt.xp, 1, tt.budget, now)
+
+			if result.Awarded != tt.wantAwarded {
+				t.Errorf("Awarded = %d, want %d", result.Awarded, tt.wantAwarded)
+			}
+			if result.LostToDiminished != tt.wantDiminish {
+				t.Errorf("LostToDiminished = %d, want %d", result.LostToDiminished, tt.wantDiminish)
+			}
+			if result.LostToCap != tt.wantCapped {
+				t.Errorf("LostToCap = %d, want %d", result.LostToCap, tt.wantCapped)
+			}
+			if character.TodayCommitXP != tt.wantTodayXP {
+				t.Errorf("TodayCommitXP = %d, want %d", character.TodayCommitXP, tt.wantTodayXP)
+			}
+			if character.TodayXPCommits != tt.wantTodayRuns {
+				t.Errorf("TodayXPCommits = %d, want %d", character.TodayXPCommits, tt.wantTodayRuns)
+			}
+		})
+	}
+}
+
+// TestXPBudgetRestBonus tests carrying unspent budget to the next day.
+func TestXPBudgetRestBonus(t *testing.T) {
+	budget := XPBudget{DailyCap: 1000, RestBonusRate: 0.1}
+	yesterday := time.Date(2025, 3, 9, 18, 0, 0, 0, time.Local)
+	today := yesterday.Add(20 * time.Hour)
+
+	character := NewCharacter("TestHero")
+	character.ApplyXPBudget(400, 1, budget, yesterday)
+
+	// 600 unspent * 0.1 = 60 rested XP waiting for today
+	remaining, rested := character.XPBudgetRemaining(budget, today)
+	if remaining != 1000 || rested != 60 {
+		t.Fatalf("XPBudgetRemaining() = %d, %d; want 1000, 60", remaining, rested)
+	}
+
+	// First commit today is doubled by the rested pool
+	result := character.ApplyXPBudget(40, 1, budget, today)
+	if result.Awarded != 80 || result.RestBonus != 40 {
+		t.Errorf("first commit: Awarded = %d, RestBonus = %d; want 80, 40", result.Awarded, result.RestBonus)
+	}
+
+	// Pool runs dry on the second commit
+	result = character.ApplyXPBudget(40, 1, budget, today)
+	if result.Awarded != 60 || result.RestBonus != 20 {
+		t.Errorf("second commit: Awarded = %d, RestBonus = %d; want 60, 20", result.Awarded, result.RestBonus)
+	}
+	if character.RestBonusXP != 0 {
+		t.Errorf("RestBonusXP = %d, want 0", character.RestBonusXP)
+	}
+}
+
+// TestXPBudgetRestBonusCapped tests that rested XP stays a small bonus.
+func TestXPBudgetRestBonusCapped(t *testing.T) {
+	budget := XPBudget{DailyCap: 1000, RestBonusRate: 1}
+	yesterday := time.Date(2025, 3, 9, 18, 0, 0, 0, time.Local)
+
+	character := NewCharacter("TestHero")
+	character.ApplyXPBudget(10, 1, budget, yesterday)
+
+	_, rested := character.XPBudgetRemaining(budget, yesterday.AddDate(0, 0, 1))
+	if rested != 250 {
+		t.Errorf("rested = %d, want 250 (25%% of cap)", rested)
+	}
+}
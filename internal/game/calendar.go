@@ -0,0 +1,104 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the quest calendar: when recurring quests come up
+// over the next few weeks. Daily quests are up every day, monthly quests
+// reset on the 1st and are due on the last day of the month, and open
+// quests with a deadline are due on that day.
+package game
+
+import "time"
+
+// CalendarWeeks is how many weeks the quest calendar covers.
+const CalendarWeeks = 4
+
+// CalendarEventKind is what happens to a quest on a calendar day.
+type CalendarEventKind string
+
+const (
+	CalendarDaily    CalendarEventKind = "daily"    // A daily quest is up
+	CalendarMonthly  CalendarEventKind = "monthly"  // A monthly quest resets for the new month
+	CalendarDeadline CalendarEventKind = "deadline" // Last day to finish a monthly quest, or a quest's deadline
+)
+
+// CalendarEvent is one quest on a calendar day.
+type CalendarEvent struct {
+	Quest *Quest
+	Kind  CalendarEventKind
+}
+
+// CalendarDay is one day of the quest calendar.
+type CalendarDay struct {
+	Date   time.Time       // The day (midnight UTC, as StreakClock.Day)
+	Events []CalendarEvent // Quests scheduled that day
+}
+
+// QuestCalendar lays out recurring quests over whole weeks (Monday to
+// Sunday), starting with the current week. Days before today are included
+// to fill the first week but have no events. Daily quests finished or
+// failed today show up again from tomorrow; a monthly quest finished this
+// month skips this month's deadline.
+//
+// Parameters:
+//   - quests: All quests
+//   - now: Current time
+//   - weeks: How many weeks to lay out
+//   - clock: Day boundaries
+//
+// Returns:
+//   - [][]CalendarDay: One slice of seven days per week
+func QuestCalendar(quests []*Quest, now time.Time, weeks int, clock StreakClock) [][]CalendarDay {
+	today := clock.Day(now)
+	// Weeks start on Monday (time.Sunday is 0)
+	start := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+
+	calendar := make([][]CalendarDay, weeks)
+	for w := range calendar {
+		calendar[w] = make([]CalendarDay, 7)
+		for d := range calendar[w] {
+			date := start.AddDate(0, 0, w*7+d)
+			calendar[w][d] = CalendarDay{Date: date, Events: calendarEvents(quests, date, today)}
+		}
+	}
+	return calendar
+}
+
+// calendarEvents returns the recurring quests scheduled on a day.
+func calendarEvents(quests []*Quest, date, today time.Time) []CalendarEvent {
+	if date.Before(today) {
+		return nil
+	}
+
+	var events []CalendarEvent
+	lastOfMonth := date.AddDate(0, 0, 1).Day() == 1
+	thisMonth := date.Year() == today.Year() && date.Month() == today.Month()
+	for _, quest := range quests {
+		// Finished today (or this month) means the quest is done until it resets
+		open := quest.Status == QuestAvailable || quest.Status == QuestActive
+
+		switch quest.Type {
+		case QuestTypeDaily:
+			if date.After(today) || open {
+				events = append(events, CalendarEvent{Quest: quest, Kind: CalendarDaily})
+			}
+		case QuestTypeMonthly:
+			if date.Day() == 1 && date.After(today) {
+				events = append(events, CalendarEvent{Quest: quest, Kind: CalendarMonthly})
+			}
+			if lastOfMonth && (!thisMonth || open) {
+				events = append(events, CalendarEvent{Quest: quest, Kind: CalendarDeadline})
+			}
+		default:
+			if open && quest.Deadline != nil && dueOn(*quest.Deadline, date) {
+				events = append(events, CalendarEvent{Quest: quest, Kind: CalendarDeadline})
+			}
+		}
+	}
+	return events
+}
+
+// dueOn reports whether a deadline falls on a calendar day (midnight UTC of
+// the date, as StreakClock.Day). The deadline's own calendar date counts,
+// in the timezone it was set in.
+func dueOn(deadline, date time.Time) bool {
+	year, month, day := deadline.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Equal(date)
+}
@@ -0,0 +1,98 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestQuestCalendar tests when daily and monthly quests and deadlines show
+// up on the calendar, depending on whether they were already finished.
+func TestQuestCalendar(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	// Wednesday; the calendar starts on Monday the 21st
+	now := time.Date(2025, 4, 23, 14, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		questType QuestType
+		status    QuestStatus
+		deadline  time.Time                       // zero = no deadline
+		want      map[time.Time]CalendarEventKind // days to check, "" = no event
+	}{
+		{
+			name:      "open daily",
+			questType: QuestTypeDaily,
+			status:    QuestActive,
+			want:      map[time.Time]CalendarEventKind{day(4, 22): "", day(4, 23): CalendarDaily, day(5, 18): CalendarDaily},
+		},
+		{
+			name:      "daily done today",
+			questType: QuestTypeDaily,
+			status:    QuestCompleted,
+			want:      map[time.Time]CalendarEventKind{day(4, 23): "", day(4, 24): CalendarDaily},
+		},
+		{
+			name:      "open monthly",
+			questType: QuestTypeMonthly,
+			status:    QuestAvailable,
+			want:      map[time.Time]CalendarEventKind{day(4, 29): "", day(4, 30): CalendarDeadline, day(5, 1): CalendarMonthly},
+		},
+		{
+			name:      "monthly done this month",
+			questType: QuestTypeMonthly,
+			status:    QuestCompleted,
+			want:      map[time.Time]CalendarEventKind{day(4, 30): "", day(5, 1): CalendarMonthly},
+		},
+		{
+			name:      "one-off quest",
+			questType: QuestTypeCommit,
+			status:    QuestActive,
+			want:      map[time.Time]CalendarEventKind{day(4, 23): "", day(4, 30): ""},
+		},
+		{
+			name:      "one-off quest with a deadline",
+			questType: QuestTypeCommit,
+			status:    QuestActive,
+			deadline:  time.Date(2025, 5, 2, 18, 0, 0, 0, time.UTC),
+			want:      map[time.Time]CalendarEventKind{day(5, 1): "", day(5, 2): CalendarDeadline},
+		},
+		{
+			name:      "finished quest with a deadline",
+			questType: QuestTypeCommit,
+			status:    QuestCompleted,
+			deadline:  time.Date(2025, 5, 2, 18, 0, 0, 0, time.UTC),
+			want:      map[time.Time]CalendarEventKind{day(5, 2): ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Quest", "", tt.questType, 1, 50, 1)
+			quest.Status = tt.status
+			if !tt.deadline.IsZero() {
+				quest.Deadline = &tt.deadline
+			}
+
+			calendar := QuestCalendar([]*Quest{quest}, now, CalendarWeeks, clock)
+			if len(calendar) != CalendarWeeks || !calendar[0][0].Date.Equal(day(4, 21)) || !calendar[3][6].Date.Equal(day(5, 18)) {
+				t.Fatalf("calendar runs %v to %v, want 4 weeks from Monday 2025-04-21",
+					calendar[0][0].Date, calendar[len(calendar)-1][6].Date)
+			}
+
+			got := make(map[time.Time]CalendarEventKind)
+			for _, week := range calendar {
+				for _, d := range week {
+					for _, event := range d.Events {
+						got[d.Date] = event.Kind
+					}
+				}
+			}
+			for date, want := range tt.want {
+				if got[date] != want {
+					t.Errorf("%s: event = %q, want %q", date.Format("Jan 2"), got[date], want)
+				}
+			}
+		})
+	}
+}
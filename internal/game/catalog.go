@@ -0,0 +1,221 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the quest catalog: each day and each week a few
+// quests (commits, lines, test files, streaks) are drawn from a catalog,
+// scaled to the character's level. The draw is seeded by the character and
+// the period, so it's the same every time it's made, and the quests are
+// removed when their day or week is over, making way for the next draw.
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// QuestPeriod is how long a generated quest runs.
+type QuestPeriod string
+
+// Quest catalog periods.
+const (
+	PeriodDaily  QuestPeriod = "daily"  // Runs until the day is over
+	PeriodWeekly QuestPeriod = "weekly" // Runs until the week (Monday to Sunday) is over
+)
+
+// GeneratedQuest records which draw a generated quest came from.
+type GeneratedQuest struct {
+	Period    QuestPeriod `json:"period"`     // Daily or weekly
+	Key       string      `json:"key"`        // The day ("2006-01-02") or week ("2025-W10") drawn for
+	ExpiresAt time.Time   `json:"expires_at"` // When the period is over
+}
+
+// catalogEntry is a quest the catalog can draw, described at level 1.
+type catalogEntry struct {
+	Type        QuestType
+	Title       string  // Title format, given the target
+	Description string  // Description format, given the target
+	Target      int     // Target at level 1
+	Growth      float64 // Target increase per level above 1, as a fraction of Target
+	XP          int     // XP reward at level 1 (grows 10% per level)
+}
+
+// dailyCatalog is the pool daily quests are drawn from.
+var dailyCatalog = []catalogEntry{
+	{Type: QuestTypeCommit, Title: "Daily Grind: %d Commits", Description: "Make %d commits before the day is out.", Target: 3, Growth: 0.1, XP: 40},
+	{Type: QuestTypeLines, Title: "Daily Output: %d Lines", Description: "Change %d lines of code (added + removed) today.", Target: 100, Growth: 0.15, XP: 40},
+	{Type: QuestTypeTests, Title: "Daily Tests: %d Test Files", Description: "Add or change %d test files today.", Target: 1, Growth: 0.05, XP: 50},
+}
+
+// weeklyCatalog is the pool weekly quests are drawn from. The streak
+// quest's target is worked out from the character's streak instead (see
+// streakTarget).
+var weeklyCatalog = []catalogEntry{
+	{Type: QuestTypeCommit, Title: "Weekly Haul: %d Commits", Description: "Make %d commits this week.", Target: 15, Growth: 0.1, XP: 200},
+	{Type: QuestTypeLines, Title: "Weekly Output: %d Lines", Description: "Change %d lines of code (added + removed) this week.", Target: 500, Growth: 0.15, XP: 200},
+	{Type: QuestTypeTests, Title: "Weekly Tests: %d Test Files", Description: "Add or change %d test files this week.", Target: 5, Growth: 0.05, XP: 250},
+	{Type: QuestTypeStreak, Title: "Weekly Streak: %d Days", Description: "Reach a %d-day coding streak before the week is out.", XP: 200},
+}
+
+// maxStreakStretch caps how many days past the current streak a weekly
+// streak quest asks for.
+const maxStreakStretch = 5
+
+// scaled returns the entry's target and XP reward at a level.
+func (e catalogEntry) scaled(level int) (target, xp int) {
+	above := max(level-1, 0)
+	target = int(math.Round(float64(e.Target) * (1 + e.Growth*float64(above))))
+	return max(target, 1), e.XP + e.XP*above/10
+}
+
+// streakTarget returns the streak a weekly streak quest asks for: a day
+// more for each day left in the week after today, up to maxStreakStretch.
+//
+// Returns:
+//   - int: Streak to reach
+//   - bool: False when too little of the week is left for a streak quest
+func streakTarget(c *Character, daysLeft int) (int, bool) {
+	if daysLeft < 2 {
+		return 0, false
+	}
+	return max(c.CurrentStreak, 0) + min(daysLeft-1, maxStreakStretch), true
+}
+
+// periodEnd returns the deadline and expiry of a period starting on a
+// streak day and lasting some days: the deadline is the last moment of its
+// final day in the home timezone, and it expires when the next streak day
+// begins.
+func periodEnd(start time.Time, days int, clock StreakClock) (deadline, expires time.Time) {
+	year, month, day := start.AddDate(0, 0, days-1).Date()
+	deadline = time.Date(year, month, day, 23, 59, 59, 0, clock.location())
+	expires = time.Date(year, month, day+1, 0, 0, 0, 0, clock.location()).Add(clock.Grace)
+	return deadline, expires
+}
+
+// catalogRand returns the random source for a character's draw in a
+// period, so the same draw is made whenever it's repeated.
+func catalogRand(characterID string, period QuestPeriod, key string) *rand.Rand {
+	hash := fnv.New64a()
+	hash.Write([]byte(characterID + ":" + string(period) + ":" + key))
+	seed := hash.Sum64()
+	return rand.New(rand.NewPCG(seed, seed>>1))
+}
+
+// GenerateQuests draws a period's quests from the catalog, scaled to the
+// character's level. The draw is seeded by the character and the period, so
+// repeating it gives the same quests, and no quest type is drawn twice.
+//
+// Parameters:
+//   - c: The character the quests are for
+//   - period: Daily or weekly
+//   - count: Quests to draw (fewer when the catalog runs out)
+//   - now: Current time
+//   - clock: Day boundaries in the home timezone
+//
+// Returns:
+//   - []*Quest: Available quests, expiring when the period is over
+func GenerateQuests(c *Character, period QuestPeriod, count int, now time.Time, clock StreakClock) []*Quest {
+	today := clock.Day(now)
+	pool, key, days := dailyCatalog, today.Format(activityDayFormat), 1
+	if period == PeriodWeekly {
+		weekday := int(today.Weekday()+6)%7 + 1 // Monday = 1 ... Sunday = 7
+		pool, key, days = weeklyCatalog, WeekKey(now, clock), 8-weekday
+	}
+	deadline, expires := periodEnd(today, days, clock)
+
+	rng := catalogRand(c.ID, period, key)
+	order := rng.Perm(len(pool))
+
+	var quests []*Quest
+	for _, i := range order {
+		if len(quests) >= count {
+			break
+		}
+		entry := pool[i]
+		target, xp := entry.scaled(c.Level)
+		if entry.Type == QuestTypeStreak {
+			var ok bool
+			if target, ok = streakTarget(c, days); !ok {
+				continue
+			}
+		}
+
+		quests = append(quests, NewQuestBuilder().
+			Title(fmt.Sprintf(entry.Title, target)).
+			Description(fmt.Sprintf(entry.Description, target)).
+			Type(entry.Type).
+			Target(target).
+			XPReward(xp).
+			Deadline(deadline).
+			Generated(GeneratedQuest{Period: period, Key: key, ExpiresAt: expires}).
+			MustBuild())
+	}
+	return quests
+}
+
+// Expired reports whether the quest is a generated quest whose period is
+// over.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - bool: True once the quest's day or week has ended
+func (q *Quest) Expired(now time.Time) bool {
+	return q.Generated != nil && !now.Before(q.Generated.ExpiresAt)
+}
+
+// RefreshGeneratedQuests keeps the catalog's quests current: generated
+// quests whose period is over are removed, finished or not, so the quest log
+// doesn't grow with every draw, and the current day's and week's quests are
+// drawn if they haven't been yet. A completed quest waiting for its reward
+// to be picked stays until it's paid out.
+//
+// Parameters:
+//   - quests: Existing quests
+//   - c: The character the quests are for
+//   - settings: Quest catalog settings (with defaults applied)
+//   - now: Current time
+//   - clock: Day boundaries in the home timezone
+//
+// Returns:
+//   - []*Quest: The quests, with expired ones removed and new ones appended
+//   - []*Quest: The quests drawn
+//   - []*Quest: The unfinished quests that expired
+func RefreshGeneratedQuests(quests []*Quest, c *Character, settings config.CatalogConfig, now time.Time, clock StreakClock) ([]*Quest, []*Quest, []*Quest) {
+	var kept, expired []*Quest
+	drawn := make(map[string]bool)
+	for _, quest := range quests {
+		if quest.Generated == nil {
+			kept = append(kept, quest)
+			continue
+		}
+		drawn[string(quest.Generated.Period)+":"+quest.Generated.Key] = true
+
+		if !quest.Expired(now) || quest.RewardPending {
+			kept = append(kept, quest)
+			continue
+		}
+		if quest.Status == QuestAvailable || quest.Status == QuestActive {
+			expired = append(expired, quest)
+		}
+	}
+
+	var added []*Quest
+	for _, draw := range []struct {
+		period QuestPeriod
+		key    string
+		count  int
+	}{
+		{PeriodDaily, clock.Day(now).Format(activityDayFormat), settings.Daily},
+		{PeriodWeekly, WeekKey(now, clock), settings.Weekly},
+	} {
+		if draw.count <= 0 || drawn[string(draw.period)+":"+draw.key] {
+			continue
+		}
+		added = append(added, GenerateQuests(c, draw.period, draw.count, now, clock)...)
+	}
+	return append(kept, added...), added, expired
+}
@@ -0,0 +1,157 @@
+package game
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestGenerateQuests tests that draws are repeatable, distinct and expire
+// at the end of their day or week.
+func TestGenerateQuests(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2025, 3, 12, 15, 0, 0, 0, time.UTC) // Wednesday
+	c := NewCharacter("Tester")
+
+	tests := []struct {
+		name        string
+		period      QuestPeriod
+		count       int
+		wantKey     string
+		wantExpires time.Time
+	}{
+		{"daily", PeriodDaily, 2, "2025-03-12", time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC)},
+		{"weekly", PeriodWeekly, 2, "2025-W11", time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)},
+		{"more than the catalog holds", PeriodDaily, 10, "2025-03-12", time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quests := GenerateQuests(c, tt.period, tt.count, now, clock)
+			again := GenerateQuests(c, tt.period, tt.count, now, clock)
+			if len(quests) != min(tt.count, len(dailyCatalog)) || len(again) != len(quests) {
+				t.Fatalf("drew %d and %d quests, want %d", len(quests), len(again), min(tt.count, len(dailyCatalog)))
+			}
+
+			types := make(map[QuestType]bool)
+			for i, quest := range quests {
+				if quest.Title != again[i].Title || quest.Target != again[i].Target {
+					t.Errorf("draw %d differs: %q then %q", i, quest.Title, again[i].Title)
+				}
+				if types[quest.Type] {
+					t.Errorf("quest type %s drawn twice", quest.Type)
+				}
+				types[quest.Type] = true
+
+				if quest.Status != QuestAvailable || quest.Generated == nil {
+					t.Fatalf("quest %q: status %s, generated %v", quest.Title, quest.Status, quest.Generated)
+				}
+				if quest.Generated.Key != tt.wantKey || !quest.Generated.ExpiresAt.Equal(tt.wantExpires) {
+					t.Errorf("quest %q: key %s expiring %v, want %s expiring %v",
+						quest.Title, quest.Generated.Key, quest.Generated.ExpiresAt, tt.wantKey, tt.wantExpires)
+				}
+				if quest.Deadline == nil || !clock.Day(*quest.Deadline).Equal(clock.Day(tt.wantExpires.Add(-time.Hour))) {
+					t.Errorf("quest %q: deadline %v, want the period's last day", quest.Title, quest.Deadline)
+				}
+			}
+		})
+	}
+}
+
+// TestCatalogScaling tests that targets and rewards grow with level.
+func TestCatalogScaling(t *testing.T) {
+	tests := []struct {
+		name       string
+		entry      catalogEntry
+		level      int
+		wantTarget int
+		wantXP     int
+	}{
+		{"level 1", dailyCatalog[0], 1, 3, 40},
+		{"level 11 commits", dailyCatalog[0], 11, 6, 80},
+		{"level 11 lines", dailyCatalog[1], 11, 250, 80},
+		{"level below 1", dailyCatalog[1], 0, 100, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, xp := tt.entry.scaled(tt.level)
+			if target != tt.wantTarget || xp != tt.wantXP {
+				t.Errorf("scaled(%d) = %d, %d XP; want %d, %d XP", tt.level, target, xp, tt.wantTarget, tt.wantXP)
+			}
+		})
+	}
+
+	// The weekly streak quest stretches the current streak by the days left
+	c := NewCharacter("Tester")
+	c.CurrentStreak = 4
+	if target, ok := streakTarget(c, 7); !ok || target != 4+maxStreakStretch {
+		t.Errorf("streakTarget on Monday = %d, %v; want %d", target, ok, 4+maxStreakStretch)
+	}
+	if _, ok := streakTarget(c, 1); ok {
+		t.Error("streakTarget on Sunday should skip the quest")
+	}
+}
+
+// TestRefreshGeneratedQuests tests drawing each period once and expiring
+// its quests when it's over.
+func TestRefreshGeneratedQuests(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	monday := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	settings := config.CatalogConfig{Enabled: true}.WithDefaults()
+	c := NewCharacter("Tester")
+	manual := NewQuestBuilder().Title("Manual").Type(QuestTypeCommit).Target(5).XPReward(50).MustBuild()
+
+	quests, added, expired := RefreshGeneratedQuests([]*Quest{manual}, c, settings, monday, clock)
+	if len(added) != settings.Daily+settings.Weekly || len(expired) != 0 || len(quests) != 1+len(added) {
+		t.Fatalf("first refresh: %d quests, %d added, %d expired", len(quests), len(added), len(expired))
+	}
+
+	// Later the same day nothing changes
+	if _, added, expired := RefreshGeneratedQuests(quests, c, settings, monday.Add(6*time.Hour), clock); added != nil || expired != nil {
+		t.Errorf("same day refresh: %d added, %d expired; want none", len(added), len(expired))
+	}
+
+	// Start one daily and finish the other, then move to Tuesday: both are
+	// removed (only the unfinished one is reported) and new ones are drawn
+	var dailies []*Quest
+	for _, quest := range quests {
+		if quest.Generated != nil && quest.Generated.Period == PeriodDaily {
+			dailies = append(dailies, quest)
+		}
+	}
+	if err := dailies[0].StartAt("", "", monday); err != nil {
+		t.Fatal(err)
+	}
+	dailies[1].Status = QuestCompleted
+	tuesday := monday.Add(24 * time.Hour)
+	quests, added, expired = RefreshGeneratedQuests(quests, c, settings, tuesday, clock)
+	if len(added) != settings.Daily || len(expired) != 1 || expired[0] != dailies[0] {
+		t.Fatalf("next day: %d added, %d expired; want %d added and the started daily expired", len(added), len(expired), settings.Daily)
+	}
+	// Manual quest, the weekly and the new dailies remain
+	if want := 1 + settings.Weekly + settings.Daily; len(quests) != want {
+		t.Errorf("quests after rollover = %d, want %d", len(quests), want)
+	}
+	for _, quest := range quests {
+		if quest.Expired(tuesday) {
+			t.Errorf("expired quest %q is still in the quest log", quest.Title)
+		}
+	}
+
+	// A finished quest waiting for its reward to be picked outlives its week
+	var weekly *Quest
+	for _, quest := range quests {
+		if quest.Generated != nil && quest.Generated.Period == PeriodWeekly {
+			weekly = quest
+		}
+	}
+	weekly.Status = QuestCompleted
+	weekly.RewardPending = true
+	quests, _, _ = RefreshGeneratedQuests(quests, c, settings, monday.AddDate(0, 0, 7), clock)
+	if !slices.Contains(quests, weekly) {
+		t.Error("quest with a pending reward was removed")
+	}
+}
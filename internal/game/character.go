@@ -15,29 +15,143 @@ type Character struct {
 	Name      string    `json:"name"`       // Player's chosen character name
 	CreatedAt time.Time `json:"created_at"` // When the character was created
 
+	// Schema - Version of the stored JSON (see CharacterSchemaVersion)
+	SchemaVersion int `json:"schema_version,omitempty"`
+
+	// Profile - Optional motto, pronouns, avatar seed and favorite language
+	Profile CharacterProfile `json:"profile"`
+
 	// Core Stats - Primary progression metrics
 	Level         int `json:"level"`            // Current character level
 	XP            int `json:"xp"`               // Current experience points
 	XPToNextLevel int `json:"xp_to_next_level"` // XP required to reach next level
 
 	// RPG Stats - Character attributes that affect gameplay
-	CodePower int `json:"code_power"` // Increases commit quality bonus (damage output)
-	Wisdom    int `json:"wisdom"`     // Increases XP gain (experience multiplier)
-	Agility   int `json:"agility"`    // Faster quest completion bonuses (speed)
+	CodePower int `json:"code_power"`         // Unlocks stat-gated quests (damage output)
+	Wisdom    int `json:"wisdom"`             // Increases XP gain (experience multiplier)
+	Agility   int `json:"agility"`            // Unlocks stat-gated quests (speed)
+	Wellness  int `json:"wellness,omitempty"` // Earned by taking reminded breaks
 
 	// Progress Tracking - Lifetime statistics
-	TotalCommits      int       `json:"total_commits"`       // All-time commit count
-	TotalLinesAdded   int       `json:"total_lines_added"`   // All-time lines of code added
-	TotalLinesRemoved int       `json:"total_lines_removed"` // All-time lines of code removed
-	QuestsCompleted   int       `json:"quests_completed"`    // Total quests completed
-	CurrentStreak     int       `json:"current_streak"`      // Consecutive days of activity
-	LongestStreak     int       `json:"longest_streak"`      // Best streak ever achieved
-	LastActiveDate    time.Time `json:"last_active_date"`    // Last day the player was active
+	TotalCommits      int                       `json:"total_commits"`                // All-time commit count
+	TotalLinesAdded   int                       `json:"total_lines_added"`            // All-time lines of code added
+	TotalLinesRemoved int                       `json:"total_lines_removed"`          // All-time lines of code removed
+	QuestsCompleted   int                       `json:"quests_completed"`             // Total quests completed
+	CurrentStreak     int                       `json:"current_streak"`               // Consecutive days of activity
+	LongestStreak     int                       `json:"longest_streak"`               // Best streak ever achieved
+	LastActiveDate    time.Time                 `json:"last_active_date"`             // Last day the player was active
+	LastActiveTZ      string                    `json:"last_active_tz,omitempty"`     // Timezone LastActiveDate's streak day was evaluated in
+	ActivityDays      map[string]int            `json:"activity_days,omitempty"`      // Commits per streak day (YYYY-MM-DD), for the heatmap
+	RepoActivityDays  map[string]map[string]int `json:"repo_activity_days,omitempty"` // Commits per streak day (YYYY-MM-DD) per repository path, for commit geography
 
 	// Session Stats - Today's activity (resets daily)
 	TodayCommits     int           `json:"today_commits"`      // Commits made today
 	TodayLinesAdded  int           `json:"today_lines_added"`  // Lines added today
 	TodaySessionTime time.Duration `json:"today_session_time"` // Time spent coding today
+
+	// Daily XP budget - Anti-grind safeguards (counters reset when the day changes)
+	BudgetDate     time.Time `json:"budget_date"`      // Day the budget counters belong to
+	TodayCommitXP  int       `json:"today_commit_xp"`  // Commit XP earned today (counts against the daily cap)
+	TodayXPCommits int       `json:"today_xp_commits"` // Commits rewarded today (drives diminishing returns)
+	RestBonusXP    int       `json:"rest_bonus_xp"`    // Rested XP carried over from unspent budget
+
+	// Unlocks - Achievements earned (used by quest requirements)
+	Achievements []string `json:"achievements,omitempty"` // Unlocked achievement IDs
+
+	// Quest rewards - Picked instead of fixed XP on bigger quests
+	SkillPoints  int       `json:"skill_points,omitempty"`   // Unspent stat points (level-ups and quest rewards)
+	Items        []Item    `json:"items,omitempty"`          // Collected trophy items
+	XPBoostUntil time.Time `json:"xp_boost_until,omitempty"` // Commit XP boost active until
+
+	// Effort - Universal currency refunded from abandoned quest progress
+	Effort        int `json:"effort,omitempty"`         // Spendable on daily quest rerolls and streak freezes
+	StreakFreezes int `json:"streak_freezes,omitempty"` // Owned freezes (each covers one missed day)
+
+	// Streak redemption - A broken streak the Redemption quest can half restore
+	LostStreak        int  `json:"lost_streak,omitempty"`        // Length of the last streak that broke (0 once redeemed)
+	RedemptionOffered bool `json:"redemption_offered,omitempty"` // Whether the Redemption quest was offered for it
+
+	// Quest streak - Consecutive days with a quest completed, and weekly crowns
+	QuestStreak        int       `json:"quest_streak,omitempty"`         // Consecutive days with at least one quest completed
+	LongestQuestStreak int       `json:"longest_quest_streak,omitempty"` // Best quest streak ever achieved
+	LastQuestDay       time.Time `json:"last_quest_day,omitempty"`       // When the last quest was completed
+	Crowns             int       `json:"crowns,omitempty"`               // Weeks with a quest completed every day
+	LastCrownWeek      string    `json:"last_crown_week,omitempty"`      // Week (WeekKey) of the last crown
+
+	// Season pass - Seasonal XP, claimed reward tiers and what they unlocked (see SeasonTiers)
+	Season        string   `json:"season,omitempty"`         // Season (SeasonKey) SeasonXP and SeasonClaimed belong to
+	SeasonXP      int      `json:"season_xp,omitempty"`      // XP earned this season
+	SeasonClaimed []int    `json:"season_claimed,omitempty"` // Tiers claimed this season
+	Titles        []string `json:"titles,omitempty"`         // Titles unlocked from season tiers
+	Title         string   `json:"title,omitempty"`          // Equipped title, shown on the character sheet
+	Cosmetics     []string `json:"cosmetics,omitempty"`      // Cosmetics collected from season tiers and loot drops
+
+	// Loot - Items dropped by commits (see LootTable)
+	XPScrolls int        `json:"xp_scrolls,omitempty"` // Held XP scrolls (each a commit XP boost when read)
+	LootPity  int        `json:"loot_pity,omitempty"`  // Qualifying commits since the last drop
+	LootDrops []LootDrop `json:"loot_drops,omitempty"` // Recent drops (oldest first, see LootHistoryLimit)
+
+	// Stat allocation - Where skill points were spent, and respecs
+	StatAllocations []StatAllocation `json:"stat_allocations,omitempty"` // Allocation history (oldest first)
+
+	// Stat decay - Points faded during long absences (opt-in, see [decay])
+	DecayedStats map[string]int `json:"decayed_stats,omitempty"` // Faded points per stat, restored by the comeback quest
+	DecaySteps   int            `json:"decay_steps,omitempty"`   // Decay periods already applied in the current absence
+
+	// Projects - Rollup stats per project (named repo groups from config)
+	Projects map[string]*ProjectStats `json:"projects,omitempty"`
+
+	// Personal bests - Most XP in a day, longest session, biggest commit, fastest quest
+	Records PersonalRecords `json:"records"`
+
+	// Dependencies - Lifetime go.mod dependency bumps (Dependency Wrangler track)
+	DependencyBumps int `json:"dependency_bumps,omitempty"`
+
+	// Collaboration - Lifetime review and pairing credit from commit trailers and git notes
+	ReviewsGiven      int `json:"reviews_given,omitempty"`       // Teammates' commits the player reviewed (Reviewed-by)
+	ReviewsReceived   int `json:"reviews_received,omitempty"`    // Teammate reviews on the player's commits
+	CoAuthoredCommits int `json:"co_authored_commits,omitempty"` // Commits shared with a teammate (Co-authored-by)
+
+	// WellFormedCommits - Lifetime commits with conventional commit messages (Well-Formed quest line)
+	WellFormedCommits int `json:"well_formed_commits,omitempty"`
+
+	// Open source - Lifetime commits to other people's repositories (Open Source track)
+	OpenSourceCommits  int      `json:"open_source_commits,omitempty"`
+	OpenSourceProjects []string `json:"open_source_projects,omitempty"` // Projects contributed to ("owner/name"), first contribution first
+
+	// Languages - Changed files per programming language (profile's top languages)
+	Languages map[string]int `json:"languages,omitempty"`
+
+	// Wellbeing - Self-reported energy check-ins
+	EnergyLog []EnergyCheckIn `json:"energy_log,omitempty"` // Daily energy ratings with productivity
+	BreakLog  []BreakRecord   `json:"break_log,omitempty"`  // Answered break reminders (adherence)
+
+	// Sleep hygiene - Late-night commits and the Well Rested track (only while warnings are on)
+	LateNights      []string  `json:"late_nights,omitempty"`       // Recent nights (YYYY-MM-DD) with a commit after bedtime
+	LateNightWarned string    `json:"late_night_warned,omitempty"` // Night of the last late-night warning
+	RestedSince     time.Time `json:"rested_since,omitempty"`      // When the current run without late-night commits began (zero while untracked)
+
+	// Rollups - Daily totals of break records older than the raw retention window
+	BreakDays map[string]BreakTally `json:"break_days,omitempty"` // Break outcomes per day (YYYY-MM-DD)
+
+	// Work in progress - When each repository's uncommitted changes first reached wip.min_lines (by repository path)
+	UncommittedSince map[string]time.Time `json:"uncommitted_since,omitempty"`
+
+	// Anomaly detection - Suspicious commit XP held for review
+	FlaggedXP   []FlaggedXP `json:"flagged_xp,omitempty"`   // XP waiting to be accepted or discarded
+	RecentDiffs []string    `json:"recent_diffs,omitempty"` // Fingerprints of recent diffs (repeat detection)
+
+	// Companion - Pet that grows with streak consistency and quest variety
+	Pet Pet `json:"pet"`
+
+	// Onboarding - Guided tutorial state
+	TutorialCompleted bool `json:"tutorial_completed,omitempty"` // Tour finished or skipped (never auto-replays)
+
+	// Celebration limits - Week (e.g. "2025-W10") of the last weekly summary
+	LastWeeklySummary string `json:"last_weekly_summary,omitempty"`
+
+	// Welcome back - When the last welcome-back summary was shown (once per absence)
+	WelcomedBackAt time.Time `json:"welcomed_back_at,omitempty"`
 }
 
 // NewCharacter creates a new character with starting stats.
@@ -54,9 +168,10 @@ func NewCharacter(name string) *Character {
 
 	return &Character{
 		// Identity
-		ID:        generateID(),
-		Name:      name,
-		CreatedAt: now,
+		ID:            generateID(),
+		Name:          name,
+		CreatedAt:     now,
+		SchemaVersion: CharacterSchemaVersion,
 
 		// Core Stats
 		Level:         startingLevel,
@@ -111,11 +226,9 @@ func (c *Character) AddXP(amount int) bool {
 		// Recalculate XP needed for the new level
 		c.XPToNextLevel = calculateXPForLevel(c.Level)
 
-		// On level up, grant small stat increases
+		// On level up, grant stat points for the player to allocate
 		// This makes leveling feel rewarding beyond just the level number
-		c.CodePower++
-		c.Wisdom++
-		c.Agility++
+		c.SkillPoints += StatPointsPerLevel
 	}
 
 	return leveledUp
@@ -124,39 +237,10 @@ func (c *Character) AddXP(amount int) bool {
 // UpdateStreak updates the daily activity streak counter.
 // This should be called whenever the player performs an activity (like making a commit).
 // It maintains both the current streak and tracks the longest streak achieved.
+// Days are evaluated in the system timezone; use UpdateStreakAt to apply a
+// configured home timezone and grace window.
 func (c *Character) UpdateStreak() {
-	now := time.Now()
-	today := truncateToDay(now)
-	lastActive := truncateToDay(c.LastActiveDate)
-
-	// Calculate the difference in days
-	daysDiff := int(today.Sub(lastActive).Hours() / 24)
-
-	switch {
-	case daysDiff == 0:
-		// Already active today
-		// If streak is 0 (brand new character or after reset), start it
-		if c.CurrentStreak == 0 {
-			c.CurrentStreak = 1
-		}
-		// Otherwise no change - already counted today
-
-	case daysDiff == 1:
-		// Active yesterday, increment streak
-		c.CurrentStreak++
-
-	default:
-		// Missed a day (or more), reset streak to 1
-		c.CurrentStreak = 1
-	}
-
-	// Update longest streak if current streak is now the best
-	if c.CurrentStreak > c.LongestStreak {
-		c.LongestStreak = c.CurrentStreak
-	}
-
-	// Update last active date to today
-	c.LastActiveDate = now
+	c.UpdateStreakAt(time.Now(), StreakClock{Location: time.Local})
 }
 
 // ResetDailyStats resets all today's statistics to zero.
@@ -180,6 +264,37 @@ func (c *Character) IsToday(t time.Time) bool {
 	return truncateToDay(now).Equal(truncateToDay(t))
 }
 
+// HasAchievement reports whether the character has unlocked an achievement.
+//
+// Parameters:
+//   - id: The achievement identifier
+//
+// Returns:
+//   - bool: true if the achievement is unlocked
+func (c *Character) HasAchievement(id string) bool {
+	for _, achievement := range c.Achievements {
+		if achievement == id {
+			return true
+		}
+	}
+	return false
+}
+
+// UnlockAchievement records an achievement as unlocked.
+//
+// Parameters:
+//   - id: The achievement identifier
+//
+// Returns:
+//   - bool: true if newly unlocked, false if it was already unlocked
+func (c *Character) UnlockAchievement(id string) bool {
+	if id == "" || c.HasAchievement(id) {
+		return false
+	}
+	c.Achievements = append(c.Achievements, id)
+	return true
+}
+
 // generateID creates a unique identifier for the character using UUID v4.
 // This ensures each character has a globally unique ID.
 //
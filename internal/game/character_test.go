@@ -195,6 +195,7 @@ func TestCharacter_AddXP(t *testing.T) {
 			initialCodePower := char.CodePower
 			initialWisdom := char.Wisdom
 			initialAgility := char.Agility
+			initialPoints := char.SkillPoints
 
 			gotLeveledUp := char.AddXP(tt.xpToAdd)
 
@@ -219,29 +220,14 @@ func TestCharacter_AddXP(t *testing.T) {
 				t.Errorf("AddXP() XPToNextLevel = %v, want %v", char.XPToNextLevel, expectedXPToNext)
 			}
 
-			// Verify stat increases on level up
+			// Verify stat points granted on level up (stats are allocated by the player)
 			levelsGained := tt.wantLevel - tt.initialLevel
-			if gotLeveledUp {
-				if char.CodePower != initialCodePower+levelsGained {
-					t.Errorf("AddXP() CodePower = %v, want %v", char.CodePower, initialCodePower+levelsGained)
-				}
-				if char.Wisdom != initialWisdom+levelsGained {
-					t.Errorf("AddXP() Wisdom = %v, want %v", char.Wisdom, initialWisdom+levelsGained)
-				}
-				if char.Agility != initialAgility+levelsGained {
-					t.Errorf("AddXP() Agility = %v, want %v", char.Agility, initialAgility+levelsGained)
-				}
-			} else {
-				// No level up, stats should be unchanged
-				if char.CodePower != initialCodePower {
-					t.Errorf("AddXP() CodePower changed without level up: %v, want %v", char.CodePower, initialCodePower)
-				}
-				if char.Wisdom != initialWisdom {
-					t.Errorf("AddXP() Wisdom changed without level up: %v, want %v", char.Wisdom, initialWisdom)
-				}
-				if char.Agility != initialAgility {
-					t.Errorf("AddXP() Agility changed without level up: %v, want %v", char.Agility, initialAgility)
-				}
+			if char.SkillPoints != initialPoints+levelsGained*StatPointsPerLevel {
+				t.Errorf("AddXP() SkillPoints = %v, want %v", char.SkillPoints, initialPoints+levelsGained*StatPointsPerLevel)
+			}
+			if char.CodePower != initialCodePower || char.Wisdom != initialWisdom || char.Agility != initialAgility {
+				t.Errorf("AddXP() changed stats to %d/%d/%d; level-ups should only grant points",
+					char.CodePower, char.Wisdom, char.Agility)
 			}
 		})
 	}
@@ -496,15 +482,9 @@ func TestCharacter_MultiLevelUp(t *testing.T) {
 		t.Errorf("AddXP() Level = %v, want 4", char.Level)
 	}
 
-	// Verify stats increased by 3 (3 level-ups)
-	if char.CodePower != 13 {
-		t.Errorf("AddXP() CodePower = %v, want 13 (10 + 3 levels)", char.CodePower)
-	}
-	if char.Wisdom != 13 {
-		t.Errorf("AddXP() Wisdom = %v, want 13 (10 + 3 levels)", char.Wisdom)
-	}
-	if char.Agility != 13 {
-		t.Errorf("AddXP() Agility = %v, want 13 (10 + 3 levels)", char.Agility)
+	// Verify stat points granted for 3 level-ups
+	if want := 3 * StatPointsPerLevel; char.SkillPoints != want {
+		t.Errorf("AddXP() SkillPoints = %v, want %v (3 levels)", char.SkillPoints, want)
 	}
 
 	// Verify XP is 0 (exact amount for levels)
@@ -0,0 +1,164 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements review and collaboration credit read from commit
+// trailers (Reviewed-by, Co-authored-by) and git notes, so review work
+// counts without a forge API.
+package game
+
+import "strings"
+
+// Trailer keys that credit collaboration (compared case-insensitively)
+const (
+	trailerReviewedBy   = "reviewed-by"
+	trailerCoAuthoredBy = "co-authored-by"
+)
+
+// CommitTrailers are the people a commit credits besides its author.
+type CommitTrailers struct {
+	ReviewedBy   []string `json:"reviewed_by,omitempty"`    // "Name <email>" from Reviewed-by
+	CoAuthoredBy []string `json:"co_authored_by,omitempty"` // "Name <email>" from Co-authored-by
+}
+
+// CommitCollaboration is the review and collaboration credit one commit earns.
+type CommitCollaboration struct {
+	ReviewsGiven    int // 1 if the player reviewed someone else's commit
+	ReviewsReceived int // Teammates who reviewed the player's commit
+	CoAuthored      int // 1 if the commit was shared with a teammate
+}
+
+// Any reports whether the commit earned any collaboration credit.
+func (c CommitCollaboration) Any() bool {
+	return c.ReviewsGiven > 0 || c.ReviewsReceived > 0 || c.CoAuthored > 0
+}
+
+// ParseCommitTrailers reads Reviewed-by and Co-authored-by trailers from a
+// commit message and its git note. In the message only the last paragraph
+// counts, as with git interpret-trailers; a note is free-form, so every
+// line is checked. Each person is listed once per key.
+//
+// Parameters:
+//   - message: Full commit message
+//   - note: The commit's git note ("" if none)
+//
+// Returns:
+//   - CommitTrailers: The people credited
+func ParseCommitTrailers(message, note string) CommitTrailers {
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n\n")
+	lines := strings.Split(paragraphs[len(paragraphs)-1], "\n")
+	// A single-paragraph message is just a subject, never trailers
+	if len(paragraphs) == 1 {
+		lines = nil
+	}
+	lines = append(lines, strings.Split(note, "\n")...)
+
+	var trailers CommitTrailers
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case trailerReviewedBy:
+			trailers.ReviewedBy = appendPerson(trailers.ReviewedBy, value)
+		case trailerCoAuthoredBy:
+			trailers.CoAuthoredBy = appendPerson(trailers.CoAuthoredBy, value)
+		}
+	}
+	return trailers
+}
+
+// appendPerson adds a person unless someone with the same email is listed.
+func appendPerson(people []string, person string) []string {
+	for _, listed := range people {
+		if TrailerEmail(listed) == TrailerEmail(person) {
+			return people
+		}
+	}
+	return append(people, person)
+}
+
+// TrailerEmail returns the lowercased email of a trailer value like
+// "Ada Lovelace <ada@example.com>", or the whole value if it has no email.
+//
+// Parameters:
+//   - person: Trailer value
+//
+// Returns:
+//   - string: The email (lowercase)
+func TrailerEmail(person string) string {
+	if start := strings.LastIndex(person, "<"); start >= 0 {
+		if end := strings.Index(person[start:], ">"); end > 0 {
+			person = person[start+1 : start+end]
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(person))
+}
+
+// CreditCollaboration works out what a commit's trailers are worth to the
+// player. On the player's own commit, each teammate in Reviewed-by is a
+// review received and any teammate in Co-authored-by makes it a shared
+// commit. On someone else's commit, being in Reviewed-by is a review given
+// and being in Co-authored-by makes it a shared commit.
+//
+// The player is whoever playerEmails lists (config git.author_emails). With
+// none configured every commit is the player's, and only the commit
+// author's own email is the player.
+//
+// Parameters:
+//   - trailers: The commit's trailers
+//   - authorEmail: The commit author's email
+//   - playerEmails: The player's emails (empty for every author)
+//
+// Returns:
+//   - CommitCollaboration: The credit earned
+func CreditCollaboration(trailers CommitTrailers, authorEmail string, playerEmails []string) CommitCollaboration {
+	isPlayer := func(email string) bool {
+		if len(playerEmails) == 0 {
+			return strings.EqualFold(email, authorEmail)
+		}
+		for _, own := range playerEmails {
+			if strings.EqualFold(email, strings.TrimSpace(own)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var credit CommitCollaboration
+	if isPlayer(authorEmail) {
+		for _, reviewer := range trailers.ReviewedBy {
+			if !isPlayer(TrailerEmail(reviewer)) {
+				credit.ReviewsReceived++
+			}
+		}
+		for _, coAuthor := range trailers.CoAuthoredBy {
+			if !isPlayer(TrailerEmail(coAuthor)) {
+				credit.CoAuthored = 1
+			}
+		}
+		return credit
+	}
+
+	for _, reviewer := range trailers.ReviewedBy {
+		if isPlayer(TrailerEmail(reviewer)) {
+			credit.ReviewsGiven = 1
+		}
+	}
+	for _, coAuthor := range trailers.CoAuthoredBy {
+		if isPlayer(TrailerEmail(coAuthor)) {
+			credit.CoAuthored = 1
+		}
+	}
+	return credit
+}
+
+// RecordCollaboration adds a commit's collaboration credit to the
+// character's lifetime stats.
+//
+// Parameters:
+//   - credit: The commit's credit
+func (c *Character) RecordCollaboration(credit CommitCollaboration) {
+	c.ReviewsGiven += credit.ReviewsGiven
+	c.ReviewsReceived += credit.ReviewsReceived
+	c.CoAuthoredCommits += credit.CoAuthored
+}
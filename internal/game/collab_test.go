@@ -0,0 +1,150 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestParseCommitTrailers tests reading reviewers and co-authors from the
+// message's trailer block and the git note.
+func TestParseCommitTrailers(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		note    string
+		want    CommitTrailers
+	}{
+		{
+			name:    "trailer block",
+			message: "Fix login\n\nLong explanation.\n\nReviewed-by: Ada <ada@example.com>\nco-authored-by: Bob <bob@example.com>\n",
+			want:    CommitTrailers{ReviewedBy: []string{"Ada <ada@example.com>"}, CoAuthoredBy: []string{"Bob <bob@example.com>"}},
+		},
+		{
+			name:    "trailers only count in the last paragraph",
+			message: "Fix login\n\nReviewed-by: Ada <ada@example.com>\n\nThanks all.",
+		},
+		{
+			name:    "subject alone is never a trailer",
+			message: "Reviewed-by: Ada <ada@example.com>",
+		},
+		{
+			name:    "git note and duplicates",
+			message: "Fix login\n\nReviewed-by: Ada <ada@example.com>",
+			note:    "Review notes:\nReviewed-by: Ada L <ADA@example.com>\nReviewed-by: Cy <cy@example.com>",
+			want:    CommitTrailers{ReviewedBy: []string{"Ada <ada@example.com>", "Cy <cy@example.com>"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCommitTrailers(tt.message, tt.note); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCommitTrailers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCreditCollaboration tests crediting reviews and pairing on the
+// player's own and teammates' commits.
+func TestCreditCollaboration(t *testing.T) {
+	me := []string{"me@example.com"}
+	tests := []struct {
+		name     string
+		trailers CommitTrailers
+		author   string
+		players  []string
+		want     CommitCollaboration
+	}{
+		{
+			name:     "own commit reviewed by two teammates",
+			trailers: CommitTrailers{ReviewedBy: []string{"Ada <ada@example.com>", "Me <me@example.com>", "Bob <bob@example.com>"}},
+			author:   "me@example.com",
+			players:  me,
+			want:     CommitCollaboration{ReviewsReceived: 2},
+		},
+		{
+			name:     "own commit co-authored",
+			trailers: CommitTrailers{CoAuthoredBy: []string{"Ada <ada@example.com>", "Bob <bob@example.com>"}},
+			author:   "ME@example.com",
+			players:  me,
+			want:     CommitCollaboration{CoAuthored: 1},
+		},
+		{
+			name:     "teammate commit I reviewed",
+			trailers: CommitTrailers{ReviewedBy: []string{"Me <me@example.com>"}},
+			author:   "ada@example.com",
+			players:  me,
+			want:     CommitCollaboration{ReviewsGiven: 1},
+		},
+		{
+			name:     "teammate commit I co-wrote",
+			trailers: CommitTrailers{CoAuthoredBy: []string{"Me <me@example.com>"}},
+			author:   "ada@example.com",
+			players:  me,
+			want:     CommitCollaboration{CoAuthored: 1},
+		},
+		{
+			name:     "teammate commit someone else reviewed",
+			trailers: CommitTrailers{ReviewedBy: []string{"Bob <bob@example.com>"}},
+			author:   "ada@example.com",
+			players:  me,
+		},
+		{
+			name:     "no author emails configured",
+			trailers: CommitTrailers{ReviewedBy: []string{"Ada <ada@example.com>", "Me <me@example.com>"}},
+			author:   "me@example.com",
+			want:     CommitCollaboration{ReviewsReceived: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreditCollaboration(tt.trailers, tt.author, tt.players); got != tt.want {
+				t.Errorf("CreditCollaboration() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCollaborationQuests tests collaboration quests and stats progressing
+// from the player's commits and from teammates' commits they reviewed.
+func TestCollaborationQuests(t *testing.T) {
+	peerReview := NewQuest("Second Pair of Eyes", "", QuestTypePeerReview, 2, 100, 1)
+	pairing := NewQuest("Better Together", "", QuestTypePairing, 1, 100, 1)
+	for _, quest := range []*Quest{peerReview, pairing} {
+		if err := quest.Start("", ""); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+	}
+
+	character := NewCharacter("Pair")
+	bus := NewEventBus()
+	handler, err := NewGameEventHandler(character, []*Quest{peerReview, pairing}, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	event := NewCommitEvent("0123456789abcdef", "Fix login", 1, 5, 0)
+	event.Data["collaboration"] = CommitCollaboration{ReviewsReceived: 2}
+	bus.Publish(event)
+	if pairing.Status != QuestCompleted || peerReview.Current != 0 {
+		t.Errorf("after a reviewed commit: pairing %s, peer review %d; want completed, 0", pairing.Status, peerReview.Current)
+	}
+
+	for _, sha := range []string{"1111111111111111", "2222222222222222"} {
+		bus.Publish(NewCollaborationEvent(sha, "/work/app", CommitCollaboration{ReviewsGiven: 1}))
+	}
+	if peerReview.Status != QuestCompleted {
+		t.Errorf("peer review quest = %s at %d/%d, want completed", peerReview.Status, peerReview.Current, peerReview.Target)
+	}
+	if character.ReviewsGiven != 2 || character.ReviewsReceived != 2 || character.TotalCommits != 1 {
+		t.Errorf("stats = %d given, %d received, %d commits; want 2, 2, 1",
+			character.ReviewsGiven, character.ReviewsReceived, character.TotalCommits)
+	}
+}
@@ -0,0 +1,56 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements commit message checks for the Well-Formed quest
+// line: a message is well formed when it follows the Conventional Commits
+// format ("type(scope): description") with a short subject line.
+package game
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxCommitSubjectLength is the longest subject line a well-formed commit
+// message may have.
+const MaxCommitSubjectLength = 72
+
+// ConventionalCommitTypes are the commit types well-formed messages use.
+var ConventionalCommitTypes = []string{
+	"feat", "fix", "docs", "style", "refactor", "perf", "test", "build", "ci", "chore", "revert",
+}
+
+// conventionalSubjectPattern matches "type(scope)!: description", with the
+// scope and breaking-change marker optional.
+var conventionalSubjectPattern = regexp.MustCompile(`^([a-z]+)(\([\w./-]+\))?!?: (\S.*)$`)
+
+// IsConventionalCommit reports whether a commit message is well formed:
+//   - the subject is "type(scope): description" with a known type
+//   - the subject is at most MaxCommitSubjectLength characters and doesn't
+//     end with a period
+//   - a body, if any, is separated from the subject by a blank line
+//
+// Parameters:
+//   - message: Full commit message
+//
+// Returns:
+//   - bool: True for well-formed conventional commit messages
+func IsConventionalCommit(message string) bool {
+	lines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+	subject := strings.TrimRight(lines[0], " \r")
+	if len([]rune(subject)) > MaxCommitSubjectLength || strings.HasSuffix(subject, ".") {
+		return false
+	}
+	if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+		return false
+	}
+
+	match := conventionalSubjectPattern.FindStringSubmatch(subject)
+	if match == nil {
+		return false
+	}
+	for _, commitType := range ConventionalCommitTypes {
+		if match[1] == commitType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestIsConventionalCommit tests recognising well-formed commit messages.
+func TestIsConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    bool
+	}{
+		{name: "type and description", message: "fix: handle expired tokens", want: true},
+		{name: "scope and breaking change", message: "feat(api)!: drop v1 endpoints", want: true},
+		{name: "body after a blank line", message: "docs(readme): add setup\n\nCovers Skate and SSH.\n", want: true},
+		{name: "plain message", message: "Fix login bug", want: false},
+		{name: "unknown type", message: "wip: stuff", want: false},
+		{name: "capitalised type", message: "Fix: handle expired tokens", want: false},
+		{name: "missing space", message: "fix:handle expired tokens", want: false},
+		{name: "trailing period", message: "fix: handle expired tokens.", want: false},
+		{name: "subject too long", message: "refactor: " + strings.Repeat("x", 63), want: false},
+		{name: "body without a blank line", message: "fix: handle expired tokens\nMore detail", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsConventionalCommit(tt.message); got != tt.want {
+				t.Errorf("IsConventionalCommit(%q) = %v, want %v", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWellFormedQuest tests that only conventional commit messages advance
+// the Well-Formed quest line and the lifetime count.
+func TestWellFormedQuest(t *testing.T) {
+	quest := NewQuest("Well-Formed I: First Words", "", QuestTypeWellFormed, 2, 80, 1)
+	if err := quest.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	character := NewCharacter("Scribe")
+	bus := NewEventBus()
+	handler, err := NewGameEventHandler(character, []*Quest{quest}, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	bus.Publish(NewCommitEvent("1111111111111111", "fix(auth): handle expired tokens", 1, 5, 0))
+	bus.Publish(NewCommitEvent("2222222222222222", "more stuff", 1, 5, 0))
+	if quest.Current != 1 || character.WellFormedCommits != 1 {
+		t.Errorf("after one well-formed commit: quest %d, stat %d; want 1, 1", quest.Current, character.WellFormedCommits)
+	}
+
+	bus.Publish(NewCommitEvent("3333333333333333", "docs: explain the quest line", 1, 5, 0))
+	if quest.Status != QuestCompleted {
+		t.Errorf("quest = %s at %d/%d, want completed", quest.Status, quest.Current, quest.Target)
+	}
+}
@@ -0,0 +1,210 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the opt-in stat decay: after weeks without commits,
+// CodePower and Agility drift back toward their base value one point at a
+// time. Level and XP never drop, faded points are remembered, and a
+// comeback quest wins them all back.
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// ComebackTemplateID marks the quest that restores faded stats.
+const ComebackTemplateID = "comeback"
+
+// decayWarningDays is how early the player is warned before stats fade.
+const decayWarningDays = 7
+
+// DecayStats are the stats that fade during long absences.
+var DecayStats = []string{"code_power", "agility"}
+
+// StatDecay is the stat decay policy.
+type StatDecay struct {
+	Enabled      bool        // Stats fade at all
+	GraceDays    int         // Days without commits before stats start fading
+	DaysPerPoint int         // Days per point each stat loses after the grace period
+	Clock        StreakClock // Decides which day activity belongs to
+}
+
+// StatDecayFromConfig builds the decay policy from the config, filling in
+// defaults for unset values.
+//
+// Parameters:
+//   - cfg: Application config (nil means decay is off)
+//
+// Returns:
+//   - StatDecay: The policy to apply
+func StatDecayFromConfig(cfg *config.Config) StatDecay {
+	var decay config.DecayConfig
+	if cfg != nil {
+		decay = cfg.Decay
+	}
+	decay = decay.WithDefaults()
+	return StatDecay{
+		Enabled:      decay.Enabled,
+		GraceDays:    decay.GraceDays,
+		DaysPerPoint: decay.DaysPerPoint,
+		Clock:        StreakClockFromConfig(cfg),
+	}
+}
+
+// daysAway returns the days since the character was last active.
+func (d StatDecay) daysAway(c *Character, now time.Time) int {
+	return int(d.Clock.Day(now).Sub(d.Clock.Day(c.LastActiveDate)).Hours() / 24)
+}
+
+// DaysUntilDecay returns how many days are left before stats start fading,
+// for warning the player. Nothing is returned when decay is off, the
+// warning window hasn't started, stats are already fading, or no stat is
+// above its base value.
+//
+// Parameters:
+//   - c: The character
+//   - now: Current time
+//
+// Returns:
+//   - int: Days left before the first point fades
+//   - bool: True if the player should be warned
+func (d StatDecay) DaysUntilDecay(c *Character, now time.Time) (int, bool) {
+	if !d.Enabled || !c.hasDecayableStats() {
+		return 0, false
+	}
+	left := d.GraceDays + 1 - d.daysAway(c, now)
+	return left, left > 0 && left <= decayWarningDays
+}
+
+// hasDecayableStats reports whether any fading stat is above its base value.
+func (c *Character) hasDecayableStats() bool {
+	for _, stat := range DecayStats {
+		if *statField(c, stat) > BaseStatValue {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyStatDecay fades stats for the time the character has been away:
+// after the grace period, each of CodePower and Agility loses one point per
+// DaysPerPoint days, never going below the base value. It can be called any
+// number of times during an absence; only newly due points fade. Being
+// active again starts the next absence from scratch.
+//
+// Parameters:
+//   - d: The decay policy
+//   - now: Current time
+//
+// Returns:
+//   - map[string]int: Points each stat just lost (empty if none)
+func (c *Character) ApplyStatDecay(d StatDecay, now time.Time) map[string]int {
+	lost := make(map[string]int)
+	if !d.Enabled {
+		return lost
+	}
+
+	away := d.daysAway(c, now)
+	if away <= d.GraceDays {
+		c.DecaySteps = 0
+		return lost
+	}
+
+	steps := (away-d.GraceDays-1)/d.DaysPerPoint + 1
+	for ; c.DecaySteps < steps; c.DecaySteps++ {
+		for _, stat := range DecayStats {
+			field := statField(c, stat)
+			if *field <= BaseStatValue {
+				continue
+			}
+			*field--
+			lost[stat]++
+			if c.DecayedStats == nil {
+				c.DecayedStats = make(map[string]int)
+			}
+			c.DecayedStats[stat]++
+		}
+	}
+	return lost
+}
+
+// DecayedPoints counts the faded stat points the comeback quest restores.
+//
+// Returns:
+//   - int: Faded points across all stats
+func (c *Character) DecayedPoints() int {
+	total := 0
+	for _, points := range c.DecayedStats {
+		total += points
+	}
+	return total
+}
+
+// RestoreDecayedStats gives back every faded stat point.
+//
+// Returns:
+//   - int: Points restored
+func (c *Character) RestoreDecayedStats() int {
+	restored := 0
+	for stat, points := range c.DecayedStats {
+		if field := statField(c, stat); field != nil {
+			*field += points
+			restored += points
+		}
+	}
+	c.DecayedStats = nil
+	return restored
+}
+
+// DecayMessage describes points that just faded, e.g. "Code Power -1, Agility -1".
+//
+// Parameters:
+//   - lost: Points each stat lost, from ApplyStatDecay
+//
+// Returns:
+//   - string: The summary ("" if nothing faded)
+func DecayMessage(lost map[string]int) string {
+	message := ""
+	for _, stat := range DecayStats {
+		if lost[stat] == 0 {
+			continue
+		}
+		if message != "" {
+			message += ", "
+		}
+		message += fmt.Sprintf("%s -%d", statLabel(stat), lost[stat])
+	}
+	return message
+}
+
+// EnsureComebackQuest adds the comeback quest when stats have faded and no
+// comeback quest is open. Completing it restores the faded points.
+//
+// Parameters:
+//   - quests: The player's quests
+//   - c: The character
+//
+// Returns:
+//   - []*Quest: The quests, with the comeback quest appended if it was added
+//   - bool: True if the quest was added
+func EnsureComebackQuest(quests []*Quest, c *Character) ([]*Quest, bool) {
+	if c.DecayedPoints() == 0 {
+		return quests, false
+	}
+	for _, quest := range quests {
+		if quest.Template == ComebackTemplateID && (quest.Status == QuestAvailable || quest.Status == QuestActive) {
+			return quests, false
+		}
+	}
+
+	quest := NewQuestBuilder().
+		Title("The Comeback").
+		Description(fmt.Sprintf("Welcome back! Make 3 commits to win back the %d stat points that faded while you were away.", c.DecayedPoints())).
+		Type(QuestTypeCommit).
+		Target(3).
+		XPReward(50).
+		RequiredLevel(1).
+		Template(ComebackTemplateID).
+		MustBuild()
+	return append(quests, quest), true
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestApplyStatDecay tests how many points fade for an absence, that Wisdom
+// and values at the base are left alone, and that repeated checks only fade
+// newly due points.
+func TestApplyStatDecay(t *testing.T) {
+	lastActive := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	decay := StatDecay{Enabled: true, GraceDays: 21, DaysPerPoint: 7, Clock: StreakClock{Location: time.UTC}}
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		daysAway  int
+		wantPower int
+		wantAgil  int
+	}{
+		{"disabled", false, 60, 14, 11},
+		{"within grace", true, 21, 14, 11},
+		{"first day past grace", true, 22, 13, 10},
+		{"three periods, agility at base", true, 36, 11, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.CodePower, c.Wisdom, c.Agility = 14, 15, 11
+			c.LastActiveDate = lastActive
+			d := decay
+			d.Enabled = tt.enabled
+			now := lastActive.AddDate(0, 0, tt.daysAway)
+
+			lost := c.ApplyStatDecay(d, now)
+			if c.CodePower != tt.wantPower || c.Agility != tt.wantAgil || c.Wisdom != 15 {
+				t.Errorf("stats = %d/%d/%d, want %d/15/%d", c.CodePower, c.Wisdom, c.Agility, tt.wantPower, tt.wantAgil)
+			}
+			if got, want := lost["code_power"]+lost["agility"], 14-tt.wantPower+11-tt.wantAgil; got != want || c.DecayedPoints() != want {
+				t.Errorf("lost %v (%d decayed), want %d points", lost, c.DecayedPoints(), want)
+			}
+
+			if again := c.ApplyStatDecay(d, now); len(again) != 0 {
+				t.Errorf("second check lost %v, want nothing new", again)
+			}
+		})
+	}
+}
+
+// TestDaysUntilDecay tests the warning window before stats start fading.
+func TestDaysUntilDecay(t *testing.T) {
+	lastActive := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	decay := StatDecay{Enabled: true, GraceDays: 21, DaysPerPoint: 7, Clock: StreakClock{Location: time.UTC}}
+
+	tests := []struct {
+		name     string
+		daysAway int
+		base     bool
+		wantDays int
+		wantWarn bool
+	}{
+		{"too early to warn", 10, false, 12, false},
+		{"a week out", 15, false, 7, true},
+		{"last day", 21, false, 1, true},
+		{"already fading", 22, false, 0, false},
+		{"nothing to lose", 15, true, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			if !tt.base {
+				c.CodePower = 12
+			}
+			c.LastActiveDate = lastActive
+
+			days, warn := decay.DaysUntilDecay(c, lastActive.AddDate(0, 0, tt.daysAway))
+			if warn != tt.wantWarn || (warn && days != tt.wantDays) {
+				t.Errorf("DaysUntilDecay() = %d, %v; want %d, %v", days, warn, tt.wantDays, tt.wantWarn)
+			}
+		})
+	}
+}
+
+// TestComebackQuest tests that the comeback quest is added once while stats
+// have faded and that faded points come back from it or from a respec.
+func TestComebackQuest(t *testing.T) {
+	c := NewCharacter("Tester")
+	if _, added := EnsureComebackQuest(nil, c); added {
+		t.Fatal("no comeback quest without faded stats")
+	}
+
+	c.CodePower, c.Agility = 12, 11
+	c.DecayedStats = map[string]int{"code_power": 2, "agility": 1}
+	quests, added := EnsureComebackQuest(nil, c)
+	if !added || len(quests) != 1 || quests[0].Template != ComebackTemplateID {
+		t.Fatalf("EnsureComebackQuest() = %v, %v; want the comeback quest", quests, added)
+	}
+	if _, added := EnsureComebackQuest(quests, c); added {
+		t.Error("comeback quest should not be added twice")
+	}
+
+	if got := c.RespecRefund(); got != 6 {
+		t.Errorf("RespecRefund() = %d, want 6 (3 allocated + 3 faded)", got)
+	}
+	if restored := c.RestoreDecayedStats(); restored != 3 || c.CodePower != 14 || c.Agility != 12 || c.DecayedPoints() != 0 {
+		t.Errorf("RestoreDecayedStats() = %d, stats %d/%d; want 3, 14/12", restored, c.CodePower, c.Agility)
+	}
+}
@@ -0,0 +1,220 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements dependency tracking: go.mod requirements are compared
+// before and after a commit to classify dependency bumps, which drive
+// dependency quests and the Dependency Wrangler achievement track.
+package game
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DependencyChangeKind classifies how a go.mod requirement changed.
+type DependencyChangeKind string
+
+const (
+	DependencyAdded     DependencyChangeKind = "added"     // New requirement
+	DependencyRemoved   DependencyChangeKind = "removed"   // Requirement dropped
+	DependencyMajor     DependencyChangeKind = "major"     // Major version bump (v1.x → v2.x)
+	DependencyMinor     DependencyChangeKind = "minor"     // Minor version bump (v1.2 → v1.3)
+	DependencyPatch     DependencyChangeKind = "patch"     // Patch, prerelease or pseudo-version bump
+	DependencyDowngrade DependencyChangeKind = "downgrade" // Moved to a lower version
+)
+
+// DependencyChange is one go.mod requirement that changed in a commit.
+type DependencyChange struct {
+	Module string               `json:"module"`         // Module path
+	From   string               `json:"from,omitempty"` // Version before ("" when added)
+	To     string               `json:"to,omitempty"`   // Version after ("" when removed)
+	Kind   DependencyChangeKind `json:"kind"`           // How it changed
+}
+
+// IsBump reports whether the change moved a dependency to a newer version.
+func (c DependencyChange) IsBump() bool {
+	switch c.Kind {
+	case DependencyMajor, DependencyMinor, DependencyPatch:
+		return true
+	}
+	return false
+}
+
+// AchievementTier is one step of an achievement track.
+type AchievementTier struct {
+	ID        string // Achievement identifier (see Character.Achievements)
+	Name      string // Display name
+	Threshold int    // Progress needed to unlock
+}
+
+// AchievementTiers returns the tiers of every achievement track, track by
+// track in the order the character sheet lists them.
+func AchievementTiers() []AchievementTier {
+	return slices.Concat(DependencyWranglerTiers, QuestCrownTiers, WellRestedTiers, OpenSourceTiers)
+}
+
+// DependencyWranglerTiers is the achievement track for lifetime dependency
+// bumps, in unlock order.
+var DependencyWranglerTiers = []AchievementTier{
+	{ID: "dependency-wrangler-1", Name: "Dependency Wrangler I", Threshold: 5},
+	{ID: "dependency-wrangler-2", Name: "Dependency Wrangler II", Threshold: 25},
+	{ID: "dependency-wrangler-3", Name: "Dependency Wrangler III", Threshold: 100},
+}
+
+// ParseGoModRequires reads the required module versions from a go.mod file.
+// Both single-line and block require directives are understood; comments
+// (including "// indirect") are ignored.
+//
+// Parameters:
+//   - content: go.mod file contents
+//
+// Returns:
+//   - map[string]string: Version by module path
+func ParseGoModRequires(content string) map[string]string {
+	requires := make(map[string]string)
+	inBlock := false
+
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case inBlock && fields[0] == ")":
+			inBlock = false
+		case inBlock:
+			if len(fields) >= 2 {
+				requires[fields[0]] = fields[1]
+			}
+		case fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			inBlock = true
+		case fields[0] == "require" && len(fields) >= 3:
+			requires[fields[1]] = fields[2]
+		}
+	}
+	return requires
+}
+
+// DiffGoMod compares two versions of a go.mod file and classifies every
+// requirement that was added, removed, bumped or downgraded.
+//
+// Parameters:
+//   - before: go.mod contents before the commit ("" if it didn't exist)
+//   - after: go.mod contents after the commit ("" if it was deleted)
+//
+// Returns:
+//   - []DependencyChange: Changes sorted by module path
+func DiffGoMod(before, after string) []DependencyChange {
+	old := ParseGoModRequires(before)
+	updated := ParseGoModRequires(after)
+	changes := make([]DependencyChange, 0)
+
+	for module, to := range updated {
+		from, existed := old[module]
+		switch {
+		case !existed:
+			changes = append(changes, DependencyChange{Module: module, To: to, Kind: DependencyAdded})
+		case from != to:
+			changes = append(changes, DependencyChange{Module: module, From: from, To: to, Kind: classifyVersionChange(from, to)})
+		}
+	}
+	for module, from := range old {
+		if _, kept := updated[module]; !kept {
+			changes = append(changes, DependencyChange{Module: module, From: from, Kind: DependencyRemoved})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Module < changes[j].Module })
+	return changes
+}
+
+// CountDependencyBumps counts the changes that moved a dependency forward.
+//
+// Parameters:
+//   - changes: Changes from DiffGoMod
+//
+// Returns:
+//   - int: Number of bumps
+func CountDependencyBumps(changes []DependencyChange) int {
+	count := 0
+	for _, change := range changes {
+		if change.IsBump() {
+			count++
+		}
+	}
+	return count
+}
+
+// classifyVersionChange compares two module versions by major, minor and
+// patch number. Versions that only differ after the patch number (a
+// prerelease or a newer pseudo-version) count as a patch bump.
+func classifyVersionChange(from, to string) DependencyChangeKind {
+	old, updated := parseVersion(from), parseVersion(to)
+	for i := range old {
+		if updated[i] == old[i] {
+			continue
+		}
+		if updated[i] < old[i] {
+			return DependencyDowngrade
+		}
+		return [...]DependencyChangeKind{DependencyMajor, DependencyMinor, DependencyPatch}[i]
+	}
+	// Same release: a release is newer than its prereleases, and
+	// prereleases and pseudo-versions (timestamped) sort as text
+	oldPre, newPre := prerelease(from), prerelease(to)
+	if (oldPre == "" && newPre != "") || (oldPre != "" && newPre != "" && newPre < oldPre) {
+		return DependencyDowngrade
+	}
+	return DependencyPatch
+}
+
+// prerelease returns the part of a version after the patch number
+// ("rc.1" for "v1.2.3-rc.1"), or "" for a release.
+func prerelease(version string) string {
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		return version[i+1:]
+	}
+	return ""
+}
+
+// parseVersion reads the major, minor and patch numbers of a module version
+// like "v1.2.3", "v1.2.3-rc.1" or "v0.0.0-20240101000000-abcdef123456".
+// Missing or unreadable parts are 0.
+func parseVersion(version string) [3]int {
+	var parts [3]int
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	for i, part := range strings.SplitN(version, ".", 3) {
+		parts[i], _ = strconv.Atoi(part)
+	}
+	return parts
+}
+
+// RecordDependencyBumps adds dependency bumps to the character's lifetime
+// count and unlocks any Dependency Wrangler tiers reached.
+//
+// Parameters:
+//   - bumps: Dependency bumps in a commit
+//
+// Returns:
+//   - []AchievementTier: Tiers newly unlocked (empty if none)
+func (c *Character) RecordDependencyBumps(bumps int) []AchievementTier {
+	if bumps <= 0 {
+		return nil
+	}
+	c.DependencyBumps += bumps
+
+	var unlocked []AchievementTier
+	for _, tier := range DependencyWranglerTiers {
+		if c.DependencyBumps >= tier.Threshold && c.UnlockAchievement(tier.ID) {
+			unlocked = append(unlocked, tier)
+		}
+	}
+	return unlocked
+}
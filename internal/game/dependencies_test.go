@@ -0,0 +1,172 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+const goModBefore = `module example.com/app
+
+go 1.22
+
+require github.com/google/uuid v1.5.0
+
+require (
+	github.com/charmbracelet/bubbletea v0.25.0
+	github.com/fsnotify/fsnotify v1.7.0 // indirect
+	golang.org/x/sys v0.20.0
+	example.com/old v1.0.0
+)
+`
+
+const goModAfter = `module example.com/app
+
+go 1.22
+
+require github.com/google/uuid v1.6.0
+
+require (
+	github.com/charmbracelet/bubbletea v1.3.10
+	github.com/fsnotify/fsnotify v1.7.1 // indirect
+	golang.org/x/sys v0.19.0
+	example.com/new v0.1.0
+)
+`
+
+// TestParseGoModRequires tests reading single-line and block requires.
+func TestParseGoModRequires(t *testing.T) {
+	got := ParseGoModRequires(goModBefore)
+	want := map[string]string{
+		"github.com/google/uuid":             "v1.5.0",
+		"github.com/charmbracelet/bubbletea": "v0.25.0",
+		"github.com/fsnotify/fsnotify":       "v1.7.0",
+		"golang.org/x/sys":                   "v0.20.0",
+		"example.com/old":                    "v1.0.0",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ParseGoModRequires() = %v, want %v", got, want)
+	}
+}
+
+// TestDiffGoMod tests classifying every kind of requirement change.
+func TestDiffGoMod(t *testing.T) {
+	got := DiffGoMod(goModBefore, goModAfter)
+	want := []DependencyChange{
+		{Module: "example.com/new", To: "v0.1.0", Kind: DependencyAdded},
+		{Module: "example.com/old", From: "v1.0.0", Kind: DependencyRemoved},
+		{Module: "github.com/charmbracelet/bubbletea", From: "v0.25.0", To: "v1.3.10", Kind: DependencyMajor},
+		{Module: "github.com/fsnotify/fsnotify", From: "v1.7.0", To: "v1.7.1", Kind: DependencyPatch},
+		{Module: "github.com/google/uuid", From: "v1.5.0", To: "v1.6.0", Kind: DependencyMinor},
+		{Module: "golang.org/x/sys", From: "v0.20.0", To: "v0.19.0", Kind: DependencyDowngrade},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DiffGoMod() = %v, want %v", got, want)
+	}
+	if bumps := CountDependencyBumps(got); bumps != 3 {
+		t.Errorf("CountDependencyBumps() = %d, want 3", bumps)
+	}
+}
+
+// TestClassifyVersionChange tests prereleases and pseudo-versions.
+func TestClassifyVersionChange(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     DependencyChangeKind
+	}{
+		{"v1.2.3", "v2.0.0", DependencyMajor},
+		{"v1.2.3", "v1.10.0", DependencyMinor},
+		{"v1.2.3", "v1.2.4", DependencyPatch},
+		{"v1.3.0-rc.1", "v1.3.0", DependencyPatch},
+		{"v1.3.0", "v1.3.0-rc.1", DependencyDowngrade},
+		{"v0.0.0-20240101000000-abcdef123456", "v0.0.0-20250301000000-123456abcdef", DependencyPatch},
+		{"v0.0.0-20250301000000-123456abcdef", "v0.0.0-20240101000000-abcdef123456", DependencyDowngrade},
+		{"v1.9.0", "v1.8.5", DependencyDowngrade},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"→"+tt.to, func(t *testing.T) {
+			if got := classifyVersionChange(tt.from, tt.to); got != tt.want {
+				t.Errorf("classifyVersionChange(%q, %q) = %s, want %s", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRecordDependencyBumps tests unlocking Dependency Wrangler tiers once.
+func TestRecordDependencyBumps(t *testing.T) {
+	character := NewCharacter("Wrangler")
+
+	if unlocked := character.RecordDependencyBumps(4); len(unlocked) != 0 {
+		t.Errorf("4 bumps unlocked %v, want none", unlocked)
+	}
+	unlocked := character.RecordDependencyBumps(22)
+	if len(unlocked) != 2 || unlocked[0].ID != "dependency-wrangler-1" || unlocked[1].ID != "dependency-wrangler-2" {
+		t.Errorf("26 bumps unlocked %v, want tiers I and II", unlocked)
+	}
+	if unlocked := character.RecordDependencyBumps(1); len(unlocked) != 0 {
+		t.Errorf("tiers unlocked again: %v", unlocked)
+	}
+	if character.DependencyBumps != 27 {
+		t.Errorf("DependencyBumps = %d, want 27", character.DependencyBumps)
+	}
+}
+
+// TestDependencyQuestsFromGoMod tests that go.mod bumps progress the
+// dependency quests and publish achievement events.
+func TestDependencyQuestsFromGoMod(t *testing.T) {
+	character := NewCharacter("Wrangler")
+	character.DependencyBumps = 4
+	quests, _ := SeedQuestTemplates([]*Quest{})
+	var deps, monthly *Quest
+	for _, quest := range quests {
+		switch quest.Template {
+		case "dependency-hygiene":
+			deps = quest
+		case "monthly-maintenance":
+			monthly = quest
+		}
+		if err := quest.Start("", ""); err != nil {
+			t.Fatalf("Start(%s) error = %v", quest.Template, err)
+		}
+	}
+
+	bus := NewEventBus()
+	var achievements []string
+	bus.Subscribe(EventAchievement, func(e Event) {
+		achievements = append(achievements, e.Data["achievement_name"].(string))
+	})
+	handler, err := NewGameEventHandler(character, quests, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	// A commit message that doesn't mention dependencies still counts
+	event := NewCommitEvent("0123456789abcdef", "Refresh modules", 2, 8, 8)
+	event.Data["file_paths"] = []string{"go.mod", "go.sum"}
+	event.Data["dependency_changes"] = DiffGoMod(goModBefore, goModAfter)
+	bus.Publish(event)
+
+	if deps.Current != 1 {
+		t.Errorf("deps quest progress = %d, want 1", deps.Current)
+	}
+	if monthly.Current != 3 || monthly.Status != QuestCompleted {
+		t.Errorf("monthly quest = %d/%d (%s), want completed at 3", monthly.Current, monthly.Target, monthly.Status)
+	}
+	if character.DependencyBumps != 7 || fmt.Sprint(achievements) != "[Dependency Wrangler I]" {
+		t.Errorf("DependencyBumps = %d, achievements = %v; want 7 and tier I", character.DependencyBumps, achievements)
+	}
+
+	// Next month the maintenance quest starts over
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	monthly.StartedAt = &lastMonth
+	if !handler.rolloverRecurringQuests(time.Now()) || monthly.Status != QuestAvailable || monthly.Current != 0 {
+		t.Errorf("monthly quest after rollover = %s at %d, want available at 0", monthly.Status, monthly.Current)
+	}
+}
@@ -0,0 +1,148 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const (
+	// MaxAbandonRefund is the Effort refunded for abandoning a quest at
+	// full progress (partial progress refunds proportionally, rounded down)
+	MaxAbandonRefund = 5
+
+	// RerollCost is the Effort needed to reroll a daily quest
+	RerollCost = 3
+
+	// StreakFreezeCost is the Effort needed to buy a streak freeze
+	StreakFreezeCost = 5
+
+	// MaxStreakFreezes caps how many streak freezes can be held at once
+	MaxStreakFreezes = 2
+)
+
+// DailyChallenge is an objective a daily quest can be rerolled into.
+type DailyChallenge struct {
+	Title       string // Quest title
+	Description string // Quest description
+	Target      int    // Commits needed today
+	XPReward    int    // XP for completing it
+}
+
+// DailyChallenges is the pool daily quest rerolls draw from.
+var DailyChallenges = []DailyChallenge{
+	{Title: "Daily Warm-up", Description: "Make 2 commits today", Target: 2, XPReward: 50},
+	{Title: "Steady Hands", Description: "Make 3 commits today", Target: 3, XPReward: 75},
+	{Title: "Bug Hunt", Description: "Squash bugs across 4 commits today", Target: 4, XPReward: 100},
+	{Title: "Refactor Run", Description: "Tidy up the codebase in 4 commits today", Target: 4, XPReward: 100},
+	{Title: "Shipping Day", Description: "Make 5 commits today", Target: 5, XPReward: 130},
+	{Title: "Marathon", Description: "Make 8 commits today", Target: 8, XPReward: 200},
+}
+
+// AbandonRefund returns the Effort refunded for abandoning a quest: a
+// fraction of MaxAbandonRefund matching the progress made so far.
+//
+// Parameters:
+//   - quest: The quest being abandoned
+//
+// Returns:
+//   - int: Effort to refund (0 for an inactive quest or no progress)
+func AbandonRefund(quest *Quest) int {
+	if quest.Status != QuestActive {
+		return 0
+	}
+	return int(max(0, min(quest.Progress, 1)) * MaxAbandonRefund)
+}
+
+// EarnEffort adds Effort to the character's balance.
+//
+// Parameters:
+//   - amount: Effort earned (ignored unless positive)
+//
+// Returns:
+//   - int: The new balance
+func (c *Character) EarnEffort(amount int) int {
+	if amount > 0 {
+		c.Effort += amount
+	}
+	return c.Effort
+}
+
+// SpendEffort takes Effort from the character's balance.
+//
+// Parameters:
+//   - amount: Effort to spend
+//
+// Returns:
+//   - error: An error if the balance is too low
+func (c *Character) SpendEffort(amount int) error {
+	if amount > c.Effort {
+		return fmt.Errorf("not enough effort (%d needed, %d available)", amount, c.Effort)
+	}
+	c.Effort -= amount
+	return nil
+}
+
+// BuyStreakFreeze spends Effort on a streak freeze. A freeze is used up
+// automatically for each missed day, keeping the streak alive.
+//
+// Returns:
+//   - error: An error if the freeze limit is reached or Effort is too low
+func (c *Character) BuyStreakFreeze() error {
+	if c.StreakFreezes >= MaxStreakFreezes {
+		return fmt.Errorf("already holding the maximum of %d streak freezes", MaxStreakFreezes)
+	}
+	if err := c.SpendEffort(StreakFreezeCost); err != nil {
+		return err
+	}
+	c.StreakFreezes++
+	return nil
+}
+
+// RerollDailyQuest spends Effort to swap a daily quest's objective for a
+// different challenge from DailyChallenges. Progress is cleared; an active
+// quest is restarted on its new objective.
+//
+// Parameters:
+//   - quest: The daily quest to reroll (available or active)
+//   - rng: Source for picking the new challenge
+//
+// Returns:
+//   - error: An error if the quest can't be rerolled or Effort is too low
+func (c *Character) RerollDailyQuest(quest *Quest, rng *rand.Rand) error {
+	if quest.Type != QuestTypeDaily {
+		return fmt.Errorf("only daily quests can be rerolled")
+	}
+	if quest.Status != QuestAvailable && quest.Status != QuestActive {
+		return fmt.Errorf("%s is already %s", quest.Title, quest.Status)
+	}
+
+	// Draw from the challenges other than the current one
+	choices := make([]DailyChallenge, 0, len(DailyChallenges))
+	for _, challenge := range DailyChallenges {
+		if challenge.Title != quest.Title {
+			choices = append(choices, challenge)
+		}
+	}
+	if len(choices) == 0 {
+		return fmt.Errorf("no other daily challenges to reroll into")
+	}
+
+	if err := c.SpendEffort(RerollCost); err != nil {
+		return err
+	}
+
+	wasActive := quest.Status == QuestActive
+	repoPath, baseSHA := quest.GitRepo, quest.GitBaseSHA
+	challenge := choices[rng.Intn(len(choices))]
+
+	quest.Reset()
+	quest.Title = challenge.Title
+	quest.Description = challenge.Description
+	quest.Target = challenge.Target
+	quest.XPReward = challenge.XPReward
+	if wasActive {
+		return quest.Start(repoPath, baseSHA)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestAbandonRefund tests converting quest progress into Effort.
+func TestAbandonRefund(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    bool
+		progress int
+		want     int
+	}{
+		{"not started", false, 0, 0},
+		{"no progress", true, 0, 0},
+		{"partial progress rounds down", true, 5, 2},
+		{"nearly done", true, 9, 4},
+		{"complete", true, 10, MaxAbandonRefund},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Marathon", "", QuestTypeCommit, 10, 100, 1)
+			if tt.start {
+				if err := quest.Start("", ""); err != nil {
+					t.Fatal(err)
+				}
+				quest.UpdateProgress(tt.progress)
+			}
+			if got := AbandonRefund(quest); got != tt.want {
+				t.Errorf("AbandonRefund() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBuyStreakFreeze tests spending Effort on streak freezes.
+func TestBuyStreakFreeze(t *testing.T) {
+	c := NewCharacter("Tester")
+	if err := c.BuyStreakFreeze(); err == nil {
+		t.Error("buying without Effort should fail")
+	}
+
+	c.EarnEffort(StreakFreezeCost * (MaxStreakFreezes + 1))
+	for i := 0; i < MaxStreakFreezes; i++ {
+		if err := c.BuyStreakFreeze(); err != nil {
+			t.Fatalf("BuyStreakFreeze() #%d: %v", i+1, err)
+		}
+	}
+	if err := c.BuyStreakFreeze(); err == nil {
+		t.Error("buying past the limit should fail")
+	}
+	if c.StreakFreezes != MaxStreakFreezes || c.Effort != StreakFreezeCost {
+		t.Errorf("StreakFreezes = %d, Effort = %d; want %d and %d", c.StreakFreezes, c.Effort, MaxStreakFreezes, StreakFreezeCost)
+	}
+}
+
+// TestStreakFreezeCoversMissedDays tests that freezes keep a streak alive.
+func TestStreakFreezeCoversMissedDays(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	last := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		freezes     int
+		daysLater   int
+		wantStreak  int
+		wantFreezes int
+	}{
+		{"no missed day keeps freezes", 1, 1, 6, 1},
+		{"one missed day uses a freeze", 1, 2, 6, 0},
+		{"two missed days use two freezes", 2, 3, 6, 0},
+		{"too few freezes resets the streak", 1, 3, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.CurrentStreak = 5
+			c.LastActiveDate = last
+			c.StreakFreezes = tt.freezes
+
+			c.UpdateStreakAt(last.AddDate(0, 0, tt.daysLater), clock)
+			if c.CurrentStreak != tt.wantStreak || c.StreakFreezes != tt.wantFreezes {
+				t.Errorf("CurrentStreak = %d, StreakFreezes = %d; want %d and %d",
+					c.CurrentStreak, c.StreakFreezes, tt.wantStreak, tt.wantFreezes)
+			}
+		})
+	}
+}
+
+// TestRerollDailyQuest tests swapping a daily quest for another challenge.
+func TestRerollDailyQuest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	c := NewCharacter("Tester")
+	commitQuest := NewQuest("First Steps", "", QuestTypeCommit, 3, 50, 1)
+	if err := c.RerollDailyQuest(commitQuest, rng); err == nil {
+		t.Error("rerolling a non-daily quest should fail")
+	}
+
+	daily := NewQuest(DailyChallenges[0].Title, "", QuestTypeDaily, DailyChallenges[0].Target, DailyChallenges[0].XPReward, 1)
+	if err := daily.Start("/repo", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	daily.UpdateProgress(1)
+	if err := c.RerollDailyQuest(daily, rng); err == nil {
+		t.Error("rerolling without Effort should fail")
+	}
+
+	c.EarnEffort(RerollCost)
+	if err := c.RerollDailyQuest(daily, rng); err != nil {
+		t.Fatalf("RerollDailyQuest() error = %v", err)
+	}
+	if daily.Title == DailyChallenges[0].Title {
+		t.Error("reroll should pick a different challenge")
+	}
+	if daily.Status != QuestActive || daily.Current != 0 || daily.GitRepo != "/repo" {
+		t.Errorf("rerolled quest: status %s, current %d, repo %q; want active, 0, /repo", daily.Status, daily.Current, daily.GitRepo)
+	}
+	if c.Effort != 0 {
+		t.Errorf("Effort = %d, want 0", c.Effort)
+	}
+}
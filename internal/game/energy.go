@@ -0,0 +1,199 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Energy rating bounds for daily check-ins.
+const (
+	MinEnergyRating = 1 // Exhausted
+	MaxEnergyRating = 5 // Fully charged
+
+	// highEnergyThreshold is the minimum rating considered a "high energy" day
+	highEnergyThreshold = 4
+
+	// minEnergySamples is the minimum number of days needed in each bucket
+	// before a correlation is reported (avoids noisy conclusions)
+	minEnergySamples = 2
+)
+
+// EnergyCheckIn records the player's self-reported energy for a single day
+// together with the productivity observed on that day.
+type EnergyCheckIn struct {
+	Date       time.Time `json:"date"`        // Day of the check-in (local time)
+	Rating     int       `json:"rating"`      // Energy rating from 1 (low) to 5 (high)
+	Commits    int       `json:"commits"`     // Commits made on that day
+	LinesAdded int       `json:"lines_added"` // Lines added on that day
+}
+
+// EnergyCorrelation summarizes how energy ratings relate to productivity.
+type EnergyCorrelation struct {
+	HighEnergyDays       int     // Days rated at or above the high energy threshold
+	LowEnergyDays        int     // Days rated below the high energy threshold
+	HighEnergyAvgCommits float64 // Average commits on high energy days
+	LowEnergyAvgCommits  float64 // Average commits on low energy days
+	PercentDifference    float64 // How many percent more commits on high energy days
+	HasEnoughData        bool    // Whether both buckets have enough samples
+}
+
+// ValidateEnergyRating checks that an energy rating is within bounds.
+//
+// Parameters:
+//   - rating: The rating to validate
+//
+// Returns:
+//   - error: An error if the rating is outside 1-5
+func ValidateEnergyRating(rating int) error {
+	if rating < MinEnergyRating || rating > MaxEnergyRating {
+		return fmt.Errorf("energy rating must be between %d and %d, got %d",
+			MinEnergyRating, MaxEnergyRating, rating)
+	}
+	return nil
+}
+
+// RecordEnergy records today's energy check-in for the character.
+// If a check-in already exists for today, its rating is replaced while the
+// tracked productivity is preserved.
+//
+// Parameters:
+//   - rating: Energy rating from 1 (low) to 5 (high)
+//
+// Returns:
+//   - error: An error if the rating is invalid
+func (c *Character) RecordEnergy(rating int) error {
+	if err := ValidateEnergyRating(rating); err != nil {
+		return err
+	}
+
+	if checkIn := c.TodayEnergy(); checkIn != nil {
+		checkIn.Rating = rating
+		return nil
+	}
+
+	c.EnergyLog = append(c.EnergyLog, EnergyCheckIn{
+		Date:       truncateToDay(time.Now()),
+		Rating:     rating,
+		Commits:    c.TodayCommits,
+		LinesAdded: c.TodayLinesAdded,
+	})
+	return nil
+}
+
+// TodayEnergy returns today's energy check-in, if one was recorded.
+//
+// Returns:
+//   - *EnergyCheckIn: Pointer into the energy log, or nil if not checked in today
+func (c *Character) TodayEnergy() *EnergyCheckIn {
+	for i := len(c.EnergyLog) - 1; i >= 0; i-- {
+		if c.IsToday(c.EnergyLog[i].Date) {
+			return &c.EnergyLog[i]
+		}
+	}
+	return nil
+}
+
+// SyncEnergyProductivity copies today's activity into today's check-in.
+// This should be called after daily stats change (e.g. after a commit) so
+// the correlation stats reflect the whole day's output.
+func (c *Character) SyncEnergyProductivity() {
+	if checkIn := c.TodayEnergy(); checkIn != nil {
+		checkIn.Commits = c.TodayCommits
+		checkIn.LinesAdded = c.TodayLinesAdded
+	}
+}
+
+// CalculateEnergyCorrelation compares productivity on high and low energy days.
+//
+// Parameters:
+//   - log: The energy check-in history
+//
+// Returns:
+//   - EnergyCorrelation: Summary statistics for the two energy buckets
+func CalculateEnergyCorrelation(log []EnergyCheckIn) EnergyCorrelation {
+	var result EnergyCorrelation
+	highCommits, lowCommits := 0, 0
+
+	for _, checkIn := range log {
+		if checkIn.Rating >= highEnergyThreshold {
+			result.HighEnergyDays++
+			highCommits += checkIn.Commits
+		} else {
+			result.LowEnergyDays++
+			lowCommits += checkIn.Commits
+		}
+	}
+
+	if result.HighEnergyDays > 0 {
+		result.HighEnergyAvgCommits = float64(highCommits) / float64(result.HighEnergyDays)
+	}
+	if result.LowEnergyDays > 0 {
+		result.LowEnergyAvgCommits = float64(lowCommits) / float64(result.LowEnergyDays)
+	}
+
+	result.HasEnoughData = result.HighEnergyDays >= minEnergySamples &&
+		result.LowEnergyDays >= minEnergySamples
+
+	if result.LowEnergyAvgCommits > 0 {
+		result.PercentDifference = (result.HighEnergyAvgCommits - result.LowEnergyAvgCommits) /
+			result.LowEnergyAvgCommits * 100
+	}
+
+	return result
+}
+
+// Summary returns a human-readable description of the correlation.
+//
+// Returns:
+//   - string: e.g. "You commit 40% more on high-energy days"
+func (e EnergyCorrelation) Summary() string {
+	if !e.HasEnoughData {
+		return fmt.Sprintf("Log energy on at least %d high and %d low energy days to see trends",
+			minEnergySamples, minEnergySamples)
+	}
+
+	if e.LowEnergyAvgCommits == 0 {
+		if e.HighEnergyAvgCommits == 0 {
+			return "No commits recorded on checked-in days yet"
+		}
+		return "You only commit on high-energy days"
+	}
+
+	switch {
+	case e.PercentDifference >= 1:
+		return fmt.Sprintf("You commit %.0f%% more on high-energy days", e.PercentDifference)
+	case e.PercentDifference <= -1:
+		return fmt.Sprintf("You commit %.0f%% less on high-energy days", -e.PercentDifference)
+	default:
+		return "Your output is steady regardless of energy"
+	}
+}
+
+// EnergyTip returns a short suggestion based on today's check-in and history.
+//
+// Parameters:
+//   - today: Today's check-in (may be nil if the player hasn't checked in)
+//   - correlation: The player's historical energy correlation
+//
+// Returns:
+//   - string: A tip for the player
+func EnergyTip(today *EnergyCheckIn, correlation EnergyCorrelation) string {
+	if today == nil {
+		return "Check in with --energy 1-5 to track how energy affects your output"
+	}
+
+	highEnergy := today.Rating >= highEnergyThreshold
+	energyMatters := correlation.HasEnoughData && correlation.PercentDifference >= 20
+
+	switch {
+	case highEnergy && energyMatters:
+		return "High energy today - a great time to tackle a hard quest"
+	case highEnergy:
+		return "Feeling good! Pick a challenging task while it lasts"
+	case energyMatters:
+		return "Low energy days slow you down - try small, focused commits"
+	default:
+		return "Take it easy - small wins still count"
+	}
+}
@@ -0,0 +1,182 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestValidateEnergyRating tests energy rating bounds
+func TestValidateEnergyRating(t *testing.T) {
+	tests := []struct {
+		name    string
+		rating  int
+		wantErr bool
+	}{
+		{"minimum", 1, false},
+		{"middle", 3, false},
+		{"maximum", 5, false},
+		{"zero", 0, true},
+		{"too high", 6, true},
+		{"negative", -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEnergyRating(tt.rating)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateEnergyRating(%d) error = %v, wantErr %v", tt.rating, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRecordEnergy tests recording and replacing today's check-in
+func TestRecordEnergy(t *testing.T) {
+	char := NewCharacter("TestHero")
+	char.TodayCommits = 2
+
+	if char.TodayEnergy() != nil {
+		t.Fatal("TodayEnergy() should be nil before any check-in")
+	}
+
+	if err := char.RecordEnergy(4); err != nil {
+		t.Fatalf("RecordEnergy(4) unexpected error: %v", err)
+	}
+	if err := char.RecordEnergy(2); err != nil {
+		t.Fatalf("RecordEnergy(2) unexpected error: %v", err)
+	}
+
+	if len(char.EnergyLog) != 1 {
+		t.Fatalf("EnergyLog length = %d, want 1 (same-day check-ins replace)", len(char.EnergyLog))
+	}
+
+	today := char.TodayEnergy()
+	if today.Rating != 2 {
+		t.Errorf("TodayEnergy().Rating = %d, want 2", today.Rating)
+	}
+	if today.Commits != 2 {
+		t.Errorf("TodayEnergy().Commits = %d, want 2", today.Commits)
+	}
+
+	if err := char.RecordEnergy(9); err == nil {
+		t.Error("RecordEnergy(9) expected error, got nil")
+	}
+}
+
+// TestSyncEnergyProductivity tests that today's activity is copied into the check-in
+func TestSyncEnergyProductivity(t *testing.T) {
+	char := NewCharacter("TestHero")
+
+	// No check-in - should be a no-op
+	char.SyncEnergyProductivity()
+
+	char.RecordEnergy(5)
+	char.TodayCommits = 7
+	char.TodayLinesAdded = 120
+	char.SyncEnergyProductivity()
+
+	today := char.TodayEnergy()
+	if today.Commits != 7 || today.LinesAdded != 120 {
+		t.Errorf("SyncEnergyProductivity() = %d commits, %d lines, want 7, 120",
+			today.Commits, today.LinesAdded)
+	}
+}
+
+// TestCalculateEnergyCorrelation tests bucket averages and summaries
+func TestCalculateEnergyCorrelation(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2025, 1, n, 0, 0, 0, 0, time.Local) }
+
+	tests := []struct {
+		name        string
+		log         []EnergyCheckIn
+		wantEnough  bool
+		wantPercent float64
+		wantSummary string
+	}{
+		{
+			name:        "empty log",
+			log:         nil,
+			wantEnough:  false,
+			wantSummary: "Log energy",
+		},
+		{
+			name: "40 percent more on high energy",
+			log: []EnergyCheckIn{
+				{Date: day(1), Rating: 5, Commits: 7},
+				{Date: day(2), Rating: 4, Commits: 7},
+				{Date: day(3), Rating: 2, Commits: 5},
+				{Date: day(4), Rating: 1, Commits: 5},
+			},
+			wantEnough:  true,
+			wantPercent: 40,
+			wantSummary: "You commit 40% more on high-energy days",
+		},
+		{
+			name: "less on high energy",
+			log: []EnergyCheckIn{
+				{Date: day(1), Rating: 5, Commits: 2},
+				{Date: day(2), Rating: 4, Commits: 2},
+				{Date: day(3), Rating: 3, Commits: 4},
+				{Date: day(4), Rating: 1, Commits: 4},
+			},
+			wantEnough:  true,
+			wantPercent: -50,
+			wantSummary: "You commit 50% less on high-energy days",
+		},
+		{
+			name: "only one low energy day",
+			log: []EnergyCheckIn{
+				{Date: day(1), Rating: 5, Commits: 7},
+				{Date: day(2), Rating: 4, Commits: 7},
+				{Date: day(3), Rating: 2, Commits: 5},
+			},
+			wantEnough:  false,
+			wantPercent: 40,
+			wantSummary: "Log energy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateEnergyCorrelation(tt.log)
+
+			if got.HasEnoughData != tt.wantEnough {
+				t.Errorf("HasEnoughData = %v, want %v", got.HasEnoughData, tt.wantEnough)
+			}
+			if got.PercentDifference != tt.wantPercent {
+				t.Errorf("PercentDifference = %v, want %v", got.PercentDifference, tt.wantPercent)
+			}
+			if !strings.HasPrefix(got.Summary(), tt.wantSummary) {
+				t.Errorf("Summary() = %q, want prefix %q", got.Summary(), tt.wantSummary)
+			}
+		})
+	}
+}
+
+// TestEnergyTip tests tip selection
+func TestEnergyTip(t *testing.T) {
+	strong := EnergyCorrelation{HasEnoughData: true, PercentDifference: 40}
+
+	tests := []struct {
+		name        string
+		today       *EnergyCheckIn
+		correlation EnergyCorrelation
+		wantContain string
+	}{
+		{"no check-in", nil, strong, "--energy"},
+		{"high energy, energy matters", &EnergyCheckIn{Rating: 5}, strong, "hard quest"},
+		{"high energy, no data", &EnergyCheckIn{Rating: 4}, EnergyCorrelation{}, "challenging"},
+		{"low energy, energy matters", &EnergyCheckIn{Rating: 2}, strong, "small, focused"},
+		{"low energy, no data", &EnergyCheckIn{Rating: 1}, EnergyCorrelation{}, "small wins"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EnergyTip(tt.today, tt.correlation)
+			if !strings.Contains(got, tt.wantContain) {
+				t.Errorf("EnergyTip() = %q, want it to contain %q", got, tt.wantContain)
+			}
+		})
+	}
+}
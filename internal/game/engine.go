@@ -183,6 +183,31 @@ func ApplyDifficultyMultiplier(baseXP int, difficulty string) int {
 	return int(math.Round(adjustedXP))
 }
 
+// ApplyReplayRate scales XP for commits replayed after downtime.
+// Commits made while CodeQuest wasn't running still earn XP, but at a
+// reduced rate so live tracking remains the better deal. Counted bot
+// commits are scaled the same way by git.bot_xp_rate.
+//
+// Examples:
+//   - 50 XP at rate 0.5: 25 XP
+//   - 50 XP at rate 0: 0 XP (replay tracks stats only)
+//
+// Parameters:
+//   - xp: The XP the commit would earn if tracked live
+//   - rate: Fraction of XP to award (clamped to 0-1)
+//
+// Returns:
+//   - int: The reduced XP
+func ApplyReplayRate(xp int, rate float64) int {
+	if rate < 0 {
+		rate = 0
+	}
+	if rate > 1 {
+		rate = 1
+	}
+	return int(math.Round(float64(xp) * rate))
+}
+
 // ApplyWisdomBonus applies the character's Wisdom stat bonus to XP gains.
 // Wisdom is one of the three core RPG stats and directly increases XP acquisition rate.
 //
@@ -216,18 +241,25 @@ func ApplyDifficultyMultiplier(baseXP int, difficulty string) int {
 // Returns:
 //   - int: The XP after applying wisdom bonus
 func ApplyWisdomBonus(baseXP int, wisdom int) int {
-	// Calculate wisdom bonus percentage
+	// Apply multiplier and round to nearest integer
+	bonusXP := float64(baseXP) * WisdomMultiplier(wisdom)
+	return int(math.Round(bonusXP))
+}
+
+// WisdomMultiplier returns the XP multiplier a Wisdom value gives
+// (ApplyWisdomBonus uses it, and so do the character sheet's stat details).
+//
+// Parameters:
+//   - wisdom: The character's Wisdom stat value
+//
+// Returns:
+//   - float64: The multiplier, never below 1.0
+func WisdomMultiplier(wisdom int) float64 {
 	// Formula: 1 + (wisdom - base) * bonusPerPoint
-	wisdomMultiplier := 1.0 + float64(wisdom-wisdomBaseValue)*wisdomBonusPer10
+	multiplier := 1.0 + float64(wisdom-wisdomBaseValue)*wisdomBonusPer10
 
 	// Ensure multiplier is never negative (minimum 1.0x)
-	if wisdomMultiplier < 1.0 {
-		wisdomMultiplier = 1.0
-	}
-
-	// Apply multiplier and round to nearest integer
-	bonusXP := float64(baseXP) * wisdomMultiplier
-	return int(math.Round(bonusXP))
+	return math.Max(multiplier, 1.0)
 }
 
 // CalculateQuestReward calculates the XP reward for completing a quest.
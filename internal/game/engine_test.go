@@ -254,6 +254,32 @@ func TestApplyWisdomBonus(t *testing.T) {
 	}
 }
 
+// TestApplyReplayRate tests XP scaling for commits replayed after downtime
+func TestApplyReplayRate(t *testing.T) {
+	tests := []struct {
+		name   string
+		xp     int
+		rate   float64
+		wantXP int
+	}{
+		{"half rate", 50, 0.5, 25},
+		{"full rate", 50, 1.0, 50},
+		{"zero rate", 50, 0, 0},
+		{"rounds to nearest", 45, 0.5, 23}, // 22.5 → 23
+		{"rate above 1 clamps", 50, 2.0, 50},
+		{"negative rate clamps", 50, -1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyReplayRate(tt.xp, tt.rate)
+			if got != tt.wantXP {
+				t.Errorf("ApplyReplayRate(%v, %v) = %v, want %v", tt.xp, tt.rate, got, tt.wantXP)
+			}
+		})
+	}
+}
+
 // TestCalculateQuestReward tests quest XP rewards
 func TestCalculateQuestReward(t *testing.T) {
 	tests := []struct {
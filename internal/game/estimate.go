@@ -0,0 +1,83 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the step-by-step commit XP breakdown RewardEngine
+// works out, and projecting it for a commit before it is made, so the XP
+// formula can be shown up front.
+package game
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// CommitXPEstimate is the XP a commit earns (or would earn), split into the
+// steps RewardEngine applies. The parts add up to Total.
+type CommitXPEstimate struct {
+	Commit        int            // Base XP every commit earns
+	Lines         int            // Lines bonus (capped)
+	Squash        int            // Extra XP for the commits a squash merge combined
+	SquashCommits int            // Commits the squash merge combined (0 if it isn't one)
+	Difficulty    int            // Game difficulty bonus (negative on hard)
+	Mode          string         // Game difficulty the bonus is for
+	Wisdom        int            // Character's Wisdom stat bonus
+	Boost         int            // Quest reward XP boost, while one is running
+	Replay        int            // XP lost to the replay rate (replayed commits)
+	Bot           int            // XP lost to the bot rate (counted bot commits)
+	Rules         int            // XP the custom XP rules added (negative when they took XP away)
+	RuleNames     []string       // Custom XP rules that matched, in order
+	Flags         []string       // Flags the matching XP rules attached
+	Budget        XPBudgetResult // Daily budget: diminishing returns, cap and rested bonus
+	Total         int            // XP the commit awards
+}
+
+// LinesCapped reports whether the commit has more changed lines than the
+// lines bonus pays for.
+func (e CommitXPEstimate) LinesCapped() bool {
+	return e.Lines >= maxLinesBonus
+}
+
+// String describes the estimate, e.g. "10 commit +42 lines +6 wisdom = 58 XP".
+// Steps that came to nothing are left out.
+func (e CommitXPEstimate) String() string {
+	parts := []string{fmt.Sprintf("%d commit", e.Commit)}
+	add := func(xp int, label string) {
+		if xp != 0 {
+			parts = append(parts, fmt.Sprintf("%+d %s", xp, label))
+		}
+	}
+	add(e.Lines, "lines")
+	add(e.Squash, "squash")
+	add(e.Difficulty, e.Mode)
+	add(e.Wisdom, "wisdom")
+	add(e.Boost, "boost")
+	add(e.Replay, "replay")
+	add(e.Bot, "bot")
+	add(e.Rules, "rules")
+	add(-e.Budget.LostToDiminished, "diminishing returns")
+	add(-e.Budget.LostToCap, "daily cap")
+	add(e.Budget.RestBonus, "rested")
+	return strings.Join(parts, " ") + fmt.Sprintf(" = %d XP", e.Total)
+}
+
+// EstimateCommitXP projects the XP a commit with the given line counts
+// would earn if it were made now (see RewardEngine.CommitXP). The character
+// is not changed.
+//
+// Parameters:
+//   - linesAdded: Lines the commit would add
+//   - linesRemoved: Lines the commit would remove
+//   - cfg: Application configuration (nil uses normal difficulty and no budget)
+//   - character: Character who would make the commit
+//   - now: When the commit would be made
+//
+// Returns:
+//   - CommitXPEstimate: The projected XP, step by step
+func EstimateCommitXP(linesAdded, linesRemoved int, cfg *config.Config, character *Character, now time.Time) CommitXPEstimate {
+	event := Event{Type: EventCommit, Timestamp: now, Data: map[string]interface{}{
+		"lines_added":   linesAdded,
+		"lines_removed": linesRemoved,
+	}}
+	return NewRewardEngine(cfg).CommitXP(event, character, now)
+}
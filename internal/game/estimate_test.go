@@ -0,0 +1,75 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestEstimateCommitXP tests that each step of the projected commit XP is
+// reported and that estimating leaves the character unchanged.
+func TestEstimateCommitXP(t *testing.T) {
+	now := time.Date(2025, 3, 10, 14, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		added    int
+		removed  int
+		setup    func(cfg *config.Config, c *Character)
+		want     int
+		wantText string
+	}{
+		{
+			name:     "small commit",
+			added:    15,
+			removed:  5,
+			want:     30,
+			wantText: "10 commit +20 lines = 30 XP",
+		},
+		{
+			name:  "capped lines on easy with wisdom",
+			added: 400,
+			setup: func(cfg *config.Config, c *Character) {
+				cfg.Game.Difficulty = DifficultyEasy
+				c.Wisdom = 20
+			},
+			want:     79,
+			wantText: "10 commit +50 lines +12 easy +7 wisdom = 79 XP",
+		},
+		{
+			name:  "boost and daily cap",
+			added: 40,
+			setup: func(cfg *config.Config, c *Character) {
+				cfg.Game.DailyXPCap = 100
+				c.XPBoostUntil = now.Add(time.Hour)
+				c.BudgetDate = truncateToDay(now)
+				c.TodayCommitXP = 80
+			},
+			want:     20,
+			wantText: "10 commit +40 lines +25 boost -55 daily cap = 20 XP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			character := NewCharacter("Tester")
+			if tt.setup != nil {
+				tt.setup(cfg, character)
+			}
+			before := *character
+
+			got := EstimateCommitXP(tt.added, tt.removed, cfg, character, now)
+			if got.Total != tt.want {
+				t.Errorf("Total = %d, want %d (%+v)", got.Total, tt.want, got)
+			}
+			if got.String() != tt.wantText {
+				t.Errorf("String() = %q, want %q", got.String(), tt.wantText)
+			}
+			if character.TodayXPCommits != before.TodayXPCommits || character.TodayCommitXP != before.TodayCommitXP {
+				t.Error("EstimateCommitXP() should not change the character's budget")
+			}
+		})
+	}
+}
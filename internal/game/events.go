@@ -21,8 +21,36 @@ const (
 	//   - "files_changed": int - Number of files changed
 	//   - "lines_added": int - Lines of code added
 	//   - "lines_removed": int - Lines of code removed
+	//   - "file_paths": []string - Paths of the changed files
+	//   - "diff_hash": string - Fingerprint of the changed lines (repeated diff detection)
+	//   - "resolved_todos": []TodoComment - TODO/FIXME comments removed (todo quests)
+	//   - "collaboration": CommitCollaboration - Review and pairing credit from trailers
 	EventCommit EventType = "commit"
 
+	// EventCommitScored is fired once the game handler has awarded a commit
+	// event's XP, so the UI shows what was actually awarded.
+	// Data fields:
+	//   - "sha": string - Commit hash
+	//   - "message": string - Commit message
+	//   - "repo_path": string - Absolute repository path
+	//   - "xp_awarded": int - XP awarded (0 when held for review)
+	//   - "held": bool - Whether the XP was held for review (see EventXPFlagged)
+	EventCommitScored EventType = "commit_scored"
+
+	// EventCollaboration is fired for someone else's commit that credits the
+	// player as a reviewer or co-author (the commit itself earns no XP).
+	// Data fields:
+	//   - "sha": string - Commit hash
+	//   - "repo_path": string - Absolute repository path
+	//   - "collaboration": CommitCollaboration - The credit earned
+	EventCollaboration EventType = "collaboration"
+
+	// EventReviewApprovals is fired when the player's recent pull request
+	// approvals have been fetched from GitHub.
+	// Data fields:
+	//   - "approved_at": []time.Time - When each approval was submitted
+	EventReviewApprovals EventType = "review_approvals"
+
 	// EventLevelUp is fired when the character gains a level.
 	// Data fields:
 	//   - "old_level": int - Previous level
@@ -42,19 +70,170 @@ const (
 	//   - "quest_id": string - Quest UUID
 	//   - "quest_title": string - Quest display name
 	//   - "xp_reward": int - XP awarded
+	//   - "reward_pending": bool - true if the player picks the reward (xp_reward is 0)
+	//   - "xp_breakdown": XPBreakdown - how xp_reward was made up (absent with reward_pending)
 	EventQuestDone EventType = "quest_done"
 
+	// EventQuestProgress is fired when an active quest's progress changes.
+	// Data fields:
+	//   - "quest_id": string - Quest UUID
+	//   - "quest_title": string - Quest display name
+	//   - "current": int - Progress after the update
+	//   - "target": int - Progress needed to complete the quest
+	EventQuestProgress EventType = "quest_progress"
+
+	// EventEffortEarned is fired when the player gains Effort.
+	// Data fields:
+	//   - "amount": int - Effort gained
+	//   - "balance": int - Effort after the change
+	//   - "reason": string - What earned it (e.g. "Abandoned Bug Hunt")
+	EventEffortEarned EventType = "effort_earned"
+
+	// EventEffortSpent is fired when the player spends Effort.
+	// Data fields:
+	//   - "amount": int - Effort spent
+	//   - "balance": int - Effort after the change
+	//   - "reason": string - What it was spent on (e.g. "Streak freeze")
+	EventEffortSpent EventType = "effort_spent"
+
+	// EventRecordBroken is fired when the player beats a personal best.
+	// Data fields:
+	//   - "record": string - Record kind (see RecordKind)
+	//   - "previous": string - Old best, formatted for display
+	//   - "current": string - New best, formatted for display
+	EventRecordBroken EventType = "record_broken"
+
+	// EventAll subscribes a handler to every event type (wildcard). It is
+	// never published itself; handlers receive the concrete event.
+	EventAll EventType = "*"
+
 	// EventSkillUnlock is fired when the player unlocks a new skill (post-MVP).
 	// Data fields:
 	//   - "skill_id": string - Skill identifier
 	//   - "skill_name": string - Skill display name
 	EventSkillUnlock EventType = "skill_unlock"
 
-	// EventAchievement is fired when the player earns an achievement (post-MVP).
+	// EventAchievement is fired when the player earns an achievement.
 	// Data fields:
 	//   - "achievement_id": string - Achievement identifier
 	//   - "achievement_name": string - Achievement display name
 	EventAchievement EventType = "achievement"
+
+	// EventStreakBroken is fired when a long streak breaks and the
+	// Redemption quest is offered for it.
+	// Data fields:
+	//   - "lost_days": int - Length of the streak that broke
+	//   - "redeemable_days": int - Days the Redemption quest restores
+	//   - "quest_id": string - Redemption quest UUID
+	EventStreakBroken EventType = "streak_broken"
+
+	// EventQuestTemplates is fired when custom quest template files have
+	// been loaded, at startup and whenever the template directory changes.
+	// Data fields:
+	//   - "templates": []QuestTemplate - Every valid custom template
+	//   - "problems": []string - Why changed files couldn't be loaded
+	EventQuestTemplates EventType = "quest_templates"
+
+	// EventXPFlagged is fired when a commit's XP is held for review because
+	// it looks suspicious.
+	// Data fields:
+	//   - "flagged_id": string - Held entry ID
+	//   - "sha": string - Flagged commit SHA
+	//   - "message": string - First line of the commit message
+	//   - "xp": int - XP held
+	//   - "reasons": []string - Why it was flagged
+	EventXPFlagged EventType = "xp_flagged"
+
+	// EventTodoSuggestions is fired when watched repositories have been
+	// scanned for TODO/FIXME comments that could become quests.
+	// Data fields:
+	//   - "todos": []TodoComment - Comments found across watched repositories
+	EventTodoSuggestions EventType = "todo_suggestions"
+
+	// EventTaskList is fired when the watched repositories' task lists have
+	// been read.
+	// Data fields:
+	//   - "tasks": []TaskItem - Every item in the task lists
+	EventTaskList EventType = "task_list"
+
+	// EventTaskListSynced is fired when task list items became quests.
+	// Data fields:
+	//   - "added": []string - Titles of the quests added
+	EventTaskListSynced EventType = "task_list_synced"
+
+	// EventTaskDone is fired when a task quest completes in CodeQuest, so
+	// its item can be ticked in the task list.
+	// Data fields:
+	//   - "task": TaskItem - The item to tick
+	EventTaskDone EventType = "task_done"
+
+	// EventTaskTicked is fired when a completed task quest's item has been
+	// ticked, in a commit or a patch to apply.
+	// Data fields:
+	//   - "task": TaskItem - The item ticked
+	//   - "commit": string - SHA of the commit that ticked it ("" in patch mode)
+	//   - "patch": string - Path of the patch that ticks it ("" in commit mode)
+	EventTaskTicked EventType = "task_ticked"
+
+	// EventUncommittedWork is fired when the working trees of watched
+	// repositories have been checked for uncommitted changes.
+	// Data fields:
+	//   - "work": []UncommittedWork - Changes per repository checked
+	EventUncommittedWork EventType = "uncommitted_work"
+
+	// EventWIPNudge is fired when uncommitted work left sitting became
+	// quests, or wip quests completed.
+	// Data fields:
+	//   - "work": []UncommittedWork - Work the quests added are for
+	EventWIPNudge EventType = "wip_nudge"
+
+	// EventQuestsGenerated is fired when the quest catalog drew new daily or
+	// weekly quests, or generated quests expired.
+	// Data fields:
+	//   - "added": []string - Titles of the quests drawn
+	//   - "expired": []string - Titles of the quests that expired
+	EventQuestsGenerated EventType = "quests_generated"
+
+	// EventQuestsArranged is fired when the player pins or reorders quests
+	// on the Quest Board. The game handler owns the quest list, so it
+	// applies the arrangement and saves it.
+	// Data fields:
+	//   - "order": []string - Quest IDs in Quest Board order
+	//   - "pinned": []string - IDs of the pinned quests
+	EventQuestsArranged EventType = "quests_arranged"
+
+	// EventXPChanged is fired whenever the character's XP changes. With the
+	// event log on, these events are the XP ledger `codequest audit` checks.
+	// Data fields:
+	//   - "amount": int - XP gained (negative when spent)
+	//   - "source": string - What changed it (see the XPSource constants)
+	//   - "total_xp": int - Cumulative XP after the change
+	EventXPChanged EventType = "xp_changed"
+
+	// EventQuestCrown is fired when a quest was completed on every day of a
+	// week, earning a crown.
+	// Data fields:
+	//   - "week": string - The crowned week (e.g. "2025-W10")
+	//   - "crowns": int - Crowns earned in total
+	EventQuestCrown EventType = "quest_crown"
+
+	// EventSeasonTier is fired when seasonal XP unlocks a season pass tier.
+	// Data fields:
+	//   - "season": string - The season (e.g. "2026-Q4")
+	//   - "tier": int - The tier unlocked
+	//   - "reward": string - The tier's reward name
+	EventSeasonTier EventType = "season_tier"
+
+	// EventLateNight is fired when late-night commits become a habit (see
+	// LateNightHabit), at most once a night.
+	// Data fields:
+	//   - "nights": int - Late nights in the past week, tonight included
+	EventLateNight EventType = "late_night"
+
+	// EventLootDrop is fired when a commit drops loot (see RollLoot).
+	// Data fields:
+	//   - "drop": LootDrop - The item dropped
+	EventLootDrop EventType = "loot_drop"
 )
 
 // Event represents something that happened in the game.
@@ -76,6 +255,14 @@ type Event struct {
 // might collect and log them.
 type EventHandler func(Event)
 
+// EventMiddleware runs on every published event before any handler sees it.
+// It returns the event to deliver (possibly transformed) and whether to
+// deliver it at all, so middleware can log, filter or rewrite events.
+//
+// Middleware must not modify the incoming event's Data map in place; to
+// transform an event, copy the map and return the new event.
+type EventMiddleware func(Event) (Event, bool)
+
 // EventBus manages event publishing and subscription.
 // It provides a thread-safe pub/sub system for decoupling game components.
 //
@@ -88,9 +275,13 @@ type EventHandler func(Event)
 // EventBus uses sync.RWMutex for concurrent access protection.
 // - Subscribe/Unsubscribe use write locks (exclusive)
 // - Publish uses read locks (multiple publishers can read handlers simultaneously)
+//
+// Cross-cutting consumers can subscribe to EventAll to receive every event,
+// and middleware added with Use runs on every event before dispatch.
 type EventBus struct {
-	handlers map[EventType][]EventHandler
-	mu       sync.RWMutex
+	handlers   map[EventType][]EventHandler
+	middleware []EventMiddleware
+	mu         sync.RWMutex
 }
 
 // NewEventBus creates a new event bus with an empty handler registry.
@@ -153,7 +344,61 @@ func (eb *EventBus) Subscribe(eventType EventType, handler EventHandler) {
 	eb.handlers[eventType] = append(eb.handlers[eventType], handler)
 }
 
-// Publish sends an event to all registered handlers for that event type.
+// Use adds middleware that runs on every published event, in the order it
+// was added. If any middleware drops the event, no handler receives it.
+//
+// Thread Safety:
+// This method acquires a write lock, like Subscribe.
+//
+// Parameters:
+//   - middleware: The middleware to add
+//
+// Example:
+//
+//	// Ignore retroactive commits replayed after downtime
+//	bus.Use(func(e Event) (Event, bool) {
+//	    retroactive, _ := e.Data["retroactive"].(bool)
+//	    return e, !retroactive
+//	})
+func (eb *EventBus) Use(middleware EventMiddleware) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	eb.middleware = append(eb.middleware, middleware)
+}
+
+// dispatch runs middleware on an event and returns it with the handlers to
+// call: the event type's handlers first, then wildcard (EventAll) handlers.
+//
+// Returns:
+//   - Event: The event after middleware
+//   - []EventHandler: Handlers to call (nil if middleware dropped the event)
+func (eb *EventBus) dispatch(event Event) (Event, []EventHandler) {
+	// Copy what we need under the read lock, then release it before running
+	// middleware so middleware can safely publish or subscribe
+	eb.mu.RLock()
+	middleware := eb.middleware
+	typed := eb.handlers[event.Type]
+	wildcard := eb.handlers[EventAll]
+	eb.mu.RUnlock()
+
+	for _, mw := range middleware {
+		var deliver bool
+		if event, deliver = mw(event); !deliver {
+			return event, nil
+		}
+	}
+
+	if len(wildcard) == 0 || event.Type == EventAll {
+		return event, typed
+	}
+	handlers := make([]EventHandler, 0, len(typed)+len(wildcard))
+	handlers = append(handlers, typed...)
+	return event, append(handlers, wildcard...)
+}
+
+// Publish sends an event to all registered handlers for that event type,
+// then to wildcard (EventAll) handlers, after running any middleware.
 // Handlers are called synchronously in the order they were registered.
 //
 // This is a blocking call - it won't return until all handlers have finished.
@@ -179,10 +424,8 @@ func (eb *EventBus) Subscribe(eventType EventType, handler EventHandler) {
 //	    },
 //	})
 func (eb *EventBus) Publish(event Event) {
-	// Acquire read lock to get handlers
-	eb.mu.RLock()
-	handlers := eb.handlers[event.Type]
-	eb.mu.RUnlock()
+	// Run middleware and collect typed and wildcard handlers
+	event, handlers := eb.dispatch(event)
 
 	// Call each handler synchronously
 	// We iterate over a copy of the handler slice, so it's safe even if
@@ -220,10 +463,8 @@ func (eb *EventBus) Publish(event Event) {
 //	})
 //	// This code continues immediately, handlers run in background
 func (eb *EventBus) PublishAsync(event Event) {
-	// Acquire read lock to get handlers
-	eb.mu.RLock()
-	handlers := eb.handlers[event.Type]
-	eb.mu.RUnlock()
+	// Run middleware (on the publisher's goroutine) and collect handlers
+	event, handlers := eb.dispatch(event)
 
 	// Call each handler in its own goroutine
 	for _, handler := range handlers {
@@ -263,7 +504,7 @@ func (eb *EventBus) UnsubscribeAll(eventType EventType) {
 	delete(eb.handlers, eventType)
 }
 
-// Clear removes all handlers for all event types.
+// Clear removes all handlers for all event types and all middleware.
 // This resets the EventBus to its initial state.
 //
 // Thread Safety:
@@ -277,8 +518,9 @@ func (eb *EventBus) Clear() {
 	eb.mu.Lock()
 	defer eb.mu.Unlock()
 
-	// Recreate the handlers map
+	// Recreate the handlers map and drop middleware
 	eb.handlers = make(map[EventType][]EventHandler)
+	eb.middleware = nil
 }
 
 // HandlerCount returns the number of handlers registered for a specific event type.
@@ -304,6 +546,21 @@ func (eb *EventBus) HandlerCount(eventType EventType) int {
 	return len(eb.handlers[eventType])
 }
 
+// LoggingMiddleware returns middleware that logs every event's type and
+// data without changing or filtering it.
+//
+// Parameters:
+//   - logf: Printf-style logger (e.g. log.Printf)
+//
+// Returns:
+//   - EventMiddleware: The logging middleware
+func LoggingMiddleware(logf func(format string, args ...interface{})) EventMiddleware {
+	return func(event Event) (Event, bool) {
+		logf("event %s: %v", event.Type, event.Data)
+		return event, true
+	}
+}
+
 // Helper functions for creating common events
 
 // NewCommitEvent creates a commit event with the given data.
@@ -332,6 +589,32 @@ func NewCommitEvent(sha, message string, filesChanged, linesAdded, linesRemoved
 	}
 }
 
+// NewCommitScoredEvent creates the event announcing a commit's awarded XP.
+//
+// Parameters:
+//   - commit: The commit event that was scored
+//   - xpAwarded: XP awarded (0 when held)
+//   - held: Whether the XP was held for review
+//
+// Returns:
+//   - Event: The constructed commit scored event
+func NewCommitScoredEvent(commit Event, xpAwarded int, held bool) Event {
+	sha, _ := commit.Data["sha"].(string)
+	message, _ := commit.Data["message"].(string)
+	repoPath, _ := commit.Data["repo_path"].(string)
+	return Event{
+		Type:      EventCommitScored,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"sha":        sha,
+			"message":    message,
+			"repo_path":  repoPath,
+			"xp_awarded": xpAwarded,
+			"held":       held,
+		},
+	}
+}
+
 // NewLevelUpEvent creates a level-up event with the given data.
 //
 // Parameters:
@@ -374,6 +657,168 @@ func NewQuestStartEvent(questID, questTitle, questType string) Event {
 	}
 }
 
+// NewQuestProgressEvent creates a quest progress event.
+//
+// Parameters:
+//   - questID: Quest UUID
+//   - questTitle: Quest display name
+//   - current: Progress after the update
+//   - target: Progress needed to complete the quest
+//
+// Returns:
+//   - Event: The constructed quest progress event
+func NewQuestProgressEvent(questID, questTitle string, current, target int) Event {
+	return Event{
+		Type:      EventQuestProgress,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"quest_id":    questID,
+			"quest_title": questTitle,
+			"current":     current,
+			"target":      target,
+		},
+	}
+}
+
+// NewEffortEvent creates an Effort earned or spent event.
+//
+// Parameters:
+//   - eventType: EventEffortEarned or EventEffortSpent
+//   - amount: Effort gained or spent
+//   - balance: Effort after the change
+//   - reason: What earned it or what it was spent on
+//
+// Returns:
+//   - Event: The constructed effort event
+func NewEffortEvent(eventType EventType, amount, balance int, reason string) Event {
+	return Event{
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"amount":  amount,
+			"balance": balance,
+			"reason":  reason,
+		},
+	}
+}
+
+// NewReviewApprovalsEvent creates a pull request approvals event.
+//
+// Parameters:
+//   - approvedAt: When each of the player's recent approvals was submitted
+//
+// Returns:
+//   - Event: The constructed review approvals event
+func NewReviewApprovalsEvent(approvedAt []time.Time) Event {
+	return Event{
+		Type:      EventReviewApprovals,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"approved_at": approvedAt,
+		},
+	}
+}
+
+// NewCollaborationEvent creates an event crediting the player for reviewing
+// or co-authoring someone else's commit.
+//
+// Parameters:
+//   - sha: Commit hash
+//   - repoPath: Absolute repository path
+//   - credit: The credit earned
+//
+// Returns:
+//   - Event: The constructed collaboration event
+func NewCollaborationEvent(sha, repoPath string, credit CommitCollaboration) Event {
+	return Event{
+		Type:      EventCollaboration,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"sha":           sha,
+			"repo_path":     repoPath,
+			"collaboration": credit,
+		},
+	}
+}
+
+// NewRecordBrokenEvent creates a personal best event.
+//
+// Parameters:
+//   - record: The broken record
+//
+// Returns:
+//   - Event: The constructed record broken event
+func NewRecordBrokenEvent(record RecordBreak) Event {
+	return Event{
+		Type:      EventRecordBroken,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"record":   string(record.Kind),
+			"previous": record.Previous,
+			"current":  record.Current,
+		},
+	}
+}
+
+// NewAchievementEvent creates an achievement unlocked event.
+//
+// Parameters:
+//   - id: Achievement identifier
+//   - name: Achievement display name
+//
+// Returns:
+//   - Event: The constructed achievement event
+func NewAchievementEvent(id, name string) Event {
+	return Event{
+		Type:      EventAchievement,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"achievement_id":   id,
+			"achievement_name": name,
+		},
+	}
+}
+
+// NewStreakBrokenEvent creates a broken streak event.
+//
+// Parameters:
+//   - lostDays: Length of the streak that broke
+//   - redeemableDays: Days the Redemption quest restores
+//   - questID: Redemption quest UUID
+//
+// Returns:
+//   - Event: The constructed streak broken event
+func NewStreakBrokenEvent(lostDays, redeemableDays int, questID string) Event {
+	return Event{
+		Type:      EventStreakBroken,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"lost_days":       lostDays,
+			"redeemable_days": redeemableDays,
+			"quest_id":        questID,
+		},
+	}
+}
+
+// NewQuestTemplatesEvent creates a custom quest templates event.
+//
+// Parameters:
+//   - templates: Every valid custom template
+//   - problems: Why changed template files couldn't be loaded
+//
+// Returns:
+//   - Event: The constructed quest templates event
+func NewQuestTemplatesEvent(templates []QuestTemplate, problems []string) Event {
+	return Event{
+		Type:      EventQuestTemplates,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"templates": templates,
+			"problems":  problems,
+		},
+	}
+}
+
 // NewQuestDoneEvent creates a quest completion event.
 //
 // Parameters:
@@ -394,3 +839,306 @@ func NewQuestDoneEvent(questID, questTitle string, xpReward int) Event {
 		},
 	}
 }
+
+// NewQuestRewardChoiceEvent creates a quest completion event for a quest
+// whose reward the player still has to pick. No XP has been awarded yet.
+//
+// Parameters:
+//   - questID: Quest UUID
+//   - questTitle: Quest display name
+//
+// Returns:
+//   - Event: The constructed quest done event
+func NewQuestRewardChoiceEvent(questID, questTitle string) Event {
+	event := NewQuestDoneEvent(questID, questTitle, 0)
+	event.Data["reward_pending"] = true
+	return event
+}
+
+// NewXPFlaggedEvent creates an event for commit XP held for review.
+//
+// Parameters:
+//   - flagged: The held entry
+//
+// Returns:
+//   - Event: The constructed XP flagged event
+func NewXPFlaggedEvent(flagged FlaggedXP) Event {
+	reasons := make([]string, len(flagged.Anomalies))
+	for i, anomaly := range flagged.Anomalies {
+		reasons[i] = anomaly.Detail
+	}
+	return Event{
+		Type:      EventXPFlagged,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"flagged_id": flagged.ID,
+			"sha":        flagged.SHA,
+			"message":    flagged.Message,
+			"xp":         flagged.XP,
+			"reasons":    reasons,
+		},
+	}
+}
+
+// NewTodoSuggestionsEvent creates an event listing the TODO/FIXME comments
+// found in watched repositories.
+//
+// Parameters:
+//   - todos: Comments found
+//
+// Returns:
+//   - Event: The constructed todo suggestions event
+func NewTodoSuggestionsEvent(todos []TodoComment) Event {
+	return Event{
+		Type:      EventTodoSuggestions,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"todos": todos,
+		},
+	}
+}
+
+// NewTaskListEvent creates an event listing the items in the watched
+// repositories' task lists.
+//
+// Parameters:
+//   - tasks: Every item found
+//
+// Returns:
+//   - Event: The constructed task list event
+func NewTaskListEvent(tasks []TaskItem) Event {
+	return Event{
+		Type:      EventTaskList,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"tasks": tasks,
+		},
+	}
+}
+
+// NewTaskListSyncedEvent creates an event naming the quests added from task
+// list items.
+//
+// Parameters:
+//   - added: Titles of the quests added
+//
+// Returns:
+//   - Event: The constructed task list synced event
+func NewTaskListSyncedEvent(added []string) Event {
+	return Event{
+		Type:      EventTaskListSynced,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"added": added,
+		},
+	}
+}
+
+// NewTaskDoneEvent creates an event asking for a completed task quest's item
+// to be ticked.
+//
+// Parameters:
+//   - task: The item to tick
+//
+// Returns:
+//   - Event: The constructed task done event
+func NewTaskDoneEvent(task TaskItem) Event {
+	return Event{
+		Type:      EventTaskDone,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"task": task,
+		},
+	}
+}
+
+// NewTaskTickedEvent creates an event reporting how a task list item was
+// ticked.
+//
+// Parameters:
+//   - task: The item ticked
+//   - commit: SHA of the commit that ticked it ("" in patch mode)
+//   - patch: Path of the patch that ticks it ("" in commit mode)
+//
+// Returns:
+//   - Event: The constructed task ticked event
+func NewTaskTickedEvent(task TaskItem, commit, patch string) Event {
+	return Event{
+		Type:      EventTaskTicked,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"task":   task,
+			"commit": commit,
+			"patch":  patch,
+		},
+	}
+}
+
+// NewQuestsArrangedEvent creates a Quest Board arrangement event.
+//
+// Parameters:
+//   - order: Quest IDs in Quest Board order
+//   - pinned: IDs of the pinned quests
+//
+// Returns:
+//   - Event: The constructed quests arranged event
+func NewQuestsArrangedEvent(order, pinned []string) Event {
+	return Event{
+		Type:      EventQuestsArranged,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"order":  order,
+			"pinned": pinned,
+		},
+	}
+}
+
+// NewXPChangedEvent creates an XP ledger entry.
+//
+// Parameters:
+//   - amount: XP gained (negative when spent)
+//   - source: What changed it (see the XPSource constants)
+//   - totalXP: Cumulative XP after the change (Character.TotalXP)
+//
+// Returns:
+//   - Event: The constructed XP changed event
+func NewXPChangedEvent(amount int, source string, totalXP int) Event {
+	return Event{
+		Type:      EventXPChanged,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"amount":   amount,
+			"source":   source,
+			"total_xp": totalXP,
+		},
+	}
+}
+
+// NewQuestCrownEvent creates a weekly crown event.
+//
+// Parameters:
+//   - week: The crowned week (WeekKey)
+//   - crowns: Crowns earned in total
+//
+// Returns:
+//   - Event: The constructed quest crown event
+func NewQuestCrownEvent(week string, crowns int) Event {
+	return Event{
+		Type:      EventQuestCrown,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"week":   week,
+			"crowns": crowns,
+		},
+	}
+}
+
+// NewSeasonTierEvent creates a season pass tier unlocked event.
+//
+// Parameters:
+//   - season: The season (SeasonKey)
+//   - tier: The tier unlocked
+//
+// Returns:
+//   - Event: The constructed season tier event
+func NewSeasonTierEvent(season string, tier SeasonTier) Event {
+	return Event{
+		Type:      EventSeasonTier,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"season": season,
+			"tier":   tier.Tier,
+			"reward": tier.Name,
+		},
+	}
+}
+
+// NewLateNightEvent creates a late-night habit warning event.
+//
+// Parameters:
+//   - nights: Late nights in the past week, tonight included
+//
+// Returns:
+//   - Event: The constructed late-night event
+func NewLateNightEvent(nights int) Event {
+	return Event{
+		Type:      EventLateNight,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"nights": nights,
+		},
+	}
+}
+
+// NewLootDropEvent creates a loot drop event.
+//
+// Parameters:
+//   - drop: The item a commit dropped
+//
+// Returns:
+//   - Event: The constructed loot drop event
+func NewLootDropEvent(drop LootDrop) Event {
+	return Event{
+		Type:      EventLootDrop,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"drop": drop,
+		},
+	}
+}
+
+// NewUncommittedWorkEvent creates an event with the uncommitted changes
+// found in watched repositories.
+//
+// Parameters:
+//   - work: Changes per repository checked
+//
+// Returns:
+//   - Event: The constructed uncommitted work event
+func NewUncommittedWorkEvent(work []UncommittedWork) Event {
+	return Event{
+		Type:      EventUncommittedWork,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"work": work,
+		},
+	}
+}
+
+// NewWIPNudgeEvent creates an event naming the uncommitted work that became
+// quests.
+//
+// Parameters:
+//   - work: Work the quests added are for
+//
+// Returns:
+//   - Event: The constructed wip nudge event
+func NewWIPNudgeEvent(work []UncommittedWork) Event {
+	return Event{
+		Type:      EventWIPNudge,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"work": work,
+		},
+	}
+}
+
+// NewQuestsGeneratedEvent creates an event naming the quests the quest
+// catalog drew and the generated quests that expired.
+//
+// Parameters:
+//   - added: Titles of the quests drawn
+//   - expired: Titles of the quests that expired
+//
+// Returns:
+//   - Event: The constructed quests generated event
+func NewQuestsGeneratedEvent(added, expired []string) Event {
+	return Event{
+		Type:      EventQuestsGenerated,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"added":   added,
+			"expired": expired,
+		},
+	}
+}
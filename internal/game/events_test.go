@@ -0,0 +1,119 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestEventBusWildcard tests that EventAll handlers receive every event,
+// after the event type's own handlers.
+func TestEventBusWildcard(t *testing.T) {
+	bus := NewEventBus()
+	var received []string
+	bus.Subscribe(EventCommit, func(e Event) { received = append(received, "commit:"+string(e.Type)) })
+	bus.Subscribe(EventAll, func(e Event) { received = append(received, "all:"+string(e.Type)) })
+
+	bus.Publish(NewCommitEvent("abc1234", "feat: x", 1, 10, 0))
+	bus.Publish(NewLevelUpEvent("char", 1, 2))
+
+	want := []string{"commit:commit", "all:commit", "all:level_up"}
+	if fmt.Sprint(received) != fmt.Sprint(want) {
+		t.Errorf("received = %v, want %v", received, want)
+	}
+	if got := bus.HandlerCount(EventAll); got != 1 {
+		t.Errorf("HandlerCount(EventAll) = %d, want 1", got)
+	}
+
+	bus.UnsubscribeAll(EventAll)
+	received = nil
+	bus.Publish(NewLevelUpEvent("char", 2, 3))
+	if len(received) != 0 {
+		t.Errorf("after UnsubscribeAll(EventAll) received = %v, want none", received)
+	}
+}
+
+// TestEventBusMiddleware tests logging, filtering and transforming events
+// with middleware.
+func TestEventBusMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		middleware []EventMiddleware
+		wantTitles []string
+	}{
+		{
+			name:       "no middleware",
+			wantTitles: []string{"First", "Second"},
+		},
+		{
+			name: "filter drops events",
+			middleware: []EventMiddleware{func(e Event) (Event, bool) {
+				return e, e.Data["quest_title"] != "First"
+			}},
+			wantTitles: []string{"Second"},
+		},
+		{
+			name: "transforms run in order",
+			middleware: []EventMiddleware{
+				func(e Event) (Event, bool) {
+					e.Data = map[string]interface{}{"quest_title": e.Data["quest_title"].(string) + "!"}
+					return e, true
+				},
+				func(e Event) (Event, bool) {
+					e.Data = map[string]interface{}{"quest_title": "[" + e.Data["quest_title"].(string) + "]"}
+					return e, true
+				},
+			},
+			wantTitles: []string{"[First!]", "[Second!]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewEventBus()
+			for _, mw := range tt.middleware {
+				bus.Use(mw)
+			}
+			var titles []string
+			bus.Subscribe(EventAll, func(e Event) { titles = append(titles, e.Data["quest_title"].(string)) })
+
+			bus.Publish(NewQuestDoneEvent("q1", "First", 100))
+			bus.Publish(NewQuestDoneEvent("q2", "Second", 100))
+
+			if fmt.Sprint(titles) != fmt.Sprint(tt.wantTitles) {
+				t.Errorf("titles = %v, want %v", titles, tt.wantTitles)
+			}
+		})
+	}
+}
+
+// TestLoggingMiddleware tests that the logging middleware logs and passes
+// every event through unchanged.
+func TestLoggingMiddleware(t *testing.T) {
+	var logged []string
+	mw := LoggingMiddleware(func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+
+	event := NewLevelUpEvent("char", 4, 5)
+	got, deliver := mw(event)
+	if !deliver || got.Type != EventLevelUp || got.Data["new_level"] != 5 {
+		t.Errorf("LoggingMiddleware() = %+v, %v; want the event delivered unchanged", got, deliver)
+	}
+	if len(logged) != 1 {
+		t.Errorf("logged %d lines, want 1", len(logged))
+	}
+}
+
+// TestEventBusClearRemovesMiddleware tests that Clear resets middleware.
+func TestEventBusClearRemovesMiddleware(t *testing.T) {
+	bus := NewEventBus()
+	bus.Use(func(e Event) (Event, bool) { return e, false })
+	bus.Clear()
+
+	delivered := false
+	bus.Subscribe(EventCommit, func(Event) { delivered = true })
+	bus.Publish(NewCommitEvent("abc1234", "fix: y", 1, 1, 1))
+	if !delivered {
+		t.Error("event dropped by middleware that Clear should have removed")
+	}
+}
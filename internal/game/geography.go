@@ -0,0 +1,126 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements commit geography: commits per repository per streak
+// day, rolled up into each repository's share of this week's commits and
+// its trend against last week, so neglected projects stand out.
+package game
+
+import (
+	"sort"
+	"time"
+)
+
+// geographyWeekDays is how many streak days make up "this week" (today and
+// the six days before it); "last week" is the seven days before that.
+const geographyWeekDays = 7
+
+// RepoActivity is one repository's commits this week and last.
+type RepoActivity struct {
+	Repo     string  // Repository path
+	Commits  int     // Commits over the last seven days
+	LastWeek int     // Commits over the seven days before that
+	Share    float64 // Fraction of this week's commits made here (0-1)
+}
+
+// Trend returns how many more commits the repository got this week than
+// last week (negative when it's cooling off).
+func (r RepoActivity) Trend() int {
+	return r.Commits - r.LastWeek
+}
+
+// Neglected reports whether the repository was worked on last week but not
+// at all this week.
+func (r RepoActivity) Neglected() bool {
+	return r.Commits == 0 && r.LastWeek > 0
+}
+
+// RecordRepoActivity adds commits to a repository's tally for the streak
+// day they count toward. Old days are dropped by PruneHistory.
+//
+// Parameters:
+//   - repo: Repository path ("" is ignored)
+//   - at: When the commits were made
+//   - commits: Number of commits
+//   - clock: Streak clock (home timezone and grace window)
+func (c *Character) RecordRepoActivity(repo string, at time.Time, commits int, clock StreakClock) {
+	if repo == "" || commits <= 0 {
+		return
+	}
+	if c.RepoActivityDays == nil {
+		c.RepoActivityDays = make(map[string]map[string]int)
+	}
+
+	day := clock.Day(at).Format(activityDayFormat)
+	if c.RepoActivityDays[day] == nil {
+		c.RepoActivityDays[day] = make(map[string]int)
+	}
+	c.RepoActivityDays[day][repo] += commits
+}
+
+// RepoGeography returns every repository committed to this week or last,
+// busiest this week first (ties go to the busier last week, then by path).
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak clock (home timezone and grace window)
+//
+// Returns:
+//   - []RepoActivity: Per-repository activity (empty if there was none)
+func (c *Character) RepoGeography(now time.Time, clock StreakClock) []RepoActivity {
+	today := clock.Day(now)
+	byRepo := make(map[string]*RepoActivity)
+	total := 0
+	for offset := 0; offset < 2*geographyWeekDays; offset++ {
+		day := today.AddDate(0, 0, -offset).Format(activityDayFormat)
+		for repo, commits := range c.RepoActivityDays[day] {
+			activity := byRepo[repo]
+			if activity == nil {
+				activity = &RepoActivity{Repo: repo}
+				byRepo[repo] = activity
+			}
+			if offset < geographyWeekDays {
+				activity.Commits += commits
+				total += commits
+			} else {
+				activity.LastWeek += commits
+			}
+		}
+	}
+
+	geography := make([]RepoActivity, 0, len(byRepo))
+	for _, activity := range byRepo {
+		if total > 0 {
+			activity.Share = float64(activity.Commits) / float64(total)
+		}
+		geography = append(geography, *activity)
+	}
+	sort.Slice(geography, func(i, j int) bool {
+		a, b := geography[i], geography[j]
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		if a.LastWeek != b.LastWeek {
+			return a.LastWeek > b.LastWeek
+		}
+		return a.Repo < b.Repo
+	})
+	return geography
+}
+
+// NeglectedRepos picks the repositories worked on last week but not this
+// week, most worked on last week first. These are the candidates for
+// "return to" nudges and quests.
+//
+// Parameters:
+//   - geography: Per-repository activity (from RepoGeography)
+//
+// Returns:
+//   - []RepoActivity: The neglected repositories
+func NeglectedRepos(geography []RepoActivity) []RepoActivity {
+	var neglected []RepoActivity
+	for _, activity := range geography {
+		if activity.Neglected() {
+			neglected = append(neglected, activity)
+		}
+	}
+	return neglected
+}
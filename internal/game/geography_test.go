@@ -0,0 +1,70 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRepoGeography tests per-repository shares and trends over this week and last.
+func TestRepoGeography(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	c.RecordRepoActivity("/src/api", now, 3, clock)
+	c.RecordRepoActivity("/src/api", now.AddDate(0, 0, -6), 3, clock)
+	c.RecordRepoActivity("/src/api", now.AddDate(0, 0, -8), 2, clock)
+	c.RecordRepoActivity("/src/web", now.AddDate(0, 0, -1), 2, clock)
+	c.RecordRepoActivity("/src/docs", now.AddDate(0, 0, -10), 4, clock)
+	c.RecordRepoActivity("/src/old", now.AddDate(0, 0, -20), 5, clock) // Too long ago
+	c.RecordRepoActivity("", now, 1, clock)                            // No repository
+
+	got := c.RepoGeography(now, clock)
+	want := []RepoActivity{
+		{Repo: "/src/api", Commits: 6, LastWeek: 2, Share: 0.75},
+		{Repo: "/src/web", Commits: 2, LastWeek: 0, Share: 0.25},
+		{Repo: "/src/docs", Commits: 0, LastWeek: 4, Share: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RepoGeography() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("RepoGeography()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	tests := []struct {
+		name          string
+		activity      RepoActivity
+		wantTrend     int
+		wantNeglected bool
+	}{
+		{"growing", want[0], 4, false},
+		{"new", want[1], 2, false},
+		{"gone quiet", want[2], -4, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.activity.Trend(); got != tt.wantTrend {
+				t.Errorf("Trend() = %d, want %d", got, tt.wantTrend)
+			}
+			if got := tt.activity.Neglected(); got != tt.wantNeglected {
+				t.Errorf("Neglected() = %v, want %v", got, tt.wantNeglected)
+			}
+		})
+	}
+
+	neglected := NeglectedRepos(got)
+	if len(neglected) != 1 || neglected[0].Repo != "/src/docs" {
+		t.Errorf("NeglectedRepos() = %+v, want only /src/docs", neglected)
+	}
+}
+
+// TestRepoGeographyEmpty tests a character with no per-repository history.
+func TestRepoGeographyEmpty(t *testing.T) {
+	c := NewCharacter("Tester")
+	if got := c.RepoGeography(time.Now(), StreakClock{Location: time.UTC}); len(got) != 0 {
+		t.Errorf("RepoGeography() = %+v, want none", got)
+	}
+}
@@ -5,14 +5,17 @@ package game
 import (
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/AutumnsGrove/codequest/internal/config"
 )
 
 // Storage defines the interface for persisting game data.
 // This breaks the circular dependency between game and storage packages.
-// The storage.SkateClient implements this interface.
+// Every storage backend implements this interface.
 type Storage interface {
 	SaveCharacter(character *Character) error
 	LoadCharacter() (*Character, error)
@@ -20,13 +23,20 @@ type Storage interface {
 	LoadQuests() ([]*Quest, error)
 }
 
+// StateSaver is implemented by storage backends that can save the character
+// and quests as one batch, rolling back if either write fails. The handler
+// uses it when available instead of two separate saves.
+type StateSaver interface {
+	SaveState(character *Character, quests []*Quest) error
+}
+
 // GameEventHandler processes game events and updates character and quest state.
 // It subscribes to the EventBus and handles commit events by:
 //  1. Calculating XP rewards using the game engine
 //  2. Applying difficulty and wisdom multipliers
 //  3. Awarding XP to the character (triggering level-ups if applicable)
 //  4. Updating active quest progress
-//  5. Publishing secondary events (EventLevelUp, EventQuestDone)
+//  5. Publishing secondary events (EventCommitScored, EventLevelUp, EventQuestProgress, EventQuestDone)
 //  6. Persisting state changes to storage
 //
 // Thread Safety:
@@ -45,6 +55,9 @@ type GameEventHandler struct {
 
 	// State management
 	running bool // Indicates if handler is active
+
+	// Clock source (time.Now, or a simulated clock; see SetClock)
+	now func() time.Time
 }
 
 // NewGameEventHandler creates a new event handler with the given dependencies.
@@ -92,6 +105,7 @@ func NewGameEventHandler(
 		storage:   storage,
 		config:    config,
 		running:   false,
+		now:       time.Now,
 	}, nil
 }
 
@@ -109,8 +123,21 @@ func (h *GameEventHandler) Start() error {
 		return fmt.Errorf("handler is already running")
 	}
 
-	// Subscribe to commit events
+	// Subscribe to commit events, GitHub review approvals, trailer credit,
+	// task lists, uncommitted work and Quest Board arrangements
 	h.eventBus.Subscribe(EventCommit, h.handleCommitEvent)
+	h.eventBus.Subscribe(EventReviewApprovals, h.handleReviewEvent)
+	h.eventBus.Subscribe(EventCollaboration, h.handleCollaborationEvent)
+	h.eventBus.Subscribe(EventTaskList, h.handleTaskListEvent)
+	h.eventBus.Subscribe(EventUncommittedWork, h.handleUncommittedWorkEvent)
+	h.eventBus.Subscribe(EventQuestsArranged, h.handleQuestsArrangedEvent)
+
+	// Reset daily and monthly quests left over from a previous period
+	if h.rolloverRecurringQuests(h.now()) {
+		if err := h.saveState(); err != nil {
+			log.Printf("ERROR: Failed to save state after quest rollover: %v", err)
+		}
+	}
 
 	h.running = true
 	log.Println("GameEventHandler started - subscribing to commit events")
@@ -132,8 +159,13 @@ func (h *GameEventHandler) Stop() error {
 		return fmt.Errorf("handler is not running")
 	}
 
-	// Unsubscribe from all commit event handlers
+	// Unsubscribe from all commit and review event handlers
 	h.eventBus.UnsubscribeAll(EventCommit)
+	h.eventBus.UnsubscribeAll(EventReviewApprovals)
+	h.eventBus.UnsubscribeAll(EventCollaboration)
+	h.eventBus.UnsubscribeAll(EventTaskList)
+	h.eventBus.UnsubscribeAll(EventUncommittedWork)
+	h.eventBus.UnsubscribeAll(EventQuestsArranged)
 
 	h.running = false
 	log.Println("GameEventHandler stopped - unsubscribed from commit events")
@@ -141,13 +173,27 @@ func (h *GameEventHandler) Stop() error {
 	return nil
 }
 
+// SetClock replaces the handler's clock, so budgets, streaks, quest
+// timestamps and rollovers follow simulated time (see Simulation). Call it
+// before Start.
+//
+// Parameters:
+//   - now: Returns the current time
+func (h *GameEventHandler) SetClock(now func() time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.now = now
+}
+
 // handleCommitEvent processes a commit event and updates game state.
 // This is the main event processing pipeline:
 //  1. Extract commit data (lines added/removed, files changed, etc.)
-//  2. Calculate base XP from lines changed
+//  2. Calculate base XP from lines changed (split across constituent
+//     commits for squash merges when github.split_squash_merges is on)
 //  3. Apply difficulty multiplier
-//  4. Apply wisdom bonus
-//  5. Award XP to character (handle level-ups)
+//  4. Apply wisdom bonus (and any quest reward XP boost)
+//  5. Award XP to character (handle level-ups), or hold it for review if
+//     the commit looks suspicious (see DetectAnomalies)
 //  6. Update active quest progress
 //  7. Persist changes to storage
 //
@@ -170,31 +216,87 @@ func (h *GameEventHandler) handleCommitEvent(event Event) {
 	log.Printf("Processing commit %s: %s (lines: +%d -%d)",
 		sha[:7], message, linesAdded, linesRemoved)
 
-	// Calculate base XP from commit
-	baseXP := CalculateCommitXP(linesAdded, linesRemoved)
-	log.Printf("  Base XP: %d", baseXP)
-
-	// Apply difficulty multiplier
-	difficulty := h.config.Game.Difficulty
-	xpWithDifficulty := ApplyDifficultyMultiplier(baseXP, difficulty)
-	log.Printf("  After difficulty (%s): %d XP", difficulty, xpWithDifficulty)
-
-	// Apply wisdom bonus
-	wisdom := h.character.Wisdom
-	finalXP := ApplyWisdomBonus(xpWithDifficulty, wisdom)
-	log.Printf("  After wisdom bonus (wisdom=%d): %d XP", wisdom, finalXP)
+	// Work out the XP: lines, squash merge, difficulty, wisdom, boost,
+	// replay and bot rates, custom XP rules, then the daily anti-grind budget
+	reward := NewRewardEngine(h.config).AwardCommitXP(event, h.character, h.now())
+	log.Printf("  XP: %s", reward)
+	if len(reward.RuleNames) > 0 {
+		log.Printf("  XP rules: %s (flags: %s)", strings.Join(reward.RuleNames, ", "), strings.Join(reward.Flags, ", "))
+	}
+	finalXP := reward.Total
+	retroactive, _ := event.Data["retroactive"].(bool)
+	bot, _ := event.Data["bot"].(bool)
+
+	commits := eventCommits(event)
+
+	// Hold suspicious XP (absurd line counts, bursts, repeated diffs) for review
+	diffHash, _ := event.Data["diff_hash"].(string)
+	sample := CommitSample{Lines: linesAdded + linesRemoved, Commits: commits, DiffHash: diffHash, At: commitTime(event)}
+	anomalies := h.character.DetectAnomalies(sample, AnomalyRulesFromConfig(h.config))
+	if slices.Contains(reward.Flags, config.XPRuleFlagReview) {
+		anomalies = append(anomalies, Anomaly{
+			Kind:   AnomalyXPRule,
+			Detail: "An XP rule flags it for review (matched: " + strings.Join(reward.RuleNames, ", ") + ")",
+		})
+	}
+	held := len(anomalies) > 0 && finalXP > 0
+	if held {
+		flagged := h.character.HoldXP(sha, message, reward.Budget, anomalies, h.now())
+		log.Printf("  Holding %d XP for review (%d anomalies)", finalXP, len(anomalies))
+		h.eventBus.Publish(NewXPFlaggedEvent(flagged))
+		finalXP = 0
+	}
 
 	// Award XP to character (handles level-ups automatically)
 	oldLevel := h.character.Level
 	leveledUp := h.character.AddXP(finalXP)
-
-	// Update character statistics
-	h.character.TotalCommits++
+	h.publishXPChange(finalXP, XPSourceCommit)
+	h.eventBus.Publish(NewCommitScoredEvent(event, finalXP, held))
+	h.publishRecord(h.character.RecordDayXP(finalXP, h.now()))
+
+	// Roll the commit up into its project (if the repo belongs to one)
+	repoPath, _ := event.Data["repo_path"].(string)
+	project := h.config.ProjectForRepo(repoPath)
+	h.character.RecordProjectCommit(project, linesAdded, linesRemoved, finalXP, commitTime(event))
+
+	// Update character statistics. Batched events sum several commits, and
+	// flagged or bot commits shouldn't set personal bests, so only single,
+	// unflagged human commits set records.
+	if commits == 1 && !held && !bot {
+		h.publishRecord(h.character.RecordCommit(linesAdded+linesRemoved, sha, commitTime(event)))
+	}
+	h.character.TotalCommits += commits
 	h.character.TotalLinesAdded += linesAdded
 	h.character.TotalLinesRemoved += linesRemoved
-	h.character.TodayCommits++
-	h.character.TodayLinesAdded += linesAdded
-	h.character.UpdateStreak()
+	if IsConventionalCommit(message) {
+		h.character.WellFormedCommits++
+	}
+	// Replayed commits from earlier days don't count toward today or the streak
+	today := !retroactive || h.character.IsToday(commitTime(event))
+	if today {
+		h.character.TodayCommits += commits
+		h.character.TodayLinesAdded += linesAdded
+	}
+
+	// Streak days follow the home timezone and late-night grace window;
+	// replayed commits count toward the day they were made
+	activeAt := h.now()
+	if retroactive {
+		activeAt = commitTime(event)
+	}
+	h.character.UpdateStreakAt(activeAt, StreakClockFromConfig(h.config))
+	if quests, added := EnsureRedemptionQuest(h.quests, h.character); added {
+		h.quests = quests
+		redemption := quests[len(quests)-1]
+		log.Printf("  Streak of %d days broken - Redemption quest offered", h.character.LostStreak)
+		h.eventBus.Publish(NewStreakBrokenEvent(h.character.LostStreak, h.character.RedeemableDays(), redemption.ID))
+	}
+	h.character.RecordActivityDay(commitTime(event), commits, StreakClockFromConfig(h.config))
+	h.character.RecordRepoActivity(repoPath, commitTime(event), commits, StreakClockFromConfig(h.config))
+	if h.character.NurturePet(activeAt, StreakClockFromConfig(h.config)) {
+		log.Printf("  Companion grew into a %s!", PetStageName(h.character.Pet.Stage))
+	}
+	h.character.SyncEnergyProductivity()
 
 	log.Printf("  Awarded %d XP to %s (Level %d, %d/%d XP)",
 		finalXP, h.character.Name, h.character.Level,
@@ -209,8 +311,61 @@ func (h *GameEventHandler) handleCommitEvent(event Event) {
 		h.eventBus.Publish(levelUpEvent)
 	}
 
+	// Count go.mod dependency bumps toward the Dependency Wrangler track
+	dependencies, _ := event.Data["dependency_changes"].([]DependencyChange)
+	bumps := CountDependencyBumps(dependencies)
+	for _, tier := range h.character.RecordDependencyBumps(bumps) {
+		log.Printf("  Achievement unlocked: %s", tier.Name)
+		h.eventBus.Publish(NewAchievementEvent(tier.ID, tier.Name))
+	}
+
+	// Count contributions to other people's repositories toward the Open
+	// Source track (bots don't contribute on the player's behalf)
+	upstream, _ := event.Data["upstream"].(string)
+	if bot {
+		upstream = ""
+	}
+	for _, tier := range h.character.RecordOpenSourceCommit(upstream, commits) {
+		log.Printf("  Achievement unlocked: %s", tier.Name)
+		h.eventBus.Publish(NewAchievementEvent(tier.ID, tier.Name))
+	}
+
+	// Warn about a late-night habit and count rested days toward Well Rested
+	// (opt-in; bots keep their own hours)
+	if h.config.Tracking.LateNightWarnings && !bot {
+		sleep := h.character.RecordCommitHour(commitTime(event), h.config.Tracking.BedtimeHour, StreakClockFromConfig(h.config))
+		for _, tier := range sleep.Unlocked {
+			log.Printf("  Achievement unlocked: %s", tier.Name)
+			h.eventBus.Publish(NewAchievementEvent(tier.ID, tier.Name))
+		}
+		if sleep.Warn {
+			log.Printf("  Late-night commit (%d late nights this week)", sleep.Nights)
+			h.eventBus.Publish(NewLateNightEvent(sleep.Nights))
+		}
+	}
+
+	// Roll for loot (bots, replays and commits without XP don't qualify)
+	if finalXP > 0 && !bot && !retroactive {
+		if drop, ok := h.character.RollLoot(sha, h.now()); ok {
+			log.Printf("  Loot drop: %s (%s)", drop.Name, drop.Rarity)
+			h.eventBus.Publish(NewLootDropEvent(drop))
+		}
+	}
+
+	// Credit reviews and pairing named in the commit's trailers and git note
+	collab, _ := event.Data["collaboration"].(CommitCollaboration)
+	h.character.RecordCollaboration(collab)
+
+	// Count changed files per language for the profile's top languages
+	paths, _ := event.Data["file_paths"].([]string)
+	h.character.RecordLanguages(paths)
+
+	// Expire last period's daily and monthly quests before counting this commit
+	h.rolloverRecurringQuests(h.now())
+
 	// Update quest progress for all active quests
-	if err := h.updateQuestProgress(linesAdded, linesRemoved); err != nil {
+	resolved, _ := event.Data["resolved_todos"].([]TodoComment)
+	if err := h.updateQuestProgress(commits, today, linesAdded, linesRemoved, message, paths, bumps, resolved, collab, repoPath, project, upstream); err != nil {
 		log.Printf("ERROR: Failed to update quest progress: %v", err)
 	}
 
@@ -277,25 +432,68 @@ func (h *GameEventHandler) extractCommitData(event Event) (int, int, string, str
 	return linesAdded, linesRemoved, sha, message, nil
 }
 
+// commitTime returns when a commit was authored, falling back to the
+// event time if the commit timestamp is missing.
+func commitTime(event Event) time.Time {
+	if timestamp, ok := event.Data["timestamp"].(time.Time); ok {
+		return timestamp
+	}
+	return event.Timestamp
+}
+
+// eventCommits returns how many commits a commit event covers: a throttled
+// watcher may batch several into one event.
+func eventCommits(event Event) int {
+	if count, ok := event.Data["commit_count"].(int); ok && count > 1 {
+		return count
+	}
+	return 1
+}
+
 // updateQuestProgress updates progress for all active quests that track commits or lines.
 // This checks each quest's type and updates progress accordingly:
-//   - QuestTypeCommit: Increment progress by 1 (one commit completed)
-//   - QuestTypeLines: Increment progress by total lines changed
+//   - QuestTypeCommit: Increment progress by the commits the event covers
+//   - QuestTypeLines: Increment progress by total lines changed (in the quest's
+//     repository, for quests started in one; see CountsRepo)
+//   - QuestTypeDaily: Increment progress by the commits the event covers (counts today's
+//     commits; replayed commits from earlier days are skipped)
+//   - QuestTypeStreak: Set progress to the character's current streak
+//   - QuestTypeDocs: Increment progress by markdown files changed
+//   - QuestTypeTests: Increment progress by test files changed
+//   - QuestTypeDeps: Increment progress by 1 for dependency update commits
+//   - QuestTypeMonthly: Increment progress by the go.mod dependency bumps
+//   - QuestTypeTodo: Complete when the commit removed the quest's comment
+//   - QuestTypeTask: Complete when the commit message mentions the quest's task list item
+//   - QuestTypePeerReview, QuestTypePairing: Add the commit's trailer credit
+//   - QuestTypeOpenSource: Increment progress by the commits to other people's repositories
 //
+// Review quests progress from EventReviewApprovals instead (see handleReviewEvent).
+//
+// Project-scoped quests only progress from commits in their project.
 // If a quest is completed during this update, a EventQuestDone event is published.
 //
 // Parameters:
+//   - commits: Commits the event covers (a throttled watcher batches several)
+//   - today: Whether the commit was made today (false for commits replayed from earlier days)
 //   - linesAdded: Lines added in the commit
 //   - linesRemoved: Lines removed in the commit
+//   - message: Commit message
+//   - paths: Paths of the files the commit changed
+//   - bumps: Dependency bumps read from the commit's go.mod changes
+//   - resolved: TODO/FIXME comments the commit removed
+//   - collab: Review and pairing credit from the commit's trailers
+//   - repoPath: Repository the commit was made in
+//   - project: Project of the commit's repository ("" if none)
+//   - upstream: Open source project the commit contributes to ("" if none)
 //
 // Returns:
 //   - error: An error if quest updates fail
-func (h *GameEventHandler) updateQuestProgress(linesAdded, linesRemoved int) error {
+func (h *GameEventHandler) updateQuestProgress(commits int, today bool, linesAdded, linesRemoved int, message string, paths []string, bumps int, resolved []TodoComment, collab CommitCollaboration, repoPath, project, upstream string) error {
 	totalLinesChanged := linesAdded + linesRemoved
 
 	for _, quest := range h.quests {
-		// Only process active quests
-		if quest.Status != QuestActive {
+		// Only process active quests scoped to this commit's project (or unscoped)
+		if quest.Status != QuestActive || !quest.MatchesProject(project) {
 			continue
 		}
 
@@ -304,7 +502,7 @@ func (h *GameEventHandler) updateQuestProgress(linesAdded, linesRemoved int) err
 		switch quest.Type {
 		case QuestTypeCommit:
 			// Commit quest: increment by 1 for each commit
-			quest.UpdateProgress(1)
+			quest.UpdateProgress(commits)
 			if quest.Current > oldProgress {
 				log.Printf("  Quest '%s': %d/%d commits (%d%%)",
 					quest.Title, quest.Current, quest.Target,
@@ -312,7 +510,11 @@ func (h *GameEventHandler) updateQuestProgress(linesAdded, linesRemoved int) err
 			}
 
 		case QuestTypeLines:
-			// Lines quest: increment by total lines changed
+			// Lines quest: increment by total lines changed, in its repository
+			// when started in one (the scope reconciling recounts)
+			if !quest.CountsRepo(repoPath) {
+				continue
+			}
 			quest.UpdateProgress(totalLinesChanged)
 			if quest.Current > oldProgress {
 				log.Printf("  Quest '%s': %d/%d lines (%d%%)",
@@ -320,49 +522,567 @@ func (h *GameEventHandler) updateQuestProgress(linesAdded, linesRemoved int) err
 					int(quest.Progress*100))
 			}
 
+		case QuestTypeDaily:
+			// Daily quest: count today's commits (yesterday's dailies were reset at rollover)
+			if !today {
+				continue
+			}
+			quest.UpdateProgress(commits)
+			if quest.Current > oldProgress {
+				log.Printf("  Daily quest '%s': %d/%d commits today",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeDocs:
+			// Documentation quest: count markdown files touched
+			quest.UpdateProgress(CountMarkdownFiles(paths))
+			if quest.Current > oldProgress {
+				log.Printf("  Docs quest '%s': %d/%d markdown files",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeTests:
+			// Tests quest: count test files touched
+			quest.UpdateProgress(CountTestFiles(paths))
+			if quest.Current > oldProgress {
+				log.Printf("  Tests quest '%s': %d/%d test files",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeDeps:
+			// Dependency quest: count commits that update go.mod
+			if bumps > 0 || IsDependencyUpdate(message, paths) {
+				quest.UpdateProgress(1)
+				log.Printf("  Dependency quest '%s': %d/%d updates",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeMonthly:
+			// Monthly maintenance: count dependencies bumped (last month's was reset at rollover)
+			quest.UpdateProgress(bumps)
+			if quest.Current > oldProgress {
+				log.Printf("  Monthly quest '%s': %d/%d dependencies updated",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeTodo:
+			// Todo quest: done once a commit removes its comment
+			if quest.ResolvedBy(resolved) {
+				quest.UpdateProgress(1)
+				log.Printf("  Todo quest '%s': comment removed", quest.Title)
+			}
+
+		case QuestTypeTask:
+			// Task quest: done once a commit message mentions its item
+			if quest.MentionedIn(message) {
+				quest.UpdateProgress(1)
+				log.Printf("  Task quest '%s': mentioned in commit", quest.Title)
+			}
+
+		case QuestTypePeerReview, QuestTypePairing:
+			// Collaboration quests: credit from Reviewed-by/Co-authored-by trailers
+			quest.UpdateProgress(collabProgress(quest, collab))
+			if quest.Current > oldProgress {
+				log.Printf("  Collaboration quest '%s': %d/%d", quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeWellFormed:
+			// Well-Formed quest line: count conventional commit messages
+			if IsConventionalCommit(message) {
+				quest.UpdateProgress(1)
+				log.Printf("  Well-Formed quest '%s': %d/%d well-formed messages",
+					quest.Title, quest.Current, quest.Target)
+			}
+
+		case QuestTypeOpenSource:
+			// Open source quest: count commits to other people's repositories
+			if upstream != "" {
+				quest.UpdateProgress(commits)
+				log.Printf("  Open source quest '%s': %d/%d contributions (%s)",
+					quest.Title, quest.Current, quest.Target, upstream)
+			}
+
+		case QuestTypeStreak:
+			// Streak quest: mirror the consecutive-day streak
+			quest.SetProgress(h.character.CurrentStreak)
+			if quest.Current != oldProgress {
+				log.Printf("  Streak quest '%s': %d/%d days",
+					quest.Title, quest.Current, quest.Target)
+			}
+
 		default:
-			// Other quest types not handled yet (tests, PRs, refactors, etc.)
+			// Other quest types not handled yet (PRs, refactors, etc.)
 			continue
 		}
 
+		// Let the dashboard animate the change before any completion
+		if quest.Current != oldProgress {
+			h.eventBus.Publish(NewQuestProgressEvent(quest.ID, quest.Title, quest.Current, quest.Target))
+		}
+
 		// Check if quest was completed by this update
 		if quest.CheckCompletion() {
-			// Mark quest as complete
-			if err := quest.Complete(); err != nil {
-				log.Printf("ERROR: Failed to complete quest %s: %v", quest.ID, err)
+			h.completeQuest(quest, project)
+		}
+	}
+
+	return nil
+}
+
+// completeQuest marks a quest whose progress reached its target as complete
+// and pays out its reward: fixed XP (with multipliers), or a pending reward
+// choice for bigger quests. Publishes EventQuestDone (or the reward choice
+// event) and any level-up the reward caused.
+//
+// Parameters:
+//   - quest: The quest that reached its target
+//   - project: Project the completing work belongs to ("" if none)
+func (h *GameEventHandler) completeQuest(quest *Quest, project string) {
+	// Mark quest as complete
+	if err := quest.CompleteAt(h.now()); err != nil {
+		log.Printf("ERROR: Failed to complete quest %s: %v", quest.ID, err)
+		return
+	}
+
+	// Increment character's quests completed counter
+	h.character.QuestsCompleted++
+	h.publishRecord(h.character.RecordQuestCompletion(quest))
+	// Count the day toward the quest streak once this quest's reward is out
+	defer h.recordQuestDay(quest, project)
+	if h.character.RecordPetQuest(quest.Type) {
+		log.Printf("  Companion grew into a %s!", PetStageName(h.character.Pet.Stage))
+	}
+	// The comeback quest wins back stats that faded during a long absence
+	if quest.Template == ComebackTemplateID {
+		if restored := h.character.RestoreDecayedStats(); restored > 0 {
+			log.Printf("  Comeback! %d faded stat points restored", restored)
+		}
+	}
+
+	// The Redemption quest wins back half of a broken streak
+	if quest.Template == RedemptionTemplateID {
+		if restored := h.character.RestoreBrokenStreak(); restored > 0 {
+			log.Printf("  Redemption! %d streak days restored", restored)
+		}
+	}
+
+	// Tick the task list item of a task quest completed here (not in the list)
+	if quest.Task != nil && !quest.Task.Done {
+		quest.Task.Done = true
+		h.eventBus.PublishAsync(NewTaskDoneEvent(*quest.Task))
+	}
+
+	// Bigger quests let the player pick a reward instead of fixed XP
+	if quest.OffersRewardChoice(h.config.Game.RewardChoiceMinXP) {
+		quest.RewardPending = true
+		h.character.RecordProjectQuest(project, 0)
+		log.Printf("  QUEST COMPLETE! '%s' - Reward choice pending", quest.Title)
+		h.eventBus.Publish(withQuestKind(NewQuestRewardChoiceEvent(quest.ID, quest.Title), quest))
+		return
+	}
+
+	// Award quest completion XP (with multipliers), keeping how it was made up
+	breakdown := NewRewardEngine(h.config).QuestXP(quest, h.character)
+	finalQuestXP := breakdown.Total()
+
+	oldLevel := h.character.Level
+	leveledUp := h.character.AddXP(finalQuestXP)
+	h.publishXPChange(finalQuestXP, XPSourceQuest)
+	h.publishRecord(h.character.RecordDayXP(finalQuestXP, h.now()))
+	quest.Reward = &QuestReward{Kind: RewardXP, XP: finalQuestXP, Breakdown: &breakdown, ClaimedAt: h.now()}
+
+	h.character.RecordProjectQuest(project, finalQuestXP)
+
+	log.Printf("  QUEST COMPLETE! '%s' - Awarded %d XP (%s)", quest.Title, finalQuestXP, breakdown)
+
+	// Check for level-up from quest reward
+	if leveledUp {
+		newLevel := h.character.Level
+		log.Printf("  LEVEL UP! %s reached level %d from quest reward!",
+			h.character.Name, newLevel)
+
+		levelUpEvent := NewLevelUpEvent(h.character.ID, oldLevel, newLevel)
+		h.eventBus.Publish(levelUpEvent)
+	}
+
+	// Publish quest completion event
+	questDoneEvent := withQuestKind(NewQuestDoneEvent(quest.ID, quest.Title, finalQuestXP), quest)
+	questDoneEvent.Data["xp_breakdown"] = breakdown
+	h.eventBus.Publish(questDoneEvent)
+}
+
+// recordQuestDay counts a completed quest toward the quest streak, crowns
+// the week when it's the last day of a full week of quests, and moves Quest
+// a Day (quest streak) quests along with the streak.
+//
+// Parameters:
+//   - completed: The quest just completed
+//   - project: Project the completing work belongs to ("" if none)
+func (h *GameEventHandler) recordQuestDay(completed *Quest, project string) {
+	result := h.character.RecordQuestDay(h.now(), StreakClockFromConfig(h.config))
+	if result.Crowned {
+		log.Printf("  CROWNED! A quest every day of %s (%d crowns)", result.Week, h.character.Crowns)
+		h.eventBus.Publish(NewQuestCrownEvent(result.Week, h.character.Crowns))
+	}
+	for _, tier := range result.Unlocked {
+		log.Printf("  Achievement unlocked: %s", tier.Name)
+		h.eventBus.Publish(NewAchievementEvent(tier.ID, tier.Name))
+	}
+
+	for _, quest := range h.quests {
+		if quest == completed || quest.Type != QuestTypeQuestStreak || quest.Status != QuestActive {
+			continue
+		}
+		oldProgress := quest.Current
+		quest.SetProgress(h.character.QuestStreak)
+		if quest.Current == oldProgress {
+			continue
+		}
+		log.Printf("  Quest streak quest '%s': %d/%d days", quest.Title, quest.Current, quest.Target)
+		h.eventBus.Publish(NewQuestProgressEvent(quest.ID, quest.Title, quest.Current, quest.Target))
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, project)
+		}
+	}
+}
+
+// withQuestKind adds the quest's type and template to a completion event,
+// so subscribers (such as habit tracker integrations) can tell daily and
+// built-in quests apart without the quest log.
+func withQuestKind(event Event, quest *Quest) Event {
+	event.Data["quest_type"] = quest.Type
+	event.Data["quest_template"] = quest.Template
+	return event
+}
+
+// publishXPChange records an XP change in the ledger and counts XP gained
+// toward the season pass, announcing any tiers it unlocks.
+//
+// Parameters:
+//   - amount: XP gained (0 records nothing)
+//   - source: What changed it (XPSource constants)
+func (h *GameEventHandler) publishXPChange(amount int, source string) {
+	if amount == 0 {
+		return
+	}
+	h.eventBus.Publish(NewXPChangedEvent(amount, source, h.character.TotalXP()))
+
+	for _, tier := range h.character.AddSeasonXP(amount, h.now(), StreakClockFromConfig(h.config)) {
+		log.Printf("  Season pass tier %d unlocked: %s", tier.Tier, tier.Name)
+		h.eventBus.Publish(NewSeasonTierEvent(h.character.Season, tier))
+	}
+}
+
+// publishRecord announces a broken personal best.
+//
+// Parameters:
+//   - record: The broken record (nil does nothing)
+func (h *GameEventHandler) publishRecord(record *RecordBreak) {
+	if record == nil {
+		return
+	}
+	log.Printf("  NEW PERSONAL BEST! %s", record.Message())
+	h.eventBus.Publish(NewRecordBrokenEvent(*record))
+}
+
+// handleReviewEvent updates review quests from the player's pull request
+// approvals on GitHub. Each active review quest counts the approvals given
+// since it started; progress never goes backwards (e.g. when older
+// approvals fall out of the lookup window).
+//
+// Parameters:
+//   - event: The EventReviewApprovals event
+func (h *GameEventHandler) handleReviewEvent(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	approvals, _ := event.Data["approved_at"].([]time.Time)
+
+	changed := false
+	for _, quest := range h.quests {
+		if quest.Status != QuestActive || quest.Type != QuestTypeReview || quest.StartedAt == nil {
+			continue
+		}
+
+		count := 0
+		for _, at := range approvals {
+			if !at.Before(*quest.StartedAt) {
+				count++
+			}
+		}
+		if count <= quest.Current {
+			continue
+		}
+
+		quest.SetProgress(count)
+		changed = true
+		log.Printf("  Review quest '%s': %d/%d approvals", quest.Title, quest.Current, quest.Target)
+		h.eventBus.Publish(NewQuestProgressEvent(quest.ID, quest.Title, quest.Current, quest.Target))
+
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, "")
+		}
+	}
+
+	if changed {
+		if err := h.saveState(); err != nil {
+			log.Printf("ERROR: Failed to save state: %v", err)
+		}
+	}
+}
+
+// handleTaskListEvent syncs task quests with the watched repositories'
+// task lists: unchecked items without a quest get one, and open quests whose
+// item was ticked in the list complete (available ones are started first).
+//
+// Parameters:
+//   - event: The EventTaskList event
+func (h *GameEventHandler) handleTaskListEvent(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	tasks, _ := event.Data["tasks"].([]TaskItem)
+	quests, added, ticked := SyncTaskQuests(h.quests, tasks)
+	h.quests = quests
+
+	for _, quest := range ticked {
+		quest.Task.Done = true
+		if quest.Status == QuestAvailable {
+			if err := quest.StartAt("", "", h.now()); err != nil {
+				log.Printf("ERROR: Failed to start task quest %s: %v", quest.ID, err)
 				continue
 			}
+		}
+		log.Printf("  Task quest '%s': ticked in %s", quest.Title, quest.Task.File)
+		quest.UpdateProgress(1)
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, h.config.ProjectForRepo(quest.Task.Repo))
+		}
+	}
 
-			// Increment character's quests completed counter
-			h.character.QuestsCompleted++
+	if len(added) == 0 && len(ticked) == 0 {
+		return
+	}
+	if err := h.saveState(); err != nil {
+		log.Printf("ERROR: Failed to save state: %v", err)
+	}
 
-			// Award quest completion XP (with multipliers)
-			questXP := quest.XPReward
-			questXPWithDifficulty := ApplyDifficultyMultiplier(questXP, h.config.Game.Difficulty)
-			finalQuestXP := ApplyWisdomBonus(questXPWithDifficulty, h.character.Wisdom)
+	// Published even when nothing was added, so the UI reloads the quests
+	// ticking completed
+	titles := make([]string, len(added))
+	for i, quest := range added {
+		titles[i] = quest.Title
+	}
+	log.Printf("  %d task list item(s) became quests, %d ticked", len(added), len(ticked))
+	h.eventBus.Publish(NewTaskListSyncedEvent(titles))
+}
 
-			oldLevel := h.character.Level
-			leveledUp := h.character.AddXP(finalQuestXP)
+// handleQuestsArrangedEvent applies the Quest Board order and pins the
+// player chose to the handler's quests and saves them, so the handler's
+// later saves keep the arrangement.
+//
+// Parameters:
+//   - event: The quests arranged event
+func (h *GameEventHandler) handleQuestsArrangedEvent(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
-			log.Printf("  QUEST COMPLETE! '%s' - Awarded %d XP", quest.Title, finalQuestXP)
+	order, _ := event.Data["order"].([]string)
+	pinnedIDs, _ := event.Data["pinned"].([]string)
+	pinned := make(map[string]bool, len(pinnedIDs))
+	for _, id := range pinnedIDs {
+		pinned[id] = true
+	}
+	h.quests = ArrangeQuests(h.quests, order, pinned)
 
-			// Check for level-up from quest reward
-			if leveledUp {
-				newLevel := h.character.Level
-				log.Printf("  LEVEL UP! %s reached level %d from quest reward!",
-					h.character.Name, newLevel)
+	if err := h.saveState(); err != nil {
+		log.Printf("ERROR: Failed to save state after arranging quests: %v", err)
+	}
+}
 
-				levelUpEvent := NewLevelUpEvent(h.character.ID, oldLevel, newLevel)
-				h.eventBus.Publish(levelUpEvent)
+// handleUncommittedWorkEvent tracks how long each repository's uncommitted
+// changes have sat: work left longer than wip.min_days becomes a quest to
+// commit or stash it, and open wip quests complete once their repository's
+// work is cleared (available ones are started first).
+//
+// Parameters:
+//   - event: The EventUncommittedWork event
+func (h *GameEventHandler) handleUncommittedWorkEvent(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	work, _ := event.Data["work"].([]UncommittedWork)
+	settings := h.config.WIP.WithDefaults()
+	tracked := len(h.character.UncommittedSince)
+	stale, cleared := h.character.TrackUncommittedWork(work, settings.MinLines, time.Duration(settings.MinDays)*24*time.Hour, h.now())
+	quests, added, done := SyncWIPQuests(h.quests, stale, cleared)
+	h.quests = quests
+
+	for _, quest := range done {
+		if quest.Status == QuestAvailable {
+			if err := quest.StartAt("", "", h.now()); err != nil {
+				log.Printf("ERROR: Failed to start wip quest %s: %v", quest.ID, err)
+				continue
 			}
+		}
+		log.Printf("  WIP quest '%s': work committed or stashed", quest.Title)
+		quest.UpdateProgress(1)
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, h.config.ProjectForRepo(quest.WIP.Repo))
+		}
+	}
 
-			// Publish quest completion event
-			questDoneEvent := NewQuestDoneEvent(quest.ID, quest.Title, finalQuestXP)
-			h.eventBus.Publish(questDoneEvent)
+	if len(added) == 0 && len(cleared) == 0 && len(h.character.UncommittedSince) == tracked {
+		return
+	}
+	if err := h.saveState(); err != nil {
+		log.Printf("ERROR: Failed to save state: %v", err)
+	}
+	if len(added) == 0 && len(done) == 0 {
+		return
+	}
+
+	// Published for completions too, so the UI reloads the quests
+	nudges := make([]UncommittedWork, len(added))
+	for i, quest := range added {
+		nudges[i] = *quest.WIP
+	}
+	log.Printf("  %d repository(ies) with uncommitted work became quests, %d cleared", len(added), len(done))
+	h.eventBus.Publish(NewWIPNudgeEvent(nudges))
+}
+
+// RefreshGeneratedQuests clears away the quest catalog's quests whose day
+// or week is over and draws the current day's and week's quests if they're
+// missing, so the Quest Board is never empty. New quests start right away
+// when quests are set to auto-start. Run at startup and at each day
+// rollover (see DayScheduler).
+//
+// Parameters:
+//   - now: Current time
+func (h *GameEventHandler) RefreshGeneratedQuests(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	quests, added, expired := RefreshGeneratedQuests(h.quests, h.character, h.config.Catalog.WithDefaults(), now, StreakClockFromConfig(h.config))
+	if len(quests) == len(h.quests) && len(added) == 0 {
+		return
+	}
+	h.quests = quests
+
+	addedTitles := make([]string, len(added))
+	for i, quest := range added {
+		addedTitles[i] = quest.Title
+		if h.config.Game.AutoStartQuests {
+			if err := quest.StartAt("", "", now); err != nil {
+				log.Printf("ERROR: Failed to start generated quest %s: %v", quest.ID, err)
+				continue
+			}
+			h.eventBus.Publish(NewQuestStartEvent(quest.ID, quest.Title, string(quest.Type)))
 		}
 	}
+	expiredTitles := make([]string, len(expired))
+	for i, quest := range expired {
+		expiredTitles[i] = quest.Title
+	}
 
-	return nil
+	if err := h.saveState(); err != nil {
+		log.Printf("ERROR: Failed to save state: %v", err)
+	}
+	log.Printf("  Quest catalog: %d quest(s) drawn, %d expired", len(added), len(expired))
+	h.eventBus.Publish(NewQuestsGeneratedEvent(addedTitles, expiredTitles))
+}
+
+// handleCollaborationEvent credits the player for reviewing or co-authoring
+// someone else's commit: the lifetime stats and active collaboration quests
+// in the commit's project move forward.
+//
+// Parameters:
+//   - event: The EventCollaboration event
+func (h *GameEventHandler) handleCollaborationEvent(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	collab, _ := event.Data["collaboration"].(CommitCollaboration)
+	if !collab.Any() {
+		return
+	}
+	repoPath, _ := event.Data["repo_path"].(string)
+	project := h.config.ProjectForRepo(repoPath)
+	h.character.RecordCollaboration(collab)
+	log.Printf("Collaboration credit: %d review(s) given, co-authored %d", collab.ReviewsGiven, collab.CoAuthored)
+
+	for _, quest := range h.quests {
+		if quest.Status != QuestActive || !quest.MatchesProject(project) {
+			continue
+		}
+		progress := collabProgress(quest, collab)
+		if progress == 0 {
+			continue
+		}
+
+		quest.UpdateProgress(progress)
+		log.Printf("  Collaboration quest '%s': %d/%d", quest.Title, quest.Current, quest.Target)
+		h.eventBus.Publish(NewQuestProgressEvent(quest.ID, quest.Title, quest.Current, quest.Target))
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, project)
+		}
+	}
+
+	if err := h.saveState(); err != nil {
+		log.Printf("ERROR: Failed to save state: %v", err)
+	}
+}
+
+// collabProgress returns how far a commit's trailer credit moves a quest:
+// peer review quests count reviews given, pairing quests count commits a
+// teammate reviewed or co-wrote. Other quest types get nothing.
+func collabProgress(quest *Quest, collab CommitCollaboration) int {
+	switch quest.Type {
+	case QuestTypePeerReview:
+		return collab.ReviewsGiven
+	case QuestTypePairing:
+		if collab.ReviewsReceived > 0 || collab.CoAuthored > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// rolloverRecurringQuests resets daily quests from a previous day and monthly
+// quests from a previous month so they can be taken again. Unfinished ones
+// expire without a reward; when quests are set to auto-start, they begin
+// again immediately for the new period.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - bool: True if any quest changed
+func (h *GameEventHandler) rolloverRecurringQuests(now time.Time) bool {
+	clock := StreakClockFromConfig(h.config)
+	changed := false
+
+	for _, quest := range h.quests {
+		if !quest.NeedsDailyReset(now, clock) && !quest.NeedsMonthlyReset(now, clock) {
+			continue
+		}
+
+		if quest.Status == QuestActive {
+			log.Printf("  Recurring quest '%s' expired at %d/%d", quest.Title, quest.Current, quest.Target)
+		}
+		quest.Reset()
+		changed = true
+
+		if h.config.Game.AutoStartQuests {
+			if err := quest.StartAt("", "", now); err != nil {
+				log.Printf("ERROR: Failed to restart recurring quest %s: %v", quest.ID, err)
+				continue
+			}
+			h.eventBus.Publish(NewQuestStartEvent(quest.ID, quest.Title, string(quest.Type)))
+		}
+	}
+
+	return changed
 }
 
 // saveState persists the current character and quest state to storage.
@@ -372,6 +1092,14 @@ func (h *GameEventHandler) updateQuestProgress(linesAdded, linesRemoved int) err
 // Returns:
 //   - error: An error if persistence fails
 func (h *GameEventHandler) saveState() error {
+	// Save both together when the backend supports batches
+	if saver, ok := h.storage.(StateSaver); ok {
+		if err := saver.SaveState(h.character, h.quests); err != nil {
+			return fmt.Errorf("saving state: %w", err)
+		}
+		return nil
+	}
+
 	// Save character
 	if err := h.storage.SaveCharacter(h.character); err != nil {
 		return fmt.Errorf("saving character: %w", err)
@@ -462,12 +1190,14 @@ func (h *GameEventHandler) StartQuest(questID, repoPath, baseSHA string) error {
 
 	// Check if quest is available
 	if !targetQuest.IsAvailable(h.character) {
-		return fmt.Errorf("quest '%s' is not available (status: %s, required level: %d, character level: %d)",
-			targetQuest.Title, targetQuest.Status, targetQuest.RequiredLevel, h.character.Level)
+		if reasons := targetQuest.LockReasons(h.character); len(reasons) > 0 {
+			return fmt.Errorf("quest '%s' is locked: %s", targetQuest.Title, strings.Join(reasons, "; "))
+		}
+		return fmt.Errorf("quest '%s' is not available (status: %s)", targetQuest.Title, targetQuest.Status)
 	}
 
 	// Start the quest
-	if err := targetQuest.Start(repoPath, baseSHA); err != nil {
+	if err := targetQuest.StartAt(repoPath, baseSHA, h.now()); err != nil {
 		return fmt.Errorf("starting quest: %w", err)
 	}
 
@@ -0,0 +1,112 @@
+// Package game contains the core game logic for CodeQuest.
+// This file tracks which programming languages the player works in, by
+// counting changed files per language, for the profile's top languages.
+package game
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// languageByExtension maps source file extensions to language names.
+// Docs, config and data files aren't listed, so they don't count.
+var languageByExtension = map[string]string{
+	".go":    "Go",
+	".py":    "Python",
+	".js":    "JavaScript",
+	".jsx":   "JavaScript",
+	".mjs":   "JavaScript",
+	".ts":    "TypeScript",
+	".tsx":   "TypeScript",
+	".rs":    "Rust",
+	".rb":    "Ruby",
+	".java":  "Java",
+	".kt":    "Kotlin",
+	".swift": "Swift",
+	".c":     "C",
+	".h":     "C",
+	".cc":    "C++",
+	".cpp":   "C++",
+	".hpp":   "C++",
+	".cs":    "C#",
+	".php":   "PHP",
+	".lua":   "Lua",
+	".zig":   "Zig",
+	".ex":    "Elixir",
+	".exs":   "Elixir",
+	".hs":    "Haskell",
+	".scala": "Scala",
+	".dart":  "Dart",
+	".sh":    "Shell",
+	".bash":  "Shell",
+	".sql":   "SQL",
+	".html":  "HTML",
+	".css":   "CSS",
+	".scss":  "CSS",
+}
+
+// LanguageShare is one language's share of the files the player changed.
+type LanguageShare struct {
+	Name  string  // Language name
+	Files int     // Changed files in this language
+	Share float64 // Fraction of all counted file changes (0-1)
+}
+
+// LanguageForPath returns the language of a source file from its extension.
+//
+// Parameters:
+//   - path: File path (any separator)
+//
+// Returns:
+//   - string: Language name, or "" for files that aren't source code
+func LanguageForPath(path string) string {
+	return languageByExtension[strings.ToLower(filepath.Ext(path))]
+}
+
+// RecordLanguages counts a commit's changed files toward their languages.
+//
+// Parameters:
+//   - paths: Files changed in the commit
+func (c *Character) RecordLanguages(paths []string) {
+	for _, path := range paths {
+		language := LanguageForPath(path)
+		if language == "" {
+			continue
+		}
+		if c.Languages == nil {
+			c.Languages = make(map[string]int)
+		}
+		c.Languages[language]++
+	}
+}
+
+// TopLanguages returns the languages with the most changed files.
+//
+// Parameters:
+//   - n: Most languages to return
+//
+// Returns:
+//   - []LanguageShare: Languages by file count, most first (ties by name)
+func (c *Character) TopLanguages(n int) []LanguageShare {
+	total := 0
+	shares := make([]LanguageShare, 0, len(c.Languages))
+	for name, files := range c.Languages {
+		total += files
+		shares = append(shares, LanguageShare{Name: name, Files: files})
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Files != shares[j].Files {
+			return shares[i].Files > shares[j].Files
+		}
+		return shares[i].Name < shares[j].Name
+	})
+	if len(shares) > n {
+		shares = shares[:max(n, 0)]
+	}
+	for i := range shares {
+		shares[i].Share = float64(shares[i].Files) / float64(total)
+	}
+	return shares
+}
@@ -0,0 +1,48 @@
+package game
+
+import "testing"
+
+// TestLanguageForPath tests mapping file extensions to languages.
+func TestLanguageForPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"internal/game/quest.go", "Go"},
+		{"web/App.TSX", "TypeScript"},
+		{`src\lib.rs`, "Rust"},
+		{"README.md", ""},
+		{"go.mod", ""},
+		{"Makefile", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := LanguageForPath(tt.path); got != tt.want {
+				t.Errorf("LanguageForPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTopLanguages tests ranking languages by changed files.
+func TestTopLanguages(t *testing.T) {
+	c := NewCharacter("Tester")
+	if got := c.TopLanguages(3); len(got) != 0 {
+		t.Errorf("TopLanguages() = %+v before any commits, want none", got)
+	}
+
+	c.RecordLanguages([]string{"main.go", "handlers.go", "README.md"})
+	c.RecordLanguages([]string{"app.ts", "server.go", "build.sh", "deploy.sh"})
+
+	got := c.TopLanguages(2)
+	if len(got) != 2 {
+		t.Fatalf("TopLanguages(2) returned %d languages, want 2", len(got))
+	}
+	if got[0].Name != "Go" || got[0].Files != 3 || got[0].Share != 0.5 {
+		t.Errorf("top language = %+v, want Go with 3 files (50%%)", got[0])
+	}
+	if got[1].Name != "Shell" || got[1].Files != 2 {
+		t.Errorf("second language = %+v, want Shell with 2 files", got[1])
+	}
+}
@@ -0,0 +1,169 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements sleep hygiene tracking: commits made after the
+// player's bedtime (and before LateNightEnd) are late-night commits, a habit
+// of them earns a gentle warning, and going days without one climbs the Well
+// Rested achievement track. It is all opt-in (tracking.late_night_warnings).
+package game
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// LateNightEnd is the hour (home timezone) late nights end; commits from
+	// bedtime until then are late-night commits
+	LateNightEnd = 5
+
+	// LateNightHabit is how many late nights within LateNightWindow count as a
+	// habit worth a warning
+	LateNightHabit = 3
+
+	// LateNightWindow is how many days back late nights are remembered
+	LateNightWindow = 7
+)
+
+// WellRestedTiers is the achievement track for days in a row without a
+// late-night commit, in unlock order.
+var WellRestedTiers = []AchievementTier{
+	{ID: "well-rested-1", Name: "Well Rested", Threshold: 14},
+	{ID: "well-rested-2", Name: "Well Rested II", Threshold: 30},
+	{ID: "well-rested-3", Name: "Well Rested III", Threshold: 60},
+}
+
+// LateNightResult is what a commit meant for sleep hygiene.
+type LateNightResult struct {
+	Late     bool              // The commit was made after bedtime
+	Nights   int               // Late nights within LateNightWindow, this one included
+	Warn     bool              // The late nights became a habit and tonight hasn't been warned yet
+	Unlocked []AchievementTier // Well Rested tiers newly unlocked
+}
+
+// IsLateNight reports whether an instant falls between bedtime and
+// LateNightEnd in the home timezone.
+//
+// Parameters:
+//   - t: The instant to check
+//   - bedtime: Bedtime hour, 0-23 (a bedtime after LateNightEnd wraps past midnight)
+//   - clock: Home timezone
+//
+// Returns:
+//   - bool: True if it's a late-night instant
+func IsLateNight(t time.Time, bedtime int, clock StreakClock) bool {
+	hour := t.In(clock.location()).Hour()
+	if bedtime < LateNightEnd {
+		return hour >= bedtime && hour < LateNightEnd
+	}
+	return hour >= bedtime || hour < LateNightEnd
+}
+
+// nightOf returns the night an instant belongs to, as midnight UTC of the
+// evening's date: 23:30 and 01:00 the next morning are the same night.
+func nightOf(t time.Time, clock StreakClock) time.Time {
+	local := t.In(clock.location()).Add(-LateNightEnd * time.Hour)
+	year, month, day := local.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// StartSleepTracking starts counting days without a late-night commit, if
+// they aren't being counted already.
+//
+// Parameters:
+//   - now: When tracking starts
+func (c *Character) StartSleepTracking(now time.Time) {
+	if c.RestedSince.IsZero() {
+		c.RestedSince = now
+	}
+}
+
+// StopSleepTracking forgets late nights and the Well Rested run, so turning
+// warnings back on starts afresh. Unlocked tiers are kept.
+func (c *Character) StopSleepTracking() {
+	c.LateNights = nil
+	c.LateNightWarned = ""
+	c.RestedSince = time.Time{}
+}
+
+// RestedDays returns how many days have passed since the last late-night
+// commit (or since tracking started).
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Home timezone
+//
+// Returns:
+//   - int: Days without a late-night commit (0 while untracked)
+func (c *Character) RestedDays(now time.Time, clock StreakClock) int {
+	if c.RestedSince.IsZero() || now.Before(c.RestedSince) {
+		return 0
+	}
+	return int(nightOf(now, clock).Sub(nightOf(c.RestedSince, clock)).Hours() / 24)
+}
+
+// RecentLateNights returns how many late nights fall within LateNightWindow
+// of now.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Home timezone
+//
+// Returns:
+//   - int: Late nights in the past week
+func (c *Character) RecentLateNights(now time.Time, clock StreakClock) int {
+	since := nightOf(now, clock).AddDate(0, 0, -LateNightWindow)
+	count := 0
+	for _, night := range c.LateNights {
+		if day, err := time.Parse(activityDayFormat, night); err == nil && day.After(since) {
+			count++
+		}
+	}
+	return count
+}
+
+// RecordCommitHour checks a commit's time against bedtime. Days rested up
+// to the commit count toward the Well Rested track first, so a long run is
+// rewarded even when it ends with a late night; a late-night commit then
+// restarts the run and is remembered for the habit check.
+//
+// Parameters:
+//   - at: When the commit was made
+//   - bedtime: Bedtime hour, 0-23
+//   - clock: Home timezone
+//
+// Returns:
+//   - LateNightResult: Whether it was late, the recent late nights and any tiers unlocked
+func (c *Character) RecordCommitHour(at time.Time, bedtime int, clock StreakClock) LateNightResult {
+	c.StartSleepTracking(at)
+
+	var result LateNightResult
+	rested := c.RestedDays(at, clock)
+	for _, tier := range WellRestedTiers {
+		if rested >= tier.Threshold && c.UnlockAchievement(tier.ID) {
+			result.Unlocked = append(result.Unlocked, tier)
+		}
+	}
+	if !IsLateNight(at, bedtime, clock) {
+		return result
+	}
+
+	night := nightOf(at, clock).Format(activityDayFormat)
+	if !slices.Contains(c.LateNights, night) {
+		c.LateNights = append(c.LateNights, night)
+		slices.Sort(c.LateNights)
+	}
+	// Only the most recent week of late nights is kept
+	latest, _ := time.Parse(activityDayFormat, c.LateNights[len(c.LateNights)-1])
+	oldest := latest.AddDate(0, 0, -LateNightWindow).Format(activityDayFormat)
+	c.LateNights = slices.DeleteFunc(c.LateNights, func(n string) bool { return n <= oldest })
+	if at.After(c.RestedSince) {
+		c.RestedSince = at
+	}
+
+	result.Late = true
+	result.Nights = c.RecentLateNights(at, clock)
+	if result.Nights >= LateNightHabit && c.LateNightWarned != night {
+		result.Warn = true
+		c.LateNightWarned = night
+	}
+	return result
+}
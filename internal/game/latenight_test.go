@@ -0,0 +1,101 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestIsLateNight tests classifying commit hours against bedtimes before
+// and after midnight.
+func TestIsLateNight(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	at := func(hour int) time.Time { return time.Date(2026, 10, 14, hour, 30, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name    string
+		hour    int
+		bedtime int
+		want    bool
+	}{
+		{"evening before bedtime", 22, 23, false},
+		{"after an evening bedtime", 23, 23, true},
+		{"small hours after an evening bedtime", 2, 23, true},
+		{"morning", 5, 23, false},
+		{"before a midnight bedtime", 23, 0, false},
+		{"after a midnight bedtime", 0, 0, true},
+		{"before a 1am bedtime", 0, 1, false},
+		{"after a 1am bedtime", 4, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLateNight(at(tt.hour), tt.bedtime, clock); got != tt.want {
+				t.Errorf("IsLateNight(%02d:30, bedtime %d) = %v, want %v", tt.hour, tt.bedtime, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRecordCommitHourHabit tests that the third late night in a week
+// warns once, and that one night spans midnight.
+func TestRecordCommitHourHabit(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	character := NewCharacter("Tester")
+	monday := time.Date(2026, 10, 12, 23, 30, 0, 0, time.UTC)
+
+	steps := []struct {
+		name       string
+		at         time.Time
+		wantLate   bool
+		wantNights int
+		wantWarn   bool
+	}{
+		{"first late night", monday, true, 1, false},
+		{"after midnight is the same night", monday.Add(2 * time.Hour), true, 1, false},
+		{"daytime commit", monday.Add(12 * time.Hour), false, 0, false},
+		{"second late night", monday.AddDate(0, 0, 1), true, 2, false},
+		{"third late night warns", monday.AddDate(0, 0, 3), true, 3, true},
+		{"same night warns once", monday.AddDate(0, 0, 3).Add(time.Hour), true, 3, false},
+		{"a week on, old nights are forgotten", monday.AddDate(0, 0, 8), true, 2, false},
+	}
+
+	for _, step := range steps {
+		result := character.RecordCommitHour(step.at, 23, clock)
+		if result.Late != step.wantLate || result.Nights != step.wantNights || result.Warn != step.wantWarn {
+			t.Errorf("%s: got late=%v nights=%d warn=%v, want late=%v nights=%d warn=%v", step.name,
+				result.Late, result.Nights, result.Warn, step.wantLate, step.wantNights, step.wantWarn)
+		}
+	}
+}
+
+// TestRecordCommitHourWellRested tests climbing the Well Rested track and
+// restarting the run after a late night.
+func TestRecordCommitHourWellRested(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	character := NewCharacter("Tester")
+	start := time.Date(2026, 10, 1, 14, 0, 0, 0, time.UTC)
+	character.StartSleepTracking(start)
+
+	if result := character.RecordCommitHour(start.AddDate(0, 0, 13), 0, clock); len(result.Unlocked) != 0 {
+		t.Errorf("13 rested days unlocked %v, want nothing", result.Unlocked)
+	}
+	result := character.RecordCommitHour(start.AddDate(0, 0, 14), 0, clock)
+	if len(result.Unlocked) != 1 || result.Unlocked[0].ID != "well-rested-1" {
+		t.Fatalf("14 rested days unlocked %v, want Well Rested", result.Unlocked)
+	}
+
+	// A late night restarts the run; the tier stays unlocked
+	late := time.Date(2026, 10, 16, 1, 0, 0, 0, time.UTC)
+	character.RecordCommitHour(late, 0, clock)
+	if got := character.RestedDays(late.Add(12*time.Hour), clock); got != 1 {
+		t.Errorf("RestedDays() after a late night = %d, want 1", got)
+	}
+	if !character.HasAchievement("well-rested-1") {
+		t.Error("a late night should not take Well Rested away")
+	}
+
+	character.StopSleepTracking()
+	if got := character.RestedDays(late.AddDate(0, 0, 5), clock); got != 0 || len(character.LateNights) != 0 {
+		t.Errorf("after StopSleepTracking: rested %d, late nights %v, want nothing tracked", got, character.LateNights)
+	}
+}
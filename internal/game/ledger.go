@@ -0,0 +1,200 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the XP ledger audit: every XP change is published as
+// an EventXPChanged (kept in the event log when it's on), and AuditXP
+// replays those entries against the stored character to find XP that
+// changed without a ledger entry, from missed events or manual edits.
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sources of XP changes recorded in the ledger.
+const (
+	XPSourceCommit  = "commit"  // Commit XP
+	XPSourceQuest   = "quest"   // Quest completion XP
+	XPSourceReward  = "reward"  // XP picked as a quest reward
+	XPSourceFlagged = "flagged" // Held commit XP accepted after review
+	XPSourceRespec  = "respec"  // XP spent on a respec
+	XPSourceAudit   = "audit"   // Adjustment made by `codequest audit`
+)
+
+// Causes of ledger discrepancies.
+const (
+	XPCauseMissedEvents = "missed events" // XP was gained without a ledger entry (e.g. while the event log was off)
+	XPCauseManualEdit   = "manual edit"   // XP dropped without a ledger entry, or the save doesn't add up
+)
+
+// LedgerEntry is one XP change from the ledger.
+type LedgerEntry struct {
+	At      time.Time // When XP changed
+	Amount  int       // XP gained (negative when spent)
+	Source  string    // What changed it (XPSource constants)
+	TotalXP int       // Cumulative XP after the change
+}
+
+// XPDiscrepancy is a difference between the ledger and the XP it should add
+// up to.
+type XPDiscrepancy struct {
+	At       time.Time // When it was found (the entry after the gap; zero for the stored character)
+	Expected int       // Cumulative XP the ledger adds up to
+	Found    int       // Cumulative XP actually recorded
+	Cause    string    // Likely cause (XPCause constants)
+	Detail   string    // What doesn't match
+}
+
+// Difference returns how much more XP was found than expected (negative
+// when XP is missing).
+func (d XPDiscrepancy) Difference() int {
+	return d.Found - d.Expected
+}
+
+// XPAudit is the result of checking the ledger against the character.
+type XPAudit struct {
+	Entries       int             // Ledger entries checked
+	ExpectedXP    int             // Cumulative XP the ledger adds up to
+	StoredXP      int             // Cumulative XP of the stored character
+	ExpectedLevel int             // Level the ledger's XP makes
+	StoredLevel   int             // Stored level
+	Discrepancies []XPDiscrepancy // Oldest first
+}
+
+// Clean reports whether the ledger and the character agree.
+func (a XPAudit) Clean() bool {
+	return len(a.Discrepancies) == 0
+}
+
+// TotalXP returns all XP the character has earned (less XP spent): the XP
+// of every level reached plus progress toward the next.
+//
+// Returns:
+//   - int: Cumulative XP
+func (c *Character) TotalXP() int {
+	return GetTotalXPForLevel(c.Level) + c.XP
+}
+
+// SetTotalXP sets the character's level and XP from cumulative XP, as a
+// reconciliation. Levels gained grant skill points like AddXP does; levels
+// lost keep the points already given.
+//
+// Parameters:
+//   - total: Cumulative XP (negative values count as 0)
+func (c *Character) SetTotalXP(total int) {
+	level, remaining := GetLevelFromXP(max(total, 0))
+	if level > c.Level {
+		c.SkillPoints += (level - c.Level) * StatPointsPerLevel
+	}
+	c.Level = level
+	c.XP = remaining
+	c.XPToNextLevel = CalculateXPForLevel(level)
+}
+
+// LedgerFromEvents picks the XP ledger entries out of logged events. Numbers
+// decoded from JSON are accepted as well as ints.
+//
+// Parameters:
+//   - events: Events in log order
+//
+// Returns:
+//   - []LedgerEntry: XP changes in log order
+func LedgerFromEvents(events []Event) []LedgerEntry {
+	var entries []LedgerEntry
+	for _, event := range events {
+		if event.Type != EventXPChanged {
+			continue
+		}
+		amount, okAmount := ledgerInt(event.Data["amount"])
+		total, okTotal := ledgerInt(event.Data["total_xp"])
+		if !okAmount || !okTotal {
+			continue
+		}
+		source, _ := event.Data["source"].(string)
+		entries = append(entries, LedgerEntry{At: event.Timestamp, Amount: amount, Source: source, TotalXP: total})
+	}
+	return entries
+}
+
+// ledgerInt reads an int from event data, which is a float64 once decoded
+// from JSON.
+func ledgerInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
+// AuditXP checks the XP ledger against the stored character. The first
+// entry anchors the audit (the log may start after the character did, or
+// have rotated its oldest files away); after that, each entry should add
+// its amount to the previous total, and the last total should match the
+// character. XP found without an entry is a missed event when it went up
+// and a manual edit when it went down. An XPSourceAudit entry marks an
+// earlier reconciliation, so gaps before it aren't reported again. A stored
+// level and XP that don't add up are a manual edit too.
+//
+// Parameters:
+//   - entries: Ledger entries in log order
+//   - character: The stored character
+//
+// Returns:
+//   - XPAudit: What the ledger adds up to and every discrepancy found
+func AuditXP(entries []LedgerEntry, character *Character) XPAudit {
+	audit := XPAudit{
+		Entries:     len(entries),
+		StoredXP:    character.TotalXP(),
+		StoredLevel: character.Level,
+		ExpectedXP:  character.TotalXP(),
+	}
+
+	if len(entries) > 0 {
+		expected := entries[0].TotalXP
+		for _, entry := range entries[1:] {
+			want := expected + entry.Amount
+			switch {
+			case entry.Source == XPSourceAudit:
+				audit.Discrepancies = nil
+			case entry.TotalXP != want:
+				audit.Discrepancies = append(audit.Discrepancies, xpGap(entry.At, want, entry.TotalXP,
+					fmt.Sprintf("%s entry of %+d XP", entry.Source, entry.Amount)))
+			}
+			expected = entry.TotalXP // Carry on from what was recorded, so one gap is reported once
+		}
+		audit.ExpectedXP = expected
+		if audit.StoredXP != expected {
+			audit.Discrepancies = append(audit.Discrepancies, xpGap(time.Time{}, expected, audit.StoredXP, "stored character"))
+		}
+	}
+	audit.ExpectedLevel, _ = GetLevelFromXP(audit.ExpectedXP)
+
+	if character.XP < 0 || character.XPToNextLevel != CalculateXPForLevel(character.Level) ||
+		(character.Level < maxLevel && character.XP >= character.XPToNextLevel) {
+		audit.Discrepancies = append(audit.Discrepancies, XPDiscrepancy{
+			Expected: audit.StoredXP,
+			Found:    audit.StoredXP,
+			Cause:    XPCauseManualEdit,
+			Detail: fmt.Sprintf("level %d with %d/%d XP doesn't add up (level %d needs %d XP)",
+				character.Level, character.XP, character.XPToNextLevel, character.Level, CalculateXPForLevel(character.Level)),
+		})
+	}
+	return audit
+}
+
+// xpGap describes XP that changed between two points without a ledger entry.
+func xpGap(at time.Time, expected, found int, where string) XPDiscrepancy {
+	cause := XPCauseMissedEvents
+	if found < expected {
+		cause = XPCauseManualEdit
+	}
+	return XPDiscrepancy{
+		At:       at,
+		Expected: expected,
+		Found:    found,
+		Cause:    cause,
+		Detail:   fmt.Sprintf("%s: expected %d total XP, found %d (%+d)", where, expected, found, found-expected),
+	}
+}
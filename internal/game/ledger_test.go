@@ -0,0 +1,131 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// ledgerCharacter returns a character holding total XP.
+func ledgerCharacter(total int) *Character {
+	c := &Character{Level: 1, XPToNextLevel: CalculateXPForLevel(1)}
+	c.SetTotalXP(total)
+	return c
+}
+
+// TestAuditXP tests which ledger gaps are reported and their causes.
+func TestAuditXP(t *testing.T) {
+	at := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	entry := func(amount int, source string, total int) LedgerEntry {
+		return LedgerEntry{At: at, Amount: amount, Source: source, TotalXP: total}
+	}
+
+	tests := []struct {
+		name       string
+		entries    []LedgerEntry
+		character  *Character
+		wantCauses []string
+		wantXP     int
+	}{
+		{
+			name:      "clean",
+			entries:   []LedgerEntry{entry(50, XPSourceCommit, 50), entry(30, XPSourceQuest, 80), entry(-20, XPSourceRespec, 60)},
+			character: ledgerCharacter(60),
+			wantXP:    60,
+		},
+		{
+			name:       "xp gained while the log was off",
+			entries:    []LedgerEntry{entry(50, XPSourceCommit, 50), entry(30, XPSourceCommit, 180)},
+			character:  ledgerCharacter(180),
+			wantCauses: []string{XPCauseMissedEvents},
+			wantXP:     180,
+		},
+		{
+			name:       "save edited down",
+			entries:    []LedgerEntry{entry(50, XPSourceCommit, 50)},
+			character:  ledgerCharacter(20),
+			wantCauses: []string{XPCauseManualEdit},
+			wantXP:     50,
+		},
+		{
+			name:       "save edited up",
+			entries:    []LedgerEntry{entry(50, XPSourceCommit, 50)},
+			character:  ledgerCharacter(500),
+			wantCauses: []string{XPCauseMissedEvents},
+			wantXP:     50,
+		},
+		{
+			name:       "level and xp don't add up",
+			entries:    []LedgerEntry{entry(50, XPSourceCommit, 50)},
+			character:  &Character{Level: 1, XP: 50, XPToNextLevel: 10},
+			wantCauses: []string{XPCauseManualEdit},
+			wantXP:     50,
+		},
+		{
+			name:      "earlier audit reconciled the gap",
+			entries:   []LedgerEntry{entry(50, XPSourceCommit, 50), entry(30, XPSourceCommit, 180), entry(0, XPSourceAudit, 180)},
+			character: ledgerCharacter(180),
+			wantXP:    180,
+		},
+		{
+			name:      "no ledger",
+			character: ledgerCharacter(75),
+			wantXP:    75,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit := AuditXP(tt.entries, tt.character)
+			if audit.ExpectedXP != tt.wantXP {
+				t.Errorf("ExpectedXP = %d, want %d", audit.ExpectedXP, tt.wantXP)
+			}
+			if len(audit.Discrepancies) != len(tt.wantCauses) {
+				t.Fatalf("Discrepancies = %+v, want causes %v", audit.Discrepancies, tt.wantCauses)
+			}
+			for i, cause := range tt.wantCauses {
+				if audit.Discrepancies[i].Cause != cause {
+					t.Errorf("Discrepancies[%d].Cause = %q, want %q", i, audit.Discrepancies[i].Cause, cause)
+				}
+			}
+			if audit.Clean() != (len(tt.wantCauses) == 0) {
+				t.Errorf("Clean() = %v", audit.Clean())
+			}
+		})
+	}
+}
+
+// TestSetTotalXP tests that TotalXP and SetTotalXP round-trip and grant
+// skill points for levels gained.
+func TestSetTotalXP(t *testing.T) {
+	c := &Character{Level: 1, XPToNextLevel: CalculateXPForLevel(1)}
+	total := GetTotalXPForLevel(3) + 7
+	c.SetTotalXP(total)
+
+	if c.Level != 3 || c.XP != 7 || c.XPToNextLevel != CalculateXPForLevel(3) {
+		t.Errorf("SetTotalXP(%d) = level %d, %d/%d XP, want level 3, 7 XP", total, c.Level, c.XP, c.XPToNextLevel)
+	}
+	if got := c.TotalXP(); got != total {
+		t.Errorf("TotalXP() = %d, want %d", got, total)
+	}
+	if c.SkillPoints != 2*StatPointsPerLevel {
+		t.Errorf("SkillPoints = %d, want %d", c.SkillPoints, 2*StatPointsPerLevel)
+	}
+}
+
+// TestLedgerFromEvents tests picking ledger entries out of decoded events.
+func TestLedgerFromEvents(t *testing.T) {
+	events := []Event{
+		NewLevelUpEvent("id", 1, 2),
+		NewXPChangedEvent(25, XPSourceQuest, 125),
+		{Type: EventXPChanged, Data: map[string]interface{}{"amount": float64(-5), "source": XPSourceRespec, "total_xp": float64(120)}},
+		{Type: EventXPChanged, Data: map[string]interface{}{"amount": "bad"}},
+	}
+
+	entries := LedgerFromEvents(events)
+	if len(entries) != 2 {
+		t.Fatalf("LedgerFromEvents() = %+v, want 2 entries", entries)
+	}
+	if entries[1].Amount != -5 || entries[1].TotalXP != 120 || entries[1].Source != XPSourceRespec {
+		t.Errorf("decoded entry = %+v", entries[1])
+	}
+}
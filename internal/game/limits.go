@@ -0,0 +1,179 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the celebration limits for players who want less
+// gamification: a daily cap on commit celebrations, quiet hours without
+// celebrations, and a weekly-summary-only mode. XP is earned either way;
+// only the fanfare around it is held back.
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// weeklySummaryDays is how many days a weekly summary covers.
+const weeklySummaryDays = 7
+
+// CelebrationLimits decides which celebrations (XP toasts, level-ups, quest
+// completions, records) are shown.
+type CelebrationLimits struct {
+	MaxCommits int         // Commit celebrations per day (0 = no limit)
+	QuietStart int         // Hour quiet hours start
+	QuietEnd   int         // Hour quiet hours end (equal to QuietStart = no quiet hours)
+	WeeklyOnly bool        // Only the weekly summary is shown
+	Clock      StreakClock // Home timezone the hours are in
+}
+
+// CelebrationLimitsFromConfig builds the celebration limits from settings.
+//
+// Parameters:
+//   - cfg: Application configuration (nil means no limits)
+//
+// Returns:
+//   - CelebrationLimits: The configured limits
+func CelebrationLimitsFromConfig(cfg *config.Config) CelebrationLimits {
+	limits := CelebrationLimits{Clock: StreakClockFromConfig(cfg)}
+	if cfg == nil {
+		return limits
+	}
+	limits.MaxCommits = cfg.Limits.MaxCelebratedCommits
+	limits.QuietStart = cfg.Limits.QuietHoursStart
+	limits.QuietEnd = cfg.Limits.QuietHoursEnd
+	limits.WeeklyOnly = cfg.Limits.WeeklySummaryOnly
+	return limits
+}
+
+// InQuietHours reports whether a time falls in quiet hours. Quiet hours
+// may run past midnight (e.g. 22 to 7).
+//
+// Parameters:
+//   - t: The time to check
+//
+// Returns:
+//   - bool: True during quiet hours
+func (l CelebrationLimits) InQuietHours(t time.Time) bool {
+	if l.QuietStart == l.QuietEnd {
+		return false
+	}
+	hour := t.In(l.Clock.location()).Hour()
+	if l.QuietStart < l.QuietEnd {
+		return hour >= l.QuietStart && hour < l.QuietEnd
+	}
+	return hour >= l.QuietStart || hour < l.QuietEnd
+}
+
+// Celebrate reports whether celebrations are shown at a given time: never
+// in weekly-summary-only mode or during quiet hours.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - bool: True if celebrations may be shown
+func (l CelebrationLimits) Celebrate(now time.Time) bool {
+	return !l.WeeklyOnly && !l.InQuietHours(now)
+}
+
+// CelebrateCommit reports whether a commit's XP gets a celebration, given
+// how many commits came before it today.
+//
+// Parameters:
+//   - earlierToday: Commits already made today
+//   - now: Current time
+//
+// Returns:
+//   - bool: True if the commit may be celebrated
+func (l CelebrationLimits) CelebrateCommit(earlierToday int, now time.Time) bool {
+	return l.Celebrate(now) && (l.MaxCommits <= 0 || earlierToday < l.MaxCommits)
+}
+
+// WeeklySummary is what the player did over the past week.
+type WeeklySummary struct {
+	Commits         int // Commits over the week
+	ActiveDays      int // Days with at least one commit
+	QuestsCompleted int // Quests completed over the week
+	Level           int // Level now
+	Streak          int // Current streak
+}
+
+// Message describes the week in a few lines.
+func (s WeeklySummary) Message() string {
+	return fmt.Sprintf("📅 YOUR WEEK\n%d commits on %d days, %d quests completed\nLevel %d, %d-day streak",
+		s.Commits, s.ActiveDays, s.QuestsCompleted, s.Level, s.Streak)
+}
+
+// TakeWeeklySummary summarizes the past seven days, once per calendar week
+// (weeks start on Monday). The week is recorded on the character, so the
+// summary isn't repeated until the next week.
+//
+// Parameters:
+//   - quests: All quests (for completions)
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - WeeklySummary: The past week
+//   - bool: False if this week's summary was already taken
+func (c *Character) TakeWeeklySummary(quests []*Quest, now time.Time, clock StreakClock) (WeeklySummary, bool) {
+	key := WeekKey(now, clock)
+	if c.LastWeeklySummary == key {
+		return WeeklySummary{}, false
+	}
+	c.LastWeeklySummary = key
+	return c.SummarizeWeek(quests, now, clock), true
+}
+
+// SummarizeWeek summarizes the seven days up to and including today.
+//
+// Parameters:
+//   - quests: All quests (for completions)
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - WeeklySummary: The past week
+func (c *Character) SummarizeWeek(quests []*Quest, now time.Time, clock StreakClock) WeeklySummary {
+	summary := WeeklySummary{Level: c.Level, Streak: c.CurrentStreak}
+	today := clock.Day(now)
+	for i := 0; i < weeklySummaryDays; i++ {
+		if commits := c.CommitsOnDay(today.AddDate(0, 0, -i)); commits > 0 {
+			summary.Commits += commits
+			summary.ActiveDays++
+		}
+	}
+	since := WeekStart(now, clock)
+	for _, quest := range quests {
+		if quest.Status == QuestCompleted && quest.CompletedAt != nil && !quest.CompletedAt.Before(since) {
+			summary.QuestsCompleted++
+		}
+	}
+	return summary
+}
+
+// WeekStart returns the first streak day a weekly summary taken now covers
+// (six days before today).
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - time.Time: The earliest day of the past week (midnight UTC, as Day)
+func WeekStart(now time.Time, clock StreakClock) time.Time {
+	return clock.Day(now).AddDate(0, 0, 1-weeklySummaryDays)
+}
+
+// WeekKey identifies the ISO calendar week of the streak day now falls on
+// (e.g. "2025-W10"), so weekly things happen once per week.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - string: The week key
+func WeekKey(now time.Time, clock StreakClock) string {
+	year, week := clock.Day(now).ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
@@ -0,0 +1,84 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestCelebrationLimits tests quiet hours (including past midnight), the
+// daily commit celebration cap and weekly-summary-only mode.
+func TestCelebrationLimits(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2025, 3, 10, hour, 30, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name         string
+		limits       config.LimitsConfig
+		now          time.Time
+		earlierToday int
+		wantQuiet    bool
+		wantCommit   bool
+	}{
+		{name: "no limits", now: at(23), earlierToday: 50, wantCommit: true},
+		{name: "daytime quiet hours", limits: config.LimitsConfig{QuietHoursStart: 12, QuietHoursEnd: 14}, now: at(13), wantQuiet: true},
+		{name: "after daytime quiet hours", limits: config.LimitsConfig{QuietHoursStart: 12, QuietHoursEnd: 14}, now: at(14), wantCommit: true},
+		{name: "overnight quiet hours before midnight", limits: config.LimitsConfig{QuietHoursStart: 22, QuietHoursEnd: 7}, now: at(23), wantQuiet: true},
+		{name: "overnight quiet hours after midnight", limits: config.LimitsConfig{QuietHoursStart: 22, QuietHoursEnd: 7}, now: at(6), wantQuiet: true},
+		{name: "overnight quiet hours over", limits: config.LimitsConfig{QuietHoursStart: 22, QuietHoursEnd: 7}, now: at(9), wantCommit: true},
+		{name: "under the commit cap", limits: config.LimitsConfig{MaxCelebratedCommits: 3}, now: at(10), earlierToday: 2, wantCommit: true},
+		{name: "at the commit cap", limits: config.LimitsConfig{MaxCelebratedCommits: 3}, now: at(10), earlierToday: 3},
+		{name: "weekly summary only", limits: config.LimitsConfig{WeeklySummaryOnly: true}, now: at(10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.Timezone = "UTC"
+			cfg.Limits = tt.limits
+			limits := CelebrationLimitsFromConfig(cfg)
+
+			if got := limits.InQuietHours(tt.now); got != tt.wantQuiet {
+				t.Errorf("InQuietHours() = %v, want %v", got, tt.wantQuiet)
+			}
+			if got := limits.CelebrateCommit(tt.earlierToday, tt.now); got != tt.wantCommit {
+				t.Errorf("CelebrateCommit(%d) = %v, want %v", tt.earlierToday, got, tt.wantCommit)
+			}
+		})
+	}
+}
+
+// TestTakeWeeklySummary tests that the summary covers the past seven days
+// and is only taken once per week.
+func TestTakeWeeklySummary(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC) // Wednesday
+	character := NewCharacter("Tester")
+	character.ActivityDays = map[string]int{
+		"2025-03-12": 2,
+		"2025-03-08": 3,
+		"2025-03-01": 9, // More than a week ago
+	}
+	recent := now.AddDate(0, 0, -2)
+	old := now.AddDate(0, 0, -10)
+	quests := []*Quest{
+		{Status: QuestCompleted, CompletedAt: &recent},
+		{Status: QuestCompleted, CompletedAt: &old},
+		{Status: QuestActive},
+	}
+
+	summary, ok := character.TakeWeeklySummary(quests, now, clock)
+	if !ok {
+		t.Fatal("TakeWeeklySummary() should be due the first time")
+	}
+	if summary.Commits != 5 || summary.ActiveDays != 2 || summary.QuestsCompleted != 1 {
+		t.Errorf("summary = %+v, want 5 commits on 2 days and 1 quest", summary)
+	}
+
+	if _, ok := character.TakeWeeklySummary(quests, now.AddDate(0, 0, 3), clock); ok {
+		t.Error("TakeWeeklySummary() should not repeat within the same week")
+	}
+	if _, ok := character.TakeWeeklySummary(quests, now.AddDate(0, 0, 5), clock); !ok {
+		t.Error("TakeWeeklySummary() should be due again the next week")
+	}
+}
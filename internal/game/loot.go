@@ -0,0 +1,187 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements loot drops: each qualifying commit has a small
+// chance to drop an item (an XP scroll, a streak freeze or a cosmetic),
+// drawn from a weighted loot table. A pity timer guarantees a drop after
+// LootPityCommits commits without one, and recent drops are kept as a history. Rolls
+// come from the commit SHA, so a commit always rolls the same way.
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// LootDropChance is the chance a qualifying commit drops loot
+	LootDropChance = 0.04
+
+	// LootPityCommits is the most qualifying commits in a row without a
+	// drop; the next one always drops
+	LootPityCommits = 40
+
+	// LootHistoryLimit is how many drops the history keeps
+	LootHistoryLimit = 50
+
+	// XPScrollDuration is how long reading an XP scroll boosts commit XP
+	// (by XPBoostPercent, like the quest reward boost)
+	XPScrollDuration = 2 * time.Hour
+)
+
+// LootKind is what a dropped item does.
+type LootKind string
+
+const (
+	LootXPScroll     LootKind = "xp_scroll"     // Held until read for a commit XP boost
+	LootStreakFreeze LootKind = "streak_freeze" // Covers one missed day
+	LootCosmetic     LootKind = "cosmetic"      // Collectible, joins the cosmetics collection
+)
+
+// LootRarity is how rare a dropped item is.
+type LootRarity string
+
+const (
+	LootCommon LootRarity = "common"
+	LootRare   LootRarity = "rare"
+	LootEpic   LootRarity = "epic"
+)
+
+// LootEntry is one item on the loot table.
+type LootEntry struct {
+	Kind   LootKind   // What the item does
+	Name   string     // Display name
+	Rarity LootRarity // How rare it is (for the drop announcement)
+	Weight int        // Relative chance among the table's entries
+}
+
+// LootTable is what a drop is drawn from. Streak freezes are left out while
+// the maximum is held, and cosmetics once collected.
+var LootTable = []LootEntry{
+	{Kind: LootXPScroll, Name: "XP Scroll", Rarity: LootCommon, Weight: 50},
+	{Kind: LootStreakFreeze, Name: "Streak Freeze", Rarity: LootRare, Weight: 25},
+	{Kind: LootCosmetic, Name: "Rubber Duck", Rarity: LootRare, Weight: 10},
+	{Kind: LootCosmetic, Name: "Mug of Endless Coffee", Rarity: LootRare, Weight: 7},
+	{Kind: LootCosmetic, Name: "Glowing Semicolon", Rarity: LootEpic, Weight: 5},
+	{Kind: LootCosmetic, Name: "Pocket Debugger", Rarity: LootEpic, Weight: 3},
+}
+
+// LootDrop is an item a commit dropped.
+type LootDrop struct {
+	Kind      LootKind   `json:"kind"`           // What the item does
+	Name      string     `json:"name"`           // Item name
+	Rarity    LootRarity `json:"rarity"`         // How rare it is
+	SHA       string     `json:"sha"`            // Commit that dropped it
+	Pity      bool       `json:"pity,omitempty"` // Guaranteed by the pity timer
+	DroppedAt time.Time  `json:"dropped_at"`     // When it dropped
+}
+
+// RollLoot rolls for loot on a qualifying commit and, on a drop, gives the
+// item to the character and records it. Callers decide what qualifies (the
+// commit handler skips bots, replays and commits without XP).
+//
+// Parameters:
+//   - sha: The commit's SHA (the roll's source)
+//   - now: When the commit was handled
+//
+// Returns:
+//   - LootDrop: The item dropped
+//   - bool: Whether anything dropped
+func (c *Character) RollLoot(sha string, now time.Time) (LootDrop, bool) {
+	c.LootPity++
+	pity := c.LootPity > LootPityCommits
+	if !pity && lootRoll(sha, "drop") >= LootDropChance {
+		return LootDrop{}, false
+	}
+
+	candidates := c.lootCandidates()
+	if len(candidates) == 0 {
+		return LootDrop{}, false
+	}
+	entry := pickLoot(candidates, lootRoll(sha, "item"))
+
+	switch entry.Kind {
+	case LootXPScroll:
+		c.XPScrolls++
+	case LootStreakFreeze:
+		c.StreakFreezes++
+	case LootCosmetic:
+		c.Cosmetics = append(c.Cosmetics, entry.Name)
+	}
+
+	drop := LootDrop{Kind: entry.Kind, Name: entry.Name, Rarity: entry.Rarity, SHA: sha, Pity: pity, DroppedAt: now}
+	c.LootDrops = append(c.LootDrops, drop)
+	if extra := len(c.LootDrops) - LootHistoryLimit; extra > 0 {
+		c.LootDrops = append([]LootDrop(nil), c.LootDrops[extra:]...)
+	}
+	c.LootPity = 0
+	return drop, true
+}
+
+// CommitsUntilPityDrop returns how many qualifying commits at most until
+// the pity timer guarantees a drop (the one after LootPityCommits misses).
+func (c *Character) CommitsUntilPityDrop() int {
+	return max(LootPityCommits+1-c.LootPity, 1)
+}
+
+// UseXPScroll reads a held XP scroll, boosting commit XP by XPBoostPercent
+// for XPScrollDuration (added on to a boost that's already running).
+//
+// Parameters:
+//   - now: When the scroll is read
+//
+// Returns:
+//   - error: An error if no scroll is held
+func (c *Character) UseXPScroll(now time.Time) error {
+	if c.XPScrolls <= 0 {
+		return fmt.Errorf("no XP scrolls held")
+	}
+	c.XPScrolls--
+
+	// Scrolls stack by extending the current boost
+	start := now
+	if c.XPBoostUntil.After(now) {
+		start = c.XPBoostUntil
+	}
+	c.XPBoostUntil = start.Add(XPScrollDuration)
+	return nil
+}
+
+// lootCandidates returns the loot table entries the character can receive.
+func (c *Character) lootCandidates() []LootEntry {
+	candidates := make([]LootEntry, 0, len(LootTable))
+	for _, entry := range LootTable {
+		switch {
+		case entry.Kind == LootStreakFreeze && c.StreakFreezes >= MaxStreakFreezes:
+		case entry.Kind == LootCosmetic && slices.Contains(c.Cosmetics, entry.Name):
+		default:
+			candidates = append(candidates, entry)
+		}
+	}
+	return candidates
+}
+
+// pickLoot draws an entry by weight, with roll in [0, 1).
+func pickLoot(entries []LootEntry, roll float64) LootEntry {
+	total := 0
+	for _, entry := range entries {
+		total += entry.Weight
+	}
+	target := int(roll * float64(total))
+	for _, entry := range entries {
+		if target < entry.Weight {
+			return entry
+		}
+		target -= entry.Weight
+	}
+	return entries[len(entries)-1]
+}
+
+// lootRoll turns a commit SHA into a roll in [0, 1). Each purpose rolls
+// independently.
+func lootRoll(sha, purpose string) float64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(purpose + ":" + sha))
+	return float64(hash.Sum64()>>11) / float64(math.MaxUint64>>11+1)
+}
@@ -0,0 +1,220 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// findSHA returns the first made-up SHA whose drop roll is (or isn't) a hit.
+func findSHA(t *testing.T, hit bool) string {
+	t.Helper()
+	for i := 0; i < 10000; i++ {
+		sha := fmt.Sprintf("%040x", i)
+		if (lootRoll(sha, "drop") < LootDropChance) == hit {
+			return sha
+		}
+	}
+	t.Fatal("no SHA found")
+	return ""
+}
+
+// TestRollLoot tests drops, the pity timer and what each drop gives.
+func TestRollLoot(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	miss, hit := findSHA(t, false), findSHA(t, true)
+
+	tests := []struct {
+		name      string
+		setup     func(c *Character)
+		sha       string
+		wantDrop  bool
+		wantPity  bool
+		wantCount int // LootPity afterwards
+		check     func(t *testing.T, c *Character, drop LootDrop)
+	}{
+		{
+			name:      "miss counts toward pity",
+			sha:       miss,
+			wantCount: 1,
+		},
+		{
+			name:     "hit drops",
+			sha:      hit,
+			wantDrop: true,
+		},
+		{
+			name:      "last commit before the pity timer still rolls",
+			setup:     func(c *Character) { c.LootPity = LootPityCommits - 1 },
+			sha:       miss,
+			wantCount: LootPityCommits,
+		},
+		{
+			name:     "pity timer guarantees a drop",
+			setup:    func(c *Character) { c.LootPity = LootPityCommits },
+			sha:      miss,
+			wantDrop: true,
+			wantPity: true,
+		},
+		{
+			name: "held freezes and owned cosmetics leave only scrolls",
+			setup: func(c *Character) {
+				c.StreakFreezes = MaxStreakFreezes
+				for _, entry := range LootTable {
+					if entry.Kind == LootCosmetic {
+						c.Cosmetics = append(c.Cosmetics, entry.Name)
+					}
+				}
+			},
+			sha:      hit,
+			wantDrop: true,
+			check: func(t *testing.T, c *Character, drop LootDrop) {
+				if drop.Kind != LootXPScroll || c.XPScrolls != 1 {
+					t.Errorf("drop = %s, scrolls = %d, want one XP scroll", drop.Kind, c.XPScrolls)
+				}
+				if c.StreakFreezes != MaxStreakFreezes {
+					t.Errorf("StreakFreezes = %d, want %d", c.StreakFreezes, MaxStreakFreezes)
+				}
+			},
+		},
+		{
+			name: "history is capped",
+			setup: func(c *Character) {
+				c.LootDrops = make([]LootDrop, LootHistoryLimit)
+			},
+			sha:      hit,
+			wantDrop: true,
+			check: func(t *testing.T, c *Character, drop LootDrop) {
+				if len(c.LootDrops) != LootHistoryLimit || c.LootDrops[LootHistoryLimit-1] != drop {
+					t.Errorf("history has %d drops ending %+v, want %d ending with the new drop",
+						len(c.LootDrops), c.LootDrops[len(c.LootDrops)-1], LootHistoryLimit)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Looter")
+			if tt.setup != nil {
+				tt.setup(c)
+			}
+			drop, dropped := c.RollLoot(tt.sha, now)
+
+			if dropped != tt.wantDrop {
+				t.Fatalf("dropped = %v, want %v", dropped, tt.wantDrop)
+			}
+			if c.LootPity != tt.wantCount {
+				t.Errorf("LootPity = %d, want %d", c.LootPity, tt.wantCount)
+			}
+			if !dropped {
+				return
+			}
+			if drop.Pity != tt.wantPity || drop.SHA != tt.sha || !drop.DroppedAt.Equal(now) {
+				t.Errorf("drop = %+v, want pity %v from %s at %v", drop, tt.wantPity, tt.sha, now)
+			}
+			if last := c.LootDrops[len(c.LootDrops)-1]; last != drop {
+				t.Errorf("last recorded drop = %+v, want %+v", last, drop)
+			}
+
+			// The item is given to the character
+			switch drop.Kind {
+			case LootXPScroll:
+				if c.XPScrolls == 0 {
+					t.Error("XP scroll not held")
+				}
+			case LootStreakFreeze:
+				if c.StreakFreezes == 0 {
+					t.Error("streak freeze not held")
+				}
+			case LootCosmetic:
+				if !slices.Contains(c.Cosmetics, drop.Name) {
+					t.Errorf("%s not in cosmetics %v", drop.Name, c.Cosmetics)
+				}
+			}
+			if tt.check != nil {
+				tt.check(t, c, drop)
+			}
+		})
+	}
+}
+
+// TestLootDropRate tests that about LootDropChance of commits drop loot.
+func TestLootDropRate(t *testing.T) {
+	const commits = 20000
+	drops := 0
+	for i := 0; i < commits; i++ {
+		if lootRoll(fmt.Sprintf("%040x", i*7919), "drop") < LootDropChance {
+			drops++
+		}
+	}
+	rate := float64(drops) / commits
+	if rate < LootDropChance*0.8 || rate > LootDropChance*1.2 {
+		t.Errorf("drop rate = %.3f, want about %.3f", rate, LootDropChance)
+	}
+}
+
+// TestPickLoot tests drawing loot table entries by weight.
+func TestPickLoot(t *testing.T) {
+	entries := []LootEntry{
+		{Name: "common", Weight: 3},
+		{Name: "rare", Weight: 1},
+	}
+	tests := []struct {
+		roll float64
+		want string
+	}{
+		{roll: 0, want: "common"},
+		{roll: 0.74, want: "common"},
+		{roll: 0.75, want: "rare"},
+		{roll: 0.999, want: "rare"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%.3f", tt.roll), func(t *testing.T) {
+			if got := pickLoot(entries, tt.roll); got.Name != tt.want {
+				t.Errorf("pickLoot(%.3f) = %s, want %s", tt.roll, got.Name, tt.want)
+			}
+		})
+	}
+}
+
+// TestUseXPScroll tests reading XP scrolls into commit XP boosts.
+func TestUseXPScroll(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		scrolls   int
+		boost     time.Time
+		wantErr   bool
+		wantUntil time.Time
+	}{
+		{name: "no scrolls", wantErr: true},
+		{name: "starts a boost", scrolls: 1, wantUntil: now.Add(XPScrollDuration)},
+		{name: "expired boost starts over", scrolls: 1, boost: now.Add(-time.Hour), wantUntil: now.Add(XPScrollDuration)},
+		{name: "extends a running boost", scrolls: 2, boost: now.Add(time.Hour), wantUntil: now.Add(time.Hour + XPScrollDuration)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Reader")
+			c.XPScrolls = tt.scrolls
+			c.XPBoostUntil = tt.boost
+
+			err := c.UseXPScroll(now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UseXPScroll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if c.XPScrolls != tt.scrolls-1 {
+				t.Errorf("XPScrolls = %d, want %d", c.XPScrolls, tt.scrolls-1)
+			}
+			if !c.XPBoostUntil.Equal(tt.wantUntil) {
+				t.Errorf("XPBoostUntil = %v, want %v", c.XPBoostUntil, tt.wantUntil)
+			}
+		})
+	}
+}
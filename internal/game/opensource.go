@@ -0,0 +1,43 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the Open Source track: commits to repositories owned
+// by someone other than the player (see git.own_accounts) are open source
+// contributions, counted apart from the player's own work toward their own
+// achievements and quests.
+package game
+
+import "slices"
+
+// OpenSourceTiers is the achievement track for lifetime open source
+// contributions, in unlock order.
+var OpenSourceTiers = []AchievementTier{
+	{ID: "open-source-1", Name: "First Contribution", Threshold: 1},
+	{ID: "open-source-2", Name: "Open Source Regular", Threshold: 10},
+	{ID: "open-source-3", Name: "Open Source Champion", Threshold: 50},
+}
+
+// RecordOpenSourceCommit counts commits to an upstream project toward the
+// Open Source track.
+//
+// Parameters:
+//   - upstream: The project contributed to ("owner/name"); "" for the player's own repositories
+//   - commits: Commits the event covers
+//
+// Returns:
+//   - []AchievementTier: Open Source tiers newly unlocked
+func (c *Character) RecordOpenSourceCommit(upstream string, commits int) []AchievementTier {
+	if upstream == "" || commits <= 0 {
+		return nil
+	}
+	c.OpenSourceCommits += commits
+	if !slices.Contains(c.OpenSourceProjects, upstream) {
+		c.OpenSourceProjects = append(c.OpenSourceProjects, upstream)
+	}
+
+	var unlocked []AchievementTier
+	for _, tier := range OpenSourceTiers {
+		if c.OpenSourceCommits >= tier.Threshold && c.UnlockAchievement(tier.ID) {
+			unlocked = append(unlocked, tier)
+		}
+	}
+	return unlocked
+}
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestRecordOpenSourceCommit tests counting contributions and unlocking
+// Open Source tiers once.
+func TestRecordOpenSourceCommit(t *testing.T) {
+	tests := []struct {
+		name         string
+		upstream     string
+		commits      int
+		wantCommits  int
+		wantProjects []string
+		wantUnlocked []string
+	}{
+		{name: "own repository", upstream: "", commits: 1, wantCommits: 9, wantProjects: []string{"charmbracelet/bubbletea"}},
+		{name: "same project", upstream: "charmbracelet/bubbletea", commits: 1, wantCommits: 10,
+			wantProjects: []string{"charmbracelet/bubbletea"}, wantUnlocked: []string{"open-source-2"}},
+		{name: "new project", upstream: "golang/go", commits: 2, wantCommits: 11,
+			wantProjects: []string{"charmbracelet/bubbletea", "golang/go"}, wantUnlocked: []string{"open-source-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := NewCharacter("Contributor")
+			character.RecordOpenSourceCommit("charmbracelet/bubbletea", 9)
+
+			var unlocked []string
+			for _, tier := range character.RecordOpenSourceCommit(tt.upstream, tt.commits) {
+				unlocked = append(unlocked, tier.ID)
+			}
+			if fmt.Sprint(unlocked) != fmt.Sprint(tt.wantUnlocked) {
+				t.Errorf("unlocked %v, want %v", unlocked, tt.wantUnlocked)
+			}
+			if character.OpenSourceCommits != tt.wantCommits {
+				t.Errorf("OpenSourceCommits = %d, want %d", character.OpenSourceCommits, tt.wantCommits)
+			}
+			if fmt.Sprint(character.OpenSourceProjects) != fmt.Sprint(tt.wantProjects) {
+				t.Errorf("OpenSourceProjects = %v, want %v", character.OpenSourceProjects, tt.wantProjects)
+			}
+		})
+	}
+}
+
+// TestOpenSourceQuestsFromCommits tests that only commits to other people's
+// repositories progress open source quests and the Open Source track.
+func TestOpenSourceQuestsFromCommits(t *testing.T) {
+	character := NewCharacter("Contributor")
+	quests, _ := SeedQuestTemplates([]*Quest{})
+	var patch *Quest
+	for _, quest := range quests {
+		if quest.Template == "open-source-patch" {
+			patch = quest
+			if err := quest.Start("", ""); err != nil {
+				t.Fatalf("Start(%s) error = %v", quest.Template, err)
+			}
+		}
+	}
+
+	bus := NewEventBus()
+	var achievements []string
+	bus.Subscribe(EventAchievement, func(e Event) {
+		achievements = append(achievements, e.Data["achievement_name"].(string))
+	})
+	handler, err := NewGameEventHandler(character, quests, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	bus.Publish(NewCommitEvent("0123456789abcdef", "Work on my own app", 10, 0, 1))
+	if patch.Current != 0 || character.OpenSourceCommits != 0 {
+		t.Fatalf("own commit: quest at %d, %d open source commits; want neither counted", patch.Current, character.OpenSourceCommits)
+	}
+
+	event := NewCommitEvent("fedcba9876543210", "Fix typo in README", 1, 1, 1)
+	event.Data["upstream"] = "golang/go"
+	bus.Publish(event)
+	if patch.Status != QuestCompleted {
+		t.Errorf("open source quest = %d/%d (%s), want completed", patch.Current, patch.Target, patch.Status)
+	}
+	if character.OpenSourceCommits != 1 || fmt.Sprint(achievements) != "[First Contribution]" {
+		t.Errorf("OpenSourceCommits = %d, achievements = %v; want 1 and First Contribution", character.OpenSourceCommits, achievements)
+	}
+}
@@ -0,0 +1,283 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the companion pet: it grows through stages with
+// streak consistency (one growth per active day, more on long streaks) and
+// quest variety (completing different quest types), eats trophy items as
+// treats, and reverts a stage when neglected for several days.
+package game
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Pet tuning
+const (
+	// petNeglectDays is how many days without activity or feeding cost the
+	// pet a stage (per full period)
+	petNeglectDays = 3
+
+	// petStreakBonusDays is the streak length from which each active day
+	// gives double growth
+	petStreakBonusDays = 7
+
+	// petTreatGrowth is the growth a fed item gives
+	petTreatGrowth = 3
+)
+
+// PetStage is a growth stage of the companion pet.
+type PetStage int
+
+// Pet growth stages, in order
+const (
+	PetEgg PetStage = iota
+	PetHatchling
+	PetJuvenile
+	PetAdult
+	PetElder
+)
+
+// petStage describes what a stage needs and how it looks.
+type petStage struct {
+	name    string   // Display name
+	growth  int      // Growth needed to reach the stage
+	variety int      // Distinct quest types completed needed to reach the stage
+	art     []string // ASCII art, all stages the same height
+}
+
+// petStages lists every stage in order (indexed by PetStage).
+var petStages = []petStage{
+	{name: "Egg", art: []string{
+		`  .-"-.  `,
+		` /     \ `,
+		`|  . .  |`,
+		` '-._.-' `,
+	}},
+	{name: "Hatchling", growth: 3, variety: 1, art: []string{
+		`  (o.o)  `,
+		` /\_^_/\ `,
+		`|  \_/  |`,
+		` '-._.-' `,
+	}},
+	{name: "Juvenile", growth: 10, variety: 2, art: []string{
+		`  /\_/\  `,
+		` ( o.o ) `,
+		`  > ^ <  `,
+		` (_____) `,
+	}},
+	{name: "Adult", growth: 25, variety: 3, art: []string{
+		`  /\_/\ ~`,
+		` ( ^.^ )/`,
+		` /  _  \ `,
+		`(__/ \__)`,
+	}},
+	{name: "Elder", growth: 50, variety: 4, art: []string{
+		`  _www_  `,
+		` ( -.- ) `,
+		` /  ~  \ `,
+		`(__/ \__)`,
+	}},
+}
+
+// Pet is the character's companion. It starts as an egg.
+type Pet struct {
+	Stage      PetStage  `json:"stage"`                 // Current growth stage
+	Growth     int       `json:"growth"`                // Growth points earned (lowered when neglect reverts a stage)
+	QuestTypes []string  `json:"quest_types,omitempty"` // Distinct quest types completed (variety)
+	LastCared  time.Time `json:"last_cared,omitempty"`  // Last activity or feeding (neglect is counted from here)
+	GrowthDay  string    `json:"growth_day,omitempty"`  // Streak day (YYYY-MM-DD) of the last daily growth
+	FedDay     string    `json:"fed_day,omitempty"`     // Streak day (YYYY-MM-DD) of the last feeding
+}
+
+// PetProgress is how far the pet is from its next stage.
+type PetProgress struct {
+	Next          PetStage // The next stage
+	Growth        int      // Growth earned
+	GrowthNeeded  int      // Growth the next stage needs
+	Variety       int      // Distinct quest types completed
+	VarietyNeeded int      // Quest types the next stage needs
+}
+
+// PetStageName returns a stage's display name.
+func PetStageName(stage PetStage) string {
+	if stage < PetEgg || int(stage) >= len(petStages) {
+		return "Unknown"
+	}
+	return petStages[stage].name
+}
+
+// PetArt returns a stage's ASCII art, one string per line.
+func PetArt(stage PetStage) []string {
+	if stage < PetEgg || int(stage) >= len(petStages) {
+		stage = PetEgg
+	}
+	return petStages[stage].art
+}
+
+// Progress reports what the pet needs to reach its next stage.
+//
+// Returns:
+//   - PetProgress: Growth and variety toward the next stage
+//   - bool: False if the pet is fully grown
+func (p Pet) Progress() (PetProgress, bool) {
+	next := p.Stage + 1
+	if int(next) >= len(petStages) {
+		return PetProgress{}, false
+	}
+	return PetProgress{
+		Next:          next,
+		Growth:        p.Growth,
+		GrowthNeeded:  petStages[next].growth,
+		Variety:       len(p.QuestTypes),
+		VarietyNeeded: petStages[next].variety,
+	}, true
+}
+
+// Mood describes how cared-for the pet feels: "happy" when looked after
+// today, "content" for a day after, then "hungry" until neglect sets in.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - string: The pet's mood
+func (p Pet) Mood(now time.Time, clock StreakClock) string {
+	switch days := p.daysSinceCared(now, clock); {
+	case days <= 0:
+		return "happy"
+	case days == 1:
+		return "content"
+	default:
+		return "hungry"
+	}
+}
+
+// daysSinceCared counts streak days since the pet was last cared for.
+func (p Pet) daysSinceCared(now time.Time, clock StreakClock) int {
+	if p.LastCared.IsZero() {
+		return 0
+	}
+	return int(clock.Day(now).Sub(clock.Day(p.LastCared)).Hours() / 24)
+}
+
+// grow advances the pet through every stage its growth and variety allow.
+func (p *Pet) grow() bool {
+	grew := false
+	for {
+		progress, ok := p.Progress()
+		if !ok || progress.Growth < progress.GrowthNeeded || progress.Variety < progress.VarietyNeeded {
+			return grew
+		}
+		p.Stage = progress.Next
+		grew = true
+	}
+}
+
+// CheckPetNeglect reverts the pet one stage for every full neglect period
+// (3 days) since it was last cared for. Its growth drops to the reverted
+// stage's threshold, so regrowing takes consistent activity again.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - int: Stages lost (0 if the pet was cared for recently)
+func (c *Character) CheckPetNeglect(now time.Time, clock StreakClock) int {
+	pet := &c.Pet
+	if pet.LastCared.IsZero() {
+		pet.LastCared = now
+		return 0
+	}
+
+	periods := pet.daysSinceCared(now, clock) / petNeglectDays
+	if periods <= 0 {
+		return 0
+	}
+	lost := min(periods, int(pet.Stage))
+	pet.Stage -= PetStage(lost)
+	pet.Growth = min(pet.Growth, petStages[pet.Stage].growth)
+	pet.LastCared = now
+	return lost
+}
+
+// NurturePet grows the pet for a day of activity: one growth per streak
+// day (later than the last one it grew on), two once the streak is a week
+// long. Neglect is applied first, so coming back after a long break still
+// costs a stage.
+//
+// Parameters:
+//   - at: When the activity happened
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - bool: True if the pet reached a new stage
+func (c *Character) NurturePet(at time.Time, clock StreakClock) bool {
+	c.CheckPetNeglect(at, clock)
+	pet := &c.Pet
+	if at.After(pet.LastCared) {
+		pet.LastCared = at
+	}
+
+	// Days sort as text; replayed activity from earlier days doesn't grow it
+	day := clock.Day(at).Format("2006-01-02")
+	if day <= pet.GrowthDay {
+		return false
+	}
+	pet.GrowthDay = day
+	pet.Growth++
+	if c.CurrentStreak >= petStreakBonusDays {
+		pet.Growth++
+	}
+	return pet.grow()
+}
+
+// RecordPetQuest counts a completed quest toward the pet's variety: a quest
+// type it hasn't seen before gives two growth, a repeat gives one.
+//
+// Parameters:
+//   - questType: Type of the completed quest
+//
+// Returns:
+//   - bool: True if the pet reached a new stage
+func (c *Character) RecordPetQuest(questType QuestType) bool {
+	pet := &c.Pet
+	if slices.Contains(pet.QuestTypes, string(questType)) {
+		pet.Growth++
+	} else {
+		pet.QuestTypes = append(pet.QuestTypes, string(questType))
+		pet.Growth += 2
+	}
+	return pet.grow()
+}
+
+// FeedPet feeds the pet the most recently collected trophy item, once per
+// day. The item is used up.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - Item: The item eaten
+//   - bool: True if the pet reached a new stage
+//   - error: An error if there's nothing to feed or it already ate today
+func (c *Character) FeedPet(now time.Time, clock StreakClock) (Item, bool, error) {
+	if len(c.Items) == 0 {
+		return Item{}, false, fmt.Errorf("no items to feed - complete bigger quests to earn trophies")
+	}
+	day := clock.Day(now).Format("2006-01-02")
+	if c.Pet.FedDay == day {
+		return Item{}, false, fmt.Errorf("already fed today")
+	}
+
+	c.CheckPetNeglect(now, clock)
+	item := c.Items[len(c.Items)-1]
+	c.Items = c.Items[:len(c.Items)-1]
+
+	c.Pet.FedDay = day
+	c.Pet.LastCared = now
+	c.Pet.Growth += petTreatGrowth
+	return item, c.Pet.grow(), nil
+}
@@ -0,0 +1,108 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestNurturePet tests daily growth: once per streak day, doubled on long
+// streaks, with quest variety gating the next stage.
+func TestNurturePet(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	day := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		streak     int
+		days       int
+		questTypes []QuestType
+		wantGrowth int
+		wantStage  PetStage
+	}{
+		{"two commits one day", 1, 1, nil, 1, PetEgg},
+		{"short streak", 1, 3, nil, 3, PetEgg},
+		{"hatches with a quest", 1, 3, []QuestType{QuestTypeCommit}, 5, PetHatchling},
+		{"long streak doubles", 7, 3, nil, 6, PetEgg},
+		{"variety gates juvenile", 7, 5, []QuestType{QuestTypeCommit, QuestTypeCommit}, 13, PetHatchling},
+		{"variety unlocks juvenile", 7, 5, []QuestType{QuestTypeCommit, QuestTypeLines}, 14, PetJuvenile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.CurrentStreak = tt.streak
+			for i := 0; i < tt.days; i++ {
+				c.NurturePet(day.AddDate(0, 0, i), clock)
+			}
+			c.NurturePet(day.Add(time.Hour), clock) // same day again: no extra growth
+			for _, questType := range tt.questTypes {
+				c.RecordPetQuest(questType)
+			}
+
+			if c.Pet.Growth != tt.wantGrowth || c.Pet.Stage != tt.wantStage {
+				t.Errorf("pet = growth %d, %s; want growth %d, %s",
+					c.Pet.Growth, PetStageName(c.Pet.Stage), tt.wantGrowth, PetStageName(tt.wantStage))
+			}
+		})
+	}
+}
+
+// TestCheckPetNeglect tests that each full neglect period reverts a stage.
+func TestCheckPetNeglect(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	cared := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		stage     PetStage
+		away      int
+		wantLost  int
+		wantStage PetStage
+	}{
+		{"two days away", PetAdult, 2, 0, PetAdult},
+		{"three days away", PetAdult, 3, 1, PetJuvenile},
+		{"seven days away", PetAdult, 7, 2, PetHatchling},
+		{"eggs can't shrink", PetEgg, 9, 0, PetEgg},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.Pet = Pet{Stage: tt.stage, Growth: 40, LastCared: cared}
+
+			lost := c.CheckPetNeglect(cared.AddDate(0, 0, tt.away), clock)
+			if lost != tt.wantLost || c.Pet.Stage != tt.wantStage {
+				t.Errorf("CheckPetNeglect() = %d, stage %s; want %d, %s", lost, PetStageName(c.Pet.Stage), tt.wantLost, PetStageName(tt.wantStage))
+			}
+			if lost > 0 && c.Pet.Growth != petStages[tt.wantStage].growth {
+				t.Errorf("Growth = %d, want the reverted stage's threshold %d", c.Pet.Growth, petStages[tt.wantStage].growth)
+			}
+		})
+	}
+}
+
+// TestFeedPet tests feeding trophy items, once per day.
+func TestFeedPet(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	if _, _, err := c.FeedPet(now, clock); err == nil {
+		t.Error("FeedPet() without items should fail")
+	}
+
+	c.Items = []Item{{Name: "Golden Keyboard"}, {Name: "Rubber Duck"}}
+	item, _, err := c.FeedPet(now, clock)
+	if err != nil || item.Name != "Rubber Duck" {
+		t.Fatalf("FeedPet() = %q, %v; want the newest item", item.Name, err)
+	}
+	if len(c.Items) != 1 || c.Pet.Growth != petTreatGrowth {
+		t.Errorf("items = %d, growth = %d; want the item used up for %d growth", len(c.Items), c.Pet.Growth, petTreatGrowth)
+	}
+	if _, _, err := c.FeedPet(now.Add(time.Hour), clock); err == nil {
+		t.Error("second feeding on the same day should fail")
+	}
+	if _, _, err := c.FeedPet(now.AddDate(0, 0, 1), clock); err != nil {
+		t.Errorf("FeedPet() next day error = %v", err)
+	}
+}
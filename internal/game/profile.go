@@ -0,0 +1,177 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the character's optional profile fields (motto,
+// pronouns, avatar seed and favorite language) and the schema version the
+// character is stored with.
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// CharacterSchemaVersion is the version of the stored character JSON.
+// Version 2 added the profile; older data (version 0 or 1) loads unchanged.
+const CharacterSchemaVersion = 2
+
+// ProfileField identifies one of the character's profile fields.
+type ProfileField string
+
+// Profile fields, in the order Settings lists them
+const (
+	ProfileMotto            ProfileField = "motto"
+	ProfilePronouns         ProfileField = "pronouns"
+	ProfileAvatarSeed       ProfileField = "avatar_seed"
+	ProfileFavoriteLanguage ProfileField = "favorite_language"
+)
+
+// ProfileFields lists every profile field in display order.
+var ProfileFields = []ProfileField{ProfileMotto, ProfilePronouns, ProfileAvatarSeed, ProfileFavoriteLanguage}
+
+// profileFieldLimits is the longest value (in characters) each field accepts.
+var profileFieldLimits = map[ProfileField]int{
+	ProfileMotto:            80,
+	ProfilePronouns:         20,
+	ProfileAvatarSeed:       32,
+	ProfileFavoriteLanguage: 24,
+}
+
+// avatars are the icons an avatar seed picks from.
+var avatars = []string{"🧙", "🧝", "🧛", "🧜", "🦸", "🥷", "🧚", "🤖", "🐉", "🦊", "🦉", "🐺"}
+
+// CharacterProfile holds optional, player-chosen details shown on the
+// character sheet and the published profile. Empty fields are left out.
+type CharacterProfile struct {
+	Motto            string `json:"motto,omitempty"`             // Tagline under the character's name
+	Pronouns         string `json:"pronouns,omitempty"`          // Shown after the name, e.g. "they/them"
+	AvatarSeed       string `json:"avatar_seed,omitempty"`       // Picks the avatar icon (empty uses the name)
+	FavoriteLanguage string `json:"favorite_language,omitempty"` // Free text, e.g. "Go"
+}
+
+// ProfileFieldLabel returns a field's display name.
+//
+// Parameters:
+//   - field: The profile field
+//
+// Returns:
+//   - string: Display name (e.g. "Favorite Language")
+func ProfileFieldLabel(field ProfileField) string {
+	switch field {
+	case ProfileMotto:
+		return "Motto"
+	case ProfilePronouns:
+		return "Pronouns"
+	case ProfileAvatarSeed:
+		return "Avatar Seed"
+	case ProfileFavoriteLanguage:
+		return "Favorite Language"
+	default:
+		return string(field)
+	}
+}
+
+// ProfileFieldLimit returns the longest value a field accepts, in characters.
+func ProfileFieldLimit(field ProfileField) int {
+	return profileFieldLimits[field]
+}
+
+// Get returns a profile field's value.
+//
+// Parameters:
+//   - field: The profile field
+//
+// Returns:
+//   - string: The value ("" if unset or unknown)
+func (p CharacterProfile) Get(field ProfileField) string {
+	switch field {
+	case ProfileMotto:
+		return p.Motto
+	case ProfilePronouns:
+		return p.Pronouns
+	case ProfileAvatarSeed:
+		return p.AvatarSeed
+	case ProfileFavoriteLanguage:
+		return p.FavoriteLanguage
+	default:
+		return ""
+	}
+}
+
+// SetProfileField sets one of the character's profile fields. Surrounding
+// whitespace is trimmed and an empty value clears the field.
+//
+// Parameters:
+//   - field: The profile field
+//   - value: The new value
+//
+// Returns:
+//   - error: An error if the field is unknown, the value spans several
+//     lines or it is longer than the field allows
+func (c *Character) SetProfileField(field ProfileField, value string) error {
+	limit, ok := profileFieldLimits[field]
+	if !ok {
+		return fmt.Errorf("unknown profile field %q", field)
+	}
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("%s must be a single line", strings.ToLower(ProfileFieldLabel(field)))
+	}
+	if length := len([]rune(value)); length > limit {
+		return fmt.Errorf("%s is %d characters (at most %d)", strings.ToLower(ProfileFieldLabel(field)), length, limit)
+	}
+
+	switch field {
+	case ProfileMotto:
+		c.Profile.Motto = value
+	case ProfilePronouns:
+		c.Profile.Pronouns = value
+	case ProfileAvatarSeed:
+		c.Profile.AvatarSeed = value
+	case ProfileFavoriteLanguage:
+		c.Profile.FavoriteLanguage = value
+	}
+	return nil
+}
+
+// Avatar returns the character's avatar icon, picked by its avatar seed (or
+// its name when no seed is set), so the same seed always gives the same icon.
+//
+// Returns:
+//   - string: An emoji
+func (c *Character) Avatar() string {
+	return avatars[c.AvatarHash()%uint32(len(avatars))]
+}
+
+// AvatarHash hashes the avatar seed (or the name when no seed is set). The
+// avatar icon, and the avatar image in terminals that draw images, are
+// picked from it.
+//
+// Returns:
+//   - uint32: FNV-1a hash of the seed
+func (c *Character) AvatarHash() uint32 {
+	seed := c.Profile.AvatarSeed
+	if seed == "" {
+		seed = c.Name
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(seed))
+	return hash.Sum32()
+}
+
+// MigrateCharacter upgrades a loaded character to the current schema
+// version. Data written by a newer CodeQuest is refused, since saving it
+// again would drop the fields this version doesn't know about.
+//
+// Parameters:
+//   - character: The loaded character
+//
+// Returns:
+//   - error: An error if the character was stored by a newer version
+func MigrateCharacter(character *Character) error {
+	if character.SchemaVersion > CharacterSchemaVersion {
+		return fmt.Errorf("character data uses schema version %d, but this CodeQuest only understands up to %d - please upgrade", character.SchemaVersion, CharacterSchemaVersion)
+	}
+	// Versions 0 and 1 predate the profile, which starts out empty
+	character.SchemaVersion = CharacterSchemaVersion
+	return nil
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSetProfileField tests profile field validation.
+func TestSetProfileField(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   ProfileField
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"motto", ProfileMotto, "  Ship small, ship often  ", "Ship small, ship often", false},
+		{"pronouns", ProfilePronouns, "they/them", "they/them", false},
+		{"clear", ProfileFavoriteLanguage, "", "", false},
+		{"multi-line", ProfileMotto, "one\ntwo", "", true},
+		{"too long", ProfilePronouns, strings.Repeat("x", 21), "", true},
+		{"unknown field", ProfileField("class"), "Wizard", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			err := c.SetProfileField(tt.field, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetProfileField() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := c.Profile.Get(tt.field); got != tt.want {
+				t.Errorf("Profile.Get(%s) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAvatar tests that the avatar follows the seed, falling back to the name.
+func TestAvatar(t *testing.T) {
+	a := NewCharacter("Alice")
+	b := NewCharacter("Alice")
+	if a.Avatar() != b.Avatar() {
+		t.Error("same name should give the same avatar")
+	}
+
+	_ = a.SetProfileField(ProfileAvatarSeed, "owl")
+	_ = b.SetProfileField(ProfileAvatarSeed, "owl")
+	b.Name = "Bob"
+	if a.Avatar() != b.Avatar() {
+		t.Error("same seed should give the same avatar regardless of name")
+	}
+}
+
+// TestMigrateCharacter tests schema version upgrades.
+func TestMigrateCharacter(t *testing.T) {
+	old := &Character{Name: "Old"}
+	if err := MigrateCharacter(old); err != nil || old.SchemaVersion != CharacterSchemaVersion {
+		t.Errorf("MigrateCharacter(v0) = %v, version %d", err, old.SchemaVersion)
+	}
+	if err := MigrateCharacter(&Character{SchemaVersion: CharacterSchemaVersion + 1}); err == nil {
+		t.Error("MigrateCharacter(newer) error = nil, want error")
+	}
+}
@@ -0,0 +1,143 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"time"
+)
+
+// ProjectStats holds rollup statistics for one project (a named group of
+// repositories from config).
+type ProjectStats struct {
+	Commits         int       `json:"commits"`                  // Commits made in the project's repos
+	LinesAdded      int       `json:"lines_added"`              // Lines added across the project
+	LinesRemoved    int       `json:"lines_removed"`            // Lines removed across the project
+	XPEarned        int       `json:"xp_earned"`                // Commit and quest XP earned from the project
+	QuestsCompleted int       `json:"quests_completed"`         // Quests completed by commits in the project
+	LastCommitAt    time.Time `json:"last_commit_at,omitempty"` // Most recent commit in the project
+}
+
+// ProjectSummary combines a project's stats with its quest counts for display.
+type ProjectSummary struct {
+	Name         string       // Project name from config
+	Stats        ProjectStats // Rollup commit and quest statistics
+	ActiveQuests int          // Active quests scoped to the project
+}
+
+// projectStats returns the stats for a project, creating them if needed.
+func (c *Character) projectStats(project string) *ProjectStats {
+	if c.Projects == nil {
+		c.Projects = make(map[string]*ProjectStats)
+	}
+	stats, ok := c.Projects[project]
+	if !ok {
+		stats = &ProjectStats{}
+		c.Projects[project] = stats
+	}
+	return stats
+}
+
+// RecordProjectCommit adds a commit to a project's rollup stats.
+// Commits outside any project (empty name) are ignored.
+//
+// Parameters:
+//   - project: Project the commit's repository belongs to
+//   - linesAdded: Lines added by the commit
+//   - linesRemoved: Lines removed by the commit
+//   - xp: XP awarded for the commit
+//   - at: When the commit was made
+func (c *Character) RecordProjectCommit(project string, linesAdded, linesRemoved, xp int, at time.Time) {
+	if project == "" {
+		return
+	}
+
+	stats := c.projectStats(project)
+	stats.Commits++
+	stats.LinesAdded += linesAdded
+	stats.LinesRemoved += linesRemoved
+	stats.XPEarned += xp
+	if at.After(stats.LastCommitAt) {
+		stats.LastCommitAt = at
+	}
+}
+
+// RecordProjectQuest credits a completed quest and its XP to a project.
+// Quests completed outside any project (empty name) are ignored.
+//
+// Parameters:
+//   - project: Project whose commit completed the quest
+//   - xp: XP awarded for the quest
+func (c *Character) RecordProjectQuest(project string, xp int) {
+	if project == "" {
+		return
+	}
+
+	stats := c.projectStats(project)
+	stats.QuestsCompleted++
+	stats.XPEarned += xp
+}
+
+// MatchesProject reports whether work in the given project counts toward
+// this quest. Quests without a project accept work from any repository.
+//
+// Parameters:
+//   - project: Project of the repository where the work happened
+//
+// Returns:
+//   - bool: True if the quest should progress
+func (q *Quest) MatchesProject(project string) bool {
+	return q.Project == "" || q.Project == project
+}
+
+// FilterQuestsByProject returns the quests scoped to a project.
+// An empty project returns all quests unchanged.
+//
+// Parameters:
+//   - quests: Quests to filter
+//   - project: Project name to keep
+//
+// Returns:
+//   - []*Quest: Quests whose Project matches
+func FilterQuestsByProject(quests []*Quest, project string) []*Quest {
+	if project == "" {
+		return quests
+	}
+
+	filtered := make([]*Quest, 0)
+	for _, quest := range quests {
+		if quest.Project == project {
+			filtered = append(filtered, quest)
+		}
+	}
+	return filtered
+}
+
+// SummarizeProjects builds a rollup for each named project, in the given
+// order. Projects with no activity yet are included with zero stats.
+//
+// Parameters:
+//   - character: Player character holding project stats (nil-safe)
+//   - quests: All quests (for per-project active quest counts)
+//   - names: Project names in display order
+//
+// Returns:
+//   - []ProjectSummary: One summary per project name
+func SummarizeProjects(character *Character, quests []*Quest, names []string) []ProjectSummary {
+	summaries := make([]ProjectSummary, 0, len(names))
+	for _, name := range names {
+		summary := ProjectSummary{Name: name}
+		if character != nil {
+			if stats, ok := character.Projects[name]; ok {
+				summary.Stats = *stats
+			}
+		}
+
+		for _, quest := range quests {
+			if quest.Project == name && quest.Status == QuestActive {
+				summary.ActiveQuests++
+			}
+		}
+
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRecordProjectCommit tests that commits roll up into their project.
+func TestRecordProjectCommit(t *testing.T) {
+	c := NewCharacter("Tester")
+	first := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	c.RecordProjectCommit("work", 10, 2, 30, second)
+	c.RecordProjectCommit("work", 5, 1, 20, first)
+	c.RecordProjectCommit("", 100, 0, 500, second) // Not in a project
+	c.RecordProjectQuest("work", 50)
+
+	stats, ok := c.Projects["work"]
+	if !ok {
+		t.Fatal("expected stats for project work")
+	}
+	if len(c.Projects) != 1 {
+		t.Errorf("len(Projects) = %d, want 1", len(c.Projects))
+	}
+	if stats.Commits != 2 || stats.LinesAdded != 15 || stats.LinesRemoved != 3 {
+		t.Errorf("stats = %+v, want 2 commits, +15/-3 lines", stats)
+	}
+	if stats.XPEarned != 100 {
+		t.Errorf("XPEarned = %d, want 100", stats.XPEarned)
+	}
+	if stats.QuestsCompleted != 1 {
+		t.Errorf("QuestsCompleted = %d, want 1", stats.QuestsCompleted)
+	}
+	if !stats.LastCommitAt.Equal(second) {
+		t.Errorf("LastCommitAt = %v, want %v", stats.LastCommitAt, second)
+	}
+}
+
+// TestQuestMatchesProject tests project scoping of quest progress.
+func TestQuestMatchesProject(t *testing.T) {
+	tests := []struct {
+		name         string
+		questProject string
+		project      string
+		want         bool
+	}{
+		{"unscoped quest accepts any repo", "", "work", true},
+		{"unscoped quest accepts repos outside projects", "", "", true},
+		{"scoped quest accepts its project", "work", "work", true},
+		{"scoped quest rejects other projects", "work", "oss", false},
+		{"scoped quest rejects repos outside projects", "work", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Quest{Project: tt.questProject}
+			if got := q.MatchesProject(tt.project); got != tt.want {
+				t.Errorf("MatchesProject(%q) = %v, want %v", tt.project, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSummarizeProjects tests per-project rollups and quest filtering.
+func TestSummarizeProjects(t *testing.T) {
+	c := NewCharacter("Tester")
+	c.RecordProjectCommit("oss", 4, 0, 10, time.Now())
+
+	quests := []*Quest{
+		{ID: "a", Project: "work", Status: QuestActive},
+		{ID: "b", Project: "work", Status: QuestCompleted},
+		{ID: "c", Project: "oss", Status: QuestAvailable},
+		{ID: "d", Status: QuestActive},
+	}
+
+	summaries := SummarizeProjects(c, quests, []string{"work", "oss"})
+	if len(summaries) != 2 {
+		t.Fatalf("len(summaries) = %d, want 2", len(summaries))
+	}
+	if summaries[0].Name != "work" || summaries[0].ActiveQuests != 1 || summaries[0].Stats.Commits != 0 {
+		t.Errorf("work summary = %+v, want 1 active quest and no commits", summaries[0])
+	}
+	if summaries[1].Name != "oss" || summaries[1].Stats.Commits != 1 {
+		t.Errorf("oss summary = %+v, want 1 commit", summaries[1])
+	}
+
+	if got := FilterQuestsByProject(quests, "work"); len(got) != 2 {
+		t.Errorf("FilterQuestsByProject(work) returned %d quests, want 2", len(got))
+	}
+	if got := FilterQuestsByProject(quests, ""); len(got) != len(quests) {
+		t.Errorf("FilterQuestsByProject(\"\") returned %d quests, want all %d", len(got), len(quests))
+	}
+}
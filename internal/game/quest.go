@@ -4,6 +4,8 @@ package game
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,31 +26,46 @@ const (
 type QuestType string
 
 const (
-	QuestTypeCommit   QuestType = "commit"   // Make N commits
-	QuestTypeLines    QuestType = "lines"    // Add/modify N lines of code
-	QuestTypeTests    QuestType = "tests"    // Add N test cases (post-MVP)
-	QuestTypePR       QuestType = "pr"       // Create/merge pull request (post-MVP)
-	QuestTypeRefactor QuestType = "refactor" // Refactor code (post-MVP)
-	QuestTypeDaily    QuestType = "daily"    // Daily quest (post-MVP)
-	QuestTypeStreak   QuestType = "streak"   // Maintain N-day streak (post-MVP)
+	QuestTypeCommit      QuestType = "commit"       // Make N commits
+	QuestTypeLines       QuestType = "lines"        // Add/modify N lines of code
+	QuestTypeTests       QuestType = "tests"        // Change N test files
+	QuestTypePR          QuestType = "pr"           // Create/merge pull request (post-MVP)
+	QuestTypeRefactor    QuestType = "refactor"     // Refactor code (post-MVP)
+	QuestTypeDaily       QuestType = "daily"        // Daily quest (post-MVP)
+	QuestTypeStreak      QuestType = "streak"       // Maintain N-day streak (post-MVP)
+	QuestTypeDocs        QuestType = "docs"         // Edit N markdown files
+	QuestTypeReview      QuestType = "review"       // Approve N pull requests (GitHub integration)
+	QuestTypeDeps        QuestType = "deps"         // Make N dependency update commits (go.mod)
+	QuestTypeMonthly     QuestType = "monthly"      // Bump N go.mod dependencies this month (resets monthly)
+	QuestTypeTodo        QuestType = "todo"         // Remove a TODO/FIXME comment
+	QuestTypePeerReview  QuestType = "peer_review"  // Review N teammates' commits (Reviewed-by trailers)
+	QuestTypePairing     QuestType = "pairing"      // Land N commits reviewed or co-authored by a teammate
+	QuestTypeWellFormed  QuestType = "well_formed"  // Make N commits with conventional commit messages
+	QuestTypeTask        QuestType = "task"         // Tick off an item in a markdown task list
+	QuestTypeQuestStreak QuestType = "quest_streak" // Complete a quest on N consecutive days
+	QuestTypeOpenSource  QuestType = "open_source"  // Make N commits to other people's repositories
+	QuestTypeWIP         QuestType = "wip"          // Commit or stash uncommitted work left sitting in a repository
 )
 
 // Quest represents a coding task or challenge that players can accept and complete.
 // Quests are the primary way players earn large XP rewards and unlock new content.
 type Quest struct {
 	// Identity - Basic quest information
-	ID          string    `json:"id"`          // Unique identifier (UUID)
-	Title       string    `json:"title"`       // Display name for the quest
-	Description string    `json:"description"` // Detailed description of what to do
-	Type        QuestType `json:"type"`        // Type of quest (commit, lines, etc.)
+	ID          string    `json:"id"`                 // Unique identifier (UUID)
+	Title       string    `json:"title"`              // Display name for the quest
+	Description string    `json:"description"`        // Detailed description of what to do
+	Type        QuestType `json:"type"`               // Type of quest (commit, lines, etc.)
+	Template    string    `json:"template,omitempty"` // Built-in template this quest was created from
 
 	// Requirements - Prerequisites to start the quest
-	RequiredLevel int      `json:"required_level"` // Minimum character level required
-	Prerequisites []string `json:"prerequisites"`  // Quest IDs that must be completed first
+	RequiredLevel int           `json:"required_level"`         // Minimum character level required
+	Prerequisites []string      `json:"prerequisites"`          // Quest IDs that must be completed first
+	Requirements  []Requirement `json:"requirements,omitempty"` // Extra conditions (achievements, streaks, stats)
 
 	// Objectives - What the player needs to accomplish
-	Target  int `json:"target"`  // Target count (e.g., 5 commits, 100 lines)
-	Current int `json:"current"` // Current progress toward target
+	Target   int        `json:"target"`             // Target count (e.g., 5 commits, 100 lines)
+	Current  int        `json:"current"`            // Current progress toward target
+	Deadline *time.Time `json:"deadline,omitempty"` // Day the quest is due (nil = no deadline)
 
 	// Rewards - What the player earns upon completion
 	XPReward      int      `json:"xp_reward"`                // Base XP awarded (before multipliers)
@@ -58,16 +75,45 @@ type Quest struct {
 	// Tracking - Git repository context for the quest
 	GitRepo    string `json:"git_repo,omitempty"`     // Path to the git repository
 	GitBaseSHA string `json:"git_base_sha,omitempty"` // Starting commit SHA
+	Project    string `json:"project,omitempty"`      // Only commits in this project count (empty = any repo)
+
+	// Todo is the comment a todo quest resolves
+	Todo *TodoComment `json:"todo,omitempty"`
+
+	// Task is the task list item a task quest ticks off
+	Task *TaskItem `json:"task,omitempty"`
+
+	// WIP is the uncommitted work a wip quest asks to commit or stash
+	WIP *UncommittedWork `json:"wip,omitempty"`
+
+	// Generated is the quest catalog draw the quest came from (nil for other quests)
+	Generated *GeneratedQuest `json:"generated,omitempty"`
 
 	// Status - Current state and progress
 	Status      QuestStatus `json:"status"`                 // Current lifecycle state
 	StartedAt   *time.Time  `json:"started_at,omitempty"`   // When quest was started
 	CompletedAt *time.Time  `json:"completed_at,omitempty"` // When quest was completed
 	Progress    float64     `json:"progress"`               // Progress percentage (0.0 to 1.0)
+
+	// Reward - What completion paid out
+	RewardPending bool         `json:"reward_pending,omitempty"` // Completed, waiting for the player to pick a reward
+	Reward        *QuestReward `json:"reward,omitempty"`         // Completion record of the reward paid
+
+	// Journal - Player's own notes about the quest
+	Notes          string     `json:"notes,omitempty"`            // Freeform markdown (why it exists, what was learned)
+	NotesUpdatedAt *time.Time `json:"notes_updated_at,omitempty"` // When notes were last edited
+
+	// Board - Player's arrangement of the Quest Board (order is the quest list's order)
+	Pinned bool `json:"pinned,omitempty"` // Listed at the top of the Quest Board
+
+	// Reminder - Nudge at a time of day while progress is behind (nil = none)
+	Reminder *QuestReminder `json:"reminder,omitempty"`
 }
 
 // NewQuest creates a new quest with the given parameters.
 // This initializes a quest in the "available" state, ready to be started.
+// Quests needing more than these fields are easier to read built with
+// NewQuestBuilder.
 //
 // Parameters:
 //   - title: Display name for the quest
@@ -78,45 +124,26 @@ type Quest struct {
 //   - requiredLevel: Minimum level needed to start
 //
 // Returns:
-//   - *Quest: A pointer to the newly created quest
+//   - *Quest: A pointer to the newly created quest (not validated)
+//
+// Deprecated: Use NewQuestBuilder, which validates the quest.
 func NewQuest(title, description string, questType QuestType, target, xpReward, requiredLevel int) *Quest {
-	return &Quest{
-		// Identity
-		ID:          generateQuestID(),
-		Title:       title,
-		Description: description,
-		Type:        questType,
-
-		// Requirements
-		RequiredLevel: requiredLevel,
-		Prerequisites: []string{}, // Empty by default
-
-		// Objectives
-		Target:  target,
-		Current: 0,
-
-		// Rewards
-		XPReward:      xpReward,
-		UnlocksSkills: []string{}, // Empty by default
-		UnlocksQuests: []string{}, // Empty by default
-
-		// Tracking
-		GitRepo:    "",
-		GitBaseSHA: "",
-
-		// Status
-		Status:      QuestAvailable,
-		StartedAt:   nil,
-		CompletedAt: nil,
-		Progress:    0.0,
-	}
+	b := NewQuestBuilder().
+		Title(title).
+		Description(description).
+		Type(questType).
+		Target(target).
+		XPReward(xpReward).
+		RequiredLevel(requiredLevel)
+	return b.quest
 }
 
 // IsAvailable checks if the quest can be started by the given character.
 // A quest is available if:
 //  1. The quest status is "available" (not already started/completed)
 //  2. The character meets the minimum level requirement
-//  3. All prerequisite quests have been completed
+//  3. The character meets every extra requirement (achievements, stats, ...)
+//  4. All prerequisite quests have been completed
 //
 // Parameters:
 //   - character: The character attempting to start the quest
@@ -129,8 +156,8 @@ func (q *Quest) IsAvailable(character *Character) bool {
 		return false
 	}
 
-	// Character must meet level requirement
-	if character.Level < q.RequiredLevel {
+	// Character must meet level and extra requirements
+	if len(q.LockReasons(character)) > 0 {
 		return false
 	}
 
@@ -140,6 +167,24 @@ func (q *Quest) IsAvailable(character *Character) bool {
 	return true
 }
 
+// LockReasons explains why a character cannot start this quest yet.
+// Only character-based requirements (level and Requirements) are checked;
+// the quest's status is not considered.
+//
+// Parameters:
+//   - character: The character attempting to start the quest
+//
+// Returns:
+//   - []string: Human-readable reasons (empty if the character qualifies)
+func (q *Quest) LockReasons(character *Character) []string {
+	requirements := q.Requirements
+	if q.RequiredLevel > 0 {
+		level := Requirement{Type: RequirementLevel, Value: q.RequiredLevel}
+		requirements = append([]Requirement{level}, requirements...)
+	}
+	return CheckRequirements(requirements, character)
+}
+
 // Start begins the quest, marking it as active.
 // This should be called when a player accepts a quest.
 //
@@ -150,13 +195,25 @@ func (q *Quest) IsAvailable(character *Character) bool {
 // Returns:
 //   - error: An error if the quest cannot be started
 func (q *Quest) Start(repoPath, baseSHA string) error {
+	return q.StartAt(repoPath, baseSHA, time.Now())
+}
+
+// StartAt starts the quest like Start, recording now as the start time.
+//
+// Parameters:
+//   - repoPath: Repository the quest is tied to ("" for any)
+//   - baseSHA: Commit the quest starts from ("" if unknown)
+//   - now: When the quest starts
+//
+// Returns:
+//   - error: An error if the quest isn't available
+func (q *Quest) StartAt(repoPath, baseSHA string, now time.Time) error {
 	// Verify quest is available
 	if q.Status != QuestAvailable {
 		return fmt.Errorf("quest %s is not available (current status: %s)", q.ID, q.Status)
 	}
 
 	// Set quest to active
-	now := time.Now()
 	q.Status = QuestActive
 	q.StartedAt = &now
 	q.GitRepo = repoPath
@@ -210,6 +267,127 @@ func (q *Quest) UpdateProgress(amount int) {
 	}
 }
 
+// SetProgress sets the quest's progress to an absolute value.
+// Used by quests that mirror a running count (like the current streak)
+// rather than accumulating increments. The value is clamped to 0..Target.
+//
+// Parameters:
+//   - value: The new progress value
+func (q *Quest) SetProgress(value int) {
+	// Only update if quest is active
+	if q.Status != QuestActive {
+		return
+	}
+
+	q.Current = max(0, min(value, q.Target))
+	if q.Target > 0 {
+		q.Progress = float64(q.Current) / float64(q.Target)
+	} else {
+		q.Progress = 1.0 // Prevent division by zero
+	}
+}
+
+// FindQuest locates a quest by full ID or unique ID prefix.
+//
+// Parameters:
+//   - quests: Quests to search
+//   - id: Full quest ID or a prefix of one
+//
+// Returns:
+//   - *Quest: The matching quest, or nil if none or the prefix is ambiguous
+func FindQuest(quests []*Quest, id string) *Quest {
+	if id == "" {
+		return nil
+	}
+
+	var match *Quest
+	for _, quest := range quests {
+		if quest.ID == id {
+			return quest
+		}
+		if strings.HasPrefix(quest.ID, id) {
+			if match != nil {
+				return nil // Ambiguous prefix
+			}
+			match = quest
+		}
+	}
+	return match
+}
+
+// ArrangeQuests puts quests in the player's Quest Board order and sets
+// which are pinned. Quests missing from the order (added since it was
+// captured) keep their relative place at the end.
+//
+// Parameters:
+//   - quests: Quests to arrange (the slice itself isn't reordered)
+//   - order: Quest IDs in the wanted order
+//   - pinned: IDs of the quests to pin (the rest are unpinned)
+//
+// Returns:
+//   - []*Quest: The arranged quests
+func ArrangeQuests(quests []*Quest, order []string, pinned map[string]bool) []*Quest {
+	position := make(map[string]int, len(order))
+	for i, id := range order {
+		position[id] = i
+	}
+	rank := func(quest *Quest) int {
+		if i, ok := position[quest.ID]; ok {
+			return i
+		}
+		return len(position)
+	}
+
+	arranged := slices.Clone(quests)
+	slices.SortStableFunc(arranged, func(a, b *Quest) int { return rank(a) - rank(b) })
+	for _, quest := range arranged {
+		quest.Pinned = pinned[quest.ID]
+	}
+	return arranged
+}
+
+// NeedsDailyReset reports whether a daily quest belongs to an earlier day and
+// should be reset at rollover. Daily quests that were started (or completed)
+// on a previous day expire; unstarted dailies are left alone.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Timezone and grace window that define "today"
+//
+// Returns:
+//   - bool: True if the quest is a daily from a previous day
+func (q *Quest) NeedsDailyReset(now time.Time, clock StreakClock) bool {
+	if q.Type != QuestTypeDaily || q.StartedAt == nil {
+		return false
+	}
+	switch q.Status {
+	case QuestActive, QuestCompleted, QuestFailed:
+		return clock.Day(*q.StartedAt).Before(clock.Day(now))
+	}
+	return false
+}
+
+// NeedsMonthlyReset reports whether a monthly quest belongs to an earlier
+// month and should be reset at rollover, like NeedsDailyReset for dailies.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Timezone and grace window that define "today"
+//
+// Returns:
+//   - bool: True if the quest is a monthly quest from a previous month
+func (q *Quest) NeedsMonthlyReset(now time.Time, clock StreakClock) bool {
+	if q.Type != QuestTypeMonthly || q.StartedAt == nil {
+		return false
+	}
+	switch q.Status {
+	case QuestActive, QuestCompleted, QuestFailed:
+		started, today := clock.Day(*q.StartedAt), clock.Day(now)
+		return started.Year() != today.Year() || started.Month() != today.Month()
+	}
+	return false
+}
+
 // CheckCompletion determines if the quest has been completed.
 // A quest is complete when the current progress reaches or exceeds the target.
 //
@@ -231,6 +409,18 @@ func (q *Quest) CheckCompletion() bool {
 // Returns:
 //   - error: An error if the quest cannot be completed
 func (q *Quest) Complete() error {
+	return q.CompleteAt(time.Now())
+}
+
+// CompleteAt completes the quest like Complete, recording now as the
+// completion time.
+//
+// Parameters:
+//   - now: When the quest was completed
+//
+// Returns:
+//   - error: An error if the quest cannot be completed
+func (q *Quest) CompleteAt(now time.Time) error {
 	// Verify quest is active
 	if q.Status != QuestActive {
 		return fmt.Errorf("quest %s is not active (current status: %s)", q.ID, q.Status)
@@ -242,7 +432,6 @@ func (q *Quest) Complete() error {
 	}
 
 	// Mark as completed
-	now := time.Now()
 	q.Status = QuestCompleted
 	q.CompletedAt = &now
 	q.Progress = 1.0
@@ -276,10 +465,29 @@ func (q *Quest) Reset() {
 	q.Progress = 0.0
 	q.StartedAt = nil
 	q.CompletedAt = nil
+	q.RewardPending = false
+	q.Reward = nil
 	q.GitRepo = ""
 	q.GitBaseSHA = ""
 }
 
+// SetNotes replaces the quest's markdown notes.
+// Trailing whitespace is trimmed so editor-added newlines don't accumulate.
+// Notes survive Reset() since they describe the quest, not a single attempt.
+//
+// Parameters:
+//   - notes: The new markdown notes (empty clears them)
+func (q *Quest) SetNotes(notes string) {
+	notes = strings.TrimRight(notes, " \t\r\n")
+	if notes == q.Notes {
+		return
+	}
+
+	now := time.Now()
+	q.Notes = notes
+	q.NotesUpdatedAt = &now
+}
+
 // generateQuestID creates a unique identifier for a quest using UUID v4.
 //
 // Returns:
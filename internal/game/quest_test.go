@@ -1,10 +1,13 @@
 package game
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
 )
 
 // TestNewQuest tests the quest creation function
@@ -944,6 +947,140 @@ func TestQuest_ProgressClamping(t *testing.T) {
 	}
 }
 
+// TestQuestSetNotes tests editing quest notes
+func TestQuestSetNotes(t *testing.T) {
+	quest := NewQuest("Refactor Parser", "Clean it up", QuestTypeCommit, 3, 100, 1)
+
+	if quest.NotesUpdatedAt != nil {
+		t.Fatal("new quest should have no NotesUpdatedAt")
+	}
+
+	quest.SetNotes("## Why\nThe parser is slow.\n\n")
+	if quest.Notes != "## Why\nThe parser is slow." {
+		t.Errorf("SetNotes() Notes = %q, want trailing whitespace trimmed", quest.Notes)
+	}
+	if quest.NotesUpdatedAt == nil {
+		t.Fatal("SetNotes() should set NotesUpdatedAt")
+	}
+
+	// Unchanged notes should not bump the timestamp
+	updatedAt := *quest.NotesUpdatedAt
+	quest.SetNotes("## Why\nThe parser is slow.")
+	if !quest.NotesUpdatedAt.Equal(updatedAt) {
+		t.Error("SetNotes() with identical notes should not change NotesUpdatedAt")
+	}
+
+	// Notes survive a reset
+	quest.Reset()
+	if quest.Notes == "" {
+		t.Error("Reset() should keep quest notes")
+	}
+}
+
+// TestQuestSetProgress tests absolute progress updates used by streak quests.
+func TestQuestSetProgress(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      QuestStatus
+		value       int
+		wantCurrent int
+	}{
+		{"sets progress", QuestActive, 3, 3},
+		{"clamps to target", QuestActive, 12, 7},
+		{"clamps negatives to zero", QuestActive, -2, 0},
+		{"ignores inactive quests", QuestAvailable, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Week Warrior", "Keep a 7-day streak", QuestTypeStreak, 7, 300, 1)
+			quest.Status = tt.status
+
+			quest.SetProgress(tt.value)
+
+			if quest.Current != tt.wantCurrent {
+				t.Errorf("Current = %d, want %d", quest.Current, tt.wantCurrent)
+			}
+			if want := float64(tt.wantCurrent) / 7; abs(quest.Progress-want) > 0.001 {
+				t.Errorf("Progress = %f, want %f", quest.Progress, want)
+			}
+		})
+	}
+}
+
+// TestQuestNeedsDailyReset tests which daily quests expire at rollover.
+func TestQuestNeedsDailyReset(t *testing.T) {
+	clock := StreakClock{Location: time.UTC, Grace: 2 * time.Hour}
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	lateLastNight := time.Date(2025, 5, 20, 1, 0, 0, 0, time.UTC) // inside grace: counts as May 19
+
+	tests := []struct {
+		name      string
+		questType QuestType
+		status    QuestStatus
+		startedAt *time.Time
+		want      bool
+	}{
+		{"active daily from yesterday", QuestTypeDaily, QuestActive, &yesterday, true},
+		{"completed daily from yesterday", QuestTypeDaily, QuestCompleted, &yesterday, true},
+		{"active daily from today", QuestTypeDaily, QuestActive, &now, false},
+		{"daily started within grace belongs to yesterday", QuestTypeDaily, QuestActive, &lateLastNight, true},
+		{"unstarted daily", QuestTypeDaily, QuestAvailable, nil, false},
+		{"commit quest from yesterday", QuestTypeCommit, QuestActive, &yesterday, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Daily Grind", "Make 3 commits today", tt.questType, 3, 50, 1)
+			quest.Status = tt.status
+			quest.StartedAt = tt.startedAt
+
+			if got := quest.NeedsDailyReset(now, clock); got != tt.want {
+				t.Errorf("NeedsDailyReset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestQuestNeedsMonthlyReset tests which monthly quests expire at rollover.
+func TestQuestNeedsMonthlyReset(t *testing.T) {
+	clock := StreakClock{Location: time.UTC, Grace: 2 * time.Hour}
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	earlierThisMonth := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	lastMonth := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	lastYear := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	lateLastNight := time.Date(2025, 6, 1, 1, 0, 0, 0, time.UTC) // inside grace: counts as May 31
+
+	tests := []struct {
+		name      string
+		questType QuestType
+		status    QuestStatus
+		startedAt *time.Time
+		want      bool
+	}{
+		{"active monthly from last month", QuestTypeMonthly, QuestActive, &lastMonth, true},
+		{"completed monthly from last month", QuestTypeMonthly, QuestCompleted, &lastMonth, true},
+		{"same month last year", QuestTypeMonthly, QuestActive, &lastYear, true},
+		{"active monthly from this month", QuestTypeMonthly, QuestActive, &earlierThisMonth, false},
+		{"started within grace belongs to last month", QuestTypeMonthly, QuestActive, &lateLastNight, true},
+		{"unstarted monthly", QuestTypeMonthly, QuestAvailable, nil, false},
+		{"deps quest from last month", QuestTypeDeps, QuestActive, &lastMonth, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Monthly Maintenance", "Update 3 dependencies this month", tt.questType, 3, 100, 1)
+			quest.Status = tt.status
+			quest.StartedAt = tt.startedAt
+
+			if got := quest.NeedsMonthlyReset(now, clock); got != tt.want {
+				t.Errorf("NeedsMonthlyReset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // Helper functions
 
 // contains checks if a string contains a substring (case-sensitive)
@@ -967,3 +1104,179 @@ func abs(x float64) float64 {
 	}
 	return x
 }
+
+// TestFindQuest tests looking quests up by full ID or unique prefix.
+func TestFindQuest(t *testing.T) {
+	first := &Quest{ID: "abc123"}
+	second := &Quest{ID: "abd456"}
+	quests := []*Quest{first, second}
+
+	tests := []struct {
+		name string
+		id   string
+		want *Quest
+	}{
+		{"full ID", "abd456", second},
+		{"unique prefix", "abc", first},
+		{"ambiguous prefix", "ab", nil},
+		{"no match", "xyz", nil},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindQuest(quests, tt.id); got != tt.want {
+				t.Errorf("FindQuest(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBatchedCommitQuestProgress tests that a throttled watcher's batched
+// commit event advances commit and daily quests by every commit it covers.
+func TestBatchedCommitQuestProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int // commit_count on the event (0 = not set)
+		wantNew int
+	}{
+		{"single commit", 0, 1},
+		{"batch of three", 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := NewCharacter("Tester")
+			commitQuest := NewQuestBuilder().Title("Commits").Type(QuestTypeCommit).Target(10).XPReward(50).MustBuild()
+			dailyQuest := NewQuestBuilder().Title("Daily").Type(QuestTypeDaily).Target(10).XPReward(50).MustBuild()
+			quests := []*Quest{commitQuest, dailyQuest}
+			for _, quest := range quests {
+				if err := quest.Start("", ""); err != nil {
+					t.Fatalf("Start() error = %v", err)
+				}
+			}
+
+			bus := NewEventBus()
+			handler, err := NewGameEventHandler(character, quests, bus, &memoryStorage{}, config.DefaultConfig())
+			if err != nil {
+				t.Fatalf("NewGameEventHandler() error = %v", err)
+			}
+			if err := handler.Start(); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer handler.Stop()
+
+			event := NewCommitEvent("0123456789abcdef", "Batched work", 10, 0, 1)
+			if tt.count > 0 {
+				event.Data["commit_count"] = tt.count
+			}
+			bus.Publish(event)
+
+			if character.TotalCommits != tt.wantNew || commitQuest.Current != tt.wantNew || dailyQuest.Current != tt.wantNew {
+				t.Errorf("TotalCommits %d, commit quest %d, daily quest %d; want %d each",
+					character.TotalCommits, commitQuest.Current, dailyQuest.Current, tt.wantNew)
+			}
+		})
+	}
+}
+
+// TestHandlerKeepsQuestArrangement tests that Quest Board pins and order
+// published by the UI are applied to the handler's quests, so its next
+// save after a commit keeps them.
+func TestHandlerKeepsQuestArrangement(t *testing.T) {
+	tests := []struct {
+		name       string
+		order      []string
+		pinned     []string
+		wantOrder  string
+		wantPinned string
+	}{
+		{"reordered", []string{"c", "a", "b"}, nil, "c a b", ""},
+		{"pinned", []string{"a", "b", "c"}, []string{"b"}, "a b c", "b"},
+		{"quest added since keeps its place at the end", []string{"b", "a"}, []string{"a"}, "b a c", "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quests := []*Quest{
+				{ID: "a", Title: "A", Type: QuestTypeCommit, Status: QuestAvailable},
+				{ID: "b", Title: "B", Type: QuestTypeCommit, Status: QuestAvailable},
+				{ID: "c", Title: "C", Type: QuestTypeCommit, Status: QuestAvailable},
+			}
+			store := &memoryStorage{}
+			bus := NewEventBus()
+			handler, err := NewGameEventHandler(NewCharacter("Tester"), quests, bus, store, config.DefaultConfig())
+			if err != nil {
+				t.Fatalf("NewGameEventHandler() error = %v", err)
+			}
+			if err := handler.Start(); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer handler.Stop()
+
+			bus.Publish(NewQuestsArrangedEvent(tt.order, tt.pinned))
+			bus.Publish(NewCommitEvent("0123456789abcdef", "feat: arranged", 1, 5, 0))
+
+			var order, pinned []string
+			for _, quest := range store.quests {
+				order = append(order, quest.ID)
+				if quest.Pinned {
+					pinned = append(pinned, quest.ID)
+				}
+			}
+			if got := strings.Join(order, " "); got != tt.wantOrder {
+				t.Errorf("saved order = %q, want %q", got, tt.wantOrder)
+			}
+			if got := strings.Join(pinned, " "); got != tt.wantPinned {
+				t.Errorf("saved pins = %q, want %q", got, tt.wantPinned)
+			}
+		})
+	}
+}
+
+// TestDailyQuestSkipsReplayedCommits tests that commits replayed from earlier
+// days still advance commit quests but not today's daily quests.
+func TestDailyQuestSkipsReplayedCommits(t *testing.T) {
+	tests := []struct {
+		name      string
+		madeAt    time.Time
+		wantDaily int
+	}{
+		{"replayed from today", time.Now(), 1},
+		{"replayed from two days ago", time.Now().AddDate(0, 0, -2), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daily := NewQuest("Daily Grind", "", QuestTypeDaily, 3, 50, 1)
+			commits := NewQuest("Commit Streak", "", QuestTypeCommit, 3, 50, 1)
+			for _, quest := range []*Quest{daily, commits} {
+				if err := quest.Start("", ""); err != nil {
+					t.Fatalf("Start() error = %v", err)
+				}
+			}
+
+			bus := NewEventBus()
+			handler, err := NewGameEventHandler(NewCharacter("Tester"), []*Quest{daily, commits}, bus, &memoryStorage{}, config.DefaultConfig())
+			if err != nil {
+				t.Fatalf("NewGameEventHandler() error = %v", err)
+			}
+			if err := handler.Start(); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer handler.Stop()
+
+			event := NewCommitEvent("0123456789abcdef", "feat: offline work", 1, 10, 0)
+			event.Data["retroactive"] = true
+			event.Data["timestamp"] = tt.madeAt
+			bus.Publish(event)
+
+			if daily.Current != tt.wantDaily {
+				t.Errorf("daily quest progress = %d, want %d", daily.Current, tt.wantDaily)
+			}
+			if commits.Current != 1 {
+				t.Errorf("commit quest progress = %d, want 1", commits.Current)
+			}
+		})
+	}
+}
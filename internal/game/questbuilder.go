@@ -0,0 +1,220 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements QuestBuilder, the fluent way to construct quests in
+// code:
+//
+//	quest, err := game.NewQuestBuilder().
+//		Title("Bug Hunt").
+//		Type(game.QuestTypeCommit).
+//		Target(5).
+//		XPReward(100).
+//		Repo("/src/api").
+//		Build()
+//
+// Build checks the quest is complete and consistent, so generated quests
+// fail with a clear error instead of landing in the quest log broken.
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// knownQuestTypes are the quest types progress can be tracked for.
+var knownQuestTypes = map[QuestType]bool{
+	QuestTypeCommit:      true,
+	QuestTypeLines:       true,
+	QuestTypeTests:       true,
+	QuestTypePR:          true,
+	QuestTypeRefactor:    true,
+	QuestTypeDaily:       true,
+	QuestTypeStreak:      true,
+	QuestTypeDocs:        true,
+	QuestTypeReview:      true,
+	QuestTypeDeps:        true,
+	QuestTypeMonthly:     true,
+	QuestTypeTodo:        true,
+	QuestTypePeerReview:  true,
+	QuestTypePairing:     true,
+	QuestTypeWellFormed:  true,
+	QuestTypeTask:        true,
+	QuestTypeQuestStreak: true,
+	QuestTypeOpenSource:  true,
+	QuestTypeWIP:         true,
+}
+
+// QuestBuilder constructs a quest one field at a time. Every setter returns
+// the builder so calls can be chained; Build validates the result. A new
+// builder starts from an available quest with a target of 1 and no reward.
+type QuestBuilder struct {
+	quest *Quest
+}
+
+// NewQuestBuilder starts building an available quest with a fresh ID.
+//
+// Returns:
+//   - *QuestBuilder: The builder
+func NewQuestBuilder() *QuestBuilder {
+	return &QuestBuilder{quest: &Quest{
+		ID:            generateQuestID(),
+		Prerequisites: []string{},
+		Target:        1,
+		UnlocksSkills: []string{},
+		UnlocksQuests: []string{},
+		Status:        QuestAvailable,
+	}}
+}
+
+// Title sets the quest's display name (required).
+func (b *QuestBuilder) Title(title string) *QuestBuilder {
+	b.quest.Title = title
+	return b
+}
+
+// Description sets what the player needs to do.
+func (b *QuestBuilder) Description(description string) *QuestBuilder {
+	b.quest.Description = description
+	return b
+}
+
+// Type sets how progress is tracked (required).
+func (b *QuestBuilder) Type(questType QuestType) *QuestBuilder {
+	b.quest.Type = questType
+	return b
+}
+
+// Target sets the progress needed to complete the quest (default 1).
+func (b *QuestBuilder) Target(target int) *QuestBuilder {
+	b.quest.Target = target
+	return b
+}
+
+// XPReward sets the base XP awarded on completion (before multipliers).
+func (b *QuestBuilder) XPReward(xp int) *QuestBuilder {
+	b.quest.XPReward = xp
+	return b
+}
+
+// RequiredLevel sets the minimum level needed to start the quest.
+func (b *QuestBuilder) RequiredLevel(level int) *QuestBuilder {
+	b.quest.RequiredLevel = level
+	return b
+}
+
+// Requirements adds conditions beyond the level (achievements, streaks, stats).
+func (b *QuestBuilder) Requirements(requirements ...Requirement) *QuestBuilder {
+	b.quest.Requirements = append(b.quest.Requirements, requirements...)
+	return b
+}
+
+// Template records the built-in template the quest was created from.
+func (b *QuestBuilder) Template(id string) *QuestBuilder {
+	b.quest.Template = id
+	return b
+}
+
+// Repo sets the git repository the quest is tracked in.
+func (b *QuestBuilder) Repo(path string) *QuestBuilder {
+	b.quest.GitRepo = path
+	return b
+}
+
+// Project scopes the quest to one project: only commits in its
+// repositories count.
+func (b *QuestBuilder) Project(name string) *QuestBuilder {
+	b.quest.Project = name
+	return b
+}
+
+// Deadline sets the day the quest is due, shown on the quest calendar.
+func (b *QuestBuilder) Deadline(due time.Time) *QuestBuilder {
+	b.quest.Deadline = &due
+	return b
+}
+
+// Todo links the quest to the TODO/FIXME comment it resolves.
+func (b *QuestBuilder) Todo(todo TodoComment) *QuestBuilder {
+	b.quest.Todo = &todo
+	return b
+}
+
+// Task links the quest to the task list item it ticks off.
+func (b *QuestBuilder) Task(task TaskItem) *QuestBuilder {
+	b.quest.Task = &task
+	return b
+}
+
+// WIP links the quest to the uncommitted work it asks to commit or stash.
+func (b *QuestBuilder) WIP(work UncommittedWork) *QuestBuilder {
+	b.quest.WIP = &work
+	return b
+}
+
+// Generated records the quest catalog draw the quest came from.
+func (b *QuestBuilder) Generated(draw GeneratedQuest) *QuestBuilder {
+	b.quest.Generated = &draw
+	return b
+}
+
+// Build validates the quest and returns it. The builder shouldn't be used
+// again afterwards.
+//
+// Returns:
+//   - *Quest: The quest
+//   - error: Every problem found (missing title or type, unknown type,
+//     target below 1, negative reward or level, todo quest without a comment,
+//     task quest without an item, wip quest without its work)
+func (b *QuestBuilder) Build() (*Quest, error) {
+	quest := b.quest
+
+	var errs []error
+	if strings.TrimSpace(quest.Title) == "" {
+		errs = append(errs, errors.New("title is required"))
+	}
+	switch {
+	case quest.Type == "":
+		errs = append(errs, errors.New("type is required"))
+	case !knownQuestTypes[quest.Type]:
+		errs = append(errs, fmt.Errorf("unknown type %q", quest.Type))
+	}
+	if quest.Target < 1 {
+		errs = append(errs, fmt.Errorf("target must be at least 1 (got %d)", quest.Target))
+	}
+	if quest.XPReward < 0 {
+		errs = append(errs, fmt.Errorf("XP reward can't be negative (got %d)", quest.XPReward))
+	}
+	if quest.RequiredLevel < 0 {
+		errs = append(errs, fmt.Errorf("required level can't be negative (got %d)", quest.RequiredLevel))
+	}
+	if quest.Type == QuestTypeTodo && quest.Todo == nil {
+		errs = append(errs, errors.New("todo quests need the comment they resolve"))
+	}
+	if quest.Type == QuestTypeTask && quest.Task == nil {
+		errs = append(errs, errors.New("task quests need the task list item they tick off"))
+	}
+	if quest.Type == QuestTypeWIP && quest.WIP == nil {
+		errs = append(errs, errors.New("wip quests need the uncommitted work they're for"))
+	}
+	if quest.Deadline != nil && quest.Deadline.IsZero() {
+		errs = append(errs, errors.New("deadline is empty"))
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid quest %q: %w", quest.Title, errors.Join(errs...))
+	}
+	return quest, nil
+}
+
+// MustBuild is Build for quests made from constants (built-in templates,
+// generated quests), where a validation error is a programming mistake.
+//
+// Returns:
+//   - *Quest: The quest (panics if it's invalid)
+func (b *QuestBuilder) MustBuild() *Quest {
+	quest, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return quest
+}
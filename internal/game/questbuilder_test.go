@@ -0,0 +1,83 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestQuestBuilder tests building quests and the validation errors for
+// incomplete ones.
+func TestQuestBuilder(t *testing.T) {
+	due := time.Date(2025, 5, 2, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		build   func() *QuestBuilder
+		wantErr []string // Substrings the error must mention (nil = valid)
+	}{
+		{
+			name: "complete quest",
+			build: func() *QuestBuilder {
+				return NewQuestBuilder().Title("Bug Hunt").Type(QuestTypeCommit).Target(5).XPReward(100).
+					Repo("/src/api").Project("Work").Deadline(due)
+			},
+		},
+		{
+			name:  "defaults to a target of 1",
+			build: func() *QuestBuilder { return NewQuestBuilder().Title("One commit").Type(QuestTypeCommit) },
+		},
+		{
+			name:    "missing title and type",
+			build:   func() *QuestBuilder { return NewQuestBuilder().Target(3) },
+			wantErr: []string{"title is required", "type is required"},
+		},
+		{
+			name: "out of range numbers",
+			build: func() *QuestBuilder {
+				return NewQuestBuilder().Title("Broken").Type(QuestTypeLines).Target(0).XPReward(-5).RequiredLevel(-1)
+			},
+			wantErr: []string{"target must be at least 1", "XP reward can't be negative", "required level can't be negative"},
+		},
+		{
+			name:    "unknown type",
+			build:   func() *QuestBuilder { return NewQuestBuilder().Title("Mystery").Type("dance") },
+			wantErr: []string{`unknown type "dance"`},
+		},
+		{
+			name:    "todo quest without its comment",
+			build:   func() *QuestBuilder { return NewQuestBuilder().Title("TODO: tidy").Type(QuestTypeTodo) },
+			wantErr: []string{"todo quests need the comment"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest, err := tt.build().Build()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Build() error = %v", err)
+				}
+				if quest.ID == "" || quest.Status != QuestAvailable || quest.Target < 1 {
+					t.Errorf("Build() = %+v, want an available quest with an ID", quest)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Build() = %+v, want an error", quest)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Build() error = %q, want it to mention %q", err, want)
+				}
+			}
+		})
+	}
+
+	quest := NewQuestBuilder().Title("Bug Hunt").Type(QuestTypeCommit).Target(5).XPReward(100).
+		RequiredLevel(2).Template("bug-hunt").Repo("/src/api").Project("Work").Deadline(due).MustBuild()
+	if quest.Title != "Bug Hunt" || quest.Target != 5 || quest.XPReward != 100 || quest.RequiredLevel != 2 ||
+		quest.Template != "bug-hunt" || quest.GitRepo != "/src/api" || quest.Project != "Work" || !quest.Deadline.Equal(due) {
+		t.Errorf("MustBuild() = %+v, want every field set", quest)
+	}
+}
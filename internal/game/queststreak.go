@@ -0,0 +1,123 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the quest streak: consecutive days with at least one
+// quest completed, kept apart from the commit streak. Completing a quest on
+// every day of a calendar week (Monday through Sunday) earns a weekly crown,
+// and crowns climb the Crowned achievement track.
+package game
+
+import (
+	"time"
+)
+
+// QuestADayTemplateID is the template of the built-in quest streak meta-quest.
+const QuestADayTemplateID = "quest-a-day"
+
+// QuestCrownTiers is the achievement track for lifetime weekly crowns, in
+// unlock order.
+var QuestCrownTiers = []AchievementTier{
+	{ID: "crowned-1", Name: "Crowned I", Threshold: 1},
+	{ID: "crowned-2", Name: "Crowned II", Threshold: 4},
+	{ID: "crowned-3", Name: "Crowned III", Threshold: 12},
+}
+
+// QuestDayResult is what recording a quest day changed.
+type QuestDayResult struct {
+	Crowned  bool              // A weekly crown was earned
+	Week     string            // The crowned week (WeekKey), when Crowned
+	Unlocked []AchievementTier // Crowned tiers newly unlocked
+}
+
+// RecordQuestDay counts a quest completion toward the quest streak. A second
+// quest the same day doesn't add to it, a quest the next day extends it and
+// a quest after a gap starts it over. The crown for a week is earned on its
+// Sunday, once the streak covers the whole week.
+//
+// Parameters:
+//   - at: When the quest was completed
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - QuestDayResult: Whether a crown was earned and any tiers unlocked
+func (c *Character) RecordQuestDay(at time.Time, clock StreakClock) QuestDayResult {
+	day := clock.Day(at)
+	var last time.Time
+	if !c.LastQuestDay.IsZero() {
+		last = clock.Day(c.LastQuestDay)
+	}
+
+	switch {
+	case !last.IsZero() && day.Before(last):
+		// Completed on an earlier day than already recorded (clock skew)
+		return QuestDayResult{}
+	case !last.IsZero() && day.Equal(last):
+		c.QuestStreak = max(c.QuestStreak, 1)
+	case !last.IsZero() && day.Equal(last.AddDate(0, 0, 1)):
+		c.QuestStreak++
+	default:
+		c.QuestStreak = 1
+	}
+	c.LastQuestDay = at
+	c.LongestQuestStreak = max(c.LongestQuestStreak, c.QuestStreak)
+
+	var result QuestDayResult
+	week := WeekKey(at, clock)
+	if day.Weekday() != time.Sunday || c.QuestStreak < 7 || c.LastCrownWeek == week {
+		return result
+	}
+	c.Crowns++
+	c.LastCrownWeek = week
+	result.Crowned = true
+	result.Week = week
+
+	for _, tier := range QuestCrownTiers {
+		if c.Crowns >= tier.Threshold && c.UnlockAchievement(tier.ID) {
+			result.Unlocked = append(result.Unlocked, tier)
+		}
+	}
+	return result
+}
+
+// CurrentQuestStreak returns the quest streak as it stands now: it lapses
+// once a whole day has passed without a quest.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - int: Consecutive quest days up to today or yesterday (0 if lapsed)
+func (c *Character) CurrentQuestStreak(now time.Time, clock StreakClock) int {
+	if c.LastQuestDay.IsZero() {
+		return 0
+	}
+	if clock.Day(now).Sub(clock.Day(c.LastQuestDay)) > 24*time.Hour {
+		return 0
+	}
+	return c.QuestStreak
+}
+
+// WeekQuestDays reports which days of the current week (Monday first) had a
+// quest completed, from the quest streak.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - [7]bool: Quest completed, by day of the week from Monday
+func (c *Character) WeekQuestDays(now time.Time, clock StreakClock) [7]bool {
+	var days [7]bool
+	if c.QuestStreak == 0 || c.LastQuestDay.IsZero() {
+		return days
+	}
+
+	today := clock.Day(now)
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	last := clock.Day(c.LastQuestDay)
+	first := last.AddDate(0, 0, -(c.QuestStreak - 1))
+	for i := range days {
+		day := monday.AddDate(0, 0, i)
+		days[i] = !day.Before(first) && !day.After(last)
+	}
+	return days
+}
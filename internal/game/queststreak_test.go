@@ -0,0 +1,146 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestRecordQuestDay tests extending, keeping and restarting the quest
+// streak, and earning the weekly crown on a full week's Sunday.
+func TestRecordQuestDay(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	monday := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	sunday := monday.AddDate(0, 0, 6)
+
+	tests := []struct {
+		name        string
+		streak      int
+		last        time.Time
+		crownWeek   string
+		at          time.Time
+		wantStreak  int
+		wantCrowned bool
+	}{
+		{"first quest starts the streak", 0, time.Time{}, "", monday, 1, false},
+		{"same day keeps the streak", 3, monday, "", monday.Add(2 * time.Hour), 3, false},
+		{"next day extends the streak", 3, monday, "", monday.AddDate(0, 0, 1), 4, false},
+		{"gap restarts the streak", 3, monday, "", monday.AddDate(0, 0, 2), 1, false},
+		{"earlier day is ignored", 3, monday, "", monday.AddDate(0, 0, -1), 3, false},
+		{"full week crowns on Sunday", 6, sunday.AddDate(0, 0, -1), "", sunday, 7, true},
+		{"long streak crowns every Sunday", 20, sunday.AddDate(0, 0, -1), "", sunday, 21, true},
+		{"week already crowned", 7, sunday, "2025-W23", sunday.Add(time.Hour), 7, false},
+		{"full week ending midweek", 6, sunday, "", sunday.AddDate(0, 0, 1), 7, false},
+		{"streak too short by Sunday", 5, sunday.AddDate(0, 0, -1), "", sunday, 6, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.QuestStreak, c.LongestQuestStreak, c.LastQuestDay, c.LastCrownWeek = tt.streak, tt.streak, tt.last, tt.crownWeek
+
+			result := c.RecordQuestDay(tt.at, clock)
+			if c.QuestStreak != tt.wantStreak {
+				t.Errorf("QuestStreak = %d, want %d", c.QuestStreak, tt.wantStreak)
+			}
+			if result.Crowned != tt.wantCrowned {
+				t.Errorf("Crowned = %v, want %v", result.Crowned, tt.wantCrowned)
+			}
+			if tt.wantCrowned && (c.Crowns != 1 || result.Week != "2025-W23" || len(result.Unlocked) != 1 || result.Unlocked[0].ID != "crowned-1") {
+				t.Errorf("crowns %d, week %q, unlocked %v; want 1 crown for 2025-W23 unlocking Crowned I", c.Crowns, result.Week, result.Unlocked)
+			}
+			if c.LongestQuestStreak < c.QuestStreak {
+				t.Errorf("LongestQuestStreak = %d, below the streak %d", c.LongestQuestStreak, c.QuestStreak)
+			}
+		})
+	}
+}
+
+// TestWeekQuestDays tests marking this week's quest days from the streak,
+// and the streak lapsing after a day without a quest.
+func TestWeekQuestDays(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	thursday := time.Date(2025, 6, 5, 12, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	c.QuestStreak, c.LastQuestDay = 5, thursday.AddDate(0, 0, -1)
+
+	want := [7]bool{true, true, true, false, false, false, false}
+	if got := c.WeekQuestDays(thursday, clock); got != want {
+		t.Errorf("WeekQuestDays() = %v, want %v", got, want)
+	}
+	if got := c.CurrentQuestStreak(thursday, clock); got != 5 {
+		t.Errorf("CurrentQuestStreak() = %d, want 5 (a quest yesterday keeps it)", got)
+	}
+	if got := c.CurrentQuestStreak(thursday.AddDate(0, 0, 1), clock); got != 0 {
+		t.Errorf("CurrentQuestStreak() = %d, want 0 after a day without quests", got)
+	}
+}
+
+// TestQuestADayThroughHandler tests the Quest a Day meta-quest and the
+// weekly crown as one quest is completed each day from Monday to Sunday.
+func TestQuestADayThroughHandler(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Game.Timezone = "UTC"
+	day := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC) // Monday
+
+	c := NewCharacter("Tester")
+	bus := NewEventBus()
+	var crowns, achievements []Event
+	bus.Subscribe(EventQuestCrown, func(event Event) {
+		crowns = append(crowns, event)
+	})
+	bus.Subscribe(EventAchievement, func(event Event) {
+		achievements = append(achievements, event)
+	})
+
+	var meta *Quest
+	for _, template := range QuestTemplates {
+		if template.ID == QuestADayTemplateID {
+			meta = template.NewQuest()
+		}
+	}
+	if meta == nil {
+		t.Fatal("no Quest a Day template")
+	}
+	if err := meta.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	handler, err := NewGameEventHandler(c, []*Quest{meta}, bus, &memoryStorage{}, cfg)
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	handler.SetClock(func() time.Time { return day })
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	for i := 0; i < 7; i++ {
+		quest := NewQuest(fmt.Sprintf("Day %d", i+1), "", QuestTypeCommit, 1, 10, 1)
+		if err := handler.AddQuest(quest); err != nil {
+			t.Fatalf("AddQuest() error = %v", err)
+		}
+		if err := quest.Start("", ""); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		bus.Publish(NewCommitEvent(fmt.Sprintf("%016x", i+1), "Daily work", 1, 1, 1))
+		if quest.Status != QuestCompleted {
+			t.Fatalf("day %d quest status = %s, want completed", i+1, quest.Status)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	if meta.Status != QuestCompleted {
+		t.Errorf("Quest a Day status = %s (%d/%d), want completed", meta.Status, meta.Current, meta.Target)
+	}
+	if c.QuestStreak != 7 || c.Crowns != 1 || len(crowns) != 1 {
+		t.Errorf("quest streak %d, %d crowns, %d crown events; want 7, 1, 1", c.QuestStreak, c.Crowns, len(crowns))
+	}
+	if len(achievements) != 1 || achievements[0].Data["achievement_id"] != "crowned-1" {
+		t.Errorf("achievements = %v, want Crowned I", achievements)
+	}
+}
@@ -0,0 +1,100 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements ranks: named titles spanning bands of levels
+// (Apprentice for levels 1-5, Journeyman for 6-10, ...), shown next to the
+// level with progress toward the next rank. Reaching a new rank is
+// celebrated apart from ordinary level-ups.
+package game
+
+// Rank is a named title held over a band of levels.
+type Rank struct {
+	Name     string // Display name
+	Icon     string // Emoji shown with the name
+	MinLevel int    // First level of the band
+	MaxLevel int    // Last level of the band (0 for the final, open-ended rank)
+}
+
+// Ranks are the ranks in order, each band starting the level after the
+// previous one ends.
+var Ranks = []Rank{
+	{Name: "Apprentice", Icon: "🔰", MinLevel: 1, MaxLevel: 5},
+	{Name: "Journeyman", Icon: "🛠️", MinLevel: 6, MaxLevel: 10},
+	{Name: "Artisan", Icon: "⚒️", MinLevel: 11, MaxLevel: 20},
+	{Name: "Expert", Icon: "🎯", MinLevel: 21, MaxLevel: 35},
+	{Name: "Master", Icon: "🏅", MinLevel: 36, MaxLevel: 50},
+	{Name: "Grandmaster", Icon: "🌟", MinLevel: 51},
+}
+
+// String returns the rank's icon and name, e.g. "🔰 Apprentice".
+func (r Rank) String() string {
+	return r.Icon + " " + r.Name
+}
+
+// RankForLevel returns the rank held at a level. Levels below 1 are
+// Apprentice.
+//
+// Parameters:
+//   - level: Character level
+//
+// Returns:
+//   - Rank: The rank whose band contains the level
+func RankForLevel(level int) Rank {
+	for _, rank := range Ranks {
+		if rank.MaxLevel == 0 || level <= rank.MaxLevel {
+			return rank
+		}
+	}
+	return Ranks[len(Ranks)-1]
+}
+
+// NextRank returns the rank after the one held at a level.
+//
+// Parameters:
+//   - level: Character level
+//
+// Returns:
+//   - Rank: The next rank
+//   - bool: False at the final rank
+func NextRank(level int) (Rank, bool) {
+	current := RankForLevel(level)
+	if current.MaxLevel == 0 {
+		return Rank{}, false
+	}
+	return RankForLevel(current.MaxLevel + 1), true
+}
+
+// RankUp reports whether going from one level to another reached a new rank.
+//
+// Parameters:
+//   - oldLevel: Level before the level-up
+//   - newLevel: Level reached
+//
+// Returns:
+//   - Rank: The rank at newLevel
+//   - bool: True if it's a higher rank than at oldLevel
+func RankUp(oldLevel, newLevel int) (Rank, bool) {
+	rank := RankForLevel(newLevel)
+	return rank, rank.MinLevel > RankForLevel(oldLevel).MinLevel
+}
+
+// Rank returns the character's current rank.
+func (c *Character) Rank() Rank {
+	return RankForLevel(c.Level)
+}
+
+// RankProgress returns how far the character is through their rank's band,
+// counting XP toward the next level as a partial level.
+//
+// Returns:
+//   - float64: Progress toward the next rank, 0.0 to 1.0 (1.0 at the final rank)
+func (c *Character) RankProgress() float64 {
+	rank := c.Rank()
+	if rank.MaxLevel == 0 {
+		return 1.0
+	}
+
+	levels := float64(max(c.Level-rank.MinLevel, 0))
+	if c.XPToNextLevel > 0 {
+		levels += min(float64(c.XP)/float64(c.XPToNextLevel), 1)
+	}
+	return min(levels/float64(rank.MaxLevel+1-rank.MinLevel), 1.0)
+}
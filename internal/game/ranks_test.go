@@ -0,0 +1,96 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRankForLevel tests the level bands of each rank.
+func TestRankForLevel(t *testing.T) {
+	tests := []struct {
+		level    int
+		want     string
+		wantNext string // "" at the final rank
+	}{
+		{level: 0, want: "Apprentice", wantNext: "Journeyman"},
+		{level: 1, want: "Apprentice", wantNext: "Journeyman"},
+		{level: 5, want: "Apprentice", wantNext: "Journeyman"},
+		{level: 6, want: "Journeyman", wantNext: "Artisan"},
+		{level: 20, want: "Artisan", wantNext: "Expert"},
+		{level: 50, want: "Master", wantNext: "Grandmaster"},
+		{level: 51, want: "Grandmaster"},
+		{level: 99, want: "Grandmaster"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := RankForLevel(tt.level); got.Name != tt.want {
+				t.Errorf("RankForLevel(%d) = %s, want %s", tt.level, got.Name, tt.want)
+			}
+			next, ok := NextRank(tt.level)
+			if ok != (tt.wantNext != "") || next.Name != tt.wantNext {
+				t.Errorf("NextRank(%d) = %q, %v; want %q", tt.level, next.Name, ok, tt.wantNext)
+			}
+		})
+	}
+}
+
+// TestRanksAreContiguous tests that every rank starts the level after the
+// previous one ends, and only the last is open-ended.
+func TestRanksAreContiguous(t *testing.T) {
+	for i, rank := range Ranks {
+		if i > 0 && rank.MinLevel != Ranks[i-1].MaxLevel+1 {
+			t.Errorf("%s starts at %d, want %d", rank.Name, rank.MinLevel, Ranks[i-1].MaxLevel+1)
+		}
+		if (rank.MaxLevel == 0) != (i == len(Ranks)-1) {
+			t.Errorf("%s: only the last rank should be open-ended", rank.Name)
+		}
+	}
+}
+
+// TestRankUp tests spotting level-ups that reach a new rank.
+func TestRankUp(t *testing.T) {
+	tests := []struct {
+		oldLevel, newLevel int
+		want               string // "" if no new rank
+	}{
+		{oldLevel: 4, newLevel: 5},
+		{oldLevel: 5, newLevel: 6, want: "Journeyman"},
+		{oldLevel: 3, newLevel: 12, want: "Artisan"},
+		{oldLevel: 51, newLevel: 52},
+	}
+
+	for _, tt := range tests {
+		rank, ok := RankUp(tt.oldLevel, tt.newLevel)
+		if ok != (tt.want != "") || (ok && rank.Name != tt.want) {
+			t.Errorf("RankUp(%d, %d) = %s, %v; want %q", tt.oldLevel, tt.newLevel, rank.Name, ok, tt.want)
+		}
+	}
+}
+
+// TestRankProgress tests progress through a rank's band of levels.
+func TestRankProgress(t *testing.T) {
+	tests := []struct {
+		name       string
+		level      int
+		xp, toNext int
+		want       float64
+	}{
+		{name: "start of Apprentice", level: 1, toNext: 100, want: 0},
+		{name: "halfway through a level", level: 1, xp: 50, toNext: 100, want: 0.1},
+		{name: "start of the last Apprentice level", level: 5, toNext: 100, want: 0.8},
+		{name: "start of Artisan", level: 11, toNext: 100, want: 0},
+		{name: "Artisan level 16", level: 16, toNext: 100, want: 0.5},
+		{name: "final rank", level: 60, toNext: 100, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Ranked")
+			c.Level, c.XP, c.XPToNextLevel = tt.level, tt.xp, tt.toNext
+			if got := c.RankProgress(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RankProgress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
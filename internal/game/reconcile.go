@@ -0,0 +1,115 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements lines quest reconciliation: progress normally comes
+// from commit events seen while CodeQuest runs, so commits made while it was
+// closed (or events lost to a crash) leave quests behind. Reconciling
+// recounts progress straight from git history instead.
+package game
+
+import (
+	"errors"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotReconcilable is reported for quests that can't be recounted from git
+// because they weren't started with a repository and base commit.
+var ErrNotReconcilable = errors.New("quest has no git starting point")
+
+// LineCounter counts the lines changed in a repository since a base commit.
+// watcher.LinesChangedSince is the implementation used by the CLI.
+type LineCounter func(repoPath, baseSHA string) (int, error)
+
+// QuestReconciliation is the outcome of recounting one lines quest.
+type QuestReconciliation struct {
+	Quest  *Quest // The quest that was recounted
+	Before int    // Progress before reconciling
+	After  int    // Progress after reconciling (same as Before on error)
+	Err    error  // Why the quest couldn't be recounted (nil on success)
+}
+
+// Changed reports whether reconciling moved the quest's progress.
+func (r QuestReconciliation) Changed() bool {
+	return r.Err == nil && r.After != r.Before
+}
+
+// CountsRepo reports whether a lines quest counts commits in a repository.
+// A quest started in a repository at a base commit counts that repository
+// only, the same scope ReconcileLinesQuests recounts from git, so a recount
+// never drops progress the live handler gave it. Other quests count every
+// repository.
+//
+// Parameters:
+//   - repoPath: Root of the repository a commit was made in
+//
+// Returns:
+//   - bool: True if the commit's lines count toward the quest
+func (q *Quest) CountsRepo(repoPath string) bool {
+	if q.GitRepo == "" || q.GitBaseSHA == "" {
+		return true
+	}
+	// The quest may have been started from a directory inside the repository
+	rel, err := filepath.Rel(filepath.Clean(repoPath), filepath.Clean(q.GitRepo))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// ReconcileLinesQuests recomputes the progress of every active lines quest
+// from git history, correcting drift in either direction. Quests that reach
+// their target are completed and rewarded as usual, and state is saved if
+// anything changed.
+//
+// Parameters:
+//   - count: Counts lines changed since a quest's base commit
+//
+// Returns:
+//   - []QuestReconciliation: One result per active lines quest
+func (h *GameEventHandler) ReconcileLinesQuests(count LineCounter) []QuestReconciliation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	results := make([]QuestReconciliation, 0)
+	changed := false
+
+	for _, quest := range h.quests {
+		if quest.Status != QuestActive || quest.Type != QuestTypeLines {
+			continue
+		}
+
+		result := QuestReconciliation{Quest: quest, Before: quest.Current, After: quest.Current}
+		if quest.GitRepo == "" || quest.GitBaseSHA == "" {
+			result.Err = ErrNotReconcilable
+			results = append(results, result)
+			continue
+		}
+
+		lines, err := count(quest.GitRepo, quest.GitBaseSHA)
+		if err != nil {
+			result.Err = err
+			results = append(results, result)
+			continue
+		}
+
+		quest.SetProgress(lines)
+		result.After = quest.Current
+		results = append(results, result)
+		if !result.Changed() {
+			continue
+		}
+
+		changed = true
+		log.Printf("  Reconciled quest '%s': %d → %d/%d lines", quest.Title, result.Before, quest.Current, quest.Target)
+		h.eventBus.Publish(NewQuestProgressEvent(quest.ID, quest.Title, quest.Current, quest.Target))
+
+		if quest.CheckCompletion() {
+			h.completeQuest(quest, h.config.ProjectForRepo(quest.GitRepo))
+		}
+	}
+
+	if changed {
+		if err := h.saveState(); err != nil {
+			log.Printf("ERROR: Failed to save state: %v", err)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,136 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestReconcileLinesQuests tests recounting lines quests from a line counter:
+// drift is corrected either way, reaching the target completes the quest and
+// quests without a git starting point or with a failing count are reported.
+func TestReconcileLinesQuests(t *testing.T) {
+	newLinesQuest := func(title, repo, base string, current int) *Quest {
+		quest := NewQuest(title, "", QuestTypeLines, 500, 100, 1)
+		if err := quest.Start(repo, base); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		quest.SetProgress(current)
+		return quest
+	}
+
+	behind := newLinesQuest("Behind", "/repo/a", "aaa", 120)
+	ahead := newLinesQuest("Ahead", "/repo/a", "bbb", 300)
+	finished := newLinesQuest("Finished", "/repo/b", "ccc", 400)
+	noGit := newLinesQuest("No Git", "", "", 50)
+	broken := newLinesQuest("Broken", "/repo/c", "ddd", 10)
+	commits := NewQuest("Commits", "", QuestTypeCommit, 5, 100, 1)
+
+	counts := map[string]int{"aaa": 340, "bbb": 250, "ccc": 620}
+	counter := func(repoPath, baseSHA string) (int, error) {
+		if lines, ok := counts[baseSHA]; ok {
+			return lines, nil
+		}
+		return 0, errors.New("base commit not on the current branch")
+	}
+
+	character := NewCharacter("Tester")
+	cfg := config.DefaultConfig()
+	cfg.Game.RewardChoiceMinXP = 0
+	store := &memoryStorage{}
+	handler, err := NewGameEventHandler(character, []*Quest{behind, ahead, finished, noGit, broken, commits}, NewEventBus(), store, cfg)
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+
+	results := handler.ReconcileLinesQuests(counter)
+	if len(results) != 5 {
+		t.Fatalf("got %d results, want 5 (one per active lines quest)", len(results))
+	}
+
+	tests := []struct {
+		quest      *Quest
+		wantBefore int
+		wantAfter  int
+		wantErr    bool
+	}{
+		{behind, 120, 340, false},
+		{ahead, 300, 250, false},
+		{finished, 400, 500, false},
+		{noGit, 50, 50, true},
+		{broken, 10, 10, true},
+	}
+	for i, tt := range tests {
+		got := results[i]
+		if got.Quest != tt.quest || got.Before != tt.wantBefore || got.After != tt.wantAfter || (got.Err != nil) != tt.wantErr {
+			t.Errorf("%s: result = {Before: %d, After: %d, Err: %v}, want {%d, %d, err %v}",
+				tt.quest.Title, got.Before, got.After, got.Err, tt.wantBefore, tt.wantAfter, tt.wantErr)
+		}
+	}
+	if !errors.Is(results[3].Err, ErrNotReconcilable) {
+		t.Errorf("quest without git = %v, want ErrNotReconcilable", results[3].Err)
+	}
+
+	if finished.Status != QuestCompleted || finished.Reward == nil {
+		t.Errorf("finished quest status = %s (reward %v), want completed with reward", finished.Status, finished.Reward)
+	}
+	if character.QuestsCompleted != 1 {
+		t.Errorf("QuestsCompleted = %d, want 1", character.QuestsCompleted)
+	}
+	if len(store.quests) == 0 {
+		t.Error("reconciled progress should be saved")
+	}
+}
+
+// TestQuestCountsRepo tests that lines quests started in a repository count
+// commits there only, the scope reconciling recounts.
+func TestQuestCountsRepo(t *testing.T) {
+	tests := []struct {
+		name     string
+		gitRepo  string
+		baseSHA  string
+		repoPath string
+		want     bool
+	}{
+		{"no starting point", "", "", "/repo/b", true},
+		{"repository without a base", "/repo/a", "", "/repo/b", true},
+		{"same repository", "/repo/a", "aaa", "/repo/a", true},
+		{"started in a subdirectory", "/repo/a/internal", "aaa", "/repo/a", true},
+		{"other repository", "/repo/a", "aaa", "/repo/b", false},
+		{"sibling with a shared prefix", "/repo/ab", "aaa", "/repo/a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := &Quest{Type: QuestTypeLines, GitRepo: tt.gitRepo, GitBaseSHA: tt.baseSHA}
+			if got := quest.CountsRepo(tt.repoPath); got != tt.want {
+				t.Errorf("CountsRepo(%q) = %v, want %v", tt.repoPath, got, tt.want)
+			}
+		})
+	}
+
+	// The commit handler applies the same scope
+	quest := NewQuest("Lines", "", QuestTypeLines, 500, 100, 1)
+	if err := quest.Start("/repo/a", "aaa"); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	bus := NewEventBus()
+	handler, err := NewGameEventHandler(NewCharacter("Tester"), []*Quest{quest}, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	for _, repo := range []string{"/repo/a", "/repo/b"} {
+		event := NewCommitEvent("0123456789abcdef", "Work", 1, 30, 10)
+		event.Data["repo_path"] = repo
+		bus.Publish(event)
+	}
+	if quest.Current != 40 {
+		t.Errorf("quest progress = %d, want 40 (lines in /repo/a only)", quest.Current)
+	}
+}
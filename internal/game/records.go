@@ -0,0 +1,208 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements personal records: the player's bests for XP in a day,
+// session length, commit size and quest completion speed.
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// RecordKind identifies a personal record.
+type RecordKind string
+
+const (
+	RecordDayXP          RecordKind = "day_xp"  // Most XP earned in one day
+	RecordLongestSession RecordKind = "session" // Longest timed coding session
+	RecordBiggestCommit  RecordKind = "commit"  // Most lines changed in one commit
+	RecordFastestQuest   RecordKind = "quest"   // Quickest quest from start to completion
+)
+
+// PersonalRecords holds the player's personal bests. A zero value means the
+// record hasn't been set yet.
+type PersonalRecords struct {
+	// Most XP in a day, plus today's running tally toward it
+	MostXPInDay int       `json:"most_xp_in_day,omitempty"` // Best day's XP
+	MostXPDate  time.Time `json:"most_xp_date,omitempty"`   // Day the best was set
+	DayXP       int       `json:"day_xp,omitempty"`         // XP earned on DayXPDate
+	DayXPDate   time.Time `json:"day_xp_date,omitempty"`    // Day DayXP belongs to
+
+	// Longest timed session
+	LongestSession   time.Duration `json:"longest_session,omitempty"`    // Session length
+	LongestSessionAt time.Time     `json:"longest_session_at,omitempty"` // When it was set
+
+	// Biggest single commit (lines added + removed)
+	BiggestCommit    int       `json:"biggest_commit,omitempty"`     // Lines changed
+	BiggestCommitSHA string    `json:"biggest_commit_sha,omitempty"` // The commit
+	BiggestCommitAt  time.Time `json:"biggest_commit_at,omitempty"`  // When it was made
+
+	// Fastest quest completion
+	FastestQuest      time.Duration `json:"fastest_quest,omitempty"`       // Start to completion
+	FastestQuestTitle string        `json:"fastest_quest_title,omitempty"` // The quest
+	FastestQuestAt    time.Time     `json:"fastest_quest_at,omitempty"`    // When it was completed
+}
+
+// RecordBreak describes a personal best that was just beaten.
+type RecordBreak struct {
+	Kind     RecordKind // Which record
+	Previous string     // Old best, formatted for display
+	Current  string     // New best, formatted for display
+}
+
+// Message returns a one-line celebration for the broken record.
+func (b RecordBreak) Message() string {
+	return fmt.Sprintf("%s: %s (was %s)", RecordLabel(b.Kind), b.Current, b.Previous)
+}
+
+// RecordLabel returns the display name of a record.
+//
+// Parameters:
+//   - kind: The record
+//
+// Returns:
+//   - string: Display name (e.g. "Most XP in a day")
+func RecordLabel(kind RecordKind) string {
+	switch kind {
+	case RecordDayXP:
+		return "Most XP in a day"
+	case RecordLongestSession:
+		return "Longest session"
+	case RecordBiggestCommit:
+		return "Biggest commit"
+	case RecordFastestQuest:
+		return "Fastest quest"
+	default:
+		return string(kind)
+	}
+}
+
+// FormatRecordDuration formats session and quest durations for records,
+// e.g. "2h 05m", "12m 30s".
+//
+// Parameters:
+//   - d: The duration
+//
+// Returns:
+//   - string: Compact duration
+func FormatRecordDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm %02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
+
+// RecordDayXP adds XP to today's tally and updates the most-XP-in-a-day
+// record. The record is reported broken only the first time today's tally
+// passes a best set on an earlier day, so one big day celebrates once.
+//
+// Parameters:
+//   - amount: XP just earned
+//   - at: When it was earned
+//
+// Returns:
+//   - *RecordBreak: The broken record, or nil
+func (c *Character) RecordDayXP(amount int, at time.Time) *RecordBreak {
+	if amount <= 0 {
+		return nil
+	}
+	r := &c.Records
+	if !sameDay(r.DayXPDate, at) {
+		r.DayXP = 0
+		r.DayXPDate = at
+	}
+	r.DayXP += amount
+	if r.DayXP <= r.MostXPInDay {
+		return nil
+	}
+
+	previous, setToday := r.MostXPInDay, sameDay(r.MostXPDate, at)
+	r.MostXPInDay = r.DayXP
+	r.MostXPDate = at
+	if previous == 0 || setToday {
+		return nil
+	}
+	return &RecordBreak{Kind: RecordDayXP, Previous: fmt.Sprintf("%d XP", previous), Current: fmt.Sprintf("%d XP", r.DayXP)}
+}
+
+// RecordSession updates the longest session record with the running
+// session's length.
+//
+// Parameters:
+//   - length: Elapsed time of the current session
+//   - at: Current time
+//
+// Returns:
+//   - *RecordBreak: The broken record (nil if not beaten or first ever)
+func (c *Character) RecordSession(length time.Duration, at time.Time) *RecordBreak {
+	r := &c.Records
+	if length <= r.LongestSession {
+		return nil
+	}
+
+	previous := r.LongestSession
+	r.LongestSession = length
+	r.LongestSessionAt = at
+	if previous == 0 {
+		return nil
+	}
+	return &RecordBreak{Kind: RecordLongestSession, Previous: FormatRecordDuration(previous), Current: FormatRecordDuration(length)}
+}
+
+// RecordCommit updates the biggest single commit record.
+//
+// Parameters:
+//   - lines: Lines added plus removed
+//   - sha: The commit hash
+//   - at: When the commit was made
+//
+// Returns:
+//   - *RecordBreak: The broken record (nil if not beaten or first ever)
+func (c *Character) RecordCommit(lines int, sha string, at time.Time) *RecordBreak {
+	r := &c.Records
+	if lines <= r.BiggestCommit {
+		return nil
+	}
+
+	previous := r.BiggestCommit
+	r.BiggestCommit = lines
+	r.BiggestCommitSHA = sha
+	r.BiggestCommitAt = at
+	if previous == 0 {
+		return nil
+	}
+	return &RecordBreak{Kind: RecordBiggestCommit, Previous: fmt.Sprintf("%d lines", previous), Current: fmt.Sprintf("%d lines", lines)}
+}
+
+// RecordQuestCompletion updates the fastest quest record from a completed
+// quest's start and completion times.
+//
+// Parameters:
+//   - quest: A completed quest
+//
+// Returns:
+//   - *RecordBreak: The broken record (nil if not beaten, first ever, or
+//     the quest has no start/completion time)
+func (c *Character) RecordQuestCompletion(quest *Quest) *RecordBreak {
+	if quest.StartedAt == nil || quest.CompletedAt == nil {
+		return nil
+	}
+	took := quest.CompletedAt.Sub(*quest.StartedAt)
+	r := &c.Records
+	if took <= 0 || (r.FastestQuest > 0 && took >= r.FastestQuest) {
+		return nil
+	}
+
+	previous := r.FastestQuest
+	r.FastestQuest = took
+	r.FastestQuestTitle = quest.Title
+	r.FastestQuestAt = *quest.CompletedAt
+	if previous == 0 {
+		return nil
+	}
+	return &RecordBreak{Kind: RecordFastestQuest, Previous: FormatRecordDuration(previous), Current: FormatRecordDuration(took)}
+}
@@ -0,0 +1,104 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRecordDayXP tests the most-XP-in-a-day record and its once-a-day
+// celebration.
+func TestRecordDayXP(t *testing.T) {
+	day1 := time.Date(2025, 3, 10, 10, 0, 0, 0, time.Local)
+	day2 := day1.AddDate(0, 0, 1)
+
+	c := NewCharacter("Tester")
+	if record := c.RecordDayXP(300, day1); record != nil {
+		t.Errorf("first ever day = %+v, want no celebration", record)
+	}
+	if record := c.RecordDayXP(200, day1.Add(time.Hour)); record != nil {
+		t.Errorf("raising today's own best = %+v, want no celebration", record)
+	}
+	if c.Records.MostXPInDay != 500 {
+		t.Errorf("MostXPInDay = %d, want 500", c.Records.MostXPInDay)
+	}
+
+	if record := c.RecordDayXP(400, day2); record != nil {
+		t.Errorf("below the best = %+v, want nil", record)
+	}
+	record := c.RecordDayXP(150, day2.Add(time.Hour))
+	if record == nil || record.Kind != RecordDayXP || record.Previous != "500 XP" || record.Current != "550 XP" {
+		t.Errorf("beating the best = %+v, want 500 XP → 550 XP", record)
+	}
+	if record := c.RecordDayXP(100, day2.Add(2*time.Hour)); record != nil {
+		t.Errorf("second break on the same day = %+v, want no celebration", record)
+	}
+	if c.Records.MostXPInDay != 650 || c.Records.DayXP != 650 {
+		t.Errorf("MostXPInDay = %d, DayXP = %d; want 650 and 650", c.Records.MostXPInDay, c.Records.DayXP)
+	}
+}
+
+// TestRecordCommitAndSession tests the biggest commit and longest session
+// records.
+func TestRecordCommitAndSession(t *testing.T) {
+	now := time.Now()
+	c := NewCharacter("Tester")
+
+	tests := []struct {
+		name      string
+		record    func() *RecordBreak
+		wantBreak bool
+	}{
+		{"first commit sets the record", func() *RecordBreak { return c.RecordCommit(120, "abc1234", now) }, false},
+		{"smaller commit", func() *RecordBreak { return c.RecordCommit(80, "def5678", now) }, false},
+		{"bigger commit", func() *RecordBreak { return c.RecordCommit(300, "0123456", now) }, true},
+		{"first session sets the record", func() *RecordBreak { return c.RecordSession(time.Hour, now) }, false},
+		{"shorter session", func() *RecordBreak { return c.RecordSession(30*time.Minute, now) }, false},
+		{"longer session", func() *RecordBreak { return c.RecordSession(90*time.Minute, now) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.record() != nil; got != tt.wantBreak {
+				t.Errorf("broken = %v, want %v", got, tt.wantBreak)
+			}
+		})
+	}
+
+	if c.Records.BiggestCommit != 300 || c.Records.BiggestCommitSHA != "0123456" {
+		t.Errorf("BiggestCommit = %d (%s), want 300 (0123456)", c.Records.BiggestCommit, c.Records.BiggestCommitSHA)
+	}
+	if c.Records.LongestSession != 90*time.Minute {
+		t.Errorf("LongestSession = %v, want 1h30m", c.Records.LongestSession)
+	}
+}
+
+// TestRecordQuestCompletion tests the fastest quest record.
+func TestRecordQuestCompletion(t *testing.T) {
+	finished := func(title string, took time.Duration) *Quest {
+		quest := NewQuest(title, "", QuestTypeCommit, 1, 100, 1)
+		completed := time.Now()
+		started := completed.Add(-took)
+		quest.Status = QuestCompleted
+		quest.StartedAt = &started
+		quest.CompletedAt = &completed
+		return quest
+	}
+
+	c := NewCharacter("Tester")
+	if record := c.RecordQuestCompletion(NewQuest("Never started", "", QuestTypeCommit, 1, 100, 1)); record != nil {
+		t.Errorf("quest without times = %+v, want nil", record)
+	}
+	if record := c.RecordQuestCompletion(finished("Slow", 2*time.Hour)); record != nil {
+		t.Errorf("first quest = %+v, want no celebration", record)
+	}
+	if record := c.RecordQuestCompletion(finished("Slower", 3*time.Hour)); record != nil {
+		t.Errorf("slower quest = %+v, want nil", record)
+	}
+	record := c.RecordQuestCompletion(finished("Quick", 20*time.Minute))
+	if record == nil || record.Previous != "2h 00m" || record.Current != "20m 00s" {
+		t.Errorf("faster quest = %+v, want 2h 00m → 20m 00s", record)
+	}
+	if c.Records.FastestQuestTitle != "Quick" {
+		t.Errorf("FastestQuestTitle = %q, want Quick", c.Records.FastestQuestTitle)
+	}
+}
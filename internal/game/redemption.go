@@ -0,0 +1,92 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements streak redemption: when a streak longer than
+// RedemptionDays breaks, a one-time Redemption quest is offered. Committing
+// RedemptionDays days in a row completes it and restores half of the lost
+// streak, so one missed day stings without wiping out weeks of work.
+package game
+
+import "fmt"
+
+// RedemptionTemplateID marks the quest that restores half a broken streak.
+const RedemptionTemplateID = "redemption"
+
+// RedemptionDays is how many days in a row the Redemption quest asks for.
+// Only streaks longer than this are worth redeeming.
+const RedemptionDays = 3
+
+// redemptionXP is the Redemption quest's XP reward.
+const redemptionXP = 50
+
+// recordBrokenStreak remembers a streak that just broke so the Redemption
+// quest can be offered for it. Short streaks are let go, which also keeps a
+// redemption attempt that breaks from replacing the streak it was for.
+//
+// Parameters:
+//   - streak: Length of the streak that broke
+func (c *Character) recordBrokenStreak(streak int) {
+	if streak <= RedemptionDays {
+		return
+	}
+	c.LostStreak = streak
+	c.RedemptionOffered = false
+}
+
+// RedeemableDays returns how many streak days completing the Redemption
+// quest would restore.
+//
+// Returns:
+//   - int: Half the lost streak, rounded down (0 if nothing is redeemable)
+func (c *Character) RedeemableDays() int {
+	return c.LostStreak / 2
+}
+
+// RestoreBrokenStreak adds half of the lost streak back onto the current
+// one. The lost streak can only be redeemed once.
+//
+// Returns:
+//   - int: Streak days restored
+func (c *Character) RestoreBrokenStreak() int {
+	restored := c.RedeemableDays()
+	c.CurrentStreak += restored
+	if c.CurrentStreak > c.LongestStreak {
+		c.LongestStreak = c.CurrentStreak
+	}
+	c.LostStreak = 0
+	c.RedemptionOffered = false
+	return restored
+}
+
+// EnsureRedemptionQuest offers the Redemption quest for the streak that
+// broke most recently. It's offered once per broken streak: an abandoned
+// Redemption quest isn't offered again, and an open one is reused.
+//
+// Parameters:
+//   - quests: The player's quests
+//   - c: The character
+//
+// Returns:
+//   - []*Quest: The quests, with the Redemption quest appended if it was added
+//   - bool: True if the quest was added
+func EnsureRedemptionQuest(quests []*Quest, c *Character) ([]*Quest, bool) {
+	if c.LostStreak == 0 || c.RedemptionOffered {
+		return quests, false
+	}
+	c.RedemptionOffered = true
+	for _, quest := range quests {
+		if quest.Template == RedemptionTemplateID && (quest.Status == QuestAvailable || quest.Status == QuestActive) {
+			return quests, false
+		}
+	}
+
+	quest := NewQuestBuilder().
+		Title("Redemption").
+		Description(fmt.Sprintf("Your %d-day streak broke. Commit %d days in a row to win back %d streak days.",
+			c.LostStreak, RedemptionDays, c.RedeemableDays())).
+		Type(QuestTypeStreak).
+		Target(RedemptionDays).
+		XPReward(redemptionXP).
+		RequiredLevel(1).
+		Template(RedemptionTemplateID).
+		MustBuild()
+	return append(quests, quest), true
+}
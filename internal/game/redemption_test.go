@@ -0,0 +1,107 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestRedemptionQuest tests that breaking a long streak offers the
+// Redemption quest once, and that completing it restores half the streak.
+func TestRedemptionQuest(t *testing.T) {
+	tests := []struct {
+		name      string
+		streak    int
+		wantOffer bool
+	}{
+		{"short streak is let go", RedemptionDays, false},
+		{"long streak can be redeemed", 9, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.CurrentStreak, c.LongestStreak = tt.streak, tt.streak
+			c.LastActiveDate = time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC)
+			c.UpdateStreakAt(time.Date(2025, 6, 5, 9, 0, 0, 0, time.UTC), StreakClock{Location: time.UTC})
+
+			quests, added := EnsureRedemptionQuest(nil, c)
+			if added != tt.wantOffer {
+				t.Fatalf("EnsureRedemptionQuest() added = %v, want %v", added, tt.wantOffer)
+			}
+			if !added {
+				return
+			}
+			if quests[0].Template != RedemptionTemplateID || quests[0].Type != QuestTypeStreak || quests[0].Target != RedemptionDays {
+				t.Errorf("quest = %+v, want a %d-day Redemption streak quest", quests[0], RedemptionDays)
+			}
+			if _, added := EnsureRedemptionQuest(nil, c); added {
+				t.Error("the Redemption quest should only be offered once per broken streak")
+			}
+
+			c.CurrentStreak = RedemptionDays
+			if restored := c.RestoreBrokenStreak(); restored != 4 || c.CurrentStreak != RedemptionDays+4 || c.LostStreak != 0 {
+				t.Errorf("RestoreBrokenStreak() = %d, streak %d; want 4, %d", restored, c.CurrentStreak, RedemptionDays+4)
+			}
+			if c.LongestStreak != 9 {
+				t.Errorf("LongestStreak = %d, want 9 (unchanged)", c.LongestStreak)
+			}
+		})
+	}
+}
+
+// TestRedemptionQuestThroughHandler tests offering and completing the
+// Redemption quest as commits come in day by day.
+func TestRedemptionQuestThroughHandler(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Game.Timezone = "UTC"
+	day := time.Date(2025, 6, 5, 12, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	c.CurrentStreak, c.LongestStreak = 10, 10
+	c.LastActiveDate = day.AddDate(0, 0, -3)
+
+	bus := NewEventBus()
+	var broken []Event
+	bus.Subscribe(EventStreakBroken, func(event Event) {
+		broken = append(broken, event)
+	})
+	handler, err := NewGameEventHandler(c, []*Quest{}, bus, &memoryStorage{}, cfg)
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	handler.SetClock(func() time.Time { return day })
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	bus.Publish(NewCommitEvent("0000000000000001", "Back at it", 1, 1, 1))
+	quests := handler.GetQuests()
+	if len(quests) != 1 || quests[0].Template != RedemptionTemplateID || len(broken) != 1 {
+		t.Fatalf("quests = %v, %d broken streak events; want the Redemption quest and one event", quests, len(broken))
+	}
+	if lost, _ := broken[0].Data["lost_days"].(int); lost != 10 {
+		t.Errorf("lost_days = %d, want 10", lost)
+	}
+	redemption := quests[0]
+	if err := redemption.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	for i := 1; i < RedemptionDays; i++ {
+		day = day.AddDate(0, 0, 1)
+		bus.Publish(NewCommitEvent(fmt.Sprintf("%016x", i+1), "Keep going", 1, 1, 1))
+	}
+	if redemption.Status != QuestCompleted {
+		t.Fatalf("Redemption status = %s after %d days in a row, want completed", redemption.Status, RedemptionDays)
+	}
+	if c.CurrentStreak != RedemptionDays+5 || c.LostStreak != 0 {
+		t.Errorf("streak = %d, lost %d; want %d with nothing left to redeem", c.CurrentStreak, c.LostStreak, RedemptionDays+5)
+	}
+	if len(handler.GetQuests()) != 1 {
+		t.Error("the Redemption quest should not be offered again")
+	}
+}
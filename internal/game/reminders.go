@@ -0,0 +1,201 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements per-quest reminders: a time of day (home timezone)
+// at which an active quest nudges the player if its progress is still
+// behind, at most once a day. A nudge can be snoozed, and a reminder can be
+// switched off without losing its settings.
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReminderSnooze is how long snoozing a quest reminder puts the nudge off
+const ReminderSnooze = time.Hour
+
+// QuestReminder nudges the player about an active quest.
+type QuestReminder struct {
+	Hour         int       `json:"hour"`                    // Time of day to nudge (home timezone), 0-23
+	Minute       int       `json:"minute"`                  // Minute past the hour, 0-59
+	Below        float64   `json:"below"`                   // Only nudge while progress is under this fraction (1 = until complete)
+	Disabled     bool      `json:"disabled,omitempty"`      // Switched off (the settings are kept)
+	SnoozedUntil time.Time `json:"snoozed_until,omitempty"` // A snoozed nudge fires again at this time
+	LastNudged   string    `json:"last_nudged,omitempty"`   // Day (YYYY-MM-DD) of the last nudge
+}
+
+// ParseReminder reads a reminder from a time of day ("16:00", "4pm",
+// "4:30pm") and an optional progress threshold ("<50%" or "50%"): the
+// quest only nudges while its progress is under the threshold. Without a
+// threshold it nudges until the quest is complete.
+//
+// Parameters:
+//   - spec: Reminder, e.g. "4pm <50%"
+//
+// Returns:
+//   - QuestReminder: The parsed reminder
+//   - error: An error if the time or threshold can't be read
+func ParseReminder(spec string) (QuestReminder, error) {
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) == 0 || len(fields) > 2 {
+		return QuestReminder{}, fmt.Errorf("want a time and optional threshold, like \"4pm <50%%\"")
+	}
+
+	hour, minute, err := parseTimeOfDay(fields[0])
+	if err != nil {
+		return QuestReminder{}, err
+	}
+	reminder := QuestReminder{Hour: hour, Minute: minute, Below: 1}
+
+	if len(fields) == 2 {
+		threshold := strings.TrimSuffix(strings.TrimPrefix(fields[1], "<"), "%")
+		percent, err := strconv.Atoi(threshold)
+		if err != nil || percent <= 0 || percent > 100 {
+			return QuestReminder{}, fmt.Errorf("invalid threshold %q (want a percentage from 1 to 100)", fields[1])
+		}
+		reminder.Below = float64(percent) / 100
+	}
+	return reminder, nil
+}
+
+// parseTimeOfDay reads "16:00", "16", "4pm" or "4:30am".
+func parseTimeOfDay(s string) (hour, minute int, err error) {
+	suffix := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		suffix = s[len(s)-2:]
+		s = s[:len(s)-2]
+	}
+	hourPart, minutePart, hasMinute := strings.Cut(s, ":")
+
+	hour, err = strconv.Atoi(hourPart)
+	if err == nil && hasMinute {
+		minute, err = strconv.Atoi(minutePart)
+	}
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("invalid time %q (want e.g. 16:00 or 4pm)", s+suffix)
+	}
+
+	switch {
+	case suffix == "" && hour >= 0 && hour <= 23:
+	case suffix != "" && hour >= 1 && hour <= 12:
+		hour %= 12
+		if suffix == "pm" {
+			hour += 12
+		}
+	default:
+		return 0, 0, fmt.Errorf("invalid time %q (want e.g. 16:00 or 4pm)", s+suffix)
+	}
+	return hour, minute, nil
+}
+
+// String describes the reminder, e.g. "16:00 if under 50%", in the format
+// ParseReminder reads back (apart from the wording).
+func (r QuestReminder) String() string {
+	at := fmt.Sprintf("%02d:%02d", r.Hour, r.Minute)
+	if r.Below >= 1 {
+		return at + " until complete"
+	}
+	return fmt.Sprintf("%s if under %.0f%%", at, r.Below*100)
+}
+
+// Spec returns the reminder in the form ParseReminder reads, for editing.
+func (r QuestReminder) Spec() string {
+	spec := fmt.Sprintf("%02d:%02d", r.Hour, r.Minute)
+	if r.Below < 1 {
+		spec += fmt.Sprintf(" <%.0f%%", r.Below*100)
+	}
+	return spec
+}
+
+// SetReminder sets the quest's reminder from a spec (see ParseReminder).
+// An empty spec removes it.
+//
+// Parameters:
+//   - spec: Reminder, e.g. "4pm <50%"
+//
+// Returns:
+//   - error: An error if the spec can't be read (the reminder is unchanged)
+func (q *Quest) SetReminder(spec string) error {
+	if strings.TrimSpace(spec) == "" {
+		q.Reminder = nil
+		return nil
+	}
+	reminder, err := ParseReminder(spec)
+	if err != nil {
+		return err
+	}
+	q.Reminder = &reminder
+	return nil
+}
+
+// SnoozeReminder puts the quest's nudge off for ReminderSnooze.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - error: An error if the quest has no reminder switched on
+func (q *Quest) SnoozeReminder(now time.Time) error {
+	if q.Reminder == nil || q.Reminder.Disabled {
+		return fmt.Errorf("quest has no reminder on")
+	}
+	q.Reminder.SnoozedUntil = now.Add(ReminderSnooze)
+	return nil
+}
+
+// ToggleReminder switches the quest's reminder off, or back on. A pending
+// snooze is dropped either way.
+//
+// Returns:
+//   - bool: True if the reminder is now on
+//   - error: An error if the quest has no reminder
+func (q *Quest) ToggleReminder() (bool, error) {
+	if q.Reminder == nil {
+		return false, fmt.Errorf("quest has no reminder")
+	}
+	q.Reminder.Disabled = !q.Reminder.Disabled
+	q.Reminder.SnoozedUntil = time.Time{}
+	return !q.Reminder.Disabled, nil
+}
+
+// DueReminders returns the active quests whose reminders should nudge now,
+// and records the nudge so each fires at most once a day (plus once when a
+// snooze runs out). Quests whose progress has reached the reminder's
+// threshold stay quiet.
+//
+// Parameters:
+//   - quests: All quests
+//   - now: Current time
+//   - clock: Home timezone for the reminders' times of day
+//
+// Returns:
+//   - []*Quest: Quests to nudge about, in quest order
+func DueReminders(quests []*Quest, now time.Time, clock StreakClock) []*Quest {
+	var due []*Quest
+	for _, quest := range quests {
+		reminder := quest.Reminder
+		if quest.Status != QuestActive || reminder == nil || reminder.Disabled || quest.Progress >= reminder.Below {
+			continue
+		}
+
+		local := now.In(clock.location())
+		today := local.Format(activityDayFormat)
+		if !reminder.SnoozedUntil.IsZero() {
+			if now.Before(reminder.SnoozedUntil) {
+				continue
+			}
+		} else {
+			year, month, day := local.Date()
+			at := time.Date(year, month, day, reminder.Hour, reminder.Minute, 0, 0, local.Location())
+			if reminder.LastNudged == today || local.Before(at) {
+				continue
+			}
+		}
+
+		reminder.SnoozedUntil = time.Time{}
+		reminder.LastNudged = today
+		due = append(due, quest)
+	}
+	return due
+}
@@ -0,0 +1,136 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseReminder tests reading reminder times and progress thresholds.
+func TestParseReminder(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    QuestReminder
+		wantErr bool
+	}{
+		{spec: "16:00", want: QuestReminder{Hour: 16, Below: 1}},
+		{spec: "4pm <50%", want: QuestReminder{Hour: 16, Below: 0.5}},
+		{spec: " 9:30AM 25% ", want: QuestReminder{Hour: 9, Minute: 30, Below: 0.25}},
+		{spec: "12am", want: QuestReminder{Hour: 0, Below: 1}},
+		{spec: "12pm", want: QuestReminder{Hour: 12, Below: 1}},
+		{spec: "7", want: QuestReminder{Hour: 7, Below: 1}},
+		{spec: "", wantErr: true},
+		{spec: "24:00", wantErr: true},
+		{spec: "13pm", wantErr: true},
+		{spec: "4:75pm", wantErr: true},
+		{spec: "teatime", wantErr: true},
+		{spec: "4pm <0%", wantErr: true},
+		{spec: "4pm <150%", wantErr: true},
+		{spec: "4pm <50% daily", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseReminder(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReminder(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReminder(%q) = %+v, want %+v", tt.spec, got, tt.want)
+			}
+			if !tt.wantErr {
+				if again, _ := ParseReminder(got.Spec()); again != got {
+					t.Errorf("Spec() %q reads back as %+v", got.Spec(), again)
+				}
+			}
+		})
+	}
+}
+
+// TestDueReminders tests when quest reminders nudge.
+func TestDueReminders(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	afternoon := time.Date(2026, 3, 10, 16, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   QuestStatus
+		progress int // out of 10
+		reminder QuestReminder
+		now      time.Time
+		want     bool
+	}{
+		{name: "due", status: QuestActive, progress: 2, reminder: QuestReminder{Hour: 16, Below: 0.5}, now: afternoon, want: true},
+		{name: "before the time", status: QuestActive, progress: 2, reminder: QuestReminder{Hour: 17, Below: 0.5}, now: afternoon},
+		{name: "progress at the threshold", status: QuestActive, progress: 5, reminder: QuestReminder{Hour: 16, Below: 0.5}, now: afternoon},
+		{name: "already nudged today", status: QuestActive, reminder: QuestReminder{Hour: 16, Below: 1, LastNudged: "2026-03-10"}, now: afternoon},
+		{name: "nudged yesterday", status: QuestActive, reminder: QuestReminder{Hour: 16, Below: 1, LastNudged: "2026-03-09"}, now: afternoon, want: true},
+		{name: "switched off", status: QuestActive, reminder: QuestReminder{Hour: 16, Below: 1, Disabled: true}, now: afternoon},
+		{name: "quest not active", status: QuestAvailable, reminder: QuestReminder{Hour: 16, Below: 1}, now: afternoon},
+		{name: "snoozed", status: QuestActive, reminder: QuestReminder{Hour: 16, Below: 1, SnoozedUntil: afternoon.Add(time.Minute)}, now: afternoon},
+		{
+			name:     "snooze over after today's nudge",
+			status:   QuestActive,
+			reminder: QuestReminder{Hour: 16, Below: 1, LastNudged: "2026-03-10", SnoozedUntil: afternoon.Add(-time.Minute)},
+			now:      afternoon,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Ship It", "", QuestTypeCommit, 10, 100, 1)
+			quest.Status = tt.status
+			quest.SetProgress(tt.progress)
+			reminder := tt.reminder
+			quest.Reminder = &reminder
+
+			due := DueReminders([]*Quest{quest}, tt.now, clock)
+			if (len(due) == 1) != tt.want {
+				t.Fatalf("due = %d quests, want nudge %v", len(due), tt.want)
+			}
+			if !tt.want {
+				return
+			}
+			if quest.Reminder.LastNudged != "2026-03-10" || !quest.Reminder.SnoozedUntil.IsZero() {
+				t.Errorf("reminder after nudge = %+v, want nudged today and no snooze", quest.Reminder)
+			}
+			if again := DueReminders([]*Quest{quest}, tt.now.Add(time.Hour), clock); len(again) != 0 {
+				t.Error("reminder nudged twice in a day")
+			}
+		})
+	}
+}
+
+// TestQuestReminderControls tests setting, snoozing and switching off a
+// quest's reminder.
+func TestQuestReminderControls(t *testing.T) {
+	now := time.Date(2026, 3, 10, 16, 30, 0, 0, time.UTC)
+	quest := NewQuest("Ship It", "", QuestTypeCommit, 10, 100, 1)
+
+	if err := quest.SnoozeReminder(now); err == nil {
+		t.Error("SnoozeReminder() without a reminder should fail")
+	}
+	if err := quest.SetReminder("not a time"); err == nil || quest.Reminder != nil {
+		t.Fatalf("SetReminder(bad) error = %v, reminder = %+v; want an error and no reminder", err, quest.Reminder)
+	}
+	if err := quest.SetReminder("4pm <50%"); err != nil {
+		t.Fatalf("SetReminder() error = %v", err)
+	}
+	if err := quest.SnoozeReminder(now); err != nil || !quest.Reminder.SnoozedUntil.Equal(now.Add(ReminderSnooze)) {
+		t.Errorf("SnoozeReminder() error = %v, snoozed until %v", err, quest.Reminder.SnoozedUntil)
+	}
+
+	if on, err := quest.ToggleReminder(); err != nil || on || !quest.Reminder.SnoozedUntil.IsZero() {
+		t.Errorf("ToggleReminder() = %v, %v, snooze %v; want off with the snooze dropped", on, err, quest.Reminder.SnoozedUntil)
+	}
+	if err := quest.SnoozeReminder(now); err == nil {
+		t.Error("SnoozeReminder() on a switched-off reminder should fail")
+	}
+	if on, _ := quest.ToggleReminder(); !on || quest.Reminder.Hour != 16 || quest.Reminder.Below != 0.5 {
+		t.Errorf("reminder back on = %+v, want the 16:00 <50%% settings kept", quest.Reminder)
+	}
+
+	if err := quest.SetReminder(""); err != nil || quest.Reminder != nil {
+		t.Errorf("SetReminder(\"\") error = %v, reminder = %+v; want it removed", err, quest.Reminder)
+	}
+}
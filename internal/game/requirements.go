@@ -0,0 +1,154 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RequirementType identifies what a quest requirement checks.
+type RequirementType string
+
+const (
+	// RequirementLevel requires a minimum character level
+	RequirementLevel RequirementType = "level"
+
+	// RequirementAchievement requires an unlocked achievement (Key = achievement ID)
+	RequirementAchievement RequirementType = "achievement"
+
+	// RequirementStreak requires a minimum current daily streak
+	RequirementStreak RequirementType = "streak"
+
+	// RequirementTotalCommits requires a minimum all-time commit count
+	RequirementTotalCommits RequirementType = "total_commits"
+
+	// RequirementQuestsCompleted requires a minimum number of completed quests
+	RequirementQuestsCompleted RequirementType = "quests_completed"
+
+	// RequirementStat requires a minimum RPG stat (Key = code_power, wisdom or agility)
+	RequirementStat RequirementType = "stat"
+)
+
+// Requirement is a single condition a character must meet to start a quest.
+// Requirements are data-only so they can be persisted with quests and
+// defined in quest templates.
+type Requirement struct {
+	Type  RequirementType `json:"type"`          // What to check
+	Key   string          `json:"key,omitempty"` // Achievement ID or stat name, when applicable
+	Value int             `json:"value"`         // Minimum value (ignored for achievements)
+}
+
+// Check evaluates the requirement against a character.
+//
+// Parameters:
+//   - character: The character to check
+//
+// Returns:
+//   - bool: true if the requirement is met
+//   - string: Human-readable reason when not met (empty when met)
+func (r Requirement) Check(character *Character) (bool, string) {
+	if character == nil {
+		return false, "no character loaded"
+	}
+
+	switch r.Type {
+	case RequirementLevel:
+		return atLeast(character.Level, r.Value, "Requires level %d (you are %d)")
+
+	case RequirementAchievement:
+		if character.HasAchievement(r.Key) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("Requires achievement %q", r.Key)
+
+	case RequirementStreak:
+		return atLeast(character.CurrentStreak, r.Value, "Requires a %d-day streak (current: %d)")
+
+	case RequirementTotalCommits:
+		return atLeast(character.TotalCommits, r.Value, "Requires %d total commits (you have %d)")
+
+	case RequirementQuestsCompleted:
+		return atLeast(character.QuestsCompleted, r.Value, "Requires %d completed quests (you have %d)")
+
+	case RequirementStat:
+		value, ok := statValue(character, r.Key)
+		if !ok {
+			return false, fmt.Sprintf("Unknown stat %q", r.Key)
+		}
+		return atLeast(value, r.Value, "Requires "+statLabel(r.Key)+" %d (you have %d)")
+
+	default:
+		return false, fmt.Sprintf("Unknown requirement %q", r.Type)
+	}
+}
+
+// Description returns a short label for the requirement (e.g. "Streak ≥ 5").
+func (r Requirement) Description() string {
+	switch r.Type {
+	case RequirementLevel:
+		return fmt.Sprintf("Level ≥ %d", r.Value)
+	case RequirementAchievement:
+		return fmt.Sprintf("Achievement: %s", r.Key)
+	case RequirementStreak:
+		return fmt.Sprintf("Streak ≥ %d", r.Value)
+	case RequirementTotalCommits:
+		return fmt.Sprintf("Total commits ≥ %d", r.Value)
+	case RequirementQuestsCompleted:
+		return fmt.Sprintf("Quests completed ≥ %d", r.Value)
+	case RequirementStat:
+		return fmt.Sprintf("%s ≥ %d", statLabel(r.Key), r.Value)
+	default:
+		return string(r.Type)
+	}
+}
+
+// CheckRequirements evaluates every requirement and collects the reasons
+// for those that are not met.
+//
+// Parameters:
+//   - requirements: Requirements to check
+//   - character: The character to check against
+//
+// Returns:
+//   - []string: Reasons for unmet requirements (empty if all are met)
+func CheckRequirements(requirements []Requirement, character *Character) []string {
+	reasons := make([]string, 0)
+	for _, requirement := range requirements {
+		if ok, reason := requirement.Check(character); !ok {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+// atLeast compares a value to a minimum and formats the failure reason.
+// The format receives the minimum followed by the actual value.
+func atLeast(actual, minimum int, format string) (bool, string) {
+	if actual >= minimum {
+		return true, ""
+	}
+	return false, fmt.Sprintf(format, minimum, actual)
+}
+
+// statValue looks up an RPG stat by its requirement key.
+func statValue(character *Character, stat string) (int, bool) {
+	field := statField(character, stat)
+	if field == nil {
+		return 0, false
+	}
+	return *field, true
+}
+
+// statLabel returns the display name for a stat key.
+func statLabel(stat string) string {
+	switch strings.ToLower(stat) {
+	case "code_power", "codepower":
+		return "Code Power"
+	case "wisdom":
+		return "Wisdom"
+	case "agility":
+		return "Agility"
+	default:
+		return stat
+	}
+}
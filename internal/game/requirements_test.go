@@ -0,0 +1,196 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRequirementCheck tests evaluation of each requirement type.
+func TestRequirementCheck(t *testing.T) {
+	character := NewCharacter("TestHero")
+	character.Level = 5
+	character.CurrentStreak = 3
+	character.TotalCommits = 40
+	character.QuestsCompleted = 2
+	character.Wisdom = 12
+	character.UnlockAchievement("first_commit")
+
+	tests := []struct {
+		name        string
+		requirement Requirement
+		character   *Character
+		wantOK      bool
+		wantReason  string
+	}{
+		{
+			name:        "level met",
+			requirement: Requirement{Type: RequirementLevel, Value: 5},
+			character:   character,
+			wantOK:      true,
+		},
+		{
+			name:        "level not met",
+			requirement: Requirement{Type: RequirementLevel, Value: 10},
+			character:   character,
+			wantOK:      false,
+			wantReason:  "Requires level 10 (you are 5)",
+		},
+		{
+			name:        "achievement unlocked",
+			requirement: Requirement{Type: RequirementAchievement, Key: "first_commit"},
+			character:   character,
+			wantOK:      true,
+		},
+		{
+			name:        "achievement missing",
+			requirement: Requirement{Type: RequirementAchievement, Key: "bug_slayer"},
+			character:   character,
+			wantOK:      false,
+			wantReason:  `Requires achievement "bug_slayer"`,
+		},
+		{
+			name:        "streak not met",
+			requirement: Requirement{Type: RequirementStreak, Value: 5},
+			character:   character,
+			wantOK:      false,
+			wantReason:  "Requires a 5-day streak (current: 3)",
+		},
+		{
+			name:        "total commits met",
+			requirement: Requirement{Type: RequirementTotalCommits, Value: 40},
+			character:   character,
+			wantOK:      true,
+		},
+		{
+			name:        "quests completed not met",
+			requirement: Requirement{Type: RequirementQuestsCompleted, Value: 3},
+			character:   character,
+			wantOK:      false,
+			wantReason:  "Requires 3 completed quests (you have 2)",
+		},
+		{
+			name:        "stat not met",
+			requirement: Requirement{Type: RequirementStat, Key: "wisdom", Value: 15},
+			character:   character,
+			wantOK:      false,
+			wantReason:  "Requires Wisdom 15 (you have 12)",
+		},
+		{
+			name:        "unknown stat",
+			requirement: Requirement{Type: RequirementStat, Key: "charisma", Value: 1},
+			character:   character,
+			wantOK:      false,
+			wantReason:  `Unknown stat "charisma"`,
+		},
+		{
+			name:        "unknown type",
+			requirement: Requirement{Type: "luck", Value: 1},
+			character:   character,
+			wantOK:      false,
+			wantReason:  `Unknown requirement "luck"`,
+		},
+		{
+			name:        "nil character",
+			requirement: Requirement{Type: RequirementLevel, Value: 1},
+			character:   nil,
+			wantOK:      false,
+			wantReason:  "no character loaded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, reason := tt.requirement.Check(tt.character)
+			if ok != tt.wantOK {
+				t.Errorf("Check() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if reason != tt.wantReason {
+				t.Errorf("Check() reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+// TestQuestLockReasons tests that quests report why they are locked.
+func TestQuestLockReasons(t *testing.T) {
+	character := NewCharacter("TestHero")
+	character.Level = 3
+	character.CurrentStreak = 1
+
+	tests := []struct {
+		name          string
+		requiredLevel int
+		requirements  []Requirement
+		wantReasons   []string
+		wantAvailable bool
+	}{
+		{
+			name:          "no requirements",
+			wantAvailable: true,
+		},
+		{
+			name:          "level too low",
+			requiredLevel: 5,
+			wantReasons:   []string{"level 5"},
+			wantAvailable: false,
+		},
+		{
+			name:          "level and streak both unmet",
+			requiredLevel: 5,
+			requirements:  []Requirement{{Type: RequirementStreak, Value: 7}},
+			wantReasons:   []string{"level 5", "7-day streak"},
+			wantAvailable: false,
+		},
+		{
+			name:          "all requirements met",
+			requiredLevel: 3,
+			requirements:  []Requirement{{Type: RequirementStreak, Value: 1}},
+			wantAvailable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Locked Quest", "desc", QuestTypeCommit, 1, 10, tt.requiredLevel)
+			quest.Requirements = tt.requirements
+
+			reasons := quest.LockReasons(character)
+			if len(reasons) != len(tt.wantReasons) {
+				t.Fatalf("LockReasons() = %v, want %d reasons", reasons, len(tt.wantReasons))
+			}
+			for i, want := range tt.wantReasons {
+				if !strings.Contains(reasons[i], want) {
+					t.Errorf("LockReasons()[%d] = %q, want it to contain %q", i, reasons[i], want)
+				}
+			}
+
+			if got := quest.IsAvailable(character); got != tt.wantAvailable {
+				t.Errorf("IsAvailable() = %v, want %v", got, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+// TestUnlockAchievement tests achievement tracking on the character.
+func TestUnlockAchievement(t *testing.T) {
+	character := NewCharacter("TestHero")
+
+	if character.HasAchievement("first_commit") {
+		t.Error("new character should not have any achievements")
+	}
+	if !character.UnlockAchievement("first_commit") {
+		t.Error("UnlockAchievement() = false on first unlock, want true")
+	}
+	if character.UnlockAchievement("first_commit") {
+		t.Error("UnlockAchievement() = true on repeat unlock, want false")
+	}
+	if character.UnlockAchievement("") {
+		t.Error("UnlockAchievement(\"\") = true, want false")
+	}
+	if !character.HasAchievement("first_commit") {
+		t.Error("HasAchievement() = false after unlock, want true")
+	}
+	if len(character.Achievements) != 1 {
+		t.Errorf("len(Achievements) = %d, want 1", len(character.Achievements))
+	}
+}
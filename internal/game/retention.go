@@ -0,0 +1,139 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements data retention: individual break records are rolled
+// up into daily totals once they're old enough, and daily history (the
+// streak heatmap, break rollups and energy check-ins) is dropped after the
+// configured number of days, so the saved character doesn't grow forever.
+package game
+
+import (
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// RetentionPolicy says how long history is kept.
+type RetentionPolicy struct {
+	RawDays    int         // Days individual break records are kept before being rolled up
+	RollupDays int         // Days daily history is kept before being dropped
+	Clock      StreakClock // Decides which day a record belongs to
+}
+
+// RetentionPolicyFromConfig builds the retention policy from the config,
+// filling in defaults for unset limits.
+//
+// Parameters:
+//   - cfg: Application config (nil uses the defaults)
+//
+// Returns:
+//   - RetentionPolicy: The policy to prune with
+func RetentionPolicyFromConfig(cfg *config.Config) RetentionPolicy {
+	var retention config.RetentionConfig
+	if cfg != nil {
+		retention = cfg.Retention
+	}
+	retention = retention.WithDefaults()
+
+	return RetentionPolicy{
+		RawDays:    retention.RawEventDays,
+		RollupDays: retention.RollupDays,
+		Clock:      StreakClockFromConfig(cfg),
+	}
+}
+
+// BreakTally counts break reminder outcomes for one day.
+type BreakTally struct {
+	Taken   int `json:"taken,omitempty"`
+	Snoozed int `json:"snoozed,omitempty"`
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// add counts one break outcome.
+func (t *BreakTally) add(outcome BreakOutcome) {
+	switch outcome {
+	case BreakTaken:
+		t.Taken++
+	case BreakSnoozed:
+		t.Snoozed++
+	case BreakSkipped:
+		t.Skipped++
+	}
+}
+
+// PruneReport describes what PruneHistory changed.
+type PruneReport struct {
+	BreaksRolledUp int // Break records folded into daily totals
+	DaysDropped    int // Daily entries (heatmap, break totals, energy) removed
+}
+
+// Changed reports whether pruning changed the character.
+func (r PruneReport) Changed() bool {
+	return r.BreaksRolledUp > 0 || r.DaysDropped > 0
+}
+
+// PruneHistory applies a retention policy: break records older than the raw
+// window become daily totals (so lifetime adherence is unchanged), and daily
+// history older than the rollup window is dropped.
+//
+// Parameters:
+//   - policy: How long to keep raw records and daily history
+//   - now: Current time
+//
+// Returns:
+//   - PruneReport: What was rolled up or dropped
+func (c *Character) PruneHistory(policy RetentionPolicy, now time.Time) PruneReport {
+	var report PruneReport
+	today := policy.Clock.Day(now)
+	rawCutoff := today.AddDate(0, 0, -policy.RawDays)
+	rollupCutoff := today.AddDate(0, 0, -policy.RollupDays)
+	rollupKey := rollupCutoff.Format(activityDayFormat)
+
+	// Roll up old break records (the log is oldest first)
+	kept := 0
+	for kept < len(c.BreakLog) && policy.Clock.Day(c.BreakLog[kept].At).Before(rawCutoff) {
+		kept++
+	}
+	if kept > 0 {
+		if c.BreakDays == nil {
+			c.BreakDays = make(map[string]BreakTally)
+		}
+		for _, record := range c.BreakLog[:kept] {
+			key := policy.Clock.Day(record.At).Format(activityDayFormat)
+			tally := c.BreakDays[key]
+			tally.add(record.Outcome)
+			c.BreakDays[key] = tally
+		}
+		c.BreakLog = append([]BreakRecord(nil), c.BreakLog[kept:]...)
+		report.BreaksRolledUp = kept
+	}
+
+	// Drop daily history past the rollup window
+	for key := range c.ActivityDays {
+		if key < rollupKey {
+			delete(c.ActivityDays, key)
+			report.DaysDropped++
+		}
+	}
+	for key := range c.RepoActivityDays {
+		if key < rollupKey {
+			delete(c.RepoActivityDays, key)
+			report.DaysDropped++
+		}
+	}
+	for key := range c.BreakDays {
+		if key < rollupKey {
+			delete(c.BreakDays, key)
+			report.DaysDropped++
+		}
+	}
+	energy := c.EnergyLog[:0]
+	for _, checkIn := range c.EnergyLog {
+		if policy.Clock.Day(checkIn.Date).Before(rollupCutoff) {
+			report.DaysDropped++
+			continue
+		}
+		energy = append(energy, checkIn)
+	}
+	c.EnergyLog = energy
+
+	return report
+}
@@ -0,0 +1,82 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestPruneHistory tests rolling old break records up into daily totals and
+// dropping daily history past the rollup window.
+func TestPruneHistory(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := RetentionPolicy{RawDays: 30, RollupDays: 100, Clock: StreakClock{Location: time.UTC}}
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	c := NewCharacter("Tester")
+	c.RecordBreak(BreakSkipped, time.Hour, daysAgo(120))
+	c.RecordBreak(BreakTaken, time.Hour, daysAgo(40))
+	c.RecordBreak(BreakTaken, time.Hour, daysAgo(40).Add(time.Hour))
+	c.RecordBreak(BreakSkipped, time.Hour, daysAgo(5))
+	c.RecordActivityDay(daysAgo(150), 3, policy.Clock)
+	c.RecordActivityDay(daysAgo(10), 2, policy.Clock)
+	c.EnergyLog = []EnergyCheckIn{{Date: daysAgo(200), Rating: 2}, {Date: daysAgo(1), Rating: 4}}
+	allTime := c.BreakAdherenceSince(time.Time{})
+
+	report := c.PruneHistory(policy, now)
+
+	if report.BreaksRolledUp != 3 {
+		t.Errorf("BreaksRolledUp = %d, want 3", report.BreaksRolledUp)
+	}
+	// The 120-day-old break total, the 150-day-old heatmap day and the
+	// 200-day-old energy check-in are past the rollup window
+	if report.DaysDropped != 3 {
+		t.Errorf("DaysDropped = %d, want 3", report.DaysDropped)
+	}
+	if len(c.BreakLog) != 1 || c.BreakLog[0].Outcome != BreakSkipped {
+		t.Errorf("BreakLog = %+v, want only the recent skipped break", c.BreakLog)
+	}
+	if got := c.BreakDays[daysAgo(40).Format(activityDayFormat)]; got.Taken != 2 {
+		t.Errorf("rolled up day = %+v, want 2 taken", got)
+	}
+	if len(c.ActivityDays) != 1 || len(c.EnergyLog) != 1 {
+		t.Errorf("kept %d heatmap days and %d check-ins, want 1 each", len(c.ActivityDays), len(c.EnergyLog))
+	}
+
+	// Rolled-up breaks still count toward adherence
+	got := c.BreakAdherenceSince(time.Time{})
+	if got.Taken != allTime.Taken || got.Skipped != allTime.Skipped-1 {
+		t.Errorf("all-time adherence = %+v, want %+v less the dropped skip", got, allTime)
+	}
+	if recent := c.BreakAdherenceSince(daysAgo(7)); recent.Taken != 0 || recent.Skipped != 1 {
+		t.Errorf("last week adherence = %+v, want 1 skipped", recent)
+	}
+
+	if c.PruneHistory(policy, now).Changed() {
+		t.Error("pruning twice should change nothing")
+	}
+}
+
+// TestRetentionPolicyFromConfig tests that unset limits use the defaults.
+func TestRetentionPolicyFromConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        *config.Config
+		wantRaw    int
+		wantRollup int
+	}{
+		{"nil config", nil, config.DefaultRawEventDays, config.DefaultRollupDays},
+		{"unset limits", &config.Config{}, config.DefaultRawEventDays, config.DefaultRollupDays},
+		{"custom limits", &config.Config{Retention: config.RetentionConfig{RawEventDays: 14, RollupDays: 730}}, 14, 730},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := RetentionPolicyFromConfig(tt.cfg)
+			if policy.RawDays != tt.wantRaw || policy.RollupDays != tt.wantRollup {
+				t.Errorf("policy = %d/%d days, want %d/%d", policy.RawDays, policy.RollupDays, tt.wantRaw, tt.wantRollup)
+			}
+		})
+	}
+}
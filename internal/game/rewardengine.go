@@ -0,0 +1,147 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements RewardEngine, the single place the XP formula is
+// applied: the commit handler awards what it works out, and the UI and CLI
+// show the same numbers instead of estimating them separately.
+package game
+
+import (
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// RewardEngine works out the XP commits and quests earn from the game
+// settings. The zero value (or a nil config) uses normal difficulty, full
+// replay and bot rates, no squash splitting, no XP rules and no daily budget.
+type RewardEngine struct {
+	config *config.Config
+}
+
+// NewRewardEngine creates a reward engine for the given settings.
+//
+// Parameters:
+//   - cfg: Application configuration (nil uses the defaults above)
+//
+// Returns:
+//   - RewardEngine: The engine
+func NewRewardEngine(cfg *config.Config) RewardEngine {
+	return RewardEngine{config: cfg}
+}
+
+// CommitXP works out the XP a commit event earns the character, step by
+// step, without changing the character. Anomaly checks, which may hold the
+// XP for review, aren't part of the formula.
+//
+// Parameters:
+//   - event: The commit event (lines, message, and the squash_commits,
+//     commit_count, retroactive, bot and timestamp fields when present)
+//   - character: Character receiving the XP, as it was before the commit
+//   - now: When the XP is awarded (for the daily budget)
+//
+// Returns:
+//   - CommitXPEstimate: The XP, step by step
+func (e RewardEngine) CommitXP(event Event, character *Character, now time.Time) CommitXPEstimate {
+	// The budget counts today's commits, so it runs on a copy
+	preview := *character
+	return e.commitXP(event, &preview, now)
+}
+
+// AwardCommitXP is CommitXP for the commit handler: the award is recorded
+// against the character's daily budget.
+//
+// Parameters:
+//   - event: The commit event
+//   - character: Character receiving the XP (its budget is updated)
+//   - now: When the XP is awarded
+//
+// Returns:
+//   - CommitXPEstimate: The XP awarded, step by step
+func (e RewardEngine) AwardCommitXP(event Event, character *Character, now time.Time) CommitXPEstimate {
+	return e.commitXP(event, character, now)
+}
+
+// commitXP applies each step of the commit XP formula, in order: lines,
+// squash merge, difficulty, wisdom, XP boost, replay and bot rates, the
+// custom XP rules, then the daily budget.
+func (e RewardEngine) commitXP(event Event, character *Character, now time.Time) CommitXPEstimate {
+	linesAdded, _ := event.Data["lines_added"].(int)
+	linesRemoved, _ := event.Data["lines_removed"].(int)
+	message, _ := event.Data["message"].(string)
+
+	difficulty := e.difficulty()
+	estimate := CommitXPEstimate{Commit: CalculateCommitXP(0, 0), Mode: difficulty}
+	base := CalculateCommitXP(linesAdded, linesRemoved)
+	estimate.Lines = base - estimate.Commit
+	if commits := e.squashCommits(event, message); commits > 1 {
+		estimate.SquashCommits = commits
+		estimate.Squash = CalculateSquashCommitXP(linesAdded, linesRemoved, commits) - base
+		base += estimate.Squash
+	}
+
+	scaled := ApplyDifficultyMultiplier(base, difficulty)
+	estimate.Difficulty = scaled - base
+	xp := ApplyWisdomBonus(scaled, character.Wisdom)
+	estimate.Wisdom = xp - scaled
+	boosted := character.ApplyXPBoost(xp, commitTime(event))
+	estimate.Boost = boosted - xp
+	xp = boosted
+
+	if retroactive, _ := event.Data["retroactive"].(bool); retroactive && e.config != nil {
+		rated := ApplyReplayRate(xp, e.config.Git.ReplayXPRate)
+		estimate.Replay = rated - xp
+		xp = rated
+	}
+	if bot, _ := event.Data["bot"].(bool); bot && e.config != nil {
+		rated := ApplyReplayRate(xp, e.config.Git.BotXPRate)
+		estimate.Bot = rated - xp
+		xp = rated
+	}
+
+	ruled := ApplyXPRules(xp, event, e.config)
+	estimate.Rules = ruled.XP - xp
+	estimate.RuleNames = ruled.Rules
+	estimate.Flags = ruled.Flags
+	xp = ruled.XP
+
+	estimate.Budget = character.ApplyXPBudget(xp, eventCommits(event), XPBudgetFromConfig(e.config), now)
+	estimate.Total = estimate.Budget.Awarded
+	return estimate
+}
+
+// QuestXP works out the XP a completed quest pays the character.
+//
+// Parameters:
+//   - quest: The completed quest
+//   - character: Character receiving the XP
+//
+// Returns:
+//   - XPBreakdown: The quest's base XP and each bonus
+func (e RewardEngine) QuestXP(quest *Quest, character *Character) XPBreakdown {
+	return QuestRewardBreakdown(quest, e.config, character)
+}
+
+// difficulty returns the configured game difficulty.
+func (e RewardEngine) difficulty() string {
+	if e.config == nil {
+		return DifficultyNormal
+	}
+	return e.config.Game.Difficulty
+}
+
+// squashCommits returns how many commits a squash merge should be scored
+// as, or 0 if the commit isn't a squash merge or splitting is turned off.
+// The GitHub integration's count (event "squash_commits") wins over the
+// commit list parsed from the message, which authors often trim.
+func (e RewardEngine) squashCommits(event Event, message string) int {
+	if e.config == nil || !e.config.Github.SplitSquashMerges {
+		return 0
+	}
+	squash, ok := DetectSquashMerge(message)
+	if !ok {
+		return 0
+	}
+	if count, ok := event.Data["squash_commits"].(int); ok && count > 0 {
+		return count
+	}
+	return squash.Commits
+}
@@ -0,0 +1,207 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestRewardEngineCommitXP tests each step of the commit XP formula as
+// worked out from a commit event.
+func TestRewardEngineCommitXP(t *testing.T) {
+	now := time.Date(2025, 3, 10, 14, 0, 0, 0, time.Local)
+	commit := func(message string, added, removed int, extra map[string]interface{}) Event {
+		event := NewCommitEvent("abc1234def", message, 1, added, removed)
+		event.Timestamp = now
+		for k, v := range extra {
+			event.Data[k] = v
+		}
+		return event
+	}
+	squash := "Add login flow (#42)\n\n* Add login form\n* Wire up session\n* Fix typo"
+
+	tests := []struct {
+		name     string
+		event    Event
+		setup    func(cfg *config.Config, c *Character)
+		want     int
+		wantText string
+	}{
+		{
+			name:     "plain commit",
+			event:    commit("fix: typo", 15, 5, nil),
+			want:     30,
+			wantText: "10 commit +20 lines = 30 XP",
+		},
+		{
+			name:     "hard difficulty",
+			event:    commit("fix: typo", 15, 5, nil),
+			setup:    func(cfg *config.Config, c *Character) { cfg.Game.Difficulty = DifficultyHard },
+			want:     24,
+			wantText: "10 commit +20 lines -6 hard = 24 XP",
+		},
+		{
+			name:     "squash merge split",
+			event:    commit(squash, 90, 0, nil),
+			setup:    func(cfg *config.Config, c *Character) { cfg.Github.SplitSquashMerges = true },
+			want:     CalculateSquashCommitXP(90, 0, 3),
+			wantText: "10 commit +50 lines +60 squash = 120 XP",
+		},
+		{
+			name:     "squash count from github",
+			event:    commit(squash, 90, 0, map[string]interface{}{"squash_commits": 5}),
+			setup:    func(cfg *config.Config, c *Character) { cfg.Github.SplitSquashMerges = true },
+			want:     CalculateSquashCommitXP(90, 0, 5),
+			wantText: "10 commit +50 lines +80 squash = 140 XP",
+		},
+		{
+			name:     "squash merge not split",
+			event:    commit(squash, 90, 0, nil),
+			want:     60,
+			wantText: "10 commit +50 lines = 60 XP",
+		},
+		{
+			name:     "replayed commit",
+			event:    commit("feat: offline work", 30, 0, map[string]interface{}{"retroactive": true}),
+			setup:    func(cfg *config.Config, c *Character) { cfg.Git.ReplayXPRate = 0.5 },
+			want:     20,
+			wantText: "10 commit +30 lines -20 replay = 20 XP",
+		},
+		{
+			name:     "bot commit",
+			event:    commit("chore(deps): bump", 30, 0, map[string]interface{}{"bot": true}),
+			setup:    func(cfg *config.Config, c *Character) { cfg.Git.BotXPRate = 0.25 },
+			want:     10,
+			wantText: "10 commit +30 lines -30 bot = 10 XP",
+		},
+		{
+			name:  "custom XP rule",
+			event: commit("feat: day job", 30, 0, map[string]interface{}{"repo_path": "/src/work/api"}),
+			setup: func(cfg *config.Config, c *Character) {
+				cfg.XPRules = []config.XPRuleConfig{
+					{Name: "work hours", When: []string{"repo in /src/work", "hour >= 9", "hour < 17"}, Multiplier: 0.5},
+					{Name: "evenings", When: []string{"hour >= 17"}, Bonus: 100},
+				}
+			},
+			want:     20,
+			wantText: "10 commit +30 lines -20 rules = 20 XP",
+		},
+		{
+			name:  "boost while it lasts at commit time",
+			event: commit("feat: boosted", 40, 0, nil),
+			setup: func(cfg *config.Config, c *Character) {
+				c.XPBoostUntil = now.Add(time.Minute)
+			},
+			want:     75,
+			wantText: "10 commit +40 lines +25 boost = 75 XP",
+		},
+		{
+			name:  "daily cap",
+			event: commit("feat: late", 40, 0, nil),
+			setup: func(cfg *config.Config, c *Character) {
+				cfg.Game.DailyXPCap = 100
+				c.BudgetDate = truncateToDay(now)
+				c.TodayCommitXP = 80
+			},
+			want:     20,
+			wantText: "10 commit +40 lines -30 daily cap = 20 XP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			character := NewCharacter("Tester")
+			if tt.setup != nil {
+				tt.setup(cfg, character)
+			}
+			engine := NewRewardEngine(cfg)
+
+			got := engine.CommitXP(tt.event, character, now)
+			if got.Total != tt.want {
+				t.Errorf("CommitXP() total = %d, want %d (%s)", got.Total, tt.want, got)
+			}
+			if got.String() != tt.wantText {
+				t.Errorf("CommitXP() = %q, want %q", got.String(), tt.wantText)
+			}
+
+			// Awarding comes to the same XP and spends today's budget
+			spent := character.TodayCommitXP
+			awarded := engine.AwardCommitXP(tt.event, character, now)
+			if !reflect.DeepEqual(awarded, got) {
+				t.Errorf("AwardCommitXP() = %s, want %s", awarded, got)
+			}
+			if character.TodayCommitXP == spent && got.Total > 0 {
+				t.Error("AwardCommitXP() should record the award against the daily budget")
+			}
+		})
+	}
+}
+
+// TestRewardEngineDefaults tests that an engine without a config uses
+// normal difficulty and no budget, rates or squash splitting.
+func TestRewardEngineDefaults(t *testing.T) {
+	event := NewCommitEvent("abc1234def", "Add login flow (#42)\n\n* a\n* b", 1, 20, 0)
+	event.Data["retroactive"] = true
+	event.Data["bot"] = true
+
+	got := NewRewardEngine(nil).CommitXP(event, NewCharacter("Tester"), time.Now())
+	if got.Total != 30 || got.Mode != DifficultyNormal {
+		t.Errorf("CommitXP() = %s (mode %q), want 30 XP on normal", got, got.Mode)
+	}
+}
+
+// TestHandlerPublishesCommitScored tests that the commit handler announces
+// the XP it actually awarded, after the daily budget, and none for XP held
+// for review.
+func TestHandlerPublishesCommitScored(t *testing.T) {
+	tests := []struct {
+		name     string
+		dailyCap int
+		added    int
+		wantXP   int
+		wantHeld bool
+	}{
+		{"awarded", 0, 10, 20, false},
+		{"capped by the daily budget", 15, 10, 15, false},
+		{"held for review", 0, 20000, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.DailyXPCap = tt.dailyCap
+			cfg.Game.FullRateCommits = 0
+			character := NewCharacter("Tester")
+			bus := NewEventBus()
+			var scored []Event
+			bus.Subscribe(EventCommitScored, func(e Event) { scored = append(scored, e) })
+
+			handler, err := NewGameEventHandler(character, []*Quest{}, bus, &memoryStorage{}, cfg)
+			if err != nil {
+				t.Fatalf("NewGameEventHandler() error = %v", err)
+			}
+			if err := handler.Start(); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer handler.Stop()
+
+			bus.Publish(NewCommitEvent("0123456789abcdef", "feat: scored", 1, tt.added, 0))
+
+			if len(scored) != 1 {
+				t.Fatalf("published %d commit scored events, want 1", len(scored))
+			}
+			if got := scored[0].Data["xp_awarded"]; got != tt.wantXP || got != character.TotalXP() {
+				t.Errorf("xp_awarded = %v, want %d (character has %d XP)", got, tt.wantXP, character.TotalXP())
+			}
+			if got := scored[0].Data["held"]; got != tt.wantHeld {
+				t.Errorf("held = %v, want %v", got, tt.wantHeld)
+			}
+			if got := scored[0].Data["sha"]; got != "0123456789abcdef" {
+				t.Errorf("sha = %v, want the commit's", got)
+			}
+		})
+	}
+}
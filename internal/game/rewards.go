@@ -0,0 +1,216 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// RewardKind identifies the kind of reward a completed quest paid out.
+type RewardKind string
+
+const (
+	RewardXP         RewardKind = "xp"          // Fixed XP (the default reward)
+	RewardXPBoost    RewardKind = "xp_boost"    // Temporary commit XP multiplier
+	RewardItem       RewardKind = "item"        // Collectible trophy item
+	RewardSkillPoint RewardKind = "skill_point" // Unspent skill point
+)
+
+// XP boost reward tuning
+const (
+	XPBoostPercent  = 50             // Extra commit XP while the boost is active
+	XPBoostDuration = 24 * time.Hour // How long one boost reward lasts
+)
+
+// QuestReward is the completion record of what a quest paid out.
+type QuestReward struct {
+	Kind      RewardKind   `json:"kind"`                // Which reward was taken
+	XP        int          `json:"xp,omitempty"`        // XP awarded (RewardXP only)
+	Breakdown *XPBreakdown `json:"breakdown,omitempty"` // How the XP was made up (RewardXP only; nil on older records)
+	Item      string       `json:"item,omitempty"`      // Item received (RewardItem only)
+	ClaimedAt time.Time    `json:"claimed_at"`          // When the reward was paid out
+}
+
+// XPBreakdown is how a quest's XP reward was made up at completion time.
+// The parts add up to the XP awarded.
+type XPBreakdown struct {
+	Base       int    `json:"base"`                 // The quest's base XP reward
+	Difficulty int    `json:"difficulty,omitempty"` // Game difficulty bonus (negative on hard)
+	Wisdom     int    `json:"wisdom,omitempty"`     // Character's Wisdom stat bonus
+	Mode       string `json:"mode,omitempty"`       // Game difficulty the bonus was for
+}
+
+// Total returns the XP the breakdown adds up to.
+func (b XPBreakdown) Total() int {
+	return b.Base + b.Difficulty + b.Wisdom
+}
+
+// String describes the breakdown, e.g. "50 base +10 easy +6 wisdom".
+// Bonuses that came to nothing are left out.
+func (b XPBreakdown) String() string {
+	parts := []string{fmt.Sprintf("%d base", b.Base)}
+	if b.Difficulty != 0 {
+		mode := b.Mode
+		if mode == "" {
+			mode = "difficulty"
+		}
+		parts = append(parts, fmt.Sprintf("%+d %s", b.Difficulty, mode))
+	}
+	if b.Wisdom != 0 {
+		parts = append(parts, fmt.Sprintf("%+d wisdom", b.Wisdom))
+	}
+	return strings.Join(parts, " ")
+}
+
+// RewardOption is one choice offered when a quest with a reward choice completes.
+type RewardOption struct {
+	Kind        RewardKind // Reward kind to claim
+	Label       string     // Short name shown in the choice list
+	Description string     // What the player gets
+}
+
+// Item is a collectible earned as a quest reward.
+type Item struct {
+	Name       string    `json:"name"`        // Display name
+	Source     string    `json:"source"`      // Quest the item came from
+	AcquiredAt time.Time `json:"acquired_at"` // When it was claimed
+}
+
+// OffersRewardChoice reports whether completing this quest lets the player
+// pick a reward instead of receiving fixed XP. Only bigger quests qualify.
+//
+// Parameters:
+//   - minXP: Smallest base XP reward that offers a choice (0 disables choices)
+//
+// Returns:
+//   - bool: True if the quest offers a reward choice
+func (q *Quest) OffersRewardChoice(minXP int) bool {
+	return minXP > 0 && q.XPReward >= minXP
+}
+
+// QuestRewardBreakdown splits the XP a quest pays out into its base reward
+// and each bonus, in the order they're applied (difficulty, then wisdom).
+//
+// Parameters:
+//   - quest: The completed quest
+//   - cfg: Application configuration (for difficulty; nil uses normal)
+//   - character: Character receiving the XP (for wisdom)
+//
+// Returns:
+//   - XPBreakdown: The parts of the quest's XP
+func QuestRewardBreakdown(quest *Quest, cfg *config.Config, character *Character) XPBreakdown {
+	difficulty := DifficultyNormal
+	if cfg != nil {
+		difficulty = cfg.Game.Difficulty
+	}
+	scaled := ApplyDifficultyMultiplier(quest.XPReward, difficulty)
+	return XPBreakdown{
+		Base:       quest.XPReward,
+		Difficulty: scaled - quest.XPReward,
+		Wisdom:     ApplyWisdomBonus(scaled, character.Wisdom) - scaled,
+		Mode:       difficulty,
+	}
+}
+
+// rewardItemName names the trophy a quest awards.
+func rewardItemName(quest *Quest) string {
+	return quest.Title + " Trophy"
+}
+
+// RewardOptions lists the rewards a player can pick from for a quest.
+// Fixed XP comes first so it is the default selection.
+//
+// Parameters:
+//   - quest: The completed quest
+//   - xp: XP the quest would pay (see QuestRewardBreakdown)
+//
+// Returns:
+//   - []RewardOption: Available choices
+func RewardOptions(quest *Quest, xp XPBreakdown) []RewardOption {
+	return []RewardOption{
+		{Kind: RewardXP, Label: "Experience", Description: fmt.Sprintf("+%d XP right now (%s)", xp.Total(), xp)},
+		{Kind: RewardXPBoost, Label: "XP Boost", Description: fmt.Sprintf("+%d%% commit XP for %d hours",
+			XPBoostPercent, int(XPBoostDuration.Hours()))},
+		{Kind: RewardItem, Label: "Trophy", Description: fmt.Sprintf("Keep the %s", rewardItemName(quest))},
+		{Kind: RewardSkillPoint, Label: "Skill Point", Description: "+1 skill point"},
+	}
+}
+
+// ClaimQuestReward pays out the reward a player picked for a completed quest
+// and records it on the quest.
+//
+// Parameters:
+//   - quest: Completed quest waiting on a reward choice
+//   - kind: The chosen reward
+//   - xp: XP to award if kind is RewardXP (see QuestRewardBreakdown)
+//   - now: Claim time (starts XP boosts)
+//
+// Returns:
+//   - bool: True if the XP reward caused a level-up
+//   - error: Quest has no pending reward or the kind is unknown
+func (c *Character) ClaimQuestReward(quest *Quest, kind RewardKind, xp XPBreakdown, now time.Time) (bool, error) {
+	if quest.Status != QuestCompleted || !quest.RewardPending {
+		return false, fmt.Errorf("quest %s has no reward to claim", quest.ID)
+	}
+
+	reward := &QuestReward{Kind: kind, ClaimedAt: now}
+	leveledUp := false
+
+	switch kind {
+	case RewardXP:
+		reward.XP = xp.Total()
+		reward.Breakdown = &xp
+		leveledUp = c.AddXP(reward.XP)
+
+	case RewardXPBoost:
+		// Boosts stack by extending the current one
+		start := now
+		if c.XPBoostUntil.After(now) {
+			start = c.XPBoostUntil
+		}
+		c.XPBoostUntil = start.Add(XPBoostDuration)
+
+	case RewardItem:
+		reward.Item = rewardItemName(quest)
+		c.Items = append(c.Items, Item{Name: reward.Item, Source: quest.Title, AcquiredAt: now})
+
+	case RewardSkillPoint:
+		c.SkillPoints++
+
+	default:
+		return false, fmt.Errorf("unknown reward kind %q", kind)
+	}
+
+	quest.RewardPending = false
+	quest.Reward = reward
+	return leveledUp, nil
+}
+
+// XPBoostActive reports whether a quest reward XP boost is running.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - bool: True while the boost lasts
+func (c *Character) XPBoostActive(now time.Time) bool {
+	return c.XPBoostUntil.After(now)
+}
+
+// ApplyXPBoost adds the quest reward boost to commit XP while it is active.
+//
+// Parameters:
+//   - xp: Commit XP before the boost
+//   - now: Time of the commit
+//
+// Returns:
+//   - int: Boosted XP (unchanged when no boost is active)
+func (c *Character) ApplyXPBoost(xp int, now time.Time) int {
+	if !c.XPBoostActive(now) {
+		return xp
+	}
+	return xp + xp*XPBoostPercent/100
+}
@@ -0,0 +1,188 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestOffersRewardChoice tests which quests let the player pick a reward.
+func TestOffersRewardChoice(t *testing.T) {
+	tests := []struct {
+		name     string
+		xpReward int
+		minXP    int
+		want     bool
+	}{
+		{name: "big quest", xpReward: 250, minXP: 200, want: true},
+		{name: "exactly at threshold", xpReward: 200, minXP: 200, want: true},
+		{name: "small quest", xpReward: 50, minXP: 200, want: false},
+		{name: "choices disabled", xpReward: 500, minXP: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := NewQuest("Quest", "", QuestTypeCommit, 5, tt.xpReward, 1)
+			if got := quest.OffersRewardChoice(tt.minXP); got != tt.want {
+				t.Errorf("OffersRewardChoice(%d) = %v, want %v", tt.minXP, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestQuestRewardBreakdown tests that the breakdown's parts add up to the
+// quest XP and name each bonus.
+func TestQuestRewardBreakdown(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty string
+		wisdom     int
+		want       XPBreakdown
+		wantText   string
+	}{
+		{
+			name:       "normal, starting wisdom",
+			difficulty: DifficultyNormal,
+			wisdom:     10,
+			want:       XPBreakdown{Base: 100, Mode: DifficultyNormal},
+			wantText:   "100 base",
+		},
+		{
+			name:       "easy with wisdom",
+			difficulty: DifficultyEasy,
+			wisdom:     20,
+			want:       XPBreakdown{Base: 100, Difficulty: 20, Wisdom: 12, Mode: DifficultyEasy},
+			wantText:   "100 base +20 easy +12 wisdom",
+		},
+		{
+			name:       "hard lowers the reward",
+			difficulty: DifficultyHard,
+			wisdom:     10,
+			want:       XPBreakdown{Base: 100, Difficulty: -20, Mode: DifficultyHard},
+			wantText:   "100 base -20 hard",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.Difficulty = tt.difficulty
+			character := NewCharacter("Tester")
+			character.Wisdom = tt.wisdom
+			quest := NewQuest("Quest", "", QuestTypeCommit, 1, 100, 1)
+
+			got := QuestRewardBreakdown(quest, cfg, character)
+			if got != tt.want {
+				t.Errorf("QuestRewardBreakdown() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.wantText {
+				t.Errorf("String() = %q, want %q", got.String(), tt.wantText)
+			}
+		})
+	}
+}
+
+// TestClaimQuestReward tests that each reward kind is paid out and recorded.
+func TestClaimQuestReward(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	xp := XPBreakdown{Base: 40, Difficulty: 10, Mode: DifficultyEasy}
+
+	tests := []struct {
+		name  string
+		kind  RewardKind
+		check func(t *testing.T, c *Character, reward *QuestReward)
+	}{
+		{
+			name: "experience",
+			kind: RewardXP,
+			check: func(t *testing.T, c *Character, reward *QuestReward) {
+				if c.XP != 50 || reward.XP != 50 {
+					t.Errorf("XP = %d, reward.XP = %d, want 50", c.XP, reward.XP)
+				}
+				if reward.Breakdown == nil || reward.Breakdown.Base != 40 || reward.Breakdown.Difficulty != 10 {
+					t.Errorf("Breakdown = %+v, want 40 base +10 difficulty", reward.Breakdown)
+				}
+			},
+		},
+		{
+			name: "xp boost",
+			kind: RewardXPBoost,
+			check: func(t *testing.T, c *Character, reward *QuestReward) {
+				if !c.XPBoostUntil.Equal(now.Add(XPBoostDuration)) {
+					t.Errorf("XPBoostUntil = %v, want %v", c.XPBoostUntil, now.Add(XPBoostDuration))
+				}
+				if c.XP != 0 {
+					t.Errorf("XP = %d, boost should not award XP", c.XP)
+				}
+			},
+		},
+		{
+			name: "trophy",
+			kind: RewardItem,
+			check: func(t *testing.T, c *Character, reward *QuestReward) {
+				if len(c.Items) != 1 || c.Items[0].Name != "Marathon Trophy" || reward.Item != "Marathon Trophy" {
+					t.Errorf("Items = %+v, reward.Item = %q, want Marathon Trophy", c.Items, reward.Item)
+				}
+			},
+		},
+		{
+			name: "skill point",
+			kind: RewardSkillPoint,
+			check: func(t *testing.T, c *Character, reward *QuestReward) {
+				if c.SkillPoints != 1 {
+					t.Errorf("SkillPoints = %d, want 1", c.SkillPoints)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := NewCharacter("Tester")
+			quest := NewQuest("Marathon", "", QuestTypeCommit, 1, 250, 1)
+			quest.Status = QuestCompleted
+			quest.RewardPending = true
+
+			if _, err := character.ClaimQuestReward(quest, tt.kind, xp, now); err != nil {
+				t.Fatalf("ClaimQuestReward() error = %v", err)
+			}
+			if quest.RewardPending || quest.Reward == nil || quest.Reward.Kind != tt.kind {
+				t.Fatalf("quest reward = %+v (pending %v), want %s recorded", quest.Reward, quest.RewardPending, tt.kind)
+			}
+			tt.check(t, character, quest.Reward)
+
+			// A reward can only be claimed once
+			if _, err := character.ClaimQuestReward(quest, tt.kind, xp, now); err == nil {
+				t.Error("claiming twice should fail")
+			}
+		})
+	}
+}
+
+// TestApplyXPBoost tests that boosts raise commit XP only while active and
+// that claiming another boost extends the current one.
+func TestApplyXPBoost(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	character := NewCharacter("Tester")
+
+	if got := character.ApplyXPBoost(100, now); got != 100 {
+		t.Errorf("ApplyXPBoost() without boost = %d, want 100", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		quest := NewQuest("Boost", "", QuestTypeCommit, 1, 250, 1)
+		quest.Status = QuestCompleted
+		quest.RewardPending = true
+		if _, err := character.ClaimQuestReward(quest, RewardXPBoost, XPBreakdown{}, now); err != nil {
+			t.Fatalf("ClaimQuestReward() error = %v", err)
+		}
+	}
+
+	if got := character.ApplyXPBoost(100, now.Add(30*time.Hour)); got != 150 {
+		t.Errorf("ApplyXPBoost() during stacked boost = %d, want 150", got)
+	}
+	if got := character.ApplyXPBoost(100, now.Add(2*XPBoostDuration)); got != 100 {
+		t.Errorf("ApplyXPBoost() after boost = %d, want 100", got)
+	}
+}
@@ -0,0 +1,76 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the day-rollover scheduler, which runs jobs once at
+// startup and again whenever a new streak day begins while the app is open.
+package game
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DayJob runs at startup and at each day rollover.
+type DayJob func(now time.Time)
+
+// DayScheduler runs jobs at each streak day rollover. Days follow the
+// streak clock, so the rollover happens at midnight in the home timezone
+// plus the grace window, like streaks and daily quests.
+type DayScheduler struct {
+	clock StreakClock
+	now   func() time.Time // Clock source (replaced in tests)
+
+	mu   sync.Mutex
+	jobs []DayJob
+}
+
+// NewDayScheduler creates a scheduler for the given streak clock.
+//
+// Parameters:
+//   - clock: Decides when a new day begins
+//
+// Returns:
+//   - *DayScheduler: A scheduler with no jobs
+func NewDayScheduler(clock StreakClock) *DayScheduler {
+	return &DayScheduler{clock: clock, now: time.Now}
+}
+
+// Add registers a job. Jobs run in the order they were added, one at a time.
+//
+// Parameters:
+//   - job: Called with the current time at startup and each rollover
+func (s *DayScheduler) Add(job DayJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, job)
+}
+
+// Run runs every job right away, then again at each day rollover, until
+// the context is cancelled. It blocks, so call it in a goroutine.
+//
+// Parameters:
+//   - ctx: Stops the scheduler when cancelled
+func (s *DayScheduler) Run(ctx context.Context) {
+	for {
+		now := s.now()
+		s.runJobs(now)
+
+		timer := time.NewTimer(s.clock.NextDay(now).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// runJobs runs every registered job once.
+func (s *DayScheduler) runJobs(now time.Time) {
+	s.mu.Lock()
+	jobs := append([]DayJob(nil), s.jobs...)
+	s.mu.Unlock()
+
+	for _, job := range jobs {
+		job(now)
+	}
+}
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestDayScheduler tests that jobs run at startup and again at rollover.
+func TestDayScheduler(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	start := time.Now()
+	scheduler := NewDayScheduler(clock)
+	scheduler.now = func() time.Time {
+		// Pretend the day ends 50ms after the scheduler started
+		return clock.NextDay(start).Add(-50 * time.Millisecond).Add(time.Since(start))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := make(chan time.Time, 2)
+	scheduler.Add(func(now time.Time) {
+		runs <- now
+		if len(runs) == 2 {
+			cancel()
+		}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		cancel()
+		t.Fatal("scheduler did not run the job again at rollover")
+	}
+
+	first, second := <-runs, <-runs
+	if clock.Day(first).Equal(clock.Day(second)) {
+		t.Errorf("runs at %v and %v fall on the same day, want startup and rollover", first, second)
+	}
+}
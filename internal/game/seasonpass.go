@@ -0,0 +1,224 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the season pass: XP earned during a season (a
+// calendar quarter in the home timezone) climbs a fixed reward track, and
+// each tier reached can be claimed for a title, a cosmetic or a streak
+// freeze. Seasonal XP and unclaimed tiers reset when the next season starts;
+// claimed titles and cosmetics are kept.
+package game
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Season pass reward kinds.
+const (
+	SeasonRewardTitle        = "title"         // A title to show on the character sheet
+	SeasonRewardCosmetic     = "cosmetic"      // A collectible cosmetic
+	SeasonRewardStreakFreeze = "streak_freeze" // A streak freeze
+)
+
+// SeasonTier is one reward on the season pass track.
+type SeasonTier struct {
+	Tier int    // Tier number, from 1
+	XP   int    // Seasonal XP needed to unlock it
+	Kind string // SeasonReward constants
+	Name string // Title or cosmetic name (shown for streak freezes too)
+}
+
+// SeasonTiers is the reward track, the same every season, in tier order.
+var SeasonTiers = []SeasonTier{
+	{Tier: 1, XP: 100, Kind: SeasonRewardCosmetic, Name: "Pixel Scarf"},
+	{Tier: 2, XP: 250, Kind: SeasonRewardStreakFreeze, Name: "Streak Freeze"},
+	{Tier: 3, XP: 500, Kind: SeasonRewardTitle, Name: "the Diligent"},
+	{Tier: 4, XP: 800, Kind: SeasonRewardCosmetic, Name: "Mechanical Keycap"},
+	{Tier: 5, XP: 1200, Kind: SeasonRewardStreakFreeze, Name: "Streak Freeze"},
+	{Tier: 6, XP: 1700, Kind: SeasonRewardTitle, Name: "Bug Slayer"},
+	{Tier: 7, XP: 2300, Kind: SeasonRewardCosmetic, Name: "Terminal Cape"},
+	{Tier: 8, XP: 3000, Kind: SeasonRewardStreakFreeze, Name: "Streak Freeze"},
+	{Tier: 9, XP: 4000, Kind: SeasonRewardCosmetic, Name: "Golden Cursor"},
+	{Tier: 10, XP: 5000, Kind: SeasonRewardTitle, Name: "Legend of the Season"},
+}
+
+// SeasonTierStatus is a tier as it stands for the character this season.
+type SeasonTierStatus struct {
+	SeasonTier
+	Unlocked bool // Enough seasonal XP has been earned
+	Claimed  bool // The reward was claimed
+}
+
+// Claimable reports whether the tier is unlocked and not yet claimed.
+func (s SeasonTierStatus) Claimable() bool {
+	return s.Unlocked && !s.Claimed
+}
+
+// SeasonPass is the character's progress along the current season's track.
+type SeasonPass struct {
+	Season   string             // Season key (e.g. "2026-Q4")
+	XP       int                // Seasonal XP earned
+	DaysLeft int                // Days until the next season starts (1 on the last day)
+	Tiers    []SeasonTierStatus // Every tier, in track order
+}
+
+// Claimable returns how many tiers are waiting to be claimed.
+func (p SeasonPass) Claimable() int {
+	count := 0
+	for _, tier := range p.Tiers {
+		if tier.Claimable() {
+			count++
+		}
+	}
+	return count
+}
+
+// SeasonKey identifies the season the streak day now falls on: its year and
+// calendar quarter (e.g. "2026-Q4").
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - string: The season key
+func SeasonKey(now time.Time, clock StreakClock) string {
+	day := clock.Day(now)
+	return fmt.Sprintf("%d-Q%d", day.Year(), (int(day.Month())-1)/3+1)
+}
+
+// seasonEnd returns the first day of the season after the one now falls in
+// (midnight UTC, as StreakClock.Day).
+func seasonEnd(now time.Time, clock StreakClock) time.Time {
+	day := clock.Day(now)
+	firstMonth := time.Month((int(day.Month())-1)/3*3 + 1)
+	return time.Date(day.Year(), firstMonth, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 3, 0)
+}
+
+// syncSeason starts the character on the current season when a new one has
+// begun, clearing seasonal XP and claimed tiers.
+func (c *Character) syncSeason(now time.Time, clock StreakClock) {
+	if season := SeasonKey(now, clock); c.Season != season {
+		c.Season = season
+		c.SeasonXP = 0
+		c.SeasonClaimed = nil
+	}
+}
+
+// AddSeasonXP counts earned XP toward the season pass.
+//
+// Parameters:
+//   - xp: XP earned (ignored unless positive; spent XP doesn't count against the season)
+//   - now: When it was earned
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - []SeasonTier: Tiers newly unlocked by it, in order
+func (c *Character) AddSeasonXP(xp int, now time.Time, clock StreakClock) []SeasonTier {
+	if xp <= 0 {
+		return nil
+	}
+	c.syncSeason(now, clock)
+	before := c.SeasonXP
+	c.SeasonXP += xp
+
+	var reached []SeasonTier
+	for _, tier := range SeasonTiers {
+		if before < tier.XP && c.SeasonXP >= tier.XP {
+			reached = append(reached, tier)
+		}
+	}
+	return reached
+}
+
+// SeasonPassAt returns the character's progress on the current season's
+// track without changing it: a character last seen in an earlier season
+// starts from zero.
+//
+// Parameters:
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - SeasonPass: The season, its XP and the status of every tier
+func (c *Character) SeasonPassAt(now time.Time, clock StreakClock) SeasonPass {
+	pass := SeasonPass{
+		Season:   SeasonKey(now, clock),
+		DaysLeft: int(seasonEnd(now, clock).Sub(clock.Day(now)).Hours() / 24),
+	}
+	var claimed []int
+	if c.Season == pass.Season {
+		pass.XP = c.SeasonXP
+		claimed = c.SeasonClaimed
+	}
+
+	for _, tier := range SeasonTiers {
+		pass.Tiers = append(pass.Tiers, SeasonTierStatus{
+			SeasonTier: tier,
+			Unlocked:   pass.XP >= tier.XP,
+			Claimed:    slices.Contains(claimed, tier.Tier),
+		})
+	}
+	return pass
+}
+
+// ClaimSeasonTier claims an unlocked tier's reward: a title is unlocked and
+// equipped, a cosmetic joins the collection and a streak freeze is added
+// (only while below MaxStreakFreezes, so a full stock can claim it later).
+//
+// Parameters:
+//   - tier: Tier number to claim
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - SeasonTier: The claimed tier
+//   - error: An error if the tier is unknown, locked, already claimed or its freeze can't be held
+func (c *Character) ClaimSeasonTier(tier int, now time.Time, clock StreakClock) (SeasonTier, error) {
+	index := slices.IndexFunc(SeasonTiers, func(t SeasonTier) bool { return t.Tier == tier })
+	if index < 0 {
+		return SeasonTier{}, fmt.Errorf("no season tier %d", tier)
+	}
+	reward := SeasonTiers[index]
+
+	c.syncSeason(now, clock)
+	switch {
+	case c.SeasonXP < reward.XP:
+		return SeasonTier{}, fmt.Errorf("tier %d needs %d season XP (%d earned)", tier, reward.XP, c.SeasonXP)
+	case slices.Contains(c.SeasonClaimed, tier):
+		return SeasonTier{}, fmt.Errorf("tier %d already claimed this season", tier)
+	}
+
+	switch reward.Kind {
+	case SeasonRewardStreakFreeze:
+		if c.StreakFreezes >= MaxStreakFreezes {
+			return SeasonTier{}, fmt.Errorf("already holding the maximum of %d streak freezes", MaxStreakFreezes)
+		}
+		c.StreakFreezes++
+	case SeasonRewardCosmetic:
+		if !slices.Contains(c.Cosmetics, reward.Name) {
+			c.Cosmetics = append(c.Cosmetics, reward.Name)
+		}
+	case SeasonRewardTitle:
+		if !slices.Contains(c.Titles, reward.Name) {
+			c.Titles = append(c.Titles, reward.Name)
+		}
+		c.Title = reward.Name
+	}
+	c.SeasonClaimed = append(c.SeasonClaimed, tier)
+	return reward, nil
+}
+
+// EquipTitle shows an unlocked title on the character sheet.
+//
+// Parameters:
+//   - title: The title ("" takes the title off)
+//
+// Returns:
+//   - error: An error if the title hasn't been unlocked
+func (c *Character) EquipTitle(title string) error {
+	if title != "" && !slices.Contains(c.Titles, title) {
+		return fmt.Errorf("title %q not unlocked", title)
+	}
+	c.Title = title
+	return nil
+}
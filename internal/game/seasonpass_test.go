@@ -0,0 +1,155 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSeasonKey tests that seasons are calendar quarters of the streak day.
+func TestSeasonKey(t *testing.T) {
+	clock := StreakClock{Location: time.UTC, Grace: 2 * time.Hour}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want string
+	}{
+		{"first quarter", time.Date(2026, 2, 14, 12, 0, 0, 0, time.UTC), "2026-Q1"},
+		{"last day of a quarter", time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC), "2026-Q3"},
+		{"first day of a quarter", time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), "2026-Q4"},
+		{"grace window counts as the prior season", time.Date(2026, 10, 1, 1, 0, 0, 0, time.UTC), "2026-Q3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SeasonKey(tt.at, clock); got != tt.want {
+				t.Errorf("SeasonKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAddSeasonXP tests unlocking tiers with seasonal XP and starting over
+// when a new season begins.
+func TestAddSeasonXP(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	october := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		season    string
+		before    int
+		xp        int
+		wantXP    int
+		wantTiers []int
+	}{
+		{"below the first tier", "2026-Q4", 0, 50, 50, nil},
+		{"reaches a tier", "2026-Q4", 50, 50, 100, []int{1}},
+		{"passes several tiers", "2026-Q4", 90, 500, 590, []int{1, 2, 3}},
+		{"spent XP is ignored", "2026-Q4", 120, -40, 120, nil},
+		{"new season starts over", "2026-Q3", 4000, 120, 120, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.Season, c.SeasonXP, c.SeasonClaimed = tt.season, tt.before, []int{1}
+
+			reached := c.AddSeasonXP(tt.xp, october, clock)
+			if c.SeasonXP != tt.wantXP {
+				t.Errorf("SeasonXP = %d, want %d", c.SeasonXP, tt.wantXP)
+			}
+			var tiers []int
+			for _, tier := range reached {
+				tiers = append(tiers, tier.Tier)
+			}
+			if len(tiers) != len(tt.wantTiers) || (len(tiers) > 0 && tiers[len(tiers)-1] != tt.wantTiers[len(tt.wantTiers)-1]) {
+				t.Errorf("reached tiers %v, want %v", tiers, tt.wantTiers)
+			}
+			if tt.season != "2026-Q4" && (c.Season != "2026-Q4" || len(c.SeasonClaimed) != 0) {
+				t.Errorf("season %q claimed %v, want a fresh 2026-Q4", c.Season, c.SeasonClaimed)
+			}
+		})
+	}
+}
+
+// TestClaimSeasonTier tests claiming each kind of reward and the reasons a
+// claim is refused.
+func TestClaimSeasonTier(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		xp      int
+		freezes int
+		claimed []int
+		tier    int
+		wantErr bool
+		check   func(c *Character) bool
+	}{
+		{"cosmetic joins the collection", 100, 0, nil, 1, false, func(c *Character) bool {
+			return len(c.Cosmetics) == 1 && c.Cosmetics[0] == "Pixel Scarf" && len(c.Items) == 0
+		}},
+		{"streak freeze is added", 250, 0, nil, 2, false, func(c *Character) bool { return c.StreakFreezes == 1 }},
+		{"title is unlocked and equipped", 500, 0, nil, 3, false, func(c *Character) bool {
+			return c.Title == "the Diligent" && len(c.Titles) == 1
+		}},
+		{"locked tier", 499, 0, nil, 3, true, nil},
+		{"already claimed", 500, 0, []int{3}, 3, true, nil},
+		{"streak freeze stock full", 250, MaxStreakFreezes, nil, 2, true, nil},
+		{"unknown tier", 9999, 0, nil, 42, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.Season, c.SeasonXP, c.SeasonClaimed, c.StreakFreezes = SeasonKey(now, clock), tt.xp, tt.claimed, tt.freezes
+
+			_, err := c.ClaimSeasonTier(tt.tier, now, clock)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClaimSeasonTier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(c.SeasonClaimed) != len(tt.claimed) {
+					t.Errorf("SeasonClaimed = %v after a refused claim", c.SeasonClaimed)
+				}
+				return
+			}
+			if !tt.check(c) {
+				t.Errorf("reward not applied: %+v", c)
+			}
+			if pass := c.SeasonPassAt(now, clock); !pass.Tiers[tt.tier-1].Claimed {
+				t.Errorf("tier %d not marked claimed", tt.tier)
+			}
+		})
+	}
+}
+
+// TestSeasonPassAt tests the track as shown for the current season,
+// including a character last seen in an earlier one.
+func TestSeasonPassAt(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2026, 12, 30, 12, 0, 0, 0, time.UTC)
+
+	c := NewCharacter("Tester")
+	c.Season, c.SeasonXP, c.SeasonClaimed = "2026-Q4", 300, []int{1}
+	pass := c.SeasonPassAt(now, clock)
+	if pass.Season != "2026-Q4" || pass.XP != 300 || pass.DaysLeft != 2 {
+		t.Errorf("pass = %s with %d XP and %d days left, want 2026-Q4 with 300 XP and 2 days left", pass.Season, pass.XP, pass.DaysLeft)
+	}
+	if len(pass.Tiers) != len(SeasonTiers) || !pass.Tiers[0].Claimed || !pass.Tiers[1].Claimable() || pass.Tiers[2].Unlocked {
+		t.Errorf("tiers = %+v, want tier 1 claimed, tier 2 claimable, tier 3 locked", pass.Tiers[:3])
+	}
+	if pass.Claimable() != 1 {
+		t.Errorf("Claimable() = %d, want 1", pass.Claimable())
+	}
+
+	next := c.SeasonPassAt(now.AddDate(0, 0, 3), clock)
+	if next.Season != "2027-Q1" || next.XP != 0 || next.Claimable() != 0 {
+		t.Errorf("next season = %s with %d XP, want 2027-Q1 from zero", next.Season, next.XP)
+	}
+	if c.SeasonXP != 300 {
+		t.Error("SeasonPassAt() should not change the character")
+	}
+}
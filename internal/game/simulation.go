@@ -0,0 +1,213 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the simulation harness: scripted commits over
+// simulated days run through the full event handler stack with a fake
+// clock and in-memory storage, so progression outcomes (levels, streaks,
+// quests) can be tuned and regression-tested deterministically.
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// Simulated working day
+const (
+	// simDayStartHour is when a simulated day's first commit is made
+	simDayStartHour = 9
+
+	// simCommitGap is the time between a day's commits, wide enough that
+	// burst detection never holds simulated XP
+	simCommitGap = 45 * time.Minute
+)
+
+// SimCommit is one scripted commit.
+type SimCommit struct {
+	Message      string   // Commit message ("" = "sim: commit <n>")
+	LinesAdded   int      // Lines added
+	LinesRemoved int      // Lines removed
+	Files        []string // Changed paths (for language, docs and tests tracking)
+}
+
+// SimDay is one simulated day of commits. A day without commits is a day off.
+type SimDay struct {
+	Commits []SimCommit
+}
+
+// Simulation runs scripted commits through a GameEventHandler on a fake
+// clock. Events are delivered synchronously, so every run with the same
+// script, config and start time ends in the same state.
+type Simulation struct {
+	Character *Character // The simulated player
+	Quests    []*Quest   // The simulated quest log
+
+	now     time.Time          // Simulated current time
+	bus     *EventBus          // Event bus the handler is subscribed to
+	handler *GameEventHandler  // The handler under simulation
+	storage *simulationStorage // Saves made by the handler
+	events  map[EventType]int  // Events published, by type
+	commits int                // Commits made so far (numbers SHAs and messages)
+}
+
+// NewSimulation creates a fresh level 1 character and starts a handler for
+// it on a fake clock set to start.
+//
+// Parameters:
+//   - cfg: Game configuration (difficulty, budgets, timezone, ...)
+//   - quests: The quest log (nil for none; start quests with StartQuest)
+//   - start: Simulated time the run begins at
+//
+// Returns:
+//   - *Simulation: A running simulation (call Stop when done)
+//   - error: An error if the handler can't be started
+func NewSimulation(cfg *config.Config, quests []*Quest, start time.Time) (*Simulation, error) {
+	character := NewCharacter("Simulated Adventurer")
+	character.CreatedAt = start
+	character.LastActiveDate = start
+	if quests == nil {
+		quests = []*Quest{}
+	}
+
+	s := &Simulation{
+		Character: character,
+		Quests:    quests,
+		now:       start,
+		bus:       NewEventBus(),
+		storage:   &simulationStorage{},
+		events:    make(map[EventType]int),
+	}
+	s.bus.Use(func(event Event) (Event, bool) {
+		s.events[event.Type]++
+		return event, true
+	})
+
+	handler, err := NewGameEventHandler(character, quests, s.bus, s.storage, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating simulated handler: %w", err)
+	}
+	handler.SetClock(s.Now)
+	if err := handler.Start(); err != nil {
+		return nil, fmt.Errorf("starting simulated handler: %w", err)
+	}
+	s.handler = handler
+	return s, nil
+}
+
+// Now returns the simulated current time.
+func (s *Simulation) Now() time.Time {
+	return s.now
+}
+
+// Advance moves the clock forward. Today's stats are reset when a new
+// streak day begins, as at a day rollover in the app.
+//
+// Parameters:
+//   - d: How far to move the clock
+func (s *Simulation) Advance(d time.Duration) {
+	clock := StreakClockFromConfig(s.handler.config)
+	before := clock.Day(s.now)
+	s.now = s.now.Add(d)
+	if clock.Day(s.now).After(before) {
+		s.Character.ResetDailyStats()
+	}
+}
+
+// Commit publishes a commit at the current simulated time and moves the
+// clock on by simCommitGap.
+//
+// Parameters:
+//   - commit: The scripted commit
+func (s *Simulation) Commit(commit SimCommit) {
+	s.commits++
+	message := commit.Message
+	if message == "" {
+		message = fmt.Sprintf("sim: commit %d", s.commits)
+	}
+
+	event := NewCommitEvent(fmt.Sprintf("%040x", s.commits), message, len(commit.Files), commit.LinesAdded, commit.LinesRemoved)
+	event.Timestamp = s.now
+	if commit.Files != nil {
+		event.Data["file_paths"] = commit.Files
+	}
+	s.bus.Publish(event)
+	s.Advance(simCommitGap)
+}
+
+// RunDays plays each day's commits, starting at simDayStartHour on the
+// current day and moving to the next day after each one.
+//
+// Parameters:
+//   - days: The script, one entry per day
+func (s *Simulation) RunDays(days []SimDay) {
+	for _, day := range days {
+		year, month, date := s.now.Date()
+		s.now = time.Date(year, month, date, simDayStartHour, 0, 0, 0, s.now.Location())
+		for _, commit := range day.Commits {
+			s.Commit(commit)
+		}
+		s.Advance(time.Date(year, month, date+1, simDayStartHour, 0, 0, 0, s.now.Location()).Sub(s.now))
+	}
+}
+
+// StartQuest starts a quest from the quest log at the current simulated time.
+//
+// Parameters:
+//   - questID: ID of the quest
+//
+// Returns:
+//   - error: An error if the quest can't be started
+func (s *Simulation) StartQuest(questID string) error {
+	return s.handler.StartQuest(questID, "", "")
+}
+
+// Events returns how many events of a type were published so far (level-ups,
+// quest completions, records, ...).
+//
+// Parameters:
+//   - eventType: The event type to count
+//
+// Returns:
+//   - int: Events of that type
+func (s *Simulation) Events(eventType EventType) int {
+	return s.events[eventType]
+}
+
+// Saves returns how many times the handler persisted state.
+func (s *Simulation) Saves() int {
+	return s.storage.saves
+}
+
+// Stop stops the simulated handler.
+func (s *Simulation) Stop() {
+	s.handler.Stop()
+}
+
+// simulationStorage keeps the simulation's saves in memory.
+type simulationStorage struct {
+	character *Character
+	quests    []*Quest
+	saves     int
+}
+
+func (m *simulationStorage) SaveCharacter(character *Character) error {
+	m.character = character
+	m.saves++
+	return nil
+}
+
+func (m *simulationStorage) LoadCharacter() (*Character, error) {
+	if m.character == nil {
+		return nil, fmt.Errorf("no character saved")
+	}
+	return m.character, nil
+}
+
+func (m *simulationStorage) SaveQuests(quests []*Quest) error {
+	m.quests = quests
+	return nil
+}
+
+func (m *simulationStorage) LoadQuests() ([]*Quest, error) {
+	return m.quests, nil
+}
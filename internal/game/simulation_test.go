@@ -0,0 +1,133 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// simStart is when simulations begin: a Monday morning.
+var simStart = time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+
+// simWorkday is a typical day: a small, a medium and a tiny commit.
+var simWorkday = []SimCommit{{LinesAdded: 40, LinesRemoved: 10}, {LinesAdded: 120, LinesRemoved: 30}, {LinesAdded: 15}}
+
+// simConfig returns the default config on UTC days.
+func simConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Game.Timezone = "UTC"
+	return cfg
+}
+
+// runSimulation plays a script on a fresh character.
+func runSimulation(t *testing.T, cfg *config.Config, quests []*Quest, days []SimDay) *Simulation {
+	t.Helper()
+	sim, err := NewSimulation(cfg, quests, simStart)
+	if err != nil {
+		t.Fatalf("NewSimulation() error = %v", err)
+	}
+	t.Cleanup(sim.Stop)
+	sim.RunDays(days)
+	return sim
+}
+
+// repeatDays returns n days with the same commits.
+func repeatDays(n int, commits ...SimCommit) []SimDay {
+	days := make([]SimDay, n)
+	for i := range days {
+		days[i] = SimDay{Commits: commits}
+	}
+	return days
+}
+
+// TestSimulationProgression pins level, XP and streak outcomes for typical
+// schedules, so balance changes show up as deliberate test updates.
+func TestSimulationProgression(t *testing.T) {
+	withDayOff := repeatDays(14, simWorkday...)
+	withDayOff[9] = SimDay{}
+	weekendsOff := append(append(repeatDays(5, simWorkday...), SimDay{}, SimDay{}), repeatDays(5, simWorkday...)...)
+
+	tests := []struct {
+		name        string
+		difficulty  string
+		days        []SimDay
+		wantLevel   int
+		wantXP      int
+		wantCommits int
+		wantStreak  int
+		wantLongest int
+	}{
+		{"fortnight with a day off", "normal", withDayOff, 6, 867, 39, 4, 9},
+		{"fortnight on hard", "hard", withDayOff, 6, 514, 39, 4, 9},
+		{"one huge commit", "normal", []SimDay{{Commits: []SimCommit{{LinesAdded: 2000}}}}, 1, 60, 1, 1, 1},
+		{"weekends off", "normal", weekendsOff, 6, 174, 30, 5, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := simConfig()
+			cfg.Game.Difficulty = tt.difficulty
+			sim := runSimulation(t, cfg, nil, tt.days)
+			c := sim.Character
+
+			if c.Level != tt.wantLevel || c.XP != tt.wantXP || c.TotalCommits != tt.wantCommits {
+				t.Errorf("level %d (%d XP) after %d commits, want level %d (%d XP) after %d",
+					c.Level, c.XP, c.TotalCommits, tt.wantLevel, tt.wantXP, tt.wantCommits)
+			}
+			if c.CurrentStreak != tt.wantStreak || c.LongestStreak != tt.wantLongest {
+				t.Errorf("streak %d (longest %d), want %d (longest %d)", c.CurrentStreak, c.LongestStreak, tt.wantStreak, tt.wantLongest)
+			}
+			if got := sim.Events(EventLevelUp); got != c.Level-1 {
+				t.Errorf("%d level-up events for level %d", got, c.Level)
+			}
+		})
+	}
+}
+
+// TestSimulationQuests tests quest completion and daily rollover on the
+// simulated clock.
+func TestSimulationQuests(t *testing.T) {
+	cfg := simConfig()
+	cfg.Game.AutoStartQuests = true
+	commitQuest := NewQuest("Five Commits", "", QuestTypeCommit, 5, 100, 1)
+	daily := NewQuest("Daily Grind", "", QuestTypeDaily, 2, 50, 1)
+
+	sim, err := NewSimulation(cfg, []*Quest{commitQuest, daily}, simStart)
+	if err != nil {
+		t.Fatalf("NewSimulation() error = %v", err)
+	}
+	defer sim.Stop()
+	for _, quest := range sim.Quests {
+		if err := sim.StartQuest(quest.ID); err != nil {
+			t.Fatalf("StartQuest(%s) error = %v", quest.Title, err)
+		}
+	}
+	sim.RunDays(repeatDays(3, SimCommit{LinesAdded: 20}, SimCommit{LinesAdded: 20}))
+
+	if commitQuest.Status != QuestCompleted || commitQuest.CompletedAt == nil || commitQuest.CompletedAt.Day() != 5 {
+		t.Errorf("commit quest = %s at %v, want completed on the third day", commitQuest.Status, commitQuest.CompletedAt)
+	}
+	if daily.Status != QuestCompleted || daily.StartedAt == nil || daily.StartedAt.Day() != 5 {
+		t.Errorf("daily quest = %s, started %v; want completed again after restarting on the third day", daily.Status, daily.StartedAt)
+	}
+	if got := sim.Events(EventQuestDone); got != 4 || sim.Character.QuestsCompleted != 4 {
+		t.Errorf("%d quest completions (%d on the character), want the commit quest and three dailies", got, sim.Character.QuestsCompleted)
+	}
+	if sim.Saves() == 0 {
+		t.Error("the handler should have saved state")
+	}
+}
+
+// TestSimulationDeterministic tests that the same script always ends in
+// the same state.
+func TestSimulationDeterministic(t *testing.T) {
+	days := repeatDays(30, simWorkday...)
+	first := runSimulation(t, simConfig(), nil, days).Character
+	second := runSimulation(t, simConfig(), nil, days).Character
+
+	if first.Level != second.Level || first.XP != second.XP || first.LongestStreak != second.LongestStreak || first.Records != second.Records {
+		t.Errorf("runs differ: level %d/%d, XP %d/%d, records %+v/%+v",
+			first.Level, second.Level, first.XP, second.XP, first.Records, second.Records)
+	}
+}
@@ -0,0 +1,103 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxSquashSplit caps how many constituent commits a squash merge is scored
+// as, so one enormous PR can't be farmed for dozens of commits' worth of XP.
+const maxSquashSplit = 20
+
+var (
+	// prReferencePattern matches the "(#123)" GitHub appends to squash merge titles
+	prReferencePattern = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+
+	// squashedCommitPattern matches the per-commit headers `git merge --squash` writes
+	squashedCommitPattern = regexp.MustCompile(`(?m)^commit [0-9a-f]{7,40}$`)
+)
+
+// SquashMerge describes a commit recognized as a squash merge.
+type SquashMerge struct {
+	PRNumber int // Pull request number from the title (0 if none)
+	Commits  int // Constituent commits listed in the message (0 if unknown)
+}
+
+// DetectSquashMerge recognizes squash merges from their commit message.
+//
+// Two formats are recognized:
+//   - GitHub squash merges: the title ends with "(#123)" and the body lists
+//     each squashed commit as a "* subject" bullet
+//   - `git merge --squash`: the message starts with "Squashed commit of the
+//     following:" followed by a "commit <sha>" header per commit
+//
+// A PR reference without a commit list is still a squash merge; its commit
+// count is left at 0 for the GitHub integration to fill in.
+//
+// Parameters:
+//   - message: Full commit message
+//
+// Returns:
+//   - SquashMerge: PR number and constituent commit count
+//   - bool: True if the message looks like a squash merge
+func DetectSquashMerge(message string) (SquashMerge, bool) {
+	message = strings.TrimSpace(message)
+	title, body, _ := strings.Cut(message, "\n")
+
+	if strings.HasPrefix(title, "Squashed commit of the following:") {
+		return SquashMerge{Commits: len(squashedCommitPattern.FindAllString(body, -1))}, true
+	}
+
+	match := prReferencePattern.FindStringSubmatch(title)
+	if match == nil {
+		return SquashMerge{}, false
+	}
+
+	squash := SquashMerge{}
+	squash.PRNumber, _ = strconv.Atoi(match[1])
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "* ") {
+			squash.Commits++
+		}
+	}
+	return squash, true
+}
+
+// CalculateSquashCommitXP scores a squash merge as if its changes had been
+// made in its constituent commits: lines are spread evenly across them and
+// each piece earns commit XP on its own, so the per-commit lines cap doesn't
+// swallow a whole PR's work.
+//
+// Examples:
+//   - 500 lines over 5 commits: 5 × 60 = 300 XP (vs 60 XP as one commit)
+//   - 20 lines over 4 commits: 4 × 15 = 60 XP
+//
+// Parameters:
+//   - linesAdded: Lines added by the squash commit
+//   - linesRemoved: Lines removed by the squash commit
+//   - commits: Constituent commits (capped at maxSquashSplit)
+//
+// Returns:
+//   - int: The base XP earned (before multipliers)
+func CalculateSquashCommitXP(linesAdded, linesRemoved, commits int) int {
+	commits = min(max(commits, 1), maxSquashSplit)
+	linesAdded = max(linesAdded, 0)
+	linesRemoved = max(linesRemoved, 0)
+
+	total := 0
+	for i := 0; i < commits; i++ {
+		// Hand out remainders to the first pieces so no line is lost
+		added := linesAdded / commits
+		if i < linesAdded%commits {
+			added++
+		}
+		removed := linesRemoved / commits
+		if i < linesRemoved%commits {
+			removed++
+		}
+		total += CalculateCommitXP(added, removed)
+	}
+	return total
+}
@@ -0,0 +1,74 @@
+package game
+
+import "testing"
+
+// TestDetectSquashMerge tests recognizing squash merges from commit messages.
+func TestDetectSquashMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    SquashMerge
+		wantOK  bool
+	}{
+		{
+			name:    "github squash with commit list",
+			message: "Add login flow (#42)\n\n* Add login form\n* Wire up session\n* Fix typo\n\nCo-authored-by: A <a@example.com>",
+			want:    SquashMerge{PRNumber: 42, Commits: 3},
+			wantOK:  true,
+		},
+		{
+			name:    "github squash without commit list",
+			message: "Bump deps (#7)",
+			want:    SquashMerge{PRNumber: 7},
+			wantOK:  true,
+		},
+		{
+			name:    "git merge --squash",
+			message: "Squashed commit of the following:\n\ncommit 1a2b3c4d5e6f\nAuthor: A\n\n    one\n\ncommit abcdef1234567\nAuthor: A\n\n    two\n",
+			want:    SquashMerge{Commits: 2},
+			wantOK:  true,
+		},
+		{
+			name:    "regular commit",
+			message: "Fix #12 in the parser",
+			wantOK:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectSquashMerge(tt.message)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("DetectSquashMerge() = %+v, %v; want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestCalculateSquashCommitXP tests splitting a squash merge's lines across
+// its constituent commits.
+func TestCalculateSquashCommitXP(t *testing.T) {
+	tests := []struct {
+		name         string
+		linesAdded   int
+		linesRemoved int
+		commits      int
+		want         int
+	}{
+		{name: "single commit matches commit XP", linesAdded: 500, commits: 1, want: 60},
+		{name: "big PR split five ways", linesAdded: 400, linesRemoved: 100, commits: 5, want: 300},
+		{name: "small PR split evenly", linesAdded: 20, commits: 4, want: 60},
+		{name: "remainders are kept", linesAdded: 7, commits: 2, want: 27},
+		{name: "split is capped", linesAdded: 10000, commits: 100, want: maxSquashSplit * 60},
+		{name: "zero commits treated as one", linesAdded: 10, commits: 0, want: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateSquashCommitXP(tt.linesAdded, tt.linesRemoved, tt.commits); got != tt.want {
+				t.Errorf("CalculateSquashCommitXP(%d, %d, %d) = %d, want %d",
+					tt.linesAdded, tt.linesRemoved, tt.commits, got, tt.want)
+			}
+		})
+	}
+}
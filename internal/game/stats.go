@@ -0,0 +1,249 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	// StatPointsPerLevel is how many skill points each level-up grants
+	StatPointsPerLevel = 3
+
+	// BaseStatValue is where every allocatable stat starts (and returns to on respec)
+	BaseStatValue = 10
+
+	// maxStatAllocations caps the stored allocation history (oldest entries are dropped)
+	maxStatAllocations = 500
+)
+
+// StatKeys lists the allocatable stats in display order.
+var StatKeys = []string{"code_power", "wisdom", "agility"}
+
+// StatAllocationKind distinguishes spending points from a respec.
+type StatAllocationKind string
+
+const (
+	StatAllocated StatAllocationKind = "allocate" // Points spent on a stat
+	StatRespec    StatAllocationKind = "respec"   // All allocated points refunded
+)
+
+// StatAllocation is one entry in the allocation history.
+type StatAllocation struct {
+	At     time.Time          `json:"at"`                // When it happened
+	Kind   StatAllocationKind `json:"kind"`              // Allocate or respec
+	Stat   string             `json:"stat,omitempty"`    // Stat key (allocations only)
+	Points int                `json:"points"`            // Points spent, or refunded by a respec
+	XPCost int                `json:"xp_cost,omitempty"` // XP paid (respecs only)
+}
+
+// statField returns a pointer to the stat named by a stat key.
+func statField(character *Character, stat string) *int {
+	switch strings.ToLower(stat) {
+	case "code_power", "codepower":
+		return &character.CodePower
+	case "wisdom":
+		return &character.Wisdom
+	case "agility":
+		return &character.Agility
+	default:
+		return nil
+	}
+}
+
+// StatLabel returns the display name for a stat key (e.g. "code_power" → "Code Power").
+func StatLabel(stat string) string {
+	return statLabel(stat)
+}
+
+// StatValue returns the current value of the stat named by a stat key.
+//
+// Parameters:
+//   - stat: Stat key (code_power, wisdom or agility)
+//
+// Returns:
+//   - int: The stat's value
+//   - bool: False for an unknown stat
+func (c *Character) StatValue(stat string) (int, bool) {
+	return statValue(c, stat)
+}
+
+// AllocateStatPoint spends one skill point on a stat.
+//
+// Parameters:
+//   - stat: Stat key (code_power, wisdom or agility)
+//   - now: When the point was spent
+//
+// Returns:
+//   - error: No unspent points, or an unknown stat
+func (c *Character) AllocateStatPoint(stat string, now time.Time) error {
+	field := statField(c, stat)
+	if field == nil {
+		return fmt.Errorf("unknown stat %q", stat)
+	}
+	if c.SkillPoints <= 0 {
+		return fmt.Errorf("no skill points to spend")
+	}
+
+	c.SkillPoints--
+	*field++
+	c.logStatAllocation(StatAllocation{At: now, Kind: StatAllocated, Stat: strings.ToLower(stat), Points: 1})
+	return nil
+}
+
+// AllocatedStatPoints counts the points invested above the base value across
+// all allocatable stats (what a respec would refund).
+//
+// Returns:
+//   - int: Refundable points
+func (c *Character) AllocatedStatPoints() int {
+	total := 0
+	for _, stat := range StatKeys {
+		total += max(*statField(c, stat)-BaseStatValue, 0)
+	}
+	return total
+}
+
+// RespecRefund counts the points a respec gives back: those invested above
+// the base value plus any that faded during an absence.
+//
+// Returns:
+//   - int: Refundable points
+func (c *Character) RespecRefund() int {
+	return c.AllocatedStatPoints() + c.DecayedPoints()
+}
+
+// RespecCost is the XP a respec costs: a quarter of the current level's XP
+// requirement, so it's affordable but not free to flip-flop.
+//
+// Returns:
+//   - int: XP cost
+func (c *Character) RespecCost() int {
+	return c.XPToNextLevel / 4
+}
+
+// Respec resets every allocatable stat to its base value and refunds the
+// invested points, including any that faded during an absence, paying
+// RespecCost from the current level's XP (it never costs a level).
+//
+// Parameters:
+//   - now: When the respec happened
+//
+// Returns:
+//   - int: Points refunded
+//   - error: Nothing to refund, or not enough XP
+func (c *Character) Respec(now time.Time) (int, error) {
+	refund := c.RespecRefund()
+	if refund == 0 {
+		return 0, fmt.Errorf("no allocated points to refund")
+	}
+	cost := c.RespecCost()
+	if c.XP < cost {
+		return 0, fmt.Errorf("respec costs %d XP, you have %d", cost, c.XP)
+	}
+
+	c.XP -= cost
+	for _, stat := range StatKeys {
+		*statField(c, stat) = min(*statField(c, stat), BaseStatValue)
+	}
+	c.DecayedStats = nil
+	c.SkillPoints += refund
+	c.logStatAllocation(StatAllocation{At: now, Kind: StatRespec, Points: refund, XPCost: cost})
+	return refund, nil
+}
+
+// logStatAllocation appends to the allocation history, dropping the oldest entries.
+func (c *Character) logStatAllocation(entry StatAllocation) {
+	c.StatAllocations = append(c.StatAllocations, entry)
+	if len(c.StatAllocations) > maxStatAllocations {
+		c.StatAllocations = c.StatAllocations[len(c.StatAllocations)-maxStatAllocations:]
+	}
+}
+
+// StatEffect is what a stat does at its current value, worked out from the
+// same formulas the engine uses so the character sheet can't drift from it.
+type StatEffect struct {
+	Stat    string   // Stat key
+	Value   int      // Current value
+	Summary string   // The effect in one line, e.g. "Wisdom 14 → +4% XP"
+	Details []string // The formula, what the next point adds and other effects
+}
+
+// StatEffects describes the concrete effect of each allocatable stat.
+// Wisdom multiplies XP; Code Power and Agility unlock stat-gated quests and
+// are the stats that fade during long absences.
+//
+// Parameters:
+//   - character: The character
+//   - quests: The quest log (for stat-gated quests)
+//   - decay: The stat decay policy
+//
+// Returns:
+//   - []StatEffect: One entry per stat, in StatKeys order
+func StatEffects(character *Character, quests []*Quest, decay StatDecay) []StatEffect {
+	effects := make([]StatEffect, 0, len(StatKeys))
+	for _, stat := range StatKeys {
+		value := *statField(character, stat)
+		effect := StatEffect{Stat: stat, Value: value}
+
+		if stat == "wisdom" {
+			percent := wisdomPercent(value)
+			effect.Summary = fmt.Sprintf("%s %d → +%d%% XP", statLabel(stat), value, percent)
+			effect.Details = []string{
+				fmt.Sprintf("Commit and quest XP × %.2f (+%.0f%% per point above %d)", WisdomMultiplier(value), wisdomBonusPer10*100, wisdomBaseValue),
+				fmt.Sprintf("A 50 XP commit earns %d XP", ApplyWisdomBonus(50, value)),
+				fmt.Sprintf("Next point: +%d%% XP", wisdomPercent(value+1)),
+			}
+			effects = append(effects, effect)
+			continue
+		}
+
+		unlocked, gated, next := statGatedQuests(quests, stat, value)
+		effect.Summary = fmt.Sprintf("%s %d → unlocks %d of %d stat-gated quests", statLabel(stat), value, unlocked, gated)
+		switch {
+		case gated == 0:
+			effect.Details = append(effect.Details, "No quests in your log require "+statLabel(stat))
+		case next > 0:
+			effect.Details = append(effect.Details, fmt.Sprintf("Next quest unlocks at %d (%d more points)", next, next-value))
+		default:
+			effect.Details = append(effect.Details, "Every quest gated on "+statLabel(stat)+" is unlocked")
+		}
+		effect.Details = append(effect.Details, "No effect on XP")
+		if decay.Enabled {
+			effect.Details = append(effect.Details, fmt.Sprintf("Fades 1 point per %d days after %d days without commits (never below %d)",
+				decay.DaysPerPoint, decay.GraceDays, BaseStatValue))
+		}
+		effects = append(effects, effect)
+	}
+	return effects
+}
+
+// wisdomPercent returns the whole-percent XP bonus a Wisdom value gives.
+func wisdomPercent(wisdom int) int {
+	return int(math.Round((WisdomMultiplier(wisdom) - 1) * 100))
+}
+
+// statGatedQuests counts the unfinished quests with a requirement on a stat,
+// how many of them the value meets, and the lowest requirement it doesn't
+// meet yet (0 if it meets them all).
+func statGatedQuests(quests []*Quest, stat string, value int) (unlocked, gated, next int) {
+	for _, quest := range quests {
+		if quest.Status == QuestCompleted {
+			continue
+		}
+		for _, req := range quest.Requirements {
+			if req.Type != RequirementStat || statLabel(req.Key) != statLabel(stat) {
+				continue
+			}
+			gated++
+			if value >= req.Value {
+				unlocked++
+			} else if next == 0 || req.Value < next {
+				next = req.Value
+			}
+		}
+	}
+	return unlocked, gated, next
+}
@@ -0,0 +1,142 @@
+package game
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestAllocateStatPoint tests spending skill points on stats
+func TestAllocateStatPoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		points     int
+		stat       string
+		wantErr    bool
+		wantValue  int
+		wantPoints int
+	}{
+		{"code power", 2, "code_power", false, 11, 1},
+		{"wisdom", 1, "wisdom", false, 11, 0},
+		{"agility case insensitive", 1, "Agility", false, 11, 0},
+		{"no points", 0, "wisdom", true, 10, 0},
+		{"unknown stat", 1, "charisma", true, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := NewCharacter("TestHero")
+			char.SkillPoints = tt.points
+
+			err := char.AllocateStatPoint(tt.stat, time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AllocateStatPoint(%q) error = %v, wantErr %v", tt.stat, err, tt.wantErr)
+			}
+			if char.SkillPoints != tt.wantPoints {
+				t.Errorf("SkillPoints = %d, want %d", char.SkillPoints, tt.wantPoints)
+			}
+			if value, _ := char.StatValue(tt.stat); value != tt.wantValue {
+				t.Errorf("StatValue(%q) = %d, want %d", tt.stat, value, tt.wantValue)
+			}
+
+			wantHistory := 1
+			if tt.wantErr {
+				wantHistory = 0
+			}
+			if len(char.StatAllocations) != wantHistory {
+				t.Errorf("len(StatAllocations) = %d, want %d", len(char.StatAllocations), wantHistory)
+			}
+		})
+	}
+}
+
+// TestRespec tests refunding allocated points for an XP cost
+func TestRespec(t *testing.T) {
+	tests := []struct {
+		name       string
+		codePower  int
+		wisdom     int
+		xp         int
+		wantRefund int
+		wantErr    bool
+	}{
+		{"refunds all allocated points", 13, 12, 100, 5, false},
+		{"nothing allocated", 10, 10, 100, 0, true},
+		{"not enough XP", 12, 10, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := NewCharacter("TestHero")
+			char.CodePower = tt.codePower
+			char.Wisdom = tt.wisdom
+			char.XP = tt.xp
+			cost := char.RespecCost()
+
+			refund, err := char.Respec(time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Respec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if char.XP != tt.xp || char.CodePower != tt.codePower {
+					t.Error("a failed respec should change nothing")
+				}
+				return
+			}
+
+			if refund != tt.wantRefund || char.SkillPoints != tt.wantRefund {
+				t.Errorf("refund = %d, SkillPoints = %d; want %d", refund, char.SkillPoints, tt.wantRefund)
+			}
+			if char.XP != tt.xp-cost {
+				t.Errorf("XP = %d, want %d (cost %d)", char.XP, tt.xp-cost, cost)
+			}
+			if char.AllocatedStatPoints() != 0 || char.CodePower != BaseStatValue || char.Wisdom != BaseStatValue {
+				t.Errorf("stats = %d/%d/%d, want all at base", char.CodePower, char.Wisdom, char.Agility)
+			}
+
+			last := char.StatAllocations[len(char.StatAllocations)-1]
+			if last.Kind != StatRespec || last.Points != tt.wantRefund || last.XPCost != cost {
+				t.Errorf("history entry = %+v, want respec of %d points for %d XP", last, tt.wantRefund, cost)
+			}
+		})
+	}
+}
+
+// TestStatEffects tests the stat details shown on the character sheet.
+func TestStatEffects(t *testing.T) {
+	character := NewCharacter("Tester")
+	character.Wisdom = 14
+	character.CodePower = 12
+
+	gated := func(stat string, value int) *Quest {
+		quest := NewQuest("Gated", "", QuestTypeCommit, 1, 10, 1)
+		quest.Requirements = []Requirement{{Type: RequirementStat, Key: stat, Value: value}}
+		return quest
+	}
+	quests := []*Quest{gated("code_power", 12), gated("codepower", 15), gated("code_power", 20)}
+	effects := StatEffects(character, quests, StatDecay{Enabled: true, GraceDays: 14, DaysPerPoint: 7})
+
+	tests := []struct {
+		stat        string
+		wantSummary string
+		wantDetail  string
+	}{
+		{"code_power", "Code Power 12 → unlocks 1 of 3 stat-gated quests", "Next quest unlocks at 15 (3 more points)"},
+		{"wisdom", "Wisdom 14 → +4% XP", "A 50 XP commit earns 52 XP"},
+		{"agility", "Agility 10 → unlocks 0 of 0 stat-gated quests", "Fades 1 point per 7 days after 14 days without commits (never below 10)"},
+	}
+	for i, tt := range tests {
+		effect := effects[i]
+		if effect.Stat != tt.stat || effect.Summary != tt.wantSummary {
+			t.Errorf("effects[%d] = %s %q, want %s %q", i, effect.Stat, effect.Summary, tt.stat, tt.wantSummary)
+		}
+		if !slices.Contains(effect.Details, tt.wantDetail) {
+			t.Errorf("%s details = %q, want %q", tt.stat, effect.Details, tt.wantDetail)
+		}
+	}
+
+	// The summary comes from the same multiplier the engine applies
+	if got, want := ApplyWisdomBonus(100, character.Wisdom), 104; got != want {
+		t.Errorf("ApplyWisdomBonus(100, 14) = %d, want %d to match the summary", got, want)
+	}
+}
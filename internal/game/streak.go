@@ -0,0 +1,134 @@
+// Package game contains the core game logic for CodeQuest
+package game
+
+import (
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// StreakClock decides which calendar day an activity counts toward.
+// Days are evaluated in the player's home timezone, and activity shortly
+// after midnight can count toward the previous day (the grace window).
+type StreakClock struct {
+	Location *time.Location // Home timezone (nil = system timezone)
+	Grace    time.Duration  // Activity this long after midnight counts as the prior day
+}
+
+// StreakClockFromConfig builds the streak clock from game settings.
+// An empty, "auto" or unknown timezone falls back to the system timezone.
+//
+// Parameters:
+//   - cfg: Application configuration (nil uses the system timezone, no grace)
+//
+// Returns:
+//   - StreakClock: The configured clock
+func StreakClockFromConfig(cfg *config.Config) StreakClock {
+	clock := StreakClock{Location: time.Local}
+	if cfg == nil {
+		return clock
+	}
+
+	if tz := cfg.Game.Timezone; tz != "" && tz != "auto" {
+		if location, err := time.LoadLocation(tz); err == nil {
+			clock.Location = location
+		}
+	}
+	clock.Grace = time.Duration(cfg.Game.StreakGraceHours) * time.Hour
+	return clock
+}
+
+// location returns the clock's timezone, defaulting to the system timezone.
+func (sc StreakClock) location() *time.Location {
+	if sc.Location == nil {
+		return time.Local
+	}
+	return sc.Location
+}
+
+// Day returns the streak day an instant counts toward, as midnight UTC of
+// that calendar date. Using UTC dates keeps day arithmetic exact across DST
+// changes (a "day" is always 24 hours apart).
+//
+// Parameters:
+//   - t: The instant to classify
+//
+// Returns:
+//   - time.Time: The streak day (midnight UTC of the calendar date)
+func (sc StreakClock) Day(t time.Time) time.Time {
+	local := t.In(sc.location()).Add(-sc.Grace)
+	year, month, day := local.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// NextDay returns the instant the streak day after t begins: the next
+// midnight in the home timezone, plus the grace window.
+//
+// Parameters:
+//   - t: The current instant
+//
+// Returns:
+//   - time.Time: When the following streak day starts
+func (sc StreakClock) NextDay(t time.Time) time.Time {
+	local := t.In(sc.location()).Add(-sc.Grace)
+	year, month, day := local.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, sc.location()).Add(sc.Grace)
+}
+
+// UpdateStreakAt updates the daily activity streak for activity at the given
+// time, evaluated with the streak clock.
+//
+// The last active day is read in the timezone it was recorded in
+// (LastActiveTZ), so changing the home timezone while travelling doesn't
+// shift past days and break the streak. Activity older than the last active
+// day (e.g. replayed commits) leaves the streak unchanged. Missed days are
+// forgiven while the character holds enough streak freezes; otherwise the
+// broken streak is remembered for the Redemption quest.
+//
+// Parameters:
+//   - at: When the activity happened
+//   - clock: Timezone and grace window to evaluate days with
+func (c *Character) UpdateStreakAt(at time.Time, clock StreakClock) {
+	lastClock := clock
+	if c.LastActiveTZ != "" {
+		if location, err := time.LoadLocation(c.LastActiveTZ); err == nil {
+			lastClock.Location = location
+		}
+	}
+
+	daysDiff := int(clock.Day(at).Sub(lastClock.Day(c.LastActiveDate)).Hours() / 24)
+
+	switch {
+	case daysDiff < 0:
+		// Older than the last recorded activity - already accounted for
+		return
+
+	case daysDiff == 0:
+		// Already active today; start the streak if it hasn't begun
+		if c.CurrentStreak == 0 {
+			c.CurrentStreak = 1
+		}
+
+	case daysDiff == 1:
+		// Active yesterday, increment streak
+		c.CurrentStreak++
+
+	case daysDiff-1 <= c.StreakFreezes:
+		// Missed days are covered by streak freezes, one per day
+		c.StreakFreezes -= daysDiff - 1
+		c.CurrentStreak++
+
+	default:
+		// Missed a day (or more), reset streak to 1; a long streak can be
+		// partly won back with the Redemption quest
+		c.recordBrokenStreak(c.CurrentStreak)
+		c.CurrentStreak = 1
+	}
+
+	if c.CurrentStreak > c.LongestStreak {
+		c.LongestStreak = c.CurrentStreak
+	}
+
+	c.LastActiveDate = at
+	c.LastActiveTZ = clock.location().String()
+}
@@ -0,0 +1,224 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// mustLoadLocation loads a timezone or skips the test if tzdata is missing.
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s not available: %v", name, err)
+	}
+	return location
+}
+
+// TestStreakClock_Day tests day classification with timezones and grace.
+func TestStreakClock_Day(t *testing.T) {
+	utc := time.UTC
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name  string
+		clock StreakClock
+		at    time.Time
+		want  time.Time
+	}{
+		{
+			name:  "midday in home timezone",
+			clock: StreakClock{Location: utc},
+			at:    time.Date(2025, 3, 10, 12, 0, 0, 0, utc),
+			want:  time.Date(2025, 3, 10, 0, 0, 0, 0, utc),
+		},
+		{
+			name:  "instant converted to home timezone",
+			clock: StreakClock{Location: tokyo},
+			at:    time.Date(2025, 3, 10, 20, 0, 0, 0, utc), // 05:00 next day in Tokyo
+			want:  time.Date(2025, 3, 11, 0, 0, 0, 0, utc),
+		},
+		{
+			name:  "late-night commit inside grace window counts as prior day",
+			clock: StreakClock{Location: utc, Grace: 3 * time.Hour},
+			at:    time.Date(2025, 3, 11, 2, 30, 0, 0, utc),
+			want:  time.Date(2025, 3, 10, 0, 0, 0, 0, utc),
+		},
+		{
+			name:  "after grace window counts as new day",
+			clock: StreakClock{Location: utc, Grace: 3 * time.Hour},
+			at:    time.Date(2025, 3, 11, 3, 0, 0, 0, utc),
+			want:  time.Date(2025, 3, 11, 0, 0, 0, 0, utc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clock.Day(tt.at); !got.Equal(tt.want) {
+				t.Errorf("Day() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestStreakClock_NextDay tests when the following streak day begins.
+func TestStreakClock_NextDay(t *testing.T) {
+	utc := time.UTC
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name  string
+		clock StreakClock
+		at    time.Time
+		want  time.Time
+	}{
+		{
+			name:  "next midnight",
+			clock: StreakClock{Location: utc},
+			at:    time.Date(2025, 3, 10, 12, 0, 0, 0, utc),
+			want:  time.Date(2025, 3, 11, 0, 0, 0, 0, utc),
+		},
+		{
+			name:  "midnight in home timezone",
+			clock: StreakClock{Location: tokyo},
+			at:    time.Date(2025, 3, 10, 12, 0, 0, 0, utc), // 21:00 in Tokyo
+			want:  time.Date(2025, 3, 10, 15, 0, 0, 0, utc),
+		},
+		{
+			name:  "inside grace window the day ends after it",
+			clock: StreakClock{Location: utc, Grace: 3 * time.Hour},
+			at:    time.Date(2025, 3, 11, 1, 0, 0, 0, utc),
+			want:  time.Date(2025, 3, 11, 3, 0, 0, 0, utc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clock.NextDay(tt.at); !got.Equal(tt.want) {
+				t.Errorf("NextDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCharacter_UpdateStreakAt tests streak changes across DST, travel,
+// grace windows and out-of-order activity.
+func TestCharacter_UpdateStreakAt(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name       string
+		lastActive time.Time
+		lastTZ     string
+		clock      StreakClock
+		at         time.Time
+		wantStreak int
+	}{
+		{
+			// DST starts 2024-03-10 in New York: that day is only 23 hours long
+			name:       "consecutive days across spring-forward DST",
+			lastActive: time.Date(2024, 3, 10, 0, 30, 0, 0, newYork),
+			clock:      StreakClock{Location: newYork},
+			at:         time.Date(2024, 3, 11, 0, 10, 0, 0, newYork),
+			wantStreak: 6,
+		},
+		{
+			// DST ends 2024-11-03 in New York: that day is 25 hours long
+			name:       "same day across fall-back DST",
+			lastActive: time.Date(2024, 11, 3, 0, 30, 0, 0, newYork),
+			clock:      StreakClock{Location: newYork},
+			at:         time.Date(2024, 11, 3, 23, 45, 0, 0, newYork),
+			wantStreak: 5,
+		},
+		{
+			// Last commit Monday 23:00 in Berlin, next one Tuesday 20:00 in New York
+			// (already Wednesday in Berlin)
+			name:       "travelling west keeps the streak",
+			lastActive: time.Date(2025, 6, 2, 23, 0, 0, 0, berlin),
+			lastTZ:     "Europe/Berlin",
+			clock:      StreakClock{Location: newYork},
+			at:         time.Date(2025, 6, 3, 20, 0, 0, 0, newYork),
+			wantStreak: 6,
+		},
+		{
+			name:       "late-night commit within grace keeps the streak going",
+			lastActive: time.Date(2025, 6, 2, 18, 0, 0, 0, berlin),
+			lastTZ:     "Europe/Berlin",
+			clock:      StreakClock{Location: berlin, Grace: 3 * time.Hour},
+			at:         time.Date(2025, 6, 4, 1, 30, 0, 0, berlin), // counts as June 3
+			wantStreak: 6,
+		},
+		{
+			name:       "missed day resets",
+			lastActive: time.Date(2025, 6, 2, 18, 0, 0, 0, berlin),
+			lastTZ:     "Europe/Berlin",
+			clock:      StreakClock{Location: berlin},
+			at:         time.Date(2025, 6, 4, 9, 0, 0, 0, berlin),
+			wantStreak: 1,
+		},
+		{
+			name:       "older activity leaves the streak alone",
+			lastActive: time.Date(2025, 6, 4, 18, 0, 0, 0, berlin),
+			lastTZ:     "Europe/Berlin",
+			clock:      StreakClock{Location: berlin},
+			at:         time.Date(2025, 6, 1, 9, 0, 0, 0, berlin),
+			wantStreak: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Traveller")
+			c.CurrentStreak = 5
+			c.LongestStreak = 5
+			c.LastActiveDate = tt.lastActive
+			c.LastActiveTZ = tt.lastTZ
+
+			c.UpdateStreakAt(tt.at, tt.clock)
+
+			if c.CurrentStreak != tt.wantStreak {
+				t.Errorf("CurrentStreak = %d, want %d", c.CurrentStreak, tt.wantStreak)
+			}
+			if tt.wantStreak > 5 && c.LongestStreak != tt.wantStreak {
+				t.Errorf("LongestStreak = %d, want %d", c.LongestStreak, tt.wantStreak)
+			}
+		})
+	}
+}
+
+// TestStreakClockFromConfig tests timezone and grace settings.
+func TestStreakClockFromConfig(t *testing.T) {
+	mustLoadLocation(t, "Asia/Tokyo")
+
+	tests := []struct {
+		name      string
+		timezone  string
+		grace     int
+		wantZone  string
+		wantGrace time.Duration
+	}{
+		{"auto uses system timezone", "auto", 0, time.Local.String(), 0},
+		{"empty uses system timezone", "", 2, time.Local.String(), 2 * time.Hour},
+		{"named timezone", "Asia/Tokyo", 3, "Asia/Tokyo", 3 * time.Hour},
+		{"unknown timezone falls back", "Mars/Olympus", 0, time.Local.String(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.Timezone = tt.timezone
+			cfg.Game.StreakGraceHours = tt.grace
+
+			clock := StreakClockFromConfig(cfg)
+			if clock.Location.String() != tt.wantZone {
+				t.Errorf("Location = %s, want %s", clock.Location, tt.wantZone)
+			}
+			if clock.Grace != tt.wantGrace {
+				t.Errorf("Grace = %v, want %v", clock.Grace, tt.wantGrace)
+			}
+		})
+	}
+}
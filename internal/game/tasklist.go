@@ -0,0 +1,167 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements quests from markdown task lists: every unchecked
+// "- [ ] item" in a repository's task list (TODO.md by default) becomes a
+// task quest, ticking the item completes the quest, and completing the
+// quest ticks the item.
+package game
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// taskQuestXP is the XP reward for ticking off a task list item.
+const taskQuestXP = 50
+
+// taskPattern matches a markdown task list item: a "-", "*" or "+" bullet,
+// then "[ ]" or "[x]", then the item text.
+var taskPattern = regexp.MustCompile(`^(\s*[-*+]\s+\[)([ xX])(\]\s+)(.*\S)\s*$`)
+
+// TaskItem is an item in a repository's markdown task list.
+type TaskItem struct {
+	Repo string `json:"repo"` // Repository path
+	File string `json:"file"` // Task list path relative to the repository root
+	Line int    `json:"line"` // 1-based line number
+	Text string `json:"text"` // Item text after the checkbox
+	Done bool   `json:"done"` // Whether the checkbox is ticked
+}
+
+// Key identifies the item independently of its line number and checkbox.
+func (t TaskItem) Key() string {
+	return t.Repo + "\x00" + t.File + "\x00" + t.Text
+}
+
+// ParseTaskList finds the task list items in a markdown file.
+//
+// Parameters:
+//   - file: File path relative to the repository root
+//   - content: File contents
+//
+// Returns:
+//   - []TaskItem: Items in line order (Repo unset)
+func ParseTaskList(file, content string) []TaskItem {
+	var tasks []TaskItem
+	for i, line := range strings.Split(content, "\n") {
+		match := taskPattern.FindStringSubmatch(strings.TrimSuffix(line, "\r"))
+		if match == nil {
+			continue
+		}
+		tasks = append(tasks, TaskItem{File: file, Line: i + 1, Text: match[4], Done: match[2] != " "})
+	}
+	return tasks
+}
+
+// TickTask ticks an item's checkbox in a task list. The unchecked item with
+// the same text nearest its recorded line is ticked, since the lines around
+// it may have changed since it was read.
+//
+// Parameters:
+//   - content: Task list contents
+//   - task: The item to tick
+//
+// Returns:
+//   - string: The updated contents
+//   - bool: False if there was no unchecked item with the text (already ticked or removed)
+func TickTask(content string, task TaskItem) (string, bool) {
+	lines := strings.Split(content, "\n")
+	best, box := -1, 0
+	for i, line := range lines {
+		match := taskPattern.FindStringSubmatch(strings.TrimSuffix(line, "\r"))
+		if match == nil || match[2] != " " || match[4] != task.Text {
+			continue
+		}
+		if best < 0 || lineDistance(i+1, task.Line) < lineDistance(best+1, task.Line) {
+			best, box = i, len(match[1])
+		}
+	}
+	if best < 0 {
+		return content, false
+	}
+
+	// Only the space inside the brackets changes, so the rest of the line
+	// (and a CRLF line ending) is left exactly as it was
+	lines[best] = lines[best][:box] + "x" + lines[best][box+1:]
+	return strings.Join(lines, "\n"), true
+}
+
+// lineDistance returns how many lines apart two line numbers are.
+func lineDistance(a, b int) int {
+	if a < b {
+		return b - a
+	}
+	return a - b
+}
+
+// NewTaskQuest creates a quest to tick off a task list item.
+//
+// Parameters:
+//   - task: The unchecked item
+//
+// Returns:
+//   - *Quest: An available task quest
+func NewTaskQuest(task TaskItem) *Quest {
+	title := task.Text
+	if runes := []rune(title); len(runes) > todoTitleLength {
+		title = string(runes[:todoTitleLength-1]) + "…"
+	}
+
+	return NewQuestBuilder().
+		Title(title).
+		Description(fmt.Sprintf("Tick off %q in %s. Completes when you tick it there or a commit message mentions it.", task.Text, task.File)).
+		Type(QuestTypeTask).
+		XPReward(taskQuestXP).
+		Task(task).
+		MustBuild()
+}
+
+// MentionedIn reports whether a commit message mentions this task quest's
+// item (its full text, ignoring case).
+//
+// Parameters:
+//   - message: Commit message
+//
+// Returns:
+//   - bool: True if the message mentions the item
+func (q *Quest) MentionedIn(message string) bool {
+	if q.Task == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(message), strings.ToLower(q.Task.Text))
+}
+
+// SyncTaskQuests brings task quests in line with the task lists: unchecked
+// items without a quest get one, and quests whose item has been ticked are
+// returned so they can be completed. Removed items leave their quest alone.
+//
+// Parameters:
+//   - quests: Existing quests
+//   - tasks: Every item in the synced task lists
+//
+// Returns:
+//   - []*Quest: The quests, with new task quests appended
+//   - []*Quest: The quests added
+//   - []*Quest: Open task quests whose item is now ticked
+func SyncTaskQuests(quests []*Quest, tasks []TaskItem) ([]*Quest, []*Quest, []*Quest) {
+	byKey := make(map[string]*Quest)
+	for _, quest := range quests {
+		if quest.Task != nil {
+			byKey[quest.Task.Key()] = quest
+		}
+	}
+
+	var added, ticked []*Quest
+	for _, task := range tasks {
+		quest, ok := byKey[task.Key()]
+		switch {
+		case !ok && !task.Done:
+			quest = NewTaskQuest(task)
+			byKey[task.Key()] = quest
+			quests = append(quests, quest)
+			added = append(added, quest)
+		case ok && task.Done && !quest.Task.Done && (quest.Status == QuestAvailable || quest.Status == QuestActive):
+			ticked = append(ticked, quest)
+		}
+	}
+	return quests, added, ticked
+}
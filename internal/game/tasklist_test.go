@@ -0,0 +1,124 @@
+package game
+
+import "testing"
+
+// TestParseTaskList tests which lines are recognized as task list items.
+func TestParseTaskList(t *testing.T) {
+	content := "# Plans\n- [ ] Fix login bug\n* [x] Write docs  \r\n  + [X] nested item\n- [] not a box\n-[ ] no space\n- plain bullet\n"
+
+	tasks := ParseTaskList("TODO.md", content)
+	want := []TaskItem{
+		{File: "TODO.md", Line: 2, Text: "Fix login bug"},
+		{File: "TODO.md", Line: 3, Text: "Write docs", Done: true},
+		{File: "TODO.md", Line: 4, Text: "nested item", Done: true},
+	}
+	if len(tasks) != len(want) {
+		t.Fatalf("ParseTaskList() = %+v, want %+v", tasks, want)
+	}
+	for i := range want {
+		if tasks[i] != want[i] {
+			t.Errorf("ParseTaskList()[%d] = %+v, want %+v", i, tasks[i], want[i])
+		}
+	}
+}
+
+// TestTickTask tests that only the checkbox of the matching unchecked item
+// nearest its recorded line changes.
+func TestTickTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		task    TaskItem
+		want    string
+		wantOK  bool
+	}{
+		{
+			name:    "ticks the item",
+			content: "- [ ] a\n- [ ] b\n",
+			task:    TaskItem{Line: 2, Text: "b"},
+			want:    "- [ ] a\n- [x] b\n",
+			wantOK:  true,
+		},
+		{
+			name:    "keeps crlf",
+			content: "- [ ] a\r\n  * [ ] b  \r\n",
+			task:    TaskItem{Line: 2, Text: "b"},
+			want:    "- [ ] a\r\n  * [x] b  \r\n",
+			wantOK:  true,
+		},
+		{
+			name:    "nearest duplicate",
+			content: "- [ ] a\n\n\n- [ ] a\n",
+			task:    TaskItem{Line: 3, Text: "a"},
+			want:    "- [ ] a\n\n\n- [x] a\n",
+			wantOK:  true,
+		},
+		{
+			name:    "already ticked",
+			content: "- [x] a\n",
+			task:    TaskItem{Line: 1, Text: "a"},
+			want:    "- [x] a\n",
+		},
+		{
+			name:    "removed",
+			content: "- [ ] b\n",
+			task:    TaskItem{Line: 1, Text: "a"},
+			want:    "- [ ] b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TickTask(tt.content, tt.task)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("TickTask() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestSyncTaskQuests tests that unchecked items get one quest each and that
+// ticked items are reported for their open quests only.
+func TestSyncTaskQuests(t *testing.T) {
+	open := TaskItem{Repo: "/r", File: "TODO.md", Line: 1, Text: "Fix login bug"}
+	quests, added, ticked := SyncTaskQuests(nil, []TaskItem{open, {Repo: "/r", File: "TODO.md", Line: 2, Text: "done already", Done: true}})
+	if len(quests) != 1 || len(added) != 1 || len(ticked) != 0 {
+		t.Fatalf("first sync = %d quests, %d added, %d ticked, want 1, 1, 0", len(quests), len(added), len(ticked))
+	}
+	quest := quests[0]
+	if quest.Type != QuestTypeTask || quest.Task == nil || quest.Task.Text != open.Text || quest.XPReward != taskQuestXP {
+		t.Errorf("task quest = %+v, want a %d XP task quest for %q", quest, taskQuestXP, open.Text)
+	}
+
+	// Moving the item to another line doesn't add a second quest
+	moved := open
+	moved.Line = 5
+	if quests, added, _ = SyncTaskQuests(quests, []TaskItem{moved}); len(quests) != 1 || len(added) != 0 {
+		t.Errorf("resync = %d quests, %d added, want 1, 0", len(quests), len(added))
+	}
+
+	moved.Done = true
+	if _, _, ticked = SyncTaskQuests(quests, []TaskItem{moved}); len(ticked) != 1 || ticked[0] != quest {
+		t.Errorf("ticked = %+v, want the task quest", ticked)
+	}
+
+	quest.Status = QuestCompleted
+	if _, _, ticked = SyncTaskQuests(quests, []TaskItem{moved}); len(ticked) != 0 {
+		t.Errorf("ticked = %+v, want none for a completed quest", ticked)
+	}
+}
+
+// TestQuestMentionedIn tests matching commit messages to task quests.
+func TestQuestMentionedIn(t *testing.T) {
+	quest := NewTaskQuest(TaskItem{Repo: "/r", File: "TODO.md", Line: 1, Text: "Fix login bug"})
+
+	if !quest.MentionedIn("fix login bug on Safari") {
+		t.Error("MentionedIn() = false, want true ignoring case")
+	}
+	if quest.MentionedIn("Fix logout bug") {
+		t.Error("MentionedIn() = true for a different message, want false")
+	}
+	if (&Quest{}).MentionedIn("Fix login bug") {
+		t.Error("MentionedIn() = true for a quest without a task, want false")
+	}
+}
@@ -0,0 +1,321 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements built-in quest templates for developer work beyond
+// raw code output: documentation, code review, collaboration, dependency
+// maintenance and open source contributions.
+package game
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// QuestTemplate describes a built-in quest that is added to every quest log.
+type QuestTemplate struct {
+	ID            string    // Stable identifier, recorded on quests created from it
+	Title         string    // Quest display name
+	Description   string    // What the player needs to do
+	Type          QuestType // How progress is tracked
+	Target        int       // Progress needed to complete
+	XPReward      int       // Base XP reward
+	RequiredLevel int       // Minimum level to start
+}
+
+// QuestTemplates are the built-in quests, in quest board order.
+var QuestTemplates = []QuestTemplate{
+	{
+		ID:            "docs-scribe",
+		Title:         "The Scribe",
+		Description:   "Good docs are a gift to your future self. Edit 5 markdown files.",
+		Type:          QuestTypeDocs,
+		Target:        5,
+		XPReward:      150,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "review-guardian",
+		Title:         "Guardian of the Main Branch",
+		Description:   "Approve 3 pull requests on GitHub. Requires the GitHub integration ([github] enabled) and $GITHUB_TOKEN.",
+		Type:          QuestTypeReview,
+		Target:        3,
+		XPReward:      200,
+		RequiredLevel: 2,
+	},
+	{
+		ID:            "dependency-hygiene",
+		Title:         "Dependency Hygiene",
+		Description:   "Keep your dependencies fresh. Make 2 commits that bump versions in go.mod (or say so, like \"chore(deps): bump ...\").",
+		Type:          QuestTypeDeps,
+		Target:        2,
+		XPReward:      120,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "monthly-maintenance",
+		Title:         "Monthly Maintenance",
+		Description:   "Update 3 dependencies this month. Version bumps are read from go.mod; progress resets when the month ends.",
+		Type:          QuestTypeMonthly,
+		Target:        3,
+		XPReward:      100,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "peer-review",
+		Title:         "Second Pair of Eyes",
+		Description:   "Review 3 of your teammates' commits. Counted when they land with a \"Reviewed-by: You <email>\" trailer or git note; set git.author_emails so CodeQuest knows which commits are yours.",
+		Type:          QuestTypePeerReview,
+		Target:        3,
+		XPReward:      150,
+		RequiredLevel: 2,
+	},
+	{
+		ID:            "pairing",
+		Title:         "Better Together",
+		Description:   "Land 3 commits a teammate reviewed or co-wrote (\"Reviewed-by:\" or \"Co-authored-by:\" trailers).",
+		Type:          QuestTypePairing,
+		Target:        3,
+		XPReward:      120,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "well-formed-1",
+		Title:         "Well-Formed I: First Words",
+		Description:   "Write 5 conventional commit messages, like \"fix(auth): handle expired tokens\". Stuck? `codequest suggest-commit-message` asks the mentor for one.",
+		Type:          QuestTypeWellFormed,
+		Target:        5,
+		XPReward:      80,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "well-formed-2",
+		Title:         "Well-Formed II: House Style",
+		Description:   "Make conventional commits a habit: 20 more well-formed commit messages.",
+		Type:          QuestTypeWellFormed,
+		Target:        20,
+		XPReward:      200,
+		RequiredLevel: 3,
+	},
+	{
+		ID:            "well-formed-3",
+		Title:         "Well-Formed III: Changelog Ready",
+		Description:   "A history anyone can generate release notes from: 50 more well-formed commit messages.",
+		Type:          QuestTypeWellFormed,
+		Target:        50,
+		XPReward:      400,
+		RequiredLevel: 5,
+	},
+	{
+		ID:            QuestADayTemplateID,
+		Title:         "Quest a Day",
+		Description:   "Finish at least one quest every day for a week. Complete one on each day Monday to Sunday to earn a crown.",
+		Type:          QuestTypeQuestStreak,
+		Target:        7,
+		XPReward:      350,
+		RequiredLevel: 2,
+	},
+	{
+		ID:            "open-source-patch",
+		Title:         "Upstream Patch",
+		Description:   "Give back: make a commit to a project you don't own. Set git.own_accounts to your GitHub users and orgs so CodeQuest can tell.",
+		Type:          QuestTypeOpenSource,
+		Target:        1,
+		XPReward:      150,
+		RequiredLevel: 1,
+	},
+	{
+		ID:            "open-source-regular",
+		Title:         "Friend of the Commons",
+		Description:   "Keep showing up upstream: 10 commits to open source projects you don't own.",
+		Type:          QuestTypeOpenSource,
+		Target:        10,
+		XPReward:      400,
+		RequiredLevel: 3,
+	},
+}
+
+// depsMessagePattern matches commit messages describing dependency updates,
+// e.g. "chore(deps): bump x", "Update dependencies", "upgrade bubbletea".
+var depsMessagePattern = regexp.MustCompile(`(?i)\b(deps?|dependenc(y|ies)|bump|upgrade|update)\b`)
+
+// markdownExtensions are the file extensions documentation quests count.
+var markdownExtensions = map[string]bool{
+	".md":       true,
+	".markdown": true,
+	".mdx":      true,
+}
+
+// testDirs are the directory names test files are kept under.
+var testDirs = map[string]bool{
+	"test":      true,
+	"tests":     true,
+	"__tests__": true,
+	"spec":      true,
+}
+
+// NewQuest creates an available quest from the template.
+//
+// Returns:
+//   - *Quest: A new quest recording the template it came from
+func (t QuestTemplate) NewQuest() *Quest {
+	return t.builder().MustBuild()
+}
+
+// Validate checks the template makes a valid quest, for templates loaded
+// from files rather than written in code.
+//
+// Returns:
+//   - error: What's wrong with the quest it would make (nil if it's valid)
+func (t QuestTemplate) Validate() error {
+	_, err := t.builder().Build()
+	return err
+}
+
+// builder returns a quest builder filled in from the template.
+func (t QuestTemplate) builder() *QuestBuilder {
+	return NewQuestBuilder().
+		Title(t.Title).
+		Description(t.Description).
+		Type(t.Type).
+		Target(t.Target).
+		XPReward(t.XPReward).
+		RequiredLevel(t.RequiredLevel).
+		Template(t.ID)
+}
+
+// SeedQuestTemplates adds a quest for every built-in template missing from
+// the quest log. Quests already created from a template (in any state) are
+// left alone, so completed template quests aren't handed out again.
+//
+// Parameters:
+//   - quests: The player's quests
+//
+// Returns:
+//   - []*Quest: The quests with missing templates appended
+//   - bool: True if any quest was added
+func SeedQuestTemplates(quests []*Quest) ([]*Quest, bool) {
+	seeded := make(map[string]bool, len(quests))
+	for _, quest := range quests {
+		if quest.Template != "" {
+			seeded[quest.Template] = true
+		}
+	}
+
+	added := false
+	for _, template := range QuestTemplates {
+		if !seeded[template.ID] {
+			quests = append(quests, template.NewQuest())
+			added = true
+		}
+	}
+	return quests, added
+}
+
+// MergeQuestTemplates brings the quest log up to date with custom templates
+// as their files change: a template without a quest gets one, and a quest
+// that hasn't been started yet takes on its template's latest fields.
+// Quests already under way are left alone so progress isn't rewritten.
+// Templates must be valid (see QuestTemplate.Validate).
+//
+// Parameters:
+//   - quests: The player's quests
+//   - templates: Custom templates
+//
+// Returns:
+//   - []*Quest: The quests, with new ones appended
+//   - int: Quests added
+//   - int: Quests updated
+func MergeQuestTemplates(quests []*Quest, templates []QuestTemplate) ([]*Quest, int, int) {
+	added, updated := 0, 0
+	for _, template := range templates {
+		quest := findTemplateQuest(quests, template.ID)
+		switch {
+		case quest == nil:
+			quests = append(quests, template.NewQuest())
+			added++
+		case quest.Status == QuestAvailable && quest.Current == 0 && !template.matches(quest):
+			quest.Title, quest.Description, quest.Type = template.Title, template.Description, template.Type
+			quest.Target, quest.XPReward, quest.RequiredLevel = template.Target, template.XPReward, template.RequiredLevel
+			updated++
+		}
+	}
+	return quests, added, updated
+}
+
+// findTemplateQuest returns the quest created from a template (nil if none).
+func findTemplateQuest(quests []*Quest, templateID string) *Quest {
+	for _, quest := range quests {
+		if quest.Template == templateID {
+			return quest
+		}
+	}
+	return nil
+}
+
+// matches reports whether a quest already has the template's fields.
+func (t QuestTemplate) matches(quest *Quest) bool {
+	return quest.Title == t.Title && quest.Description == t.Description && quest.Type == t.Type &&
+		quest.Target == t.Target && quest.XPReward == t.XPReward && quest.RequiredLevel == t.RequiredLevel
+}
+
+// CountMarkdownFiles counts the documentation files among changed paths.
+//
+// Parameters:
+//   - paths: Paths of the files a commit changed
+//
+// Returns:
+//   - int: Number of markdown files
+func CountMarkdownFiles(paths []string) int {
+	count := 0
+	for _, path := range paths {
+		if markdownExtensions[strings.ToLower(filepath.Ext(path))] {
+			count++
+		}
+	}
+	return count
+}
+
+// CountTestFiles counts the test files among changed paths: Go's _test
+// files, test_ and _test named files, .test. and .spec. files, and anything
+// under a test directory.
+//
+// Parameters:
+//   - paths: Paths of the files a commit changed
+//
+// Returns:
+//   - int: Number of test files
+func CountTestFiles(paths []string) int {
+	count := 0
+	for _, path := range paths {
+		parts := strings.Split(filepath.ToSlash(strings.ToLower(path)), "/")
+		name := parts[len(parts)-1]
+		stem := strings.TrimSuffix(name, filepath.Ext(name))
+
+		isTest := strings.HasSuffix(stem, "_test") || strings.HasPrefix(name, "test_") ||
+			strings.Contains(name, ".test.") || strings.Contains(name, ".spec.")
+		for _, dir := range parts[:len(parts)-1] {
+			isTest = isTest || testDirs[dir]
+		}
+		if isTest {
+			count++
+		}
+	}
+	return count
+}
+
+// IsDependencyUpdate reports whether a commit updates Go dependencies: it
+// touches a go.mod file and its message says so.
+//
+// Parameters:
+//   - message: Commit message
+//   - paths: Paths of the files the commit changed
+//
+// Returns:
+//   - bool: True for dependency update commits
+func IsDependencyUpdate(message string, paths []string) bool {
+	for _, path := range paths {
+		if filepath.Base(path) == "go.mod" {
+			return depsMessagePattern.MatchString(message)
+		}
+	}
+	return false
+}
@@ -0,0 +1,222 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// memoryStorage keeps game state in memory for handler tests.
+type memoryStorage struct {
+	character *Character
+	quests    []*Quest
+}
+
+func (s *memoryStorage) SaveCharacter(character *Character) error {
+	s.character = character
+	return nil
+}
+
+func (s *memoryStorage) LoadCharacter() (*Character, error) {
+	return s.character, nil
+}
+
+func (s *memoryStorage) SaveQuests(quests []*Quest) error {
+	s.quests = quests
+	return nil
+}
+
+func (s *memoryStorage) LoadQuests() ([]*Quest, error) {
+	return s.quests, nil
+}
+
+// TestSeedQuestTemplates tests adding built-in quests to a quest log.
+func TestSeedQuestTemplates(t *testing.T) {
+	quests, added := SeedQuestTemplates([]*Quest{})
+	if !added || len(quests) != len(QuestTemplates) {
+		t.Fatalf("SeedQuestTemplates(empty) = %d quests (added %v), want %d", len(quests), added, len(QuestTemplates))
+	}
+	for i, quest := range quests {
+		if quest.Template != QuestTemplates[i].ID || quest.Status != QuestAvailable {
+			t.Errorf("quest %d = template %q status %s, want %q available", i, quest.Template, quest.Status, QuestTemplates[i].ID)
+		}
+	}
+
+	// A completed template quest isn't handed out again
+	quests[0].Status = QuestCompleted
+	reseeded, added := SeedQuestTemplates(quests)
+	if added || len(reseeded) != len(quests) {
+		t.Errorf("SeedQuestTemplates(seeded) = %d quests (added %v), want %d unchanged", len(reseeded), added, len(quests))
+	}
+
+	// Only missing templates are added
+	partial, added := SeedQuestTemplates(quests[:1])
+	if !added || len(partial) != len(QuestTemplates) {
+		t.Errorf("SeedQuestTemplates(partial) = %d quests, want %d", len(partial), len(QuestTemplates))
+	}
+}
+
+// TestMergeQuestTemplates tests adding quests for new custom templates and
+// updating only quests that haven't been started.
+func TestMergeQuestTemplates(t *testing.T) {
+	bugBash := QuestTemplate{ID: "custom/bug-bash", Title: "Bug Bash", Type: QuestTypeCommit, Target: 10, XPReward: 300}
+	quests, added, updated := MergeQuestTemplates([]*Quest{}, []QuestTemplate{bugBash})
+	if added != 1 || updated != 0 || len(quests) != 1 || quests[0].Template != bugBash.ID {
+		t.Fatalf("MergeQuestTemplates(new) = %d quests, %d added, %d updated; want the Bug Bash quest added", len(quests), added, updated)
+	}
+	if _, added, updated := MergeQuestTemplates(quests, []QuestTemplate{bugBash}); added+updated != 0 {
+		t.Errorf("unchanged template: %d added, %d updated, want nothing", added, updated)
+	}
+
+	edited := bugBash
+	edited.Target, edited.Title = 15, "Big Bug Bash"
+	if _, added, updated := MergeQuestTemplates(quests, []QuestTemplate{edited}); added != 0 || updated != 1 || quests[0].Target != 15 || quests[0].Title != "Big Bug Bash" {
+		t.Errorf("edited template: %d added, %d updated, quest %q target %d; want it updated", added, updated, quests[0].Title, quests[0].Target)
+	}
+
+	// Quests under way keep the fields they were started with
+	if err := quests[0].Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	edited.Target = 20
+	if _, _, updated := MergeQuestTemplates(quests, []QuestTemplate{edited}); updated != 0 || quests[0].Target != 15 {
+		t.Errorf("started quest was updated to target %d, want it left at 15", quests[0].Target)
+	}
+}
+
+// TestCountMarkdownFiles tests recognizing documentation files.
+func TestCountMarkdownFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  int
+	}{
+		{"no files", nil, 0},
+		{"code only", []string{"main.go", "internal/ui/app.go"}, 0},
+		{"mixed", []string{"README.md", "main.go", "docs/guide.markdown", "site/page.MDX"}, 3},
+		{"markdown-like names", []string{"md", "notes.md.bak"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountMarkdownFiles(tt.paths); got != tt.want {
+				t.Errorf("CountMarkdownFiles(%v) = %d, want %d", tt.paths, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCountTestFiles tests counting test files among changed paths.
+func TestCountTestFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  int
+	}{
+		{"no files", nil, 0},
+		{"code only", []string{"main.go", "internal/ui/app.go", "contest.go"}, 0},
+		{"go and python", []string{"internal/game/quest_test.go", "test_parser.py", "parser_test.py"}, 3},
+		{"js specs", []string{"src/app.test.ts", "src/App.spec.jsx", "src/app.ts"}, 2},
+		{"test directories", []string{"tests/fixtures/data.json", "src/__tests__/app.js", "latest/notes.txt"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountTestFiles(tt.paths); got != tt.want {
+				t.Errorf("CountTestFiles(%v) = %d, want %d", tt.paths, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsDependencyUpdate tests recognizing dependency update commits.
+func TestIsDependencyUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		paths   []string
+		want    bool
+	}{
+		{"conventional deps commit", "chore(deps): bump bubbletea to v1.3", []string{"go.mod", "go.sum"}, true},
+		{"nested module", "Upgrade go-git", []string{"tools/go.mod"}, true},
+		{"update dependencies", "Update dependencies", []string{"go.mod"}, true},
+		{"go.mod without matching message", "Add retry helper", []string{"go.mod", "retry.go"}, false},
+		{"message without go.mod", "chore(deps): bump lodash", []string{"package.json"}, false},
+		{"similar word", "Fix updater crash", []string{"go.mod"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDependencyUpdate(tt.message, tt.paths); got != tt.want {
+				t.Errorf("IsDependencyUpdate(%q, %v) = %v, want %v", tt.message, tt.paths, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTemplateQuestProgress tests docs, deps and review quests progressing
+// through the event handler.
+func TestTemplateQuestProgress(t *testing.T) {
+	character := NewCharacter("Scribe")
+	quests, _ := SeedQuestTemplates([]*Quest{})
+	started := time.Now().Add(-time.Hour)
+	for _, quest := range quests {
+		if err := quest.Start("", ""); err != nil {
+			t.Fatalf("Start(%s) error = %v", quest.Template, err)
+		}
+		quest.StartedAt = &started
+	}
+	docs, review, deps := quests[0], quests[1], quests[2]
+
+	cfg := config.DefaultConfig()
+	cfg.Game.RewardChoiceMinXP = 0
+	bus := NewEventBus()
+	handler, err := NewGameEventHandler(character, quests, bus, &memoryStorage{}, cfg)
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	commit := func(message string, paths ...string) {
+		event := NewCommitEvent("0123456789abcdef", message, len(paths), 10, 2)
+		event.Data["file_paths"] = paths
+		bus.Publish(event)
+	}
+
+	commit("docs: explain quests", "README.md", "docs/quests.md", "main.go")
+	commit("chore(deps): bump go-git", "go.mod", "go.sum")
+	commit("Add quest board filter", "go.mod", "internal/ui/questboard.go")
+
+	if docs.Current != 2 {
+		t.Errorf("docs quest progress = %d, want 2", docs.Current)
+	}
+	if deps.Current != 1 {
+		t.Errorf("deps quest progress = %d, want 1", deps.Current)
+	}
+	if review.Current != 0 {
+		t.Errorf("review quest progressed from commits: %d", review.Current)
+	}
+
+	// Approvals before the quest started don't count
+	now := time.Now()
+	bus.Publish(NewReviewApprovalsEvent([]time.Time{started.Add(-time.Minute), now.Add(-30 * time.Minute), now}))
+	if review.Current != 2 {
+		t.Errorf("review quest progress = %d, want 2", review.Current)
+	}
+
+	bus.Publish(NewReviewApprovalsEvent([]time.Time{now.Add(-30 * time.Minute), now, now}))
+	if review.Status != QuestCompleted || review.Reward == nil {
+		t.Errorf("review quest status = %s (reward %v), want completed with reward", review.Status, review.Reward)
+	}
+
+	// Progress never goes backwards when approvals leave the lookup window
+	reviewBefore := review.Current
+	bus.Publish(NewReviewApprovalsEvent(nil))
+	if review.Current != reviewBefore {
+		t.Errorf("review progress dropped to %d, want %d", review.Current, reviewBefore)
+	}
+}
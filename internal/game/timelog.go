@@ -0,0 +1,75 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the time log: each uninterrupted stretch of the
+// session timer is kept as an entry, attributed to the repository worked
+// in and the quest being played, so the hours can be exported to time
+// trackers such as Toggl or Clockify.
+package game
+
+import (
+	"sort"
+	"time"
+)
+
+// MinTimeEntry is the shortest stretch worth logging; quicker start/pause
+// toggles are dropped.
+const MinTimeEntry = time.Minute
+
+// TimeEntry is one uninterrupted stretch of the session timer.
+type TimeEntry struct {
+	Start time.Time `json:"start"`           // When the timer started or resumed
+	End   time.Time `json:"end"`             // When the timer paused or stopped
+	Repo  string    `json:"repo,omitempty"`  // Repository committed to most during the stretch ("" if none)
+	Quest string    `json:"quest,omitempty"` // Title of the quest in progress ("" if none)
+}
+
+// Duration returns how long the stretch lasted.
+//
+// Returns:
+//   - time.Duration: End minus Start
+func (e TimeEntry) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// AppendTimeEntry adds an entry to a time log (oldest first) and drops
+// entries older than the retention policy's daily history window.
+// Entries shorter than MinTimeEntry aren't logged.
+//
+// Parameters:
+//   - log: The time log, oldest first
+//   - entry: The stretch that just ended
+//   - policy: Retention policy (RollupDays bounds the log)
+//   - now: Current time
+//
+// Returns:
+//   - []TimeEntry: The updated log
+func AppendTimeEntry(log []TimeEntry, entry TimeEntry, policy RetentionPolicy, now time.Time) []TimeEntry {
+	if entry.Duration() >= MinTimeEntry {
+		log = append(log, entry)
+		sort.SliceStable(log, func(i, j int) bool { return log[i].Start.Before(log[j].Start) })
+	}
+
+	cutoff := policy.Clock.Day(now).AddDate(0, 0, -policy.RollupDays)
+	kept := 0
+	for kept < len(log) && log[kept].End.Before(cutoff) {
+		kept++
+	}
+	return log[kept:]
+}
+
+// BusiestRepo picks the repository with the most commits, breaking ties
+// by path so the choice is stable.
+//
+// Parameters:
+//   - commits: Commits per repository path
+//
+// Returns:
+//   - string: The busiest repository ("" if there were no commits)
+func BusiestRepo(commits map[string]int) string {
+	busiest, most := "", 0
+	for repo, count := range commits {
+		if count > most || count == most && count > 0 && repo < busiest {
+			busiest, most = repo, count
+		}
+	}
+	return busiest
+}
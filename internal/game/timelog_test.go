@@ -0,0 +1,63 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestAppendTimeEntry tests logging timer stretches, skipping blips and
+// dropping entries past the retention window.
+func TestAppendTimeEntry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := RetentionPolicy{RawDays: 30, RollupDays: 100, Clock: StreakClock{Location: time.UTC}}
+	stretch := func(daysAgo int, length time.Duration) TimeEntry {
+		start := now.AddDate(0, 0, -daysAgo)
+		return TimeEntry{Start: start, End: start.Add(length)}
+	}
+
+	tests := []struct {
+		name    string
+		log     []TimeEntry
+		entry   TimeEntry
+		wantLen int
+	}{
+		{"first entry", nil, stretch(0, time.Hour), 1},
+		{"blip is skipped", []TimeEntry{stretch(1, time.Hour)}, stretch(0, 30*time.Second), 1},
+		{"old entries dropped", []TimeEntry{stretch(150, time.Hour), stretch(2, time.Hour)}, stretch(0, time.Hour), 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AppendTimeEntry(tt.log, tt.entry, policy, now)
+			if len(got) != tt.wantLen {
+				t.Fatalf("AppendTimeEntry() kept %d entries, want %d: %+v", len(got), tt.wantLen, got)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Start.Before(got[i-1].Start) {
+					t.Errorf("log not oldest first: %+v", got)
+				}
+			}
+		})
+	}
+}
+
+// TestBusiestRepo tests attributing a stretch to the repo with the most commits.
+func TestBusiestRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		commits map[string]int
+		want    string
+	}{
+		{"no commits", nil, ""},
+		{"most commits wins", map[string]int{"/src/api": 1, "/src/web": 3}, "/src/web"},
+		{"ties break by path", map[string]int{"/src/web": 2, "/src/api": 2}, "/src/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BusiestRepo(tt.commits); got != tt.want {
+				t.Errorf("BusiestRepo(%v) = %q, want %q", tt.commits, got, tt.want)
+			}
+		})
+	}
+}
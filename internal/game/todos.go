@@ -0,0 +1,226 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements quest suggestions from TODO/FIXME comments: comments
+// found in watched repositories are grouped by package and can be turned
+// into todo quests, which complete once a commit removes the comment.
+package game
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// todoQuestXP is the XP reward for resolving a TODO comment (FIXMEs are
+// worth half as much again).
+const todoQuestXP = 100
+
+// todoTitleLength is the longest comment text used in a quest title.
+const todoTitleLength = 40
+
+// todoPattern matches a TODO or FIXME at the start of a comment, with an
+// optional "(owner)" and separator before the text.
+var todoPattern = regexp.MustCompile(`(?://|#|/\*|\*|--|<!--|;)\s*(TODO|FIXME)\b(?:\([^)]*\))?[:\s-]*(.*)`)
+
+// TodoComment is a TODO or FIXME comment in a repository.
+type TodoComment struct {
+	Repo string `json:"repo"` // Repository path
+	Path string `json:"path"` // File path relative to the repository root
+	Line int    `json:"line"` // 1-based line number
+	Kind string `json:"kind"` // "TODO" or "FIXME"
+	Text string `json:"text"` // Comment text after the marker
+}
+
+// Key identifies the comment independently of its line number, which shifts
+// as the file around it changes.
+func (t TodoComment) Key() string {
+	return t.Repo + "\x00" + t.Path + "\x00" + t.Kind + "\x00" + t.Text
+}
+
+// Title is a short label for the comment ("TODO: text", with long text
+// cut off), used as its quest's title.
+func (t TodoComment) Title() string {
+	text := t.Text
+	if runes := []rune(text); len(runes) > todoTitleLength {
+		text = string(runes[:todoTitleLength-1]) + "…"
+	}
+	return t.Kind + ": " + text
+}
+
+// Package returns the directory the comment's file is in ("." at the root).
+func (t TodoComment) Package() string {
+	return path.Dir(t.Path)
+}
+
+// TodoGroup is the comments of one package (directory) of a repository.
+type TodoGroup struct {
+	Repo    string        // Repository path
+	Package string        // Directory relative to the repository root
+	Todos   []TodoComment // Comments sorted by file and line
+}
+
+// ParseTodos finds the TODO and FIXME comments in a file. Markers must be
+// upper case and start a comment (//, #, /*, *, --, <!-- or ;); comments
+// with no text after the marker are skipped.
+//
+// Parameters:
+//   - file: File path relative to the repository root
+//   - content: File contents
+//
+// Returns:
+//   - []TodoComment: Comments in line order (Repo unset)
+func ParseTodos(file, content string) []TodoComment {
+	var todos []TodoComment
+	for i, line := range strings.Split(content, "\n") {
+		match := todoPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		text := strings.TrimSpace(match[2])
+		text = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(text, "*/"), "-->"))
+		if text == "" {
+			continue
+		}
+		todos = append(todos, TodoComment{Path: file, Line: i + 1, Kind: match[1], Text: text})
+	}
+	return todos
+}
+
+// ResolvedTodos returns the comments a change to a file removed: those in
+// the old contents with no comment of the same kind and text left in the
+// new contents.
+//
+// Parameters:
+//   - file: File path relative to the repository root
+//   - before: Contents before the change ("" if the file was added)
+//   - after: Contents after the change ("" if the file was deleted)
+//
+// Returns:
+//   - []TodoComment: Removed comments, at their old line numbers (Repo unset)
+func ResolvedTodos(file, before, after string) []TodoComment {
+	remaining := make(map[string]int)
+	for _, todo := range ParseTodos(file, after) {
+		remaining[todo.Key()]++
+	}
+
+	var resolved []TodoComment
+	for _, todo := range ParseTodos(file, before) {
+		if remaining[todo.Key()] > 0 {
+			remaining[todo.Key()]--
+			continue
+		}
+		resolved = append(resolved, todo)
+	}
+	return resolved
+}
+
+// GroupTodos groups comments by repository and package, sorted by path,
+// with each group's comments sorted by file and line.
+//
+// Parameters:
+//   - todos: Comments to group
+//
+// Returns:
+//   - []TodoGroup: One group per repository package
+func GroupTodos(todos []TodoComment) []TodoGroup {
+	byPackage := make(map[[2]string]*TodoGroup)
+	var groups []*TodoGroup
+	for _, todo := range todos {
+		id := [2]string{todo.Repo, todo.Package()}
+		group, ok := byPackage[id]
+		if !ok {
+			group = &TodoGroup{Repo: todo.Repo, Package: todo.Package()}
+			byPackage[id] = group
+			groups = append(groups, group)
+		}
+		group.Todos = append(group.Todos, todo)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Repo != groups[j].Repo {
+			return groups[i].Repo < groups[j].Repo
+		}
+		return groups[i].Package < groups[j].Package
+	})
+	result := make([]TodoGroup, len(groups))
+	for i, group := range groups {
+		sort.SliceStable(group.Todos, func(a, b int) bool {
+			if group.Todos[a].Path != group.Todos[b].Path {
+				return group.Todos[a].Path < group.Todos[b].Path
+			}
+			return group.Todos[a].Line < group.Todos[b].Line
+		})
+		result[i] = *group
+	}
+	return result
+}
+
+// UnclaimedTodos drops comments that already have a todo quest (in any
+// status), so suggestions only offer new work.
+//
+// Parameters:
+//   - todos: Comments found in watched repositories
+//   - quests: Existing quests
+//
+// Returns:
+//   - []TodoComment: Comments without a quest
+func UnclaimedTodos(todos []TodoComment, quests []*Quest) []TodoComment {
+	claimed := make(map[string]bool)
+	for _, quest := range quests {
+		if quest.Todo != nil {
+			claimed[quest.Todo.Key()] = true
+		}
+	}
+
+	var unclaimed []TodoComment
+	for _, todo := range todos {
+		if !claimed[todo.Key()] {
+			unclaimed = append(unclaimed, todo)
+		}
+	}
+	return unclaimed
+}
+
+// NewTodoQuest creates a quest to resolve a comment. It completes when a
+// commit in the comment's repository removes it.
+//
+// Parameters:
+//   - todo: The comment to resolve
+//
+// Returns:
+//   - *Quest: An available todo quest
+func NewTodoQuest(todo TodoComment) *Quest {
+	xp := todoQuestXP
+	if todo.Kind == "FIXME" {
+		xp = todoQuestXP * 3 / 2
+	}
+
+	return NewQuestBuilder().
+		Title(todo.Title()).
+		Description(fmt.Sprintf("Resolve the %s at %s:%d (%s). Completes when a commit removes the comment.", todo.Kind, todo.Path, todo.Line, todo.Text)).
+		Type(QuestTypeTodo).
+		XPReward(xp).
+		Todo(todo).
+		MustBuild()
+}
+
+// ResolvedBy reports whether a commit's removed comments include the one
+// this todo quest is for.
+//
+// Parameters:
+//   - resolved: Comments removed by a commit
+//
+// Returns:
+//   - bool: True if the quest's comment was removed
+func (q *Quest) ResolvedBy(resolved []TodoComment) bool {
+	if q.Todo == nil {
+		return false
+	}
+	for _, todo := range resolved {
+		if todo.Key() == q.Todo.Key() {
+			return true
+		}
+	}
+	return false
+}
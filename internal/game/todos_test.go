@@ -0,0 +1,127 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestParseTodos tests which comment lines are recognized as TODOs.
+func TestParseTodos(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		wantKind string
+		wantText string
+	}{
+		{"go comment", "\tx := 1 // TODO: handle overflow", "TODO", "handle overflow"},
+		{"owner", "# FIXME(alice) - flaky on CI", "FIXME", "flaky on CI"},
+		{"block comment", "/* TODO retry the request */", "TODO", "retry the request"},
+		{"html comment", "<!-- TODO: add screenshots -->", "TODO", "add screenshots"},
+		{"lower case", "// todo: not a marker", "", ""},
+		{"no text", "// TODO", "", ""},
+		{"not a comment", `msg := "TODO list"`, "", ""},
+		{"word prefix", "// TODOS are tracked elsewhere", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := ParseTodos("main.go", "package main\n"+tt.line+"\n")
+			if tt.wantKind == "" {
+				if len(todos) != 0 {
+					t.Errorf("ParseTodos() = %+v, want none", todos)
+				}
+				return
+			}
+			if len(todos) != 1 {
+				t.Fatalf("ParseTodos() found %d comments, want 1", len(todos))
+			}
+			if todos[0].Kind != tt.wantKind || todos[0].Text != tt.wantText || todos[0].Line != 2 {
+				t.Errorf("ParseTodos() = %+v, want %s %q on line 2", todos[0], tt.wantKind, tt.wantText)
+			}
+		})
+	}
+}
+
+// TestResolvedTodos tests that only comments gone from the new contents
+// count as resolved, wherever the remaining ones moved.
+func TestResolvedTodos(t *testing.T) {
+	before := "// TODO: cache results\nfunc a() {}\n// FIXME: leaks\n// TODO: cache results\n"
+	after := "func a() {}\n\n// TODO: cache results\n"
+
+	resolved := ResolvedTodos("a.go", before, after)
+	if len(resolved) != 2 {
+		t.Fatalf("ResolvedTodos() = %+v, want the FIXME and one duplicate TODO", resolved)
+	}
+	if resolved[0].Text != "leaks" && resolved[1].Text != "leaks" {
+		t.Errorf("ResolvedTodos() = %+v, want the FIXME resolved", resolved)
+	}
+	if got := ResolvedTodos("a.go", after, after); len(got) != 0 {
+		t.Errorf("unchanged file resolved %+v, want none", got)
+	}
+}
+
+// TestGroupTodos tests grouping by package and filtering out comments that
+// already have a quest.
+func TestGroupTodos(t *testing.T) {
+	todos := []TodoComment{
+		{Repo: "/r", Path: "internal/ui/app.go", Line: 9, Kind: "TODO", Text: "b"},
+		{Repo: "/r", Path: "main.go", Line: 3, Kind: "FIXME", Text: "c"},
+		{Repo: "/r", Path: "internal/ui/app.go", Line: 2, Kind: "TODO", Text: "a"},
+	}
+
+	groups := GroupTodos(todos)
+	if len(groups) != 2 || groups[0].Package != "." || groups[1].Package != "internal/ui" {
+		t.Fatalf("GroupTodos() = %+v, want . then internal/ui", groups)
+	}
+	if ui := groups[1].Todos; ui[0].Line != 2 || ui[1].Line != 9 {
+		t.Errorf("internal/ui todos = %+v, want sorted by line", ui)
+	}
+
+	quests := []*Quest{NewTodoQuest(todos[1])}
+	if got := UnclaimedTodos(todos, quests); len(got) != 2 {
+		t.Errorf("UnclaimedTodos() = %+v, want the two TODOs", got)
+	}
+}
+
+// TestTodoQuestCompletesWhenCommentRemoved tests that a todo quest completes
+// only when a commit in its repository removes its comment.
+func TestTodoQuestCompletesWhenCommentRemoved(t *testing.T) {
+	todo := TodoComment{Repo: "/work/app", Path: "db/db.go", Line: 12, Kind: "FIXME", Text: "close rows"}
+	quest := NewTodoQuest(todo)
+	if quest.Title != "FIXME: close rows" || quest.XPReward != 150 {
+		t.Errorf("NewTodoQuest() = %q worth %d XP, want FIXME title worth 150", quest.Title, quest.XPReward)
+	}
+	if err := quest.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	bus := NewEventBus()
+	handler, err := NewGameEventHandler(NewCharacter("Tidy"), []*Quest{quest}, bus, &memoryStorage{}, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewGameEventHandler() error = %v", err)
+	}
+	if err := handler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer handler.Stop()
+
+	// The same comment removed in another repository doesn't count
+	other := todo
+	other.Repo = "/work/other"
+	event := NewCommitEvent("0123456789abcdef", "Close rows", 1, 1, 1)
+	event.Data["resolved_todos"] = []TodoComment{other}
+	bus.Publish(event)
+	if quest.Status != QuestActive {
+		t.Fatalf("quest status = %s after another repo's commit, want active", quest.Status)
+	}
+
+	moved := todo
+	moved.Line = 40 // Line numbers shift as the file changes
+	event = NewCommitEvent("fedcba9876543210", "Close rows", 1, 1, 1)
+	event.Data["resolved_todos"] = []TodoComment{moved}
+	bus.Publish(event)
+	if quest.Status != QuestCompleted {
+		t.Errorf("quest status = %s, want completed", quest.Status)
+	}
+}
@@ -0,0 +1,103 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements level-gated feature unlocks: advanced views stay
+// locked until the character reaches a low level threshold, so new players
+// meet the basics first and discover the rest as they level up.
+package game
+
+import "fmt"
+
+// Feature unlock IDs
+const (
+	FeatureSkills        = "skills"         // Spending and respeccing skill points
+	FeatureStatDetails   = "stat_details"   // Per-stat effects popover on the character sheet
+	FeatureQuestCalendar = "quest_calendar" // Four-week quest calendar
+	FeatureTodoQuests    = "todo_quests"    // Quests from TODO/FIXME comments
+)
+
+// FeatureUnlock is a feature that opens up at a character level.
+type FeatureUnlock struct {
+	ID    string // Stable identifier (one of the Feature* constants)
+	Name  string // Display name
+	Level int    // Level the feature unlocks at
+	Hint  string // How to reach the feature, shown when it unlocks
+}
+
+// featureUnlocks is the central unlock table, in unlock order.
+var featureUnlocks = []FeatureUnlock{
+	{ID: FeatureSkills, Name: "Skill Points", Level: 2, Hint: "Spend them with 1-3 on the character sheet"},
+	{ID: FeatureStatDetails, Name: "Stat Details", Level: 3, Hint: "Press I on the character sheet"},
+	{ID: FeatureQuestCalendar, Name: "Quest Calendar", Level: 3, Hint: "Press V on the quest board"},
+	{ID: FeatureTodoQuests, Name: "TODO Quests", Level: 4, Hint: "Press T on the quest board"},
+}
+
+// LookupFeatureUnlock finds a feature in the unlock table.
+//
+// Parameters:
+//   - id: Feature ID
+//
+// Returns:
+//   - FeatureUnlock: The feature's unlock entry
+//   - bool: False if the feature isn't level-gated
+func LookupFeatureUnlock(id string) (FeatureUnlock, bool) {
+	for _, feature := range featureUnlocks {
+		if feature.ID == id {
+			return feature, true
+		}
+	}
+	return FeatureUnlock{}, false
+}
+
+// FeatureUnlockLevel returns the level a feature unlocks at.
+//
+// Parameters:
+//   - id: Feature ID
+//
+// Returns:
+//   - int: The unlock level (1 for features that aren't gated)
+func FeatureUnlockLevel(id string) int {
+	if feature, ok := LookupFeatureUnlock(id); ok {
+		return feature.Level
+	}
+	return 1
+}
+
+// IsFeatureUnlocked reports whether a character level has unlocked a feature.
+//
+// Parameters:
+//   - id: Feature ID
+//   - level: Character level
+//
+// Returns:
+//   - bool: True if the feature is available
+func IsFeatureUnlocked(id string, level int) bool {
+	return level >= FeatureUnlockLevel(id)
+}
+
+// FeatureLockedText describes when a locked feature opens up.
+//
+// Parameters:
+//   - id: Feature ID
+//
+// Returns:
+//   - string: e.g. "Unlocks at level 3"
+func FeatureLockedText(id string) string {
+	return fmt.Sprintf("Unlocks at level %d", FeatureUnlockLevel(id))
+}
+
+// FeaturesUnlockedBetween returns the features a level-up unlocked.
+//
+// Parameters:
+//   - oldLevel: Level before the level-up
+//   - newLevel: Level reached
+//
+// Returns:
+//   - []FeatureUnlock: Features with oldLevel < Level <= newLevel, in unlock order
+func FeaturesUnlockedBetween(oldLevel, newLevel int) []FeatureUnlock {
+	var unlocked []FeatureUnlock
+	for _, feature := range featureUnlocks {
+		if feature.Level > oldLevel && feature.Level <= newLevel {
+			unlocked = append(unlocked, feature)
+		}
+	}
+	return unlocked
+}
@@ -0,0 +1,55 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestIsFeatureUnlocked tests gating features by character level.
+func TestIsFeatureUnlocked(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		level int
+		want  bool
+	}{
+		{name: "below the threshold", id: FeatureQuestCalendar, level: 2, want: false},
+		{name: "at the threshold", id: FeatureQuestCalendar, level: 3, want: true},
+		{name: "above the threshold", id: FeatureSkills, level: 10, want: true},
+		{name: "ungated feature", id: "dashboard", level: 1, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsFeatureUnlocked(tt.id, tt.level); got != tt.want {
+				t.Errorf("IsFeatureUnlocked(%q, %d) = %v, want %v", tt.id, tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFeaturesUnlockedBetween tests which features a level-up announces.
+func TestFeaturesUnlockedBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldLevel int
+		newLevel int
+		want     []string
+	}{
+		{name: "first level-up", oldLevel: 1, newLevel: 2, want: []string{FeatureSkills}},
+		{name: "several levels at once", oldLevel: 2, newLevel: 4, want: []string{FeatureStatDetails, FeatureQuestCalendar, FeatureTodoQuests}},
+		{name: "nothing left to unlock", oldLevel: 9, newLevel: 10, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, feature := range FeaturesUnlockedBetween(tt.oldLevel, tt.newLevel) {
+				got = append(got, feature.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FeaturesUnlockedBetween(%d, %d) = %v, want %v", tt.oldLevel, tt.newLevel, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the welcome-back summary: when the player returns
+// after WelcomeBackDays or more without activity, they're told what
+// happened while they were away (streak, expired quests, missed dailies)
+// and offered a quest to get going again, and stale recurring quests are
+// rolled over instead of lingering on the board.
+package game
+
+import (
+	"sort"
+	"time"
+)
+
+// WelcomeBackDays is how many days without activity earn a welcome back.
+const WelcomeBackDays = 3
+
+// WelcomeBack is what happened while the player was away.
+type WelcomeBack struct {
+	DaysAway      int      // Streak days since the last activity
+	Streak        int      // Streak when the player left
+	StreakKept    bool     // Streak freezes cover the missed days
+	FreezesNeeded int      // Freezes the next commit uses up (when kept)
+	ExpiredQuests []*Quest // Open quests whose deadline passed
+	MissedDailies []*Quest // Daily and monthly quests that expired unfinished (now reset)
+	Recommended   *Quest   // Quest to get going again (nil if none can be started)
+}
+
+// TakeWelcomeBack summarizes the player's absence, once per absence: the
+// time it was shown is recorded on the character, so it isn't repeated
+// until the player has been active and away again. Daily and monthly
+// quests from an earlier period are reset, as a commit would have done.
+//
+// Parameters:
+//   - quests: All quests (stale recurring quests are reset)
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - WelcomeBack: The absence
+//   - bool: False if the player wasn't away long enough or was already welcomed back
+func (c *Character) TakeWelcomeBack(quests []*Quest, now time.Time, clock StreakClock) (WelcomeBack, bool) {
+	if c.LastActiveDate.IsZero() || c.WelcomedBackAt.After(c.LastActiveDate) {
+		return WelcomeBack{}, false
+	}
+	away := int(clock.Day(now).Sub(clock.Day(c.LastActiveDate)).Hours() / 24)
+	if away < WelcomeBackDays {
+		return WelcomeBack{}, false
+	}
+	c.WelcomedBackAt = now
+
+	welcome := WelcomeBack{DaysAway: away, Streak: c.CurrentStreak}
+	if missed := away - 1; c.CurrentStreak > 0 && missed <= c.StreakFreezes {
+		welcome.StreakKept = true
+		welcome.FreezesNeeded = missed
+	}
+
+	today := clock.Day(now)
+	for _, quest := range quests {
+		switch {
+		case quest.NeedsDailyReset(now, clock) || quest.NeedsMonthlyReset(now, clock):
+			if quest.Status == QuestActive {
+				welcome.MissedDailies = append(welcome.MissedDailies, quest)
+			}
+			quest.Reset()
+		case quest.Deadline != nil && clock.Day(*quest.Deadline).Before(today) &&
+			(quest.Status == QuestAvailable || quest.Status == QuestActive):
+			welcome.ExpiredQuests = append(welcome.ExpiredQuests, quest)
+		}
+	}
+
+	welcome.Recommended = RecommendQuest(quests, c, now, clock)
+	return welcome, true
+}
+
+// RecommendQuest picks an available quest that's easy to get going with:
+// the Redemption quest when a streak is waiting to be won back, otherwise
+// the quest with the smallest target (the most XP breaks ties). Quests the
+// character can't start yet or whose deadline has passed are skipped.
+//
+// Parameters:
+//   - quests: All quests
+//   - c: The character
+//   - now: Current time
+//   - clock: Streak day boundaries
+//
+// Returns:
+//   - *Quest: The recommended quest (nil if none can be started)
+func RecommendQuest(quests []*Quest, c *Character, now time.Time, clock StreakClock) *Quest {
+	var candidates []*Quest
+	for _, quest := range quests {
+		if quest.Status != QuestAvailable || !quest.IsAvailable(c) {
+			continue
+		}
+		if quest.Deadline != nil && clock.Day(*quest.Deadline).Before(clock.Day(now)) {
+			continue
+		}
+		if quest.Template == RedemptionTemplateID && c.LostStreak > 0 {
+			return quest
+		}
+		candidates = append(candidates, quest)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Target != candidates[j].Target {
+			return candidates[i].Target < candidates[j].Target
+		}
+		return candidates[i].XPReward > candidates[j].XPReward
+	})
+	return candidates[0]
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTakeWelcomeBack tests when the welcome back is shown and what it
+// reports about the streak and quests.
+func TestTakeWelcomeBack(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	tests := []struct {
+		name       string
+		away       int
+		freezes    int
+		welcomed   time.Time
+		wantOK     bool
+		wantKept   bool
+		wantFreeze int
+	}{
+		{name: "back after two days", away: 2},
+		{name: "streak lapsed", away: 5, wantOK: true},
+		{name: "freezes cover the gap", away: 3, freezes: 2, wantOK: true, wantKept: true, wantFreeze: 2},
+		{name: "already welcomed back", away: 4, welcomed: daysAgo(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCharacter("Tester")
+			c.CurrentStreak = 6
+			c.StreakFreezes = tt.freezes
+			c.LastActiveDate = daysAgo(tt.away)
+			c.WelcomedBackAt = tt.welcomed
+
+			welcome, ok := c.TakeWelcomeBack(nil, now, clock)
+			if ok != tt.wantOK {
+				t.Fatalf("TakeWelcomeBack() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if welcome.DaysAway != tt.away || welcome.Streak != 6 || welcome.StreakKept != tt.wantKept || welcome.FreezesNeeded != tt.wantFreeze {
+				t.Errorf("welcome = %+v", welcome)
+			}
+			if _, again := c.TakeWelcomeBack(nil, now, clock); again {
+				t.Error("the welcome back should only be shown once per absence")
+			}
+		})
+	}
+}
+
+// TestTakeWelcomeBackQuests tests that stale dailies are reset and reported,
+// overdue quests are reported, and a startable quest is recommended.
+func TestTakeWelcomeBackQuests(t *testing.T) {
+	clock := StreakClock{Location: time.UTC}
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	c := NewCharacter("Tester")
+	c.LastActiveDate = now.AddDate(0, 0, -4)
+
+	daily := NewQuestBuilder().Title("Daily").Type(QuestTypeDaily).MustBuild()
+	daily.StartAt("", "", now.AddDate(0, 0, -4))
+	overdue := NewQuestBuilder().Title("Overdue").Type(QuestTypeCommit).Target(5).Deadline(now.AddDate(0, 0, -1)).MustBuild()
+	big := NewQuestBuilder().Title("Big").Type(QuestTypeLines).Target(500).XPReward(300).MustBuild()
+	small := NewQuestBuilder().Title("Small").Type(QuestTypeCommit).Target(2).XPReward(50).MustBuild()
+	locked := NewQuestBuilder().Title("Locked").Type(QuestTypeCommit).Target(1).RequiredLevel(50).MustBuild()
+	quests := []*Quest{daily, overdue, big, small, locked}
+
+	welcome, ok := c.TakeWelcomeBack(quests, now, clock)
+	if !ok {
+		t.Fatal("TakeWelcomeBack() ok = false after four days away")
+	}
+	if len(welcome.MissedDailies) != 1 || welcome.MissedDailies[0] != daily || daily.Status != QuestAvailable {
+		t.Errorf("MissedDailies = %v, daily status %s; want the daily reported and reset", welcome.MissedDailies, daily.Status)
+	}
+	if len(welcome.ExpiredQuests) != 1 || welcome.ExpiredQuests[0] != overdue {
+		t.Errorf("ExpiredQuests = %v, want the overdue quest", welcome.ExpiredQuests)
+	}
+	if welcome.Recommended != daily {
+		t.Errorf("Recommended = %v, want the reset daily (smallest target)", welcome.Recommended)
+	}
+
+	// With a broken streak, the Redemption quest comes first
+	c.LostStreak = 10
+	quests, _ = EnsureRedemptionQuest(quests, c)
+	if got := RecommendQuest(quests, c, now, clock); got == nil || got.Template != RedemptionTemplateID {
+		t.Errorf("RecommendQuest() = %v, want the Redemption quest", got)
+	}
+}
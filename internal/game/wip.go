@@ -0,0 +1,133 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements work-in-progress detection: uncommitted changes that
+// sit in a watched repository's working tree for days (see the [wip]
+// settings) bring a nudge and a quest to commit or stash them, which
+// completes once the working tree is clear again.
+package game
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// wipQuestXP is the XP reward for committing or stashing work left sitting
+const wipQuestXP = 50
+
+// UncommittedWork is the uncommitted changes in a repository's working tree.
+type UncommittedWork struct {
+	Repo         string    `json:"repo"`            // Repository path
+	Files        int       `json:"files"`           // Files with uncommitted changes (untracked ones included)
+	LinesAdded   int       `json:"lines_added"`     // Lines added since HEAD
+	LinesRemoved int       `json:"lines_removed"`   // Lines removed since HEAD
+	Since        time.Time `json:"since,omitempty"` // When the changes first reached the nudge threshold (set by TrackUncommittedWork)
+}
+
+// Lines returns the uncommitted lines added and removed.
+func (w UncommittedWork) Lines() int {
+	return w.LinesAdded + w.LinesRemoved
+}
+
+// RepoName returns the repository's directory name, for display.
+func (w UncommittedWork) RepoName() string {
+	return filepath.Base(w.Repo)
+}
+
+// TrackUncommittedWork records when each repository's uncommitted changes
+// first reached minLines, and forgets repositories that dropped below it
+// (committed or stashed).
+//
+// Parameters:
+//   - work: Uncommitted changes per checked repository (repositories that couldn't be checked are left out)
+//   - minLines: Uncommitted lines worth a nudge
+//   - minAge: How long they sit before the nudge
+//   - now: Current time
+//
+// Returns:
+//   - []UncommittedWork: Work at or over minLines for at least minAge, with Since set
+//   - []string: Tracked repositories whose work dropped below minLines
+func (c *Character) TrackUncommittedWork(work []UncommittedWork, minLines int, minAge time.Duration, now time.Time) ([]UncommittedWork, []string) {
+	var stale []UncommittedWork
+	var cleared []string
+	for _, w := range work {
+		since, tracked := c.UncommittedSince[w.Repo]
+		if w.Lines() < minLines {
+			if tracked {
+				delete(c.UncommittedSince, w.Repo)
+				cleared = append(cleared, w.Repo)
+			}
+			continue
+		}
+
+		if !tracked {
+			if c.UncommittedSince == nil {
+				c.UncommittedSince = make(map[string]time.Time)
+			}
+			since = now
+			c.UncommittedSince[w.Repo] = since
+		}
+		if now.Sub(since) >= minAge {
+			w.Since = since
+			stale = append(stale, w)
+		}
+	}
+	return stale, cleared
+}
+
+// NewWIPQuest creates a quest to commit or stash work left sitting in a
+// repository.
+//
+// Parameters:
+//   - work: The uncommitted changes, with Since set
+//
+// Returns:
+//   - *Quest: An available wip quest
+func NewWIPQuest(work UncommittedWork) *Quest {
+	return NewQuestBuilder().
+		Title("Commit or stash WIP in " + work.RepoName()).
+		Description(fmt.Sprintf("%d uncommitted lines in %d files have been sitting in %s since %s. Completes once they're committed or stashed.",
+			work.Lines(), work.Files, work.Repo, work.Since.Format("Jan 2"))).
+		Type(QuestTypeWIP).
+		XPReward(wipQuestXP).
+		WIP(work).
+		MustBuild()
+}
+
+// SyncWIPQuests brings wip quests in line with the working trees:
+// repositories with stale work and no open wip quest get one, and open wip
+// quests whose repository was cleared are returned so they can be completed.
+//
+// Parameters:
+//   - quests: Existing quests
+//   - stale: Work left sitting (see TrackUncommittedWork)
+//   - cleared: Repositories whose work was committed or stashed
+//
+// Returns:
+//   - []*Quest: The quests, with new wip quests appended
+//   - []*Quest: The quests added
+//   - []*Quest: Open wip quests whose work was cleared
+func SyncWIPQuests(quests []*Quest, stale []UncommittedWork, cleared []string) ([]*Quest, []*Quest, []*Quest) {
+	open := make(map[string]*Quest)
+	for _, quest := range quests {
+		if quest.WIP != nil && (quest.Status == QuestAvailable || quest.Status == QuestActive) {
+			open[quest.WIP.Repo] = quest
+		}
+	}
+
+	var added, done []*Quest
+	for _, work := range stale {
+		if _, ok := open[work.Repo]; ok {
+			continue
+		}
+		quest := NewWIPQuest(work)
+		open[work.Repo] = quest
+		quests = append(quests, quest)
+		added = append(added, quest)
+	}
+	for _, repo := range cleared {
+		if quest, ok := open[repo]; ok {
+			done = append(done, quest)
+		}
+	}
+	return quests, added, done
+}
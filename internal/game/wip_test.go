@@ -0,0 +1,84 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTrackUncommittedWork tests when uncommitted work goes stale and when
+// it counts as cleared.
+func TestTrackUncommittedWork(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	c := NewCharacter("Tester")
+	check := func(now time.Time, work ...UncommittedWork) ([]UncommittedWork, []string) {
+		return c.TrackUncommittedWork(work, 200, 48*time.Hour, now)
+	}
+
+	// Small changes aren't tracked at all
+	if stale, cleared := check(start, UncommittedWork{Repo: "/a", LinesAdded: 50}); stale != nil || cleared != nil {
+		t.Errorf("small work: stale %v, cleared %v, want neither", stale, cleared)
+	}
+	if len(c.UncommittedSince) != 0 {
+		t.Errorf("UncommittedSince = %v, want empty", c.UncommittedSince)
+	}
+
+	// Big changes are tracked from when they're first seen, and go stale after two days
+	big := UncommittedWork{Repo: "/a", LinesAdded: 300, LinesRemoved: 100}
+	if stale, _ := check(start, big); stale != nil {
+		t.Errorf("fresh work is stale: %v", stale)
+	}
+	if stale, _ := check(start.Add(47*time.Hour), big); stale != nil {
+		t.Errorf("work under two days old is stale: %v", stale)
+	}
+	stale, _ := check(start.Add(49*time.Hour), big)
+	if len(stale) != 1 || !stale[0].Since.Equal(start) || stale[0].Lines() != 400 {
+		t.Fatalf("stale = %+v, want /a's 400 lines since %v", stale, start)
+	}
+
+	// A repository that couldn't be checked keeps its place; a clean one clears
+	if _, cleared := check(start.Add(50 * time.Hour)); cleared != nil {
+		t.Errorf("unchecked repository cleared: %v", cleared)
+	}
+	_, cleared := check(start.Add(51*time.Hour), UncommittedWork{Repo: "/a"})
+	if len(cleared) != 1 || cleared[0] != "/a" {
+		t.Errorf("cleared = %v, want [/a]", cleared)
+	}
+	if _, tracked := c.UncommittedSince["/a"]; tracked {
+		t.Error("cleared repository is still tracked")
+	}
+}
+
+// TestSyncWIPQuests tests that stale work gets one open quest per
+// repository, and clearing the work returns that quest for completion.
+func TestSyncWIPQuests(t *testing.T) {
+	work := UncommittedWork{Repo: "/src/codequest", Files: 3, LinesAdded: 400, Since: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	quests, added, done := SyncWIPQuests(nil, []UncommittedWork{work}, nil)
+	if len(added) != 1 || len(quests) != 1 || done != nil {
+		t.Fatalf("first sync: %d quests, %d added, %d done; want 1, 1, 0", len(quests), len(added), len(done))
+	}
+	quest := added[0]
+	if quest.Type != QuestTypeWIP || quest.Title != "Commit or stash WIP in codequest" || quest.WIP.Repo != work.Repo {
+		t.Errorf("quest = %q (%s, %+v)", quest.Title, quest.Type, quest.WIP)
+	}
+
+	// Still stale: no second quest
+	quests, added, _ = SyncWIPQuests(quests, []UncommittedWork{work}, nil)
+	if len(added) != 0 || len(quests) != 1 {
+		t.Errorf("second sync added %d quests, want 0", len(added))
+	}
+
+	// Cleared elsewhere: nothing to complete
+	if _, _, done = SyncWIPQuests(quests, nil, []string{"/src/other"}); done != nil {
+		t.Errorf("done = %v for another repository", done)
+	}
+	if _, _, done = SyncWIPQuests(quests, nil, []string{work.Repo}); len(done) != 1 || done[0] != quest {
+		t.Errorf("done = %v, want the wip quest", done)
+	}
+
+	// A completed quest doesn't stop the next stale spell getting a new one
+	quest.Status = QuestCompleted
+	if _, added, _ = SyncWIPQuests(quests, []UncommittedWork{work}, nil); len(added) != 1 {
+		t.Errorf("added %d quests after completion, want 1", len(added))
+	}
+}
@@ -0,0 +1,215 @@
+// Package game contains the core game logic for CodeQuest.
+// This file implements the custom XP rules ([[xp_rules]] in the config):
+// conditions over a commit's fields that scale its XP, add a bonus or flag
+// it, so power users can say "commits to the work repo between 9 and 17
+// earn half XP" without code changes. RewardEngine applies them.
+package game
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// XPRuleResult is what the custom XP rules did to a commit's XP.
+type XPRuleResult struct {
+	XP    int      // XP after the rules
+	Rules []string // Names of the rules that matched, in order
+	Flags []string // Flags the matching rules attached (no duplicates)
+}
+
+// Flagged reports whether a matching rule attached a flag.
+//
+// Parameters:
+//   - flag: The flag (e.g. config.XPRuleFlagReview)
+//
+// Returns:
+//   - bool: True if the flag is attached
+func (r XPRuleResult) Flagged(flag string) bool {
+	return slices.Contains(r.Flags, flag)
+}
+
+// XPRuleFieldValues returns a commit's values for the XP rule condition
+// fields (see config.XPRuleFields). Times are in the home timezone.
+//
+// Parameters:
+//   - event: The commit event
+//   - cfg: Application configuration (for projects and the home timezone; may be nil)
+//
+// Returns:
+//   - map[string]string: Field values by field name
+func XPRuleFieldValues(event Event, cfg *config.Config) map[string]string {
+	linesAdded, _ := event.Data["lines_added"].(int)
+	linesRemoved, _ := event.Data["lines_removed"].(int)
+	files, _ := event.Data["files_changed"].(int)
+	repoPath, _ := event.Data["repo_path"].(string)
+	message, _ := event.Data["message"].(string)
+	author, _ := event.Data["author"].(string)
+	email, _ := event.Data["email"].(string)
+	bot, _ := event.Data["bot"].(bool)
+	retroactive, _ := event.Data["retroactive"].(bool)
+	at := commitTime(event).In(StreakClockFromConfig(cfg).location())
+	subject, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+	project := ""
+	if cfg != nil {
+		project = cfg.ProjectForRepo(repoPath)
+	}
+
+	return map[string]string{
+		"repo":          repoPath,
+		"project":       project,
+		"hour":          strconv.Itoa(at.Hour()),
+		"weekday":       at.Weekday().String(),
+		"lines":         strconv.Itoa(linesAdded + linesRemoved),
+		"lines_added":   strconv.Itoa(linesAdded),
+		"lines_removed": strconv.Itoa(linesRemoved),
+		"files":         strconv.Itoa(files),
+		"message":       subject,
+		"author":        author,
+		"email":         email,
+		"bot":           strconv.FormatBool(bot),
+		"retroactive":   strconv.FormatBool(retroactive),
+	}
+}
+
+// ApplyXPRules applies every custom XP rule that matches a commit, in
+// config order: each multiplies the XP so far, then adds its bonus.
+//
+// Parameters:
+//   - xp: The commit's XP before the rules
+//   - event: The commit event
+//   - cfg: Application configuration (nil or no rules leaves XP unchanged)
+//
+// Returns:
+//   - XPRuleResult: The XP after the rules, and which rules and flags applied
+func ApplyXPRules(xp int, event Event, cfg *config.Config) XPRuleResult {
+	result := XPRuleResult{XP: xp}
+	if cfg == nil || len(cfg.XPRules) == 0 {
+		return result
+	}
+
+	fields := XPRuleFieldValues(event, cfg)
+	for _, rule := range cfg.XPRules {
+		if !rule.Matches(fields) {
+			continue
+		}
+		result.XP = rule.Apply(result.XP)
+		result.Rules = append(result.Rules, rule.Name)
+		for _, flag := range rule.Flags {
+			if flag = strings.TrimSpace(flag); flag != "" && !result.Flagged(flag) {
+				result.Flags = append(result.Flags, flag)
+			}
+		}
+	}
+	return result
+}
+
+// ParseXPRuleSample builds a commit event to dry-run the XP rules against
+// from "field=value" pairs, e.g. `repo=~/work/api hour=10 lines=40
+// message="WIP: spike"`. Fields are those of config.XPRuleFields except
+// project, which follows from repo; lines counts as lines added. Unset
+// fields are zero, and the commit is made now, at the given hour and
+// weekday if set.
+//
+// Parameters:
+//   - sample: The field=value pairs (values with spaces in quotes)
+//   - cfg: Application configuration (for the home timezone; may be nil)
+//   - now: Current time
+//
+// Returns:
+//   - Event: A commit event with the sample's fields
+//   - error: Unknown field or a value that doesn't fit it
+func ParseXPRuleSample(sample string, cfg *config.Config, now time.Time) (Event, error) {
+	pairs, err := splitSamplePairs(sample)
+	if err != nil {
+		return Event{}, err
+	}
+
+	at := now.In(StreakClockFromConfig(cfg).location())
+	event := NewCommitEvent("dry-run", "", 0, 0, 0)
+	for _, pair := range pairs {
+		name, value, _ := strings.Cut(pair, "=")
+		name = strings.ToLower(name)
+		if name == "project" {
+			return Event{}, fmt.Errorf("project follows from repo; set repo to a path in the project")
+		}
+		// Reuse the condition checks for the value
+		if _, err := config.ParseXPCondition(name + " = " + value); err != nil {
+			return Event{}, err
+		}
+
+		switch name {
+		case "repo":
+			path, err := config.ExpandPath(value)
+			if err != nil {
+				return Event{}, err
+			}
+			event.Data["repo_path"] = config.NormalizePath(path)
+		case "hour":
+			hour, _ := strconv.Atoi(value)
+			if hour < 0 || hour > 23 {
+				return Event{}, fmt.Errorf("hour: %d is not between 0 and 23", hour)
+			}
+			at = time.Date(at.Year(), at.Month(), at.Day(), hour, 0, 0, 0, at.Location())
+		case "weekday":
+			day := strings.ToLower(value)[:3] // Checked above: a weekday name or abbreviation
+			for strings.ToLower(at.Weekday().String()[:3]) != day {
+				at = at.AddDate(0, 0, 1)
+			}
+		case "lines", "lines_added", "lines_removed", "files":
+			count, _ := strconv.Atoi(value)
+			key := map[string]string{"lines": "lines_added", "files": "files_changed"}[name]
+			if key == "" {
+				key = name
+			}
+			event.Data[key] = count
+		case "bot", "retroactive":
+			flag, _ := strconv.ParseBool(value)
+			event.Data[name] = flag
+		default:
+			event.Data[name] = value
+		}
+	}
+	event.Timestamp = at
+	event.Data["timestamp"] = at
+	return event, nil
+}
+
+// splitSamplePairs splits a dry-run sample into field=value pairs, keeping
+// quoted values whole and dropping the quotes.
+func splitSamplePairs(sample string) ([]string, error) {
+	var pairs []string
+	var current strings.Builder
+	var quote rune
+	for _, r := range sample + " " {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ' ' || r == '\t':
+			if current.Len() > 0 {
+				pairs = append(pairs, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unclosed quote in %q", sample)
+	}
+	for _, pair := range pairs {
+		if name, _, ok := strings.Cut(pair, "="); !ok || name == "" {
+			return nil, fmt.Errorf("%q is not field=value", pair)
+		}
+	}
+	return pairs, nil
+}
@@ -0,0 +1,123 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestApplyXPRules tests matching custom XP rules against commit fields and
+// applying them in order.
+func TestApplyXPRules(t *testing.T) {
+	saturday := time.Date(2025, 3, 15, 22, 30, 0, 0, time.UTC)
+	event := NewCommitEvent("abc1234def", "WIP: spike\n\nmore notes", 1, 40, 10)
+	event.Data["repo_path"] = "/src/oss/codequest"
+	event.Data["timestamp"] = saturday
+
+	tests := []struct {
+		name      string
+		rules     []config.XPRuleConfig
+		wantXP    int
+		wantRules []string
+		wantFlags []string
+	}{
+		{
+			name:   "no rules",
+			wantXP: 60,
+		},
+		{
+			name: "multiplier then bonus",
+			rules: []config.XPRuleConfig{
+				{Name: "weekend", When: []string{"weekday in sat, sun"}, Multiplier: 1.5, Bonus: 10},
+			},
+			wantXP:    100,
+			wantRules: []string{"weekend"},
+		},
+		{
+			name: "rules apply in order",
+			rules: []config.XPRuleConfig{
+				{Name: "late", When: []string{"hour >= 22"}, Bonus: 20},
+				{Name: "oss", When: []string{"project = OSS"}, Multiplier: 0.5},
+			},
+			wantXP:    40,
+			wantRules: []string{"late", "oss"},
+		},
+		{
+			name: "every condition must hold",
+			rules: []config.XPRuleConfig{
+				{Name: "small wip", When: []string{"message matches wip*", "lines < 20"}, Multiplier: 0.1},
+			},
+			wantXP: 60,
+		},
+		{
+			name: "flags without duplicates",
+			rules: []config.XPRuleConfig{
+				{Name: "wip", When: []string{"message matches wip*"}, Flags: []string{"review", "wip"}},
+				{Name: "big", When: []string{"lines >= 50"}, Flags: []string{"review"}},
+			},
+			wantXP:    60,
+			wantRules: []string{"wip", "big"},
+			wantFlags: []string{"review", "wip"},
+		},
+		{
+			name: "never below zero",
+			rules: []config.XPRuleConfig{
+				{Name: "penalty", Bonus: -100},
+			},
+			wantXP:    0,
+			wantRules: []string{"penalty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Game.Timezone = "UTC"
+			cfg.Projects = []config.ProjectConfig{{Name: "oss", Repos: []string{"/src/oss"}}}
+			cfg.XPRules = tt.rules
+
+			got := ApplyXPRules(60, event, cfg)
+			if got.XP != tt.wantXP {
+				t.Errorf("ApplyXPRules() XP = %d, want %d", got.XP, tt.wantXP)
+			}
+			if !reflect.DeepEqual(got.Rules, tt.wantRules) {
+				t.Errorf("ApplyXPRules() rules = %v, want %v", got.Rules, tt.wantRules)
+			}
+			if !reflect.DeepEqual(got.Flags, tt.wantFlags) {
+				t.Errorf("ApplyXPRules() flags = %v, want %v", got.Flags, tt.wantFlags)
+			}
+		})
+	}
+}
+
+// TestParseXPRuleSample tests building a dry-run commit from field=value
+// pairs.
+func TestParseXPRuleSample(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Game.Timezone = "UTC"
+	cfg.Projects = []config.ProjectConfig{{Name: "work", Repos: []string{"/src/work"}}}
+	wednesday := time.Date(2025, 3, 12, 8, 15, 0, 0, time.UTC)
+
+	event, err := ParseXPRuleSample(`repo=/src/work/api hour=10 weekday=sat lines=40 message="WIP: spike" bot=true`, cfg, wednesday)
+	if err != nil {
+		t.Fatalf("ParseXPRuleSample() error = %v", err)
+	}
+	want := map[string]string{
+		"repo": "/src/work/api", "project": "work", "hour": "10", "weekday": "Saturday",
+		"lines": "40", "lines_added": "40", "message": "WIP: spike", "bot": "true", "retroactive": "false",
+	}
+	got := XPRuleFieldValues(event, cfg)
+	for field, value := range want {
+		if got[field] != value {
+			t.Errorf("sample %s = %q, want %q", field, got[field], value)
+		}
+	}
+
+	for _, sample := range []string{"hour=25", "weekday=someday", "branch=main", "project=work", "lines", `message="open`} {
+		if _, err := ParseXPRuleSample(sample, cfg, wednesday); err == nil {
+			t.Errorf("ParseXPRuleSample(%q) should fail", sample)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Package github provides the small slice of the GitHub REST API CodeQuest
+// uses: looking up the commits behind a squash-merged pull request, listing
+// the player's pull request approvals for review quests and publishing the
+// profile page to a gist.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// defaultBaseURL is the public GitHub REST API endpoint.
+const defaultBaseURL = "https://api.github.com"
+
+// requestTimeout bounds each API call so a slow network can't stall scoring.
+const requestTimeout = 10 * time.Second
+
+// remotePattern matches GitHub remotes in SSH (git@github.com:owner/repo.git)
+// and HTTPS (https://github.com/owner/repo.git) form.
+var remotePattern = regexp.MustCompile(`github\.com[:/]([^/]+)/([^/]+?)(?:\.git)?/?$`)
+
+// Client calls the GitHub REST API.
+type Client struct {
+	baseURL    string       // API endpoint (overridable for tests / GitHub Enterprise)
+	token      string       // Personal access token (empty = unauthenticated, public repos only)
+	httpClient *http.Client // Underlying HTTP client
+}
+
+// NewClient creates a GitHub API client.
+//
+// Parameters:
+//   - token: Personal access token (empty for unauthenticated access)
+//
+// Returns:
+//   - *Client: Client for api.github.com
+func NewClient(token string) *Client {
+	return &Client{
+		baseURL:    defaultBaseURL,
+		token:      token,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// NewClientFromEnv creates a client authenticated with $GITHUB_TOKEN
+// (unauthenticated if it isn't set).
+func NewClientFromEnv() *Client {
+	return NewClient(strings.TrimSpace(os.Getenv("GITHUB_TOKEN")))
+}
+
+// Authenticated reports whether the client has a token. Listing approvals
+// needs one; public PR lookups don't.
+func (c *Client) Authenticated() bool {
+	return c.token != ""
+}
+
+// PullRequestCommit is one commit on a pull request.
+type PullRequestCommit struct {
+	SHA     string // Commit hash
+	Message string // Full commit message
+}
+
+// PullRequestCommits lists the commits on a pull request (at most 100, the
+// first page, which covers any PR a squash split would count in full).
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - owner: Repository owner
+//   - repo: Repository name
+//   - number: Pull request number
+//
+// Returns:
+//   - []PullRequestCommit: The PR's commits, oldest first
+//   - error: Request or decoding failure, or a non-200 response
+func (c *Client) PullRequestCommits(ctx context.Context, owner, repo string, number int) ([]PullRequestCommit, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/commits?per_page=100", c.baseURL, owner, repo, number)
+
+	var payload []struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message string `json:"message"`
+		} `json:"commit"`
+	}
+	if err := c.getJSON(ctx, url, &payload); err != nil {
+		return nil, fmt.Errorf("fetching commits for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	commits := make([]PullRequestCommit, len(payload))
+	for i, item := range payload {
+		commits[i] = PullRequestCommit{SHA: item.SHA, Message: item.Commit.Message}
+	}
+	return commits, nil
+}
+
+// maxReviewedPullRequests caps how many reviewed PRs Approvals inspects,
+// bounding the API calls one poll makes.
+const maxReviewedPullRequests = 50
+
+// Approvals lists when the authenticated user approved pull requests since
+// a point in time. Reviewed PRs are found with the search API, then each
+// PR's reviews are checked for the user's approvals.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - since: Ignore approvals submitted before this time
+//
+// Returns:
+//   - []time.Time: Submission time of each approval
+//   - error: Missing token, request or decoding failure
+func (c *Client) Approvals(ctx context.Context, since time.Time) ([]time.Time, error) {
+	if c.token == "" {
+		return nil, fmt.Errorf("listing approvals needs a token ($GITHUB_TOKEN)")
+	}
+
+	var user struct {
+		Login string `json:"login"`
+	}
+	if err := c.getJSON(ctx, c.baseURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("fetching authenticated user: %w", err)
+	}
+
+	query := fmt.Sprintf("is:pr reviewed-by:%s updated:>=%s", user.Login, since.UTC().Format("2006-01-02"))
+	var search struct {
+		Items []struct {
+			PullRequest struct {
+				URL string `json:"url"`
+			} `json:"pull_request"`
+		} `json:"items"`
+	}
+	searchURL := fmt.Sprintf("%s/search/issues?q=%s&per_page=%d", c.baseURL, url.QueryEscape(query), maxReviewedPullRequests)
+	if err := c.getJSON(ctx, searchURL, &search); err != nil {
+		return nil, fmt.Errorf("searching reviewed pull requests: %w", err)
+	}
+
+	approvals := make([]time.Time, 0)
+	for _, item := range search.Items {
+		var reviews []struct {
+			User struct {
+				Login string `json:"login"`
+			} `json:"user"`
+			State       string    `json:"state"`
+			SubmittedAt time.Time `json:"submitted_at"`
+		}
+		if err := c.getJSON(ctx, item.PullRequest.URL+"/reviews?per_page=100", &reviews); err != nil {
+			return nil, fmt.Errorf("fetching reviews for %s: %w", item.PullRequest.URL, err)
+		}
+
+		for _, review := range reviews {
+			if review.State == "APPROVED" && strings.EqualFold(review.User.Login, user.Login) && !review.SubmittedAt.Before(since) {
+				approvals = append(approvals, review.SubmittedAt)
+			}
+		}
+	}
+	return approvals, nil
+}
+
+// Gist is a gist CodeQuest published to.
+type Gist struct {
+	ID  string // Gist ID (set publish.gist_id to keep updating it)
+	URL string // Web page of the gist
+}
+
+// PublishGist writes a single file to a gist: a new public gist when id is
+// empty, otherwise the file in the existing gist is replaced.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - id: Gist to update ("" creates one)
+//   - description: Gist description
+//   - filename: Name of the file in the gist
+//   - content: File contents
+//
+// Returns:
+//   - Gist: The created or updated gist
+//   - error: Missing token, request or decoding failure
+func (c *Client) PublishGist(ctx context.Context, id, description, filename, content string) (Gist, error) {
+	if c.token == "" {
+		return Gist{}, fmt.Errorf("publishing a gist needs a token ($GITHUB_TOKEN)")
+	}
+
+	payload := map[string]interface{}{
+		"description": description,
+		"files":       map[string]interface{}{filename: map[string]string{"content": content}},
+	}
+	method, endpoint := http.MethodPost, c.baseURL+"/gists"
+	if id != "" {
+		method, endpoint = http.MethodPatch, c.baseURL+"/gists/"+url.PathEscape(id)
+	} else {
+		payload["public"] = true
+	}
+
+	var gist struct {
+		ID      string `json:"id"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := c.doJSON(ctx, method, endpoint, payload, &gist); err != nil {
+		return Gist{}, fmt.Errorf("publishing gist: %w", err)
+	}
+	return Gist{ID: gist.ID, URL: gist.HTMLURL}, nil
+}
+
+// getJSON makes an authenticated GET request and decodes the JSON response.
+// Non-2xx responses are errors.
+func (c *Client) getJSON(ctx context.Context, endpoint string, out interface{}) error {
+	return c.doJSON(ctx, http.MethodGet, endpoint, nil, out)
+}
+
+// doJSON makes an authenticated request with an optional JSON body and
+// decodes the JSON response. Non-2xx responses are errors.
+func (c *Client) doJSON(ctx context.Context, method, endpoint string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+// ParseRemoteURL extracts the owner and repository name from a GitHub remote.
+//
+// Parameters:
+//   - remote: Remote URL (SSH or HTTPS)
+//
+// Returns:
+//   - owner: Repository owner
+//   - repo: Repository name
+//   - ok: False if the remote isn't on github.com
+func ParseRemoteURL(remote string) (owner, repo string, ok bool) {
+	match := remotePattern.FindStringSubmatch(strings.TrimSpace(remote))
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
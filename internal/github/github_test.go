@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPullRequestCommits tests listing a pull request's commits.
+func TestPullRequestCommits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/octo/app/pulls/42/commits" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want Bearer secret", got)
+		}
+		w.Write([]byte(`[{"sha":"a1","commit":{"message":"one"}},{"sha":"b2","commit":{"message":"two"}}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	client.baseURL = server.URL
+
+	commits, err := client.PullRequestCommits(context.Background(), "octo", "app", 42)
+	if err != nil {
+		t.Fatalf("PullRequestCommits() error = %v", err)
+	}
+	if len(commits) != 2 || commits[1].SHA != "b2" || commits[1].Message != "two" {
+		t.Errorf("PullRequestCommits() = %+v, want two commits", commits)
+	}
+
+	if _, err := client.PullRequestCommits(context.Background(), "octo", "app", 7); err == nil {
+		t.Error("PullRequestCommits() for a missing PR should fail")
+	}
+}
+
+// TestApprovals tests listing the authenticated user's PR approvals.
+func TestApprovals(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user":
+			w.Write([]byte(`{"login":"octocat"}`))
+		case "/search/issues":
+			if q := r.URL.Query().Get("q"); !strings.Contains(q, "reviewed-by:octocat") || !strings.Contains(q, "updated:>=2026-03-01") {
+				t.Errorf("search query = %q", q)
+			}
+			fmt.Fprintf(w, `{"items":[{"pull_request":{"url":"%[1]s/repos/octo/app/pulls/1"}},{"pull_request":{"url":"%[1]s/repos/octo/app/pulls/2"}}]}`, server.URL)
+		case "/repos/octo/app/pulls/1/reviews":
+			w.Write([]byte(`[
+				{"user":{"login":"octocat"},"state":"COMMENTED","submitted_at":"2026-03-02T10:00:00Z"},
+				{"user":{"login":"octocat"},"state":"APPROVED","submitted_at":"2026-03-02T11:00:00Z"},
+				{"user":{"login":"hubot"},"state":"APPROVED","submitted_at":"2026-03-02T12:00:00Z"}
+			]`))
+		case "/repos/octo/app/pulls/2/reviews":
+			w.Write([]byte(`[
+				{"user":{"login":"octocat"},"state":"APPROVED","submitted_at":"2026-02-20T09:00:00Z"},
+				{"user":{"login":"OctoCat"},"state":"APPROVED","submitted_at":"2026-03-03T09:00:00Z"}
+			]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	client.baseURL = server.URL
+
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	approvals, err := client.Approvals(context.Background(), since)
+	if err != nil {
+		t.Fatalf("Approvals() error = %v", err)
+	}
+	want := []time.Time{
+		time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC),
+	}
+	if len(approvals) != len(want) {
+		t.Fatalf("Approvals() = %v, want %v", approvals, want)
+	}
+	for i := range want {
+		if !approvals[i].Equal(want[i]) {
+			t.Errorf("approval %d = %v, want %v", i, approvals[i], want[i])
+		}
+	}
+
+	if _, err := NewClient("").Approvals(context.Background(), since); err == nil {
+		t.Error("Approvals() without a token should fail")
+	}
+}
+
+// TestPublishGist tests creating a gist and then updating it in place.
+func TestPublishGist(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Public *bool `json:"public"`
+			Files  map[string]struct {
+				Content string `json:"content"`
+			} `json:"files"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		if body.Files["profile.html"].Content != "<html>" {
+			t.Errorf("files = %+v, want profile.html", body.Files)
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/gists":
+			if body.Public == nil || !*body.Public {
+				t.Error("new gists should be public")
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"abc123","html_url":"https://gist.github.com/abc123"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/gists/abc123":
+			w.Write([]byte(`{"id":"abc123","html_url":"https://gist.github.com/abc123"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	client.baseURL = server.URL
+
+	for _, id := range []string{"", "abc123"} {
+		gist, err := client.PublishGist(context.Background(), id, "profile", "profile.html", "<html>")
+		if err != nil {
+			t.Fatalf("PublishGist(%q) error = %v", id, err)
+		}
+		if gist.ID != "abc123" || gist.URL != "https://gist.github.com/abc123" {
+			t.Errorf("PublishGist(%q) = %+v", id, gist)
+		}
+	}
+
+	if _, err := client.PublishGist(context.Background(), "missing", "profile", "profile.html", "<html>"); err == nil {
+		t.Error("PublishGist() for a missing gist should fail")
+	}
+	if _, err := NewClient("").PublishGist(context.Background(), "", "profile", "profile.html", "<html>"); err == nil {
+		t.Error("PublishGist() without a token should fail")
+	}
+}
+
+// TestParseRemoteURL tests extracting owner/repo from GitHub remotes.
+func TestParseRemoteURL(t *testing.T) {
+	tests := []struct {
+		remote    string
+		wantOwner string
+		wantRepo  string
+		wantOK    bool
+	}{
+		{remote: "git@github.com:AutumnsGrove/CodeQuest.git", wantOwner: "AutumnsGrove", wantRepo: "CodeQuest", wantOK: true},
+		{remote: "https://github.com/AutumnsGrove/CodeQuest", wantOwner: "AutumnsGrove", wantRepo: "CodeQuest", wantOK: true},
+		{remote: "https://github.com/octo/app.git/", wantOwner: "octo", wantRepo: "app", wantOK: true},
+		{remote: "git@gitlab.com:octo/app.git", wantOK: false},
+		{remote: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.remote, func(t *testing.T) {
+			owner, repo, ok := ParseRemoteURL(tt.remote)
+			if owner != tt.wantOwner || repo != tt.wantRepo || ok != tt.wantOK {
+				t.Errorf("ParseRemoteURL(%q) = %q, %q, %v; want %q, %q, %v",
+					tt.remote, owner, repo, ok, tt.wantOwner, tt.wantRepo, tt.wantOK)
+			}
+		})
+	}
+}
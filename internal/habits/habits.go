@@ -0,0 +1,227 @@
+// Package habits pushes quest completions to habit trackers the player
+// already uses: mapped quests score their task in Habitica, and completed
+// daily (or mapped) quests are POSTed to a generic webhook, so CodeQuest
+// can feed an existing habit system instead of competing with it.
+package habits
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// defaultHabiticaURL is the public Habitica API endpoint.
+const defaultHabiticaURL = "https://habitica.com/api/v3"
+
+// requestTimeout bounds each push so a slow tracker can't pile up requests.
+const requestTimeout = 10 * time.Second
+
+// clientName identifies CodeQuest in Habitica's x-client header.
+const clientName = "CodeQuest"
+
+// Completion is a completed quest as the trackers see it.
+type Completion struct {
+	QuestID     string         `json:"quest_id"`
+	Title       string         `json:"quest_title"`
+	Type        game.QuestType `json:"quest_type"`
+	Template    string         `json:"quest_template,omitempty"` // Built-in template the quest came from
+	XPReward    int            `json:"xp_reward"`                // XP awarded (0 while a reward choice is pending)
+	Habit       string         `json:"habit_id,omitempty"`       // Mapped habit ("" if unmapped)
+	CompletedAt time.Time      `json:"completed_at"`
+}
+
+// webhookPayload is the JSON body POSTed to the webhook.
+type webhookPayload struct {
+	Event string `json:"event"` // Always "quest_completed"
+	Completion
+}
+
+// Pusher sends completions to the configured trackers.
+type Pusher struct {
+	settings    config.HabitsConfig
+	habiticaURL string       // Habitica API endpoint (overridable for tests)
+	token       string       // Habitica API token from the environment
+	httpClient  *http.Client // Underlying HTTP client
+	logf        func(format string, args ...interface{})
+
+	wg sync.WaitGroup // Pushes in flight
+}
+
+// New creates a pusher for the habits settings. The Habitica token is read
+// from the environment variable the settings name.
+//
+// Parameters:
+//   - settings: Habits settings (webhook, Habitica account, quest mapping)
+//   - logf: Printf-style logger for failed pushes (e.g. log.Printf)
+//
+// Returns:
+//   - *Pusher: The pusher
+func New(settings config.HabitsConfig, logf func(format string, args ...interface{})) *Pusher {
+	tokenEnv := settings.Habitica.TokenEnv
+	if tokenEnv == "" {
+		tokenEnv = config.DefaultHabiticaTokenEnv
+	}
+	return &Pusher{
+		settings:    settings,
+		habiticaURL: defaultHabiticaURL,
+		token:       strings.TrimSpace(os.Getenv(tokenEnv)),
+		httpClient:  &http.Client{Timeout: requestTimeout},
+		logf:        logf,
+	}
+}
+
+// CompletionFromEvent reads a completion from a quest done event.
+//
+// Parameters:
+//   - event: An EventQuestDone event
+//
+// Returns:
+//   - Completion: The completed quest (Habit left empty)
+//   - bool: False if the event isn't a quest completion
+func CompletionFromEvent(event game.Event) (Completion, bool) {
+	if event.Type != game.EventQuestDone {
+		return Completion{}, false
+	}
+	questID, _ := event.Data["quest_id"].(string)
+	if questID == "" {
+		return Completion{}, false
+	}
+	title, _ := event.Data["quest_title"].(string)
+	questType, _ := event.Data["quest_type"].(game.QuestType)
+	template, _ := event.Data["quest_template"].(string)
+	xp, _ := event.Data["xp_reward"].(int)
+	return Completion{
+		QuestID:     questID,
+		Title:       title,
+		Type:        questType,
+		Template:    template,
+		XPReward:    xp,
+		CompletedAt: event.Timestamp,
+	}, true
+}
+
+// Handler returns an event handler for EventQuestDone that pushes each
+// completion in the background. Failures are reported through logf and
+// never affect the game.
+//
+// Returns:
+//   - game.EventHandler: The handler to subscribe
+func (p *Pusher) Handler() game.EventHandler {
+	return func(event game.Event) {
+		completion, ok := CompletionFromEvent(event)
+		if !ok {
+			return
+		}
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			defer cancel()
+			if err := p.Push(ctx, completion); err != nil {
+				p.logf("Warning: Failed to push %q to habit tracker: %v", completion.Title, err)
+			}
+		}()
+	}
+}
+
+// Wait blocks until every push started by Handler has finished.
+func (p *Pusher) Wait() {
+	p.wg.Wait()
+}
+
+// Push sends a completion to every tracker it applies to: the webhook for
+// daily and mapped quests, Habitica for mapped quests. Other quests are
+// skipped.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - completion: The completed quest
+//
+// Returns:
+//   - error: Every tracker's failure, joined (nil if all succeeded)
+func (p *Pusher) Push(ctx context.Context, completion Completion) error {
+	completion.Habit = p.settings.HabitFor(completion.QuestID, completion.Template)
+
+	var errs []error
+	if p.settings.WebhookURL != "" && (completion.Habit != "" || completion.Type == game.QuestTypeDaily) {
+		if err := p.postWebhook(ctx, completion); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.settings.Habitica.UserID != "" && completion.Habit != "" {
+		if err := p.scoreHabitica(ctx, completion.Habit); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// postWebhook POSTs the completion as JSON to the webhook.
+func (p *Pusher) postWebhook(ctx context.Context, completion Completion) error {
+	body, err := json.Marshal(webhookPayload{Event: "quest_completed", Completion: completion})
+	if err != nil {
+		return fmt.Errorf("encoding webhook payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.settings.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := p.do(req); err != nil {
+		return fmt.Errorf("posting to webhook: %w", err)
+	}
+	return nil
+}
+
+// scoreHabitica marks a Habitica habit or daily as done (scores it up).
+func (p *Pusher) scoreHabitica(ctx context.Context, taskID string) error {
+	if p.token == "" {
+		return fmt.Errorf("no Habitica API token (set $%s)", p.tokenEnv())
+	}
+	endpoint := fmt.Sprintf("%s/tasks/%s/score/up", p.habiticaURL, url.PathEscape(taskID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating Habitica request: %w", err)
+	}
+	req.Header.Set("x-api-user", p.settings.Habitica.UserID)
+	req.Header.Set("x-api-key", p.token)
+	req.Header.Set("x-client", p.settings.Habitica.UserID+"-"+clientName)
+	if err := p.do(req); err != nil {
+		return fmt.Errorf("scoring Habitica task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// do sends a request and fails on any non-2xx response.
+func (p *Pusher) do(req *http.Request) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// tokenEnv returns the environment variable holding the Habitica token.
+func (p *Pusher) tokenEnv() string {
+	if p.settings.Habitica.TokenEnv == "" {
+		return config.DefaultHabiticaTokenEnv
+	}
+	return p.settings.Habitica.TokenEnv
+}
@@ -0,0 +1,137 @@
+package habits
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// recorder is a fake webhook and Habitica API that records what it gets.
+type recorder struct {
+	mu       sync.Mutex
+	webhooks []webhookPayload
+	scored   []string // Habitica task IDs scored up
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/hook":
+		var payload webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rec.webhooks = append(rec.webhooks, payload)
+	case r.Header.Get("x-api-key") != "secret" || r.Header.Get("x-client") != "user-1-CodeQuest":
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	default:
+		rec.scored = append(rec.scored, r.URL.Path)
+	}
+}
+
+// TestPush tests which completions reach the webhook and Habitica.
+func TestPush(t *testing.T) {
+	tests := []struct {
+		name        string
+		completion  Completion
+		wantWebhook string // Habit ID in the webhook payload ("-" = no webhook call)
+		wantScored  string // Habitica path scored ("" = none)
+	}{
+		{"unmapped daily quest", Completion{QuestID: "q1", Type: game.QuestTypeDaily}, "", ""},
+		{"mapped by quest ID", Completion{QuestID: "q2", Type: game.QuestTypeCommit}, "habit-a", "/tasks/habit-a/score/up"},
+		{"mapped by template", Completion{QuestID: "q3", Type: game.QuestTypeDocs, Template: "docs-scribe"}, "habit-b", "/tasks/habit-b/score/up"},
+		{"unmapped commit quest", Completion{QuestID: "q4", Type: game.QuestTypeCommit}, "-", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_HABITICA_TOKEN", "secret")
+			rec := &recorder{}
+			server := httptest.NewServer(rec)
+			defer server.Close()
+
+			pusher := New(config.HabitsConfig{
+				Enabled:    true,
+				WebhookURL: server.URL + "/hook",
+				Habitica:   config.HabitsHabiticaConfig{UserID: "user-1", TokenEnv: "TEST_HABITICA_TOKEN"},
+				Quests:     map[string]string{"q2": "habit-a", "docs-scribe": "habit-b"},
+			}, t.Logf)
+			pusher.habiticaURL = server.URL
+
+			if err := pusher.Push(context.Background(), tt.completion); err != nil {
+				t.Fatalf("Push() error = %v", err)
+			}
+			if tt.wantWebhook == "-" {
+				if len(rec.webhooks) != 0 {
+					t.Errorf("webhook got %+v, want no call", rec.webhooks)
+				}
+			} else if len(rec.webhooks) != 1 || rec.webhooks[0].Event != "quest_completed" || rec.webhooks[0].Habit != tt.wantWebhook {
+				t.Errorf("webhook got %+v, want one call with habit %q", rec.webhooks, tt.wantWebhook)
+			}
+			if tt.wantScored == "" && len(rec.scored) != 0 || tt.wantScored != "" && (len(rec.scored) != 1 || rec.scored[0] != tt.wantScored) {
+				t.Errorf("Habitica scored %v, want %q", rec.scored, tt.wantScored)
+			}
+		})
+	}
+}
+
+// TestPushErrors tests that tracker failures are reported.
+func TestPushErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	t.Setenv(config.DefaultHabiticaTokenEnv, "")
+	pusher := New(config.HabitsConfig{
+		WebhookURL: server.URL,
+		Habitica:   config.HabitsHabiticaConfig{UserID: "user-1"},
+		Quests:     map[string]string{"q1": "habit-a"},
+	}, t.Logf)
+
+	err := pusher.Push(context.Background(), Completion{QuestID: "q1", Type: game.QuestTypeDaily})
+	if err == nil {
+		t.Fatal("Push() should fail when the webhook errors and no token is set")
+	}
+	for _, want := range []string{"503", config.DefaultHabiticaTokenEnv} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Push() error = %q, want it to mention %q", err, want)
+		}
+	}
+}
+
+// TestHandler tests pushing quest done events from the event bus.
+func TestHandler(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	pusher := New(config.HabitsConfig{Enabled: true, WebhookURL: server.URL + "/hook"}, t.Logf)
+	bus := game.NewEventBus()
+	bus.Subscribe(game.EventQuestDone, pusher.Handler())
+
+	event := game.NewQuestDoneEvent("q1", "Daily Grind", 50)
+	event.Timestamp = time.Date(2025, 3, 3, 18, 0, 0, 0, time.UTC)
+	event.Data["quest_type"] = game.QuestTypeDaily
+	bus.Publish(event)
+	pusher.Wait()
+
+	if len(rec.webhooks) != 1 {
+		t.Fatalf("webhook got %d calls, want 1", len(rec.webhooks))
+	}
+	got := rec.webhooks[0]
+	if got.QuestID != "q1" || got.Title != "Daily Grind" || got.XPReward != 50 || !got.CompletedAt.Equal(event.Timestamp) {
+		t.Errorf("webhook payload = %+v", got)
+	}
+}
@@ -0,0 +1,204 @@
+// Package power detects whether CodeQuest should run in low-power mode:
+// always, never, or while the machine is on battery. In low-power mode the
+// watcher polls less often and the UI skips animations and redraws less, so
+// a laptop wakes up less.
+package power
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Low-power settings (config power.low_power).
+const (
+	ModeAuto = "auto" // Low power while on battery
+	ModeOn   = "on"   // Always low power
+	ModeOff  = "off"  // Never low power
+)
+
+// Slowdown is how many times longer polling intervals are in low-power mode.
+const Slowdown = 4
+
+// checkInterval is how often Run looks at the power source again.
+const checkInterval = time.Minute
+
+// sysfsPowerSupply is where Linux lists power supplies.
+const sysfsPowerSupply = "/sys/class/power_supply"
+
+// ErrUnsupported is returned where the power source can't be read.
+var ErrUnsupported = errors.New("battery detection is not supported on " + runtime.GOOS)
+
+// Monitor tracks whether low-power mode is on. It's safe for concurrent
+// use, and a nil Monitor is never in low-power mode.
+type Monitor struct {
+	setting   string
+	onBattery func() (bool, error)
+
+	low     atomic.Bool
+	battery atomic.Bool
+}
+
+// NewMonitor creates a monitor for a low_power setting and checks the power
+// source right away.
+//
+// Parameters:
+//   - setting: "auto", "on" or "off" ("" means auto)
+//
+// Returns:
+//   - *Monitor: A monitor with the current state
+func NewMonitor(setting string) *Monitor {
+	m := &Monitor{setting: setting, onBattery: OnBattery}
+	m.Refresh()
+	return m
+}
+
+// Refresh checks the power source again. A power source that can't be read
+// counts as mains power.
+func (m *Monitor) Refresh() {
+	switch m.setting {
+	case ModeOn:
+		m.low.Store(true)
+	case ModeOff:
+		m.low.Store(false)
+	default:
+		battery, err := m.onBattery()
+		battery = battery && err == nil
+		m.battery.Store(battery)
+		m.low.Store(battery)
+	}
+}
+
+// Run refreshes the state every minute until the context is cancelled, so
+// plugging in or unplugging the laptop switches modes.
+//
+// Parameters:
+//   - ctx: Context that stops the monitor
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Refresh()
+		}
+	}
+}
+
+// Low reports whether low-power mode is on.
+func (m *Monitor) Low() bool {
+	return m != nil && m.low.Load()
+}
+
+// OnBattery reports whether the last check found the machine on battery
+// (only checked with the auto setting).
+func (m *Monitor) OnBattery() bool {
+	return m != nil && m.battery.Load()
+}
+
+// Interval stretches a polling interval by Slowdown in low-power mode.
+//
+// Parameters:
+//   - interval: The normal interval
+//
+// Returns:
+//   - time.Duration: The interval to wait
+func (m *Monitor) Interval(interval time.Duration) time.Duration {
+	if m.Low() {
+		return interval * Slowdown
+	}
+	return interval
+}
+
+// OnBattery reports whether the machine is running on battery, from sysfs
+// on Linux, pmset on macOS and WMI on Windows. Machines without a battery
+// are never on battery.
+//
+// Returns:
+//   - bool: True if running on battery
+//   - error: ErrUnsupported on other systems, or an error reading the power source
+func OnBattery() (bool, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return linuxOnBattery(sysfsPowerSupply)
+	case "darwin":
+		out, err := exec.Command("pmset", "-g", "batt").Output()
+		if err != nil {
+			return false, err
+		}
+		return pmsetOnBattery(string(out)), nil
+	case "windows":
+		out, err := exec.Command("powershell", "-NoProfile", "-Command",
+			"(Get-CimInstance -ClassName Win32_Battery).BatteryStatus").Output()
+		if err != nil {
+			return false, err
+		}
+		return windowsOnBattery(string(out)), nil
+	default:
+		return false, ErrUnsupported
+	}
+}
+
+// linuxOnBattery reads the power supplies under a sysfs directory: the
+// machine is on battery if no mains or USB supply is online and a battery
+// is discharging.
+func linuxOnBattery(root string) (bool, error) {
+	supplies, err := os.ReadDir(root)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	discharging := false
+	for _, supply := range supplies {
+		dir := filepath.Join(root, supply.Name())
+		switch readSysfs(dir, "type") {
+		case "Mains", "USB":
+			if readSysfs(dir, "online") == "1" {
+				return false, nil
+			}
+		case "Battery":
+			if readSysfs(dir, "status") == "Discharging" {
+				discharging = true
+			}
+		}
+	}
+	return discharging, nil
+}
+
+// readSysfs reads a sysfs attribute ("" if it can't be read).
+func readSysfs(dir, name string) string {
+	value, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(value))
+}
+
+// pmsetOnBattery parses `pmset -g batt`, whose first line names the power
+// source ("Now drawing from 'Battery Power'").
+func pmsetOnBattery(output string) bool {
+	return strings.Contains(output, "'Battery Power'")
+}
+
+// windowsOnBattery parses Win32_Battery's BatteryStatus, which is 1 while
+// discharging (one line per battery, none without a battery).
+func windowsOnBattery(output string) bool {
+	for _, status := range strings.Fields(output) {
+		if status == "1" {
+			return true
+		}
+	}
+	return false
+}
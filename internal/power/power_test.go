@@ -0,0 +1,128 @@
+package power
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLinuxOnBattery tests reading the power source from sysfs.
+func TestLinuxOnBattery(t *testing.T) {
+	tests := []struct {
+		name     string
+		supplies map[string]map[string]string
+		want     bool
+	}{
+		{
+			name: "discharging",
+			supplies: map[string]map[string]string{
+				"AC":   {"type": "Mains", "online": "0"},
+				"BAT0": {"type": "Battery", "status": "Discharging"},
+			},
+			want: true,
+		},
+		{
+			name: "plugged in",
+			supplies: map[string]map[string]string{
+				"AC":   {"type": "Mains", "online": "1"},
+				"BAT0": {"type": "Battery", "status": "Charging"},
+			},
+			want: false,
+		},
+		{
+			name: "charging over usb-c",
+			supplies: map[string]map[string]string{
+				"ucsi": {"type": "USB", "online": "1"},
+				"BAT0": {"type": "Battery", "status": "Discharging"},
+			},
+			want: false,
+		},
+		{
+			name:     "desktop",
+			supplies: map[string]map[string]string{},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			for name, attrs := range tt.supplies {
+				dir := filepath.Join(root, name)
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatalf("MkdirAll() error = %v", err)
+				}
+				for attr, value := range attrs {
+					if err := os.WriteFile(filepath.Join(dir, attr), []byte(value+"\n"), 0644); err != nil {
+						t.Fatalf("WriteFile() error = %v", err)
+					}
+				}
+			}
+
+			got, err := linuxOnBattery(root)
+			if err != nil || got != tt.want {
+				t.Errorf("linuxOnBattery() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if got, err := linuxOnBattery(filepath.Join(t.TempDir(), "missing")); got || err != nil {
+		t.Errorf("linuxOnBattery() without sysfs = %v, %v, want false", got, err)
+	}
+}
+
+// TestParsePowerSource tests the macOS and Windows command output parsers.
+func TestParsePowerSource(t *testing.T) {
+	if !pmsetOnBattery("Now drawing from 'Battery Power'\n -InternalBattery-0\t85%; discharging") {
+		t.Error("pmsetOnBattery() = false on battery, want true")
+	}
+	if pmsetOnBattery("Now drawing from 'AC Power'\n -InternalBattery-0\t100%; charged") {
+		t.Error("pmsetOnBattery() = true on AC, want false")
+	}
+	if !windowsOnBattery("1\r\n") || windowsOnBattery("2\r\n") || windowsOnBattery("") {
+		t.Error("windowsOnBattery() should be true only for status 1")
+	}
+}
+
+// TestMonitor tests the low_power settings and interval stretching.
+func TestMonitor(t *testing.T) {
+	tests := []struct {
+		name      string
+		setting   string
+		onBattery bool
+		err       error
+		wantLow   bool
+	}{
+		{"auto on battery", ModeAuto, true, nil, true},
+		{"auto on mains", ModeAuto, false, nil, false},
+		{"unset means auto", "", true, nil, true},
+		{"auto unsupported", ModeAuto, false, ErrUnsupported, false},
+		{"detection error", ModeAuto, true, errors.New("pmset failed"), false},
+		{"always on", ModeOn, false, nil, true},
+		{"always off", ModeOff, true, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Monitor{setting: tt.setting, onBattery: func() (bool, error) { return tt.onBattery, tt.err }}
+			m.Refresh()
+			if m.Low() != tt.wantLow {
+				t.Errorf("Low() = %v, want %v", m.Low(), tt.wantLow)
+			}
+			want := time.Minute
+			if tt.wantLow {
+				want *= Slowdown
+			}
+			if got := m.Interval(time.Minute); got != want {
+				t.Errorf("Interval() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	var m *Monitor
+	if m.Low() || m.OnBattery() || m.Interval(time.Second) != time.Second {
+		t.Error("nil Monitor should never be in low-power mode")
+	}
+}
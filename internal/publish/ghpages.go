@@ -0,0 +1,199 @@
+package publish
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// PageFile is the file the profile is written to on the pages branch.
+const PageFile = "index.html"
+
+// CommitPage commits files onto a branch without touching the working tree
+// or the checked-out branch: the branch's tree is copied with the files
+// added or replaced, and the branch is moved to the new commit. Other files
+// on the branch (e.g. a CNAME) are kept. Nothing is committed when the
+// files are unchanged.
+//
+// Parameters:
+//   - repoPath: Path to the repository
+//   - branch: Branch to commit to (created if missing)
+//   - files: File contents by name (top-level files only)
+//   - message: Commit message
+//   - now: Commit time
+//
+// Returns:
+//   - bool: True if a new commit was made
+//   - error: An error if the repository can't be read or written
+func CommitPage(repoPath, branch string, files map[string][]byte, message string, now time.Time) (bool, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return false, fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+
+	refName := plumbing.NewBranchReferenceName(branch)
+	entries := make(map[string]object.TreeEntry)
+	var parents []plumbing.Hash
+	var parentTree plumbing.Hash
+
+	ref, err := repo.Reference(refName, true)
+	switch {
+	case err == nil:
+		tip, err := repo.CommitObject(ref.Hash())
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", branch, err)
+		}
+		tree, err := tip.Tree()
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s tree: %w", branch, err)
+		}
+		for _, entry := range tree.Entries {
+			entries[entry.Name] = entry
+		}
+		parents = []plumbing.Hash{tip.Hash}
+		parentTree = tip.TreeHash
+	case !errors.Is(err, plumbing.ErrReferenceNotFound):
+		return false, fmt.Errorf("failed to read branch %s: %w", branch, err)
+	}
+
+	for name, content := range files {
+		blob := repo.Storer.NewEncodedObject()
+		blob.SetType(plumbing.BlobObject)
+		writer, err := blob.Writer()
+		if err != nil {
+			return false, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if _, err := writer.Write(content); err != nil {
+			writer.Close()
+			return false, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if err := writer.Close(); err != nil {
+			return false, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		hash, err := repo.Storer.SetEncodedObject(blob)
+		if err != nil {
+			return false, fmt.Errorf("failed to store %s: %w", name, err)
+		}
+		entries[name] = object.TreeEntry{Name: name, Mode: filemode.Regular, Hash: hash}
+	}
+
+	treeHash, err := storeTree(repo, entries)
+	if err != nil {
+		return false, err
+	}
+	if treeHash == parentTree {
+		return false, nil
+	}
+
+	signature := commitSignature(repo, now)
+	commit := &object.Commit{
+		Author:       signature,
+		Committer:    signature,
+		Message:      message,
+		TreeHash:     treeHash,
+		ParentHashes: parents,
+	}
+	encoded := repo.Storer.NewEncodedObject()
+	if err := commit.Encode(encoded); err != nil {
+		return false, fmt.Errorf("failed to encode commit: %w", err)
+	}
+	commitHash, err := repo.Storer.SetEncodedObject(encoded)
+	if err != nil {
+		return false, fmt.Errorf("failed to store commit: %w", err)
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(refName, commitHash)); err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", branch, err)
+	}
+	return true, nil
+}
+
+// storeTree writes a flat tree object. Git requires entries sorted by name,
+// with directories compared as if they ended in "/".
+func storeTree(repo *git.Repository, entries map[string]object.TreeEntry) (plumbing.Hash, error) {
+	tree := &object.Tree{Entries: make([]object.TreeEntry, 0, len(entries))}
+	for _, entry := range entries {
+		tree.Entries = append(tree.Entries, entry)
+	}
+	sortKey := func(entry object.TreeEntry) string {
+		if entry.Mode == filemode.Dir {
+			return entry.Name + "/"
+		}
+		return entry.Name
+	}
+	sort.Slice(tree.Entries, func(i, j int) bool {
+		return sortKey(tree.Entries[i]) < sortKey(tree.Entries[j])
+	})
+
+	encoded := repo.Storer.NewEncodedObject()
+	if err := tree.Encode(encoded); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to encode tree: %w", err)
+	}
+	hash, err := repo.Storer.SetEncodedObject(encoded)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to store tree: %w", err)
+	}
+	return hash, nil
+}
+
+// commitSignature signs publish commits as the git user (from the
+// repository or global config), or as CodeQuest if none is set.
+func commitSignature(repo *git.Repository, now time.Time) object.Signature {
+	signature := object.Signature{Name: "CodeQuest", Email: "codequest@localhost", When: now}
+	if cfg, err := repo.ConfigScoped(gitconfig.GlobalScope); err == nil {
+		if cfg.User.Name != "" {
+			signature.Name = cfg.User.Name
+		}
+		if cfg.User.Email != "" {
+			signature.Email = cfg.User.Email
+		}
+	}
+	return signature
+}
+
+// PushBranch pushes a branch to a remote. HTTPS remotes authenticate with
+// $GITHUB_TOKEN when it's set; SSH remotes use the SSH agent.
+//
+// Parameters:
+//   - repoPath: Path to the repository
+//   - remote: Remote name (e.g. "origin")
+//   - branch: Branch to push
+//
+// Returns:
+//   - error: An error if the push fails (already up to date is not an error)
+func PushBranch(repoPath, remote, branch string) error {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+
+	remoteConfig, err := repo.Remote(remote)
+	if err != nil {
+		return fmt.Errorf("remote %q not found: %w", remote, err)
+	}
+
+	var auth transport.AuthMethod
+	if urls := remoteConfig.Config().URLs; len(urls) > 0 && strings.HasPrefix(urls[0], "https://") {
+		if token := strings.TrimSpace(os.Getenv("GITHUB_TOKEN")); token != "" {
+			auth = &githttp.BasicAuth{Username: "x-access-token", Password: token}
+		}
+	}
+
+	refSpec := gitconfig.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%s", branch, branch))
+	err = repo.Push(&git.PushOptions{RemoteName: remote, RefSpecs: []gitconfig.RefSpec{refSpec}, Auth: auth})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to push %s to %s: %w", branch, remote, err)
+	}
+	return nil
+}
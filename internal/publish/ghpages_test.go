@@ -0,0 +1,79 @@
+package publish
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// TestCommitAndPushPage tests committing the page onto a new pages branch,
+// skipping unchanged pages, keeping other files and pushing to a remote.
+func TestCommitAndPushPage(t *testing.T) {
+	repoPath := t.TempDir()
+	repo, err := git.PlainInit(repoPath, false)
+	if err != nil {
+		t.Fatalf("Failed to init repo: %v", err)
+	}
+	remotePath := t.TempDir()
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to init remote: %v", err)
+	}
+	if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{remotePath}}); err != nil {
+		t.Fatalf("Failed to add remote: %v", err)
+	}
+
+	now := time.Now()
+	changed, err := CommitPage(repoPath, "gh-pages", map[string][]byte{"CNAME": []byte("me.example.com"), PageFile: []byte("v1")}, "Publish profile", now)
+	if err != nil || !changed {
+		t.Fatalf("CommitPage() = %v, %v, want a new commit", changed, err)
+	}
+	changed, err = CommitPage(repoPath, "gh-pages", map[string][]byte{PageFile: []byte("v1")}, "Publish profile", now)
+	if err != nil || changed {
+		t.Errorf("CommitPage() with the same page = %v, %v, want no commit", changed, err)
+	}
+	changed, err = CommitPage(repoPath, "gh-pages", map[string][]byte{PageFile: []byte("v2")}, "Publish profile", now)
+	if err != nil || !changed {
+		t.Fatalf("CommitPage() with a new page = %v, %v, want a new commit", changed, err)
+	}
+
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName("gh-pages"), true)
+	if err != nil {
+		t.Fatalf("gh-pages branch missing: %v", err)
+	}
+	tip, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		t.Fatalf("Failed to read tip: %v", err)
+	}
+	if tip.NumParents() != 1 {
+		t.Errorf("tip has %d parents, want 1", tip.NumParents())
+	}
+	for name, want := range map[string]string{PageFile: "v2", "CNAME": "me.example.com"} {
+		file, err := tip.File(name)
+		if err != nil {
+			t.Fatalf("%s missing from gh-pages: %v", name, err)
+		}
+		if got, _ := file.Contents(); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	if err := PushBranch(repoPath, "origin", "gh-pages"); err != nil {
+		t.Fatalf("PushBranch() error = %v", err)
+	}
+	remote, err := git.PlainOpen(remotePath)
+	if err != nil {
+		t.Fatalf("Failed to open remote: %v", err)
+	}
+	if pushed, err := remote.Reference(plumbing.NewBranchReferenceName("gh-pages"), true); err != nil || pushed.Hash() != tip.Hash {
+		t.Errorf("remote gh-pages = %v (%v), want %s", pushed, err, tip.Hash)
+	}
+	if err := PushBranch(repoPath, "origin", "gh-pages"); err != nil {
+		t.Errorf("PushBranch() when up to date error = %v", err)
+	}
+	if err := PushBranch(repoPath, "upstream", "gh-pages"); err == nil {
+		t.Error("PushBranch() to a missing remote should fail")
+	}
+}
@@ -0,0 +1,127 @@
+package publish
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+// pageTemplate is the self-contained profile page (inline CSS, no scripts)
+// so it works from a gh-pages branch or a gist preview alike.
+var pageTemplate = template.Must(template.New("profile").Funcs(template.FuncMap{
+	"percent": func(share float64) string { return fmt.Sprintf("%.0f%%", share*100) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Name}} · CodeQuest</title>
+<style>
+  body { background: #1a1b26; color: #c0caf5; font-family: ui-monospace, Menlo, Consolas, monospace; margin: 0; padding: 2rem; }
+  main { max-width: 52rem; margin: 0 auto; }
+  h1 { color: #bb9af7; margin-bottom: 0.25rem; }
+  h2 { color: #7aa2f7; font-size: 1rem; margin-top: 2rem; }
+  .muted { color: #565f89; }
+  .stats { display: flex; flex-wrap: wrap; gap: 1.5rem; }
+  .stat strong { display: block; font-size: 1.5rem; color: #e0af68; }
+  .badges { list-style: none; padding: 0; display: flex; flex-wrap: wrap; gap: 0.5rem; }
+  .badges li { border: 1px solid #414868; border-radius: 0.5rem; padding: 0.25rem 0.75rem; }
+  .heatmap { display: flex; gap: 3px; overflow-x: auto; }
+  .week { display: flex; flex-direction: column; gap: 3px; }
+  .day { width: 10px; height: 10px; border-radius: 2px; background: #24283b; }
+  .day.empty { background: transparent; }
+  .l1 { background: #2ac3de; } .l2 { background: #9ece6a; } .l3 { background: #e0af68; }
+  .bar { background: #24283b; border-radius: 0.25rem; height: 0.5rem; margin: 0.25rem 0 0.75rem; }
+  .bar span { display: block; height: 100%; border-radius: 0.25rem; background: #7aa2f7; }
+</style>
+</head>
+<body>
+<main>
+<h1>{{if .Avatar}}{{.Avatar}}{{else}}⚔️{{end}} {{.Name}}{{if .Pronouns}} <span class="muted">({{.Pronouns}})</span>{{end}}</h1>
+{{- if .Motto}}
+<p><em>“{{.Motto}}”</em></p>
+{{- end}}
+{{- if .FavoriteLanguage}}
+<p class="muted">Favorite language: {{.FavoriteLanguage}}</p>
+{{- end}}
+{{- if .Privacy.ShowLevel}}
+<p>Level {{.Level}} · {{.XP}}/{{.XPToNextLevel}} XP to next level</p>
+{{- end}}
+
+{{- if or .Privacy.ShowStreak .Privacy.ShowTotals}}
+<div class="stats">
+{{- if .Privacy.ShowStreak}}
+  <div class="stat"><strong>{{.CurrentStreak}} 🔥</strong>day streak</div>
+  <div class="stat"><strong>{{.LongestStreak}}</strong>longest streak</div>
+{{- end}}
+{{- if .Privacy.ShowTotals}}
+  <div class="stat"><strong>{{.TotalCommits}}</strong>commits</div>
+  <div class="stat"><strong>+{{.TotalLinesAdded}} / -{{.TotalLinesRemoved}}</strong>lines</div>
+  <div class="stat"><strong>{{.QuestsCompleted}}</strong>quests completed</div>
+{{- end}}
+</div>
+{{- end}}
+
+{{- if .Privacy.ShowBadges}}
+<h2>Badges</h2>
+{{- if .Badges}}
+<ul class="badges">
+{{- range .Badges}}
+  <li>{{.Icon}} {{.Name}}</li>
+{{- end}}
+</ul>
+{{- else}}
+<p class="muted">No badges yet.</p>
+{{- end}}
+{{- end}}
+
+{{- if .Privacy.ShowHeatmap}}
+<h2>Activity</h2>
+<div class="heatmap">
+{{- range .Heatmap}}
+  <div class="week">
+  {{- range .}}
+    {{- if .Date}}
+    <div class="day l{{.Level}}" title="{{.Date}}: {{.Commits}} commits"></div>
+    {{- else}}
+    <div class="day empty"></div>
+    {{- end}}
+  {{- end}}
+  </div>
+{{- end}}
+</div>
+{{- end}}
+
+{{- if .Privacy.ShowLanguages}}
+<h2>Top Languages</h2>
+{{- if .Languages}}
+{{- range .Languages}}
+<div>{{.Name}} <span class="muted">{{percent .Share}}</span></div>
+<div class="bar"><span style="width: {{percent .Share}}"></span></div>
+{{- end}}
+{{- else}}
+<p class="muted">No code commits yet.</p>
+{{- end}}
+{{- end}}
+
+<p class="muted">Generated by CodeQuest on {{.GeneratedAt.Format "2006-01-02"}}</p>
+</main>
+</body>
+</html>
+`))
+
+// RenderPage renders a profile as a standalone HTML page.
+//
+// Parameters:
+//   - profile: Profile data from BuildProfile
+//
+// Returns:
+//   - []byte: The HTML page
+//   - error: An error if rendering fails
+func RenderPage(profile Profile) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pageTemplate.Execute(&buf, profile); err != nil {
+		return nil, fmt.Errorf("rendering profile page: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,177 @@
+// Package publish renders the player's public profile page (level, badges,
+// activity heatmap, top languages) as static HTML and publishes it to a
+// gh-pages branch or a GitHub gist. Privacy settings decide which stats are
+// included; hidden stats never reach the page.
+package publish
+
+import (
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+const (
+	// heatmapWeeks is how many weeks the profile heatmap covers
+	heatmapWeeks = 53
+
+	// topLanguages is how many languages the profile lists
+	topLanguages = 5
+
+	// anonymousName replaces the character name when it's kept private
+	anonymousName = "Anonymous Adventurer"
+)
+
+// Profile is everything the public page shows. Sections the privacy
+// settings hide are left empty.
+type Profile struct {
+	Name        string                      // Character name, or anonymousName
+	Privacy     config.PublishPrivacyConfig // Which sections are shown
+	GeneratedAt time.Time                   // When the page was rendered
+
+	// Profile fields (ShowProfile; empty fields are left out)
+	Avatar           string
+	Pronouns         string
+	Motto            string
+	FavoriteLanguage string
+
+	// Level (ShowLevel)
+	Level         int
+	XP            int
+	XPToNextLevel int
+
+	// Streaks (ShowStreak)
+	CurrentStreak int
+	LongestStreak int
+
+	// Lifetime totals (ShowTotals)
+	TotalCommits      int
+	TotalLinesAdded   int
+	TotalLinesRemoved int
+	QuestsCompleted   int
+
+	Badges    []Badge              // Achievements and trophy items (ShowBadges)
+	Heatmap   [][]HeatmapDay       // Weeks (oldest first) of Monday-first days (ShowHeatmap)
+	Languages []game.LanguageShare // Top languages (ShowLanguages)
+}
+
+// Badge is an achievement or trophy shown on the profile.
+type Badge struct {
+	Icon string // Emoji shown with the badge
+	Name string // Display name
+}
+
+// HeatmapDay is one cell of the activity heatmap.
+type HeatmapDay struct {
+	Date    string // YYYY-MM-DD ("" for days after today)
+	Commits int    // Commits that day
+	Level   int    // Shade from 0 (none) to 3 (busy)
+}
+
+// BuildProfile collects the stats the privacy settings allow.
+//
+// Parameters:
+//   - character: The player's character
+//   - privacy: Which stats to include
+//   - clock: Streak clock (decides which day commits count toward)
+//   - now: Current time
+//
+// Returns:
+//   - Profile: Data for the profile page
+func BuildProfile(character *game.Character, privacy config.PublishPrivacyConfig, clock game.StreakClock, now time.Time) Profile {
+	profile := Profile{Name: anonymousName, Privacy: privacy, GeneratedAt: now}
+
+	if privacy.ShowName && character.Name != "" {
+		profile.Name = character.Name
+	}
+	if privacy.ShowProfile {
+		profile.Avatar = character.Avatar()
+		profile.Pronouns = character.Profile.Pronouns
+		profile.Motto = character.Profile.Motto
+		profile.FavoriteLanguage = character.Profile.FavoriteLanguage
+	}
+	if privacy.ShowLevel {
+		profile.Level = character.Level
+		profile.XP = character.XP
+		profile.XPToNextLevel = character.XPToNextLevel
+	}
+	if privacy.ShowStreak {
+		profile.CurrentStreak = character.CurrentStreak
+		profile.LongestStreak = character.LongestStreak
+	}
+	if privacy.ShowTotals {
+		profile.TotalCommits = character.TotalCommits
+		profile.TotalLinesAdded = character.TotalLinesAdded
+		profile.TotalLinesRemoved = character.TotalLinesRemoved
+		profile.QuestsCompleted = character.QuestsCompleted
+	}
+	if privacy.ShowBadges {
+		profile.Badges = badges(character)
+	}
+	if privacy.ShowHeatmap {
+		profile.Heatmap = heatmap(character, clock, now)
+	}
+	if privacy.ShowLanguages {
+		profile.Languages = character.TopLanguages(topLanguages)
+	}
+
+	return profile
+}
+
+// badges lists unlocked achievements, then trophy items.
+func badges(character *game.Character) []Badge {
+	names := make(map[string]string)
+	for _, tier := range game.AchievementTiers() {
+		names[tier.ID] = tier.Name
+	}
+
+	list := make([]Badge, 0, len(character.Achievements)+len(character.Items))
+	for _, id := range character.Achievements {
+		name := names[id]
+		if name == "" {
+			name = id
+		}
+		list = append(list, Badge{Icon: "🏅", Name: name})
+	}
+	for _, item := range character.Items {
+		list = append(list, Badge{Icon: "🏆", Name: item.Name})
+	}
+	return list
+}
+
+// heatmap builds a year of commits per day as weeks of Monday-first days,
+// ending with the current week (like the dashboard widget).
+func heatmap(character *game.Character, clock game.StreakClock, now time.Time) [][]HeatmapDay {
+	today := clock.Day(now)
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	first := weekStart.AddDate(0, 0, -7*(heatmapWeeks-1))
+
+	weeks := make([][]HeatmapDay, heatmapWeeks)
+	for week := range weeks {
+		weeks[week] = make([]HeatmapDay, 7)
+		for weekday := range weeks[week] {
+			day := first.AddDate(0, 0, 7*week+weekday)
+			if day.After(today) {
+				continue
+			}
+			commits := character.CommitsOnDay(day)
+			weeks[week][weekday] = HeatmapDay{Date: day.Format("2006-01-02"), Commits: commits, Level: heatmapLevel(commits)}
+		}
+	}
+	return weeks
+}
+
+// heatmapLevel shades a day by its commit count, with the same steps as the
+// dashboard heatmap.
+func heatmapLevel(commits int) int {
+	switch {
+	case commits == 0:
+		return 0
+	case commits < 3:
+		return 1
+	case commits < 6:
+		return 2
+	default:
+		return 3
+	}
+}
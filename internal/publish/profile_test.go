@@ -0,0 +1,97 @@
+package publish
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// testCharacter returns a character with a bit of everything the profile shows.
+func testCharacter(now time.Time, clock game.StreakClock) *game.Character {
+	character := game.NewCharacter("Ada")
+	character.Level = 7
+	character.CurrentStreak = 12
+	character.TotalCommits = 321
+	character.UnlockAchievement("dependency-wrangler-1")
+	character.Items = []game.Item{{Name: "Golden Keyboard"}}
+	character.RecordLanguages([]string{"main.go", "app.ts", "util.go"})
+	character.RecordActivityDay(now, 4, clock)
+	character.Profile = game.CharacterProfile{Motto: "Ship it", Pronouns: "she/her"}
+	return character
+}
+
+// TestBuildProfile tests that privacy settings decide which stats are
+// collected, and that hidden ones stay empty.
+func TestBuildProfile(t *testing.T) {
+	now := time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC) // a Wednesday
+	clock := game.StreakClock{Location: time.UTC}
+	character := testCharacter(now, clock)
+
+	t.Run("everything shown", func(t *testing.T) {
+		privacy := config.PublishPrivacyConfig{ShowName: true, ShowProfile: true, ShowLevel: true, ShowStreak: true,
+			ShowTotals: true, ShowBadges: true, ShowHeatmap: true, ShowLanguages: true}
+		profile := BuildProfile(character, privacy, clock, now)
+
+		if profile.Name != "Ada" || profile.Level != 7 || profile.CurrentStreak != 12 || profile.TotalCommits != 321 {
+			t.Errorf("profile = %+v, want name, level, streak and totals", profile)
+		}
+		if profile.Motto != "Ship it" || profile.Pronouns != "she/her" || profile.Avatar == "" {
+			t.Errorf("profile = %+v, want motto, pronouns and avatar", profile)
+		}
+		if len(profile.Badges) != 2 || profile.Badges[0].Name != "Dependency Wrangler I" || profile.Badges[1].Name != "Golden Keyboard" {
+			t.Errorf("Badges = %+v, want the achievement then the trophy", profile.Badges)
+		}
+		if len(profile.Languages) != 2 || profile.Languages[0].Name != "Go" {
+			t.Errorf("Languages = %+v, want Go first", profile.Languages)
+		}
+
+		if len(profile.Heatmap) != heatmapWeeks {
+			t.Fatalf("heatmap has %d weeks, want %d", len(profile.Heatmap), heatmapWeeks)
+		}
+		thisWeek := profile.Heatmap[heatmapWeeks-1]
+		if today := thisWeek[2]; today.Date != "2025-06-04" || today.Commits != 4 || today.Level != 2 {
+			t.Errorf("today = %+v, want 4 commits at level 2", today)
+		}
+		if thisWeek[3].Date != "" {
+			t.Errorf("tomorrow = %+v, want an empty cell", thisWeek[3])
+		}
+	})
+
+	t.Run("everything hidden", func(t *testing.T) {
+		profile := BuildProfile(character, config.PublishPrivacyConfig{}, clock, now)
+		if profile.Name != anonymousName || profile.Motto != "" || profile.Level != 0 || profile.CurrentStreak != 0 || profile.TotalCommits != 0 {
+			t.Errorf("profile = %+v, want no stats", profile)
+		}
+		if profile.Badges != nil || profile.Heatmap != nil || profile.Languages != nil {
+			t.Error("hidden sections should not be collected")
+		}
+	})
+}
+
+// TestRenderPage tests that the page shows the allowed sections only.
+func TestRenderPage(t *testing.T) {
+	now := time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC)
+	clock := game.StreakClock{Location: time.UTC}
+	character := testCharacter(now, clock)
+
+	privacy := config.PublishPrivacyConfig{ShowName: true, ShowProfile: true, ShowLevel: true, ShowBadges: true, ShowLanguages: true}
+	page, err := RenderPage(BuildProfile(character, privacy, clock, now))
+	if err != nil {
+		t.Fatalf("RenderPage() error = %v", err)
+	}
+
+	html := string(page)
+	for _, want := range []string{"<title>Ada · CodeQuest</title>", "(she/her)", "“Ship it”", "Level 7", "Golden Keyboard", "Go <span class=\"muted\">67%</span>", "width: 67%"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("page missing %q", want)
+		}
+	}
+	for _, hidden := range []string{"day streak", "commits</div>", "class=\"heatmap\""} {
+		if strings.Contains(html, hidden) {
+			t.Errorf("page shows hidden section %q", hidden)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Package sandbox runs Go snippets from mentor answers in a throwaway directory.
+//
+// Snippets are built and run from a fresh temp directory with the module
+// proxy disabled (GOPROXY=off, so nothing is downloaded), a time limit on
+// both the build and the run, and capped output. Snippets that import
+// networking or process-spawning packages are refused before anything runs.
+// This keeps quick experiments safe to try; it is not a security boundary
+// for hostile code.
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits applied to every run
+const (
+	DefaultTimeout = 10 * time.Second // Run time limit when none is given
+	BuildTimeout   = 60 * time.Second // Compile time limit (first builds are slow)
+	MaxOutputBytes = 16 * 1024        // Stdout/stderr kept per stream
+)
+
+// blockedImports are packages snippets may not import: networking and
+// spawning other processes.
+var blockedImports = []string{"net", "os/exec", "syscall", "plugin", "unsafe"}
+
+// snippetFencePattern matches fenced code blocks and captures the language
+// tag and body.
+var snippetFencePattern = regexp.MustCompile("(?s)```([^\\n]*)\\n(.*?)```")
+
+// Result is the outcome of running a snippet.
+type Result struct {
+	Stdout    string        // Program output (truncated to MaxOutputBytes)
+	Stderr    string        // Compiler errors or program errors (truncated)
+	ExitCode  int           // Process exit code (non-zero on build failure)
+	Duration  time.Duration // Time spent running (excluding the build)
+	BuildFail bool          // The snippet didn't compile
+	TimedOut  bool          // The build or run hit its time limit
+}
+
+// OK reports whether the snippet built and exited cleanly.
+func (r Result) OK() bool {
+	return !r.BuildFail && !r.TimedOut && r.ExitCode == 0
+}
+
+// Available reports whether the go toolchain needed to run snippets is
+// installed.
+func Available() bool {
+	_, err := exec.LookPath("go")
+	return err == nil
+}
+
+// FindRunnable returns the last runnable Go program in markdown: a fenced
+// block tagged go (or untagged) with package main and a main function.
+//
+// Parameters:
+//   - markdown: Markdown text (e.g. a mentor answer)
+//
+// Returns:
+//   - string: The program source
+//   - bool: False if there's no runnable program
+func FindRunnable(markdown string) (string, bool) {
+	matches := snippetFencePattern.FindAllStringSubmatch(markdown, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		lang := strings.ToLower(strings.TrimSpace(matches[i][1]))
+		if lang != "" && lang != "go" && lang != "golang" {
+			continue
+		}
+		code := matches[i][2]
+		if IsRunnable(code) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// IsRunnable reports whether Go source is a complete program.
+//
+// Parameters:
+//   - code: Go source
+//
+// Returns:
+//   - bool: True if it parses as package main with a main function
+func IsRunnable(code string) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", code, parser.SkipObjectResolution)
+	if err != nil || file.Name.Name != "main" {
+		return false
+	}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "main" {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckImports refuses snippets importing networking or process packages.
+//
+// Parameters:
+//   - code: Go source
+//
+// Returns:
+//   - error: Names the first blocked import, or a parse error
+func CheckImports(code string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", code, parser.ImportsOnly)
+	if err != nil {
+		return fmt.Errorf("failed to parse snippet: %w", err)
+	}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		for _, blocked := range blockedImports {
+			if path == blocked || strings.HasPrefix(path, blocked+"/") {
+				return fmt.Errorf("snippet imports %q, which isn't allowed in the sandbox", path)
+			}
+		}
+	}
+	return nil
+}
+
+// Run builds and runs a Go program in a temp directory, then removes it.
+// Build failures and non-zero exits are reported in the Result, not as
+// errors.
+//
+// Parameters:
+//   - ctx: Cancels the build or run
+//   - code: Go source of a complete program (see IsRunnable)
+//   - timeout: Run time limit (DefaultTimeout if zero)
+//
+// Returns:
+//   - Result: Output and exit status
+//   - error: An error if the snippet is refused or the sandbox can't be set up
+func Run(ctx context.Context, code string, timeout time.Duration) (Result, error) {
+	if err := CheckImports(code); err != nil {
+		return Result{}, err
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	dir, err := os.MkdirTemp("", "codequest-snippet-*")
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create sandbox directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(code), 0600); err != nil {
+		return Result{}, fmt.Errorf("failed to write snippet: %w", err)
+	}
+
+	// Build separately so the time limit kills the program itself, not
+	// just a `go run` parent. Building the file directly (no go.mod) keeps
+	// it to the standard library at the toolchain's language version.
+	binary := filepath.Join(dir, "snippet")
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+	build, err := execute(ctx, dir, BuildTimeout, "go", "build", "-o", binary, "main.go")
+	if err != nil {
+		return Result{}, err
+	}
+	if build.TimedOut || build.ExitCode != 0 {
+		build.BuildFail = !build.TimedOut
+		build.Duration = 0
+		return build, nil
+	}
+
+	return execute(ctx, dir, timeout, binary)
+}
+
+// execute runs a command in the sandbox directory with a time limit and
+// capped output.
+func execute(ctx context.Context, dir string, timeout time.Duration, name string, args ...string) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout := &cappedBuffer{limit: MaxOutputBytes}
+	stderr := &cappedBuffer{limit: MaxOutputBytes}
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = sandboxEnv(dir)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = time.Second
+
+	started := time.Now()
+	err := cmd.Run()
+	result := Result{
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Duration: time.Since(started),
+		TimedOut: errors.Is(ctx.Err(), context.DeadlineExceeded),
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	case result.TimedOut:
+		result.ExitCode = -1
+	default:
+		return Result{}, fmt.Errorf("failed to run %s: %w", name, err)
+	}
+	return result, nil
+}
+
+// sandboxEnv returns the environment for sandboxed commands: the user's
+// environment (for the build cache and toolchain) with module downloads
+// and toolchain switching turned off.
+func sandboxEnv(dir string) []string {
+	return append(os.Environ(),
+		"GOPROXY=off",
+		"GOFLAGS=",
+		"GOTOOLCHAIN=local",
+		"GOWORK=off",
+		"CGO_ENABLED=0",
+		"TMPDIR="+dir,
+	)
+}
+
+// cappedBuffer keeps the first limit bytes written and discards the rest.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write implements io.Writer, always reporting the full length as written
+// so the program isn't killed by a short write.
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); room < len(p) {
+		b.truncated = true
+		b.buf.Write(p[:max(room, 0)])
+		return len(p), nil
+	}
+	b.buf.Write(p)
+	return len(p), nil
+}
+
+// String returns the kept output, noting if some was dropped.
+func (b *cappedBuffer) String() string {
+	if b.truncated {
+		return b.buf.String() + "\n… output truncated"
+	}
+	return b.buf.String()
+}
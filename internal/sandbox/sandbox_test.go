@@ -0,0 +1,141 @@
+package sandbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+const helloProgram = `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("hello from the sandbox")
+}
+`
+
+// TestFindRunnable tests picking a runnable Go program out of markdown.
+func TestFindRunnable(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		wantOK   bool
+	}{
+		{"go block", "Try this:\n```go\n" + helloProgram + "```\n", true},
+		{"untagged block", "```\n" + helloProgram + "```", true},
+		{"fragment without main", "```go\nx := 1\nfmt.Println(x)\n```", false},
+		{"other language", "```python\nprint('hi')\n```", false},
+		{"no code", "Just use a map.", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, ok := FindRunnable(tt.markdown)
+			if ok != tt.wantOK {
+				t.Fatalf("FindRunnable() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !strings.Contains(code, "func main()") {
+				t.Errorf("FindRunnable() code = %q, want the program", code)
+			}
+		})
+	}
+}
+
+// TestCheckImports tests refusing networking and process imports.
+func TestCheckImports(t *testing.T) {
+	tests := []struct {
+		name    string
+		imports string
+		wantErr bool
+	}{
+		{"standard library", `"fmt"; "strings"`, false},
+		{"net/http", `"net/http"`, true},
+		{"net", `"net"`, true},
+		{"net subpackage", `"net/netip"`, true},
+		{"os/exec", `"os/exec"`, true},
+		{"os", `"os"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := "package main\nimport (" + tt.imports + ")\nfunc main() {}\n"
+			if err := CheckImports(code); (err != nil) != tt.wantErr {
+				t.Errorf("CheckImports() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRun tests building and running snippets, including build failures,
+// non-zero exits and timeouts.
+func TestRun(t *testing.T) {
+	if testing.Short() || !Available() {
+		t.Skip("needs the go toolchain")
+	}
+
+	tests := []struct {
+		name      string
+		code      string
+		timeout   time.Duration
+		wantOut   string
+		wantErr   string
+		buildFail bool
+		timedOut  bool
+		exitCode  int
+	}{
+		{name: "prints output", code: helloProgram, wantOut: "hello from the sandbox"},
+		{
+			name:      "compile error",
+			code:      "package main\n\nfunc main() {\n\tundefinedThing()\n}\n",
+			wantErr:   "undefined",
+			buildFail: true,
+			exitCode:  1,
+		},
+		{
+			name:     "non-zero exit",
+			code:     "package main\n\nimport \"os\"\n\nfunc main() {\n\tos.Exit(3)\n}\n",
+			exitCode: 3,
+		},
+		{
+			name:     "times out",
+			code:     "package main\n\nimport \"time\"\n\nfunc main() {\n\ttime.Sleep(time.Minute)\n}\n",
+			timeout:  200 * time.Millisecond,
+			timedOut: true,
+			exitCode: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Run(context.Background(), tt.code, tt.timeout)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if !strings.Contains(result.Stdout, tt.wantOut) {
+				t.Errorf("Stdout = %q, want it to contain %q", result.Stdout, tt.wantOut)
+			}
+			if !strings.Contains(result.Stderr, tt.wantErr) {
+				t.Errorf("Stderr = %q, want it to contain %q", result.Stderr, tt.wantErr)
+			}
+			if result.BuildFail != tt.buildFail || result.TimedOut != tt.timedOut {
+				t.Errorf("BuildFail = %v, TimedOut = %v; want %v, %v", result.BuildFail, result.TimedOut, tt.buildFail, tt.timedOut)
+			}
+			if tt.timedOut {
+				return
+			}
+			if result.ExitCode != tt.exitCode {
+				t.Errorf("ExitCode = %d, want %d", result.ExitCode, tt.exitCode)
+			}
+		})
+	}
+}
+
+// TestRunRefusesBlockedImports tests that refused snippets never run.
+func TestRunRefusesBlockedImports(t *testing.T) {
+	code := "package main\n\nimport \"net/http\"\n\nfunc main() {\n\thttp.Get(\"http://example.com\")\n}\n"
+	if _, err := Run(context.Background(), code, 0); err == nil {
+		t.Error("Run() with net/http should be refused")
+	}
+}
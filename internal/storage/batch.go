@@ -0,0 +1,123 @@
+// This file implements batched saves: writing the character and quests
+// together so a failure part way through can't leave them out of sync.
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// State is the game state written together by Save.
+// Nil fields are left untouched in storage.
+type State struct {
+	Character *game.Character // Character to save (nil = don't save)
+	Quests    []*game.Quest   // Quest log to save (nil = don't save)
+}
+
+// batchWrite is one key written by a batch, with its previous value for
+// rollback.
+type batchWrite struct {
+	key      string // Storage key
+	value    string // New JSON value
+	previous string // Value before the batch
+	existed  bool   // Whether the key existed before the batch
+}
+
+// Save writes every part of the state as one batch. All values are
+// serialized before anything is written, and if any write fails the keys
+// already written are rolled back to their previous values (or deleted if
+// they didn't exist), so storage never mixes old and new state.
+//
+// Parameters:
+//   - state: The state to save
+//
+// Returns:
+//   - error: An error if serialization fails, or if a write fails (with any
+//     rollback failure joined in)
+func (s *gameData) Save(state State) error {
+	writes, err := state.batch()
+	if err != nil {
+		return err
+	}
+
+	// Snapshot current values so a failed batch can be undone
+	for i := range writes {
+		previous, err := s.keys.getKey(writes[i].key)
+		switch {
+		case err == nil:
+			writes[i].previous, writes[i].existed = previous, true
+		case !IsNotFound(err):
+			return fmt.Errorf("failed to read %s before saving: %w", writes[i].key, err)
+		}
+	}
+
+	for i, write := range writes {
+		if err := s.keys.setKey(write.key, write.value); err != nil {
+			saveErr := fmt.Errorf("failed to save %s: %w", write.key, err)
+			if rollbackErr := s.rollback(writes[:i]); rollbackErr != nil {
+				return errors.Join(saveErr, rollbackErr)
+			}
+			return saveErr
+		}
+	}
+
+	return nil
+}
+
+// SaveState saves the character and quests together (see Save).
+// It lets the game event handler batch its saves without importing storage.
+//
+// Parameters:
+//   - character: The character to save
+//   - quests: The quest log to save
+//
+// Returns:
+//   - error: An error if the batch fails
+func (s *gameData) SaveState(character *game.Character, quests []*game.Quest) error {
+	return s.Save(State{Character: character, Quests: quests})
+}
+
+// batch serializes the state into the keys to write, in write order.
+func (state State) batch() ([]batchWrite, error) {
+	writes := make([]batchWrite, 0, 2)
+
+	if state.Character != nil {
+		jsonData, err := json.Marshal(state.Character)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal character to JSON: %w", err)
+		}
+		writes = append(writes, batchWrite{key: KeyCharacter, value: string(jsonData)})
+	}
+
+	if state.Quests != nil {
+		jsonData, err := json.Marshal(state.Quests)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal quests to JSON: %w", err)
+		}
+		writes = append(writes, batchWrite{key: KeyQuests, value: string(jsonData)})
+	}
+
+	return writes, nil
+}
+
+// rollback restores keys written by a failed batch, newest first.
+func (s *gameData) rollback(written []batchWrite) error {
+	var errs []error
+	for i := len(written) - 1; i >= 0; i-- {
+		write := written[i]
+		var err error
+		if write.existed {
+			err = s.keys.setKey(write.key, write.previous)
+		} else {
+			err = s.keys.deleteKey(write.key)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back %s: %w", write.key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
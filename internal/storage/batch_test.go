@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestSaveBatch tests saving character and quests together, and rolling
+// back the character when the quests can't be written.
+func TestSaveBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		existingLevel int  // Saved character level before the batch (0 = none)
+		failQuests    bool // Make the quests write fail
+		wantLevel     int  // Character level after the batch (0 = not saved)
+	}{
+		{"saves everything", 1, false, 7},
+		{"first save", 0, false, 7},
+		{"failure restores previous character", 1, true, 1},
+		{"failure removes new character", 0, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewLocalStore(t.TempDir())
+			if err != nil {
+				t.Fatalf("NewLocalStore() error = %v", err)
+			}
+
+			if tt.existingLevel > 0 {
+				existing := game.NewCharacter("Tester")
+				existing.Level = tt.existingLevel
+				if err := client.SaveCharacter(existing); err != nil {
+					t.Fatalf("SaveCharacter() error = %v", err)
+				}
+			}
+			if tt.failQuests {
+				// A directory where the temp file goes makes the write fail
+				if err := os.Mkdir(client.path(KeyQuests)+".tmp", 0700); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			character := game.NewCharacter("Tester")
+			character.Level = 7
+			quests := []*game.Quest{game.NewQuest("Batch", "", game.QuestTypeCommit, 1, 10, 1)}
+			err = client.Save(State{Character: character, Quests: quests})
+			if tt.failQuests != (err != nil) {
+				t.Fatalf("Save() error = %v, want error: %v", err, tt.failQuests)
+			}
+
+			loaded, err := client.LoadCharacter()
+			switch {
+			case tt.wantLevel == 0:
+				if !IsNotFound(err) {
+					t.Errorf("LoadCharacter() = %v, %v; want not found", loaded, err)
+				}
+			case err != nil:
+				t.Fatalf("LoadCharacter() error = %v", err)
+			case loaded.Level != tt.wantLevel:
+				t.Errorf("character level = %d, want %d", loaded.Level, tt.wantLevel)
+			}
+
+			if !tt.failQuests {
+				savedQuests, err := client.LoadQuests()
+				if err != nil || len(savedQuests) != 1 || savedQuests[0].Title != "Batch" {
+					t.Errorf("LoadQuests() = %v, %v; want the batch quest", savedQuests, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// This file implements Client, the app's handle on the configured store. It
+// saves to the store the config picks until a storage error makes the player
+// switch to local files, and runs the storage doctor's checks.
+
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// Client stores game data in the configured store (SkateStore, LocalStore or
+// SSHStore), and can fall back to a LocalStore when that store fails.
+type Client struct {
+	gameData
+
+	// primary is the configured store, kept for diagnosis after a fallback
+	primary keyStore
+}
+
+// NewClient creates the storage client for the configured backend.
+//
+// Parameters:
+//   - cfg: Application configuration (nil uses Skate)
+//
+// Returns:
+//   - *Client: The storage client
+//   - error: An error if the backend's CLI (skate or ssh) isn't installed, or
+//     the local directory can't be created
+func NewClient(cfg *config.Config) (*Client, error) {
+	backend := ""
+	if cfg != nil {
+		backend = cfg.Storage.Backend
+	}
+
+	switch backend {
+	case "ssh":
+		dir, err := config.DataDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the data directory for the ssh cache: %w", err)
+		}
+		store, err := NewSSHStore(cfg.Storage.SSH, filepath.Join(dir, "ssh-cache"))
+		if err != nil {
+			return nil, err
+		}
+		return newClient(store), nil
+	case "local":
+		dir, err := FallbackDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the data directory for local storage: %w", err)
+		}
+		return NewLocalClient(dir)
+	}
+
+	store, err := NewSkateStore()
+	if err != nil {
+		return nil, err
+	}
+	return newClient(store), nil
+}
+
+// NewLocalClient creates a storage client that keeps game data as JSON
+// files in dir (see LocalStore).
+//
+// Parameters:
+//   - dir: Directory to store data in (created if missing)
+//
+// Returns:
+//   - *Client: A client storing local files
+//   - error: An error if the directory can't be created
+func NewLocalClient(dir string) (*Client, error) {
+	store, err := NewLocalStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(store), nil
+}
+
+// newClient creates a client saving to a store.
+func newClient(store keyStore) *Client {
+	return &Client{gameData: gameData{keys: store}, primary: store}
+}
+
+// UseFallback switches the client from its store to plain JSON files in dir.
+// This keeps the game playable when Skate is broken or its database is locked.
+// Data saved in fallback mode is not synced back to the store automatically.
+//
+// Parameters:
+//   - dir: Directory to store data in (created if missing)
+//
+// Returns:
+//   - error: An error if the directory can't be created
+func (c *Client) UseFallback(dir string) error {
+	local, err := NewLocalStore(dir)
+	if err != nil {
+		return err
+	}
+	c.keys = local
+	return nil
+}
+
+// IsFallback reports whether the client is storing local files, as the
+// local backend or after falling back from another store.
+func (c *Client) IsFallback() bool {
+	_, local := c.keys.(*LocalStore)
+	return local
+}
+
+// SSH returns the SSH store the client saves to, for pushing and pulling
+// changes by hand.
+//
+// Returns:
+//   - *SSHStore: The SSH store
+//   - bool: False if the backend isn't ssh, or the client fell back to local files
+func (c *Client) SSH() (*SSHStore, bool) {
+	store, ok := c.keys.(*SSHStore)
+	return store, ok
+}
+
+// Diagnose runs storage health checks for the doctor panel.
+// Checks are read-only so they're safe to run while recovering from an error.
+//
+// Returns:
+//   - []HealthCheck: Results in display order
+func (c *Client) Diagnose() []HealthCheck {
+	checks := make([]HealthCheck, 0, 4)
+
+	backend := c.keys.describe()
+	if c.keys != c.primary {
+		backend = "local fallback (" + c.keys.(*LocalStore).Dir() + ")"
+	}
+	checks = append(checks, HealthCheck{Name: "Storage backend", OK: true, Detail: backend})
+
+	// After a fallback the configured store is still checked, to see why it failed
+	checks = append(checks, c.primary.checks()...)
+
+	for _, key := range []string{KeyCharacter, KeyQuests} {
+		_, err := c.keys.getKey(key)
+		switch {
+		case err == nil:
+			checks = append(checks, HealthCheck{Name: "Read " + key, OK: true, Detail: "ok"})
+		case IsNotFound(err):
+			checks = append(checks, HealthCheck{Name: "Read " + key, OK: true, Detail: "not saved yet"})
+		default:
+			checks = append(checks, HealthCheck{Name: "Read " + key, OK: false, Detail: err.Error()})
+		}
+	}
+
+	return checks
+}
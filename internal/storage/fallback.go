@@ -0,0 +1,136 @@
+// This file implements LocalStore, plain JSON files on this machine: the
+// "local" backend, and the fallback used when Skate is unavailable. It also
+// has the health checks used by the error recovery screen.
+
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// HealthCheck is the result of a single storage diagnostic.
+type HealthCheck struct {
+	Name   string // What was checked (e.g. "Skate CLI")
+	OK     bool   // Whether the check passed
+	Detail string // Path, value or error explaining the result
+}
+
+// IsNotFound reports whether a storage error means the key doesn't exist yet.
+// Missing keys are expected on first run and should not be treated as failures.
+//
+// Parameters:
+//   - err: Error returned by a Load method
+//
+// Returns:
+//   - bool: true if the error is a missing-key error
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no such key")
+}
+
+// FallbackDir returns the directory used for local fallback storage.
+// Data is kept under ~/.local/share/codequest/fallback (%LocalAppData%\codequest\fallback
+// on Windows) as one JSON file per key.
+//
+// Returns:
+//   - string: Absolute path to the fallback directory
+//   - error: An error if the data directory can't be determined
+func FallbackDir() (string, error) {
+	dir, err := config.DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "fallback"), nil
+}
+
+// LocalStore keeps game data as JSON files in a directory on this machine,
+// one per key. It's pure Go, so it works without Skate.
+type LocalStore struct {
+	gameData
+
+	// dir is the directory holding the files
+	dir string
+}
+
+// NewLocalStore creates a local store keeping game data in dir.
+//
+// Parameters:
+//   - dir: Directory to store data in (created if missing)
+//
+// Returns:
+//   - *LocalStore: A store writing local files
+//   - error: An error if the directory can't be created
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create local storage directory: %w", err)
+	}
+	s := &LocalStore{dir: dir}
+	s.gameData = gameData{keys: s}
+	return s, nil
+}
+
+// Dir returns the directory the store keeps its files in.
+func (s *LocalStore) Dir() string {
+	return s.dir
+}
+
+// describe names the backend for the storage doctor.
+func (s *LocalStore) describe() string {
+	return "local (" + s.dir + ")"
+}
+
+// checks has nothing to add: local files need no CLI.
+func (s *LocalStore) checks() []HealthCheck {
+	return nil
+}
+
+// path returns the file used to store a key.
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.dir, key+".json")
+}
+
+// setKey writes a value to the store's directory.
+// The file is written to a temp path and renamed so a crash can't truncate it.
+func (s *LocalStore) setKey(key, value string) error {
+	path := s.path(key)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(value), 0600); err != nil {
+		return fmt.Errorf("local write failed: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("local write failed: %w", err)
+	}
+	return nil
+}
+
+// getKey reads a value from the store's directory.
+func (s *LocalStore) getKey(key string) (string, error) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("key %s not found in local storage", key)
+		}
+		return "", fmt.Errorf("local read failed: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// deleteKey removes a value from the store's directory.
+func (s *LocalStore) deleteKey(key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("local delete failed: %w", err)
+	}
+	return nil
+}
+
+// listKeys lists the keys stored in the store's directory.
+func (s *LocalStore) listKeys() ([]StoredKey, error) {
+	return listDir(s.dir, ".json")
+}
@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestIsNotFound tests missing-key error detection.
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"skate missing key", errors.New("key codequest.character not found in Skate"), true},
+		{"no such key", errors.New("no such key"), true},
+		{"transient failure", errors.New("skate get failed: database is locked"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFallbackRoundTrip tests saving and loading through fallback storage.
+func TestFallbackRoundTrip(t *testing.T) {
+	client := newClient(newSkateStore("skate"))
+	if client.IsFallback() {
+		t.Fatal("IsFallback() = true before UseFallback()")
+	}
+	if err := client.UseFallback(t.TempDir()); err != nil {
+		t.Fatalf("UseFallback() error = %v", err)
+	}
+	if !client.IsFallback() {
+		t.Fatal("IsFallback() = false after UseFallback()")
+	}
+
+	if _, err := client.LoadCharacter(); !IsNotFound(err) {
+		t.Errorf("LoadCharacter() before save error = %v, want not found", err)
+	}
+
+	character := game.NewCharacter("FallbackHero")
+	if err := client.SaveCharacter(character); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+
+	loaded, err := client.LoadCharacter()
+	if err != nil {
+		t.Fatalf("LoadCharacter() error = %v", err)
+	}
+	if loaded.Name != character.Name || loaded.ID != character.ID {
+		t.Errorf("LoadCharacter() = %s/%s, want %s/%s", loaded.Name, loaded.ID, character.Name, character.ID)
+	}
+
+	quests, err := client.LoadQuests()
+	if err != nil || len(quests) != 0 {
+		t.Errorf("LoadQuests() = %v, %v; want empty list", quests, err)
+	}
+
+	if err := client.DeleteCharacter(); err != nil {
+		t.Fatalf("DeleteCharacter() error = %v", err)
+	}
+	if client.CharacterExists() {
+		t.Error("CharacterExists() = true after delete")
+	}
+
+	// The doctor names the fallback and still checks the configured store
+	checks := client.Diagnose()
+	if len(checks) < 2 || !strings.HasPrefix(checks[0].Detail, "local fallback") || checks[1].Name != "Skate CLI" {
+		t.Errorf("Diagnose() = %+v, want the local fallback then the Skate CLI check", checks)
+	}
+}
+
+// TestRepoCheckpointsNormalized tests that checkpoint keys are normalized
+// repository paths, so one repo never gets two checkpoints.
+func TestRepoCheckpointsNormalized(t *testing.T) {
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	if err := client.SaveRepoCheckpoints(map[string]string{"/src/app/": "abc123"}); err != nil {
+		t.Fatalf("SaveRepoCheckpoints() error = %v", err)
+	}
+
+	checkpoints, err := client.LoadRepoCheckpoints()
+	if err != nil {
+		t.Fatalf("LoadRepoCheckpoints() error = %v", err)
+	}
+	want := filepath.FromSlash("/src/app")
+	if len(checkpoints) != 1 || checkpoints[want] != "abc123" {
+		t.Errorf("LoadRepoCheckpoints() = %v, want {%s: abc123}", checkpoints, want)
+	}
+}
+
+// TestTimeLogRoundTrip tests saving and loading the session time log.
+func TestTimeLogRoundTrip(t *testing.T) {
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	if entries, err := client.LoadTimeLog(); err != nil || len(entries) != 0 {
+		t.Fatalf("LoadTimeLog() = %v, %v; want an empty log before the first save", entries, err)
+	}
+
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	saved := []game.TimeEntry{{Start: start, End: start.Add(90 * time.Minute), Repo: "/src/app", Quest: "Bug Hunt"}}
+	if err := client.SaveTimeLog(saved); err != nil {
+		t.Fatalf("SaveTimeLog() error = %v", err)
+	}
+
+	entries, err := client.LoadTimeLog()
+	if err != nil {
+		t.Fatalf("LoadTimeLog() error = %v", err)
+	}
+	if len(entries) != 1 || !entries[0].Start.Equal(start) || entries[0].Duration() != 90*time.Minute || entries[0].Quest != "Bug Hunt" {
+		t.Errorf("LoadTimeLog() = %+v, want %+v", entries, saved)
+	}
+}
+
+// TestChatAndSessionRoundTrip tests saving, loading and deleting the
+// mentor chat thread and the session timer, which are stored as given.
+func TestChatAndSessionRoundTrip(t *testing.T) {
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		save func([]byte) error
+		load func() ([]byte, error)
+		data string
+	}{
+		{"chat history", client.SaveChatHistory, client.LoadChatHistory, `{"persona":"Sage","messages":[]}`},
+		{"session state", client.SaveSessionState, client.LoadSessionState, `{"state":"paused"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.load(); !IsNotFound(err) {
+				t.Fatalf("load before save error = %v, want not found", err)
+			}
+			if err := tt.save([]byte(tt.data)); err != nil {
+				t.Fatalf("save error = %v", err)
+			}
+			if data, err := tt.load(); err != nil || string(data) != tt.data {
+				t.Errorf("load = %q, %v; want %q", data, err, tt.data)
+			}
+		})
+	}
+
+	if err := client.DeleteSessionState(); err != nil {
+		t.Fatalf("DeleteSessionState() error = %v", err)
+	}
+	if _, err := client.LoadSessionState(); !IsNotFound(err) {
+		t.Errorf("LoadSessionState() after delete error = %v, want not found", err)
+	}
+}
+
+// TestCharacterSchemaVersion tests that old character data is migrated on
+// load and data from a newer version is refused.
+func TestCharacterSchemaVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{"unversioned", `{"id":"a","name":"Old","level":3}`, false},
+		{"current with profile", `{"id":"a","name":"New","schema_version":2,"profile":{"motto":"Ship it","pronouns":"they/them"}}`, false},
+		{"newer version", `{"id":"a","name":"Future","schema_version":99}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewLocalStore(t.TempDir())
+			if err != nil {
+				t.Fatalf("NewLocalStore() error = %v", err)
+			}
+			if err := client.setKey(KeyCharacter, tt.json); err != nil {
+				t.Fatalf("setKey() error = %v", err)
+			}
+
+			loaded, err := client.LoadCharacter()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("LoadCharacter() error = nil, want schema version error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadCharacter() error = %v", err)
+			}
+			if loaded.SchemaVersion != game.CharacterSchemaVersion {
+				t.Errorf("SchemaVersion = %d, want %d", loaded.SchemaVersion, game.CharacterSchemaVersion)
+			}
+		})
+	}
+
+	// Profile fields survive a save and load
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	character := game.NewCharacter("Profiled")
+	character.Profile = game.CharacterProfile{Motto: "Ship it", Pronouns: "she/her", AvatarSeed: "owl", FavoriteLanguage: "Go"}
+	if err := client.SaveCharacter(character); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+	loaded, err := client.LoadCharacter()
+	if err != nil {
+		t.Fatalf("LoadCharacter() error = %v", err)
+	}
+	if loaded.Profile != character.Profile {
+		t.Errorf("Profile = %+v, want %+v", loaded.Profile, character.Profile)
+	}
+}
+
+// TestLocalBackend tests that the local backend stores JSON files under the
+// data directory without needing Skate.
+func TestLocalBackend(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("PATH", "") // No skate to find
+
+	cfg := config.DefaultConfig()
+	cfg.Storage.Backend = "local"
+	client, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	character := game.NewCharacter("LocalHero")
+	if err := client.SaveState(character, []*game.Quest{}); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".local", "share", "codequest", "fallback", KeyCharacter+".json")); err != nil {
+		t.Errorf("character file not written: %v", err)
+	}
+	if loaded, err := client.LoadCharacter(); err != nil || loaded.Name != "LocalHero" {
+		t.Errorf("LoadCharacter() = %v, %v; want LocalHero", loaded, err)
+	}
+
+	for _, check := range client.Diagnose() {
+		if !check.OK {
+			t.Errorf("health check %s failed: %s", check.Name, check.Detail)
+		}
+		if check.Name == "Skate CLI" {
+			t.Error("local backend shouldn't check for Skate")
+		}
+	}
+}
@@ -0,0 +1,397 @@
+// This file implements the game data operations, serialized to JSON and
+// kept under fixed keys. They're written once, on top of the raw keys of
+// whichever store holds them (Skate, local files or a server over SSH).
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/AutumnsGrove/codequest/internal/ai"
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Storage key names used for storing game data
+const (
+	KeyCharacter = "codequest.character" // Character data storage key
+	KeyQuests    = "codequest.quests"    // Quests list storage key
+
+	// KeyRepoCheckpoints stores the last processed commit SHA per repository
+	KeyRepoCheckpoints = "codequest.repo_checkpoints"
+
+	// KeyAIAnswers stores the mentor's cached answers (offline FAQ)
+	KeyAIAnswers = "codequest.ai_answers"
+
+	// KeyTimeLog stores the session timer's stretches (timesheet export)
+	KeyTimeLog = "codequest.time_log"
+
+	// KeyChatHistory stores the mentor chat thread (saved by the Mentor screen)
+	KeyChatHistory = "codequest_chat_history"
+
+	// KeySessionState stores the running session timer (saved by the session tracker)
+	KeySessionState = "codequest_session_state"
+)
+
+// gameData implements Storage over a store's raw keys. Each store embeds
+// one over its own keys, and Client one over the store in use.
+type gameData struct {
+	keys keyStore
+}
+
+// SaveCharacter persists a character to storage.
+// The character is serialized to JSON, stamped with the current schema
+// version, before being stored.
+//
+// Parameters:
+//   - character: The character to save (must not be nil)
+//
+// Returns:
+//   - error: An error if serialization or storage fails
+func (s *gameData) SaveCharacter(character *game.Character) error {
+	if character == nil {
+		return fmt.Errorf("cannot save nil character")
+	}
+	character.SchemaVersion = game.CharacterSchemaVersion
+
+	// Marshal character to JSON
+	jsonData, err := json.Marshal(character)
+	if err != nil {
+		return fmt.Errorf("failed to marshal character to JSON: %w", err)
+	}
+
+	if err := s.keys.setKey(KeyCharacter, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return nil
+}
+
+// LoadCharacter retrieves a character from storage.
+// The stored JSON is deserialized into a Character struct and migrated to
+// the current schema version.
+//
+// Returns:
+//   - *game.Character: The loaded character
+//   - error: An error if the character doesn't exist, if retrieval/deserialization
+//     fails, or if it was stored by a newer CodeQuest
+func (s *gameData) LoadCharacter() (*game.Character, error) {
+	jsonData, err := s.keys.getKey(KeyCharacter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load character: %w", err)
+	}
+
+	// Unmarshal JSON to Character struct
+	var character game.Character
+	if err := json.Unmarshal([]byte(jsonData), &character); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal character JSON: %w", err)
+	}
+	if err := game.MigrateCharacter(&character); err != nil {
+		return nil, err
+	}
+
+	return &character, nil
+}
+
+// SaveQuests persists a list of quests to storage.
+// The quest list is serialized to JSON before being stored.
+//
+// Parameters:
+//   - quests: The list of quests to save (can be empty, but not nil)
+//
+// Returns:
+//   - error: An error if serialization or storage fails
+func (s *gameData) SaveQuests(quests []*game.Quest) error {
+	if quests == nil {
+		return fmt.Errorf("cannot save nil quests list (use empty slice instead)")
+	}
+
+	// Marshal quests to JSON
+	jsonData, err := json.Marshal(quests)
+	if err != nil {
+		return fmt.Errorf("failed to marshal quests to JSON: %w", err)
+	}
+
+	if err := s.keys.setKey(KeyQuests, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to save quests: %w", err)
+	}
+
+	return nil
+}
+
+// LoadQuests retrieves a list of quests from storage.
+// The stored JSON is deserialized into a slice of Quest pointers.
+//
+// Returns:
+//   - []*game.Quest: The loaded quests (empty slice if no quests exist)
+//   - error: An error if retrieval or deserialization fails
+func (s *gameData) LoadQuests() ([]*game.Quest, error) {
+	jsonData, err := s.keys.getKey(KeyQuests)
+	if err != nil {
+		// If key doesn't exist, return empty slice instead of error
+		// This is expected on first run
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no such key") {
+			return []*game.Quest{}, nil
+		}
+		return nil, fmt.Errorf("failed to load quests: %w", err)
+	}
+
+	// Unmarshal JSON to Quest slice
+	var quests []*game.Quest
+	if err := json.Unmarshal([]byte(jsonData), &quests); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quests JSON: %w", err)
+	}
+
+	// Return empty slice if quests is nil (defensive)
+	if quests == nil {
+		return []*game.Quest{}, nil
+	}
+
+	return quests, nil
+}
+
+// SaveRepoCheckpoints persists the last processed commit SHA for each
+// watched repository. The watcher uses these to replay missed commits.
+//
+// Parameters:
+//   - checkpoints: Map of repository path to commit SHA
+//
+// Returns:
+//   - error: An error if serialization or storage fails
+func (s *gameData) SaveRepoCheckpoints(checkpoints map[string]string) error {
+	jsonData, err := json.Marshal(normalizeCheckpoints(checkpoints))
+	if err != nil {
+		return fmt.Errorf("failed to marshal repo checkpoints to JSON: %w", err)
+	}
+
+	if err := s.keys.setKey(KeyRepoCheckpoints, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to save repo checkpoints: %w", err)
+	}
+
+	return nil
+}
+
+// LoadRepoCheckpoints retrieves the last processed commit SHA for each
+// watched repository.
+//
+// Returns:
+//   - map[string]string: Repository path to commit SHA (empty if none saved)
+//   - error: An error if retrieval or deserialization fails
+func (s *gameData) LoadRepoCheckpoints() (map[string]string, error) {
+	jsonData, err := s.keys.getKey(KeyRepoCheckpoints)
+	if err != nil {
+		// No checkpoints yet is expected on first run
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no such key") {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to load repo checkpoints: %w", err)
+	}
+
+	checkpoints := map[string]string{}
+	if err := json.Unmarshal([]byte(jsonData), &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal repo checkpoints JSON: %w", err)
+	}
+
+	return normalizeCheckpoints(checkpoints), nil
+}
+
+// normalizeCheckpoints keys checkpoints by normalized repository path, so
+// the same repo written as c:/code/app or C:\code\app shares one checkpoint.
+func normalizeCheckpoints(checkpoints map[string]string) map[string]string {
+	normalized := make(map[string]string, len(checkpoints))
+	for repoPath, sha := range checkpoints {
+		normalized[config.NormalizePath(repoPath)] = sha
+	}
+	return normalized
+}
+
+// SaveAIAnswers persists the mentor's cached answers so repeat questions
+// can be answered instantly (and offline) in later sessions.
+//
+// Parameters:
+//   - answers: Cached answers to store
+//
+// Returns:
+//   - error: An error if serialization or storage fails
+func (s *gameData) SaveAIAnswers(answers []ai.CachedAnswer) error {
+	jsonData, err := json.Marshal(answers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal AI answers to JSON: %w", err)
+	}
+
+	if err := s.keys.setKey(KeyAIAnswers, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to save AI answers: %w", err)
+	}
+
+	return nil
+}
+
+// LoadAIAnswers retrieves the mentor's cached answers.
+//
+// Returns:
+//   - []ai.CachedAnswer: Saved answers (empty if none saved)
+//   - error: An error if retrieval or deserialization fails
+func (s *gameData) LoadAIAnswers() ([]ai.CachedAnswer, error) {
+	jsonData, err := s.keys.getKey(KeyAIAnswers)
+	if err != nil {
+		// No answers yet is expected until the mentor has been used
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no such key") {
+			return []ai.CachedAnswer{}, nil
+		}
+		return nil, fmt.Errorf("failed to load AI answers: %w", err)
+	}
+
+	var answers []ai.CachedAnswer
+	if err := json.Unmarshal([]byte(jsonData), &answers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal AI answers JSON: %w", err)
+	}
+
+	return answers, nil
+}
+
+// SaveTimeLog persists the session timer's logged stretches.
+//
+// Parameters:
+//   - entries: Time log, oldest first
+//
+// Returns:
+//   - error: An error if serialization or storage fails
+func (s *gameData) SaveTimeLog(entries []game.TimeEntry) error {
+	jsonData, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal time log to JSON: %w", err)
+	}
+
+	if err := s.keys.setKey(KeyTimeLog, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to save time log: %w", err)
+	}
+
+	return nil
+}
+
+// LoadTimeLog retrieves the session timer's logged stretches.
+//
+// Returns:
+//   - []game.TimeEntry: Time log, oldest first (empty if none saved)
+//   - error: An error if retrieval or deserialization fails
+func (s *gameData) LoadTimeLog() ([]game.TimeEntry, error) {
+	jsonData, err := s.keys.getKey(KeyTimeLog)
+	if err != nil {
+		// No log yet is expected until the timer has been used
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no such key") {
+			return []game.TimeEntry{}, nil
+		}
+		return nil, fmt.Errorf("failed to load time log: %w", err)
+	}
+
+	var entries []game.TimeEntry
+	if err := json.Unmarshal([]byte(jsonData), &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal time log JSON: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeleteCharacter removes the character from storage.
+// This is useful for starting fresh or resetting progress.
+//
+// Returns:
+//   - error: An error if deletion fails
+func (s *gameData) DeleteCharacter() error {
+	if err := s.keys.deleteKey(KeyCharacter); err != nil {
+		return fmt.Errorf("failed to delete character: %w", err)
+	}
+	return nil
+}
+
+// DeleteQuests removes all quests from storage.
+// This is useful for starting fresh or resetting progress.
+//
+// Returns:
+//   - error: An error if deletion fails
+func (s *gameData) DeleteQuests() error {
+	if err := s.keys.deleteKey(KeyQuests); err != nil {
+		return fmt.Errorf("failed to delete quests: %w", err)
+	}
+	return nil
+}
+
+// CharacterExists checks if a character is stored in storage.
+// This is useful for determining if this is a first run.
+//
+// Returns:
+//   - bool: true if a character exists, false otherwise
+func (s *gameData) CharacterExists() bool {
+	_, err := s.keys.getKey(KeyCharacter)
+	return err == nil
+}
+
+// SaveChatHistory persists the mentor chat thread. The thread is encoded
+// by the Mentor screen, so it's stored as given.
+//
+// Parameters:
+//   - thread: The chat thread as JSON
+//
+// Returns:
+//   - error: An error if storage fails
+func (s *gameData) SaveChatHistory(thread []byte) error {
+	if err := s.keys.setKey(KeyChatHistory, string(thread)); err != nil {
+		return fmt.Errorf("failed to save chat history: %w", err)
+	}
+	return nil
+}
+
+// LoadChatHistory retrieves the mentor chat thread.
+//
+// Returns:
+//   - []byte: The chat thread as JSON
+//   - error: An error if retrieval fails (not found if none saved)
+func (s *gameData) LoadChatHistory() ([]byte, error) {
+	thread, err := s.keys.getKey(KeyChatHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat history: %w", err)
+	}
+	return []byte(thread), nil
+}
+
+// SaveSessionState persists the running session timer, so a session
+// survives restarts. The state is encoded by the session tracker, so it's
+// stored as given.
+//
+// Parameters:
+//   - state: The session state as JSON
+//
+// Returns:
+//   - error: An error if storage fails
+func (s *gameData) SaveSessionState(state []byte) error {
+	if err := s.keys.setKey(KeySessionState, string(state)); err != nil {
+		return fmt.Errorf("failed to save session state: %w", err)
+	}
+	return nil
+}
+
+// LoadSessionState retrieves the saved session timer.
+//
+// Returns:
+//   - []byte: The session state as JSON
+//   - error: An error if retrieval fails (not found if none saved)
+func (s *gameData) LoadSessionState() ([]byte, error) {
+	state, err := s.keys.getKey(KeySessionState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session state: %w", err)
+	}
+	return []byte(state), nil
+}
+
+// DeleteSessionState removes the saved session timer.
+//
+// Returns:
+//   - error: An error if deletion fails
+func (s *gameData) DeleteSessionState() error {
+	if err := s.keys.deleteKey(KeySessionState); err != nil {
+		return fmt.Errorf("failed to delete session state: %w", err)
+	}
+	return nil
+}
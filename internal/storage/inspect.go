@@ -0,0 +1,154 @@
+// This file implements the raw data inspector's side of storage: listing
+// every CodeQuest key the backend holds with its size and last change, and
+// reading or deleting a single key by name.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// keyPrefix starts every key CodeQuest stores (codequest.character,
+// codequest_chat_history, ...)
+const keyPrefix = "codequest"
+
+// sshListScript prints "<bytes> <key>" for every save file on the server
+const sshListScript = `cd "$1" 2>/dev/null || exit 0
+for f in *.save; do [ -f "$f" ] && printf '%s %s\n' "$(wc -c < "$f")" "${f%.save}"; done
+exit 0`
+
+// StoredKey is a key found in the storage backend.
+type StoredKey struct {
+	Key      string    // Storage key
+	Label    string    // What the key holds ("" for keys this version doesn't know)
+	Bytes    int       // Stored size
+	Modified time.Time // Last change (zero when the backend doesn't record one)
+}
+
+// ListKeys lists every CodeQuest key in the storage backend, sorted by key.
+// Skate doesn't record when keys change; over SSH the time is when this
+// machine last synced the key.
+//
+// Returns:
+//   - []StoredKey: Keys with their sizes
+//   - error: An error if the backend can't be listed
+func (s *gameData) ListKeys() ([]StoredKey, error) {
+	keys, err := s.keys.listKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string, len(usageKeys))
+	for _, entry := range usageKeys {
+		labels[entry.Key] = entry.Label
+	}
+	for i := range keys {
+		keys[i].Label = labels[keys[i].Key]
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys, nil
+}
+
+// ReadKey returns the raw value stored under a key.
+//
+// Parameters:
+//   - key: Storage key
+//
+// Returns:
+//   - string: The stored value (usually JSON)
+//   - error: An error if the key is missing or can't be read
+func (s *gameData) ReadKey(key string) (string, error) {
+	return s.keys.getKey(key)
+}
+
+// DeleteKey removes a single CodeQuest key from the storage backend.
+//
+// Parameters:
+//   - key: Storage key (must start with "codequest")
+//
+// Returns:
+//   - error: An error if the key isn't CodeQuest's or can't be deleted
+func (s *gameData) DeleteKey(key string) error {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return fmt.Errorf("refusing to delete %s: not a CodeQuest key", key)
+	}
+	return s.keys.deleteKey(key)
+}
+
+// parseSkateList reads `skate list` output (a tab between key and value on
+// each line), keeping CodeQuest's keys.
+func parseSkateList(output string) []StoredKey {
+	var keys []StoredKey
+	for _, line := range strings.Split(output, "\n") {
+		key, value, _ := strings.Cut(line, "\t")
+		if strings.HasPrefix(key, keyPrefix) {
+			keys = append(keys, StoredKey{Key: key, Bytes: len(strings.TrimSpace(value))})
+		}
+	}
+	return keys
+}
+
+// listDir lists the keys stored as files with the given extension in dir.
+func listDir(dir, ext string) ([]StoredKey, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+
+	var keys []StoredKey
+	for _, entry := range entries {
+		key, ok := strings.CutSuffix(entry.Name(), ext)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		keys = append(keys, StoredKey{Key: key, Bytes: int(info.Size()), Modified: info.ModTime()})
+	}
+	return keys, nil
+}
+
+// list lists the keys on the server, with when each was last synced here.
+// While the server can't be reached the cached keys are listed instead.
+func (r *sshRemote) list() ([]StoredKey, error) {
+	output, code, err := r.run(sshListScript, "")
+	switch {
+	case code == sshExitOffline:
+		return listDir(r.cacheDir, ".json")
+	case err != nil:
+		return nil, fmt.Errorf("failed to list keys on %s: %w", r.describe(), err)
+	}
+
+	keys := parseSSHList(output)
+	for i := range keys {
+		if info, err := os.Stat(r.cachePath(keys[i].Key)); err == nil {
+			keys[i].Modified = info.ModTime()
+		}
+	}
+	return keys, nil
+}
+
+// parseSSHList reads sshListScript output ("<bytes> <key>" per line).
+func parseSSHList(output string) []StoredKey {
+	var keys []StoredKey
+	for _, line := range strings.Split(output, "\n") {
+		size, key, ok := strings.Cut(strings.TrimSpace(line), " ")
+		bytes, err := strconv.Atoi(size)
+		if !ok || err != nil || !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		keys = append(keys, StoredKey{Key: key, Bytes: bytes})
+	}
+	return keys
+}
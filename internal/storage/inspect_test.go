@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseKeyListings tests reading key listings from Skate and the SSH
+// backend, keeping only CodeQuest's keys.
+func TestParseKeyListings(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) []StoredKey
+		input string
+		want  []StoredKey
+	}{
+		{
+			name:  "skate list",
+			parse: parseSkateList,
+			input: "codequest.character\t{\"name\":\"Hero\"}\nother-app\tsecret\ncodequest_chat_history\t[]\n",
+			want:  []StoredKey{{Key: "codequest.character", Bytes: 15}, {Key: "codequest_chat_history", Bytes: 2}},
+		},
+		{
+			name:  "empty skate list",
+			parse: parseSkateList,
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "ssh list",
+			parse: parseSSHList,
+			input: "120 codequest.quests\n  8 codequest.time_log\ngarbage\n",
+			want:  []StoredKey{{Key: "codequest.quests", Bytes: 120}, {Key: "codequest.time_log", Bytes: 8}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.parse(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parse(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestInspectFallbackKeys tests listing, reading and deleting raw keys in
+// fallback storage.
+func TestInspectFallbackKeys(t *testing.T) {
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	if err := client.setKey(KeyQuests, `[{"id":"q1"}]`); err != nil {
+		t.Fatalf("setKey() error = %v", err)
+	}
+	if err := client.setKey(KeyCharacter, `{"name":"Hero"}`); err != nil {
+		t.Fatalf("setKey() error = %v", err)
+	}
+
+	keys, err := client.ListKeys()
+	if err != nil {
+		t.Fatalf("ListKeys() error = %v", err)
+	}
+	if len(keys) != 2 || keys[0].Key != KeyCharacter || keys[0].Label != "Character" || keys[0].Modified.IsZero() {
+		t.Fatalf("ListKeys() = %+v, want the character then quests, labeled and timestamped", keys)
+	}
+
+	if value, err := client.ReadKey(KeyQuests); err != nil || value != `[{"id":"q1"}]` {
+		t.Errorf("ReadKey() = %q, %v", value, err)
+	}
+	if err := client.DeleteKey("someone.else"); err == nil {
+		t.Error("DeleteKey() should refuse keys that aren't CodeQuest's")
+	}
+	if err := client.DeleteKey(KeyQuests); err != nil {
+		t.Fatalf("DeleteKey() error = %v", err)
+	}
+	if keys, _ := client.ListKeys(); len(keys) != 1 {
+		t.Errorf("ListKeys() after delete = %+v, want only the character", keys)
+	}
+}
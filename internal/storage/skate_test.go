@@ -55,8 +55,8 @@ func resetMockExec() {
 	}
 }
 
-// TestNewSkateClient tests the Skate client initialization
-func TestNewSkateClient(t *testing.T) {
+// TestNewSkateStore tests the Skate client initialization
+func TestNewSkateStore(t *testing.T) {
 	tests := []struct {
 		name    string
 		wantErr bool
@@ -70,7 +70,7 @@ func TestNewSkateClient(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Try to create a new client
-			client, err := NewSkateClient()
+			client, err := NewSkateStore()
 
 			if tt.wantErr {
 				// If Skate IS installed, skip this test
@@ -79,52 +79,52 @@ func TestNewSkateClient(t *testing.T) {
 				}
 				// Verify error message mentions skate
 				if !strings.Contains(err.Error(), "skate") {
-					t.Errorf("NewSkateClient() error should mention 'skate', got: %v", err)
+					t.Errorf("NewSkateStore() error should mention 'skate', got: %v", err)
 				}
 			} else {
 				if err != nil {
-					t.Errorf("NewSkateClient() unexpected error: %v", err)
+					t.Errorf("NewSkateStore() unexpected error: %v", err)
 				}
 				if client == nil {
-					t.Errorf("NewSkateClient() returned nil client")
+					t.Errorf("NewSkateStore() returned nil client")
 				}
 				if client.skatePath == "" {
-					t.Errorf("NewSkateClient() skatePath is empty")
+					t.Errorf("NewSkateStore() skatePath is empty")
 				}
 			}
 		})
 	}
 }
 
-// TestNewSkateClient_WithSkateInstalled tests client creation when Skate is available
-func TestNewSkateClient_WithSkateInstalled(t *testing.T) {
+// TestNewSkateStore_WithSkateInstalled tests client creation when Skate is available
+func TestNewSkateStore_WithSkateInstalled(t *testing.T) {
 	// Check if skate is installed
 	_, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client, err := NewSkateClient()
+	client, err := NewSkateStore()
 	if err != nil {
-		t.Errorf("NewSkateClient() unexpected error with Skate installed: %v", err)
+		t.Errorf("NewSkateStore() unexpected error with Skate installed: %v", err)
 	}
 	if client == nil {
-		t.Errorf("NewSkateClient() returned nil client")
+		t.Errorf("NewSkateStore() returned nil client")
 	}
 	if client.skatePath == "" {
-		t.Errorf("NewSkateClient() skatePath is empty")
+		t.Errorf("NewSkateStore() skatePath is empty")
 	}
 }
 
-// TestSkateClient_SaveCharacter tests character serialization and storage
-func TestSkateClient_SaveCharacter(t *testing.T) {
+// TestSkateStore_SaveCharacter tests character serialization and storage
+func TestSkateStore_SaveCharacter(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	tests := []struct {
 		name      string
@@ -183,15 +183,15 @@ func TestSkateClient_SaveCharacter(t *testing.T) {
 	_ = client.DeleteCharacter()
 }
 
-// TestSkateClient_LoadCharacter tests character retrieval and deserialization
-func TestSkateClient_LoadCharacter(t *testing.T) {
+// TestSkateStore_LoadCharacter tests character retrieval and deserialization
+func TestSkateStore_LoadCharacter(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	tests := []struct {
 		name     string
@@ -297,15 +297,15 @@ func TestSkateClient_LoadCharacter(t *testing.T) {
 	}
 }
 
-// TestSkateClient_SaveQuests tests quest list serialization and storage
-func TestSkateClient_SaveQuests(t *testing.T) {
+// TestSkateStore_SaveQuests tests quest list serialization and storage
+func TestSkateStore_SaveQuests(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	tests := []struct {
 		name    string
@@ -374,15 +374,15 @@ func TestSkateClient_SaveQuests(t *testing.T) {
 	_ = client.DeleteQuests()
 }
 
-// TestSkateClient_LoadQuests tests quest list retrieval and deserialization
-func TestSkateClient_LoadQuests(t *testing.T) {
+// TestSkateStore_LoadQuests tests quest list retrieval and deserialization
+func TestSkateStore_LoadQuests(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	tests := []struct {
 		name     string
@@ -506,15 +506,15 @@ func TestSkateClient_LoadQuests(t *testing.T) {
 	}
 }
 
-// TestSkateClient_CharacterExists tests character existence check
-func TestSkateClient_CharacterExists(t *testing.T) {
+// TestSkateStore_CharacterExists tests character existence check
+func TestSkateStore_CharacterExists(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	tests := []struct {
 		name  string
@@ -559,15 +559,15 @@ func TestSkateClient_CharacterExists(t *testing.T) {
 	}
 }
 
-// TestSkateClient_DeleteCharacter tests character deletion
-func TestSkateClient_DeleteCharacter(t *testing.T) {
+// TestSkateStore_DeleteCharacter tests character deletion
+func TestSkateStore_DeleteCharacter(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	// Create a character first
 	char := game.NewCharacter("DeleteTest")
@@ -593,15 +593,15 @@ func TestSkateClient_DeleteCharacter(t *testing.T) {
 	}
 }
 
-// TestSkateClient_DeleteQuests tests quest list deletion
-func TestSkateClient_DeleteQuests(t *testing.T) {
+// TestSkateStore_DeleteQuests tests quest list deletion
+func TestSkateStore_DeleteQuests(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	// Create quests first
 	quests := []*game.Quest{
@@ -628,15 +628,15 @@ func TestSkateClient_DeleteQuests(t *testing.T) {
 	}
 }
 
-// TestSkateClient_SaveLoadRoundTrip tests full save/load cycle
-func TestSkateClient_SaveLoadRoundTrip(t *testing.T) {
+// TestSkateStore_SaveLoadRoundTrip tests full save/load cycle
+func TestSkateStore_SaveLoadRoundTrip(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	// Create a character with various states
 	originalChar := game.NewCharacter("RoundTripTest")
@@ -684,15 +684,15 @@ func TestSkateClient_SaveLoadRoundTrip(t *testing.T) {
 	_ = client.DeleteCharacter()
 }
 
-// TestSkateClient_QuestsSaveLoadRoundTrip tests full quest save/load cycle
-func TestSkateClient_QuestsSaveLoadRoundTrip(t *testing.T) {
+// TestSkateStore_QuestsSaveLoadRoundTrip tests full quest save/load cycle
+func TestSkateStore_QuestsSaveLoadRoundTrip(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	// Create quests with various states
 	quest1 := game.NewQuest("Available Quest", "Not started", game.QuestTypeCommit, 5, 100, 1)
@@ -807,15 +807,15 @@ func TestJSONMarshaling(t *testing.T) {
 	}
 }
 
-// TestSkateClient_ErrorHandling tests various error conditions
-func TestSkateClient_ErrorHandling(t *testing.T) {
+// TestSkateStore_ErrorHandling tests various error conditions
+func TestSkateStore_ErrorHandling(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	t.Run("save nil character", func(t *testing.T) {
 		err := client.SaveCharacter(nil)
@@ -848,15 +848,15 @@ func TestSkateClient_ErrorHandling(t *testing.T) {
 	})
 }
 
-// TestSkateClient_ConcurrentAccess tests thread safety (basic)
-func TestSkateClient_ConcurrentAccess(t *testing.T) {
+// TestSkateStore_ConcurrentAccess tests thread safety (basic)
+func TestSkateStore_ConcurrentAccess(t *testing.T) {
 	// Skip if Skate not installed
 	skatePath, err := exec.LookPath("skate")
 	if err != nil {
 		t.Skip("Skate not installed, skipping integration test")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 
 	// This is a basic test - Skate itself handles concurrency
 	// We're just verifying our wrapper doesn't panic
@@ -897,7 +897,7 @@ func BenchmarkSaveCharacter(b *testing.B) {
 		b.Skip("Skate not installed")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 	char := game.NewCharacter("BenchChar")
 
 	b.ResetTimer()
@@ -915,7 +915,7 @@ func BenchmarkLoadCharacter(b *testing.B) {
 		b.Skip("Skate not installed")
 	}
 
-	client := &SkateClient{skatePath: skatePath}
+	client := newSkateStore(skatePath)
 	char := game.NewCharacter("BenchChar")
 	_ = client.SaveCharacter(char)
 
@@ -1024,9 +1024,9 @@ func TestInvalidJSON(t *testing.T) {
 	}
 }
 
-// TestSkateClient_InvalidSkatePath tests behavior with invalid skate binary path
-func TestSkateClient_InvalidSkatePath(t *testing.T) {
-	client := &SkateClient{skatePath: "/nonexistent/path/to/skate"}
+// TestSkateStore_InvalidSkatePath tests behavior with invalid skate binary path
+func TestSkateStore_InvalidSkatePath(t *testing.T) {
+	client := newSkateStore("/nonexistent/path/to/skate")
 
 	// Try to save a character with invalid skate path
 	char := game.NewCharacter("Test")
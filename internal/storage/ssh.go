@@ -0,0 +1,468 @@
+// This file implements the SSH backend, which keeps game data on your own
+// server instead of Charm Cloud. Each key is a save envelope on the server:
+// a version number on the first line and the JSON value after it. Writes
+// only succeed against the version this machine last saw (optimistic
+// locking), and while the server is unreachable reads and writes go to a
+// local cache that is pushed once it's back.
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// ErrConflict means the server copy changed since this machine last saw it
+// (another machine saved in between). Resolve with `codequest storage pull`
+// (keep the server copy) or `codequest storage push --force` (keep this one).
+var ErrConflict = errors.New("save conflict")
+
+// errOffline means the server couldn't be reached.
+var errOffline = errors.New("server unreachable")
+
+// Exit codes of the remote scripts. ssh itself exits 255 when it can't
+// connect, which is treated as being offline.
+const (
+	sshExitOffline  = 255
+	sshExitNotFound = 44
+	sshExitLocked   = 75
+	sshExitConflict = 76
+)
+
+// Remote scripts, run with sh -c as: <script> codequest <dir> <key> [version].
+const (
+	// sshGetScript prints the save envelope
+	sshGetScript = `f="$1/$2.save"; [ -f "$f" ] || exit 44; cat "$f"`
+
+	// sshPutScript writes stdin as the next version if the server is still at
+	// the expected version, printing the new version (or the current one on a
+	// conflict). A lock directory keeps two writers from interleaving.
+	sshPutScript = `mkdir -p "$1" || exit 1
+f="$1/$2.save"
+mkdir "$f.lock" 2>/dev/null || exit 75
+trap 'rmdir "$f.lock"' EXIT
+v=$(head -n 1 "$f" 2>/dev/null); v=${v:-0}
+if [ "$v" != "$3" ]; then echo "$v"; exit 76; fi
+n=$((v + 1))
+{ echo "$n"; cat; } > "$f.tmp" && mv "$f.tmp" "$f" && echo "$n"`
+
+	// sshDeleteScript removes a save file
+	sshDeleteScript = `rm -f "$1/$2.save"`
+)
+
+// syncedKeys are the keys Storage writes, which the SSH backend syncs.
+var syncedKeys = []string{KeyCharacter, KeyQuests, KeyRepoCheckpoints, KeyAIAnswers, KeyTimeLog, KeyChatHistory, KeySessionState}
+
+// sshRemote stores keys on a server over SSH, with a local cache.
+type sshRemote struct {
+	sshPath  string   // ssh binary
+	host     string   // user@host or ~/.ssh/config alias
+	dir      string   // remote directory (relative paths are under the remote home)
+	options  []string // extra ssh options (port, identity file)
+	cacheDir string   // local copies of every key, and changes not pushed yet
+}
+
+// sshCacheEntry is the local copy of a key.
+type sshCacheEntry struct {
+	Version int    `json:"version"` // Server version the copy is based on
+	Pending bool   `json:"pending"` // Changed locally and not pushed yet
+	Value   string `json:"value"`   // The JSON value
+}
+
+// SSHStore keeps game data on a server over SSH, with a local cache for
+// when the server can't be reached.
+type SSHStore struct {
+	gameData
+
+	// remote is the server and the local cache
+	remote *sshRemote
+}
+
+// NewSSHStore creates a store that keeps game data on a server.
+//
+// Parameters:
+//   - ssh: Server settings
+//   - cacheDir: Local directory for cached copies (created if missing)
+//
+// Returns:
+//   - *SSHStore: A store saving over SSH
+//   - error: An error if ssh isn't installed or the cache can't be created
+func NewSSHStore(ssh config.SSHStorageConfig, cacheDir string) (*SSHStore, error) {
+	sshPath, err := exec.LookPath("ssh")
+	if err != nil {
+		return nil, fmt.Errorf("ssh not found in PATH: %w", err)
+	}
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create ssh cache directory: %w", err)
+	}
+
+	dir := ssh.Path
+	if dir == "" || dir == "~" {
+		dir = ".codequest"
+	}
+	// Commands start in the remote home, and quoting would stop ~ expanding
+	dir = strings.TrimPrefix(dir, "~/")
+
+	// BatchMode fails instead of prompting for a password over the TUI
+	options := []string{"-o", "BatchMode=yes", "-o", "ConnectTimeout=5"}
+	if ssh.Port != 0 {
+		options = append(options, "-p", strconv.Itoa(ssh.Port))
+	}
+	if ssh.IdentityFile != "" {
+		identity, err := config.ExpandPath(ssh.IdentityFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ssh identity file: %w", err)
+		}
+		options = append(options, "-i", identity)
+	}
+
+	return newSSHStore(&sshRemote{
+		sshPath:  sshPath,
+		host:     ssh.Host,
+		dir:      dir,
+		options:  options,
+		cacheDir: cacheDir,
+	}), nil
+}
+
+// newSSHStore creates a store saving to a remote.
+func newSSHStore(remote *sshRemote) *SSHStore {
+	s := &SSHStore{remote: remote}
+	s.gameData = gameData{keys: s}
+	return s
+}
+
+// describe names the backend for the storage doctor.
+func (s *SSHStore) describe() string {
+	return "SSH (" + s.remote.describe() + ")"
+}
+
+// checks reports changes made offline and not pushed yet.
+func (s *SSHStore) checks() []HealthCheck {
+	switch pending, err := s.remote.pending(); {
+	case err != nil:
+		return []HealthCheck{{Name: "Offline changes", OK: false, Detail: err.Error()}}
+	case len(pending) > 0:
+		return []HealthCheck{{Name: "Offline changes", OK: false,
+			Detail: strings.Join(pending, ", ") + " not pushed yet (codequest storage push)"}}
+	default:
+		return []HealthCheck{{Name: "Offline changes", OK: true, Detail: "none"}}
+	}
+}
+
+// getKey returns a key's value from the server (see sshRemote.get).
+func (s *SSHStore) getKey(key string) (string, error) {
+	return s.remote.get(key)
+}
+
+// setKey saves a key on the server (see sshRemote.set).
+func (s *SSHStore) setKey(key, value string) error {
+	return s.remote.set(key, value)
+}
+
+// deleteKey removes a key from the server and the cache.
+func (s *SSHStore) deleteKey(key string) error {
+	return s.remote.delete(key)
+}
+
+// listKeys lists the keys on the server (see sshRemote.list).
+func (s *SSHStore) listKeys() ([]StoredKey, error) {
+	return s.remote.list()
+}
+
+// PendingChanges lists the keys saved while the server was unreachable (or
+// rejected as a conflict) and not pushed yet.
+//
+// Returns:
+//   - []string: Keys with local changes
+//   - error: An error if the cache can't be read
+func (s *SSHStore) PendingChanges() ([]string, error) {
+	return s.remote.pending()
+}
+
+// Push sends every pending local change to the server. Without force a
+// change is rejected with ErrConflict if the server copy changed since it
+// was last seen; with force it replaces the server copy.
+//
+// Parameters:
+//   - force: Overwrite the server copy on a conflict
+//
+// Returns:
+//   - error: An error if any change couldn't be pushed
+func (s *SSHStore) Push(force bool) error {
+	keys, err := s.PendingChanges()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, key := range keys {
+		if err := s.remote.push(key, force); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Pull throws away pending local changes and refreshes the cache from the
+// server, making the server copy win.
+//
+// Returns:
+//   - error: An error if the server can't be read
+func (s *SSHStore) Pull() error {
+	// Everything is read before the cache changes, so an unreachable
+	// server can't cost the local changes
+	entries := make(map[string]sshCacheEntry)
+	for _, key := range syncedKeys {
+		entry, ok, err := s.remote.fetch(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			entries[key] = entry
+		}
+	}
+
+	for _, key := range syncedKeys {
+		entry, ok := entries[key]
+		if !ok {
+			if err := os.Remove(s.remote.cachePath(key)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to drop cached %s: %w", key, err)
+			}
+			continue
+		}
+		if err := s.remote.writeCache(key, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describe names the server and directory, e.g. "me@homeserver:.codequest".
+func (r *sshRemote) describe() string {
+	return r.host + ":" + r.dir
+}
+
+// run executes a remote script, returning its output and exit code.
+// An exit code of sshExitOffline means the server couldn't be reached.
+func (r *sshRemote) run(script, stdin string, args ...string) (string, int, error) {
+	command := []string{"sh", "-c", shellQuote(script), "codequest", shellQuote(r.dir)}
+	for _, arg := range args {
+		command = append(command, shellQuote(arg))
+	}
+
+	cmdArgs := append(append([]string{}, r.options...), r.host, strings.Join(command, " "))
+	cmd := exec.Command(r.sshPath, cmdArgs...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr strings.Builder
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return stdout.String(), 0, nil
+	case errors.As(err, &exitErr):
+		return stdout.String(), exitErr.ExitCode(), fmt.Errorf("ssh %s: %w (stderr: %s)", r.host, err, strings.TrimSpace(stderr.String()))
+	default:
+		return "", -1, fmt.Errorf("ssh %s: %w", r.host, err)
+	}
+}
+
+// get returns a key's value. Changes made offline are pushed first; when the
+// server can't be reached, the cached copy is returned.
+func (r *sshRemote) get(key string) (string, error) {
+	if err := r.push(key, false); err != nil && !errors.Is(err, errOffline) {
+		return "", err
+	}
+
+	entry, ok, err := r.fetch(key)
+	switch {
+	case errors.Is(err, errOffline):
+		cached, cachedOK, cacheErr := r.readCache(key)
+		switch {
+		case cacheErr != nil:
+			return "", cacheErr
+		case !cachedOK:
+			// Not "not found": a missing key would let first-run setup
+			// overwrite data the server may well have
+			return "", fmt.Errorf("%s isn't cached and %w", key, err)
+		}
+		return cached.Value, nil
+	case err != nil:
+		return "", err
+	case !ok:
+		return "", fmt.Errorf("key %s not found on %s", key, r.describe())
+	}
+
+	if err := r.writeCache(key, entry); err != nil {
+		return "", err
+	}
+	return entry.Value, nil
+}
+
+// fetch reads a key from the server, without touching the cache.
+//
+// Returns:
+//   - sshCacheEntry: The server copy
+//   - bool: False if the server has no copy
+//   - error: errOffline if the server can't be reached, or a read error
+func (r *sshRemote) fetch(key string) (sshCacheEntry, bool, error) {
+	output, code, err := r.run(sshGetScript, "", key)
+	switch code {
+	case 0:
+		version, value, parseErr := parseEnvelope(output)
+		if parseErr != nil {
+			return sshCacheEntry{}, false, fmt.Errorf("bad save file for %s on %s: %w", key, r.describe(), parseErr)
+		}
+		return sshCacheEntry{Version: version, Value: value}, true, nil
+	case sshExitNotFound:
+		return sshCacheEntry{}, false, nil
+	case sshExitOffline:
+		return sshCacheEntry{}, false, fmt.Errorf("%w (%s): %w", errOffline, r.describe(), err)
+	default:
+		return sshCacheEntry{}, false, fmt.Errorf("failed to read %s from %s: %w", key, r.describe(), err)
+	}
+}
+
+// set saves a key against the version last seen. When the server can't be
+// reached the value is kept in the cache and pushed later.
+func (r *sshRemote) set(key, value string) error {
+	entry, _, err := r.readCache(key)
+	if err != nil {
+		return err
+	}
+	entry.Value, entry.Pending = value, true
+	if err := r.writeCache(key, entry); err != nil {
+		return err
+	}
+
+	if err := r.push(key, false); err != nil && !errors.Is(err, errOffline) {
+		return err
+	}
+	return nil
+}
+
+// push sends a key's pending local change to the server. Without force the
+// write only goes through if the server is still at the version the change
+// was based on; with force it replaces whatever the server has.
+func (r *sshRemote) push(key string, force bool) error {
+	entry, ok, err := r.readCache(key)
+	if err != nil || !ok || !entry.Pending {
+		return err
+	}
+
+	base := entry.Version
+	if force {
+		current, _, err := r.fetch(key)
+		if err != nil {
+			return err
+		}
+		base = current.Version
+	}
+
+	output, code, err := r.run(sshPutScript, entry.Value+"\n", key, strconv.Itoa(base))
+	switch code {
+	case 0:
+		version, parseErr := strconv.Atoi(strings.TrimSpace(output))
+		if parseErr != nil {
+			return fmt.Errorf("bad reply saving %s to %s: %q", key, r.describe(), output)
+		}
+		return r.writeCache(key, sshCacheEntry{Version: version, Value: entry.Value})
+	case sshExitConflict:
+		return fmt.Errorf("%w: %s on %s is at version %s, this machine's change is based on %d (run `codequest storage pull` to keep the server copy or `codequest storage push --force` to keep this one)",
+			ErrConflict, key, r.describe(), strings.TrimSpace(output), entry.Version)
+	case sshExitLocked:
+		return fmt.Errorf("%s is locked on %s by another save (remove %s/%s.save.lock if no save is running)", key, r.describe(), r.dir, key)
+	case sshExitOffline:
+		return fmt.Errorf("%w (%s): %w", errOffline, r.describe(), err)
+	default:
+		return fmt.Errorf("failed to save %s to %s: %w", key, r.describe(), err)
+	}
+}
+
+// delete removes a key from the server and the cache. It needs the server.
+func (r *sshRemote) delete(key string) error {
+	if _, _, err := r.run(sshDeleteScript, "", key); err != nil {
+		return fmt.Errorf("failed to delete %s on %s: %w", key, r.describe(), err)
+	}
+	if err := os.Remove(r.cachePath(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete cached %s: %w", key, err)
+	}
+	return nil
+}
+
+// pending lists the keys changed locally and not pushed yet.
+func (r *sshRemote) pending() ([]string, error) {
+	var keys []string
+	for _, key := range syncedKeys {
+		entry, ok, err := r.readCache(key)
+		if err != nil {
+			return nil, err
+		}
+		if ok && entry.Pending {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// cachePath returns the file caching a key.
+func (r *sshRemote) cachePath(key string) string {
+	return filepath.Join(r.cacheDir, key+".json")
+}
+
+// readCache returns the cached copy of a key.
+func (r *sshRemote) readCache(key string) (sshCacheEntry, bool, error) {
+	var entry sshCacheEntry
+	data, err := os.ReadFile(r.cachePath(key))
+	if os.IsNotExist(err) {
+		return entry, false, nil
+	}
+	if err != nil {
+		return entry, false, fmt.Errorf("failed to read cached %s: %w", key, err)
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return entry, false, fmt.Errorf("failed to read cached %s: %w", key, err)
+	}
+	return entry, true, nil
+}
+
+// writeCache stores the cached copy of a key, via a temp file so a crash
+// can't truncate it.
+func (r *sshRemote) writeCache(key string, entry sshCacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to cache %s: %w", key, err)
+	}
+	path := r.cachePath(key)
+	if err := os.WriteFile(path+".tmp", data, 0600); err != nil {
+		return fmt.Errorf("failed to cache %s: %w", key, err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("failed to cache %s: %w", key, err)
+	}
+	return nil
+}
+
+// parseEnvelope splits a save envelope into its version and value.
+func parseEnvelope(envelope string) (int, string, error) {
+	header, value, _ := strings.Cut(envelope, "\n")
+	version, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil {
+		return 0, "", fmt.Errorf("missing version line")
+	}
+	return version, strings.TrimSpace(value), nil
+}
+
+// shellQuote quotes a string for the remote shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// fakeSSH is a stand-in ssh that runs the remote command locally, or exits
+// 255 (can't connect) while the offline file exists.
+type fakeSSH struct {
+	path      string // The fake ssh script
+	offline   string // Offline flag file
+	remoteDir string // "Server" directory shared by every client
+}
+
+// newFakeSSH writes the fake ssh script into a temp directory.
+func newFakeSSH(t *testing.T) fakeSSH {
+	t.Helper()
+	dir := t.TempDir()
+	fake := fakeSSH{
+		path:      filepath.Join(dir, "ssh"),
+		offline:   filepath.Join(dir, "offline"),
+		remoteDir: filepath.Join(dir, "server"),
+	}
+	script := "#!/bin/sh\n[ -e '" + fake.offline + "' ] && exit 255\nfor last; do :; done\nexec sh -c \"$last\"\n"
+	if err := os.WriteFile(fake.path, []byte(script), 0755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return fake
+}
+
+// client returns a client on its own machine (own cache) for the server.
+func (f fakeSSH) client(t *testing.T) *SSHStore {
+	return newSSHStore(&sshRemote{sshPath: f.path, host: "me@home", dir: f.remoteDir, cacheDir: t.TempDir()})
+}
+
+// setOffline cuts or restores the connection to the server.
+func (f fakeSSH) setOffline(t *testing.T, offline bool) {
+	t.Helper()
+	if !offline {
+		os.Remove(f.offline)
+		return
+	}
+	if err := os.WriteFile(f.offline, nil, 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+// TestSSHStorageRoundTrip tests saving and loading through the server, and
+// that the save envelope carries the version.
+func TestSSHStorageRoundTrip(t *testing.T) {
+	fake := newFakeSSH(t)
+	client := fake.client(t)
+
+	if _, err := client.LoadCharacter(); !IsNotFound(err) {
+		t.Fatalf("LoadCharacter() on an empty server error = %v, want not found", err)
+	}
+	if err := client.SaveCharacter(game.NewCharacter("Hero")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+	if err := client.SaveCharacter(game.NewCharacter("Hero Again")); err != nil {
+		t.Fatalf("second SaveCharacter() error = %v", err)
+	}
+
+	envelope, err := os.ReadFile(filepath.Join(fake.remoteDir, KeyCharacter+".save"))
+	if err != nil {
+		t.Fatalf("save file missing: %v", err)
+	}
+	if !strings.HasPrefix(string(envelope), "2\n") {
+		t.Errorf("envelope starts %q, want version 2", strings.SplitN(string(envelope), "\n", 2)[0])
+	}
+
+	loaded, err := fake.client(t).LoadCharacter()
+	if err != nil || loaded.Name != "Hero Again" {
+		t.Errorf("LoadCharacter() on another machine = %v, %v; want Hero Again", loaded, err)
+	}
+}
+
+// TestSSHStorageConflict tests that a save based on an outdated copy is
+// rejected, and that pull and push --force resolve it.
+func TestSSHStorageConflict(t *testing.T) {
+	fake := newFakeSSH(t)
+	laptop, desktop := fake.client(t), fake.client(t)
+	if err := laptop.SaveCharacter(game.NewCharacter("Start")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+	if _, err := desktop.LoadCharacter(); err != nil {
+		t.Fatalf("LoadCharacter() error = %v", err)
+	}
+
+	if err := laptop.SaveCharacter(game.NewCharacter("Laptop")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+	if err := desktop.SaveCharacter(game.NewCharacter("Desktop")); !errors.Is(err, ErrConflict) {
+		t.Fatalf("stale SaveCharacter() error = %v, want ErrConflict", err)
+	}
+	if _, err := desktop.LoadCharacter(); !errors.Is(err, ErrConflict) {
+		t.Errorf("LoadCharacter() with an unresolved conflict error = %v, want ErrConflict", err)
+	}
+
+	// Keep the desktop's copy
+	if err := desktop.Push(true); err != nil {
+		t.Fatalf("Push(force) error = %v", err)
+	}
+	if loaded, err := laptop.LoadCharacter(); err != nil || loaded.Name != "Desktop" {
+		t.Errorf("after push --force, server has %v, %v; want Desktop", loaded, err)
+	}
+
+	// Keep the server's copy
+	if err := laptop.SaveCharacter(game.NewCharacter("Laptop 2")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+	if err := desktop.SaveCharacter(game.NewCharacter("Desktop 2")); !errors.Is(err, ErrConflict) {
+		t.Fatalf("stale SaveCharacter() error = %v, want ErrConflict", err)
+	}
+	if err := desktop.Pull(); err != nil {
+		t.Fatalf("Pull() error = %v", err)
+	}
+	if loaded, err := desktop.LoadCharacter(); err != nil || loaded.Name != "Laptop 2" {
+		t.Errorf("after pull, desktop has %v, %v; want Laptop 2", loaded, err)
+	}
+}
+
+// TestSSHStorageOffline tests saving and loading from the cache while the
+// server is unreachable, and pushing the change once it's back.
+func TestSSHStorageOffline(t *testing.T) {
+	fake := newFakeSSH(t)
+	client := fake.client(t)
+	if err := client.SaveCharacter(game.NewCharacter("Online")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+
+	fake.setOffline(t, true)
+	if _, err := fake.client(t).LoadCharacter(); err == nil || IsNotFound(err) {
+		t.Errorf("offline LoadCharacter() without a cache error = %v, want an error that isn't not found", err)
+	}
+	if err := client.SaveCharacter(game.NewCharacter("Offline")); err != nil {
+		t.Fatalf("offline SaveCharacter() error = %v", err)
+	}
+	if loaded, err := client.LoadCharacter(); err != nil || loaded.Name != "Offline" {
+		t.Errorf("offline LoadCharacter() = %v, %v; want the cached Offline", loaded, err)
+	}
+	if err := client.Pull(); err == nil {
+		t.Error("Pull() should fail while offline")
+	}
+	if pending, _ := client.PendingChanges(); len(pending) != 1 || pending[0] != KeyCharacter {
+		t.Errorf("PendingChanges() = %v, want [%s]", pending, KeyCharacter)
+	}
+
+	fake.setOffline(t, false)
+	if err := client.Push(false); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	if pending, _ := client.PendingChanges(); len(pending) != 0 {
+		t.Errorf("PendingChanges() after push = %v, want none", pending)
+	}
+	if loaded, err := fake.client(t).LoadCharacter(); err != nil || loaded.Name != "Offline" {
+		t.Errorf("server has %v, %v; want the pushed Offline", loaded, err)
+	}
+}
@@ -0,0 +1,65 @@
+// Package storage provides data persistence for CodeQuest through pluggable
+// backends, picked by storage.backend in the config: SkateStore (Charm's
+// Skate KV store, encrypted and cloud-synced), LocalStore (plain JSON files
+// on this machine, also the fallback when Skate is unavailable) and SSHStore
+// (your own server over SSH). Client wraps whichever one is in use.
+//
+// This file defines Storage, the game data operations every backend
+// supports, and keyStore, the raw keys each store provides underneath.
+package storage
+
+import (
+	"github.com/AutumnsGrove/codequest/internal/ai"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Storage saves and loads CodeQuest's game data. Code that only needs the
+// game data should take a Storage rather than a *Client, so it works with
+// whichever backend is configured.
+type Storage interface {
+	SaveCharacter(character *game.Character) error
+	LoadCharacter() (*game.Character, error)
+	DeleteCharacter() error
+	CharacterExists() bool
+
+	SaveQuests(quests []*game.Quest) error
+	LoadQuests() ([]*game.Quest, error)
+	DeleteQuests() error
+
+	// SaveState saves the character and quests as one batch
+	SaveState(character *game.Character, quests []*game.Quest) error
+
+	SaveRepoCheckpoints(checkpoints map[string]string) error
+	LoadRepoCheckpoints() (map[string]string, error)
+	SaveAIAnswers(answers []ai.CachedAnswer) error
+	LoadAIAnswers() ([]ai.CachedAnswer, error)
+	SaveTimeLog(entries []game.TimeEntry) error
+	LoadTimeLog() ([]game.TimeEntry, error)
+
+	// Chat history and session state are encoded by their owners
+	SaveChatHistory(thread []byte) error
+	LoadChatHistory() ([]byte, error)
+	SaveSessionState(state []byte) error
+	LoadSessionState() ([]byte, error)
+	DeleteSessionState() error
+}
+
+// keyStore is a store's raw key-value access. The game data operations are
+// written once on top of it (see gameData).
+type keyStore interface {
+	getKey(key string) (string, error)
+	setKey(key, value string) error
+	deleteKey(key string) error
+	listKeys() ([]StoredKey, error)
+
+	describe() string      // Backend name for the storage doctor
+	checks() []HealthCheck // Backend-specific doctor checks
+}
+
+// Every store, and the client switching between them, implements Storage
+var (
+	_ Storage = (*SkateStore)(nil)
+	_ Storage = (*LocalStore)(nil)
+	_ Storage = (*SSHStore)(nil)
+	_ Storage = (*Client)(nil)
+)
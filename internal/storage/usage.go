@@ -0,0 +1,50 @@
+package storage
+
+// KeyUsage is how much space one stored key takes.
+type KeyUsage struct {
+	Key   string // Storage key
+	Label string // What the key holds, for display
+	Bytes int    // Stored size (0 if not saved yet)
+}
+
+// usageKeys lists every key CodeQuest stores, in display order.
+var usageKeys = []KeyUsage{
+	{Key: KeyCharacter, Label: "Character"},
+	{Key: KeyQuests, Label: "Quests"},
+	{Key: KeyChatHistory, Label: "Mentor chat"},
+	{Key: KeyAIAnswers, Label: "Mentor answer cache"},
+	{Key: KeyRepoCheckpoints, Label: "Repository checkpoints"},
+	{Key: KeySessionState, Label: "Session timer"},
+	{Key: KeyTimeLog, Label: "Time log"},
+}
+
+// Usage reports the stored size of each key, for the Settings screen.
+// Keys that can't be read count as empty.
+//
+// Returns:
+//   - []KeyUsage: Size per key, in display order
+func (s *gameData) Usage() []KeyUsage {
+	usage := make([]KeyUsage, 0, len(usageKeys))
+	for _, entry := range usageKeys {
+		if value, err := s.keys.getKey(entry.Key); err == nil {
+			entry.Bytes = len(value)
+		}
+		usage = append(usage, entry)
+	}
+	return usage
+}
+
+// TotalBytes adds up the stored size of every key.
+//
+// Parameters:
+//   - usage: Sizes from Usage
+//
+// Returns:
+//   - int: Total bytes stored
+func TotalBytes(usage []KeyUsage) int {
+	total := 0
+	for _, entry := range usage {
+		total += entry.Bytes
+	}
+	return total
+}
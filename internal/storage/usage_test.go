@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestUsage tests reporting stored sizes per key, with unsaved keys empty.
+func TestUsage(t *testing.T) {
+	client, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	if err := client.SaveCharacter(game.NewCharacter("Tester")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+
+	usage := client.Usage()
+	if len(usage) != len(usageKeys) {
+		t.Fatalf("Usage() returned %d keys, want %d", len(usage), len(usageKeys))
+	}
+	for _, entry := range usage {
+		switch {
+		case entry.Key == KeyCharacter && entry.Bytes == 0:
+			t.Error("saved character should take space")
+		case entry.Key != KeyCharacter && entry.Bytes != 0:
+			t.Errorf("%s = %d bytes, want 0 (not saved)", entry.Key, entry.Bytes)
+		}
+	}
+	if TotalBytes(usage) != usage[0].Bytes {
+		t.Errorf("TotalBytes() = %d, want %d", TotalBytes(usage), usage[0].Bytes)
+	}
+}
@@ -0,0 +1,217 @@
+// Package termimage detects which inline image protocol the terminal speaks
+// and encodes small images for it.
+// This file encodes images for each protocol. Images are drawn one text row
+// high: kitty images sit in Unicode placeholder cells, so they move and
+// disappear with the text around them; iTerm2 and sixel images are drawn at
+// the cursor, which is then put back and moved past blank cells.
+package termimage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// kittyChunk is the largest base64 payload in one kitty graphics command.
+const kittyChunk = 4096
+
+// kittyPlaceholder is the Unicode placeholder kitty replaces with image cells.
+const kittyPlaceholder = '\U0010EEEE'
+
+// kittyDiacritics number placeholder rows and columns (from kitty's
+// rowcolumn-diacritics.txt); a cell's row and column are the diacritics at
+// those indexes.
+var kittyDiacritics = []rune{'\u0305', '\u030D', '\u030E', '\u0310', '\u0312', '\u033D', '\u033E', '\u033F'}
+
+// sixelMaxColors is how many colors a sixel image keeps; rarer colors are
+// mapped to the closest kept one.
+const sixelMaxColors = 64
+
+// Inline encodes an image to draw in the text flow, one row high.
+//
+// Parameters:
+//   - protocol: Protocol to encode for
+//   - img: The image (scaled by the terminal to fit the cells, except with sixel)
+//   - id: Image ID, unique per distinct image (kitty places images by ID)
+//   - cols: Width in terminal cells (at most 8)
+//
+// Returns:
+//   - string: Escape sequences and cells taking exactly cols cells ("" for ProtocolNone)
+func Inline(protocol Protocol, img image.Image, id uint32, cols int) string {
+	cols = max(min(cols, len(kittyDiacritics)), 1)
+	switch protocol {
+	case ProtocolKitty:
+		return kittyInline(img, id&0xFFFFFF, cols)
+	case ProtocolITerm2:
+		data := encodePNG(img)
+		return "\x1b7" + fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=1;preserveAspectRatio=1:%s\a",
+			len(data), cols, base64.StdEncoding.EncodeToString(data)) + "\x1b8" + strings.Repeat(" ", cols)
+	case ProtocolSixel:
+		return "\x1b7" + Sixel(img) + "\x1b8" + strings.Repeat(" ", cols)
+	default:
+		return ""
+	}
+}
+
+// kittyInline transmits the image for Unicode placement and writes its
+// placeholder cells, colored with the image ID.
+func kittyInline(img image.Image, id uint32, cols int) string {
+	var b strings.Builder
+	payload := base64.StdEncoding.EncodeToString(encodePNG(img))
+	for first := true; first || payload != ""; first = false {
+		chunk := payload[:min(len(payload), kittyChunk)]
+		payload = payload[len(chunk):]
+		more := 0
+		if payload != "" {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&b, "\x1b_Ga=T,U=1,f=100,q=2,i=%d,c=%d,r=1,m=%d;%s\x1b\\", id, cols, more, chunk)
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+
+	fmt.Fprintf(&b, "\x1b[38;2;%d;%d;%dm", id>>16&0xFF, id>>8&0xFF, id&0xFF)
+	for col := 0; col < cols; col++ {
+		b.WriteRune(kittyPlaceholder)
+		b.WriteRune(kittyDiacritics[0])
+		b.WriteRune(kittyDiacritics[col])
+	}
+	b.WriteString("\x1b[39m")
+	return b.String()
+}
+
+// encodePNG encodes an image as PNG (empty if it can't be encoded).
+func encodePNG(img image.Image) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Sixel encodes an image as DEC sixel graphics at its own pixel size.
+// Transparent pixels are left undrawn.
+//
+// Parameters:
+//   - img: The image
+//
+// Returns:
+//   - string: The sixel device control string
+func Sixel(img image.Image) string {
+	bounds := img.Bounds()
+	palette := sixelPalette(img)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\x1bP0;1;0q\"1;1;%d;%d", bounds.Dx(), bounds.Dy())
+	for i, c := range palette {
+		fmt.Fprintf(&b, "#%d;2;%d;%d;%d", i, percent(c.R), percent(c.G), percent(c.B))
+	}
+
+	// Each band is six pixel rows; each color in it is one pass over the band
+	for top := bounds.Min.Y; top < bounds.Max.Y; top += 6 {
+		for index := range palette {
+			var row []byte
+			used := false
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && top+dy < bounds.Max.Y; dy++ {
+					if c, ok := opaque(img.At(x, top+dy)); ok && nearest(palette, c) == index {
+						bits |= 1 << dy
+					}
+				}
+				row = append(row, 63+bits)
+				used = used || bits != 0
+			}
+			if used {
+				fmt.Fprintf(&b, "#%d%s$", index, sixelRunLength(row))
+			}
+		}
+		b.WriteByte('-')
+	}
+	b.WriteString("\x1b\\")
+	return b.String()
+}
+
+// sixelPalette picks the image's most common opaque colors.
+func sixelPalette(img image.Image) []color.RGBA {
+	counts := make(map[color.RGBA]int)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if c, ok := opaque(img.At(x, y)); ok {
+				counts[c]++
+			}
+		}
+	}
+
+	palette := make([]color.RGBA, 0, len(counts))
+	for c := range counts {
+		palette = append(palette, c)
+	}
+	// Most common first, then by value so the output is stable
+	for i := 1; i < len(palette); i++ {
+		for j := i; j > 0 && sixelBefore(palette[j], palette[j-1], counts); j-- {
+			palette[j], palette[j-1] = palette[j-1], palette[j]
+		}
+	}
+	return palette[:min(len(palette), sixelMaxColors)]
+}
+
+// sixelBefore orders palette colors: more common first, then by value.
+func sixelBefore(a, b color.RGBA, counts map[color.RGBA]int) bool {
+	if counts[a] != counts[b] {
+		return counts[a] > counts[b]
+	}
+	return uint32(a.R)<<16|uint32(a.G)<<8|uint32(a.B) < uint32(b.R)<<16|uint32(b.G)<<8|uint32(b.B)
+}
+
+// opaque returns a pixel's color, unless it's mostly transparent.
+func opaque(c color.Color) (color.RGBA, bool) {
+	r, g, b, a := c.RGBA()
+	if a < 0x8000 {
+		return color.RGBA{}, false
+	}
+	// Undo premultiplied alpha
+	return color.RGBA{R: uint8(r * 0xFF / a), G: uint8(g * 0xFF / a), B: uint8(b * 0xFF / a), A: 0xFF}, true
+}
+
+// nearest finds the palette entry closest to a color.
+func nearest(palette []color.RGBA, c color.RGBA) int {
+	best, bestDistance := 0, -1
+	for i, p := range palette {
+		dr, dg, db := int(p.R)-int(c.R), int(p.G)-int(c.G), int(p.B)-int(c.B)
+		if distance := dr*dr + dg*dg + db*db; bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	return best
+}
+
+// percent converts an 8-bit color channel to sixel's 0-100 scale.
+func percent(channel uint8) int {
+	return (int(channel)*100 + 127) / 255
+}
+
+// sixelRunLength compresses repeated sixel characters ("!n" prefix).
+func sixelRunLength(row []byte) string {
+	var b strings.Builder
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if run := j - i; run > 3 {
+			fmt.Fprintf(&b, "!%d%c", run, row[i])
+		} else {
+			b.WriteString(strings.Repeat(string(row[i]), run))
+		}
+		i = j
+	}
+	return b.String()
+}
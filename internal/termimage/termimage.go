@@ -0,0 +1,240 @@
+// Package termimage detects which inline image protocol the terminal speaks
+// (the kitty graphics protocol, iTerm2 inline images or sixel) and encodes
+// small images for it, so the UI can draw the avatar and achievement badges
+// as pictures and fall back to text everywhere else. Detection is cached per
+// terminal, so the terminal is only queried the first time it's seen.
+package termimage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Protocol is an inline image protocol.
+type Protocol string
+
+const (
+	ProtocolNone   Protocol = "none"   // No images: text fallback
+	ProtocolKitty  Protocol = "kitty"  // Kitty graphics protocol (kitty, Ghostty)
+	ProtocolITerm2 Protocol = "iterm2" // iTerm2 inline images (iTerm2, WezTerm)
+	ProtocolSixel  Protocol = "sixel"  // DEC sixel graphics (foot, mlterm, xterm -ti vt340)
+)
+
+// Image settings (config ui.images), besides forcing a protocol by name.
+const (
+	ModeAuto = "auto" // Detect the protocol (cached per terminal)
+	ModeOff  = "off"  // Never draw images
+)
+
+// queryTimeout is how long to wait for the terminal to answer the
+// device attributes query before assuming it has no sixel support.
+const queryTimeout = 200 * time.Millisecond
+
+// Detect picks a protocol from the environment alone. Terminals known to
+// speak one protocol are recognised by name; inside tmux or screen images
+// are off, since the multiplexer doesn't pass them through.
+//
+// Parameters:
+//   - getenv: Looks up environment variables (os.Getenv)
+//
+// Returns:
+//   - Protocol: The detected protocol
+//   - bool: False if the environment doesn't say (query the terminal for sixel)
+func Detect(getenv func(string) string) (Protocol, bool) {
+	termName := getenv("TERM")
+	program := getenv("TERM_PROGRAM")
+
+	switch {
+	case getenv("TMUX") != "" || strings.HasPrefix(termName, "screen") || strings.HasPrefix(termName, "tmux"):
+		return ProtocolNone, true
+	case termName == "dumb" || termName == "linux":
+		return ProtocolNone, true
+	case termName == "xterm-kitty" || getenv("KITTY_WINDOW_ID") != "" || program == "ghostty" || termName == "xterm-ghostty":
+		return ProtocolKitty, true
+	case program == "iTerm.app" || getenv("LC_TERMINAL") == "iTerm2" || program == "WezTerm":
+		return ProtocolITerm2, true
+	case termName == "foot" || strings.HasPrefix(termName, "foot-") || strings.HasPrefix(termName, "mlterm") ||
+		strings.HasSuffix(termName, "-sixel"):
+		return ProtocolSixel, true
+	}
+	return ProtocolNone, false
+}
+
+// TerminalKey identifies the terminal for the detection cache: the
+// terminal program and its version when the terminal says, plus TERM.
+//
+// Parameters:
+//   - getenv: Looks up environment variables (os.Getenv)
+//
+// Returns:
+//   - string: Cache key (e.g. "WezTerm 20240203 xterm-256color")
+func TerminalKey(getenv func(string) string) string {
+	var parts []string
+	for _, name := range []string{"TERM_PROGRAM", "TERM_PROGRAM_VERSION", "TERM"} {
+		if value := getenv(name); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Resolve decides which protocol to use. A forced protocol or "off" is used
+// as is. With "auto", a cached result for this terminal wins; otherwise the
+// environment is checked and, if it doesn't say, the terminal is asked
+// whether it draws sixels. The answer is cached (a missing or unwritable
+// cache only means detecting again next time).
+//
+// Parameters:
+//   - setting: ui.images setting ("" means auto)
+//   - cachePath: Detection cache file ("" skips the cache)
+//   - getenv: Looks up environment variables (os.Getenv)
+//   - query: Asks the terminal whether it draws sixels (nil skips asking)
+//
+// Returns:
+//   - Protocol: The protocol to draw images with
+func Resolve(setting, cachePath string, getenv func(string) string, query func() (bool, error)) Protocol {
+	switch setting {
+	case ModeOff:
+		return ProtocolNone
+	case string(ProtocolKitty), string(ProtocolITerm2), string(ProtocolSixel):
+		return Protocol(setting)
+	}
+
+	key := TerminalKey(getenv)
+	cache := loadCache(cachePath)
+	if protocol, ok := cache[key]; ok {
+		return protocol
+	}
+
+	protocol, known := Detect(getenv)
+	if !known {
+		if query == nil {
+			return ProtocolNone
+		}
+		sixel, err := query()
+		if err != nil {
+			return ProtocolNone // Not a terminal we can ask; try again next time
+		}
+		if sixel {
+			protocol = ProtocolSixel
+		}
+	}
+
+	if cachePath != "" {
+		cache[key] = protocol
+		if err := saveCache(cachePath, cache); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to cache terminal image support: %v\n", err)
+		}
+	}
+	return protocol
+}
+
+// loadCache reads the detection cache (empty if it's missing or unreadable).
+func loadCache(path string) map[string]Protocol {
+	cache := make(map[string]Protocol)
+	if path == "" {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return make(map[string]Protocol)
+	}
+	return cache
+}
+
+// saveCache writes the detection cache, creating its directory.
+func saveCache(path string, cache map[string]Protocol) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding image protocol cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing image protocol cache: %w", err)
+	}
+	return nil
+}
+
+// QuerySixel asks the controlling terminal for its primary device
+// attributes; attribute 4 in the answer means it draws sixels. Call it
+// before the UI takes over the terminal.
+//
+// Returns:
+//   - bool: Whether the terminal draws sixels
+//   - error: An error if there's no terminal to ask or it can't time out a read
+func QuerySixel() (bool, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return false, fmt.Errorf("opening terminal: %w", err)
+	}
+	defer tty.Close()
+
+	// Without a read deadline an unanswered query would block forever
+	if err := tty.SetReadDeadline(time.Now().Add(queryTimeout)); err != nil {
+		return false, fmt.Errorf("setting read deadline: %w", err)
+	}
+	// Fd would switch the file to blocking mode and lose the deadline
+	conn, err := tty.SyscallConn()
+	if err != nil {
+		return false, fmt.Errorf("getting terminal handle: %w", err)
+	}
+	var fd int
+	conn.Control(func(handle uintptr) { fd = int(handle) })
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return false, fmt.Errorf("entering raw mode: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	if _, err := tty.WriteString("\x1b[c"); err != nil {
+		return false, fmt.Errorf("writing query: %w", err)
+	}
+
+	var answer []byte
+	buf := make([]byte, 64)
+	for !strings.HasSuffix(string(answer), "c") {
+		n, err := tty.Read(buf)
+		if err != nil {
+			return false, nil // No answer in time: no sixels
+		}
+		answer = append(answer, buf[:n]...)
+	}
+	return HasSixelAttribute(string(answer)), nil
+}
+
+// HasSixelAttribute reports whether a primary device attributes answer
+// (ESC [ ? 62 ; 4 ; 22 c) lists attribute 4, sixel graphics.
+//
+// Parameters:
+//   - answer: The terminal's answer
+//
+// Returns:
+//   - bool: Whether sixel graphics are listed
+func HasSixelAttribute(answer string) bool {
+	start := strings.Index(answer, "\x1b[?")
+	end := strings.LastIndex(answer, "c")
+	if start < 0 || end < start {
+		return false
+	}
+	attributes := strings.Split(answer[start+3:end], ";")
+	for _, attribute := range attributes[1:] { // The first is the terminal class
+		if attribute == "4" {
+			return true
+		}
+	}
+	return false
+}
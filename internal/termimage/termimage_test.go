@@ -0,0 +1,158 @@
+package termimage
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// env returns a getenv function for a fixed environment.
+func env(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+// TestDetect tests recognising terminals from the environment.
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name      string
+		vars      map[string]string
+		want      Protocol
+		wantKnown bool
+	}{
+		{"kitty", map[string]string{"TERM": "xterm-kitty"}, ProtocolKitty, true},
+		{"ghostty", map[string]string{"TERM_PROGRAM": "ghostty", "TERM": "xterm-ghostty"}, ProtocolKitty, true},
+		{"iTerm2", map[string]string{"TERM_PROGRAM": "iTerm.app", "TERM": "xterm-256color"}, ProtocolITerm2, true},
+		{"iTerm2 over ssh", map[string]string{"LC_TERMINAL": "iTerm2", "TERM": "xterm-256color"}, ProtocolITerm2, true},
+		{"WezTerm", map[string]string{"TERM_PROGRAM": "WezTerm"}, ProtocolITerm2, true},
+		{"foot", map[string]string{"TERM": "foot"}, ProtocolSixel, true},
+		{"kitty inside tmux", map[string]string{"TERM": "tmux-256color", "TMUX": "/tmp/tmux-1000/default", "KITTY_WINDOW_ID": "1"}, ProtocolNone, true},
+		{"linux console", map[string]string{"TERM": "linux"}, ProtocolNone, true},
+		{"unknown xterm", map[string]string{"TERM": "xterm-256color"}, ProtocolNone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, known := Detect(env(tt.vars))
+			if got != tt.want || known != tt.wantKnown {
+				t.Errorf("Detect() = %s, %v; want %s, %v", got, known, tt.want, tt.wantKnown)
+			}
+		})
+	}
+}
+
+// TestResolve tests forced settings, querying unknown terminals and the
+// per-terminal cache.
+func TestResolve(t *testing.T) {
+	xterm := env(map[string]string{"TERM": "xterm-256color"})
+	kitty := env(map[string]string{"TERM": "xterm-kitty"})
+	sixel := func() (bool, error) { return true, nil }
+	noTTY := func() (bool, error) { return false, errors.New("no terminal") }
+
+	tests := []struct {
+		name    string
+		setting string
+		getenv  func(string) string
+		query   func() (bool, error)
+		want    Protocol
+	}{
+		{"off", ModeOff, kitty, sixel, ProtocolNone},
+		{"forced protocol", "iterm2", xterm, nil, ProtocolITerm2},
+		{"known terminal", ModeAuto, kitty, nil, ProtocolKitty},
+		{"unknown terminal with sixels", ModeAuto, xterm, sixel, ProtocolSixel},
+		{"unknown terminal without a tty", "", xterm, noTTY, ProtocolNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := filepath.Join(t.TempDir(), "cache", "terminal-images.json")
+			if got := Resolve(tt.setting, cache, tt.getenv, tt.query); got != tt.want {
+				t.Errorf("Resolve() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// A detected terminal isn't asked again
+	cache := filepath.Join(t.TempDir(), "terminal-images.json")
+	Resolve(ModeAuto, cache, xterm, sixel)
+	asked := false
+	got := Resolve(ModeAuto, cache, xterm, func() (bool, error) { asked = true; return false, nil })
+	if got != ProtocolSixel || asked {
+		t.Errorf("Resolve() with a cached terminal = %s (asked %v), want sixel without asking", got, asked)
+	}
+	// Only the same terminal gets the cached answer
+	if got := Resolve(ModeAuto, cache, env(map[string]string{"TERM": "xterm"}), nil); got != ProtocolNone {
+		t.Errorf("Resolve() for another terminal = %s, want none", got)
+	}
+}
+
+// TestHasSixelAttribute tests reading device attributes answers.
+func TestHasSixelAttribute(t *testing.T) {
+	tests := []struct {
+		answer string
+		want   bool
+	}{
+		{"\x1b[?62;4;22c", true},
+		{"\x1b[?64;1;2;6;9;15;18;21;22c", false},
+		{"\x1b[?4c", false}, // Class 4, no attributes
+		{"garbage", false},
+	}
+
+	for _, tt := range tests {
+		if got := HasSixelAttribute(tt.answer); got != tt.want {
+			t.Errorf("HasSixelAttribute(%q) = %v, want %v", tt.answer, got, tt.want)
+		}
+	}
+}
+
+// TestInline tests that every protocol's encoding takes exactly the cells
+// asked for, and carries the image.
+func TestInline(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for i := 0; i < 8; i++ {
+		img.Set(i, i, color.RGBA{R: 0xFF, A: 0xFF})
+	}
+
+	tests := []struct {
+		protocol Protocol
+		want     string
+	}{
+		{ProtocolKitty, "\x1b_Ga=T,U=1,f=100,q=2,i=42,c=2,r=1"},
+		{ProtocolITerm2, "\x1b]1337;File=inline=1;"},
+		{ProtocolSixel, "\x1bP0;1;0q\"1;1;8;8#0;2;100;0;0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.protocol), func(t *testing.T) {
+			got := Inline(tt.protocol, img, 42, 2)
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("Inline() = %q, want it to contain %q", got, tt.want)
+			}
+			if width := ansi.StringWidth(got + "|"); width != 3 {
+				t.Errorf("Inline() takes %d cells, want 2", width-1)
+			}
+		})
+	}
+
+	if got := Inline(ProtocolNone, img, 42, 2); got != "" {
+		t.Errorf("Inline(none) = %q, want empty", got)
+	}
+}
+
+// TestSixel tests the sixel encoding of a small image, including run
+// lengths and undrawn transparent pixels.
+func TestSixel(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 6, 2))
+	for x := 0; x < 5; x++ {
+		img.Set(x, 0, color.RGBA{B: 0xFF, A: 0xFF})
+	}
+
+	want := "\x1bP0;1;0q\"1;1;6;2#0;2;0;0;100#0!5@?$-\x1b\\"
+	if got := Sixel(img); got != want {
+		t.Errorf("Sixel() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,144 @@
+// Package timesheet exports the session timer's time log as CSV that Toggl
+// Track and Clockify can import, so players who report their hours can
+// reuse CodeQuest's timer instead of tracking time twice. Each stretch the
+// timer ran becomes one row: the project is the repository's project group
+// (or the repository's name) and the description is the quest in progress,
+// or "coding".
+package timesheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Format is a time tracker's CSV import layout.
+type Format string
+
+const (
+	FormatToggl    Format = "toggl"    // Toggl Track CSV import
+	FormatClockify Format = "clockify" // Clockify CSV import
+)
+
+// DefaultDescription describes stretches with no quest in progress.
+const DefaultDescription = "coding"
+
+// ParseFormat reads a format name, case-insensitively.
+//
+// Parameters:
+//   - name: "toggl" or "clockify"
+//
+// Returns:
+//   - Format: The format
+//   - error: An error if the name is unknown
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case FormatToggl, FormatClockify:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown timesheet format %q (expected toggl or clockify)", name)
+	}
+}
+
+// Row is one time entry as the trackers see it.
+type Row struct {
+	Project     string    // Project group or repository name ("" if no repo)
+	Description string    // Quest in progress, or "coding"
+	Start       time.Time // When the stretch began
+	End         time.Time // When the stretch ended
+}
+
+// Rows turns time log entries into timesheet rows, keeping entries that
+// start on or after since.
+//
+// Parameters:
+//   - entries: The time log, oldest first
+//   - since: Earliest start to include (zero for everything)
+//   - projectFor: Project group of a repository ("" if it isn't in one)
+//
+// Returns:
+//   - []Row: Rows in log order
+func Rows(entries []game.TimeEntry, since time.Time, projectFor func(repoPath string) string) []Row {
+	rows := make([]Row, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Start.Before(since) {
+			continue
+		}
+		row := Row{Description: entry.Quest, Start: entry.Start, End: entry.End}
+		if row.Description == "" {
+			row.Description = DefaultDescription
+		}
+		if entry.Repo != "" {
+			row.Project = projectFor(entry.Repo)
+			if row.Project == "" {
+				row.Project = filepath.Base(entry.Repo)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// WriteCSV writes rows in a tracker's import layout. Times are written in
+// the given location, which the trackers read as the user's local time.
+//
+// Parameters:
+//   - w: Destination
+//   - rows: Rows to write
+//   - format: Tracker layout
+//   - email: Email of the user the entries belong to (may be "" for a personal workspace)
+//   - loc: Time zone to write times in
+//
+// Returns:
+//   - error: An error if writing fails
+func WriteCSV(w io.Writer, rows []Row, format Format, email string, loc *time.Location) error {
+	out := csv.NewWriter(w)
+	switch format {
+	case FormatToggl:
+		out.Write([]string{"Email", "Start date", "Start time", "Duration", "Project", "Description"})
+		for _, row := range rows {
+			start := row.Start.In(loc)
+			out.Write([]string{
+				email,
+				start.Format(time.DateOnly),
+				start.Format(time.TimeOnly),
+				formatDuration(row.End.Sub(row.Start)),
+				row.Project,
+				row.Description,
+			})
+		}
+	case FormatClockify:
+		out.Write([]string{"Project", "Description", "Email", "Start Date", "Start Time", "End Date", "End Time", "Duration (h)"})
+		for _, row := range rows {
+			start, end := row.Start.In(loc), row.End.In(loc)
+			out.Write([]string{
+				row.Project,
+				row.Description,
+				email,
+				start.Format(time.DateOnly),
+				start.Format(time.TimeOnly),
+				end.Format(time.DateOnly),
+				end.Format(time.TimeOnly),
+				formatDuration(row.End.Sub(row.Start)),
+			})
+		}
+	default:
+		return fmt.Errorf("unknown timesheet format %q", format)
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return fmt.Errorf("writing timesheet: %w", err)
+	}
+	return nil
+}
+
+// formatDuration formats a duration as HH:MM:SS, rounded to the second.
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
@@ -0,0 +1,87 @@
+package timesheet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRows tests attributing time log entries to projects and descriptions.
+func TestRows(t *testing.T) {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	entries := []game.TimeEntry{
+		{Start: start.AddDate(0, 0, -7), End: start.AddDate(0, 0, -7).Add(time.Hour), Repo: "/src/old"},
+		{Start: start, End: start.Add(time.Hour), Repo: "/src/api", Quest: "Bug Hunt"},
+		{Start: start.Add(2 * time.Hour), End: start.Add(3 * time.Hour), Repo: "/src/blog"},
+		{Start: start.Add(4 * time.Hour), End: start.Add(5 * time.Hour)},
+	}
+	projects := map[string]string{"/src/api": "Work"}
+
+	rows := Rows(entries, start, func(repo string) string { return projects[repo] })
+
+	want := []Row{
+		{Project: "Work", Description: "Bug Hunt", Start: entries[1].Start, End: entries[1].End},
+		{Project: "blog", Description: DefaultDescription, Start: entries[2].Start, End: entries[2].End},
+		{Project: "", Description: DefaultDescription, Start: entries[3].Start, End: entries[3].End},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("Rows() = %+v, want %+v", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+}
+
+// TestWriteCSV tests each tracker's import layout.
+func TestWriteCSV(t *testing.T) {
+	start := time.Date(2025, 3, 3, 23, 30, 0, 0, time.UTC)
+	rows := []Row{{Project: "Work", Description: "Fix \"login\", again", Start: start, End: start.Add(90*time.Minute + 4*time.Second)}}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatToggl, "Email,Start date,Start time,Duration,Project,Description\n" +
+			"me@example.com,2025-03-03,23:30:00,01:30:04,Work,\"Fix \"\"login\"\", again\"\n"},
+		{FormatClockify, "Project,Description,Email,Start Date,Start Time,End Date,End Time,Duration (h)\n" +
+			"Work,\"Fix \"\"login\"\", again\",me@example.com,2025-03-03,23:30:00,2025-03-04,01:00:04,01:30:04\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteCSV(&buf, rows, tt.format, "me@example.com", time.UTC); err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("WriteCSV() =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+// TestParseFormat tests reading format names.
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Format
+		wantErr bool
+	}{
+		{"toggl", FormatToggl, false},
+		{" Clockify ", FormatClockify, false},
+		{"harvest", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFormat(tt.name)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseFormat(%q) = %q, %v; want %q (error %v)", tt.name, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the F-key action bar: each screen declares its
+// function-key actions in one registry, and the bar at the bottom of the
+// screen renders them and dispatches their keys the same way everywhere.
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Action is a function-key action offered on a screen.
+type Action struct {
+	Binding key.Binding                        // Function key, with its label as the help text
+	Enabled func(m Model) bool                 // Whether it can run right now (nil = always)
+	Run     func(m Model) (tea.Model, tea.Cmd) // What it does
+}
+
+// Available reports whether the action can run in the model's current state.
+func (a Action) Available(m Model) bool {
+	return a.Enabled == nil || a.Enabled(m)
+}
+
+// Actions shared by the registry entries below.
+var (
+	actionHelp = Action{
+		Binding: key.NewBinding(key.WithKeys("f1"), key.WithHelp("F1", "Help")),
+		Run: func(m Model) (tea.Model, tea.Cmd) {
+			m.showingHelp = true
+			return m, m.startTransition(TransitionFade, 0)
+		},
+	}
+
+	actionRefresh = Action{
+		Binding: key.NewBinding(key.WithKeys("f5"), key.WithHelp("F5", "Refresh")),
+		Enabled: func(m Model) bool { return m.storage != nil },
+		Run:     Model.refresh,
+	}
+)
+
+// screenActions registers each screen's action bar, in display order.
+var screenActions = map[Screen][]Action{
+	ScreenDashboard: {actionHelp, actionRefresh},
+	ScreenQuestBoard: {
+		actionHelp,
+		{
+			Binding: key.NewBinding(key.WithKeys("f2"), key.WithHelp("F2", "Start quest")),
+			Enabled: func(m Model) bool {
+				quest := m.questDetail
+				if quest == nil && m.boardListShown() {
+					quest = m.selectedBoardQuest()
+				}
+				return quest != nil && quest.Status == game.QuestAvailable
+			},
+			Run: Model.startQuest,
+		},
+		{
+			Binding: key.NewBinding(key.WithKeys("f3"), key.WithHelp("F3", "Filter")),
+			Enabled: Model.boardListShown,
+			Run:     Model.cycleQuestFilter,
+		},
+		{
+			Binding: key.NewBinding(key.WithKeys("f4"), key.WithHelp("F4", "New quest")),
+			Enabled: Model.boardListShown,
+			Run:     Model.openQuestForm,
+		},
+		actionRefresh,
+	},
+	ScreenCharacter: {
+		actionHelp,
+		{
+			Binding: key.NewBinding(key.WithKeys("f3"), key.WithHelp("F3", "Project")),
+			Enabled: func(m Model) bool { return m.config != nil && len(m.config.Projects) > 0 },
+			Run:     Model.cycleProjectFilter,
+		},
+		actionRefresh,
+	},
+	ScreenMentor:   {actionHelp},
+	ScreenSettings: {actionHelp},
+	ScreenSeason: {
+		actionHelp,
+		{
+			Binding: key.NewBinding(key.WithKeys("f2"), key.WithHelp("F2", "Claim all")),
+			Enabled: func(m Model) bool { return m.seasonPass(time.Now()).Claimable() > 0 },
+			Run:     Model.claimAllSeasonTiers,
+		},
+	},
+	ScreenData: {actionHelp},
+}
+
+// boardListShown reports whether the quest board shows its quest list (not
+// the detail view, calendar, TODO picker or New Quest form).
+func (m Model) boardListShown() bool {
+	return m.questDetail == nil && !m.showingCalendar && m.todoPicker == nil && m.questForm == nil
+}
+
+// handleActionKey runs the current screen's action for a function key.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The action's command
+//   - bool: False if no action on this screen uses the key
+func (m Model) handleActionKey(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	for _, action := range screenActions[m.currentScreen] {
+		if !key.Matches(msg, action.Binding) {
+			continue
+		}
+		if !action.Available(m) {
+			return m, nil, true
+		}
+		model, cmd := action.Run(m)
+		return model, cmd, true
+	}
+	return m, nil, false
+}
+
+// refresh reloads the character and quests from storage, picking up changes
+// made outside the UI (the CLI, another machine syncing).
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Load commands and a notification
+func (m Model) refresh() (tea.Model, tea.Cmd) {
+	model, notify := m.notifyAction("⟳ Refreshed", NotificationInfo)
+	return model, tea.Batch(loadCharacterCmd(m.storage), loadQuestsCmd(m.storage), notify)
+}
+
+// viewActionBar renders the current screen's actions as a bar of function
+// keys; actions that can't run right now are dimmed.
+//
+// Returns:
+//   - string: The action bar ("" if the screen has no actions or the terminal is too narrow)
+func (m Model) viewActionBar() string {
+	actions := screenActions[m.currentScreen]
+	if len(actions) == 0 || m.width < 40 {
+		return ""
+	}
+
+	items := make([]string, 0, len(actions))
+	for _, action := range actions {
+		help := action.Binding.Help()
+		if !action.Available(m) {
+			items = append(items, MutedTextStyle.Render(help.Key+" "+help.Desc))
+			continue
+		}
+		items = append(items, KeybindStyle.Render(help.Key)+" "+KeybindDescStyle.Render(help.Desc))
+	}
+	return lipgloss.NewStyle().Width(m.width).Render(strings.Join(items, "  "))
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestActionBarKeys tests that F-keys run the current screen's actions, and
+// that unavailable actions do nothing.
+func TestActionBarKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		screen     Screen
+		selected   int
+		key        tea.KeyType
+		wantStatus game.QuestStatus // Status of quest A afterwards
+		wantFilter screens.QuestFilter
+		wantHelp   bool
+	}{
+		{"F1 opens help", ScreenSettings, 0, tea.KeyF1, game.QuestAvailable, 0, true},
+		{"F2 starts the selected quest", ScreenQuestBoard, 0, tea.KeyF2, game.QuestActive, 0, false},
+		{"F2 ignores active quests", ScreenQuestBoard, 2, tea.KeyF2, game.QuestAvailable, 0, false},
+		{"F2 only on the quest board", ScreenDashboard, 0, tea.KeyF2, game.QuestAvailable, 0, false},
+		{"F3 cycles the quest filter", ScreenQuestBoard, 0, tea.KeyF3, game.QuestAvailable, 1, false},
+		{"F5 without storage", ScreenQuestBoard, 0, tea.KeyF5, game.QuestAvailable, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{keys: NewKeyMap(), character: veteranCharacter(), currentScreen: tt.screen}
+			m.quests = []*game.Quest{
+				{ID: "a", Title: "A", Status: game.QuestAvailable},
+				{ID: "b", Title: "B", Status: game.QuestAvailable},
+				{ID: "c", Title: "C", Status: game.QuestActive},
+			}
+			m.questBoardSelectedIndex = tt.selected
+
+			m = pressKey(t, m, tea.KeyMsg{Type: tt.key})
+			if m.quests[0].Status != tt.wantStatus {
+				t.Errorf("quest A status = %v, want %v", m.quests[0].Status, tt.wantStatus)
+			}
+			if m.questBoardFilter != tt.wantFilter {
+				t.Errorf("filter = %d, want %d", m.questBoardFilter, tt.wantFilter)
+			}
+			if m.showingHelp != tt.wantHelp {
+				t.Errorf("showingHelp = %v, want %v", m.showingHelp, tt.wantHelp)
+			}
+		})
+	}
+}
+
+// TestViewActionBar tests that every screen offers help and that the quest
+// board lists its actions in order.
+func TestViewActionBar(t *testing.T) {
+	m := Model{keys: NewKeyMap(), character: veteranCharacter(), width: 100}
+	for _, screen := range []Screen{ScreenDashboard, ScreenQuestBoard, ScreenCharacter, ScreenMentor, ScreenSettings} {
+		m.currentScreen = screen
+		if bar := ansi.Strip(m.viewActionBar()); !strings.HasPrefix(bar, "F1 Help") {
+			t.Errorf("screen %d action bar = %q, want it to start with F1 Help", screen, bar)
+		}
+	}
+
+	m.currentScreen = ScreenQuestBoard
+	bar := ansi.Strip(m.viewActionBar())
+	if !strings.Contains(bar, "F2 Start quest  F3 Filter  F4 New quest  F5 Refresh") {
+		t.Errorf("quest board action bar = %q, want F2 to F5", bar)
+	}
+
+	m.width = 30
+	if bar := m.viewActionBar(); bar != "" {
+		t.Errorf("narrow action bar = %q, want none", bar)
+	}
+}
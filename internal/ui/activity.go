@@ -0,0 +1,113 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the app's async feedback: the loading screen, the
+// saving indicator and import progress for commits replayed on startup all
+// go through one shared screens.Activity, which the mentor's thinking
+// spinner uses too.
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
+)
+
+// replayProgressBuffer is how many replay progress reports can wait for
+// the UI before intermediate ones are dropped.
+const replayProgressBuffer = 64
+
+// saveStartedMsg is sent when a save begins (saveCompletedMsg or an
+// errorMsg ends it).
+type saveStartedMsg struct{}
+
+// replayProgressMsg carries the watcher's progress replaying one
+// repository's missed commits.
+type replayProgressMsg watcher.ReplayProgress
+
+// ReportReplayProgress passes the watcher's replay progress to the UI; pass
+// it to watcher.WatcherManager.SetReplayProgress. Intermediate reports are
+// dropped when the UI falls behind, but a replay's final report always
+// arrives, so the import indicator never gets stuck.
+//
+// Parameters:
+//   - progress: How far one repository's replay has got
+func (m *Model) ReportReplayProgress(progress watcher.ReplayProgress) {
+	if progress.Done >= progress.Total {
+		m.replayProgress <- progress
+		return
+	}
+	select {
+	case m.replayProgress <- progress:
+	default:
+	}
+}
+
+// startLoading shows the loading screen until the character loads.
+func (m *Model) startLoading() tea.Cmd {
+	m.loading = true
+	return m.activity.Start(screens.ActivityLoading, "Loading CodeQuest...")
+}
+
+// stopLoading leaves the loading screen.
+func (m *Model) stopLoading() {
+	m.loading = false
+	m.activity.Stop(screens.ActivityLoading)
+}
+
+// listenForReplayProgress waits for the next replay progress report.
+func listenForReplayProgress(reports <-chan watcher.ReplayProgress) tea.Cmd {
+	if reports == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return replayProgressMsg(<-reports)
+	}
+}
+
+// handleReplayProgress updates the import indicator. Progress from every
+// repository replaying at once is summed into one bar, which goes away once
+// they have all finished.
+func (m Model) handleReplayProgress(msg replayProgressMsg) (tea.Model, tea.Cmd) {
+	imports := make(map[string]watcher.ReplayProgress, len(m.imports)+1)
+	for repo, progress := range m.imports {
+		imports[repo] = progress
+	}
+	imports[msg.Repo] = watcher.ReplayProgress(msg)
+
+	done, total, finished := 0, 0, true
+	for _, progress := range imports {
+		done += progress.Done
+		total += progress.Total
+		finished = finished && progress.Done >= progress.Total
+	}
+
+	listen := listenForReplayProgress(m.replayProgress)
+	if finished {
+		m.imports = nil
+		m.activity.Stop(screens.ActivityImporting)
+		return m, listen
+	}
+	m.imports = imports
+	return m, tea.Batch(listen, m.activity.SetProgress(screens.ActivityImporting, "Importing missed commits", done, total))
+}
+
+// viewActivity renders the running operations for the footer: a status
+// line (saving, the mentor thinking away from its screen) and the import
+// progress bar.
+func (m Model) viewActivity() string {
+	except := []screens.ActivityKind{screens.ActivityLoading, screens.ActivityImporting}
+	if m.currentScreen == ScreenMentor {
+		except = append(except, screens.ActivityThinking, screens.ActivityRunning) // Shown by the mentor screen
+	}
+
+	var lines []string
+	if status := m.activity.ViewStatus(except...); status != "" {
+		lines = append(lines, status)
+	}
+	if imports := m.activity.View(screens.ActivityImporting); imports != "" {
+		lines = append(lines, imports)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
+)
+
+// TestReplayProgress tests that replays in several repositories share one
+// import bar, which goes away once every replay has finished.
+func TestReplayProgress(t *testing.T) {
+	m := Model{activity: screens.NewActivity(), replayProgress: make(chan watcher.ReplayProgress, replayProgressBuffer), width: 80}
+
+	steps := []struct {
+		progress watcher.ReplayProgress
+		want     string // Expected footer activity ("" = nothing)
+	}{
+		{watcher.ReplayProgress{Repo: "/a", Done: 0, Total: 4}, "0/4"},
+		{watcher.ReplayProgress{Repo: "/b", Done: 1, Total: 2}, "1/6"},
+		{watcher.ReplayProgress{Repo: "/a", Done: 4, Total: 4}, "5/6"},
+		{watcher.ReplayProgress{Repo: "/b", Done: 2, Total: 2}, ""},
+	}
+	for _, step := range steps {
+		updated, _ := m.Update(replayProgressMsg(step.progress))
+		m = updated.(Model)
+		got := m.viewActivity()
+		if step.want == "" && got != "" || !strings.Contains(got, step.want) {
+			t.Errorf("after %+v: footer activity = %q, want %q", step.progress, got, step.want)
+		}
+	}
+	if m.imports != nil {
+		t.Errorf("imports = %v after every replay finished, want none", m.imports)
+	}
+}
+
+// TestSavingIndicator tests the footer's saving indicator during a save.
+func TestSavingIndicator(t *testing.T) {
+	m := Model{activity: screens.NewActivity(), width: 80}
+
+	updated, _ := m.Update(saveStartedMsg{})
+	m = updated.(Model)
+	if !strings.Contains(m.viewActivity(), "Saving...") {
+		t.Errorf("footer activity while saving = %q, want Saving...", m.viewActivity())
+	}
+
+	updated, _ = m.Update(saveCompletedMsg{})
+	m = updated.(Model)
+	if got := m.viewActivity(); got != "" {
+		t.Errorf("footer activity after saving = %q, want nothing", got)
+	}
+}
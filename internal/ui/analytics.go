@@ -0,0 +1,51 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file wires the optional analytics database into the character
+// sheet: each time the sheet opens, recent totals are queried in the
+// background and shown in its Analytics section.
+package ui
+
+import (
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// analyticsDays is the window the character sheet's Analytics section covers.
+const analyticsDays = 30
+
+// analyticsMsg carries totals queried from the analytics database.
+type analyticsMsg struct {
+	summary *screens.AnalyticsSummary
+}
+
+// SetAnalytics shows totals from the analytics database on the character
+// sheet. Call before the program starts.
+//
+// Parameters:
+//   - analytics: The open analytics database
+func (m *Model) SetAnalytics(analytics *eventlog.Analytics) {
+	m.analytics = analytics
+}
+
+// loadAnalyticsCmd queries the last analyticsDays days of totals in the
+// background. A failed query is logged and leaves the section as it was.
+func loadAnalyticsCmd(analytics *eventlog.Analytics, now time.Time) tea.Cmd {
+	return func() tea.Msg {
+		since := now.AddDate(0, 0, -analyticsDays)
+		events, err := analytics.EventCounts(since)
+		if err != nil {
+			log.Printf("ERROR: Failed to load analytics: %v", err)
+			return nil
+		}
+		days, err := analytics.XPByDay(since)
+		if err != nil {
+			log.Printf("ERROR: Failed to load analytics: %v", err)
+			return nil
+		}
+		return analyticsMsg{summary: &screens.AnalyticsSummary{Days: analyticsDays, Events: events, XPByDay: days}}
+	}
+}
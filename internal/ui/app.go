@@ -5,15 +5,22 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/AutumnsGrove/codequest/internal/ai"
 	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/power"
 	"github.com/AutumnsGrove/codequest/internal/storage"
 	"github.com/AutumnsGrove/codequest/internal/ui/screens"
 	"github.com/AutumnsGrove/codequest/internal/watcher"
@@ -38,6 +45,12 @@ const (
 
 	// ScreenSettings allows configuration of app settings and preferences
 	ScreenSettings
+
+	// ScreenSeason shows the season pass reward track and claims its tiers
+	ScreenSeason
+
+	// ScreenData inspects and deletes raw storage keys (debug mode only)
+	ScreenData
 )
 
 // Model is the main Bubble Tea model for the CodeQuest application.
@@ -50,12 +63,13 @@ const (
 //   - Status: loading, error handling
 type Model struct {
 	// Game State - Core game data
-	character *game.Character // Player character (nil if not loaded)
-	quests    []*game.Quest   // All quests (active, completed, available)
-	eventBus  *game.EventBus  // Event system for game events
+	character  *game.Character // Player character (nil if not loaded)
+	quests     []*game.Quest   // All quests (active, completed, available)
+	eventBus   *game.EventBus  // Event system for game events
+	gameEvents chan game.Event // Events from eventBus waiting for Update
 
 	// Storage - Data persistence
-	storage *storage.SkateClient // Skate KV store client
+	storage *storage.Client // Skate KV store client
 
 	// AI Integration
 	aiManager    *ai.AIManager         // AI provider manager
@@ -67,27 +81,157 @@ type Model struct {
 	keys          *KeyMap // Key bindings for navigation
 
 	// Quest Board state
-	questBoardSelectedIndex int                 // Currently selected quest index
-	questBoardFilter        screens.QuestFilter // Current quest filter
+	questBoardSelectedIndex int                     // Currently selected quest index
+	questBoardFilter        screens.QuestFilter     // Current quest filter
+	questCards              *screens.QuestCardCache // Rendered quest cards, reused until they change
+	showingCalendar         bool                    // Calendar view instead of the list
+	calendarDay             int                     // Selected calendar day (index from the first cell)
+	calendarEvent           int                     // Selected quest on that day
+
+	// Quest Detail state (opened from the Quest Board)
+	questDetail     *game.Quest     // Quest shown in the detail view (nil when closed)
+	notesEditor     textarea.Model  // Inline markdown editor for quest notes
+	editingNotes    bool            // Whether the notes editor has focus
+	reminderInput   textinput.Model // Input for the quest's reminder ("4pm <50%")
+	editingReminder bool            // Whether the reminder input has focus
 
 	// Terminal dimensions - Updated on window resize
 	width  int // Terminal width in characters
 	height int // Terminal height in characters
 
 	// Status - Application state
-	loading bool  // True during async operations (load, save)
-	err     error // Most recent error (if any)
+	loading bool  // True while loading game data (shows the loading screen)
+	err     error // Most recent error (if any) - shows the recovery screen
+
+	// Async feedback - Spinner and progress bars for loading, saving, the mentor and imports
+	activity       *screens.Activity                 // Shared by every screen showing async feedback
+	replayProgress chan watcher.ReplayProgress       // Replay progress from the watcher (see ReportReplayProgress)
+	imports        map[string]watcher.ReplayProgress // Replay progress per repository while importing
+
+	// Error recovery state
+	errRetry         tea.Cmd               // Re-runs the failed operation (nil if not retryable)
+	retrying         bool                  // A retry is in flight
+	retryScheduled   bool                  // An automatic retry is waiting on its backoff delay
+	recoveryAttempts int                   // Automatic retries made for the current error
+	showingDoctor    bool                  // Whether the storage doctor panel is open
+	doctorReport     []storage.HealthCheck // Latest diagnostics (nil while running)
 
 	// Session Tracking - Timer integration
 	sessionTracker *watcher.SessionTracker // Session time tracker
+	stretch        timeStretch             // Timer's current stretch, for the time log
+
+	// Watcher telemetry for the settings debug section (nil when unavailable)
+	watcherMetrics func() []watcher.WatcherMetrics
+
+	// Low-power mode state (nil = never low power)
+	power *power.Monitor
+
+	// Stored size per key for the settings storage section (nil until measured)
+	storageUsage []storage.KeyUsage
+
+	// Analytics database and its totals for the character sheet (nil when off)
+	analytics        *eventlog.Analytics
+	analyticsSummary *screens.AnalyticsSummary
 
 	// Help overlay state
 	showingHelp bool // Whether the help overlay is currently displayed
 
+	// Guided tutorial state
+	showingTutorial bool // Whether the tutorial callouts are displayed
+	tutorialStep    int  // Index of the current tutorial step
+
+	// Reward choice modal for completed quests (nil when closed)
+	rewardChoice *rewardChoice
+
+	// Review modal for XP held by anomaly detection (nil when closed)
+	flaggedReview *flaggedReview
+
+	// Quest suggestions from TODO/FIXME comments in watched repositories
+	todoSuggestions []game.TodoComment // Comments without a quest yet
+	todoPicker      *todoPicker        // Open suggestion picker (nil when closed)
+	questForm       *questForm         // Open New Quest form (nil when closed)
+	welcomeBack     *game.WelcomeBack  // Open welcome-back screen (nil when closed)
+
+	// Full-screen level-up celebration (nil when closed)
+	fanfare    *levelUpFanfare
+	fanfareSeq int // Sequence ID so stale confetti frames are ignored
+
+	// Break reminders during long timed sessions
+	breakReminder *breakReminder // Open reminder banner (nil when none)
+	nextBreakAt   time.Duration  // Session time the next reminder is due (0 = not scheduled yet)
+
+	// Session time when this session's longest-session record was celebrated (0 = not yet)
+	sessionRecordAt time.Duration
+
+	// Focus mode: gamification held back during deep work
+	focus         *focusSession // Running focus session (nil when off)
+	focusSeq      int           // Sequence ID so a stale end timer is ignored
+	choosingFocus bool          // Whether the focus duration picker is open
+	search        *globalSearch // Open global search popover (nil when closed)
+
+	// Transition animation state (screen switches and modals)
+	transition Transition // Current transition (inactive when Kind is TransitionNone)
+
+	// Live quest progress animation on the dashboard's active quest card
+	questProgress    *screens.QuestProgressTick // Animated bar state (nil when idle)
+	questProgressSeq int                        // Sequence ID so stale frames are ignored
+
+	// Active quest selected in the dashboard's Active Quests widget
+	dashboardQuest int
+
+	// Project filter shared by the quest board and character screen ("" = all projects)
+	projectFilter string
+
+	// Respec armed on the character sheet (confirmed by pressing R again)
+	respecConfirm bool
+
+	// Stat details popover open on the character sheet
+	statDetails bool
+
+	// Section focused (and maybe expanded) on the character sheet
+	characterFocus screens.CharacterFocus
+
+	// Discord test message on its way (from the Settings screen)
+	discordSending bool
+
+	// Quest to open once quests load (from a --open quest/<id> deep link)
+	pendingQuestID string
+
+	// Row selected on the Settings screen (dashboard widgets, then profile fields)
+	settingsSelected int
+
+	// Profile editing on the Settings screen
+	editingProfile game.ProfileField // Field being edited ("" when not editing)
+	profileInput   textinput.Model   // Input for the edited field
+
+	// XP rules dry-run tester on the Settings screen
+	testingXPRules bool            // Sample input open
+	xpRuleInput    textinput.Model // Sample commit input
+	xpRuleDryRun   *xpRuleDryRun   // Last dry run (nil before one)
+
+	// Tier selected on the season pass track (index into game.SeasonTiers)
+	seasonSelected int
+
+	// Raw storage inspector (Data screen)
+	dataKeys     []storage.StoredKey // Keys in the backend (nil while listing)
+	dataSelected int                 // Selected key
+	dataOpenKey  string              // Key whose value is shown ("" for the key list)
+	dataValue    string              // The open key's value, pretty-printed
+	dataScroll   int                 // First value line shown
+	dataConfirm  bool                // Deleting the selected key awaits a second X
+	dataErr      string              // Why the last storage call failed
+
+	// Undo/redo stacks for reversible UI actions (Ctrl+Z / Ctrl+Y)
+	history undoHistory
+
 	// Notification system - Real-time event notifications
 	notifications       []Notification // Queue of pending notifications
 	currentNotification *Notification  // Currently displayed notification (nil if none)
 
+	// Recent activity - Used for quick actions like copying a commit SHA
+	lastCommitSHA string                 // SHA of the most recently detected commit
+	activityFeed  []screens.ActivityItem // Recent events for the dashboard feed, oldest first
+
 	// Application metadata
 	version string // Application version (e.g., "v0.1.0-beta")
 }
@@ -105,14 +249,30 @@ type Model struct {
 //
 // The model starts on the Dashboard screen with loading state active.
 // Init() will attempt to load saved data from storage.
-func NewModel(storageClient *storage.SkateClient, cfg *config.Config, version string) *Model {
+func NewModel(storageClient *storage.Client, cfg *config.Config, version string) *Model {
 	// Initialize AI Manager with providers
 	aiManager := initializeAIManager(cfg)
 
+	// Cached mentor answers survive restarts (a failed load starts an empty FAQ)
+	if storageClient != nil {
+		_ = aiManager.SetAnswerStore(storageClient)
+	}
+
+	// One activity indicator animates all async feedback
+	activity := screens.NewActivity()
+
 	// Create mentor screen (will be initialized properly in Init)
 	var mentorScreen *screens.MentorScreen
 	if aiManager != nil {
 		mentorScreen = screens.NewMentorScreen(aiManager, 80, 24)
+		mentorScreen.SetActivity(activity)
+		if cfg != nil {
+			mentorScreen.SetMentorConfig(cfg.AI.Mentor)
+			mentorScreen.SetHistoryLimit(cfg.Retention.WithDefaults().ChatHistoryMessages)
+		}
+		if storageClient != nil {
+			mentorScreen.SetChatStore(storageClient)
+		}
 	}
 
 	// Create a temporary character for SessionTracker initialization
@@ -120,13 +280,19 @@ func NewModel(storageClient *storage.SkateClient, cfg *config.Config, version st
 	tempChar := game.NewCharacter("Loading...")
 
 	// Initialize SessionTracker (will be updated with real character in Init)
-	sessionTracker := watcher.NewSessionTracker(tempChar, storageClient)
+	sessionTracker := watcher.NewSessionTracker(tempChar, sessionStorage(storageClient))
+
+	// Game events queue up until Update takes them one at a time
+	eventBus := game.NewEventBus()
+	gameEvents := make(chan game.Event, gameEventBuffer)
+	subscribeGameEvents(eventBus, gameEvents)
 
 	return &Model{
 		// Game State - Will be loaded in Init()
-		character: nil,
-		quests:    []*game.Quest{},
-		eventBus:  game.NewEventBus(),
+		character:  nil,
+		quests:     []*game.Quest{},
+		eventBus:   eventBus,
+		gameEvents: gameEvents,
 
 		// Storage
 		storage: storageClient,
@@ -143,6 +309,10 @@ func NewModel(storageClient *storage.SkateClient, cfg *config.Config, version st
 		// Quest Board state
 		questBoardSelectedIndex: 0,
 		questBoardFilter:        screens.FilterAll,
+		questCards:              screens.NewQuestCardCache(),
+
+		// Quest Detail state
+		notesEditor: newNotesEditor(),
 
 		// Dimensions - Will be set on first WindowSizeMsg
 		width:  80, // Default width
@@ -152,6 +322,10 @@ func NewModel(storageClient *storage.SkateClient, cfg *config.Config, version st
 		loading: true, // Start in loading state
 		err:     nil,
 
+		// Async feedback
+		activity:       activity,
+		replayProgress: make(chan watcher.ReplayProgress, replayProgressBuffer),
+
 		// Session Tracking
 		sessionTracker: sessionTracker,
 
@@ -192,16 +366,10 @@ func initializeAIManager(cfg *config.Config) *ai.AIManager {
 	return manager
 }
 
-// timerTickMsg is sent every second to update the timer display.
+// timerTickMsg is sent every second (less often in low-power mode) to
+// update the timer display.
 type timerTickMsg time.Time
 
-// timerTick returns a command that sends a timerTickMsg after 1 second.
-func timerTick() tea.Cmd {
-	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
-		return timerTickMsg(t)
-	})
-}
-
 // Init is the Bubble Tea initialization method.
 // It returns commands to load character and quests from storage,
 // and subscribes to game events for real-time UI updates.
@@ -212,11 +380,14 @@ func timerTick() tea.Cmd {
 //   - tea.Cmd: Commands to load data from storage and listen for events
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
+		m.startLoading(),
+		listenForReplayProgress(m.replayProgress), // Import progress for commits replayed on startup
 		loadCharacterCmd(m.storage),
 		loadQuestsCmd(m.storage),
-		screens.LoadChatHistory(),       // Load chat history for mentor screen
-		listenForGameEvents(m.eventBus), // Subscribe to game events
-		timerTick(),                     // Start timer ticks
+		m.loadChatHistoryCmd(),         // Load chat history for mentor screen
+		waitForNextEvent(m.gameEvents), // Subscribe to game events
+		m.timerTick(),                  // Start timer ticks
+		reminderTick(),                 // Start checking quest reminders
 	)
 }
 
@@ -257,28 +428,105 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Character loaded from storage
 	case characterLoadedMsg:
 		m.character = msg.character
-		m.loading = false
+		m.stopLoading()
+		if m.retrying {
+			m.clearError()
+		}
 		// Update SessionTracker with real character
 		if m.sessionTracker != nil && m.character != nil {
-			m.sessionTracker = watcher.NewSessionTracker(m.character, m.storage)
+			m.sessionTracker = watcher.NewSessionTracker(m.character, sessionStorage(m.storage))
+			// A timer restored from the last run logs from now on
+			if m.sessionTracker.GetState() == watcher.SessionRunning && m.stretch.start.IsZero() {
+				m.startTimeStretch()
+			}
 		}
-		return m, nil
+		// Brand new players get the guided tour once
+		if msg.firstRun && m.character != nil && !m.character.TutorialCompleted {
+			return m.startTutorial()
+		}
+		m = m.openPendingReward()
+		m = m.openFlaggedReview()
+		var summaryCmd, welcomeCmd tea.Cmd
+		m, summaryCmd = m.showWeeklySummary()
+		m, welcomeCmd = m.openWelcomeBack()
+		return m, tea.Batch(summaryCmd, welcomeCmd, m.showNextNotification())
 
 	// Quests loaded from storage
+	case storageUsageMsg:
+		m.storageUsage = msg.usage
+		return m, nil
+
+	case analyticsMsg:
+		m.analyticsSummary = msg.summary
+		return m, nil
+
 	case questsLoadedMsg:
 		m.quests = msg.quests
-		return m, nil
+		// A deep-linked quest opens in the detail view
+		m = m.openPendingQuest()
+		// Completed quests waiting on a reward choice open the picker
+		m = m.openPendingReward()
+		var summaryCmd, welcomeCmd tea.Cmd
+		m, summaryCmd = m.showWeeklySummary()
+		m, welcomeCmd = m.openWelcomeBack()
+		return m, tea.Batch(summaryCmd, welcomeCmd, m.showNextNotification())
 
 	// Error occurred
 	case errorMsg:
-		m.err = msg.err
-		m.loading = false
+		return m.handleError(msg)
+
+	case timeLogSavedMsg:
+		return m.handleTimeLogSaved(msg)
+
+	// Save started - Show the saving indicator
+	case saveStartedMsg:
+		return m, m.activity.Start(screens.ActivitySaving, "Saving...")
+
+	// Save succeeded - Leave the recovery screen if this was a retry
+	case saveCompletedMsg:
+		m.activity.Stop(screens.ActivitySaving)
+		if m.retrying {
+			m.clearError()
+		}
 		return m, nil
 
+	// Automatic retry due (ignore ticks from an earlier error)
+	case autoRetryMsg:
+		if m.err == nil || msg.attempt != m.recoveryAttempts {
+			return m, nil
+		}
+		return m.retryFailedOperation()
+
+	// Async feedback animation frames
+	case spinner.TickMsg, progress.FrameMsg:
+		return m, m.activity.Update(msg)
+
+	// Watcher replaying missed commits
+	case replayProgressMsg:
+		return m.handleReplayProgress(msg)
+
+	// Storage doctor finished
+	// Data screen storage call finished
+	case dataKeysMsg, dataValueMsg, dataDeletedMsg:
+		return m.handleDataMsg(msg)
+
+	// Storage doctor finished
+	case doctorReportMsg:
+		m.doctorReport = msg.checks
+		return m, nil
+
+	// Quest reminder check - Nudge about due reminders
+	case reminderTickMsg:
+		return m.checkReminders(time.Time(msg))
+
 	// Timer tick - Request next tick if timer is running
 	case timerTickMsg:
 		if m.sessionTracker != nil && m.sessionTracker.GetState() == watcher.SessionRunning {
-			return m, timerTick()
+			elapsed := m.sessionTracker.GetElapsed()
+			m = m.checkBreakReminder(elapsed)
+			var recordCmd tea.Cmd
+			m, recordCmd = m.checkSessionRecord(elapsed)
+			return m, tea.Batch(m.timerTick(), recordCmd)
 		}
 		return m, nil
 
@@ -288,48 +536,71 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Commit detected - Show XP gain notification
 	case commitDetectedMsg:
-		// Add XP gain notification
-		notification := Notification{
-			Message:   fmt.Sprintf("+%d XP from commit!", msg.xpAwarded),
-			Type:      NotificationSuccess,
-			Duration:  3 * time.Second,
-			Timestamp: time.Now(),
+		m.lastCommitSHA = msg.sha
+		m.noteStretchCommit(msg.repoPath)
+		if msg.held {
+			m.recordActivity("📝", "XP held for review: "+firstLine(msg.message))
+		} else {
+			m.recordActivity("📝", fmt.Sprintf("+%d XP: %s", msg.xpAwarded, firstLine(msg.message)))
+		}
+
+		// Add XP gain notification (focus mode just counts it for the summary,
+		// commits past the daily celebration cap earn XP silently, and held
+		// XP has its own toast)
+		if m.focus != nil {
+			m.focus.noteCommit(msg.xpAwarded)
+		} else if !msg.held && m.celebrateCommit(time.Now()) {
+			m.addNotification(Notification{
+				Message:     fmt.Sprintf("+%d XP from commit!", msg.xpAwarded),
+				Type:        NotificationSuccess,
+				Duration:    3 * time.Second,
+				Timestamp:   time.Now(),
+				Celebration: true,
+			})
 		}
-		m.addNotification(notification)
 
 		// Reload character data to reflect new XP and continue listening
 		return m, tea.Batch(
 			loadCharacterCmd(m.storage),
 			m.showNextNotification(),
-			listenForGameEvents(m.eventBus), // Keep listening for more events
+			waitForNextEvent(m.gameEvents), // Keep listening for more events
 		)
 
 	// Level up - Show celebration and reload character
 	case levelUpMsg:
-		// Add level-up notification with celebration
-		notification := Notification{
-			Message:   fmt.Sprintf("⚡ LEVEL UP! ⚡\nYou are now Level %d!", msg.newLevel),
-			Type:      NotificationLevelUp,
-			Duration:  5 * time.Second,
-			Timestamp: time.Now(),
-		}
-		m.addNotification(notification)
+		m.recordActivity("⚡", fmt.Sprintf("Reached level %d", msg.newLevel))
+
+		// Celebrate with the fanfare (or a toast when it's turned off)
+		var fanfareCmd tea.Cmd
+		m, fanfareCmd = m.celebrateLevelUp(msg.oldLevel, msg.newLevel)
+		m.announceUnlocks(msg.oldLevel, msg.newLevel)
 
 		// Reload character data to reflect new level and continue listening
 		return m, tea.Batch(
 			loadCharacterCmd(m.storage),
+			fanfareCmd,
 			m.showNextNotification(),
-			listenForGameEvents(m.eventBus), // Keep listening for more events
+			waitForNextEvent(m.gameEvents), // Keep listening for more events
 		)
 
 	// Quest completed - Show completion notification and reload quests
 	case questCompleteMsg:
+		m.recordActivity("✓", "Completed "+msg.questName)
+
 		// Add quest completion notification
+		reward := fmt.Sprintf("+%d XP", msg.xpAwarded)
+		if msg.xpBreakdown.Total() == msg.xpAwarded && msg.xpAwarded > 0 {
+			reward += "\n" + msg.xpBreakdown.String()
+		}
+		if msg.rewardPending {
+			reward = "Choose your reward!"
+		}
 		notification := Notification{
-			Message:   fmt.Sprintf("✓ QUEST COMPLETE!\n%s\n+%d XP", msg.questName, msg.xpAwarded),
-			Type:      NotificationQuestComplete,
-			Duration:  4 * time.Second,
-			Timestamp: time.Now(),
+			Message:     fmt.Sprintf("✓ QUEST COMPLETE!\n%s\n%s", msg.questName, reward),
+			Type:        NotificationQuestComplete,
+			Duration:    4 * time.Second,
+			Timestamp:   time.Now(),
+			Celebration: true,
 		}
 		m.addNotification(notification)
 
@@ -338,7 +609,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			loadCharacterCmd(m.storage),
 			loadQuestsCmd(m.storage),
 			m.showNextNotification(),
-			listenForGameEvents(m.eventBus), // Keep listening for more events
+			waitForNextEvent(m.gameEvents), // Keep listening for more events
 		)
 
 	// Quest started - Reload quests to reflect new active quest
@@ -356,13 +627,238 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(
 			loadQuestsCmd(m.storage),
 			m.showNextNotification(),
-			listenForGameEvents(m.eventBus), // Keep listening for more events
+			waitForNextEvent(m.gameEvents), // Keep listening for more events
+		)
+
+	// Effort earned or spent - Add it to the activity feed and continue listening
+	case effortChangedMsg:
+		change := fmt.Sprintf("+%d", msg.amount)
+		if msg.spent {
+			change = fmt.Sprintf("-%d", msg.amount)
+		}
+		m.recordActivity("💠", fmt.Sprintf("%s Effort: %s (%d left)", change, msg.reason, msg.balance))
+		return m, waitForNextEvent(m.gameEvents)
+
+	// Personal best broken - Celebrate and continue listening
+	case recordBrokenMsg:
+		m = m.celebrateRecord(msg.record)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Achievement unlocked - Celebrate and continue listening
+	case achievementMsg:
+		m = m.celebrateAchievement(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Weekly crown earned - Celebrate and continue listening
+	case questCrownMsg:
+		m = m.celebrateCrown(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Late-night commits became a habit - Gently warn and continue listening
+	case lateNightMsg:
+		m = m.warnLateNight(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Loot dropped - Announce it and continue listening
+	case lootDropMsg:
+		m = m.announceLoot(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Season pass tier unlocked - Announce it and continue listening
+	case seasonTierMsg:
+		m = m.celebrateSeasonTier(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Custom quest templates reloaded - Merge them into the quest log and
+	// continue listening
+	case questTemplatesMsg:
+		var save tea.Cmd
+		m, save = m.applyQuestTemplates(msg)
+		return m, tea.Batch(save, m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Long streak broken - Offer the Redemption quest, reload the quests
+	// and character that now include it, and continue listening
+	case streakBrokenMsg:
+		m = m.offerRedemption(msg)
+		return m, tea.Batch(
+			loadCharacterCmd(m.storage),
+			loadQuestsCmd(m.storage),
+			m.showNextNotification(),
+			waitForNextEvent(m.gameEvents),
+		)
+
+	// Commit XP held for review - Reload the character (the handler saved
+	// the held entry), which opens the review modal
+	case xpFlaggedMsg:
+		m.recordActivity("⚠", fmt.Sprintf("Held %d XP for review", msg.xp))
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("⚠ XP HELD FOR REVIEW\n%s\n%d XP: %s", msg.message, msg.xp, strings.Join(msg.reasons, ", ")),
+			Type:      NotificationWarning,
+			Duration:  4 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m, tea.Batch(
+			loadCharacterCmd(m.storage),
+			m.showNextNotification(),
+			waitForNextEvent(m.gameEvents),
 		)
 
+	// TODO/FIXME scan finished - Keep the new suggestions and continue listening
+	case todoSuggestionsMsg:
+		m = m.handleTodoSuggestions(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Task lists synced - Announce new task quests, reload the quests the
+	// handler saved and continue listening
+	case taskListSyncedMsg:
+		m = m.announceTaskQuests(msg)
+		return m, tea.Batch(
+			loadQuestsCmd(m.storage),
+			m.showNextNotification(),
+			waitForNextEvent(m.gameEvents),
+		)
+
+	// Uncommitted work left sitting - Nudge about it, reload the quests the
+	// handler saved and continue listening
+	case wipNudgeMsg:
+		m = m.announceWIP(msg, time.Now())
+		return m, tea.Batch(
+			loadQuestsCmd(m.storage),
+			m.showNextNotification(),
+			waitForNextEvent(m.gameEvents),
+		)
+
+	// Quest catalog draw - Announce the new quests, reload the quests the
+	// handler saved and continue listening
+	case questsGeneratedMsg:
+		m = m.announceGeneratedQuests(msg)
+		return m, tea.Batch(
+			loadQuestsCmd(m.storage),
+			m.showNextNotification(),
+			waitForNextEvent(m.gameEvents),
+		)
+
+	// Task list item ticked - Say where the change went and continue listening
+	case taskTickedMsg:
+		m = m.announceTaskTicked(msg)
+		return m, tea.Batch(m.showNextNotification(), waitForNextEvent(m.gameEvents))
+
+	// Quest progress - Animate the active quest card and continue listening
+	case questProgressMsg:
+		model, cmd := m.handleQuestProgress(msg)
+		return model, tea.Batch(cmd, waitForNextEvent(m.gameEvents))
+
+	// Quest progress animation frame
+	case questProgressFrameMsg:
+		return m.advanceQuestProgress(msg)
+
 	// Notification dismissed - Show next notification if any
 	case notificationDismissedMsg:
 		m.currentNotification = nil
 		return m, m.showNextNotification()
+
+	// Clipboard copy finished - Show confirmation toast
+	case clipboardCopiedMsg:
+		m.addNotification(clipboardNotification(msg))
+		return m, m.showNextNotification()
+
+	// Quest notes edited in $EDITOR - Apply and persist
+	case questNotesEditedMsg:
+		return m.applyEditedNotes(msg)
+
+	// Quest notes persisted - Confirm or report failure
+	case questNotesSavedMsg:
+		notification := Notification{
+			Message:   "📓 Quest notes saved",
+			Type:      NotificationSuccess,
+			Duration:  2 * time.Second,
+			Timestamp: time.Now(),
+		}
+		if msg.err != nil {
+			notification.Message = fmt.Sprintf("Failed to save notes: %v", msg.err)
+			notification.Type = NotificationError
+		}
+		m.addNotification(notification)
+		return m, m.showNextNotification()
+
+	// Quest start/abandon/undo saved - Only failures need attention
+	case questStateSavedMsg:
+		if msg.err != nil {
+			m.addNotification(Notification{
+				Message:   fmt.Sprintf("Failed to save quests: %v", msg.err),
+				Type:      NotificationError,
+				Duration:  3 * time.Second,
+				Timestamp: time.Now(),
+			})
+			return m, m.showNextNotification()
+		}
+		return m, nil
+
+	// Config saved after a settings change - Only failures need attention
+	case discordTestMsg:
+		return m.handleDiscordTest(msg)
+
+	case configSavedMsg:
+		if msg.err != nil {
+			m.addNotification(Notification{
+				Message:   fmt.Sprintf("Failed to save settings: %v", msg.err),
+				Type:      NotificationError,
+				Duration:  3 * time.Second,
+				Timestamp: time.Now(),
+			})
+			return m, m.showNextNotification()
+		}
+		return m, nil
+
+	// Focus time is up - Show the summary (ignore timers from ended sessions)
+	case focusEndedMsg:
+		if m.focus == nil || m.focus.id != msg.id {
+			return m, nil
+		}
+		return m.endFocus()
+
+	// Transition frame - Advance animation (ignore ticks from stale transitions)
+	case fanfareFrameMsg:
+		return m.advanceFanfare(msg)
+
+	case transitionFrameMsg:
+		if msg.id != m.transition.ID || !m.transition.Active() {
+			return m, nil
+		}
+		m.transition.Frame++
+		if m.transition.Active() {
+			return m, transitionTick(m.transition.ID)
+		}
+		return m, nil
+	}
+
+	// Forward remaining messages (e.g. cursor blink) to the notes editor
+	if m.editingNotes {
+		var cmd tea.Cmd
+		m.notesEditor, cmd = m.notesEditor.Update(msg)
+		return m, cmd
+	}
+	if m.editingReminder {
+		var cmd tea.Cmd
+		m.reminderInput, cmd = m.reminderInput.Update(msg)
+		return m, cmd
+	}
+	if m.editingProfile != "" {
+		var cmd tea.Cmd
+		m.profileInput, cmd = m.profileInput.Update(msg)
+		return m, cmd
+	}
+	if m.testingXPRules {
+		var cmd tea.Cmd
+		m.xpRuleInput, cmd = m.xpRuleInput.Update(msg)
+		return m, cmd
+	}
+
+	// Mentor results (AI answers, snippet runs) arrive on any screen
+	if m.mentorScreen != nil {
+		var cmd tea.Cmd
+		m.mentorScreen, cmd = m.mentorScreen.Update(msg)
+		return m, cmd
 	}
 
 	return m, nil
@@ -397,6 +893,10 @@ func (m Model) View() string {
 		mainContent = m.viewMentor()
 	case ScreenSettings:
 		mainContent = m.viewSettings()
+	case ScreenSeason:
+		mainContent = m.viewSeason()
+	case ScreenData:
+		mainContent = m.viewData()
 	default:
 		mainContent = "Unknown screen"
 	}
@@ -404,6 +904,56 @@ func (m Model) View() string {
 	// Add timer to footer
 	mainContent = m.addTimerFooter(mainContent)
 
+	// F-key action bar along the bottom
+	if bar := m.viewActionBar(); bar != "" {
+		mainContent = lipgloss.JoinVertical(lipgloss.Left, mainContent, bar)
+	}
+
+	// Slide in the new screen while a screen transition is playing
+	if m.transition.Kind == TransitionSlide {
+		mainContent = m.transition.Apply(mainContent, m.width)
+	}
+
+	// Level-up fanfare takes over the whole screen until dismissed
+	if m.fanfare != nil {
+		return m.viewFanfare()
+	}
+
+	// Tutorial callouts point at parts of the screen being toured
+	if m.showingTutorial {
+		return m.viewTutorial(mainContent)
+	}
+
+	// Welcome-back summary after a long absence
+	if m.welcomeBack != nil {
+		return m.viewWelcomeBack()
+	}
+
+	// Reward choice modal for a completed quest
+	if m.rewardChoice != nil {
+		return m.viewRewardChoice()
+	}
+
+	// Review modal for XP held by anomaly detection
+	if m.flaggedReview != nil {
+		return m.viewFlaggedReview()
+	}
+
+	// Break reminder banner stays up until it's answered
+	if m.breakReminder != nil {
+		return m.viewBreakReminder(mainContent)
+	}
+
+	// Focus duration picker stays up until a length is chosen
+	if m.choosingFocus {
+		return m.viewFocusPicker(mainContent)
+	}
+
+	// Global search popover stays up until a result is chosen or it's closed
+	if m.search != nil {
+		return m.viewSearch()
+	}
+
 	// If help overlay is showing, render it on top
 	if m.showingHelp {
 		return m.viewHelpOverlay(mainContent)
@@ -420,9 +970,16 @@ func (m Model) View() string {
 // handleKeyPress handles keyboard input and routes to appropriate handlers.
 //
 // Priority order:
-//  1. Help overlay (if showing, Esc to close)
-//  2. Global keys (Ctrl+C quit, ? for help, Alt+ modifiers)
-//  3. Screen-specific keys (Q, C, M, S on dashboard)
+//  1. Help overlay (if showing, Esc to close, T for the tutorial)
+//  2. Level-up fanfare (if showing, any key but Ctrl+C dismisses it)
+//  3. Tutorial (if showing, Enter to advance, Esc to skip)
+//  4. Reward choice (if open, ↑/↓ to choose, Enter to claim, Esc to put off)
+//  5. Flagged XP review (if open, A to accept, D to discard, Esc to put off)
+//  6. Break reminder (if shown, Enter to take, Z to snooze, Esc to skip)
+//  7. Focus picker (if open, 1-3 to choose a duration, Esc to cancel)
+//  8. Global search (if open, ↑/↓ to choose, Enter to go, Esc to close)
+//  9. Global keys (Ctrl+C quit, ? for help, Ctrl+F search, Ctrl+Z/Ctrl+Y undo/redo, Alt+ modifiers)
+//  10. Screen-specific keys (Q, C, M, S on dashboard)
 //
 // Parameters:
 //   - msg: The key press message
@@ -437,6 +994,9 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.showingHelp = false
 			return m, nil
 		}
+		if key.Matches(msg, m.keys.Tutorial) {
+			return m.startTutorial()
+		}
 		// Ignore all other keys when help is showing
 		return m, nil
 	}
@@ -446,10 +1006,80 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	// Any key dismisses the level-up fanfare
+	if m.fanfare != nil {
+		m.fanfare = nil
+		return m, nil
+	}
+
+	// Tutorial captures all other keys while its callouts are shown
+	if m.showingTutorial {
+		return m.handleTutorialKeys(msg)
+	}
+
+	// Welcome-back screen captures the first key after a long absence
+	if m.welcomeBack != nil {
+		return m.handleWelcomeBackKeys(msg)
+	}
+
+	// Reward choice modal captures keys until a reward is picked or put off
+	if m.rewardChoice != nil {
+		return m.handleRewardChoiceKeys(msg)
+	}
+
+	// Flagged XP review captures keys until the XP is accepted, discarded or put off
+	if m.flaggedReview != nil {
+		return m.handleFlaggedReviewKeys(msg)
+	}
+
+	// Break reminder captures keys until it's taken, snoozed or skipped
+	if m.breakReminder != nil {
+		return m.handleBreakReminderKeys(msg)
+	}
+
+	// Focus picker captures keys until a duration is chosen or it's closed
+	if m.choosingFocus {
+		return m.handleFocusPickerKeys(msg)
+	}
+
+	// Global search captures keys until a result is chosen or it's closed
+	if m.search != nil {
+		return m.handleSearchKeys(msg)
+	}
+
+	// New Quest form captures keys until the quest is created or discarded
+	if m.questForm != nil {
+		return m.handleQuestFormKeys(msg)
+	}
+
+	// Recovery screen captures all other keys while an error is shown
+	if m.err != nil {
+		return m.handleRecoveryKeys(msg)
+	}
+
+	// Notes editor captures all other keys (so typing doesn't trigger shortcuts)
+	if m.editingNotes {
+		return m.handleQuestDetailKeys(msg)
+	}
+	if m.editingReminder {
+		return m.handleReminderEditKeys(msg)
+	}
+	if m.editingProfile != "" {
+		return m.handleProfileEditKeys(msg)
+	}
+	if m.testingXPRules {
+		return m.handleXPRuleSampleKeys(msg)
+	}
+
+	// F-key actions from the screen's action bar
+	if model, cmd, ok := m.handleActionKey(msg); ok {
+		return model, cmd
+	}
+
 	// Global help overlay (? key) - works from any screen except dashboard (dashboard uses ? for help)
 	if m.currentScreen != ScreenDashboard && key.Matches(msg, m.keys.HelpOverlay) {
 		m.showingHelp = true
-		return m, nil
+		return m, m.startTransition(TransitionFade, 0)
 	}
 
 	// Global save (Ctrl+S)
@@ -462,6 +1092,27 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.toggleTimer()
 	}
 
+	// Global focus mode toggle (Alt+F)
+	if key.Matches(msg, m.keys.GlobalFocus) {
+		return m.toggleFocus()
+	}
+
+	// Global search (Ctrl+F)
+	if key.Matches(msg, m.keys.GlobalSearch) {
+		return m.openSearch()
+	}
+
+	// Global undo/redo (Ctrl+Z / Ctrl+Y). The mentor screen keeps Ctrl+Y for
+	// copying responses and has no undoable actions of its own.
+	if m.currentScreen != ScreenMentor {
+		if key.Matches(msg, m.keys.Undo) {
+			return m.undo()
+		}
+		if key.Matches(msg, m.keys.Redo) {
+			return m.redo()
+		}
+	}
+
 	// Global navigation (Alt+ modifiers - work from any screen)
 	if key.Matches(msg, m.keys.GlobalDashboard) {
 		return m.switchScreen(ScreenDashboard)
@@ -487,6 +1138,33 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if key.Matches(msg, m.keys.DashboardSettings) {
 			return m.switchScreen(ScreenSettings)
 		}
+		if key.Matches(msg, m.keys.DashboardCopySHA) {
+			return m.copyLastCommitSHA()
+		}
+		if key.Matches(msg, m.keys.DashboardFeedPet) {
+			return m.feedPet()
+		}
+		if key.Matches(msg, m.keys.DashboardSeason) {
+			return m.switchScreen(ScreenSeason)
+		}
+		if key.Matches(msg, m.keys.DashboardData) {
+			return m.openDataScreen()
+		}
+		if key.Matches(msg, m.keys.Up) {
+			m.dashboardQuest = max(m.dashboardQuest-1, 0)
+			return m, nil
+		}
+		if key.Matches(msg, m.keys.Down) {
+			m.dashboardQuest = min(m.dashboardQuest+1, max(len(screens.ActiveQuests(m.quests))-1, 0))
+			return m, nil
+		}
+		if key.Matches(msg, m.keys.Enter) {
+			return m.openDashboardQuest()
+		}
+		if key.Matches(msg, m.keys.DashboardHelpKey) {
+			m.showingHelp = true
+			return m, m.startTransition(TransitionFade, 0)
+		}
 	}
 
 	// Quest Board specific keys
@@ -499,6 +1177,26 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleMentorKeys(msg)
 	}
 
+	// Character screen: project filter, stat allocation and respec
+	if m.currentScreen == ScreenCharacter {
+		return m.handleCharacterKeys(msg)
+	}
+
+	// Settings screen: dashboard widget selection and order
+	if m.currentScreen == ScreenSettings {
+		return m.handleSettingsKeys(msg)
+	}
+
+	// Season pass: tier selection and claims
+	if m.currentScreen == ScreenSeason {
+		return m.handleSeasonKeys(msg)
+	}
+
+	// Data: stored key inspection and deletion
+	if m.currentScreen == ScreenData {
+		return m.handleDataKeys(msg)
+	}
+
 	// Escape key - return to dashboard from any screen
 	if key.Matches(msg, m.keys.Esc) && m.currentScreen != ScreenDashboard {
 		return m.switchScreen(ScreenDashboard)
@@ -510,11 +1208,16 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // handleMentorKeys handles keyboard input specific to the Mentor screen.
 // Delegates most handling to the MentorScreen component.
 func (m Model) handleMentorKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Esc key returns to dashboard
-	if key.Matches(msg, m.keys.Esc) {
+	// Esc key returns to dashboard (unless it's closing a dropdown)
+	if key.Matches(msg, m.keys.Esc) && (m.mentorScreen == nil || !m.mentorScreen.DropdownOpen()) {
 		return m.switchScreen(ScreenDashboard)
 	}
 
+	// Ctrl+Y copies the last response (its code blocks, if any)
+	if key.Matches(msg, m.keys.CopyResponse) {
+		return m.copyLastResponse()
+	}
+
 	// Delegate to MentorScreen component
 	if m.mentorScreen != nil {
 		updatedScreen, cmd := m.mentorScreen.Update(msg)
@@ -537,12 +1240,52 @@ func (m Model) handleMentorKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 //   - tea.Model: Updated model
 //   - tea.Cmd: Optional command (nil for now)
 func (m Model) switchScreen(screen Screen) (tea.Model, tea.Cmd) {
+	// Slide forward when moving to a later screen, backward otherwise
+	var cmd tea.Cmd
+	if screen != m.currentScreen {
+		direction := 1
+		if screen < m.currentScreen {
+			direction = -1
+		}
+		cmd = m.startTransition(TransitionSlide, direction)
+	}
+
 	m.currentScreen = screen
 
+	// Close any open quest detail view
+	m.questDetail = nil
+	m.editingNotes = false
+	m.editingReminder = false
+	m.editingProfile = ""
+	m.testingXPRules = false
+	m.statDetails = false
+	m.characterFocus = screens.CharacterFocus{}
+
 	// Reset quest board state when switching to it
 	if screen == ScreenQuestBoard {
 		m.questBoardSelectedIndex = 0
 		m.questBoardFilter = screens.FilterAll
+		m.showingCalendar = false
+	}
+
+	// Start the season pass on the first reward waiting to be claimed
+	if screen == ScreenSeason {
+		m.seasonSelected = m.initialSeasonTier()
+	}
+
+	// List stored keys each time the data screen opens
+	if screen == ScreenData {
+		cmd = tea.Batch(cmd, m.resetData())
+	}
+
+	// Query recent totals each time the character sheet opens
+	if screen == ScreenCharacter && m.analytics != nil {
+		cmd = tea.Batch(cmd, loadAnalyticsCmd(m.analytics, time.Now()))
+	}
+
+	// Measure storage usage each time settings opens
+	if screen == ScreenSettings && m.storage != nil {
+		cmd = tea.Batch(cmd, loadStorageUsageCmd(m.storage))
 	}
 
 	// Enable/disable dashboard keys based on screen
@@ -552,15 +1295,51 @@ func (m Model) switchScreen(screen Screen) (tea.Model, tea.Cmd) {
 		m.keys.DisableDashboardKeys()
 	}
 
-	return m, nil
+	return m, cmd
+}
+
+// animationsEnabled reports whether transitions should be played.
+// Animations are skipped when disabled in config, when reduced motion is
+// requested, or in low-power mode.
+func (m Model) animationsEnabled() bool {
+	if m.lowPower() {
+		return false
+	}
+	if m.config == nil {
+		return true
+	}
+	return m.config.UI.ShowAnimations && !m.config.UI.ReducedMotion
+}
+
+// startTransition begins a new transition and returns the command that drives it.
+// Returns nil (and leaves no transition running) when animations are disabled.
+//
+// Parameters:
+//   - kind: The effect to play
+//   - direction: Slide direction (1 = from the right, -1 = from the left)
+//
+// Returns:
+//   - tea.Cmd: Frame tick command, or nil if animations are disabled
+func (m *Model) startTransition(kind TransitionKind, direction int) tea.Cmd {
+	if !m.animationsEnabled() {
+		m.transition = Transition{ID: m.transition.ID}
+		return nil
+	}
+
+	m.transition = newTransition(m.transition, kind, direction)
+	return transitionTick(m.transition.ID)
 }
 
 // handleQuestBoardKeys handles keyboard input specific to the Quest Board screen.
 //
 // Supports:
 //   - Up/Down: Navigate quest list
-//   - Enter: Start/view selected quest (placeholder for now)
+//   - Enter: Open the selected quest's detail view (notes)
+//   - *: Pin or unpin the selected quest
+//   - Shift+Up/Down: Move the selected quest within its section
 //   - F: Cycle through filters
+//   - V: Switch to the calendar view
+//   - N: Create a custom quest
 //
 // Parameters:
 //   - msg: The key press message
@@ -569,9 +1348,36 @@ func (m Model) switchScreen(screen Screen) (tea.Model, tea.Cmd) {
 //   - tea.Model: Updated model
 //   - tea.Cmd: Optional command
 func (m Model) handleQuestBoardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Filter quests based on current filter to get the correct count
-	filteredQuests := m.getFilteredQuests()
-	maxIndex := len(filteredQuests) - 1
+	// TODO suggestion picker captures keys until it's closed
+	if m.todoPicker != nil {
+		return m.handleTodoPickerKeys(msg)
+	}
+
+	// Quest detail view has its own key handling
+	if m.questDetail != nil {
+		return m.handleQuestDetailKeys(msg)
+	}
+
+	// Calendar view has its own navigation
+	if m.showingCalendar {
+		return m.handleQuestCalendarKeys(msg)
+	}
+
+	// Count the quests the board lists for the current filters
+	maxIndex := len(m.boardQuests()) - 1
+
+	// Shift+Up/Down - move the selected quest (checked before plain Up/Down)
+	if key.Matches(msg, m.keys.MoveQuestUp) {
+		return m.moveQuest(-1)
+	}
+	if key.Matches(msg, m.keys.MoveQuestDown) {
+		return m.moveQuest(1)
+	}
+
+	// * key - pin or unpin the selected quest
+	if key.Matches(msg, m.keys.PinQuest) {
+		return m.togglePinQuest()
+	}
 
 	// Up arrow - select previous quest
 	if key.Matches(msg, m.keys.Up) {
@@ -591,18 +1397,83 @@ func (m Model) handleQuestBoardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	// F key - cycle through filters
 	if msg.String() == "f" || msg.String() == "F" {
-		m.questBoardFilter = (m.questBoardFilter + 1) % 4
-		m.questBoardSelectedIndex = 0 // Reset selection when filter changes
-		return m, nil
+		return m.cycleQuestFilter()
 	}
 
-	// Enter key - start/view quest (placeholder)
+	// P key - cycle through projects
+	if msg.String() == "p" || msg.String() == "P" {
+		return m.cycleProjectFilter()
+	}
+
+	// Enter key - open quest detail view
 	if key.Matches(msg, m.keys.Enter) {
-		// TODO: Implement quest start/view logic
-		// For now, just do nothing
+		return m.openQuestDetail()
+	}
+
+	// N key - create a custom quest
+	if key.Matches(msg, m.keys.NewQuest) {
+		return m.openQuestForm()
+	}
+
+	// T key - turn TODO/FIXME comments into quests
+	if key.Matches(msg, m.keys.TodoQuests) {
+		if !m.featureUnlocked(game.FeatureTodoQuests) {
+			return m.lockedFeature(game.FeatureTodoQuests)
+		}
+		return m.openTodoPicker()
+	}
+
+	// V key - switch to the calendar view
+	if key.Matches(msg, m.keys.QuestCalendar) {
+		if !m.featureUnlocked(game.FeatureQuestCalendar) {
+			return m.lockedFeature(game.FeatureQuestCalendar)
+		}
+		return m.toggleQuestCalendar()
+	}
+
+	return m, nil
+}
+
+// cycleQuestFilter moves the quest board to the next status filter.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Always nil
+func (m Model) cycleQuestFilter() (tea.Model, tea.Cmd) {
+	before := m.currentFilterState()
+	m.questBoardFilter = (m.questBoardFilter + 1) % 4
+	m.questBoardSelectedIndex = 0 // Reset selection when filter changes
+	m.recordFilterChange("quest filter change", before)
+	return m, nil
+}
+
+// cycleProjectFilter moves the project filter to the next configured project,
+// wrapping back to "all projects" after the last one.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Always nil
+func (m Model) cycleProjectFilter() (tea.Model, tea.Cmd) {
+	if m.config == nil || len(m.config.Projects) == 0 {
 		return m, nil
 	}
 
+	names := m.config.ProjectNames()
+	next := names[0]
+	for i, name := range names {
+		if name == m.projectFilter {
+			next = ""
+			if i+1 < len(names) {
+				next = names[i+1]
+			}
+			break
+		}
+	}
+
+	before := m.currentFilterState()
+	m.projectFilter = next
+	m.questBoardSelectedIndex = 0 // Reset selection when the quest list changes
+	m.recordFilterChange("project filter change", before)
 	return m, nil
 }
 
@@ -633,7 +1504,8 @@ func (m Model) toggleTimer() (tea.Model, tea.Cmd) {
 			m.addNotification(notification)
 			return m, m.showNextNotification()
 		}
-		return m, timerTick()
+		m.startTimeStretch()
+		return m, m.timerTick()
 
 	case watcher.SessionRunning:
 		// Pause session
@@ -648,7 +1520,7 @@ func (m Model) toggleTimer() (tea.Model, tea.Cmd) {
 			m.addNotification(notification)
 			return m, m.showNextNotification()
 		}
-		return m, nil
+		return m, m.endTimeStretch()
 
 	case watcher.SessionPaused:
 		// Resume session
@@ -663,55 +1535,25 @@ func (m Model) toggleTimer() (tea.Model, tea.Cmd) {
 			m.addNotification(notification)
 			return m, m.showNextNotification()
 		}
-		return m, timerTick()
+		m.startTimeStretch()
+		return m, m.timerTick()
 	}
 
 	return m, nil
 }
 
-// getFilteredQuests returns quests filtered by the current filter.
-func (m Model) getFilteredQuests() []*game.Quest {
-	if m.questBoardFilter == screens.FilterAll {
-		return m.quests
-	}
-
-	filtered := make([]*game.Quest, 0)
-	for _, quest := range m.quests {
-		switch m.questBoardFilter {
-		case screens.FilterAvailable:
-			if quest.Status == game.QuestAvailable {
-				filtered = append(filtered, quest)
-			}
-		case screens.FilterActive:
-			if quest.Status == game.QuestActive {
-				filtered = append(filtered, quest)
-			}
-		case screens.FilterCompleted:
-			if quest.Status == game.QuestCompleted {
-				filtered = append(filtered, quest)
-			}
-		}
-	}
-	return filtered
-}
-
 // saveStateCmd returns a command to save current game state to storage.
+// The saving indicator shows while it runs.
 func (m Model) saveStateCmd() tea.Cmd {
-	return func() tea.Msg {
-		// Save character
-		if m.character != nil {
-			if err := m.storage.SaveCharacter(m.character); err != nil {
-				return errorMsg{err: fmt.Errorf("failed to save character: %w", err)}
-			}
-		}
-
-		// Save quests
-		if err := m.storage.SaveQuests(m.quests); err != nil {
-			return errorMsg{err: fmt.Errorf("failed to save quests: %w", err)}
+	return tea.Sequence(func() tea.Msg { return saveStartedMsg{} }, func() tea.Msg {
+		// Save character and quests as one batch (rolled back on failure)
+		state := storage.State{Character: m.character, Quests: m.quests}
+		if err := m.storage.Save(state); err != nil {
+			return errorMsg{err: fmt.Errorf("failed to save game state: %w", err), retry: m.saveStateCmd()}
 		}
 
 		return saveCompletedMsg{}
-	}
+	})
 }
 
 // ============================================================================
@@ -763,7 +1605,7 @@ func (m Model) addTimerFooter(content string) string {
 	timerDisplay := timerStyle.Render(icon + " " + timeStr)
 
 	// Create footer with timer and help hint
-	helpHint := MutedTextStyle.Render("  |  Press ? for help  |  Ctrl+T to pause/resume timer")
+	helpHint := MutedTextStyle.Render("  |  Press ? for help  |  Ctrl+T to pause/resume timer") + m.viewPowerMode()
 
 	footer := lipgloss.NewStyle().
 		Width(m.width).
@@ -771,6 +1613,11 @@ func (m Model) addTimerFooter(content string) string {
 		Foreground(ColorDim).
 		Render(timerDisplay + helpHint)
 
+	// Saving, imports and other running operations go under the timer
+	if activity := m.viewActivity(); activity != "" {
+		footer = lipgloss.JoinVertical(lipgloss.Left, footer, activity)
+	}
+
 	// Combine content and footer
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -780,34 +1627,65 @@ func (m Model) addTimerFooter(content string) string {
 	)
 }
 
-// viewLoading renders the loading screen.
+// viewLoading renders the loading screen, with import progress when
+// missed commits are being replayed.
 func (m Model) viewLoading() string {
-	frame := 0 // TODO: Animate this with a tick command
-	spinner := RenderLoadingSpinner(frame, "Loading CodeQuest...")
-	return PlaceInCenter(m.width, m.height, spinner)
-}
-
-// viewError renders the error screen.
-func (m Model) viewError() string {
-	errorText := ErrorTextStyle.Render("Error: " + m.err.Error())
-	hint := MutedTextStyle.Render("\nPress Ctrl+C to quit")
-	return PlaceInCenter(m.width, m.height, errorText+hint)
+	loading := m.activity.View(screens.ActivityLoading)
+	if imports := m.activity.View(screens.ActivityImporting); imports != "" {
+		loading = lipgloss.JoinVertical(lipgloss.Left, loading, "", imports)
+	}
+	return PlaceInCenter(m.width, m.height, loading)
 }
 
 // viewDashboard renders the dashboard screen.
 // Delegates to screens.RenderDashboard for full implementation.
 func (m Model) viewDashboard() string {
-	return screens.RenderDashboard(m.character, m.quests, m.width, m.height)
+	if m.focus != nil {
+		return m.viewFocusDashboard()
+	}
+	data := screens.DashboardData{
+		Character:     m.character,
+		Quests:        m.quests,
+		SelectedQuest: m.dashboardQuest,
+		Budget:        game.XPBudgetFromConfig(m.config),
+		ProgressTick:  m.questProgress,
+		Activity:      m.activityFeed,
+		Clock:         game.StreakClockFromConfig(m.config),
+		Now:           time.Now(),
+	}
+	return screens.RenderDashboard(data, m.dashboardWidgets(), m.width, m.height)
 }
 
 // viewQuestBoard renders the quest board screen.
 // Delegates to screens.RenderQuestBoard for full implementation.
 func (m Model) viewQuestBoard() string {
+	if m.questForm != nil {
+		return m.viewQuestForm()
+	}
+	if m.todoPicker != nil {
+		return m.viewTodoPicker()
+	}
+	if m.questDetail != nil {
+		editorView, reminderView := "", ""
+		if m.editingNotes {
+			editorView = m.notesEditor.View()
+		}
+		if m.editingReminder {
+			reminderView = m.reminderInput.View()
+		}
+		return screens.RenderQuestDetail(m.character, m.questDetail, editorView, reminderView, m.width, m.height)
+	}
+	if m.showingCalendar {
+		return m.viewQuestCalendar()
+	}
+
 	return screens.RenderQuestBoard(
+		m.questCards,
 		m.character,
 		m.quests,
 		m.questBoardSelectedIndex,
 		m.questBoardFilter,
+		m.projectFilter,
 		m.width,
 		m.height,
 	)
@@ -816,7 +1694,15 @@ func (m Model) viewQuestBoard() string {
 // viewCharacter renders the character sheet screen.
 // Delegates to screens.RenderCharacter for full implementation.
 func (m Model) viewCharacter() string {
-	return screens.RenderCharacter(m.character, m.width, m.height)
+	if m.statDetails && m.character != nil {
+		return screens.RenderStatDetails(game.StatEffects(m.character, m.quests, game.StatDecayFromConfig(m.config)), m.width, m.height)
+	}
+
+	var projects []game.ProjectSummary
+	if m.config != nil {
+		projects = game.SummarizeProjects(m.character, m.quests, m.config.ProjectNames())
+	}
+	return screens.RenderCharacter(m.character, projects, m.projectFilter, m.analyticsSummary, m.characterFocus, m.width, m.height)
 }
 
 // viewMentor renders the mentor/AI assistant screen.
@@ -856,7 +1742,31 @@ func (m Model) viewMentor() string {
 // viewSettings renders the settings screen.
 // Delegates to screens.RenderSettings for full implementation.
 func (m Model) viewSettings() string {
-	return screens.RenderSettings(m.character, m.width, m.height)
+	var metrics []watcher.WatcherMetrics
+	if m.watcherMetrics != nil {
+		metrics = m.watcherMetrics()
+	}
+	return screens.RenderSettings(m.character, metrics, m.storageData(), m.dashboardWidgets(), m.settingsSelected, m.profileEditorView(), m.experimentalFlags(), m.discordSettings(), m.xpRulesSettings(), m.sleepSettings(), m.width, m.height)
+}
+
+// SetWatcherMetrics provides a source of git watcher telemetry, shown in the
+// debug section of the settings screen. Call before the program starts.
+//
+// Parameters:
+//   - source: Function returning current watcher metrics (e.g. WatcherManager.Metrics)
+func (m *Model) SetWatcherMetrics(source func() []watcher.WatcherMetrics) {
+	m.watcherMetrics = source
+}
+
+// SetEventBus makes the UI listen on an event bus shared with the game
+// handler, so commits, level-ups and quest completions published there show
+// up as they happen. Call before the program starts.
+//
+// Parameters:
+//   - bus: Event bus the game handler listens on
+func (m *Model) SetEventBus(bus *game.EventBus) {
+	m.eventBus = bus
+	subscribeGameEvents(bus, m.gameEvents)
 }
 
 // viewHelpOverlay renders the help overlay on top of the main content.
@@ -882,10 +1792,19 @@ func (m Model) viewHelpOverlay(mainContent string) string {
 	case ScreenSettings:
 		helpTitle = "Settings Help"
 		helpBindings = m.keys.SettingsHelp()
+	case ScreenSeason:
+		helpTitle = "Season Pass Help"
+		helpBindings = m.keys.SeasonHelp()
+	case ScreenData:
+		helpTitle = "Data Help"
+		helpBindings = m.keys.DataHelp()
 	default:
 		helpTitle = "Help"
 		helpBindings = m.keys.ShortHelp()
 	}
+	for _, action := range screenActions[m.currentScreen] {
+		helpBindings = append(helpBindings, action.Binding)
+	}
 
 	// Build help text from bindings
 	helpLines := make([]string, 0)
@@ -899,7 +1818,17 @@ func (m Model) viewHelpOverlay(mainContent string) string {
 		helpLines = append(helpLines, line)
 	}
 
+	// Explain the live state of the focused element (selected quest, XP bar)
+	if topic, ok := m.contextHelp(time.Now()); ok {
+		helpLines = append(helpLines, "")
+		helpLines = append(helpLines, HeadingStyle.Render(topic.Title))
+		for _, line := range topic.Lines {
+			helpLines = append(helpLines, TextStyle.Render(line))
+		}
+	}
+
 	helpLines = append(helpLines, "")
+	helpLines = append(helpLines, RenderKeybind(m.keys.Tutorial.Help().Key, m.keys.Tutorial.Help().Desc))
 	helpLines = append(helpLines, MutedTextStyle.Render("Press Esc to close this help overlay"))
 
 	helpContent := lipgloss.JoinVertical(lipgloss.Left, helpLines...)
@@ -907,6 +1836,11 @@ func (m Model) viewHelpOverlay(mainContent string) string {
 	// Wrap in modal style
 	helpBox := ModalStyle.Render(helpContent)
 
+	// Fade the modal in while its opening transition is playing
+	if m.transition.Kind == TransitionFade {
+		helpBox = m.transition.Apply(helpBox, m.width)
+	}
+
 	// Center the help box over the main content
 	overlay := PlaceInCenter(m.width, m.height, helpBox)
 
@@ -920,6 +1854,7 @@ func (m Model) viewHelpOverlay(mainContent string) string {
 // characterLoadedMsg is sent when character loading completes.
 type characterLoadedMsg struct {
 	character *game.Character
+	firstRun  bool // Character was just created (no saved progress)
 }
 
 // questsLoadedMsg is sent when quest loading completes.
@@ -928,8 +1863,11 @@ type questsLoadedMsg struct {
 }
 
 // errorMsg is sent when an error occurs.
+// A non-nil retry marks the failure as transient: it's retried automatically
+// and offered as the "Retry" option on the recovery screen.
 type errorMsg struct {
-	err error
+	err   error
+	retry tea.Cmd
 }
 
 // saveCompletedMsg is sent when save operation completes successfully.
@@ -944,11 +1882,11 @@ type saveCompletedMsg struct{}
 // commitDetectedMsg is sent when a git commit is detected.
 // The UI can show XP gain notifications and update character stats.
 type commitDetectedMsg struct {
-	sha          string
-	message      string
-	xpAwarded    int
-	linesAdded   int
-	linesRemoved int
+	sha       string
+	message   string
+	repoPath  string
+	xpAwarded int  // XP the game handler awarded
+	held      bool // Whether the XP was held for review instead
 }
 
 // levelUpMsg is sent when the character gains a level.
@@ -962,9 +1900,11 @@ type levelUpMsg struct {
 // questCompleteMsg is sent when a quest is completed.
 // The UI can show quest completion notification and update quest list.
 type questCompleteMsg struct {
-	questID   string
-	questName string
-	xpAwarded int
+	questID       string
+	questName     string
+	xpAwarded     int
+	xpBreakdown   game.XPBreakdown // How xpAwarded was made up (zero if unknown)
+	rewardPending bool             // Player picks the reward (xpAwarded is 0)
 }
 
 // questStartMsg is sent when a quest is started.
@@ -980,18 +1920,27 @@ type questStartMsg struct {
 // ============================================================================
 
 // loadCharacterCmd loads the character from storage asynchronously.
-func loadCharacterCmd(storage *storage.SkateClient) tea.Cmd {
+func loadCharacterCmd(storageClient *storage.Client) tea.Cmd {
 	return func() tea.Msg {
 		// Try to load existing character
-		character, err := storage.LoadCharacter()
+		character, err := storageClient.LoadCharacter()
 		if err != nil {
-			// If character doesn't exist (first run), create a new one
+			// Don't overwrite saved progress because storage is temporarily down
+			if !storage.IsNotFound(err) {
+				return errorMsg{err: err, retry: loadCharacterCmd(storageClient)}
+			}
+
+			// Character doesn't exist (first run), create a new one
 			character = game.NewCharacter("Adventurer")
 
 			// Save the new character
-			if saveErr := storage.SaveCharacter(character); saveErr != nil {
-				return errorMsg{err: fmt.Errorf("failed to create new character: %w", saveErr)}
+			if saveErr := storageClient.SaveCharacter(character); saveErr != nil {
+				return errorMsg{
+					err:   fmt.Errorf("failed to create new character: %w", saveErr),
+					retry: loadCharacterCmd(storageClient),
+				}
 			}
+			return characterLoadedMsg{character: character, firstRun: true}
 		}
 
 		return characterLoadedMsg{character: character}
@@ -999,7 +1948,7 @@ func loadCharacterCmd(storage *storage.SkateClient) tea.Cmd {
 }
 
 // loadQuestsCmd loads quests from storage asynchronously.
-func loadQuestsCmd(storage *storage.SkateClient) tea.Cmd {
+func loadQuestsCmd(storage *storage.Client) tea.Cmd {
 	return func() tea.Msg {
 		quests, err := storage.LoadQuests()
 		if err != nil {
@@ -1031,15 +1980,18 @@ const (
 	NotificationLevelUp
 	// NotificationQuestComplete - Quest completion (cyan)
 	NotificationQuestComplete
+	// NotificationLoot - Loot drop (lavender)
+	NotificationLoot
 )
 
 // Notification represents a temporary message to display to the user.
 // Notifications auto-dismiss after their duration expires.
 type Notification struct {
-	Message   string           // The message to display
-	Type      NotificationType // Visual style of notification
-	Duration  time.Duration    // How long to display (0 = manual dismiss)
-	Timestamp time.Time        // When the notification was created
+	Message     string           // The message to display
+	Type        NotificationType // Visual style of notification
+	Duration    time.Duration    // How long to display (0 = manual dismiss)
+	Timestamp   time.Time        // When the notification was created
+	Celebration bool             // Game celebration, dropped when limits hold celebrations back
 }
 
 // notificationDismissedMsg is sent when a notification's timer expires.
@@ -1048,6 +2000,14 @@ type notificationDismissedMsg struct{}
 // addNotification adds a notification to the queue.
 // If no notification is currently showing, it will be displayed immediately.
 func (m *Model) addNotification(notification Notification) {
+	// Quiet hours and weekly-summary-only mode drop celebrations
+	if notification.Celebration && !m.celebrationLimits().Celebrate(time.Now()) {
+		return
+	}
+	// Focus mode holds notifications for its end-of-focus summary
+	if m.focus != nil && m.focus.holdNotification(notification) {
+		return
+	}
 	m.notifications = append(m.notifications, notification)
 }
 
@@ -1073,7 +2033,7 @@ func (m *Model) showNextNotification() tea.Cmd {
 
 	// Return a command to dismiss after duration
 	if m.currentNotification.Duration > 0 {
-		return tea.Tick(m.currentNotification.Duration, func(t time.Time) tea.Msg {
+		return tea.Tick(m.notificationDuration(m.currentNotification.Duration), func(t time.Time) tea.Msg {
 			return notificationDismissedMsg{}
 		})
 	}
@@ -1085,6 +2045,7 @@ func (m *Model) showNextNotification() tea.Cmd {
 // This ensures the session timer is properly stopped and state is saved.
 func (m Model) Cleanup() {
 	if m.sessionTracker != nil {
+		m.saveTimeStretchNow()
 		m.sessionTracker.Stop() // Saves final state
 	}
 }
@@ -1145,6 +2106,16 @@ func (m Model) renderNotification(n Notification) string {
 			Padding(1, 2)
 		icon = "✓"
 
+	case NotificationLoot:
+		style = lipgloss.NewStyle().
+			Border(lipgloss.ThickBorder()).
+			BorderForeground(ColorMagic).
+			Foreground(ColorMagic).
+			Background(lipgloss.Color("235")).
+			Bold(true).
+			Padding(1, 2)
+		icon = "✦"
+
 	case NotificationSuccess:
 		style = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
@@ -1197,14 +2168,14 @@ func (m Model) renderNotification(n Notification) string {
 // Event Bus Integration - Bridge to Bubble Tea
 // ============================================================================
 
-// listenForGameEvents subscribes to the EventBus and converts game events
-// to Bubble Tea messages. This creates a bridge between the game's EventBus
-// and Bubble Tea's message system.
+// subscribeGameEvents subscribes to the EventBus and forwards game events
+// to a channel the UI reads from. This creates a bridge between the game's
+// EventBus and Bubble Tea's message system.
 //
 // Architecture:
-//  1. Subscribe to EventBus events with handlers
-//  2. Handlers convert game.Event to Bubble Tea messages via channels
-//  3. A goroutine listens to the channel and returns messages to Bubble Tea
+//  1. Subscribe once to every EventBus event with a wildcard (game.EventAll) handler
+//  2. The handler queues events on a buffered channel shared by every copy of the model
+//  3. waitForNextEvent takes one event at a time off the channel and returns it to Bubble Tea
 //
 // Thread Safety:
 // - EventBus handlers run in the publisher's goroutine (synchronously)
@@ -1213,55 +2184,27 @@ func (m Model) renderNotification(n Notification) string {
 //
 // Parameters:
 //   - eventBus: The game event bus to subscribe to
-//
-// Returns:
-//   - tea.Cmd: A command that waits for the next game event
-func listenForGameEvents(eventBus *game.EventBus) tea.Cmd {
-	return func() tea.Msg {
-		// Create a buffered channel for game events
-		eventChan := make(chan game.Event, 10)
-
-		// Subscribe to all relevant event types
-		// Each subscription adds a handler that sends events to our channel
-		eventBus.Subscribe(game.EventCommit, func(e game.Event) {
-			select {
-			case eventChan <- e:
-				// Event sent successfully
-			default:
-				// Channel full, drop event (prevents blocking game logic)
-			}
-		})
-
-		eventBus.Subscribe(game.EventLevelUp, func(e game.Event) {
-			select {
-			case eventChan <- e:
-			default:
-			}
-		})
-
-		eventBus.Subscribe(game.EventQuestDone, func(e game.Event) {
-			select {
-			case eventChan <- e:
-			default:
-			}
-		})
-
-		eventBus.Subscribe(game.EventQuestStart, func(e game.Event) {
-			select {
-			case eventChan <- e:
-			default:
-			}
-		})
-
-		// Wait for the first event from the channel
-		// This blocks until an event is received
-		event := <-eventChan
-
-		// Convert the game event to a Bubble Tea message
-		return convertEventToMessage(event)
-	}
+//   - eventChan: Channel the events are queued on
+func subscribeGameEvents(eventBus *game.EventBus, eventChan chan<- game.Event) {
+	// Events the UI has no message for are skipped here so they never
+	// reach Bubble Tea as nil messages
+	eventBus.Subscribe(game.EventAll, func(e game.Event) {
+		if convertEventToMessage(e) == nil {
+			return
+		}
+		select {
+		case eventChan <- e:
+			// Event queued successfully
+		default:
+			// Channel full, drop event (prevents blocking game logic)
+		}
+	})
 }
 
+// gameEventBuffer is how many game events can wait for Update before more
+// are dropped
+const gameEventBuffer = 64
+
 // waitForNextEvent returns a command that waits for the next game event.
 // This should be called after processing each event to keep listening.
 func waitForNextEvent(eventChan <-chan game.Event) tea.Cmd {
@@ -1281,28 +2224,21 @@ func waitForNextEvent(eventChan <-chan game.Event) tea.Cmd {
 //   - tea.Msg: The corresponding Bubble Tea message
 func convertEventToMessage(event game.Event) tea.Msg {
 	switch event.Type {
-	case game.EventCommit:
-		// Extract commit event data
+	case game.EventCommitScored:
+		// Extract the scored commit's data (the game handler publishes it
+		// once the XP is awarded)
 		sha, _ := event.Data["sha"].(string)
 		message, _ := event.Data["message"].(string)
-		linesAdded, _ := event.Data["lines_added"].(int)
-		linesRemoved, _ := event.Data["lines_removed"].(int)
-
-		// Calculate XP awarded (simplified - actual XP comes from handler)
-		// For display purposes, we'll estimate it
-		// In reality, the handler already calculated and applied it
-		// We're just showing a notification, so approximate XP is fine
-		baseXP := (linesAdded + linesRemoved) * 5
-		if baseXP < 10 {
-			baseXP = 10 // Minimum XP
-		}
+		repoPath, _ := event.Data["repo_path"].(string)
+		xpAwarded, _ := event.Data["xp_awarded"].(int)
+		held, _ := event.Data["held"].(bool)
 
 		return commitDetectedMsg{
-			sha:          sha,
-			message:      message,
-			xpAwarded:    baseXP,
-			linesAdded:   linesAdded,
-			linesRemoved: linesRemoved,
+			sha:       sha,
+			message:   message,
+			repoPath:  repoPath,
+			xpAwarded: xpAwarded,
+			held:      held,
 		}
 
 	case game.EventLevelUp:
@@ -1322,11 +2258,15 @@ func convertEventToMessage(event game.Event) tea.Msg {
 		questID, _ := event.Data["quest_id"].(string)
 		questTitle, _ := event.Data["quest_title"].(string)
 		xpReward, _ := event.Data["xp_reward"].(int)
+		rewardPending, _ := event.Data["reward_pending"].(bool)
+		breakdown, _ := event.Data["xp_breakdown"].(game.XPBreakdown)
 
 		return questCompleteMsg{
-			questID:   questID,
-			questName: questTitle,
-			xpAwarded: xpReward,
+			questID:       questID,
+			questName:     questTitle,
+			xpAwarded:     xpReward,
+			xpBreakdown:   breakdown,
+			rewardPending: rewardPending,
 		}
 
 	case game.EventQuestStart:
@@ -1341,6 +2281,134 @@ func convertEventToMessage(event game.Event) tea.Msg {
 			questType: questType,
 		}
 
+	case game.EventQuestProgress:
+		// Extract quest progress event data
+		questID, _ := event.Data["quest_id"].(string)
+		questTitle, _ := event.Data["quest_title"].(string)
+		current, _ := event.Data["current"].(int)
+		target, _ := event.Data["target"].(int)
+
+		return questProgressMsg{
+			questID:   questID,
+			questName: questTitle,
+			current:   current,
+			target:    target,
+		}
+
+	case game.EventEffortEarned, game.EventEffortSpent:
+		// Extract effort event data
+		amount, _ := event.Data["amount"].(int)
+		balance, _ := event.Data["balance"].(int)
+		reason, _ := event.Data["reason"].(string)
+
+		return effortChangedMsg{
+			spent:   event.Type == game.EventEffortSpent,
+			amount:  amount,
+			balance: balance,
+			reason:  reason,
+		}
+
+	case game.EventRecordBroken:
+		// Extract personal best event data
+		record, _ := event.Data["record"].(string)
+		previous, _ := event.Data["previous"].(string)
+		current, _ := event.Data["current"].(string)
+
+		return recordBrokenMsg{record: game.RecordBreak{
+			Kind:     game.RecordKind(record),
+			Previous: previous,
+			Current:  current,
+		}}
+
+	case game.EventAchievement:
+		// Extract achievement event data
+		id, _ := event.Data["achievement_id"].(string)
+		name, _ := event.Data["achievement_name"].(string)
+
+		return achievementMsg{id: id, name: name}
+
+	case game.EventQuestCrown:
+		// Extract the crowned week and crown count
+		week, _ := event.Data["week"].(string)
+		crowns, _ := event.Data["crowns"].(int)
+
+		return questCrownMsg{week: week, crowns: crowns}
+
+	case game.EventLateNight:
+		// Extract the late nights this week
+		nights, _ := event.Data["nights"].(int)
+
+		return lateNightMsg{nights: nights}
+
+	case game.EventLootDrop:
+		// Extract the dropped item
+		drop, _ := event.Data["drop"].(game.LootDrop)
+
+		return lootDropMsg{drop: drop}
+
+	case game.EventSeasonTier:
+		// Extract the season and the unlocked tier
+		season, _ := event.Data["season"].(string)
+		tier, _ := event.Data["tier"].(int)
+		reward, _ := event.Data["reward"].(string)
+
+		return seasonTierMsg{season: season, tier: tier, reward: reward}
+
+	case game.EventQuestTemplates:
+		// Extract the reloaded templates and any problems loading them
+		templates, _ := event.Data["templates"].([]game.QuestTemplate)
+		problems, _ := event.Data["problems"].([]string)
+
+		return questTemplatesMsg{templates: templates, problems: problems}
+
+	case game.EventStreakBroken:
+		// Extract broken streak event data
+		lostDays, _ := event.Data["lost_days"].(int)
+		redeemableDays, _ := event.Data["redeemable_days"].(int)
+
+		return streakBrokenMsg{lostDays: lostDays, redeemableDays: redeemableDays}
+
+	case game.EventXPFlagged:
+		// Extract flagged XP event data
+		message, _ := event.Data["message"].(string)
+		xp, _ := event.Data["xp"].(int)
+		reasons, _ := event.Data["reasons"].([]string)
+
+		return xpFlaggedMsg{message: message, xp: xp, reasons: reasons}
+
+	case game.EventTodoSuggestions:
+		// Extract the comments found by the scan
+		todos, _ := event.Data["todos"].([]game.TodoComment)
+
+		return todoSuggestionsMsg{todos: todos}
+
+	case game.EventTaskListSynced:
+		// Extract the titles of the quests added
+		added, _ := event.Data["added"].([]string)
+
+		return taskListSyncedMsg{added: added}
+
+	case game.EventWIPNudge:
+		// Extract the work the quests added are for
+		work, _ := event.Data["work"].([]game.UncommittedWork)
+
+		return wipNudgeMsg{work: work}
+
+	case game.EventQuestsGenerated:
+		// Extract the quests drawn and expired
+		added, _ := event.Data["added"].([]string)
+		expired, _ := event.Data["expired"].([]string)
+
+		return questsGeneratedMsg{added: added, expired: expired}
+
+	case game.EventTaskTicked:
+		// Extract the ticked item and where the change went
+		task, _ := event.Data["task"].(game.TaskItem)
+		commit, _ := event.Data["commit"].(string)
+		patch, _ := event.Data["patch"].(string)
+
+		return taskTickedMsg{task: task, commit: commit, patch: patch}
+
 	default:
 		// Unknown event type - return nil message
 		return nil
@@ -1349,14 +2417,16 @@ func convertEventToMessage(event game.Event) tea.Msg {
 
 // Note on Event Bridge Design:
 //
-// This implementation uses a simplified approach where listenForGameEvents
+// This implementation uses a simplified approach where waitForNextEvent
 // returns after receiving ONE event. To continue listening, the Update method
-// needs to call listenForGameEvents again after processing each event.
+// needs to call waitForNextEvent again after processing each event. Events
+// published meanwhile (a commit's XP, level-up and quest events arrive
+// together) wait on the channel, which subscribeGameEvents fills.
 //
 // This is the correct Bubble Tea pattern:
-// 1. Init() calls listenForGameEvents()
+// 1. Init() calls waitForNextEvent()
 // 2. First event arrives, Update() is called
-// 3. Update() processes the event and returns listenForGameEvents() as a cmd
+// 3. Update() processes the event and returns waitForNextEvent() as a cmd
 // 4. Next event arrives, Update() is called again
 // 5. Repeat...
 //
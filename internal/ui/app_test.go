@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestSetEventBus tests that every event of a burst published on a shared
+// bus reaches Update in order, not just the first.
+func TestSetEventBus(t *testing.T) {
+	model := NewModel(nil, config.DefaultConfig(), "test")
+	bus := game.NewEventBus()
+	model.SetEventBus(bus)
+
+	// A commit's handling publishes its level-up and quest completion at once
+	bus.Publish(game.NewCommitScoredEvent(game.NewCommitEvent("abc1234def", "feat: burst", 1, 10, 0), 20, false))
+	bus.Publish(game.NewLevelUpEvent("character", 4, 5))
+	bus.Publish(game.NewQuestDoneEvent("quest", "Burst Quest", 100))
+
+	wantTypes := []string{"commit", "level up", "quest done"}
+	for _, want := range wantTypes {
+		msg := waitForNextEvent(model.gameEvents)()
+		var got string
+		switch msg.(type) {
+		case commitDetectedMsg:
+			got = "commit"
+		case levelUpMsg:
+			got = "level up"
+		case questCompleteMsg:
+			got = "quest done"
+		default:
+			got = "unexpected"
+		}
+		if got != want {
+			t.Fatalf("next event = %s (%T), want %s", got, msg, want)
+		}
+	}
+}
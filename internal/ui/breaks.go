@@ -0,0 +1,153 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements break reminders: after a stretch of active session
+// time a warning banner suggests stepping away, which the player can take
+// (pausing the timer and earning Wellness), snooze or skip.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// breakReminder is the open break reminder banner.
+type breakReminder struct {
+	sessionTime time.Duration // Active session time when the reminder fired
+}
+
+// breakInterval returns the active session time between reminders
+// (0 when reminders are off).
+func (m Model) breakInterval() time.Duration {
+	if m.config == nil || !m.config.Tracking.BreakReminders {
+		return 0
+	}
+	return time.Duration(m.config.Tracking.BreakIntervalMins) * time.Minute
+}
+
+// checkBreakReminder opens the break reminder once the running session
+// reaches the next scheduled break. Called on every timer tick.
+//
+// Parameters:
+//   - elapsed: Active session time so far
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) checkBreakReminder(elapsed time.Duration) Model {
+	interval := m.breakInterval()
+	if interval <= 0 || m.breakReminder != nil || m.character == nil {
+		return m
+	}
+
+	// Breaks are never more than one interval away, so a larger gap means
+	// the session restarted and the schedule starts over
+	if m.nextBreakAt == 0 || m.nextBreakAt-elapsed > interval {
+		m.nextBreakAt = interval
+	}
+
+	if elapsed >= m.nextBreakAt {
+		m.breakReminder = &breakReminder{sessionTime: elapsed}
+	}
+	return m
+}
+
+// handleBreakReminderKeys answers the break reminder: Enter takes the break,
+// Z snoozes it and Esc skips it. Other keys are ignored until it's answered.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands once answered
+func (m Model) handleBreakReminderKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter", "b":
+		return m.answerBreak(game.BreakTaken)
+	case "z":
+		return m.answerBreak(game.BreakSnoozed)
+	case "esc", "x":
+		return m.answerBreak(game.BreakSkipped)
+	}
+	return m, nil
+}
+
+// answerBreak logs the answer, schedules the next reminder and, for a taken
+// break, pauses the session timer.
+//
+// Parameters:
+//   - outcome: How the reminder was answered
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) answerBreak(outcome game.BreakOutcome) (tea.Model, tea.Cmd) {
+	reminder := m.breakReminder
+	m.breakReminder = nil
+	if reminder == nil || m.character == nil {
+		return m, nil
+	}
+
+	wellness := m.character.RecordBreak(outcome, reminder.sessionTime, time.Now())
+
+	var timeLogCmd tea.Cmd
+
+	var message string
+	switch outcome {
+	case game.BreakTaken:
+		m.nextBreakAt = reminder.sessionTime + m.breakInterval()
+		if m.sessionTracker != nil {
+			// Best effort: the break still counts if the timer can't pause
+			if m.sessionTracker.Pause() == nil {
+				timeLogCmd = m.endTimeStretch()
+			}
+		}
+		message = fmt.Sprintf("🧘 Enjoy your break! +%d Wellness\nTimer paused. Ctrl+T to resume", wellness)
+	case game.BreakSnoozed:
+		snooze := time.Duration(m.config.Tracking.BreakSnoozeMins) * time.Minute
+		m.nextBreakAt = reminder.sessionTime + snooze
+		message = fmt.Sprintf("⏰ Break snoozed for %d minutes", m.config.Tracking.BreakSnoozeMins)
+	default:
+		m.nextBreakAt = reminder.sessionTime + m.breakInterval()
+		message = "Break skipped. Remember to stretch!"
+	}
+
+	m.addNotification(Notification{
+		Message:   message,
+		Type:      NotificationInfo,
+		Duration:  3 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, tea.Batch(m.saveStateCmd(), timeLogCmd, m.showNextNotification())
+}
+
+// viewBreakReminder renders the break reminder as a warning banner above the
+// current screen.
+//
+// Parameters:
+//   - mainContent: The rendered screen underneath
+//
+// Returns:
+//   - string: The screen with the banner on top
+func (m Model) viewBreakReminder(mainContent string) string {
+	minutes := int(m.breakReminder.sessionTime.Minutes())
+	banner := m.renderNotification(Notification{
+		Message: fmt.Sprintf("Time for a break! You've been coding for %d minutes.\n%s  %s  %s",
+			minutes,
+			RenderKeybind("Enter", "Take break"),
+			RenderKeybind("Z", "Snooze"),
+			RenderKeybind("Esc", "Skip"),
+		),
+		Type: NotificationWarning,
+	})
+
+	centered := lipgloss.NewStyle().
+		Width(m.width).
+		Align(lipgloss.Center).
+		Render(banner)
+
+	return lipgloss.JoinVertical(lipgloss.Left, "", centered, "", mainContent)
+}
@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestCheckBreakReminder tests when the break reminder opens during a session.
+func TestCheckBreakReminder(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		nextBreakAt time.Duration
+		elapsed     time.Duration
+		wantOpen    bool
+		wantNext    time.Duration
+	}{
+		{"before first interval", true, 0, 49 * time.Minute, false, 50 * time.Minute},
+		{"first interval reached", true, 0, 50 * time.Minute, true, 50 * time.Minute},
+		{"snoozed reminder due", true, 60 * time.Minute, 60 * time.Minute, true, 60 * time.Minute},
+		{"session restarted", true, 100 * time.Minute, 10 * time.Minute, false, 50 * time.Minute},
+		{"reminders disabled", false, 0, 2 * time.Hour, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Tracking.BreakReminders = tt.enabled
+			m := Model{config: cfg, character: game.NewCharacter("Tester"), nextBreakAt: tt.nextBreakAt}
+
+			m = m.checkBreakReminder(tt.elapsed)
+			if (m.breakReminder != nil) != tt.wantOpen {
+				t.Errorf("reminder open = %v, want %v", m.breakReminder != nil, tt.wantOpen)
+			}
+			if m.nextBreakAt != tt.wantNext {
+				t.Errorf("nextBreakAt = %v, want %v", m.nextBreakAt, tt.wantNext)
+			}
+		})
+	}
+}
+
+// TestBreakReminderKeys tests taking, snoozing and skipping a reminder.
+func TestBreakReminderKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          tea.KeyMsg
+		wantOutcome  game.BreakOutcome
+		wantWellness int
+		wantNext     time.Duration
+	}{
+		{"enter takes the break", tea.KeyMsg{Type: tea.KeyEnter}, game.BreakTaken, game.BreakWellnessBonus, 100 * time.Minute},
+		{"z snoozes", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}}, game.BreakSnoozed, 0, 60 * time.Minute},
+		{"esc skips", tea.KeyMsg{Type: tea.KeyEsc}, game.BreakSkipped, 0, 100 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			m := Model{keys: NewKeyMap(), config: config.DefaultConfig(), character: character, width: 100, height: 40}
+			m.breakReminder = &breakReminder{sessionTime: 50 * time.Minute}
+
+			if view := m.View(); !strings.Contains(view, "Time for a break") {
+				t.Error("view should show the break reminder")
+			}
+
+			// Other keys wait for an answer
+			model, _ := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+			if model.(Model).breakReminder == nil {
+				t.Fatal("reminder should stay open until answered")
+			}
+
+			model, cmd := m.handleKeyPress(tt.key)
+			m = model.(Model)
+			if cmd == nil {
+				t.Error("answering should save state")
+			}
+			if m.breakReminder != nil {
+				t.Error("reminder should close once answered")
+			}
+			if len(character.BreakLog) != 1 || character.BreakLog[0].Outcome != tt.wantOutcome {
+				t.Errorf("BreakLog = %+v, want one %s record", character.BreakLog, tt.wantOutcome)
+			}
+			if character.Wellness != tt.wantWellness {
+				t.Errorf("Wellness = %d, want %d", character.Wellness, tt.wantWellness)
+			}
+			if m.nextBreakAt != tt.wantNext {
+				t.Errorf("nextBreakAt = %v, want %v", m.nextBreakAt, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the announcements for the quest catalog: daily and
+// weekly quests drawn for the day or week, and generated quests that
+// expired unfinished.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// questsGeneratedMsg is sent when the quest catalog drew new quests, or
+// generated quests expired.
+type questsGeneratedMsg struct {
+	added   []string // Titles of the quests drawn
+	expired []string // Titles of the quests that expired
+}
+
+// announceGeneratedQuests queues a toast listing the quests the catalog
+// drew, and one for generated quests that expired before they were done.
+//
+// Parameters:
+//   - msg: The draw
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) announceGeneratedQuests(msg questsGeneratedMsg) Model {
+	if len(msg.added) > 0 {
+		m.recordActivity("🎲", fmt.Sprintf("%d new quests on the Quest Board", len(msg.added)))
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("🎲 New quests on the Quest Board\n%s", strings.Join(msg.added, "\n")),
+			Type:      NotificationInfo,
+			Duration:  4 * time.Second,
+			Timestamp: time.Now(),
+		})
+	}
+	if len(msg.expired) > 0 {
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("⌛ Time ran out on\n%s", strings.Join(msg.expired, "\n")),
+			Type:      NotificationInfo,
+			Duration:  4 * time.Second,
+			Timestamp: time.Now(),
+		})
+	}
+	return m
+}
@@ -0,0 +1,157 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file provides clipboard integration for copying AI responses and
+// commit SHAs, using OSC 52 (works over SSH) plus the native clipboard.
+package ui
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// codeFencePattern matches fenced markdown code blocks and captures their body
+var codeFencePattern = regexp.MustCompile("(?s)```[^\\n]*\\n(.*?)```")
+
+// clipboardCopiedMsg reports the result of a clipboard copy.
+type clipboardCopiedMsg struct {
+	what string // Human-readable description of what was copied
+	err  error  // Non-nil if every clipboard mechanism failed
+}
+
+// copyToClipboardCmd copies text to the clipboard asynchronously.
+// The OSC 52 escape sequence is always emitted so copying works in remote
+// sessions; the native clipboard is tried as well for terminals without
+// OSC 52 support. The copy fails only if both mechanisms fail.
+//
+// Parameters:
+//   - text: The text to copy
+//   - what: Description used in the confirmation toast (e.g. "commit SHA")
+//
+// Returns:
+//   - tea.Cmd: Command that performs the copy and returns clipboardCopiedMsg
+func copyToClipboardCmd(text, what string) tea.Cmd {
+	return func() tea.Msg {
+		if text == "" {
+			return clipboardCopiedMsg{what: what, err: fmt.Errorf("nothing to copy")}
+		}
+
+		seq := osc52.New(text)
+		if os.Getenv("TMUX") != "" {
+			seq = seq.Tmux()
+		}
+		_, oscErr := seq.WriteTo(os.Stderr)
+
+		nativeErr := clipboard.WriteAll(text)
+
+		if oscErr != nil && nativeErr != nil {
+			return clipboardCopiedMsg{what: what, err: nativeErr}
+		}
+		return clipboardCopiedMsg{what: what}
+	}
+}
+
+// clipboardNotification builds the confirmation toast for a copy result.
+func clipboardNotification(msg clipboardCopiedMsg) Notification {
+	if msg.err != nil {
+		return Notification{
+			Message:   fmt.Sprintf("Copy failed: %v", msg.err),
+			Type:      NotificationError,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		}
+	}
+
+	return Notification{
+		Message:   fmt.Sprintf("📋 Copied %s to clipboard", msg.what),
+		Type:      NotificationSuccess,
+		Duration:  2 * time.Second,
+		Timestamp: time.Now(),
+	}
+}
+
+// extractCodeBlocks returns the bodies of all fenced code blocks in markdown.
+//
+// Parameters:
+//   - markdown: Markdown text (e.g. an AI response)
+//
+// Returns:
+//   - []string: Code block contents without fences, in order of appearance
+func extractCodeBlocks(markdown string) []string {
+	matches := codeFencePattern.FindAllStringSubmatch(markdown, -1)
+	blocks := make([]string, 0, len(matches))
+	for _, match := range matches {
+		blocks = append(blocks, strings.TrimRight(match[1], "\n"))
+	}
+	return blocks
+}
+
+// copyableResponse picks what to copy from an AI response: its code blocks
+// if it has any (joined by blank lines), otherwise the full response.
+//
+// Returns:
+//   - string: The text to copy
+//   - string: Description for the confirmation toast
+func copyableResponse(content string) (string, string) {
+	blocks := extractCodeBlocks(content)
+	switch len(blocks) {
+	case 0:
+		return content, "response"
+	case 1:
+		return blocks[0], "code block"
+	default:
+		return strings.Join(blocks, "\n\n"), fmt.Sprintf("%d code blocks", len(blocks))
+	}
+}
+
+// copyLastCommitSHA copies the most recently detected commit SHA.
+func (m Model) copyLastCommitSHA() (tea.Model, tea.Cmd) {
+	if m.lastCommitSHA == "" {
+		m.addNotification(Notification{
+			Message:   "No commits detected this session",
+			Type:      NotificationInfo,
+			Duration:  2 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m, m.showNextNotification()
+	}
+
+	return m, copyToClipboardCmd(m.lastCommitSHA, "commit SHA "+shortSHA(m.lastCommitSHA))
+}
+
+// copyLastResponse copies the latest mentor response (or its code blocks).
+func (m Model) copyLastResponse() (tea.Model, tea.Cmd) {
+	var response screens.Message
+	found := false
+	if m.mentorScreen != nil {
+		response, found = m.mentorScreen.LastResponse()
+	}
+
+	if !found {
+		m.addNotification(Notification{
+			Message:   "No mentor response to copy yet",
+			Type:      NotificationInfo,
+			Duration:  2 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m, m.showNextNotification()
+	}
+
+	text, what := copyableResponse(response.Content)
+	return m, copyToClipboardCmd(text, what)
+}
+
+// shortSHA returns the 7-character abbreviated form of a commit SHA.
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"testing"
+)
+
+// TestCopyableResponse tests choosing code blocks over full responses
+func TestCopyableResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantText string
+		wantWhat string
+	}{
+		{
+			name:     "plain response",
+			content:  "Use a map for lookups.",
+			wantText: "Use a map for lookups.",
+			wantWhat: "response",
+		},
+		{
+			name:     "single code block",
+			content:  "Try this:\n\n```go\nfmt.Println(\"hi\")\n```\n\nDone.",
+			wantText: "fmt.Println(\"hi\")",
+			wantWhat: "code block",
+		},
+		{
+			name:     "multiple code blocks",
+			content:  "```\na := 1\n```\nthen\n```bash\ngo test ./...\n```",
+			wantText: "a := 1\n\ngo test ./...",
+			wantWhat: "2 code blocks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, what := copyableResponse(tt.content)
+			if text != tt.wantText {
+				t.Errorf("copyableResponse() text = %q, want %q", text, tt.wantText)
+			}
+			if what != tt.wantWhat {
+				t.Errorf("copyableResponse() what = %q, want %q", what, tt.wantWhat)
+			}
+		})
+	}
+}
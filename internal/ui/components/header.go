@@ -115,7 +115,7 @@ func renderRightSection(char *game.Character) string {
 		Bold(true)
 
 	name := nameStyle.Render(char.Name)
-	level := levelStyle.Render(fmt.Sprintf("Lvl %d", char.Level))
+	level := levelStyle.Render(fmt.Sprintf("Lvl %d · %s", char.Level, char.Rank().Name))
 
 	// Join with a space
 	return name + " " + level
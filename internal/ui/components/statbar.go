@@ -110,7 +110,7 @@ func renderHeaderSection(char *game.Character, width int) string {
 		Padding(0, 1)
 
 	nameAndLevel := nameStyle.Render(char.Name) + " " +
-		levelStyle.Render(fmt.Sprintf("Lv.%d", char.Level))
+		levelStyle.Render(fmt.Sprintf("Lv.%d %s", char.Level, char.Rank().Name))
 
 	// XP Progress Bar
 	// Calculate available width for the progress bar (reserve space for text)
@@ -307,7 +307,7 @@ func RenderStatBadge(char *game.Character) string {
 		Bold(true)
 
 	badge := fmt.Sprintf("%s | %s XP: %d/%d | %s %d🔥",
-		levelStyle.Render(fmt.Sprintf("Lv.%d", char.Level)),
+		levelStyle.Render(fmt.Sprintf("Lv.%d %s", char.Level, char.Rank().Name)),
 		ui.StatLabelStyle.Render(""),
 		char.XP,
 		char.XPToNextLevel,
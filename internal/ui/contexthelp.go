@@ -0,0 +1,189 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements contextual help: besides the keybindings, the help
+// overlay explains the live state of the focused element (the selected
+// quest's progress rules, or the XP formula and active multipliers on the
+// XP bar). Explanations come from a registry keyed by focused element.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// helpFocus identifies the element the help overlay explains.
+type helpFocus int
+
+const (
+	helpFocusNone  helpFocus = iota // Nothing focused (keybindings only)
+	helpFocusQuest                  // Selected quest on the board, or the open quest detail
+	helpFocusXPBar                  // XP bar on the dashboard and character sheet
+)
+
+// helpTopic is a contextual explanation shown below the keybindings.
+type helpTopic struct {
+	Title string   // Heading for the explanation
+	Lines []string // Explanation lines, built from live state
+}
+
+// helpRegistry maps each focusable element to the function explaining its
+// current state. Add an entry (and a case in focusedElement) to give another
+// element contextual help.
+var helpRegistry = map[helpFocus]func(m Model, now time.Time) (helpTopic, bool){
+	helpFocusQuest: questHelpTopic,
+	helpFocusXPBar: xpBarHelpTopic,
+}
+
+// questProgressRules explains how each quest type's progress is computed.
+var questProgressRules = map[game.QuestType]string{
+	game.QuestTypeCommit:      "Each commit adds 1.",
+	game.QuestTypeLines:       "Each commit adds its lines changed (added + removed). Run `codequest quests reconcile` to recount from git history.",
+	game.QuestTypeDaily:       "Each commit today adds 1. Progress resets when a new day starts.",
+	game.QuestTypeStreak:      "Progress mirrors your current streak of consecutive coding days.",
+	game.QuestTypeDocs:        "Each commit adds the number of markdown files it changed.",
+	game.QuestTypeTests:       "Each commit adds the number of test files it changed (_test, test_, .test. and .spec. files, or files under a test directory).",
+	game.QuestTypeDeps:        "Each commit that updates go.mod adds 1.",
+	game.QuestTypeMonthly:     "Each go.mod dependency bumped adds 1. Progress resets when a new month starts.",
+	game.QuestTypeReview:      "Each pull request you approve on GitHub after starting the quest adds 1.",
+	game.QuestTypeTodo:        "Completes when a commit removes the TODO/FIXME comment it was created from.",
+	game.QuestTypeTask:        "Completes when you tick its item in the repository's task list, or a commit message mentions the item.",
+	game.QuestTypeWIP:         "Completes once the repository's uncommitted work is committed or stashed.",
+	game.QuestTypePeerReview:  "Each teammate commit that lands with a Reviewed-by trailer (or git note) naming you adds 1.",
+	game.QuestTypePairing:     "Each of your commits with a teammate in a Reviewed-by or Co-authored-by trailer adds 1.",
+	game.QuestTypeWellFormed:  "Each commit with a conventional commit message (\"type(scope): description\", subject of 72 characters or fewer) adds 1. Try `codequest suggest-commit-message`.",
+	game.QuestTypeOpenSource:  "Each commit to a GitHub repository owned by someone outside git.own_accounts adds 1.",
+	game.QuestTypeQuestStreak: "Progress mirrors your quest streak: consecutive days with at least one other quest completed.",
+}
+
+// focusedElement reports which element the help overlay should explain.
+//
+// Returns:
+//   - helpFocus: The focused element (helpFocusNone if nothing has contextual help)
+func (m Model) focusedElement() helpFocus {
+	switch m.currentScreen {
+	case ScreenQuestBoard:
+		if m.focusedQuest() != nil {
+			return helpFocusQuest
+		}
+	case ScreenDashboard, ScreenCharacter:
+		if m.character != nil {
+			return helpFocusXPBar
+		}
+	}
+	return helpFocusNone
+}
+
+// focusedQuest returns the quest open in the detail view, or else the
+// quest selected on the board.
+func (m Model) focusedQuest() *game.Quest {
+	if m.questDetail != nil {
+		return m.questDetail
+	}
+	return m.selectedBoardQuest()
+}
+
+// contextHelp looks up the explanation for the focused element.
+//
+// Parameters:
+//   - now: Current time (for time-limited multipliers and daily budgets)
+//
+// Returns:
+//   - helpTopic: The explanation
+//   - bool: False when the focused element has no contextual help
+func (m Model) contextHelp(now time.Time) (helpTopic, bool) {
+	explain, ok := helpRegistry[m.focusedElement()]
+	if !ok {
+		return helpTopic{}, false
+	}
+	return explain(m, now)
+}
+
+// questHelpTopic explains the focused quest's type and how its progress is
+// computed.
+func questHelpTopic(m Model, now time.Time) (helpTopic, bool) {
+	quest := m.focusedQuest()
+	if quest == nil {
+		return helpTopic{}, false
+	}
+
+	rule, ok := questProgressRules[quest.Type]
+	if !ok {
+		rule = "This quest type isn't tracked automatically yet."
+	}
+
+	lines := []string{
+		fmt.Sprintf("Type: %s quest", quest.Type),
+		rule,
+		fmt.Sprintf("Progress: %d/%d (%d%%)", quest.Current, quest.Target, int(quest.Progress*100)),
+	}
+	if quest.Project != "" {
+		lines = append(lines, fmt.Sprintf("Only commits in project %q count.", quest.Project))
+	}
+
+	switch quest.Status {
+	case game.QuestAvailable:
+		lines = append(lines, "Progress starts counting once the quest is started.")
+	case game.QuestCompleted:
+		lines = append(lines, "Completed: progress no longer changes.")
+	case game.QuestFailed:
+		lines = append(lines, "Failed: progress no longer changes.")
+	}
+
+	return helpTopic{Title: "About: " + quest.Title, Lines: lines}, true
+}
+
+// xpBarHelpTopic explains the XP formula and the multipliers active right
+// now, in the order commit XP applies them.
+func xpBarHelpTopic(m Model, now time.Time) (helpTopic, bool) {
+	c := m.character
+	if c == nil {
+		return helpTopic{}, false
+	}
+
+	difficulty := game.DifficultyNormal
+	replayRate := 0.0
+	if m.config != nil {
+		if m.config.Game.Difficulty != "" {
+			difficulty = m.config.Game.Difficulty
+		}
+		replayRate = m.config.Git.ReplayXPRate
+	}
+
+	// The base XP and line bonus cap come from the reward engine (a commit
+	// far past the cap), the multipliers from the functions it applies
+	const sample = 1000
+	capped := game.EstimateCommitXP(sample, 0, m.config, c, now)
+	baseXP, maxBonus := capped.Commit, capped.Lines
+	difficultyRate := float64(game.ApplyDifficultyMultiplier(sample, difficulty)) / sample
+	wisdomRate := game.WisdomMultiplier(c.Wisdom)
+
+	lines := []string{
+		fmt.Sprintf("Level %d (%s): %d/%d XP to the next level.", c.Level, c.Rank().Name, c.XP, c.XPToNextLevel),
+		fmt.Sprintf("Commit XP: %d base + 1 per line changed (line bonus capped at %d).", baseXP, maxBonus),
+		fmt.Sprintf("× %.2f difficulty (%s)", difficultyRate, difficulty),
+		fmt.Sprintf("× %.2f wisdom (%d Wisdom)", wisdomRate, c.Wisdom),
+	}
+	if c.XPBoostActive(now) {
+		lines = append(lines, fmt.Sprintf("+ %d%% quest reward boost until %s", game.XPBoostPercent, c.XPBoostUntil.Local().Format("Jan 2 15:04")))
+	}
+	lines = append(lines, fmt.Sprintf("× %.2f for commits replayed after downtime", replayRate))
+
+	budget := game.XPBudgetFromConfig(m.config)
+	remaining, rested := c.XPBudgetRemaining(budget, now)
+	if budget.FullRateCommits > 0 {
+		lines = append(lines, fmt.Sprintf("Diminishing returns after %d commits a day.", budget.FullRateCommits))
+	}
+	if remaining >= 0 {
+		lines = append(lines, fmt.Sprintf("Daily cap: %d of %d commit XP left today.", remaining, budget.DailyCap))
+	}
+	if rested > 0 {
+		lines = append(lines, fmt.Sprintf("Rested bonus: the next %d XP earned is doubled.", rested))
+	}
+
+	// The example goes through the reward engine, budget included
+	example := game.EstimateCommitXP(20, 0, m.config, c, now)
+	lines = append(lines, fmt.Sprintf("A 20-line commit earns about %d XP right now.", example.Total))
+
+	return helpTopic{Title: "About: XP bar", Lines: lines}, true
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestContextHelp tests that the help overlay explains the focused element
+// from live state.
+func TestContextHelp(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	linesQuest := game.NewQuest("Line Smith", "Change 100 lines", game.QuestTypeLines, 100, 50, 1)
+	linesQuest.Project = "work"
+	commitQuest := game.NewQuest("First Steps", "Make 3 commits", game.QuestTypeCommit, 3, 50, 1)
+
+	boosted := game.NewCharacter("Tester")
+	boosted.Wisdom = 20
+	boosted.XPBoostUntil = now.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		screen    Screen
+		character *game.Character
+		detail    *game.Quest
+		wantFocus helpFocus
+		want      []string
+	}{
+		{
+			name:      "selected quest on the board",
+			screen:    ScreenQuestBoard,
+			character: game.NewCharacter("Tester"),
+			wantFocus: helpFocusQuest,
+			want:      []string{"About: Line Smith", "Type: lines quest", "lines changed", `project "work"`, "once the quest is started"},
+		},
+		{
+			name:      "open quest detail",
+			screen:    ScreenQuestBoard,
+			character: game.NewCharacter("Tester"),
+			detail:    commitQuest,
+			wantFocus: helpFocusQuest,
+			want:      []string{"About: First Steps", "Each commit adds 1."},
+		},
+		{
+			name:      "XP bar with active multipliers",
+			screen:    ScreenDashboard,
+			character: boosted,
+			wantFocus: helpFocusXPBar,
+			want:      []string{"About: XP bar", "× 1.20 difficulty (easy)", "× 1.10 wisdom (20 Wisdom)", "quest reward boost", "Daily cap: 500 of 500", "A 20-line commit earns about 60 XP"},
+		},
+		{
+			name:      "nothing focused on the mentor screen",
+			screen:    ScreenMentor,
+			character: game.NewCharacter("Tester"),
+			wantFocus: helpFocusNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{
+				keys:          NewKeyMap(),
+				character:     tt.character,
+				config:        &config.Config{Game: config.GameConfig{Difficulty: game.DifficultyEasy, DailyXPCap: 500}},
+				quests:        []*game.Quest{linesQuest, commitQuest},
+				currentScreen: tt.screen,
+				questDetail:   tt.detail,
+				width:         100,
+				height:        40,
+			}
+
+			if got := m.focusedElement(); got != tt.wantFocus {
+				t.Fatalf("focusedElement() = %v, want %v", got, tt.wantFocus)
+			}
+
+			topic, ok := m.contextHelp(now)
+			if ok != (len(tt.want) > 0) {
+				t.Fatalf("contextHelp() ok = %v", ok)
+			}
+			text := topic.Title + "\n" + strings.Join(topic.Lines, "\n")
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("help missing %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
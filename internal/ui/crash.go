@@ -0,0 +1,251 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the crash guard: panics in the model (Init, Update,
+// View and the commands they return) are recovered into a diagnostics
+// bundle, and a crash screen offers to open the bundle or file an issue
+// instead of dumping a stack trace over the terminal.
+package ui
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/crash"
+)
+
+// crashMsg reports a panic recovered in a command.
+type crashMsg struct {
+	value any    // The panic value
+	stack string // Stack trace of the panicking goroutine
+}
+
+// crashState is the guard's crash, shared between copies of the guard so
+// a panic in View (which can't return a new model) is kept.
+type crashState struct {
+	report     crash.Report
+	bundlePath string // Where the bundle was written ("" if that failed)
+	bundleErr  error  // Why the bundle couldn't be written
+	status     string // Result of the last action (open bundle, file issue)
+}
+
+// CrashGuard wraps the root model and turns panics into a crash screen
+// with a diagnostics bundle.
+type CrashGuard struct {
+	model   tea.Model
+	config  *config.Config
+	version string
+	logs    *crash.LogRing
+	dir     string // Bundle directory ("" for crash.BundleDir)
+
+	width, height int
+	state         *crashState // Set once the model has panicked
+}
+
+// NewCrashGuard wraps a model with panic recovery.
+//
+// Parameters:
+//   - model: The root model
+//   - cfg: Application config (redacted into the bundle)
+//   - version: CodeQuest version
+//   - logs: Recent log lines (nil for none)
+//
+// Returns:
+//   - CrashGuard: The wrapped model, to pass to tea.NewProgram
+func NewCrashGuard(model tea.Model, cfg *config.Config, version string, logs *crash.LogRing) CrashGuard {
+	return CrashGuard{model: model, config: cfg, version: version, logs: logs, state: &crashState{}}
+}
+
+// Crashed reports whether the model panicked.
+//
+// Returns:
+//   - string: Path of the diagnostics bundle ("" if it couldn't be written)
+//   - bool: True if the model panicked
+func (g CrashGuard) Crashed() (string, bool) {
+	return g.state.bundlePath, !g.state.report.Time.IsZero()
+}
+
+// Cleanup runs the wrapped model's cleanup as of when the program exited,
+// so the session timer that's stopped (and its last stretch logged) is the
+// one the app ended with.
+func (g CrashGuard) Cleanup() {
+	switch model := g.model.(type) {
+	case Model:
+		model.Cleanup()
+	case *Model:
+		model.Cleanup()
+	}
+}
+
+// Init initializes the wrapped model.
+func (g CrashGuard) Init() (cmd tea.Cmd) {
+	defer func() {
+		if r := recover(); r != nil {
+			g.recordCrash(r, string(debug.Stack()))
+			cmd = nil
+		}
+	}()
+	return g.guardCmd(g.model.Init())
+}
+
+// Update forwards messages to the wrapped model until it panics, then
+// handles the crash screen's keys.
+func (g CrashGuard) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		g.width, g.height = size.Width, size.Height
+	}
+	if crashed, ok := msg.(crashMsg); ok {
+		g.recordCrash(crashed.value, crashed.stack)
+	}
+	if _, crashed := g.Crashed(); crashed {
+		return g.handleCrashKeys(msg)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			g.recordCrash(r, string(debug.Stack()))
+			model, cmd = g, nil
+		}
+	}()
+	next, nextCmd := g.model.Update(msg)
+	g.model = next
+	return g, g.guardCmd(nextCmd)
+}
+
+// View renders the wrapped model, or the crash screen after a panic.
+func (g CrashGuard) View() (view string) {
+	if _, crashed := g.Crashed(); crashed {
+		return g.viewCrash()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			g.recordCrash(r, string(debug.Stack()))
+			view = g.viewCrash()
+		}
+	}()
+	return g.model.View()
+}
+
+// guardCmd wraps a command (and the commands of a batch it returns) so a
+// panic while it runs becomes a crashMsg.
+func (g CrashGuard) guardCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = crashMsg{value: r, stack: string(debug.Stack())}
+			}
+		}()
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			guarded := make(tea.BatchMsg, len(batch))
+			for i, inner := range batch {
+				guarded[i] = g.guardCmd(inner)
+			}
+			return guarded
+		}
+		return msg
+	}
+}
+
+// recordCrash writes the diagnostics bundle for the first panic; later
+// panics (e.g. the wrapped model failing again) are ignored.
+func (g CrashGuard) recordCrash(value any, stack string) {
+	if _, crashed := g.Crashed(); crashed {
+		return
+	}
+
+	g.state.report = crash.Report{
+		Panic:   fmt.Sprint(value),
+		Stack:   stack,
+		Version: g.version,
+		Time:    time.Now(),
+		Logs:    g.logs.Lines(),
+		Config:  crash.RedactConfig(g.config),
+	}
+	if g.config != nil {
+		g.state.report.Features = g.config.EnabledFeatures()
+	}
+
+	dir := g.dir
+	if dir == "" {
+		var err error
+		if dir, err = crash.BundleDir(); err != nil {
+			g.state.bundleErr = err
+			return
+		}
+	}
+	g.state.bundlePath, g.state.bundleErr = crash.WriteBundle(dir, g.state.report)
+}
+
+// handleCrashKeys handles keyboard input on the crash screen.
+//
+// Supports:
+//   - O: Open the diagnostics bundle
+//   - I: File a GitHub issue (opens the browser)
+//   - Q/Esc/Ctrl+C: Quit
+func (g CrashGuard) handleCrashKeys(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return g, nil
+	}
+
+	switch key.String() {
+	case "o", "O":
+		if g.state.bundlePath == "" {
+			return g, nil
+		}
+		g.state.status = "Opened the bundle"
+		if err := crash.Open(g.state.bundlePath); err != nil {
+			g.state.status = err.Error()
+		}
+	case "i", "I":
+		g.state.status = "Opened a new issue in your browser - attach the bundle there"
+		if err := crash.Open(crash.IssueURL(g.state.report, g.state.bundlePath)); err != nil {
+			g.state.status = err.Error()
+		}
+	case "q", "Q", "esc", "ctrl+c":
+		return g, tea.Quit
+	}
+	return g, nil
+}
+
+// viewCrash renders the crash screen.
+func (g CrashGuard) viewCrash() string {
+	title := ErrorTextStyle.Render("💥 CodeQuest crashed")
+	summary := TextStyle.Render(g.state.report.Summary())
+
+	var bundle string
+	if g.state.bundlePath != "" {
+		bundle = lipgloss.JoinVertical(lipgloss.Left,
+			InfoTextStyle.Render("A diagnostics bundle was saved to:"),
+			BoldTextStyle.Render(crash.Anonymize(g.state.bundlePath)),
+			MutedTextStyle.Render("Secrets, emails and your home directory are redacted."),
+		)
+	} else {
+		bundle = WarningTextStyle.Render(fmt.Sprintf("The diagnostics bundle couldn't be saved: %v", g.state.bundleErr))
+	}
+
+	options := []string{RenderKeybind("I", "File a GitHub issue"), RenderKeybind("Q", "Quit")}
+	if g.state.bundlePath != "" {
+		options = append([]string{RenderKeybind("O", "Open the bundle")}, options...)
+	}
+
+	sections := []string{
+		title, "", summary, "", bundle, "",
+		MutedTextStyle.Render("Progress since the last save may be lost."), "",
+		lipgloss.JoinVertical(lipgloss.Left, options...),
+	}
+	if g.state.status != "" {
+		sections = append(sections, "", InfoTextStyle.Render(g.state.status))
+	}
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+	return PlaceInCenter(g.width, g.height, box)
+}
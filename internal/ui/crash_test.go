@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// panicModel panics where it's told to.
+type panicModel struct {
+	inUpdate, inView bool
+	inCmd            bool
+}
+
+func (p panicModel) Init() tea.Cmd { return nil }
+
+func (p panicModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if p.inUpdate {
+		panic("update exploded")
+	}
+	if p.inCmd {
+		return p, tea.Batch(func() tea.Msg { panic("command exploded") })
+	}
+	return p, nil
+}
+
+func (p panicModel) View() string {
+	if p.inView {
+		panic("view exploded")
+	}
+	return "all good"
+}
+
+// TestCrashGuard tests that panics in Update, View and commands become the
+// crash screen with a diagnostics bundle.
+func TestCrashGuard(t *testing.T) {
+	tests := []struct {
+		name  string
+		model panicModel
+		want  string
+	}{
+		{"update", panicModel{inUpdate: true}, "update exploded"},
+		{"view", panicModel{inView: true}, "view exploded"},
+		{"command in a batch", panicModel{inCmd: true}, "command exploded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := NewCrashGuard(tt.model, config.DefaultConfig(), "v1.2.3", nil)
+			guard.dir = t.TempDir()
+
+			var model tea.Model = guard
+			model, cmd := model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+			// Run commands (and batches) the way Bubble Tea would
+			for cmd != nil {
+				msg := cmd()
+				if batch, ok := msg.(tea.BatchMsg); ok {
+					cmd = batch[0]
+					continue
+				}
+				model, cmd = model.Update(msg)
+			}
+			view := model.View()
+
+			path, crashed := model.(CrashGuard).Crashed()
+			if !crashed || path == "" {
+				t.Fatalf("Crashed() = %q, %v; want a bundle", path, crashed)
+			}
+			if !strings.Contains(view, "CodeQuest crashed") || !strings.Contains(view, tt.want) {
+				t.Errorf("view doesn't show the crash screen for %q:\n%s", tt.want, view)
+			}
+			bundle, err := os.ReadFile(path)
+			if err != nil || !strings.Contains(string(bundle), tt.want) || !strings.Contains(string(bundle), "v1.2.3") {
+				t.Errorf("bundle = %q, %v; want the panic and version", bundle, err)
+			}
+
+			if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}); cmd == nil {
+				t.Error("Q on the crash screen should quit")
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the Data screen, the raw storage inspector opened
+// with D on the dashboard in debug mode: ↑/↓ select a stored key, Enter
+// shows its value as pretty-printed JSON, X (pressed twice) deletes it and
+// R lists the keys again. Storage calls run in the background.
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// dataKeysMsg carries the keys listed from the storage backend.
+type dataKeysMsg struct {
+	keys []storage.StoredKey
+	err  error
+}
+
+// dataValueMsg carries a key's raw value.
+type dataValueMsg struct {
+	key   string
+	value string
+	err   error
+}
+
+// dataDeletedMsg reports a deleted key.
+type dataDeletedMsg struct {
+	key string
+	err error
+}
+
+// listDataKeysCmd lists the stored keys in the background.
+func listDataKeysCmd(storageClient *storage.Client) tea.Cmd {
+	return func() tea.Msg {
+		keys, err := storageClient.ListKeys()
+		return dataKeysMsg{keys: keys, err: err}
+	}
+}
+
+// readDataKeyCmd reads a key's value in the background.
+func readDataKeyCmd(storageClient *storage.Client, key string) tea.Cmd {
+	return func() tea.Msg {
+		value, err := storageClient.ReadKey(key)
+		return dataValueMsg{key: key, value: value, err: err}
+	}
+}
+
+// deleteDataKeyCmd deletes a key in the background.
+func deleteDataKeyCmd(storageClient *storage.Client, key string) tea.Cmd {
+	return func() tea.Msg {
+		return dataDeletedMsg{key: key, err: storageClient.DeleteKey(key)}
+	}
+}
+
+// debugEnabled reports whether debug mode is on, which shows the Data
+// screen.
+func (m Model) debugEnabled() bool {
+	return m.config != nil && m.config.Debug.Enabled
+}
+
+// openDataScreen switches to the Data screen, or explains how to turn on
+// debug mode.
+func (m Model) openDataScreen() (tea.Model, tea.Cmd) {
+	if !m.debugEnabled() {
+		return m.notifyAction("The Data screen needs debug mode (set enabled = true under [debug])", NotificationInfo)
+	}
+	if m.storage == nil {
+		return m.notifyAction("Storage isn't available", NotificationWarning)
+	}
+	return m.switchScreen(ScreenData)
+}
+
+// resetData clears the Data screen and starts listing keys.
+func (m *Model) resetData() tea.Cmd {
+	m.dataKeys = nil
+	m.dataErr = ""
+	m.dataOpenKey = ""
+	m.dataValue = ""
+	m.dataScroll = 0
+	m.dataConfirm = false
+	if m.storage == nil {
+		return nil
+	}
+	return listDataKeysCmd(m.storage)
+}
+
+// handleDataMsg applies a finished storage call to the Data screen.
+//
+// Parameters:
+//   - msg: dataKeysMsg, dataValueMsg or dataDeletedMsg
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: A new listing after a delete, and notifications
+func (m Model) handleDataMsg(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case dataKeysMsg:
+		m.dataKeys = msg.keys
+		if m.dataKeys == nil {
+			m.dataKeys = []storage.StoredKey{}
+		}
+		m.dataSelected = min(m.dataSelected, max(len(m.dataKeys)-1, 0))
+		m.dataErr = ""
+		if msg.err != nil {
+			m.dataErr = msg.err.Error()
+		}
+	case dataValueMsg:
+		if msg.err != nil {
+			m.dataErr = fmt.Sprintf("Can't read %s: %v", msg.key, msg.err)
+			return m, nil
+		}
+		m.dataErr = ""
+		m.dataOpenKey = msg.key
+		m.dataValue = prettyJSON(msg.value)
+		m.dataScroll = 0
+	case dataDeletedMsg:
+		if msg.err != nil {
+			m.dataErr = fmt.Sprintf("Can't delete %s: %v", msg.key, msg.err)
+			return m, nil
+		}
+		model, notify := m.notifyAction("🗑️ Deleted "+msg.key, NotificationSuccess)
+		m = model.(Model)
+		return m, tea.Batch(notify, listDataKeysCmd(m.storage))
+	}
+	return m, nil
+}
+
+// handleDataKeys handles keys on the Data screen.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Storage commands
+func (m Model) handleDataKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// A pending delete only survives until the next key
+	confirming := m.dataConfirm
+	m.dataConfirm = false
+
+	// The value viewer scrolls and closes back to the key list
+	if m.dataOpenKey != "" {
+		switch {
+		case key.Matches(msg, m.keys.Up):
+			m.dataScroll = max(m.dataScroll-1, 0)
+		case key.Matches(msg, m.keys.Down):
+			m.dataScroll = min(m.dataScroll+1, max(strings.Count(m.dataValue, "\n"), 0))
+		case key.Matches(msg, m.keys.Esc):
+			m.dataOpenKey, m.dataValue, m.dataScroll = "", "", 0
+		}
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		m.dataSelected = max(m.dataSelected-1, 0)
+	case key.Matches(msg, m.keys.Down):
+		m.dataSelected = min(m.dataSelected+1, max(len(m.dataKeys)-1, 0))
+	case key.Matches(msg, m.keys.Enter):
+		if selected, ok := m.selectedDataKey(); ok {
+			return m, readDataKeyCmd(m.storage, selected.Key)
+		}
+	case key.Matches(msg, m.keys.DeleteKey):
+		selected, ok := m.selectedDataKey()
+		if !ok {
+			return m, nil
+		}
+		if confirming {
+			return m, deleteDataKeyCmd(m.storage, selected.Key)
+		}
+		m.dataConfirm = true
+	case key.Matches(msg, m.keys.RefreshData):
+		return m, m.resetData()
+	case key.Matches(msg, m.keys.Esc):
+		return m.switchScreen(ScreenDashboard)
+	}
+	return m, nil
+}
+
+// selectedDataKey returns the key selected on the Data screen.
+func (m Model) selectedDataKey() (storage.StoredKey, bool) {
+	if m.storage == nil || m.dataSelected < 0 || m.dataSelected >= len(m.dataKeys) {
+		return storage.StoredKey{}, false
+	}
+	return m.dataKeys[m.dataSelected], true
+}
+
+// prettyJSON indents a JSON value for reading. Values that aren't JSON are
+// returned unchanged.
+func prettyJSON(value string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(value), "", "  "); err != nil {
+		return value
+	}
+	return out.String()
+}
+
+// viewData renders the Data screen.
+func (m Model) viewData() string {
+	return screens.RenderData(screens.DataView{
+		Keys:     m.dataKeys,
+		Selected: m.dataSelected,
+		OpenKey:  m.dataOpenKey,
+		Value:    m.dataValue,
+		Scroll:   m.dataScroll,
+		Confirm:  m.dataConfirm,
+		Err:      m.dataErr,
+	}, m.width, m.height)
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+)
+
+// runDataCmd runs a Data screen storage command and applies its result.
+func runDataCmd(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a storage command")
+	}
+	model, _ := m.handleDataMsg(cmd())
+	return model.(Model)
+}
+
+// TestDataScreen tests opening the Data screen in debug mode, viewing a
+// key's value and deleting it with a second X.
+func TestDataScreen(t *testing.T) {
+	client, err := storage.NewLocalClient(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalClient() error = %v", err)
+	}
+	if err := client.SaveCharacter(game.NewCharacter("Inspector")); err != nil {
+		t.Fatalf("SaveCharacter() error = %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	m := Model{keys: NewKeyMap(), config: cfg, storage: client, currentScreen: ScreenDashboard, width: 100, height: 40}
+	d := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}}
+	x := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}
+
+	if m = pressKey(t, m, d); m.currentScreen != ScreenDashboard {
+		t.Fatal("D should not open the Data screen outside debug mode")
+	}
+
+	cfg.Debug.Enabled = true
+	model, _ := m.openDataScreen()
+	m = model.(Model)
+	if m.currentScreen != ScreenData {
+		t.Fatalf("currentScreen = %v, want ScreenData in debug mode", m.currentScreen)
+	}
+	m = runDataCmd(t, m, listDataKeysCmd(m.storage))
+	if len(m.dataKeys) != 1 || m.dataKeys[0].Key != storage.KeyCharacter {
+		t.Fatalf("dataKeys = %+v, want the character", m.dataKeys)
+	}
+
+	model, cmd := m.handleDataKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	m = runDataCmd(t, model.(Model), cmd)
+	if m.dataOpenKey != storage.KeyCharacter || !strings.Contains(m.dataValue, "\n  \"name\": \"Inspector\"") {
+		t.Errorf("value = %q, want the character pretty-printed", m.dataValue)
+	}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.dataOpenKey != "" || m.currentScreen != ScreenData {
+		t.Error("Esc should close the value and stay on the Data screen")
+	}
+
+	model, cmd = m.handleDataKeys(x)
+	m = model.(Model)
+	if cmd != nil || !m.dataConfirm {
+		t.Fatal("the first X should only ask for confirmation")
+	}
+	model, cmd = m.handleDataKeys(x)
+	m = runDataCmd(t, model.(Model), cmd)
+	if m.dataErr != "" || client.CharacterExists() {
+		t.Errorf("the second X should delete the key (err %q)", m.dataErr)
+	}
+}
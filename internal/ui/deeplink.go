@@ -0,0 +1,106 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements deep links: `codequest --open <target>` launches the
+// TUI straight onto a screen or quest instead of the dashboard, so git
+// hooks, scripts and notification actions can point at what they're about.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// DeepLink is a screen (and optionally a quest) to open the TUI on.
+type DeepLink struct {
+	Screen  Screen // Screen to start on
+	QuestID string // Quest ID or unique prefix to open in detail ("" = none)
+}
+
+// deepLinkScreens maps deep link targets to screens.
+var deepLinkScreens = map[string]Screen{
+	"dashboard": ScreenDashboard,
+	"quests":    ScreenQuestBoard,
+	"character": ScreenCharacter,
+	"stats":     ScreenCharacter,
+	"mentor":    ScreenMentor,
+	"settings":  ScreenSettings,
+	"season":    ScreenSeason,
+}
+
+// DeepLinkTargets lists the accepted --open targets, for help and errors.
+const DeepLinkTargets = "dashboard, quests, quest/<id>, stats, mentor, settings, season"
+
+// ParseDeepLink parses an --open target such as "mentor" or "quest/3f2a".
+// Targets are case-insensitive and surrounding slashes are ignored.
+//
+// Parameters:
+//   - target: The target as given on the command line
+//
+// Returns:
+//   - DeepLink: The screen and quest to open
+//   - error: An error if the target is unknown or a quest ID is missing
+func ParseDeepLink(target string) (DeepLink, error) {
+	target = strings.Trim(strings.TrimSpace(target), "/")
+
+	kind, id, hasID := strings.Cut(target, "/")
+	kind = strings.ToLower(kind)
+	if kind == "quest" {
+		if !hasID || id == "" {
+			return DeepLink{}, fmt.Errorf("missing quest ID (use quest/<id>)")
+		}
+		return DeepLink{Screen: ScreenQuestBoard, QuestID: id}, nil
+	}
+
+	screen, ok := deepLinkScreens[kind]
+	if !ok || hasID {
+		return DeepLink{}, fmt.Errorf("unknown target %q (expected one of: %s)", target, DeepLinkTargets)
+	}
+	return DeepLink{Screen: screen}, nil
+}
+
+// OpenOnStart makes the TUI start on a deep link's screen. A linked quest
+// opens in the detail view once quests have loaded. Call before the program
+// starts.
+//
+// Parameters:
+//   - link: Where to start
+func (m *Model) OpenOnStart(link DeepLink) {
+	m.currentScreen = link.Screen
+	m.pendingQuestID = link.QuestID
+	if link.Screen == ScreenDashboard {
+		m.keys.EnableDashboardKeys()
+	} else {
+		m.keys.DisableDashboardKeys()
+	}
+}
+
+// openPendingQuest opens the quest a deep link asked for, once quests have
+// loaded. A quest that no longer exists leaves the board open with a warning.
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) openPendingQuest() Model {
+	if m.pendingQuestID == "" {
+		return m
+	}
+	id := m.pendingQuestID
+	m.pendingQuestID = ""
+
+	quest := game.FindQuest(m.quests, id)
+	if quest == nil {
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("Quest not found: %s", id),
+			Type:      NotificationWarning,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m
+	}
+
+	m.currentScreen = ScreenQuestBoard
+	m.questDetail = quest
+	m.editingNotes = false
+	return m
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestParseDeepLink tests parsing --open targets.
+func TestParseDeepLink(t *testing.T) {
+	tests := []struct {
+		target  string
+		want    DeepLink
+		wantErr bool
+	}{
+		{target: "mentor", want: DeepLink{Screen: ScreenMentor}},
+		{target: "stats", want: DeepLink{Screen: ScreenCharacter}},
+		{target: "Settings", want: DeepLink{Screen: ScreenSettings}},
+		{target: "season", want: DeepLink{Screen: ScreenSeason}},
+		{target: "quests/", want: DeepLink{Screen: ScreenQuestBoard}},
+		{target: "quest/3f2a9c", want: DeepLink{Screen: ScreenQuestBoard, QuestID: "3f2a9c"}},
+		{target: "quest", wantErr: true},
+		{target: "mentor/extra", wantErr: true},
+		{target: "inventory", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, err := ParseDeepLink(tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDeepLink(%q) error = %v, wantErr %v", tt.target, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDeepLink(%q) = %+v, want %+v", tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestOpenOnStartQuest tests that a deep-linked quest opens once quests load.
+func TestOpenOnStartQuest(t *testing.T) {
+	quest := game.NewQuest("Marathon", "", game.QuestTypeCommit, 10, 100, 1)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantDetail bool
+	}{
+		{name: "ID prefix", id: quest.ID[:8], wantDetail: true},
+		{name: "unknown quest", id: "nope", wantDetail: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+			m.OpenOnStart(DeepLink{Screen: ScreenQuestBoard, QuestID: tt.id})
+
+			model, _ := m.Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+			got := model.(Model)
+			if got.currentScreen != ScreenQuestBoard {
+				t.Errorf("currentScreen = %v, want quest board", got.currentScreen)
+			}
+			if (got.questDetail == quest) != tt.wantDetail {
+				t.Errorf("questDetail open = %v, want %v", got.questDetail != nil, tt.wantDetail)
+			}
+			if !tt.wantDetail && got.currentNotification == nil {
+				t.Error("an unknown quest should show a warning")
+			}
+			if got.pendingQuestID != "" {
+				t.Error("the pending quest should be cleared")
+			}
+		})
+	}
+}
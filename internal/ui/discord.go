@@ -0,0 +1,76 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the Discord test message on the Settings screen,
+// which posts a sample embed so the webhook can be checked before sharing
+// is switched on. The row follows the experimental feature flags.
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/discord"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// discordTestTimeout bounds the test message, including any rate limit wait.
+const discordTestTimeout = 30 * time.Second
+
+// discordTestMsg reports the outcome of a Discord test message.
+type discordTestMsg struct {
+	err error
+}
+
+// discordSettings returns the Discord status for the Settings screen.
+func (m Model) discordSettings() screens.DiscordSettings {
+	if m.config == nil {
+		return screens.DiscordSettings{Sending: m.discordSending}
+	}
+	settings := m.config.Discord.WithDefaults()
+	return screens.DiscordSettings{
+		Configured: settings.WebhookURL != "",
+		Enabled:    settings.Enabled,
+		Events:     settings.Events,
+		Sending:    m.discordSending,
+	}
+}
+
+// sendDiscordTest posts a test embed to the configured webhook. Only one
+// test is sent at a time.
+func (m Model) sendDiscordTest() (tea.Model, tea.Cmd) {
+	if m.config == nil || m.config.Discord.WebhookURL == "" {
+		return m.notifyAction("Set discord.webhook_url in the config file to share to Discord", NotificationWarning)
+	}
+	if m.discordSending {
+		return m, nil
+	}
+
+	name := "Adventurer"
+	if m.character != nil {
+		name = m.character.Name
+	}
+	m.discordSending = true
+	return m, sendDiscordTestCmd(m.config.Discord, name)
+}
+
+// sendDiscordTestCmd posts the test embed in the background.
+func sendDiscordTestCmd(settings config.DiscordConfig, name string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), discordTestTimeout)
+		defer cancel()
+		return discordTestMsg{err: discord.New(settings, name, log.Printf).SendTest(ctx)}
+	}
+}
+
+// handleDiscordTest reports how the test message went.
+func (m Model) handleDiscordTest(msg discordTestMsg) (tea.Model, tea.Cmd) {
+	m.discordSending = false
+	if msg.err != nil {
+		return m.notifyAction(fmt.Sprintf("Discord test failed: %v", msg.err), NotificationError)
+	}
+	return m.notifyAction("📣 Test message sent to Discord", NotificationSuccess)
+}
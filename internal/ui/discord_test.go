@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestSettingsDiscordTest tests sending a Discord test message from Settings.
+func TestSettingsDiscordTest(t *testing.T) {
+	var posts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultConfig()
+	m := Model{
+		keys:          NewKeyMap(),
+		config:        cfg,
+		character:     game.NewCharacter("Tester"),
+		currentScreen: ScreenSettings,
+		width:         100,
+		height:        40,
+	}
+	m.settingsSelected = screens.SettingsDiscordTestRow()
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	// Without a webhook there's nothing to test
+	model, cmd := m.handleKeyPress(enter)
+	if model.(Model).discordSending || posts.Load() != 0 {
+		t.Fatal("no test message should be sent without a webhook")
+	}
+	if cmd == nil {
+		t.Error("a missing webhook should be explained")
+	}
+
+	cfg.Discord.WebhookURL = server.URL
+	model, cmd = m.handleKeyPress(enter)
+	m = model.(Model)
+	if !m.discordSending || cmd == nil {
+		t.Fatal("Enter on the Discord row should send a test message")
+	}
+	if view := m.View(); !strings.Contains(view, "Sending test message") {
+		t.Error("Settings should show the test message on its way")
+	}
+	if _, again := m.handleKeyPress(enter); again != nil {
+		t.Error("a second Enter while sending should be ignored")
+	}
+
+	model, _ = m.Update(cmd())
+	m = model.(Model)
+	if m.discordSending || posts.Load() != 1 {
+		t.Errorf("after the test: sending = %v, posts = %d; want done after one post", m.discordSending, posts.Load())
+	}
+}
@@ -0,0 +1,71 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements spending Effort, the currency refunded from abandoned
+// quest progress: rerolling a daily quest from its detail view (R) and
+// buying a streak freeze from the character sheet (F).
+package ui
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// effortChangedMsg is sent when Effort is earned or spent.
+type effortChangedMsg struct {
+	spent   bool   // Spent rather than earned
+	amount  int    // Effort gained or spent
+	balance int    // Effort after the change
+	reason  string // What earned it or what it bought
+}
+
+// publishEffort announces an Effort change on the event bus.
+func (m Model) publishEffort(eventType game.EventType, amount, balance int, reason string) {
+	if m.eventBus != nil {
+		m.eventBus.Publish(game.NewEffortEvent(eventType, amount, balance, reason))
+	}
+}
+
+// rerollDailyQuest spends Effort to swap the daily quest shown in the detail
+// view for a different challenge.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command and notification
+func (m Model) rerollDailyQuest() (tea.Model, tea.Cmd) {
+	quest := m.questDetail
+	if m.character == nil || quest.Type != game.QuestTypeDaily {
+		return m, nil
+	}
+
+	oldTitle := quest.Title
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if err := m.character.RerollDailyQuest(quest, rng); err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't reroll: %v", err), NotificationWarning)
+	}
+
+	m.publishEffort(game.EventEffortSpent, game.RerollCost, m.character.Effort, "Rerolled "+oldTitle)
+	model, notify := m.notifyAction(fmt.Sprintf("🎲 %s rerolled into %s\n-%d Effort", oldTitle, quest.Title, game.RerollCost), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
+
+// buyStreakFreeze spends Effort on a streak freeze from the character sheet.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command and notification
+func (m Model) buyStreakFreeze() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+	if err := m.character.BuyStreakFreeze(); err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't buy a streak freeze: %v", err), NotificationWarning)
+	}
+
+	m.publishEffort(game.EventEffortSpent, game.StreakFreezeCost, m.character.Effort, "Streak freeze")
+	model, notify := m.notifyAction(fmt.Sprintf("🧊 Streak freeze bought (%d held)\n-%d Effort", m.character.StreakFreezes, game.StreakFreezeCost), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestAbandonRefundSpentBlocksUndo tests that an abandon can't be undone once
+// its Effort refund has been spent.
+func TestAbandonRefundSpentBlocksUndo(t *testing.T) {
+	quest := game.NewQuest("Marathon", "", game.QuestTypeCommit, 10, 100, 1)
+	if err := quest.Start("", ""); err != nil {
+		t.Fatal(err)
+	}
+	quest.UpdateProgress(10)
+
+	character := game.NewCharacter("Tester")
+	m := Model{keys: NewKeyMap(), character: character, currentScreen: ScreenQuestBoard}
+	m.quests = []*game.Quest{quest}
+	m.questDetail = quest
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if character.Effort != game.MaxAbandonRefund {
+		t.Fatalf("Effort after abandon = %d, want %d", character.Effort, game.MaxAbandonRefund)
+	}
+
+	// Spend it on a streak freeze from the character sheet
+	m.questDetail = nil
+	m.currentScreen = ScreenCharacter
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if character.StreakFreezes != 1 || character.Effort != 0 {
+		t.Fatalf("StreakFreezes = %d, Effort = %d; want 1 and 0", character.StreakFreezes, character.Effort)
+	}
+
+	pressKey(t, m, ctrlZ)
+	if quest.Status != game.QuestAvailable {
+		t.Errorf("status after refused undo = %s, want available", quest.Status)
+	}
+}
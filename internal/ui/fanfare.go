@@ -0,0 +1,164 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the level-up fanfare: a full-screen celebration shown
+// instead of the level-up toast (ui.level_up_fanfare), dismissed with any key.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// Fanfare timing - the confetti falls for about two seconds, then settles
+const (
+	fanfareFrames        = 16
+	fanfareFrameInterval = 120 * time.Millisecond
+)
+
+// levelUpFanfare is the open level-up celebration.
+type levelUpFanfare struct {
+	data  screens.LevelUpFanfare // What the celebration shows
+	frame int                    // Current confetti frame
+	id    int                    // Sequence ID so stale frame ticks are ignored
+}
+
+// fanfareFrameMsg advances the fanfare with the matching ID by one frame.
+type fanfareFrameMsg struct {
+	id int
+}
+
+// fanfareTick returns a command that advances the given fanfare.
+func fanfareTick(id int) tea.Cmd {
+	return tea.Tick(fanfareFrameInterval, func(time.Time) tea.Msg {
+		return fanfareFrameMsg{id: id}
+	})
+}
+
+// fanfareEnabled reports whether level-ups get the full-screen celebration.
+func (m Model) fanfareEnabled() bool {
+	return m.config == nil || m.config.UI.LevelUpFanfare
+}
+
+// celebrateLevelUp opens the level-up fanfare, or queues the level-up toast
+// when the fanfare is turned off. A level-up while the fanfare is already
+// open extends it to the newest level; focus mode only counts it for the
+// end-of-focus summary.
+//
+// Parameters:
+//   - oldLevel: Level before the level-up
+//   - newLevel: Level reached
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Confetti animation command (nil when not animating)
+func (m Model) celebrateLevelUp(oldLevel, newLevel int) (Model, tea.Cmd) {
+	// Focus mode saves the celebration for its summary
+	if m.focus != nil {
+		m.focus.noteLevelUp(oldLevel, newLevel)
+		return m, nil
+	}
+
+	// Quiet hours and weekly-summary-only mode skip the celebration
+	if !m.celebrationLimits().Celebrate(time.Now()) {
+		return m, nil
+	}
+
+	if !m.fanfareEnabled() || m.character == nil {
+		message := fmt.Sprintf("⚡ LEVEL UP! ⚡\nYou are now Level %d!", newLevel)
+		if rank, ok := game.RankUp(oldLevel, newLevel); ok {
+			message = fmt.Sprintf("🎖️ RANK UP! 🎖️\nYou've reached the rank of %s (Level %d)!", rank, newLevel)
+		}
+		m.addNotification(Notification{
+			Message:     message,
+			Type:        NotificationLevelUp,
+			Duration:    5 * time.Second,
+			Timestamp:   time.Now(),
+			Celebration: true,
+		})
+		return m, nil
+	}
+
+	if m.fanfare != nil {
+		oldLevel = min(oldLevel, m.fanfare.data.OldLevel)
+	}
+	m.fanfareSeq++
+	m.fanfare = &levelUpFanfare{data: m.levelUpFanfareData(oldLevel, newLevel), id: m.fanfareSeq}
+	m.showingHelp = false
+
+	if !m.animationsEnabled() {
+		return m, nil
+	}
+	return m, fanfareTick(m.fanfareSeq)
+}
+
+// levelUpFanfareData works out the before/after deltas and unlocked quests
+// for a level-up. The character may not have been reloaded yet, so the stat
+// points after the level-up are derived from the levels gained.
+//
+// Parameters:
+//   - oldLevel: Level before the level-up
+//   - newLevel: Level reached
+//
+// Returns:
+//   - screens.LevelUpFanfare: What the celebration shows
+func (m Model) levelUpFanfareData(oldLevel, newLevel int) screens.LevelUpFanfare {
+	pointsAfter := m.character.SkillPoints + game.StatPointsPerLevel*max(newLevel-m.character.Level, 0)
+	pointsBefore := max(pointsAfter-game.StatPointsPerLevel*(newLevel-oldLevel), 0)
+
+	unlocked := make([]string, 0)
+	for _, quest := range m.quests {
+		if quest.Status == game.QuestAvailable && quest.RequiredLevel > oldLevel && quest.RequiredLevel <= newLevel {
+			unlocked = append(unlocked, quest.Title)
+		}
+	}
+
+	var rankUp *game.Rank
+	if rank, ok := game.RankUp(oldLevel, newLevel); ok {
+		rankUp = &rank
+	}
+
+	return screens.LevelUpFanfare{
+		OldLevel: oldLevel,
+		NewLevel: newLevel,
+		Deltas: []screens.StatDelta{
+			{Label: "Level", Before: oldLevel, After: newLevel},
+			{Label: "XP to next level", Before: game.CalculateXPForLevel(oldLevel), After: game.CalculateXPForLevel(newLevel)},
+			{Label: "Stat points", Before: pointsBefore, After: pointsAfter},
+		},
+		UnlockedQuests: unlocked,
+		RankUp:         rankUp,
+	}
+}
+
+// advanceFanfare plays the next confetti frame.
+//
+// Parameters:
+//   - msg: The frame tick
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Next frame tick (nil once the confetti settles)
+func (m Model) advanceFanfare(msg fanfareFrameMsg) (Model, tea.Cmd) {
+	if m.fanfare == nil || msg.id != m.fanfare.id || m.fanfare.frame >= fanfareFrames {
+		return m, nil
+	}
+	fanfare := *m.fanfare
+	fanfare.frame++
+	m.fanfare = &fanfare
+	if fanfare.frame >= fanfareFrames {
+		return m, nil
+	}
+	return m, fanfareTick(fanfare.id)
+}
+
+// viewFanfare renders the level-up celebration over the whole screen.
+//
+// Returns:
+//   - string: The rendered fanfare
+func (m Model) viewFanfare() string {
+	return screens.RenderLevelUpFanfare(m.fanfare.data, m.fanfare.frame, m.width, m.height)
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestLevelUpFanfare tests that a level-up opens the full-screen fanfare
+// with stat deltas and unlocked quests, and that any key dismisses it.
+func TestLevelUpFanfare(t *testing.T) {
+	character := game.NewCharacter("Tester") // Still level 1: not reloaded yet
+	unlocked := game.NewQuest("Guardian", "", game.QuestTypeReview, 3, 200, 2)
+	locked := game.NewQuest("Epic", "", game.QuestTypeCommit, 50, 500, 5)
+
+	m := Model{keys: NewKeyMap(), character: character, quests: []*game.Quest{unlocked, locked}, width: 100, height: 40}
+	m, cmd := m.celebrateLevelUp(1, 2)
+	if m.fanfare == nil {
+		t.Fatal("level-up should open the fanfare")
+	}
+	if cmd == nil {
+		t.Error("fanfare should animate confetti")
+	}
+
+	data := m.fanfare.data
+	if got := data.Deltas[2]; got.Before != 0 || got.After != game.StatPointsPerLevel {
+		t.Errorf("stat points delta = %d → %d, want 0 → %d", got.Before, got.After, game.StatPointsPerLevel)
+	}
+	if len(data.UnlockedQuests) != 1 || data.UnlockedQuests[0] != "Guardian" {
+		t.Errorf("UnlockedQuests = %v, want [Guardian]", data.UnlockedQuests)
+	}
+	if data.RankUp != nil {
+		t.Errorf("RankUp = %v, want nil within Apprentice", data.RankUp)
+	}
+
+	view := m.View()
+	for _, want := range []string{"LEVEL UP", "Level 1 → Level 2", "Stat points", "Guardian", "Press any key"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("fanfare view missing %q", want)
+		}
+	}
+
+	// Confetti advances frame by frame; stale ticks are ignored
+	model, next := m.Update(fanfareFrameMsg{id: m.fanfare.id})
+	m = model.(Model)
+	if m.fanfare.frame != 1 || next == nil {
+		t.Errorf("frame = %d (next tick %v), want 1 with another tick", m.fanfare.frame, next != nil)
+	}
+	model, _ = m.Update(fanfareFrameMsg{id: m.fanfare.id - 1})
+	if model.(Model).fanfare.frame != 1 {
+		t.Error("stale frame tick should be ignored")
+	}
+
+	// A second level-up while open extends the fanfare from the first level
+	m, _ = m.celebrateLevelUp(2, 3)
+	if m.fanfare.data.OldLevel != 1 || m.fanfare.data.NewLevel != 3 {
+		t.Errorf("fanfare levels = %d → %d, want 1 → 3", m.fanfare.data.OldLevel, m.fanfare.data.NewLevel)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if m.fanfare != nil {
+		t.Error("any key should dismiss the fanfare")
+	}
+}
+
+// TestLevelUpFanfareDisabled tests that turning the fanfare off falls back
+// to the level-up toast.
+func TestLevelUpFanfareDisabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.UI.LevelUpFanfare = false
+
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), config: cfg, width: 100, height: 40}
+	m, cmd := m.celebrateLevelUp(1, 2)
+	if m.fanfare != nil || cmd != nil {
+		t.Error("disabled fanfare should not open")
+	}
+	if len(m.notifications) != 1 || m.notifications[0].Type != NotificationLevelUp {
+		t.Errorf("notifications = %+v, want one level-up toast", m.notifications)
+	}
+}
@@ -0,0 +1,89 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements switching experimental feature flags from the
+// Settings screen, where the feature rows follow the profile fields.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// featureAt returns the experimental feature on a Settings row, if the row
+// is past the dashboard widgets and the profile fields.
+//
+// Parameters:
+//   - row: Settings row index
+//
+// Returns:
+//   - config.Feature: The feature on that row
+//   - bool: False if the row is a widget or a profile field
+func featureAt(row int) (config.Feature, bool) {
+	index := row - len(screens.DashboardWidgets()) - len(game.ProfileFields)
+	if index < 0 || index >= len(config.Features) {
+		return config.Feature{}, false
+	}
+	return config.Features[index], true
+}
+
+// experimentalFlags returns the feature flags for the Settings screen.
+func (m Model) experimentalFlags() map[string]bool {
+	if m.config == nil {
+		return nil
+	}
+	return m.config.Experimental
+}
+
+// toggleFeature switches an experimental feature on or off and records the
+// change for undo.
+func (m Model) toggleFeature(feature config.Feature) (tea.Model, tea.Cmd) {
+	enabled := !m.config.FeatureEnabled(feature.ID)
+	action := featureToggleAction{feature: feature, enabled: enabled}
+	cmd, err := action.redo(&m)
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't change %s: %v", feature.Name, err), NotificationWarning)
+	}
+	m.history.record(action, 0, time.Now())
+
+	state := "off"
+	if enabled {
+		state = "on - it's experimental and may change or break"
+	}
+	model, notify := m.notifyAction(fmt.Sprintf("🧪 %s switched %s", feature.Name, state), NotificationInfo)
+	return model, tea.Batch(cmd, notify)
+}
+
+// featureToggleAction is switching an experimental feature on or off.
+type featureToggleAction struct {
+	feature config.Feature
+	enabled bool // State after the change
+}
+
+func (a featureToggleAction) describe() string {
+	if a.enabled {
+		return "enabling " + a.feature.Name
+	}
+	return "disabling " + a.feature.Name
+}
+
+func (a featureToggleAction) undo(m *Model) (tea.Cmd, error) {
+	return m.setFeature(a.feature.ID, !a.enabled)
+}
+
+func (a featureToggleAction) redo(m *Model) (tea.Cmd, error) {
+	return m.setFeature(a.feature.ID, a.enabled)
+}
+
+// setFeature stores a feature flag in the config and saves it.
+func (m *Model) setFeature(id string, enabled bool) (tea.Cmd, error) {
+	if m.config == nil {
+		return nil, fmt.Errorf("config not loaded")
+	}
+	m.config.SetFeature(id, enabled)
+	return saveConfigCmd(m.config), nil
+}
@@ -0,0 +1,167 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the review modal for flagged XP: commit XP that
+// anomaly detection held back (absurd line counts, bursts, repeated diffs)
+// waits here until the player accepts or discards it.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// xpFlaggedMsg is sent when the game handler holds a commit's XP for review.
+type xpFlaggedMsg struct {
+	message string   // First line of the commit message
+	xp      int      // XP held
+	reasons []string // Why it was flagged
+}
+
+// flaggedReview is the open flagged XP review modal.
+type flaggedReview struct {
+	id string // Held entry being reviewed
+}
+
+// openFlaggedReview opens the review modal if XP is held and nothing else
+// (tutorial, a reward choice, focus mode) is in the way.
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) openFlaggedReview() Model {
+	if m.flaggedReview != nil || m.rewardChoice != nil || m.showingTutorial || m.focus != nil || m.character == nil {
+		return m
+	}
+	if len(m.character.FlaggedXP) > 0 {
+		m.flaggedReview = &flaggedReview{id: m.character.FlaggedXP[0].ID}
+	}
+	return m
+}
+
+// flaggedReviewEntry returns the held entry the open modal is for.
+func (m Model) flaggedReviewEntry() *game.FlaggedXP {
+	if m.flaggedReview == nil || m.character == nil {
+		return nil
+	}
+	for i := range m.character.FlaggedXP {
+		if m.character.FlaggedXP[i].ID == m.flaggedReview.id {
+			return &m.character.FlaggedXP[i]
+		}
+	}
+	return nil
+}
+
+// handleFlaggedReviewKeys accepts (A/Enter) or discards (D) the held XP.
+// Esc puts the review off; it reopens the next time the character loads.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands after a decision
+func (m Model) handleFlaggedReviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.flaggedReviewEntry() == nil {
+		m.flaggedReview = nil
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "a", "A", "enter":
+		return m.resolveFlaggedXP(true)
+	case "d", "D":
+		return m.resolveFlaggedXP(false)
+	case "esc":
+		m.flaggedReview = nil
+	}
+	return m, nil
+}
+
+// resolveFlaggedXP awards or drops the reviewed XP and saves.
+//
+// Parameters:
+//   - accept: True to award the XP, false to discard it
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) resolveFlaggedXP(accept bool) (tea.Model, tea.Cmd) {
+	id := m.flaggedReview.id
+	m.flaggedReview = nil
+
+	var fanfareCmd tea.Cmd
+	if accept {
+		oldLevel := m.character.Level
+		flagged, leveledUp, err := m.character.AcceptFlaggedXP(id)
+		if err != nil {
+			return m.notifyAction(fmt.Sprintf("Couldn't accept XP: %v", err), NotificationWarning)
+		}
+		m.publishXPChange(flagged.XP, game.XPSourceFlagged)
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("✓ XP ACCEPTED\n%s\n+%d XP", flagged.Message, flagged.XP),
+			Type:      NotificationSuccess,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		})
+		if record := m.character.RecordDayXP(flagged.XP, time.Now()); record != nil {
+			m = m.celebrateRecord(*record)
+		}
+		if leveledUp {
+			m, fanfareCmd = m.celebrateLevelUp(oldLevel, m.character.Level)
+			m.announceUnlocks(oldLevel, m.character.Level)
+		}
+	} else {
+		flagged, err := m.character.DiscardFlaggedXP(id)
+		if err != nil {
+			return m.notifyAction(fmt.Sprintf("Couldn't discard XP: %v", err), NotificationWarning)
+		}
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("✗ XP DISCARDED\n%s\n%d XP", flagged.Message, flagged.XP),
+			Type:      NotificationInfo,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		})
+	}
+
+	// More XP may be waiting on review
+	m = m.openFlaggedReview()
+	return m, tea.Batch(m.saveStateCmd(), fanfareCmd, m.showNextNotification())
+}
+
+// viewFlaggedReview renders the review modal centered on screen.
+//
+// Returns:
+//   - string: The rendered modal
+func (m Model) viewFlaggedReview() string {
+	flagged := m.flaggedReviewEntry()
+	if flagged == nil {
+		return ""
+	}
+
+	sha := flagged.SHA
+	if len(sha) > 7 {
+		sha = sha[:7]
+	}
+	lines := []string{
+		TitleStyle.Render("⚠ XP Held for Review"),
+		SubtitleStyle.Render(fmt.Sprintf("%s %s", sha, flagged.Message)),
+		"",
+		fmt.Sprintf("%d XP was held because this commit looks unusual:", flagged.XP),
+	}
+	for _, anomaly := range flagged.Anomalies {
+		lines = append(lines, WarningTextStyle.Render("  • "+anomaly.Detail))
+	}
+	if waiting := len(m.character.FlaggedXP) - 1; waiting > 0 {
+		lines = append(lines, "", MutedTextStyle.Render(fmt.Sprintf("%d more waiting for review", waiting)))
+	}
+	lines = append(lines,
+		"",
+		RenderKeybind("A", "accept")+"  "+RenderKeybind("D", "discard")+"  "+RenderKeybind("Esc", "decide later"),
+	)
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return PlaceInCenter(m.width, m.height, box)
+}
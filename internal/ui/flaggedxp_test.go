@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestFlaggedReviewFlow tests that held XP opens the review modal when the
+// character loads, and that A accepts and D discards it.
+func TestFlaggedReviewFlow(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        tea.KeyMsg
+		wantXP     int
+		wantNotice string
+	}{
+		{"A accepts", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}}, 80, "XP ACCEPTED"},
+		{"D discards", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}}, 0, "XP DISCARDED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			anomalies := []game.Anomaly{{Kind: game.AnomalyHugeCommit, Detail: "20000 lines changed (limit 5000)"}}
+			character.HoldXP("0123456789abcdef", "Add generated fixtures", game.XPBudgetResult{Awarded: 80}, anomalies, time.Now())
+
+			m := Model{keys: NewKeyMap(), width: 100, height: 40}
+			model, _ := m.Update(characterLoadedMsg{character: character})
+			m = model.(Model)
+			if m.flaggedReview == nil {
+				t.Fatal("loading a character with held XP should open the review")
+			}
+			if view := m.View(); !strings.Contains(view, "XP Held for Review") || !strings.Contains(view, "20000 lines changed") {
+				t.Error("view should show why the XP was held")
+			}
+
+			model, cmd := m.handleKeyPress(tt.key)
+			m = model.(Model)
+			if cmd == nil {
+				t.Error("resolving should save state")
+			}
+			if m.flaggedReview != nil || len(character.FlaggedXP) != 0 {
+				t.Error("review should close and the entry be resolved")
+			}
+			if character.XP != tt.wantXP {
+				t.Errorf("XP = %d, want %d", character.XP, tt.wantXP)
+			}
+			if m.currentNotification == nil || !strings.Contains(m.currentNotification.Message, tt.wantNotice) {
+				t.Errorf("notification = %+v, want %q", m.currentNotification, tt.wantNotice)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements focus mode: for a chosen duration, notifications,
+// the level-up fanfare, reward choices and the dashboard's XP widgets are
+// held back. Events pile up silently and a single summary is shown when
+// focus ends.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// focusDurations are the lengths offered by the focus picker (keys 1-3).
+var focusDurations = []time.Duration{25 * time.Minute, 50 * time.Minute, 90 * time.Minute}
+
+// maxFocusSummaryItems is how many held notifications the summary lists
+// before "and N more".
+const maxFocusSummaryItems = 3
+
+// focusSession is a running focus session and what happened during it.
+type focusSession struct {
+	id       int            // Sequence ID so a stale end timer is ignored
+	started  time.Time      // When focus started
+	until    time.Time      // When focus ends
+	duration time.Duration  // Length chosen in the picker
+	commits  int            // Commits detected while focused
+	xp       int            // XP earned from those commits
+	fromLvl  int            // Level before the first level-up (0 = no level-up)
+	toLvl    int            // Latest level reached
+	held     []Notification // Notifications held back until focus ends
+	dropped  int            // Held notifications beyond the summary's list
+}
+
+// focusEndedMsg fires when a focus session's time is up.
+type focusEndedMsg struct {
+	id int // Focus session the timer belongs to
+}
+
+// focusing reports whether focus mode is on.
+func (m Model) focusing() bool {
+	return m.focus != nil
+}
+
+// toggleFocus opens the duration picker, or ends focus early if it's on.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The summary notification when focus ends
+func (m Model) toggleFocus() (tea.Model, tea.Cmd) {
+	if m.focusing() {
+		return m.endFocus()
+	}
+	m.choosingFocus = true
+	return m, nil
+}
+
+// handleFocusPickerKeys starts focus for the chosen duration (1-3).
+// Esc closes the picker; other keys are ignored while it's open.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The end-of-focus timer once a duration is chosen
+func (m Model) handleFocusPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.choosingFocus = false
+	case "1", "2", "3":
+		m.choosingFocus = false
+		return m.startFocus(focusDurations[msg.String()[0]-'1'], time.Now())
+	}
+	return m, nil
+}
+
+// startFocus turns focus mode on. Anything already queued is held too, so
+// the screen goes quiet straight away.
+//
+// Parameters:
+//   - duration: How long to focus
+//   - now: Current time
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Fires focusEndedMsg when the time is up
+func (m Model) startFocus(duration time.Duration, now time.Time) (tea.Model, tea.Cmd) {
+	m.focusSeq++
+	m.focus = &focusSession{
+		id:       m.focusSeq,
+		started:  now,
+		until:    now.Add(duration),
+		duration: duration,
+	}
+
+	queued := m.notifications
+	m.notifications = nil
+	m.currentNotification = nil
+	for _, notification := range queued {
+		m.addNotification(notification)
+	}
+
+	id := m.focusSeq
+	return m, tea.Tick(duration, func(time.Time) tea.Msg {
+		return focusEndedMsg{id: id}
+	})
+}
+
+// endFocus turns focus mode off and shows one summary of what happened,
+// then opens any reward choice that was put off.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Shows the summary notification
+func (m Model) endFocus() (tea.Model, tea.Cmd) {
+	session := m.focus
+	m.focus = nil
+	if session == nil {
+		return m, nil
+	}
+
+	m.addNotification(Notification{
+		Message:   session.summary(time.Now()),
+		Type:      NotificationInfo,
+		Duration:  8 * time.Second,
+		Timestamp: time.Now(),
+	})
+	m = m.openPendingReward()
+	m = m.openFlaggedReview()
+	return m, m.showNextNotification()
+}
+
+// holdNotification keeps a notification for the focus summary. Errors are
+// never held: they still need attention straight away.
+//
+// Parameters:
+//   - notification: The notification to hold
+//
+// Returns:
+//   - bool: False if the notification should be shown now
+func (s *focusSession) holdNotification(notification Notification) bool {
+	if notification.Type == NotificationError {
+		return false
+	}
+	if len(s.held) < maxFocusSummaryItems {
+		s.held = append(s.held, notification)
+	} else {
+		s.dropped++
+	}
+	return true
+}
+
+// noteCommit counts a commit and its XP for the summary.
+func (s *focusSession) noteCommit(xp int) {
+	s.commits++
+	s.xp += xp
+}
+
+// noteLevelUp records a level-up for the summary, keeping the level before
+// the first one.
+func (s *focusSession) noteLevelUp(oldLevel, newLevel int) {
+	if s.fromLvl == 0 {
+		s.fromLvl = oldLevel
+	}
+	s.toLvl = max(s.toLvl, newLevel)
+}
+
+// summary describes the focus session: how long it ran, commits and XP,
+// level-ups and the first line of each held notification.
+//
+// Parameters:
+//   - now: When focus ended
+//
+// Returns:
+//   - string: The summary notification text
+func (s *focusSession) summary(now time.Time) string {
+	elapsed := min(now.Sub(s.started), s.duration).Round(time.Minute)
+	lines := []string{fmt.Sprintf("🎯 Focus complete (%s)", formatFocusLength(elapsed))}
+
+	switch {
+	case s.commits > 0:
+		lines = append(lines, fmt.Sprintf("%d %s · +%d XP", s.commits, plural(s.commits, "commit", "commits"), s.xp))
+	case len(s.held) == 0 && s.fromLvl == 0:
+		lines = append(lines, "All quiet. Nice deep work!")
+	}
+	if s.fromLvl > 0 {
+		lines = append(lines, fmt.Sprintf("⚡ Level %d → Level %d", s.fromLvl, s.toLvl))
+		if rank, ok := game.RankUp(s.fromLvl, s.toLvl); ok {
+			lines = append(lines, "🎖️ Rank up: "+rank.String())
+		}
+	}
+	for _, notification := range s.held {
+		lines = append(lines, "• "+firstLine(notification.Message))
+	}
+	if s.dropped > 0 {
+		lines = append(lines, fmt.Sprintf("…and %d more", s.dropped))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatFocusLength formats a focus length as "1h 30m" or "25m".
+func formatFocusLength(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	if minutes >= 60 {
+		return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+// plural picks the singular or plural word for n.
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
+
+// viewFocusPicker renders the focus duration picker as a banner above the
+// current screen.
+//
+// Parameters:
+//   - mainContent: The rendered screen underneath
+//
+// Returns:
+//   - string: The screen with the picker on top
+func (m Model) viewFocusPicker(mainContent string) string {
+	options := make([]string, 0, len(focusDurations)+1)
+	for i, duration := range focusDurations {
+		options = append(options, RenderKeybind(fmt.Sprint(i+1), formatFocusLength(duration)))
+	}
+	options = append(options, RenderKeybind("Esc", "Cancel"))
+
+	banner := m.renderNotification(Notification{
+		Message: "🎯 Focus for how long? Notifications wait until you're done.\n" +
+			strings.Join(options, "  "),
+		Type: NotificationInfo,
+	})
+
+	centered := lipgloss.NewStyle().
+		Width(m.width).
+		Align(lipgloss.Center).
+		Render(banner)
+
+	return lipgloss.JoinVertical(lipgloss.Left, "", centered, "", mainContent)
+}
+
+// viewFocusDashboard renders the calm dashboard shown while focusing.
+func (m Model) viewFocusDashboard() string {
+	return screens.RenderFocusMode(screens.FocusData{
+		Remaining: time.Until(m.focus.until),
+		EndsAt:    m.focus.until,
+		Quests:    m.quests,
+	}, m.width, m.height)
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+var altF = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}
+
+// TestFocusPicker tests opening the picker with Alt+F, choosing a duration
+// and cancelling.
+func TestFocusPicker(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       tea.KeyMsg
+		wantFocus time.Duration
+	}{
+		{"1 picks 25 minutes", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}}, 25 * time.Minute},
+		{"3 picks 90 minutes", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}}, 90 * time.Minute},
+		{"esc cancels", tea.KeyMsg{Type: tea.KeyEsc}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+			m = pressKey(t, m, altF)
+			if !m.choosingFocus || !strings.Contains(m.View(), "Focus for how long?") {
+				t.Fatal("Alt+F should open the focus picker")
+			}
+
+			m = pressKey(t, m, tt.key)
+			if m.choosingFocus {
+				t.Error("picker should close after a choice")
+			}
+			if tt.wantFocus == 0 {
+				if m.focusing() {
+					t.Error("Esc should not start focus")
+				}
+				return
+			}
+			if !m.focusing() || m.focus.duration != tt.wantFocus {
+				t.Fatalf("focus = %+v, want %v session", m.focus, tt.wantFocus)
+			}
+			if view := m.View(); !strings.Contains(view, "Focus Mode") || strings.Contains(view, "Level") {
+				t.Error("dashboard should show the focus card without XP or levels")
+			}
+		})
+	}
+}
+
+// TestFocusHoldsEventsAndSummarizes tests that commits, level-ups and
+// notifications are held while focusing and summarized once at the end.
+func TestFocusHoldsEventsAndSummarizes(t *testing.T) {
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+	m.addNotification(Notification{Message: "Queued before focus", Type: NotificationInfo})
+	model, _ := m.startFocus(50*time.Minute, time.Now())
+	m = model.(Model)
+
+	for _, msg := range []tea.Msg{
+		commitDetectedMsg{sha: "abc1234", message: "feat: one", xpAwarded: 30},
+		commitDetectedMsg{sha: "def5678", message: "fix: two", xpAwarded: 20},
+		levelUpMsg{oldLevel: 1, newLevel: 2},
+		questCompleteMsg{questName: "First Steps"},
+	} {
+		model, _ = m.Update(msg)
+		m = model.(Model)
+	}
+	m.addNotification(Notification{Message: "Disk full", Type: NotificationError})
+
+	if m.fanfare != nil {
+		t.Error("level-up fanfare should wait until focus ends")
+	}
+	if len(m.notifications) != 1 || m.notifications[0].Message != "Disk full" {
+		t.Errorf("queued %+v while focusing, want only the error", m.notifications)
+	}
+	m.notifications = nil
+
+	// A timer from an earlier session doesn't end this one
+	model, _ = m.Update(focusEndedMsg{id: m.focus.id - 1})
+	if !model.(Model).focusing() {
+		t.Fatal("stale focus timer should be ignored")
+	}
+
+	model, _ = m.Update(focusEndedMsg{id: m.focus.id})
+	m = model.(Model)
+	if m.focusing() || m.currentNotification == nil {
+		t.Fatal("focus should end with a summary notification")
+	}
+	summary := m.currentNotification.Message
+	for _, want := range []string{"Focus complete", "2 commits · +50 XP", "Level 1 → Level 2", "Queued before focus"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary %q missing %q", summary, want)
+		}
+	}
+	if len(m.notifications) != 0 {
+		t.Errorf("%d notifications left after the summary, want one summary only", len(m.notifications))
+	}
+}
+
+// TestFocusEndsEarlyAndOpensReward tests ending focus with Alt+F and that a
+// reward choice put off during focus opens afterwards.
+func TestFocusEndsEarlyAndOpensReward(t *testing.T) {
+	quest := game.NewQuest("Marathon", "", game.QuestTypeCommit, 50, 250, 1)
+	quest.Status = game.QuestCompleted
+	quest.RewardPending = true
+
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+	model, _ := m.startFocus(25*time.Minute, time.Now())
+	model, _ = model.(Model).Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+	m = model.(Model)
+	if m.rewardChoice != nil {
+		t.Fatal("reward choice should wait until focus ends")
+	}
+
+	m = pressKey(t, m, altF)
+	if m.focusing() || m.choosingFocus {
+		t.Error("Alt+F while focusing should end focus")
+	}
+	if m.rewardChoice == nil {
+		t.Error("the put-off reward choice should open when focus ends")
+	}
+}
+
+// TestFocusSummary tests the summary text for quiet and busy sessions.
+func TestFocusSummary(t *testing.T) {
+	started := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		session focusSession
+		end     time.Duration
+		want    []string
+	}{
+		{
+			name:    "quiet session",
+			session: focusSession{duration: 25 * time.Minute},
+			end:     25 * time.Minute,
+			want:    []string{"Focus complete (25m)", "All quiet"},
+		},
+		{
+			name:    "ended early with one commit",
+			session: focusSession{duration: 90 * time.Minute, commits: 1, xp: 15},
+			end:     40 * time.Minute,
+			want:    []string{"(40m)", "1 commit · +15 XP"},
+		},
+		{
+			name: "held notifications beyond the list",
+			session: focusSession{
+				duration: 90 * time.Minute,
+				held:     []Notification{{Message: "🏆 NEW PERSONAL BEST!\nMost XP in a day"}},
+				dropped:  2,
+			},
+			end:  2 * time.Hour,
+			want: []string{"(1h 30m)", "• 🏆 NEW PERSONAL BEST!", "…and 2 more"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.session.started = started
+			got := tt.session.summary(started.Add(tt.end))
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("summary %q missing %q", got, want)
+				}
+			}
+		})
+	}
+}
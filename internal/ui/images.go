@@ -0,0 +1,19 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file applies the inline image protocol (ui.images) the terminal was
+// detected to speak, so the screens draw the avatar and achievement badges
+// as images where they can.
+package ui
+
+import (
+	"github.com/AutumnsGrove/codequest/internal/termimage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// ApplyImageProtocol sets how the screens draw avatars and badges. Call it
+// before the program starts rendering.
+//
+// Parameters:
+//   - protocol: Inline image protocol (termimage.ProtocolNone draws text)
+func ApplyImageProtocol(protocol termimage.Protocol) {
+	screens.SetImageProtocol(protocol)
+}
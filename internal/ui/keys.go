@@ -9,11 +9,12 @@ import (
 // organized by their function. Keys can be reconfigured at runtime.
 type KeyMap struct {
 	// Navigation keys - Basic movement
-	Up    key.Binding
-	Down  key.Binding
-	Left  key.Binding
-	Right key.Binding
-	Tab   key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Left     key.Binding
+	Right    key.Binding
+	Tab      key.Binding
+	ShiftTab key.Binding
 
 	// Action keys - Common interactions
 	Enter key.Binding
@@ -27,6 +28,10 @@ type KeyMap struct {
 	DashboardMentor    key.Binding
 	DashboardSettings  key.Binding
 	DashboardHelpKey   key.Binding
+	DashboardCopySHA   key.Binding
+	DashboardFeedPet   key.Binding
+	DashboardSeason    key.Binding
+	DashboardData      key.Binding
 
 	// Help overlay key (works from any screen)
 	HelpOverlay key.Binding
@@ -37,12 +42,39 @@ type KeyMap struct {
 	GlobalSettings  key.Binding
 	GlobalHelp      key.Binding
 	GlobalTimer     key.Binding
+	GlobalFocus     key.Binding
+	GlobalSearch    key.Binding
 	GlobalQuit      key.Binding
 
 	// Special function keys
 	CommandPalette key.Binding
 	Save           key.Binding
 	Cancel         key.Binding
+	CopyResponse   key.Binding
+	Tutorial       key.Binding
+	Undo           key.Binding
+	Redo           key.Binding
+
+	// Quest board keys
+	NewQuest      key.Binding
+	TodoQuests    key.Binding
+	QuestCalendar key.Binding
+	PinQuest      key.Binding
+	MoveQuestUp   key.Binding
+	MoveQuestDown key.Binding
+
+	// Character sheet keys
+	AllocateStat key.Binding
+	Respec       key.Binding
+	StatDetails  key.Binding
+
+	// Settings screen keys
+	MoveWidgetUp   key.Binding
+	MoveWidgetDown key.Binding
+
+	// Data screen keys
+	DeleteKey   key.Binding
+	RefreshData key.Binding
 }
 
 // NewKeyMap creates a new KeyMap with default bindings.
@@ -71,6 +103,10 @@ func NewKeyMap() *KeyMap {
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "next section"),
 		),
+		ShiftTab: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous section"),
+		),
 
 		// Action keys
 		Enter: key.NewBinding(
@@ -112,6 +148,22 @@ func NewKeyMap() *KeyMap {
 			key.WithKeys("h", "H", "?"),
 			key.WithHelp("H/?", "help"),
 		),
+		DashboardCopySHA: key.NewBinding(
+			key.WithKeys("y", "Y"),
+			key.WithHelp("Y", "copy last commit SHA"),
+		),
+		DashboardFeedPet: key.NewBinding(
+			key.WithKeys("p", "P"),
+			key.WithHelp("P", "feed companion"),
+		),
+		DashboardSeason: key.NewBinding(
+			key.WithKeys("r", "R"),
+			key.WithHelp("R", "season pass rewards"),
+		),
+		DashboardData: key.NewBinding(
+			key.WithKeys("d", "D"),
+			key.WithHelp("D", "stored data (debug mode)"),
+		),
 
 		// Help overlay key (works from any screen)
 		HelpOverlay: key.NewBinding(
@@ -141,6 +193,14 @@ func NewKeyMap() *KeyMap {
 			key.WithKeys("ctrl+t"),
 			key.WithHelp("ctrl+T", "toggle session timer"),
 		),
+		GlobalFocus: key.NewBinding(
+			key.WithKeys("alt+f"),
+			key.WithHelp("alt+F", "focus mode"),
+		),
+		GlobalSearch: key.NewBinding(
+			key.WithKeys("ctrl+f"),
+			key.WithHelp("ctrl+F", "search everything"),
+		),
 		GlobalQuit: key.NewBinding(
 			key.WithKeys("ctrl+c"),
 			key.WithHelp("ctrl+C", "quit application"),
@@ -159,6 +219,84 @@ func NewKeyMap() *KeyMap {
 			key.WithKeys("esc", "ctrl+g"),
 			key.WithHelp("esc", "cancel"),
 		),
+		CopyResponse: key.NewBinding(
+			key.WithKeys("ctrl+y"),
+			key.WithHelp("ctrl+Y", "copy last response/code"),
+		),
+		// Replays the guided tutorial (from the help overlay)
+		Tutorial: key.NewBinding(
+			key.WithKeys("t", "T"),
+			key.WithHelp("T", "take the guided tour"),
+		),
+		// Opens the New Quest form (quest board)
+		NewQuest: key.NewBinding(
+			key.WithKeys("n", "N"),
+			key.WithHelp("N", "new quest"),
+		),
+		// Turns TODO/FIXME comments into quests (quest board)
+		TodoQuests: key.NewBinding(
+			key.WithKeys("t", "T"),
+			key.WithHelp("T", "quests from TODO comments"),
+		),
+		// Switches the quest board between the list and the calendar
+		QuestCalendar: key.NewBinding(
+			key.WithKeys("v", "V"),
+			key.WithHelp("V", "calendar view"),
+		),
+		// Pins the selected quest to the top of the quest board
+		PinQuest: key.NewBinding(
+			key.WithKeys("*"),
+			key.WithHelp("*", "pin/unpin quest"),
+		),
+		// Reorder quests within their quest board section
+		MoveQuestUp: key.NewBinding(
+			key.WithKeys("shift+up", "K"),
+			key.WithHelp("shift+↑/K", "move quest up"),
+		),
+		MoveQuestDown: key.NewBinding(
+			key.WithKeys("shift+down", "J"),
+			key.WithHelp("shift+↓/J", "move quest down"),
+		),
+		// Undo/redo reversible UI actions (filters, quest start/abandon)
+		Undo: key.NewBinding(
+			key.WithKeys("ctrl+z"),
+			key.WithHelp("ctrl+Z", "undo"),
+		),
+		Redo: key.NewBinding(
+			key.WithKeys("ctrl+y"),
+			key.WithHelp("ctrl+Y", "redo"),
+		),
+		// Spend skill points on CodePower / Wisdom / Agility, or refund them
+		AllocateStat: key.NewBinding(
+			key.WithKeys("1", "2", "3"),
+			key.WithHelp("1-3", "allocate skill point"),
+		),
+		Respec: key.NewBinding(
+			key.WithKeys("r", "R"),
+			key.WithHelp("R", "respec stats (press twice)"),
+		),
+		StatDetails: key.NewBinding(
+			key.WithKeys("i", "I"),
+			key.WithHelp("I", "stat details"),
+		),
+		// Reorder dashboard widgets on the settings screen
+		MoveWidgetUp: key.NewBinding(
+			key.WithKeys("shift+up", "K"),
+			key.WithHelp("shift+↑/K", "move widget up"),
+		),
+		MoveWidgetDown: key.NewBinding(
+			key.WithKeys("shift+down", "J"),
+			key.WithHelp("shift+↓/J", "move widget down"),
+		),
+		// Inspect stored keys on the data screen
+		DeleteKey: key.NewBinding(
+			key.WithKeys("x", "X", "delete"),
+			key.WithHelp("X", "delete key (press twice)"),
+		),
+		RefreshData: key.NewBinding(
+			key.WithKeys("r", "R"),
+			key.WithHelp("R", "refresh key list"),
+		),
 	}
 }
 
@@ -186,9 +324,9 @@ func (k *KeyMap) FullHelp() [][]key.Binding {
 		// Column 4: More screens
 		{k.DashboardMentor, k.DashboardSettings, k.DashboardHelpKey},
 		// Column 5: Global shortcuts
-		{k.GlobalDashboard, k.GlobalMentor, k.GlobalSettings, k.GlobalTimer},
+		{k.GlobalDashboard, k.GlobalMentor, k.GlobalSettings, k.GlobalTimer, k.GlobalFocus, k.GlobalSearch},
 		// Column 6: Special functions
-		{k.CommandPalette, k.Save, k.GlobalQuit, k.GlobalHelp},
+		{k.CommandPalette, k.Save, k.Undo, k.Redo, k.GlobalQuit, k.GlobalHelp},
 	}
 }
 
@@ -202,7 +340,13 @@ func (k *KeyMap) DashboardHelp() []key.Binding {
 		k.DashboardMentor,
 		k.DashboardSettings,
 		k.DashboardHelpKey,
+		k.DashboardCopySHA,
+		k.DashboardFeedPet,
+		k.DashboardSeason,
+		k.DashboardData,
 		k.GlobalTimer,
+		k.GlobalFocus,
+		k.GlobalSearch,
 		k.GlobalQuit,
 	}
 }
@@ -214,6 +358,14 @@ func (k *KeyMap) QuestBoardHelp() []key.Binding {
 		k.Up,
 		k.Down,
 		k.Enter,
+		k.NewQuest,
+		k.TodoQuests,
+		k.QuestCalendar,
+		k.PinQuest,
+		k.MoveQuestUp,
+		k.MoveQuestDown,
+		k.Undo,
+		k.Redo,
 		k.GlobalDashboard,
 		k.GlobalMentor,
 		k.Esc,
@@ -223,9 +375,12 @@ func (k *KeyMap) QuestBoardHelp() []key.Binding {
 // CharacterHelp returns key bindings specific to the character sheet screen.
 func (k *KeyMap) CharacterHelp() []key.Binding {
 	return []key.Binding{
-		k.Up,
-		k.Down,
 		k.Tab,
+		k.ShiftTab,
+		k.Enter,
+		k.AllocateStat,
+		k.Respec,
+		k.StatDetails,
 		k.GlobalDashboard,
 		k.Esc,
 	}
@@ -236,21 +391,46 @@ func (k *KeyMap) CharacterHelp() []key.Binding {
 func (k *KeyMap) MentorHelp() []key.Binding {
 	return []key.Binding{
 		k.Enter,
+		k.CopyResponse,
 		k.GlobalDashboard,
 		k.GlobalSettings,
 		k.Esc,
 	}
 }
 
+// SeasonHelp returns key bindings specific to the season pass screen.
+func (k *KeyMap) SeasonHelp() []key.Binding {
+	return []key.Binding{
+		k.Left,
+		k.Right,
+		k.Enter,
+		k.GlobalDashboard,
+		k.Esc,
+	}
+}
+
+// DataHelp returns key bindings specific to the data screen.
+func (k *KeyMap) DataHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Enter,
+		k.DeleteKey,
+		k.RefreshData,
+		k.GlobalDashboard,
+		k.Esc,
+	}
+}
+
 // SettingsHelp returns key bindings specific to the settings screen.
 func (k *KeyMap) SettingsHelp() []key.Binding {
 	return []key.Binding{
 		k.Up,
 		k.Down,
-		k.Tab,
 		k.Space,
-		k.Enter,
-		k.Save,
+		k.MoveWidgetUp,
+		k.MoveWidgetDown,
+		k.Undo,
 		k.Esc,
 	}
 }
@@ -264,7 +444,12 @@ func (k *KeyMap) RenderDashboardHelp() string {
 		RenderKeybind("M", "Mentor") + "\n" +
 		RenderKeybind("S", "Settings") + "  " +
 		RenderKeybind("H", "Help") + "  " +
+		RenderKeybind("P", "Feed pet") + "  " +
+		RenderKeybind("R", "Season pass") + "  " +
+		RenderKeybind("↑/↓", "Select quest") + "  " +
+		RenderKeybind("Enter", "Quest details") + "\n" +
 		RenderKeybind("Ctrl+T", "Timer") + "  " +
+		RenderKeybind("Alt+F", "Focus") + "  " +
 		RenderKeybind("Esc", "Exit")
 }
 
@@ -275,6 +460,10 @@ func (k *KeyMap) RenderQuestBoardHelp() string {
 		RenderKeybind("Alt+S", "Settings") + "\n" +
 		RenderKeybind("↑↓", "Navigate") + "  " +
 		RenderKeybind("Enter", "Accept") + "  " +
+		RenderKeybind("*", "Pin") + "  " +
+		RenderKeybind("Shift+↑↓", "Move") + "\n" +
+		RenderKeybind("T", "TODO quests") + "  " +
+		RenderKeybind("V", "Calendar") + "  " +
 		RenderKeybind("Esc", "Back")
 }
 
@@ -282,8 +471,12 @@ func (k *KeyMap) RenderQuestBoardHelp() string {
 func (k *KeyMap) RenderCharacterHelp() string {
 	return RenderKeybind("Alt+Q", "Dashboard") + "  " +
 		RenderKeybind("Alt+M", "Mentor") + "\n" +
-		RenderKeybind("↑↓", "Navigate") + "  " +
-		RenderKeybind("Tab", "Next Section") + "  " +
+		RenderKeybind("1-3", "Allocate") + "  " +
+		RenderKeybind("R", "Respec") + "  " +
+		RenderKeybind("I", "Stat details") + "  " +
+		RenderKeybind("U", "Read XP scroll") + "\n" +
+		RenderKeybind("Tab/Shift+Tab", "Sections") + "  " +
+		RenderKeybind("Enter", "Expand") + "  " +
 		RenderKeybind("Esc", "Back")
 }
 
@@ -292,16 +485,18 @@ func (k *KeyMap) RenderMentorHelp() string {
 	return RenderKeybind("Alt+Q", "Dashboard") + "  " +
 		RenderKeybind("Alt+S", "Settings") + "\n" +
 		RenderKeybind("Enter", "Send") + "  " +
+		RenderKeybind("Ctrl+Y", "Copy") + "  " +
+		RenderKeybind("Ctrl+E", "Run code") + "  " +
 		RenderKeybind("Esc", "Back")
 }
 
 // RenderSettingsHelp formats the settings screen help text for display.
 func (k *KeyMap) RenderSettingsHelp() string {
-	return RenderKeybind("↑↓", "Navigate") + "  " +
-		RenderKeybind("Space", "Toggle") + "  " +
-		RenderKeybind("Enter", "Edit") + "\n" +
-		RenderKeybind("Ctrl+S", "Save") + "  " +
-		RenderKeybind("Esc", "Cancel")
+	return RenderKeybind("↑↓", "Select") + "  " +
+		RenderKeybind("Space", "Show/Hide, Edit, Switch, Send") + "  " +
+		RenderKeybind("Shift+↑↓", "Move") + "\n" +
+		RenderKeybind("Ctrl+Z", "Undo") + "  " +
+		RenderKeybind("Esc", "Back")
 }
 
 // EnableDashboardKeys enables dashboard-specific single-key shortcuts.
@@ -313,6 +508,10 @@ func (k *KeyMap) EnableDashboardKeys() {
 	k.DashboardMentor.SetEnabled(true)
 	k.DashboardSettings.SetEnabled(true)
 	k.DashboardHelpKey.SetEnabled(true)
+	k.DashboardCopySHA.SetEnabled(true)
+	k.DashboardFeedPet.SetEnabled(true)
+	k.DashboardSeason.SetEnabled(true)
+	k.DashboardData.SetEnabled(true)
 }
 
 // DisableDashboardKeys disables dashboard-specific single-key shortcuts.
@@ -325,6 +524,10 @@ func (k *KeyMap) DisableDashboardKeys() {
 	k.DashboardMentor.SetEnabled(false)
 	k.DashboardSettings.SetEnabled(false)
 	k.DashboardHelpKey.SetEnabled(false)
+	k.DashboardCopySHA.SetEnabled(false)
+	k.DashboardFeedPet.SetEnabled(false)
+	k.DashboardSeason.SetEnabled(false)
+	k.DashboardData.SetEnabled(false)
 }
 
 // EnableAllKeys enables all key bindings.
@@ -335,6 +538,7 @@ func (k *KeyMap) EnableAllKeys() {
 	k.Left.SetEnabled(true)
 	k.Right.SetEnabled(true)
 	k.Tab.SetEnabled(true)
+	k.ShiftTab.SetEnabled(true)
 	k.Enter.SetEnabled(true)
 	k.Esc.SetEnabled(true)
 	k.Space.SetEnabled(true)
@@ -346,11 +550,26 @@ func (k *KeyMap) EnableAllKeys() {
 	k.GlobalSettings.SetEnabled(true)
 	k.GlobalHelp.SetEnabled(true)
 	k.GlobalTimer.SetEnabled(true)
+	k.GlobalFocus.SetEnabled(true)
+	k.GlobalSearch.SetEnabled(true)
 	k.GlobalQuit.SetEnabled(true)
 
 	k.CommandPalette.SetEnabled(true)
 	k.Save.SetEnabled(true)
 	k.Cancel.SetEnabled(true)
+	k.CopyResponse.SetEnabled(true)
+	k.Undo.SetEnabled(true)
+	k.Redo.SetEnabled(true)
+	k.AllocateStat.SetEnabled(true)
+	k.Respec.SetEnabled(true)
+	k.StatDetails.SetEnabled(true)
+	k.MoveWidgetUp.SetEnabled(true)
+	k.MoveWidgetDown.SetEnabled(true)
+	k.PinQuest.SetEnabled(true)
+	k.MoveQuestUp.SetEnabled(true)
+	k.MoveQuestDown.SetEnabled(true)
+	k.DeleteKey.SetEnabled(true)
+	k.RefreshData.SetEnabled(true)
 }
 
 // DisableAllKeys disables all key bindings.
@@ -361,6 +580,7 @@ func (k *KeyMap) DisableAllKeys() {
 	k.Left.SetEnabled(false)
 	k.Right.SetEnabled(false)
 	k.Tab.SetEnabled(false)
+	k.ShiftTab.SetEnabled(false)
 	k.Enter.SetEnabled(false)
 	k.Esc.SetEnabled(false)
 	k.Space.SetEnabled(false)
@@ -372,9 +592,24 @@ func (k *KeyMap) DisableAllKeys() {
 	k.GlobalSettings.SetEnabled(false)
 	k.GlobalHelp.SetEnabled(false)
 	k.GlobalTimer.SetEnabled(false)
+	k.GlobalFocus.SetEnabled(false)
+	k.GlobalSearch.SetEnabled(false)
 	k.GlobalQuit.SetEnabled(false)
 
 	k.CommandPalette.SetEnabled(false)
 	k.Save.SetEnabled(false)
 	k.Cancel.SetEnabled(false)
+	k.CopyResponse.SetEnabled(false)
+	k.Undo.SetEnabled(false)
+	k.Redo.SetEnabled(false)
+	k.AllocateStat.SetEnabled(false)
+	k.Respec.SetEnabled(false)
+	k.StatDetails.SetEnabled(false)
+	k.MoveWidgetUp.SetEnabled(false)
+	k.MoveWidgetDown.SetEnabled(false)
+	k.PinQuest.SetEnabled(false)
+	k.MoveQuestUp.SetEnabled(false)
+	k.MoveQuestDown.SetEnabled(false)
+	k.DeleteKey.SetEnabled(false)
+	k.RefreshData.SetEnabled(false)
 }
@@ -0,0 +1,31 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file records XP changes made in the UI (accepted held XP, quest
+// rewards, respecs) in the XP ledger, like the game handler does for
+// commits and quests, so `codequest audit` can account for them.
+package ui
+
+import (
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// publishXPChange records an XP change in the ledger and counts XP gained
+// toward the season pass, announcing any tiers it unlocks.
+//
+// Parameters:
+//   - amount: XP gained, negative when spent (0 records nothing)
+//   - source: What changed it (game.XPSource constants)
+func (m Model) publishXPChange(amount int, source string) {
+	if amount == 0 || m.character == nil {
+		return
+	}
+	reached := m.character.AddSeasonXP(amount, time.Now(), game.StreakClockFromConfig(m.config))
+	if m.eventBus == nil {
+		return
+	}
+	m.eventBus.Publish(game.NewXPChangedEvent(amount, source, m.character.TotalXP()))
+	for _, tier := range reached {
+		m.eventBus.Publish(game.NewSeasonTierEvent(m.character.Season, tier))
+	}
+}
@@ -0,0 +1,56 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the celebration limits ([limits] config): XP toasts,
+// level-ups, quest completions and records are dropped past the daily
+// commit cap, during quiet hours or in weekly-summary-only mode, where a
+// summary of the past week is shown once a week instead.
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// celebrationLimits returns the configured celebration limits.
+func (m Model) celebrationLimits() game.CelebrationLimits {
+	return game.CelebrationLimitsFromConfig(m.config)
+}
+
+// celebrateCommit reports whether a just-detected commit gets an XP toast.
+// The loaded character is the state before the commit, so its count for
+// today is the commits made earlier.
+func (m Model) celebrateCommit(now time.Time) bool {
+	limits := m.celebrationLimits()
+	earlier := 0
+	if m.character != nil {
+		earlier = m.character.CommitsOnDay(limits.Clock.Day(now))
+	}
+	return limits.CelebrateCommit(earlier, now)
+}
+
+// showWeeklySummary queues the weekly summary in weekly-summary-only mode,
+// once per week, and saves that it was shown. It waits until both the
+// character and the quests are loaded.
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Save command when a summary was queued (nil otherwise)
+func (m Model) showWeeklySummary() (Model, tea.Cmd) {
+	limits := m.celebrationLimits()
+	if !limits.WeeklyOnly || m.character == nil || m.quests == nil {
+		return m, nil
+	}
+	summary, ok := m.character.TakeWeeklySummary(m.quests, time.Now(), limits.Clock)
+	if !ok {
+		return m, nil
+	}
+	m.addNotification(Notification{
+		Message:   summary.Message(),
+		Type:      NotificationInfo,
+		Duration:  6 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, m.saveStateCmd()
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestCelebrationLimitsDropToasts tests that the daily commit cap and
+// weekly-summary-only mode hold back commit toasts and the level-up fanfare
+// while other notifications still show.
+func TestCelebrationLimitsDropToasts(t *testing.T) {
+	tests := []struct {
+		name          string
+		limits        config.LimitsConfig
+		earlierToday  int
+		wantToast     bool
+		wantCelebrate bool
+	}{
+		{"no limits", config.LimitsConfig{}, 5, true, true},
+		{"under the daily cap", config.LimitsConfig{MaxCelebratedCommits: 2}, 1, true, true},
+		{"past the daily cap", config.LimitsConfig{MaxCelebratedCommits: 2}, 2, false, true},
+		{"weekly summary only", config.LimitsConfig{WeeklySummaryOnly: true}, 0, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Limits = tt.limits
+			character := game.NewCharacter("Tester")
+			if tt.earlierToday > 0 {
+				character.RecordActivityDay(time.Now(), tt.earlierToday, game.StreakClockFromConfig(cfg))
+			}
+			m := Model{keys: NewKeyMap(), config: cfg, character: character, width: 100, height: 40}
+
+			model, _ := m.Update(commitDetectedMsg{sha: "abc1234", message: "feat: one", xpAwarded: 30})
+			m = model.(Model)
+			gotToast := m.currentNotification != nil || len(m.notifications) > 0
+			if gotToast != tt.wantToast {
+				t.Errorf("commit toast shown = %v, want %v", gotToast, tt.wantToast)
+			}
+
+			m.currentNotification, m.notifications = nil, nil
+			model, _ = m.Update(levelUpMsg{oldLevel: 1, newLevel: 2})
+			m = model.(Model)
+			gotCelebrate := m.fanfare != nil || m.currentNotification != nil || len(m.notifications) > 0
+			if gotCelebrate != tt.wantCelebrate {
+				t.Errorf("level-up celebrated = %v, want %v", gotCelebrate, tt.wantCelebrate)
+			}
+
+			m.fanfare, m.currentNotification, m.notifications = nil, nil, nil
+			m.addNotification(Notification{Message: "Disk full", Type: NotificationError})
+			if len(m.notifications) != 1 {
+				t.Error("non-celebration notifications should always be queued")
+			}
+		})
+	}
+}
+
+// TestShowWeeklySummary tests that weekly-summary-only mode shows the
+// summary once per week, after both the character and quests load.
+func TestShowWeeklySummary(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Limits.WeeklySummaryOnly = true
+	m := Model{keys: NewKeyMap(), config: cfg, character: game.NewCharacter("Tester"), width: 100, height: 40}
+
+	if m, cmd := m.showWeeklySummary(); cmd != nil || len(m.notifications) != 0 {
+		t.Fatal("summary should wait for quests to load")
+	}
+
+	model, _ := m.Update(questsLoadedMsg{quests: []*game.Quest{}})
+	m = model.(Model)
+	if m.currentNotification == nil || !strings.Contains(m.currentNotification.Message, "YOUR WEEK") {
+		t.Fatalf("notification = %+v, want the weekly summary", m.currentNotification)
+	}
+
+	m.currentNotification = nil
+	var cmd tea.Cmd
+	if m, cmd = m.showWeeklySummary(); cmd != nil || len(m.notifications) != 0 {
+		t.Error("summary should be shown only once per week")
+	}
+}
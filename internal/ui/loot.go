@@ -0,0 +1,72 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements loot drops in the UI: the drop announcement, styled
+// by the item's rarity, and reading a dropped XP scroll with U on the
+// character sheet.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// lootDropMsg is sent when a commit drops loot.
+type lootDropMsg struct {
+	drop game.LootDrop
+}
+
+// announceLoot queues the loot drop announcement and logs the drop in the
+// activity feed.
+//
+// Parameters:
+//   - msg: The item dropped
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) announceLoot(msg lootDropMsg) Model {
+	drop := msg.drop
+	icon := screens.LootIcon(drop.Kind)
+	m.recordActivity("🎁", fmt.Sprintf("Loot: %s (%s)", drop.Name, drop.Rarity))
+
+	message := fmt.Sprintf("🎁 %s LOOT DROP! 🎁\n%s %s", strings.ToUpper(string(drop.Rarity)), icon, drop.Name)
+	switch drop.Kind {
+	case game.LootXPScroll:
+		message += " · press U on the character sheet to read it"
+	case game.LootStreakFreeze:
+		message += " · covers a missed day"
+	case game.LootCosmetic:
+		message += " · added to your collection"
+	}
+	if drop.Pity {
+		message += "\n(your luck finally turned)"
+	}
+	m.addNotification(Notification{
+		Message:     message,
+		Type:        NotificationLoot,
+		Duration:    5 * time.Second,
+		Timestamp:   time.Now(),
+		Celebration: true,
+	})
+	return m
+}
+
+// useXPScroll reads a held XP scroll for a commit XP boost and saves.
+func (m Model) useXPScroll() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+	now := time.Now()
+	if err := m.character.UseXPScroll(now); err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't read a scroll: %v", err), NotificationWarning)
+	}
+
+	remaining := m.character.XPBoostUntil.Sub(now).Round(time.Minute)
+	model, notify := m.notifyAction(fmt.Sprintf("📜 XP scroll read: +%d%% commit XP for %s (%d left)",
+		game.XPBoostPercent, remaining, m.character.XPScrolls), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
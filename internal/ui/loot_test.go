@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestAnnounceLoot tests the loot drop announcement.
+func TestAnnounceLoot(t *testing.T) {
+	tests := []struct {
+		name string
+		drop game.LootDrop
+		want []string
+	}{
+		{
+			name: "scroll",
+			drop: game.LootDrop{Kind: game.LootXPScroll, Name: "XP Scroll", Rarity: game.LootCommon},
+			want: []string{"COMMON LOOT DROP", "📜 XP Scroll", "press U"},
+		},
+		{
+			name: "pity cosmetic",
+			drop: game.LootDrop{Kind: game.LootCosmetic, Name: "Pocket Debugger", Rarity: game.LootEpic, Pity: true},
+			want: []string{"EPIC LOOT DROP", "✨ Pocket Debugger", "luck finally turned"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{}
+			m = m.announceLoot(lootDropMsg{drop: tt.drop})
+
+			if len(m.notifications) != 1 || m.notifications[0].Type != NotificationLoot {
+				t.Fatalf("notifications = %+v, want one loot notification", m.notifications)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(m.notifications[0].Message, want) {
+					t.Errorf("message %q doesn't mention %q", m.notifications[0].Message, want)
+				}
+			}
+			if len(m.activityFeed) != 1 {
+				t.Errorf("activity feed has %d items, want 1", len(m.activityFeed))
+			}
+		})
+	}
+}
+
+// TestUseXPScrollKey tests reading an XP scroll with U on the character sheet.
+func TestUseXPScrollKey(t *testing.T) {
+	character := game.NewCharacter("Reader")
+	character.XPScrolls = 1
+	m := Model{
+		keys:          NewKeyMap(),
+		config:        config.DefaultConfig(),
+		character:     character,
+		currentScreen: ScreenCharacter,
+		width:         100,
+		height:        40,
+	}
+
+	u := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}}
+	m = pressKey(t, m, u)
+	if character.XPScrolls != 0 || !character.XPBoostActive(time.Now()) {
+		t.Fatalf("scrolls = %d, boost until %v; want the scroll read into a boost", character.XPScrolls, character.XPBoostUntil)
+	}
+
+	until := character.XPBoostUntil
+	m = pressKey(t, m, u)
+	if !character.XPBoostUntil.Equal(until) {
+		t.Error("U without a scroll shouldn't change the boost")
+	}
+}
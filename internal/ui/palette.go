@@ -0,0 +1,38 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file applies the configured color palette (ui.palette) to the ui
+// styles and the screens, so color-blind players get success, warning and
+// error colors they can tell apart.
+package ui
+
+import "github.com/AutumnsGrove/codequest/internal/ui/screens"
+
+// ApplyPalette recolors the semantic color roles in the ui and screens
+// packages. Call it before the program starts rendering.
+//
+// Parameters:
+//   - name: Palette name from the config ("" or unknown names use the default)
+func ApplyPalette(name string) {
+	palette := screens.PaletteByName(name)
+	screens.ApplyPalette(palette)
+
+	ColorSuccess = palette.Success
+	ColorWarning = palette.Warning
+	ColorError = palette.Error
+	ColorInfo = palette.Info
+	ColorQuest = palette.Progress
+	ColorXP = palette.Reward
+
+	SuccessTextStyle = SuccessTextStyle.Foreground(ColorSuccess)
+	WarningTextStyle = WarningTextStyle.Foreground(ColorWarning)
+	ErrorTextStyle = ErrorTextStyle.Foreground(ColorError)
+	InfoTextStyle = InfoTextStyle.Foreground(ColorInfo)
+	XPBarStyle = XPBarStyle.Foreground(ColorXP)
+	QuestProgressBarStyle = QuestProgressBarStyle.Foreground(ColorQuest)
+	HealthBarStyle = HealthBarStyle.Foreground(ColorSuccess)
+	StatusActiveStyle = StatusActiveStyle.Foreground(ColorSuccess)
+	StatusCompletedStyle = StatusCompletedStyle.Foreground(ColorInfo)
+	StatusFailedStyle = StatusFailedStyle.Foreground(ColorError)
+	StatusPendingStyle = StatusPendingStyle.Foreground(ColorWarning)
+	TimerStyle = TimerStyle.Foreground(ColorInfo)
+	NotificationStyle = NotificationStyle.BorderForeground(ColorSuccess)
+}
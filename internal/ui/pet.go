@@ -0,0 +1,37 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements feeding the companion pet from the dashboard (P),
+// which uses up the most recently collected trophy item.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// feedPet feeds the companion a trophy item and saves.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) feedPet() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+
+	item, grew, err := m.character.FeedPet(time.Now(), game.StreakClockFromConfig(m.config))
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't feed your companion: %v", err), NotificationWarning)
+	}
+
+	message := fmt.Sprintf("🐾 Your companion ate the %s", item.Name)
+	if grew {
+		message += fmt.Sprintf("\nIt grew into a %s!", game.PetStageName(m.character.Pet.Stage))
+		m.recordActivity("🐾", "Companion grew into a "+game.PetStageName(m.character.Pet.Stage))
+	}
+	model, notify := m.notifyAction(message, NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
@@ -0,0 +1,133 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file contains a small one-shot picker program used by headless CLI
+// commands when a required argument is omitted (Gum-style selection).
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Picker dimensions used before the first WindowSizeMsg arrives
+const (
+	pickerDefaultWidth  = 60
+	pickerDefaultHeight = 14
+)
+
+// PickerItem is a single selectable entry in a picker.
+type PickerItem struct {
+	Value  string // Value returned to the caller (e.g. a quest ID)
+	Label  string // Primary text shown in the list
+	Detail string // Secondary text shown under the label
+}
+
+// Title implements list.DefaultItem.
+func (i PickerItem) Title() string { return i.Label }
+
+// Description implements list.DefaultItem.
+func (i PickerItem) Description() string { return i.Detail }
+
+// FilterValue implements list.Item so the picker can be filtered with /.
+func (i PickerItem) FilterValue() string { return i.Label }
+
+// pickerModel is the Bubble Tea model behind RunPicker.
+type pickerModel struct {
+	list     list.Model
+	selected *PickerItem
+	quitting bool
+}
+
+// Init implements tea.Model.
+func (m pickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+// Enter selects the highlighted item; Esc or Ctrl+C cancels.
+func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width, min(msg.Height, pickerDefaultHeight))
+		return m, nil
+
+	case tea.KeyMsg:
+		// Let the list handle keys while the user is typing a filter
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch msg.String() {
+		case "enter":
+			if item, ok := m.list.SelectedItem().(PickerItem); ok {
+				m.selected = &item
+			}
+			m.quitting = true
+			return m, tea.Quit
+		case "esc", "ctrl+c", "q":
+			m.quitting = true
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model.
+func (m pickerModel) View() string {
+	if m.quitting {
+		return ""
+	}
+	return m.list.View()
+}
+
+// RunPicker shows an interactive list and blocks until the user picks an item.
+// The picker runs inline (no alternate screen) so it feels like a shell prompt.
+//
+// Parameters:
+//   - title: Heading shown above the list
+//   - items: Entries to choose from (must not be empty)
+//
+// Returns:
+//   - *PickerItem: The chosen item, or nil if the user cancelled
+//   - error: An error if there is nothing to pick, stdin is not a terminal,
+//     or the program fails
+func RunPicker(title string, items []PickerItem) (*PickerItem, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("nothing to choose from")
+	}
+	if !IsInteractive() {
+		return nil, fmt.Errorf("cannot show picker: stdin is not a terminal")
+	}
+
+	listItems := make([]list.Item, len(items))
+	for i, item := range items {
+		listItems[i] = item
+	}
+
+	l := list.New(listItems, list.NewDefaultDelegate(), pickerDefaultWidth, pickerDefaultHeight)
+	l.Title = title
+	l.Styles.Title = TitleStyle
+	l.SetShowHelp(true)
+
+	result, err := tea.NewProgram(pickerModel{list: l}).Run()
+	if err != nil {
+		return nil, fmt.Errorf("running picker: %w", err)
+	}
+
+	return result.(pickerModel).selected, nil
+}
+
+// IsInteractive reports whether stdin is attached to a terminal.
+// Headless commands use this to decide between a picker and an error.
+func IsInteractive() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,73 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements low-power mode in the UI: while it's on (on battery,
+// or power.low_power = "on"), animations are skipped, the session timer
+// redraws every 15 seconds instead of every second, toasts stay up longer
+// so the queue wakes the UI less, and the footer shows a small indicator.
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/power"
+)
+
+// Low-power timing
+const (
+	lowPowerTimerInterval      = 15 * time.Second // Session timer redraws in low-power mode
+	lowPowerNotificationFactor = 2                // How many times longer toasts stay up
+)
+
+// SetPowerMonitor enables low-power mode, following the monitor's state.
+// Call before the program starts.
+//
+// Parameters:
+//   - monitor: Low-power mode state (shared with the watcher)
+func (m *Model) SetPowerMonitor(monitor *power.Monitor) {
+	m.power = monitor
+}
+
+// lowPower reports whether low-power mode is on.
+func (m Model) lowPower() bool {
+	return m.power.Low()
+}
+
+// timerTick returns a command that sends a timerTickMsg after a second, or
+// after lowPowerTimerInterval in low-power mode.
+func (m Model) timerTick() tea.Cmd {
+	interval := time.Second
+	if m.lowPower() {
+		interval = lowPowerTimerInterval
+	}
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return timerTickMsg(t)
+	})
+}
+
+// notificationDuration returns how long a toast stays up, longer in
+// low-power mode.
+//
+// Parameters:
+//   - duration: The toast's normal duration
+//
+// Returns:
+//   - time.Duration: How long to show it
+func (m Model) notificationDuration(duration time.Duration) time.Duration {
+	if m.lowPower() {
+		return duration * lowPowerNotificationFactor
+	}
+	return duration
+}
+
+// viewPowerMode renders the footer's low-power indicator ("" when off).
+func (m Model) viewPowerMode() string {
+	if !m.lowPower() {
+		return ""
+	}
+	label := "  |  🔋 Low power"
+	if m.power.OnBattery() {
+		label += " (on battery)"
+	}
+	return MutedTextStyle.Render(label)
+}
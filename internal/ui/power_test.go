@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/power"
+)
+
+// TestLowPowerMode tests that low-power mode turns animations off, stretches
+// toasts and shows the footer indicator, and that "off" changes nothing.
+func TestLowPowerMode(t *testing.T) {
+	tests := []struct {
+		setting        string
+		wantAnimations bool
+		wantDuration   time.Duration
+		wantIndicator  bool
+	}{
+		{power.ModeOn, false, 6 * time.Second, true},
+		{power.ModeOff, true, 3 * time.Second, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.setting, func(t *testing.T) {
+			m := Model{config: config.DefaultConfig()}
+			m.SetPowerMonitor(power.NewMonitor(tt.setting))
+
+			if got := m.animationsEnabled(); got != tt.wantAnimations {
+				t.Errorf("animationsEnabled() = %v, want %v", got, tt.wantAnimations)
+			}
+			if got := m.notificationDuration(3 * time.Second); got != tt.wantDuration {
+				t.Errorf("notificationDuration() = %v, want %v", got, tt.wantDuration)
+			}
+			if got := strings.Contains(m.viewPowerMode(), "Low power"); got != tt.wantIndicator {
+				t.Errorf("viewPowerMode() = %q, want indicator %v", m.viewPowerMode(), tt.wantIndicator)
+			}
+		})
+	}
+}
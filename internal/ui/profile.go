@@ -0,0 +1,97 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements editing the character's profile (motto, pronouns,
+// avatar seed and favorite language) from the Settings screen, where the
+// profile rows follow the dashboard widgets.
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// profileFieldAt returns the profile field on a Settings row, if the row is
+// past the dashboard widgets.
+//
+// Parameters:
+//   - row: Settings row index
+//
+// Returns:
+//   - game.ProfileField: The field on that row
+//   - bool: False if the row is a dashboard widget
+func profileFieldAt(row int) (game.ProfileField, bool) {
+	index := row - len(screens.DashboardWidgets())
+	if index < 0 || index >= len(game.ProfileFields) {
+		return "", false
+	}
+	return game.ProfileFields[index], true
+}
+
+// editProfileField opens the input for a profile field, filled with its
+// current value.
+func (m Model) editProfileField(field game.ProfileField) (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m.notifyAction("No character to edit", NotificationWarning)
+	}
+
+	input := textinput.New()
+	input.Placeholder = game.ProfileFieldLabel(field)
+	input.CharLimit = game.ProfileFieldLimit(field)
+	input.Width = max(min(m.width-40, game.ProfileFieldLimit(field)), 20)
+	input.SetValue(m.character.Profile.Get(field))
+	input.CursorEnd()
+
+	m.editingProfile = field
+	m.profileInput = input
+	return m, m.profileInput.Focus()
+}
+
+// handleProfileEditKeys handles keys while a profile field is being edited:
+// Enter saves the value, Esc discards it, anything else goes to the input.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands, or the input's command
+func (m Model) handleProfileEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Enter):
+		field := m.editingProfile
+		if err := m.character.SetProfileField(field, m.profileInput.Value()); err != nil {
+			return m.notifyAction(fmt.Sprintf("Can't save: %v", err), NotificationWarning)
+		}
+		m.editingProfile = ""
+		m.profileInput.Blur()
+
+		message := game.ProfileFieldLabel(field) + " saved"
+		if m.character.Profile.Get(field) == "" {
+			message = game.ProfileFieldLabel(field) + " cleared"
+		}
+		model, notify := m.notifyAction("👤 "+message, NotificationSuccess)
+		return model, tea.Batch(m.saveStateCmd(), notify)
+	case key.Matches(msg, m.keys.Esc):
+		m.editingProfile = ""
+		m.profileInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.profileInput, cmd = m.profileInput.Update(msg)
+	return m, cmd
+}
+
+// profileEditorView renders the profile input, or "" when no field is being
+// edited.
+func (m Model) profileEditorView() string {
+	if m.editingProfile == "" {
+		return ""
+	}
+	return m.profileInput.View()
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestSettingsProfileEditing tests editing profile fields from the
+// Settings screen, where they follow the dashboard widgets.
+func TestSettingsProfileEditing(t *testing.T) {
+	tests := []struct {
+		name   string
+		typed  string
+		finish tea.KeyMsg
+		want   string
+	}{
+		{"enter saves", "Ship it?", tea.KeyMsg{Type: tea.KeyEnter}, "Ship it?"},
+		{"esc discards", "Ship it?", tea.KeyMsg{Type: tea.KeyEsc}, "Old motto"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			character.Profile.Motto = "Old motto"
+			m := Model{
+				keys:          NewKeyMap(),
+				config:        config.DefaultConfig(),
+				character:     character,
+				currentScreen: ScreenSettings,
+				width:         100,
+				height:        40,
+			}
+
+			// Down past the widgets to the first profile field (Motto)
+			for range screens.DashboardWidgets() {
+				m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyDown})
+			}
+			m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+			if m.editingProfile != game.ProfileMotto {
+				t.Fatalf("editingProfile = %q, want motto", m.editingProfile)
+			}
+
+			// Typing replaces the value; "?" goes to the input, not the help overlay
+			m.profileInput.SetValue("")
+			for _, r := range tt.typed {
+				m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			}
+			if m.showingHelp {
+				t.Fatal("typing ? should not open the help overlay")
+			}
+			m = pressKey(t, m, tt.finish)
+
+			if m.editingProfile != "" {
+				t.Error("editing should end")
+			}
+			if m.currentScreen != ScreenSettings {
+				t.Errorf("currentScreen = %v, want Settings", m.currentScreen)
+			}
+			if got := m.character.Profile.Motto; got != tt.want {
+				t.Errorf("Motto = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
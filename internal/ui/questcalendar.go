@@ -0,0 +1,108 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the Quest Board's calendar view (V): recurring quests
+// over the next few weeks, navigated by day and week, with quests opened
+// straight from the selected day.
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// questCalendar lays out the recurring quests in the current project.
+//
+// Returns:
+//   - [][]game.CalendarDay: Weeks of days from today's week on
+//   - time.Time: Today, as the calendar counts days
+func (m Model) questCalendar() ([][]game.CalendarDay, time.Time) {
+	clock := game.StreakClockFromConfig(m.config)
+	now := time.Now()
+	quests := game.FilterQuestsByProject(m.quests, m.projectFilter)
+	return game.QuestCalendar(quests, now, game.CalendarWeeks, clock), clock.Day(now)
+}
+
+// toggleQuestCalendar switches the Quest Board between the list and the
+// calendar. The calendar opens with today selected.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Always nil
+func (m Model) toggleQuestCalendar() (tea.Model, tea.Cmd) {
+	m.showingCalendar = !m.showingCalendar
+	if m.showingCalendar {
+		calendar, today := m.questCalendar()
+		m.calendarDay = int(today.Sub(calendar[0][0].Date).Hours() / 24)
+		m.calendarEvent = 0
+	}
+	return m, nil
+}
+
+// handleQuestCalendarKeys handles keyboard input in the calendar view.
+//
+// Supports:
+//   - Left/Right: Previous/next day
+//   - Up/Down: Previous/next week
+//   - Tab: Next quest on the selected day
+//   - Enter: Open the selected quest's detail view
+//   - V/Esc: Back to the quest list
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Optional command
+func (m Model) handleQuestCalendarKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	calendar, _ := m.questCalendar()
+	day := screens.CalendarDayAt(calendar, m.calendarDay)
+
+	move := func(days int) {
+		if next := m.calendarDay + days; next >= 0 && next < len(calendar)*7 {
+			m.calendarDay = next
+			m.calendarEvent = 0
+		}
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Left):
+		move(-1)
+	case key.Matches(msg, m.keys.Right):
+		move(1)
+	case key.Matches(msg, m.keys.Up):
+		move(-7)
+	case key.Matches(msg, m.keys.Down):
+		move(7)
+	case key.Matches(msg, m.keys.Tab):
+		if day != nil && len(day.Events) > 0 {
+			m.calendarEvent = (m.calendarEvent + 1) % len(day.Events)
+		}
+	case key.Matches(msg, m.keys.Enter):
+		if day != nil && m.calendarEvent < len(day.Events) {
+			m.questDetail = day.Events[m.calendarEvent].Quest
+			m.editingNotes = false
+		}
+	case key.Matches(msg, m.keys.QuestCalendar), key.Matches(msg, m.keys.Esc):
+		return m.toggleQuestCalendar()
+	}
+	return m, nil
+}
+
+// viewQuestCalendar renders the calendar view.
+func (m Model) viewQuestCalendar() string {
+	calendar, today := m.questCalendar()
+	return screens.RenderQuestCalendar(
+		m.character,
+		calendar,
+		today,
+		m.calendarDay,
+		m.calendarEvent,
+		m.projectFilter,
+		m.width,
+		m.height,
+	)
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestQuestCalendarView tests switching the quest board to the calendar,
+// moving to tomorrow and opening its daily quest.
+func TestQuestCalendarView(t *testing.T) {
+	daily := game.NewQuest("Daily Commit", "Commit once today", game.QuestTypeDaily, 1, 25, 1)
+	oneOff := game.NewQuest("Ship It", "Make 5 commits", game.QuestTypeCommit, 5, 100, 1)
+	m := Model{
+		keys:          NewKeyMap(),
+		character:     veteranCharacter(),
+		quests:        []*game.Quest{daily, oneOff},
+		currentScreen: ScreenQuestBoard,
+		width:         120,
+		height:        50,
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	view := m.View()
+	if !m.showingCalendar || !strings.Contains(view, "Calendar") || !strings.Contains(view, "Daily Commit") {
+		t.Fatal("V should show the calendar with the daily quest")
+	}
+	if strings.Contains(view, "Ship It") {
+		t.Error("one-off quests should not be on the calendar")
+	}
+
+	calendar, today := m.questCalendar()
+	if day := calendar[m.calendarDay/7][m.calendarDay%7]; !day.Date.Equal(today) {
+		t.Errorf("calendar opened on %v, want today %v", day.Date, today)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.questDetail != daily {
+		t.Fatalf("Enter opened %v, want the daily quest", m.questDetail)
+	}
+
+	m.questDetail = nil
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showingCalendar {
+		t.Error("Esc should go back to the quest list")
+	}
+}
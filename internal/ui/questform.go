@@ -0,0 +1,335 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the New Quest form (N on the quest board): custom
+// quests are filled in field by field, checked as they're submitted and
+// saved straight away, without writing a template file.
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Fields of the New Quest form, in display order.
+const (
+	questFormTitle = iota
+	questFormDescription
+	questFormType
+	questFormTarget
+	questFormReward
+	questFormScope
+	questFormDeadline
+	questFormFieldCount
+)
+
+// questFormLabels are the field labels, by field.
+var questFormLabels = [questFormFieldCount]string{
+	questFormTitle:       "Title",
+	questFormDescription: "Description",
+	questFormType:        "Type",
+	questFormTarget:      "Target",
+	questFormReward:      "XP reward",
+	questFormScope:       "Scope",
+	questFormDeadline:    "Deadline",
+}
+
+// questFormTypes are the quest types the form offers. Todo and task quests
+// are left out: they're made from a comment or task list item.
+var questFormTypes = []game.QuestType{
+	game.QuestTypeCommit,
+	game.QuestTypeLines,
+	game.QuestTypeDocs,
+	game.QuestTypeTests,
+	game.QuestTypeDeps,
+	game.QuestTypeWellFormed,
+	game.QuestTypeReview,
+	game.QuestTypePeerReview,
+	game.QuestTypePairing,
+	game.QuestTypeOpenSource,
+}
+
+// questScope is where a new quest's progress counts.
+type questScope struct {
+	label   string // Shown in the form
+	repo    string // Repository path ("" for any)
+	project string // Project name ("" for any)
+}
+
+// questForm is the open New Quest form.
+type questForm struct {
+	inputs    [questFormFieldCount]textinput.Model // Text fields (unused for Type and Scope)
+	typeIndex int                                  // Chosen entry of questFormTypes
+	scopes    []questScope                         // Scopes to choose from ("any repository" first)
+	scope     int                                  // Chosen scope
+	focus     int                                  // Focused field
+	errs      map[int]string                       // Problems found on submit, by field
+	problem   string                               // Problem not tied to one field
+}
+
+// isChoice reports whether a field is picked with ←/→ instead of typed.
+func (f *questForm) isChoice(field int) bool {
+	return field == questFormType || field == questFormScope
+}
+
+// openQuestForm opens an empty New Quest form on the title field.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Focuses the title input
+func (m Model) openQuestForm() (tea.Model, tea.Cmd) {
+	form := &questForm{scopes: []questScope{{label: "Any repository"}}}
+	if m.config != nil {
+		for _, name := range m.config.ProjectNames() {
+			form.scopes = append(form.scopes, questScope{label: "Project: " + name, project: name})
+		}
+		for _, path := range m.config.Git.WatchPaths {
+			form.scopes = append(form.scopes, questScope{label: "Repo: " + filepath.Base(path), repo: path})
+		}
+	}
+
+	placeholders := map[int]string{
+		questFormTitle:       "Bug Hunt",
+		questFormDescription: "What needs doing (optional)",
+		questFormTarget:      "How many (e.g. 5 commits)",
+		questFormReward:      "Base XP on completion",
+		questFormDeadline:    "YYYY-MM-DD (optional)",
+	}
+	limits := map[int]int{questFormTitle: 60, questFormDescription: 200, questFormTarget: 6, questFormReward: 6, questFormDeadline: 10}
+	for field, placeholder := range placeholders {
+		input := textinput.New()
+		input.Placeholder = placeholder
+		input.Prompt = ""
+		input.CharLimit = limits[field]
+		input.Width = max(min(m.width-40, 50), 20)
+		form.inputs[field] = input
+	}
+	form.inputs[questFormTarget].SetValue("1")
+	form.inputs[questFormReward].SetValue("100")
+
+	m.questForm = form
+	return m, m.questForm.inputs[questFormTitle].Focus()
+}
+
+// handleQuestFormKeys handles keys while the form is open: Tab/↓ and
+// Shift+Tab/↑ move between fields, ←/→ change the type and scope, Enter
+// creates the quest and Esc discards it. Anything else edits the focused
+// text field.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Focus, input, or save and notification commands
+func (m Model) handleQuestFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := *m.questForm
+	m.questForm = &form
+
+	// Only the arrow keys move, so h, j, k and l can still be typed
+	switch {
+	case key.Matches(msg, m.keys.Esc):
+		m.questForm = nil
+		return m, nil
+	case key.Matches(msg, m.keys.Enter):
+		return m.submitQuestForm()
+	case msg.Type == tea.KeyTab || msg.Type == tea.KeyDown:
+		return m, form.focusField((form.focus + 1) % questFormFieldCount)
+	case msg.Type == tea.KeyShiftTab || msg.Type == tea.KeyUp:
+		return m, form.focusField((form.focus + questFormFieldCount - 1) % questFormFieldCount)
+	}
+
+	if form.isChoice(form.focus) {
+		step := 0
+		switch msg.Type {
+		case tea.KeyLeft:
+			step = -1
+		case tea.KeyRight, tea.KeySpace:
+			step = 1
+		}
+		if form.focus == questFormType {
+			form.typeIndex = (form.typeIndex + step + len(questFormTypes)) % len(questFormTypes)
+		} else {
+			form.scope = (form.scope + step + len(form.scopes)) % len(form.scopes)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	form.inputs[form.focus], cmd = form.inputs[form.focus].Update(msg)
+	return m, cmd
+}
+
+// focusField moves the cursor to a field.
+//
+// Parameters:
+//   - field: Field to focus
+//
+// Returns:
+//   - tea.Cmd: Focuses the field's input (nil for choice fields)
+func (f *questForm) focusField(field int) tea.Cmd {
+	if !f.isChoice(f.focus) {
+		f.inputs[f.focus].Blur()
+	}
+	f.focus = field
+	if f.isChoice(field) {
+		return nil
+	}
+	return f.inputs[field].Focus()
+}
+
+// build checks every field and makes the quest. Problems are recorded on
+// the form by field; the quest is nil if there are any.
+//
+// Parameters:
+//   - now: Current time (deadlines can't be in the past)
+//
+// Returns:
+//   - *game.Quest: The new, available quest
+func (f *questForm) build(now time.Time) *game.Quest {
+	f.errs = make(map[int]string)
+	f.problem = ""
+	value := func(field int) string { return strings.TrimSpace(f.inputs[field].Value()) }
+
+	builder := game.NewQuestBuilder().
+		Title(value(questFormTitle)).
+		Description(value(questFormDescription)).
+		Type(questFormTypes[f.typeIndex])
+	if value(questFormTitle) == "" {
+		f.errs[questFormTitle] = "required"
+	}
+
+	if target, err := strconv.Atoi(value(questFormTarget)); err != nil {
+		f.errs[questFormTarget] = "must be a whole number"
+	} else if target < 1 {
+		f.errs[questFormTarget] = "must be at least 1"
+	} else {
+		builder.Target(target)
+	}
+
+	if reward, err := strconv.Atoi(value(questFormReward)); err != nil {
+		f.errs[questFormReward] = "must be a whole number"
+	} else if reward < 0 {
+		f.errs[questFormReward] = "can't be negative"
+	} else {
+		builder.XPReward(reward)
+	}
+
+	if scope := f.scopes[f.scope]; scope.project != "" {
+		builder.Project(scope.project)
+	} else if scope.repo != "" {
+		builder.Repo(scope.repo)
+	}
+
+	if deadline := value(questFormDeadline); deadline != "" {
+		due, err := time.ParseInLocation("2006-01-02", deadline, now.Location())
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		switch {
+		case err != nil:
+			f.errs[questFormDeadline] = "use YYYY-MM-DD"
+		case due.Before(today):
+			f.errs[questFormDeadline] = "is in the past"
+		default:
+			builder.Deadline(due)
+		}
+	}
+
+	if len(f.errs) > 0 {
+		return nil
+	}
+	quest, err := builder.Build()
+	if err != nil {
+		// Anything the fields don't catch; show the reasons, not the wrapper
+		var joined interface{ Unwrap() []error }
+		if errors.As(err, &joined) {
+			var reasons []string
+			for _, reason := range joined.Unwrap() {
+				reasons = append(reasons, reason.Error())
+			}
+			f.problem = strings.Join(reasons, "; ")
+		} else {
+			f.problem = err.Error()
+		}
+		return nil
+	}
+	return quest
+}
+
+// submitQuestForm adds the quest to the quest log and saves it, or keeps
+// the form open showing what's wrong.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands (nil if the form has problems)
+func (m Model) submitQuestForm() (tea.Model, tea.Cmd) {
+	quest := m.questForm.build(time.Now())
+	if quest == nil {
+		for field := 0; field < questFormFieldCount; field++ {
+			if _, bad := m.questForm.errs[field]; bad {
+				return m, m.questForm.focusField(field)
+			}
+		}
+		return m, nil
+	}
+
+	m.questForm = nil
+	m.quests = append(m.quests, quest)
+	m.recordActivity("📜", "Quest created: "+quest.Title)
+	model, notify := m.notifyAction("📜 Quest created: "+quest.Title+"\nStart it from the quest board", NotificationSuccess)
+	return model, tea.Batch(saveQuestStateCmd(m.storage, m.quests), notify)
+}
+
+// viewQuestForm renders the form centered on screen, with each field's
+// problem under it.
+//
+// Returns:
+//   - string: The rendered form
+func (m Model) viewQuestForm() string {
+	form := m.questForm
+	lines := []string{TitleStyle.Render("📜 New Quest"), ""}
+
+	for field := 0; field < questFormFieldCount; field++ {
+		marker := "  "
+		label := fmt.Sprintf("%-12s", questFormLabels[field])
+		if field == form.focus {
+			marker = lipgloss.NewStyle().Foreground(ColorXP).Render("▸ ")
+			label = lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(label)
+		} else {
+			label = MutedTextStyle.Render(label)
+		}
+
+		var value string
+		switch field {
+		case questFormType:
+			value = "◂ " + string(questFormTypes[form.typeIndex]) + " ▸"
+		case questFormScope:
+			value = "◂ " + form.scopes[form.scope].label + " ▸"
+		default:
+			value = form.inputs[field].View()
+		}
+		lines = append(lines, marker+label+value)
+		if problem, bad := form.errs[field]; bad {
+			lines = append(lines, "  "+fmt.Sprintf("%-12s", "")+ErrorTextStyle.Render(questFormLabels[field]+" "+problem))
+		}
+	}
+	if form.problem != "" {
+		lines = append(lines, "", ErrorTextStyle.Render(form.problem))
+	}
+
+	lines = append(lines,
+		"",
+		RenderKeybind("Tab/↑↓", "field")+"  "+RenderKeybind("←/→", "change")+"  "+RenderKeybind("Enter", "create")+"  "+RenderKeybind("Esc", "cancel"),
+	)
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return PlaceInCenter(m.width, m.height, box)
+}
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// typeText types a string into the model one key at a time.
+func typeText(t *testing.T, m Model, text string) Model {
+	t.Helper()
+	for _, r := range text {
+		m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+// TestQuestForm tests filling in the New Quest form and creating the quest.
+func TestQuestForm(t *testing.T) {
+	cfg := &config.Config{Projects: []config.ProjectConfig{{Name: "api", Repos: []string{"/src/api"}}}}
+	m := Model{keys: NewKeyMap(), config: cfg, character: veteranCharacter(), width: 100, height: 40, currentScreen: ScreenQuestBoard}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.questForm == nil {
+		t.Fatal("N should open the New Quest form")
+	}
+	m = typeText(t, m, "Bug hunt")
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	m = typeText(t, m, "Fix the flaky tests")
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRight}) // commit → lines
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = typeText(t, m, "250")
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRight}) // any → project api
+	if view := m.View(); !strings.Contains(view, "New Quest") || !strings.Contains(view, "Project: api") {
+		t.Error("view should show the form with the chosen scope")
+	}
+
+	model, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	m = model.(Model)
+	if m.questForm != nil || cmd == nil {
+		t.Fatalf("form open = %v, cmd = %v; want the quest created and saved", m.questForm != nil, cmd)
+	}
+	if len(m.quests) != 1 {
+		t.Fatalf("quests = %d, want 1", len(m.quests))
+	}
+	quest := m.quests[0]
+	if quest.Title != "Bug hunt" || quest.Description != "Fix the flaky tests" || quest.Type != game.QuestTypeLines ||
+		quest.Target != 250 || quest.XPReward != 100 || quest.Project != "api" || quest.Status != game.QuestAvailable {
+		t.Errorf("quest = %+v", quest)
+	}
+
+	// Esc discards a form without creating anything
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.questForm != nil || len(m.quests) != 1 {
+		t.Error("Esc should close the form without a quest")
+	}
+}
+
+// TestQuestFormValidation tests the problems reported for each field.
+func TestQuestFormValidation(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		values map[int]string
+		want   map[int]string
+	}{
+		{
+			name:   "valid with deadline today",
+			values: map[int]string{questFormTitle: "Docs", questFormTarget: "3", questFormReward: "0", questFormDeadline: "2026-10-18"},
+			want:   map[int]string{},
+		},
+		{
+			name:   "missing title",
+			values: map[int]string{questFormTarget: "3", questFormReward: "50"},
+			want:   map[int]string{questFormTitle: "required"},
+		},
+		{
+			name:   "bad numbers",
+			values: map[int]string{questFormTitle: "Docs", questFormTarget: "0", questFormReward: "lots"},
+			want:   map[int]string{questFormTarget: "must be at least 1", questFormReward: "must be a whole number"},
+		},
+		{
+			name:   "negative reward",
+			values: map[int]string{questFormTitle: "Docs", questFormTarget: "1", questFormReward: "-5"},
+			want:   map[int]string{questFormReward: "can't be negative"},
+		},
+		{
+			name:   "past deadline",
+			values: map[int]string{questFormTitle: "Docs", questFormTarget: "1", questFormReward: "5", questFormDeadline: "2026-10-17"},
+			want:   map[int]string{questFormDeadline: "is in the past"},
+		},
+		{
+			name:   "unreadable deadline",
+			values: map[int]string{questFormTitle: "Docs", questFormTarget: "1", questFormReward: "5", questFormDeadline: "next week"},
+			want:   map[int]string{questFormDeadline: "use YYYY-MM-DD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model, _ := Model{keys: NewKeyMap(), width: 100, height: 40}.openQuestForm()
+			form := model.(Model).questForm
+			for field, value := range tt.values {
+				form.inputs[field].SetValue(value)
+			}
+
+			quest := form.build(now)
+			if (quest != nil) != (len(tt.want) == 0) {
+				t.Errorf("build() = %+v with problems %v, want problems %v", quest, form.errs, tt.want)
+			}
+			if len(form.errs) != len(tt.want) {
+				t.Fatalf("problems = %v, want %v", form.errs, tt.want)
+			}
+			for field, problem := range tt.want {
+				if form.errs[field] != problem {
+					t.Errorf("%s problem = %q, want %q", questFormLabels[field], form.errs[field], problem)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file handles the Quest Detail view and editing of per-quest notes,
+// either inline with a textarea or externally via $EDITOR.
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// notesEditorHeight is the number of visible lines in the inline notes editor
+const notesEditorHeight = 10
+
+// questNotesEditedMsg is sent when an external $EDITOR session finishes.
+type questNotesEditedMsg struct {
+	questID string
+	notes   string
+	err     error
+}
+
+// questNotesSavedMsg is sent after quest notes are persisted.
+type questNotesSavedMsg struct {
+	err error
+}
+
+// newNotesEditor creates the textarea used for inline note editing.
+func newNotesEditor() textarea.Model {
+	editor := textarea.New()
+	editor.Placeholder = "Write markdown notes... (why this quest exists, what you learned)"
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+	editor.SetHeight(notesEditorHeight)
+	return editor
+}
+
+// openQuestDetail opens the detail view for the quest selected on the board.
+func (m Model) openQuestDetail() (tea.Model, tea.Cmd) {
+	quest := m.selectedBoardQuest()
+	if quest == nil {
+		return m, nil
+	}
+
+	m.questDetail = quest
+	m.editingNotes = false
+	return m, nil
+}
+
+// openDashboardQuest jumps from the dashboard straight to the detail view of
+// the quest selected in the Active Quests widget.
+func (m Model) openDashboardQuest() (tea.Model, tea.Cmd) {
+	quests := screens.ActiveQuests(m.quests)
+	if len(quests) == 0 {
+		return m, nil
+	}
+	quest := quests[max(min(m.dashboardQuest, len(quests)-1), 0)]
+
+	updated, cmd := m.switchScreen(ScreenQuestBoard)
+	m = updated.(Model)
+	m.questDetail = quest
+	return m, cmd
+}
+
+// handleQuestDetailKeys handles keyboard input in the Quest Detail view.
+//
+// Supports:
+//   - S: Start the quest (undoable for a short while with Ctrl+Z)
+//   - A: Abandon the active quest (undoable for a short while with Ctrl+Z)
+//   - R: Reroll a daily quest into a different challenge (costs Effort)
+//   - N: Edit notes inline (Ctrl+S saves, Esc discards)
+//   - E: Edit notes in $EDITOR
+//   - M: Set a reminder (nudge at a time of day while progress is behind)
+//   - Z: Snooze the reminder's nudge
+//   - X: Turn the reminder off or back on
+//   - Esc: Return to the Quest Board
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Optional command
+func (m Model) handleQuestDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.editingNotes {
+		switch {
+		case key.Matches(msg, m.keys.Save):
+			m.editingNotes = false
+			m.notesEditor.Blur()
+			m.questDetail.SetNotes(m.notesEditor.Value())
+			return m, saveQuestsCmd(m.storage, m.quests)
+		case key.Matches(msg, m.keys.Esc):
+			m.editingNotes = false
+			m.notesEditor.Blur()
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+		m.notesEditor, cmd = m.notesEditor.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Esc):
+		m.questDetail = nil
+		return m, nil
+	case msg.String() == "s" || msg.String() == "S":
+		return m.startQuest()
+	case msg.String() == "a" || msg.String() == "A":
+		return m.abandonQuest()
+	case msg.String() == "r" || msg.String() == "R":
+		return m.rerollDailyQuest()
+	case msg.String() == "n" || msg.String() == "N":
+		m.editingNotes = true
+		m.notesEditor.SetWidth(max(m.width-12, 20))
+		m.notesEditor.SetValue(m.questDetail.Notes)
+		return m, m.notesEditor.Focus()
+	case msg.String() == "e" || msg.String() == "E":
+		return m, editNotesInEditorCmd(m.questDetail)
+	case msg.String() == "m" || msg.String() == "M":
+		return m.editReminder()
+	case msg.String() == "z" || msg.String() == "Z":
+		return m.snoozeReminder()
+	case msg.String() == "x" || msg.String() == "X":
+		return m.toggleReminder()
+	}
+
+	return m, nil
+}
+
+// applyEditedNotes stores notes returned from an external editor session.
+func (m Model) applyEditedNotes(msg questNotesEditedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("Editor failed: %v", msg.err),
+			Type:      NotificationError,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m, m.showNextNotification()
+	}
+
+	for _, quest := range m.quests {
+		if quest.ID == msg.questID {
+			quest.SetNotes(msg.notes)
+			return m, saveQuestsCmd(m.storage, m.quests)
+		}
+	}
+
+	return m, nil
+}
+
+// saveQuestsCmd persists quests and reports the result as questNotesSavedMsg.
+func saveQuestsCmd(storageClient *storage.Client, quests []*game.Quest) tea.Cmd {
+	return func() tea.Msg {
+		if storageClient == nil {
+			return questNotesSavedMsg{err: fmt.Errorf("storage not available")}
+		}
+		return questNotesSavedMsg{err: storageClient.SaveQuests(quests)}
+	}
+}
+
+// editNotesInEditorCmd suspends the TUI and opens the quest's notes in the
+// user's editor ($VISUAL, then $EDITOR, falling back to vi).
+func editNotesInEditorCmd(quest *game.Quest) tea.Cmd {
+	questID := quest.ID
+
+	file, err := os.CreateTemp("", "codequest-notes-*.md")
+	if err != nil {
+		return func() tea.Msg {
+			return questNotesEditedMsg{questID: questID, err: fmt.Errorf("failed to create temp file: %w", err)}
+		}
+	}
+	path := file.Name()
+	_, writeErr := file.WriteString(quest.Notes)
+	file.Close()
+	if writeErr != nil {
+		os.Remove(path)
+		return func() tea.Msg {
+			return questNotesEditedMsg{questID: questID, err: fmt.Errorf("failed to write temp file: %w", writeErr)}
+		}
+	}
+
+	editorArgs := strings.Fields(editorCommand())
+	cmd := exec.Command(editorArgs[0], append(editorArgs[1:], path)...)
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return questNotesEditedMsg{questID: questID, err: err}
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return questNotesEditedMsg{questID: questID, err: fmt.Errorf("failed to read notes: %w", err)}
+		}
+		return questNotesEditedMsg{questID: questID, notes: string(data)}
+	})
+}
+
+// editorCommand returns the user's preferred editor command line.
+func editorCommand() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
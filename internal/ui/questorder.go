@@ -0,0 +1,167 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements arranging the Quest Board: pinning favorite quests
+// to the top (*) and moving quests up or down within their section
+// (Shift+Up/Down). The arrangement is saved with the quests themselves, so
+// it survives restarts, and each change can be undone.
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// boardQuests returns the quests the Quest Board lists, in display order,
+// for the current status and project filters.
+func (m Model) boardQuests() []*game.Quest {
+	return screens.BoardQuests(game.FilterQuestsByProject(m.quests, m.projectFilter), m.questBoardFilter)
+}
+
+// selectedBoardQuest returns the quest highlighted on the Quest Board.
+func (m Model) selectedBoardQuest() *game.Quest {
+	return screens.SelectedQuest(game.FilterQuestsByProject(m.quests, m.projectFilter), m.questBoardFilter, m.questBoardSelectedIndex)
+}
+
+// togglePinQuest pins the selected quest to the top of the board, or unpins
+// it. The selection follows the quest to its new row.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Quest save command (nil if no quest is selected)
+func (m Model) togglePinQuest() (tea.Model, tea.Cmd) {
+	quest := m.selectedBoardQuest()
+	if quest == nil {
+		return m, nil
+	}
+
+	before := m.questArrangement()
+	after := before.clone()
+	label := "pinning " + quest.Title
+	if quest.Pinned {
+		delete(after.pinned, quest.ID)
+		label = "unpinning " + quest.Title
+	} else {
+		after.pinned[quest.ID] = true
+	}
+	return m.applyQuestArrangement(questArrangementAction{label: label, questID: quest.ID, before: before, after: after})
+}
+
+// moveQuest swaps the selected quest with its neighbor on the board. Quests
+// only move within their section; pinning is how a quest changes section.
+//
+// Parameters:
+//   - delta: -1 to move up, 1 to move down
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Quest save command (nil if the quest can't move that way)
+func (m Model) moveQuest(delta int) (tea.Model, tea.Cmd) {
+	board := m.boardQuests()
+	from := m.questBoardSelectedIndex
+	to := from + delta
+	if from < 0 || from >= len(board) || to < 0 || to >= len(board) || !screens.SameBoardSection(board[from], board[to]) {
+		return m, nil
+	}
+
+	before := m.questArrangement()
+	after := before.clone()
+	i, j := slices.Index(after.order, board[from].ID), slices.Index(after.order, board[to].ID)
+	after.order[i], after.order[j] = after.order[j], after.order[i]
+	return m.applyQuestArrangement(questArrangementAction{label: "moving " + board[from].Title, questID: board[from].ID, before: before, after: after})
+}
+
+// applyQuestArrangement performs an arrangement change and records it for undo.
+func (m Model) applyQuestArrangement(action questArrangementAction) (tea.Model, tea.Cmd) {
+	cmd, err := action.redo(&m)
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't rearrange the quest board: %v", err), NotificationWarning)
+	}
+	m.history.record(action, 0, time.Now())
+	return m, cmd
+}
+
+// questArrangement is the player's order of the quest list and which
+// quests are pinned.
+type questArrangement struct {
+	order  []string        // Quest IDs in list order
+	pinned map[string]bool // IDs of pinned quests
+}
+
+// questArrangement captures the current arrangement of the quest list.
+func (m Model) questArrangement() questArrangement {
+	arrangement := questArrangement{order: make([]string, 0, len(m.quests)), pinned: map[string]bool{}}
+	for _, quest := range m.quests {
+		arrangement.order = append(arrangement.order, quest.ID)
+		if quest.Pinned {
+			arrangement.pinned[quest.ID] = true
+		}
+	}
+	return arrangement
+}
+
+// clone returns a copy that can be changed without affecting the original.
+func (a questArrangement) clone() questArrangement {
+	pinned := make(map[string]bool, len(a.pinned))
+	for id := range a.pinned {
+		pinned[id] = true
+	}
+	return questArrangement{order: slices.Clone(a.order), pinned: pinned}
+}
+
+// questArrangementAction is a quest being pinned, unpinned or moved.
+type questArrangementAction struct {
+	label   string
+	questID string // Quest the selection follows
+	before  questArrangement
+	after   questArrangement
+}
+
+func (a questArrangementAction) describe() string { return a.label }
+
+func (a questArrangementAction) undo(m *Model) (tea.Cmd, error) {
+	return m.arrangeQuests(a.before, a.questID)
+}
+
+func (a questArrangementAction) redo(m *Model) (tea.Cmd, error) {
+	return m.arrangeQuests(a.after, a.questID)
+}
+
+// arrangeQuests puts the quest list in an arrangement and saves it. Quests
+// added since the arrangement was captured keep their place at the end.
+// With a game handler on the event bus, the arrangement is published for
+// it to apply and save, since its next save would otherwise overwrite ours.
+//
+// Parameters:
+//   - arrangement: Order and pins to apply
+//   - selectedID: Quest to keep selected on the board
+//
+// Returns:
+//   - tea.Cmd: Quest save command (nil when the game handler saves)
+//   - error: An error if the quest moved or pinned no longer exists
+func (m *Model) arrangeQuests(arrangement questArrangement, selectedID string) (tea.Cmd, error) {
+	if m.findQuest(selectedID) == nil {
+		return nil, fmt.Errorf("quest no longer exists")
+	}
+
+	m.quests = game.ArrangeQuests(m.quests, arrangement.order, arrangement.pinned)
+	if i := slices.IndexFunc(m.boardQuests(), func(quest *game.Quest) bool { return quest.ID == selectedID }); i >= 0 {
+		m.questBoardSelectedIndex = i
+	}
+
+	if m.eventBus != nil && m.eventBus.HandlerCount(game.EventQuestsArranged) > 0 {
+		pinned := make([]string, 0, len(arrangement.pinned))
+		for _, quest := range m.quests {
+			if quest.Pinned {
+				pinned = append(pinned, quest.ID)
+			}
+		}
+		m.eventBus.Publish(game.NewQuestsArrangedEvent(slices.Clone(arrangement.order), pinned))
+		return nil, nil
+	}
+	return saveQuestStateCmd(m.storage, m.quests), nil
+}
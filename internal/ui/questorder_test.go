@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestArrangeQuestBoard tests pinning and moving quests on the board, and
+// undoing each change.
+func TestArrangeQuestBoard(t *testing.T) {
+	star := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")}
+	shiftUp := tea.KeyMsg{Type: tea.KeyShiftUp}
+	shiftDown := tea.KeyMsg{Type: tea.KeyShiftDown}
+
+	tests := []struct {
+		name         string
+		selected     int
+		keys         []tea.KeyMsg
+		wantBoard    string
+		wantSelected int
+	}{
+		{"pin moves quest to the top", 2, []tea.KeyMsg{star}, "C A B D", 0},
+		{"unpin returns quest to its section", 2, []tea.KeyMsg{star, star}, "A B C D", 2},
+		{"move down within section", 0, []tea.KeyMsg{shiftDown}, "B A C D", 1},
+		{"move up within section", 1, []tea.KeyMsg{shiftUp}, "B A C D", 0},
+		{"can't move past section end", 2, []tea.KeyMsg{shiftDown}, "A B C D", 2},
+		{"can't move above the top", 0, []tea.KeyMsg{shiftUp}, "A B C D", 0},
+		{"pinned quests reorder among themselves", 3, []tea.KeyMsg{star, {Type: tea.KeyDown}, {Type: tea.KeyDown}, {Type: tea.KeyDown}, star, shiftDown}, "D C A B", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{keys: NewKeyMap(), character: veteranCharacter(), currentScreen: ScreenQuestBoard}
+			m.quests = []*game.Quest{
+				{ID: "a", Title: "A", Status: game.QuestAvailable},
+				{ID: "b", Title: "B", Status: game.QuestAvailable},
+				{ID: "c", Title: "C", Status: game.QuestActive},
+				{ID: "d", Title: "D", Status: game.QuestCompleted},
+			}
+			m.questBoardSelectedIndex = tt.selected
+
+			for _, msg := range tt.keys {
+				m = pressKey(t, m, msg)
+			}
+			if got := joinTitles(m.boardQuests()); got != tt.wantBoard {
+				t.Errorf("board = %q, want %q", got, tt.wantBoard)
+			}
+			if m.questBoardSelectedIndex != tt.wantSelected {
+				t.Errorf("selected = %d, want %d", m.questBoardSelectedIndex, tt.wantSelected)
+			}
+
+			// Undoing every change restores the original board
+			for len(m.history.done) > 0 {
+				m = pressKey(t, m, ctrlZ)
+			}
+			if got := joinTitles(m.boardQuests()); got != "A B C D" {
+				t.Errorf("after undo board = %q, want %q", got, "A B C D")
+			}
+		})
+	}
+}
+
+// joinTitles lists quest titles separated by spaces.
+func joinTitles(quests []*game.Quest) string {
+	titles := make([]string, len(quests))
+	for i, quest := range quests {
+		titles[i] = quest.Title
+	}
+	return strings.Join(titles, " ")
+}
+
+// TestArrangeQuestsPublishes tests that with a game handler listening, an
+// arrangement is published for it to save instead of saved by the UI.
+func TestArrangeQuestsPublishes(t *testing.T) {
+	bus := game.NewEventBus()
+	var arranged []game.Event
+	bus.Subscribe(game.EventQuestsArranged, func(e game.Event) { arranged = append(arranged, e) })
+
+	m := Model{keys: NewKeyMap(), character: veteranCharacter(), currentScreen: ScreenQuestBoard, eventBus: bus}
+	m.quests = []*game.Quest{
+		{ID: "a", Title: "A", Status: game.QuestAvailable},
+		{ID: "b", Title: "B", Status: game.QuestAvailable},
+	}
+	m.questBoardSelectedIndex = 1
+
+	model, cmd := m.togglePinQuest()
+	m = model.(Model)
+	if cmd != nil {
+		t.Error("togglePinQuest() returned a save command, want the handler to save")
+	}
+	if len(arranged) != 1 {
+		t.Fatalf("published %d arrangements, want 1", len(arranged))
+	}
+	if order := arranged[0].Data["order"].([]string); strings.Join(order, " ") != "a b" {
+		t.Errorf("order = %v, want [a b]", order)
+	}
+	if pinned := arranged[0].Data["pinned"].([]string); strings.Join(pinned, " ") != "b" {
+		t.Errorf("pinned = %v, want [b]", pinned)
+	}
+	if got := joinTitles(m.boardQuests()); got != "B A" {
+		t.Errorf("board = %q, want %q", got, "B A")
+	}
+}
@@ -0,0 +1,133 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file animates the dashboard's active quest card when quest_progress
+// events arrive, so progress ticks up live instead of waiting for a reload.
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// Quest progress animation timing
+const (
+	questProgressSteps         = 6                      // Frames to fill the bar from old to new progress
+	questProgressFrameInterval = 60 * time.Millisecond  // Delay between frames
+	questProgressHighlightTime = 800 * time.Millisecond // How long the bar stays highlighted after moving
+)
+
+// questProgressMsg is sent when the game reports new progress on a quest.
+type questProgressMsg struct {
+	questID   string
+	questName string
+	current   int
+	target    int
+}
+
+// questProgressFrameMsg advances the quest progress animation with the
+// matching sequence ID (stale frames from earlier updates are ignored).
+type questProgressFrameMsg struct {
+	id int
+}
+
+// questProgressFrame schedules the next animation frame.
+func questProgressFrame(id int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return questProgressFrameMsg{id: id}
+	})
+}
+
+// handleQuestProgress applies new quest progress to the in-memory quest and
+// starts animating the dashboard bar from the value currently on screen
+// (when animations are enabled).
+//
+// Parameters:
+//   - msg: The quest progress update
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The first animation frame, if the quest is known
+func (m Model) handleQuestProgress(msg questProgressMsg) (tea.Model, tea.Cmd) {
+	for _, quest := range m.quests {
+		if quest.ID != msg.questID {
+			continue
+		}
+
+		// Start from whatever is on screen so back-to-back updates stay smooth
+		from := quest.Current
+		if m.questProgress != nil && m.questProgress.QuestID == quest.ID {
+			from = m.questProgress.Displayed
+		}
+
+		quest.Current = msg.current
+		quest.Target = msg.target
+		if quest.Target > 0 {
+			quest.Progress = float64(quest.Current) / float64(quest.Target)
+		}
+
+		// Without animations the bar just shows the new value
+		if !m.animationsEnabled() {
+			m.questProgress = nil
+			return m, nil
+		}
+
+		m.questProgressSeq++
+		m.questProgress = &screens.QuestProgressTick{
+			QuestID:   quest.ID,
+			Displayed: from,
+			Highlight: true,
+		}
+		return m, questProgressFrame(m.questProgressSeq, questProgressFrameInterval)
+	}
+
+	return m, nil
+}
+
+// advanceQuestProgress moves the animated value one step toward the quest's
+// real progress, then clears the highlight after a short pause.
+//
+// Parameters:
+//   - msg: The animation frame tick
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The next frame, or nil when the animation is done
+func (m Model) advanceQuestProgress(msg questProgressFrameMsg) (tea.Model, tea.Cmd) {
+	if msg.id != m.questProgressSeq || m.questProgress == nil {
+		return m, nil
+	}
+
+	target, ok := m.questProgressTarget()
+	if !ok || m.questProgress.Displayed == target {
+		// Highlight pause is over (or the quest disappeared)
+		m.questProgress = nil
+		return m, nil
+	}
+
+	tick := *m.questProgress
+	if tick.Displayed > target {
+		tick.Displayed = target // Progress went down (e.g. reset) - no need to animate
+	} else {
+		step := (target - m.questProgress.Displayed + questProgressSteps - 1) / questProgressSteps
+		tick.Displayed += max(step, 1)
+		tick.Displayed = min(tick.Displayed, target)
+	}
+	m.questProgress = &tick
+
+	if tick.Displayed == target {
+		return m, questProgressFrame(m.questProgressSeq, questProgressHighlightTime)
+	}
+	return m, questProgressFrame(m.questProgressSeq, questProgressFrameInterval)
+}
+
+// questProgressTarget returns the real progress of the animated quest.
+func (m Model) questProgressTarget() (int, bool) {
+	for _, quest := range m.quests {
+		if quest.ID == m.questProgress.QuestID {
+			return quest.Current, true
+		}
+	}
+	return 0, false
+}
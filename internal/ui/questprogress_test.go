@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestConvertQuestProgressEvent tests that quest_progress events reach the UI.
+func TestConvertQuestProgressEvent(t *testing.T) {
+	msg, ok := convertEventToMessage(game.NewQuestProgressEvent("q1", "First Steps", 3, 5)).(questProgressMsg)
+	if !ok {
+		t.Fatal("convertEventToMessage() did not return a questProgressMsg")
+	}
+	if msg.questID != "q1" || msg.current != 3 || msg.target != 5 {
+		t.Errorf("questProgressMsg = %+v, want q1 at 3/5", msg)
+	}
+}
+
+// TestQuestProgressAnimation tests that the active quest bar steps up to the
+// new progress, stays highlighted briefly, then settles.
+func TestQuestProgressAnimation(t *testing.T) {
+	quest := game.NewQuest("First Steps", "Make commits", game.QuestTypeCommit, 10, 50, 1)
+	quest.ID = "q1"
+	quest.Status = game.QuestActive
+	quest.Current = 2
+
+	m := Model{quests: []*game.Quest{quest}}
+
+	model, cmd := m.handleQuestProgress(questProgressMsg{questID: "q1", current: 8, target: 10})
+	m = model.(Model)
+	if cmd == nil {
+		t.Fatal("handleQuestProgress() should schedule an animation frame")
+	}
+	if quest.Current != 8 {
+		t.Errorf("quest.Current = %d, want 8", quest.Current)
+	}
+	if m.questProgress == nil || m.questProgress.Displayed != 2 || !m.questProgress.Highlight {
+		t.Fatalf("questProgress = %+v, want highlighted bar starting at 2", m.questProgress)
+	}
+
+	// Each frame moves closer without overshooting
+	previous := m.questProgress.Displayed
+	for frame := 0; frame < 20 && m.questProgress.Displayed < 8; frame++ {
+		model, _ = m.advanceQuestProgress(questProgressFrameMsg{id: m.questProgressSeq})
+		m = model.(Model)
+		if m.questProgress.Displayed <= previous || m.questProgress.Displayed > 8 {
+			t.Fatalf("frame %d: Displayed = %d after %d", frame, m.questProgress.Displayed, previous)
+		}
+		previous = m.questProgress.Displayed
+	}
+	if m.questProgress.Displayed != 8 {
+		t.Fatalf("animation never reached 8, stuck at %d", m.questProgress.Displayed)
+	}
+
+	// Stale frames are ignored
+	model, cmd = m.advanceQuestProgress(questProgressFrameMsg{id: m.questProgressSeq - 1})
+	m = model.(Model)
+	if cmd != nil || m.questProgress == nil {
+		t.Error("stale frame should be ignored")
+	}
+
+	// After the highlight pause the animation clears
+	model, cmd = m.advanceQuestProgress(questProgressFrameMsg{id: m.questProgressSeq})
+	m = model.(Model)
+	if cmd != nil || m.questProgress != nil {
+		t.Errorf("animation should be cleared after the highlight, got %+v", m.questProgress)
+	}
+}
+
+// TestQuestProgressUnknownQuest tests that progress for unknown quests is ignored.
+func TestQuestProgressUnknownQuest(t *testing.T) {
+	m := Model{}
+	model, cmd := m.handleQuestProgress(questProgressMsg{questID: "missing", current: 1, target: 2})
+	if cmd != nil || model.(Model).questProgress != nil {
+		t.Error("unknown quest should not start an animation")
+	}
+}
@@ -0,0 +1,64 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements hot-loading custom quest templates: when the
+// template watcher reloads ~/.config/codequest/quests/, new templates show
+// up on the Quest Board, unstarted quests pick up edits, and files that
+// couldn't be loaded are reported as warnings.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// questTemplatesMsg is sent when the custom quest templates were reloaded.
+type questTemplatesMsg struct {
+	templates []game.QuestTemplate // Every valid custom template
+	problems  []string             // Why changed files couldn't be loaded
+}
+
+// applyQuestTemplates merges reloaded custom templates into the quest log
+// and reports what changed and which files are broken.
+//
+// Parameters:
+//   - msg: The reloaded templates
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Quest save command (nil if no quest changed)
+func (m Model) applyQuestTemplates(msg questTemplatesMsg) (Model, tea.Cmd) {
+	for _, problem := range msg.problems {
+		m.addNotification(Notification{
+			Message:   "⚠ QUEST TEMPLATE NOT LOADED\n" + problem,
+			Type:      NotificationWarning,
+			Duration:  5 * time.Second,
+			Timestamp: time.Now(),
+		})
+	}
+
+	quests, added, updated := game.MergeQuestTemplates(m.quests, msg.templates)
+	if added+updated == 0 {
+		return m, nil
+	}
+	m.quests = quests
+
+	var changes []string
+	if added > 0 {
+		changes = append(changes, fmt.Sprintf("%d new", added))
+	}
+	if updated > 0 {
+		changes = append(changes, fmt.Sprintf("%d updated", updated))
+	}
+	m.recordActivity("📜", "Custom quests reloaded: "+strings.Join(changes, ", "))
+	m.addNotification(Notification{
+		Message:   "📜 Custom quests reloaded\n" + strings.Join(changes, ", "),
+		Type:      NotificationInfo,
+		Duration:  3 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, saveQuestStateCmd(m.storage, m.quests)
+}
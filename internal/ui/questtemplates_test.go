@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestApplyQuestTemplates tests that reloaded templates reach the Quest
+// Board and that problems are shown as warnings.
+func TestApplyQuestTemplates(t *testing.T) {
+	bugBash := game.QuestTemplate{ID: "custom/bug-bash", Title: "Bug Bash", Type: game.QuestTypeCommit, Target: 10}
+	m := Model{keys: NewKeyMap(), quests: []*game.Quest{}}
+
+	m, save := m.applyQuestTemplates(questTemplatesMsg{
+		templates: []game.QuestTemplate{bugBash},
+		problems:  []string{"typo.toml: unknown field \"targte\""},
+	})
+	if save == nil || len(m.quests) != 1 || m.quests[0].Title != "Bug Bash" {
+		t.Fatalf("quests = %v (save %v), want the Bug Bash quest saved", m.quests, save != nil)
+	}
+	if len(m.notifications) != 2 || m.notifications[0].Type != NotificationWarning || !strings.Contains(m.notifications[0].Message, "typo.toml") {
+		t.Errorf("notifications = %+v, want the typo.toml warning then the reload summary", m.notifications)
+	}
+
+	m.notifications = nil
+	if m, save = m.applyQuestTemplates(questTemplatesMsg{templates: []game.QuestTemplate{bugBash}}); save != nil || len(m.notifications) != 0 {
+		t.Error("reloading unchanged templates should neither save nor notify")
+	}
+}
@@ -0,0 +1,121 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements personal best celebrations: a toast when a record on
+// the character's records sheet is broken, whether by the game handler
+// (XP, commits, quests) or by the session timer, when an achievement tier
+// is unlocked, and when a week of daily quests earns a crown.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// recordBrokenMsg is sent when the game handler reports a new personal best.
+type recordBrokenMsg struct {
+	record game.RecordBreak // The broken record
+}
+
+// achievementMsg is sent when the game handler unlocks an achievement.
+type achievementMsg struct {
+	id   string // Achievement identifier
+	name string // Achievement display name
+}
+
+// celebrateAchievement queues the achievement toast and logs it in the
+// activity feed.
+//
+// Parameters:
+//   - msg: The unlocked achievement
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) celebrateAchievement(msg achievementMsg) Model {
+	m.recordActivity("🏅", "Achievement unlocked: "+msg.name)
+	m.addNotification(Notification{
+		Message:     "🏅 ACHIEVEMENT UNLOCKED!\n" + msg.name,
+		Type:        NotificationLevelUp,
+		Duration:    4 * time.Second,
+		Timestamp:   time.Now(),
+		Celebration: true,
+	})
+	return m
+}
+
+// questCrownMsg is sent when a quest completed every day of a week earns a
+// crown.
+type questCrownMsg struct {
+	week   string // The crowned week (e.g. "2025-W10")
+	crowns int    // Crowns earned in total
+}
+
+// celebrateCrown queues the crown toast and logs it in the activity feed.
+//
+// Parameters:
+//   - msg: The crowned week
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) celebrateCrown(msg questCrownMsg) Model {
+	m.recordActivity("👑", fmt.Sprintf("Crowned for %s (%d crowns)", msg.week, msg.crowns))
+	m.addNotification(Notification{
+		Message:     fmt.Sprintf("👑 WEEK CROWNED!\nA quest every day of %s · %d crowns", msg.week, msg.crowns),
+		Type:        NotificationLevelUp,
+		Duration:    4 * time.Second,
+		Timestamp:   time.Now(),
+		Celebration: true,
+	})
+	return m
+}
+
+// celebrateRecord queues the personal best toast and logs it in the
+// activity feed.
+//
+// Parameters:
+//   - record: The broken record
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) celebrateRecord(record game.RecordBreak) Model {
+	m.recordActivity("🏆", "New personal best! "+record.Message())
+	m.addNotification(Notification{
+		Message:     "🏆 NEW PERSONAL BEST!\n" + record.Message(),
+		Type:        NotificationLevelUp,
+		Duration:    4 * time.Second,
+		Timestamp:   time.Now(),
+		Celebration: true,
+	})
+	return m
+}
+
+// checkSessionRecord keeps the longest session record up to date while the
+// timer runs and celebrates the first time a session beats it. The session
+// tracker persists the character along with the session time.
+//
+// Parameters:
+//   - elapsed: Active session time so far
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Notification command when the record was just broken
+func (m Model) checkSessionRecord(elapsed time.Duration) (Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+
+	// Elapsed time below the celebrated mark means a new session started
+	if elapsed < m.sessionRecordAt {
+		m.sessionRecordAt = 0
+	}
+
+	record := m.character.RecordSession(elapsed, time.Now())
+	if record == nil || m.sessionRecordAt > 0 {
+		return m, nil
+	}
+	m.sessionRecordAt = elapsed
+	m = m.celebrateRecord(*record)
+	return m, m.showNextNotification()
+}
@@ -0,0 +1,254 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the error recovery screen, which replaces the old
+// dead-end error view with actionable options and automatic retries for
+// transient storage failures.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// maxAutoRetries is how many times a transient failure is retried before
+// the recovery screen waits for the user to pick an option.
+const maxAutoRetries = 3
+
+// autoRetryBaseDelay is the first retry delay; each attempt doubles it.
+const autoRetryBaseDelay = 500 * time.Millisecond
+
+// autoRetryMsg fires when an automatic retry is due.
+type autoRetryMsg struct {
+	attempt int // Which attempt this tick belongs to (stale ticks are ignored)
+}
+
+// doctorReportMsg carries storage diagnostics for the doctor panel.
+type doctorReportMsg struct {
+	checks []storage.HealthCheck
+}
+
+// retryDelay returns the backoff delay before the given attempt (1-based).
+func retryDelay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	return autoRetryBaseDelay << (attempt - 1)
+}
+
+// autoRetryCmd schedules an automatic retry after the backoff delay.
+func autoRetryCmd(attempt int) tea.Cmd {
+	return tea.Tick(retryDelay(attempt), func(time.Time) tea.Msg {
+		return autoRetryMsg{attempt: attempt}
+	})
+}
+
+// doctorCmd runs storage diagnostics without blocking the UI.
+func doctorCmd(storageClient *storage.Client) tea.Cmd {
+	return func() tea.Msg {
+		return doctorReportMsg{checks: storageClient.Diagnose()}
+	}
+}
+
+// handleError shows the recovery screen for a failed operation.
+// Failures that carry a retry command are treated as transient and retried
+// automatically with exponential backoff before asking the user.
+//
+// Parameters:
+//   - msg: The error message from an async operation
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Automatic retry command, if applicable
+func (m Model) handleError(msg errorMsg) (tea.Model, tea.Cmd) {
+	m.err = msg.err
+	m.errRetry = msg.retry
+	m.stopLoading()
+	m.activity.Stop(screens.ActivitySaving)
+	m.retrying = false
+	m.retryScheduled = false
+
+	if msg.retry != nil && m.recoveryAttempts < maxAutoRetries {
+		m.recoveryAttempts++
+		m.retryScheduled = true
+		return m, autoRetryCmd(m.recoveryAttempts)
+	}
+	return m, nil
+}
+
+// clearError leaves the recovery screen after an operation succeeds.
+func (m *Model) clearError() {
+	m.err = nil
+	m.errRetry = nil
+	m.retrying = false
+	m.retryScheduled = false
+	m.recoveryAttempts = 0
+	m.showingDoctor = false
+	m.doctorReport = nil
+}
+
+// retryFailedOperation re-runs the operation that failed. Load failures are
+// retried by reloading everything from storage.
+func (m Model) retryFailedOperation() (tea.Model, tea.Cmd) {
+	m.retrying = true
+	m.retryScheduled = false
+	if m.errRetry != nil {
+		return m, m.errRetry
+	}
+	return m, tea.Batch(m.startLoading(), loadCharacterCmd(m.storage), loadQuestsCmd(m.storage))
+}
+
+// handleRecoveryKeys handles keyboard input on the recovery screen.
+//
+// Supports:
+//   - R: Retry the failed operation now
+//   - F: Switch to local fallback storage and reload
+//   - D: Toggle the storage doctor panel
+//   - C: Continue with cached (in-memory) state
+//   - Q: Quit
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Optional command
+func (m Model) handleRecoveryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch strings.ToLower(msg.String()) {
+	case "r":
+		m.recoveryAttempts = maxAutoRetries // Manual retry: stop auto-retrying
+		return m.retryFailedOperation()
+
+	case "f":
+		return m.switchToFallbackStorage()
+
+	case "d":
+		m.showingDoctor = !m.showingDoctor
+		if m.showingDoctor && m.storage != nil {
+			m.doctorReport = nil
+			return m, doctorCmd(m.storage)
+		}
+		return m, nil
+
+	case "c":
+		return m.continueWithCachedState()
+
+	case "q":
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// switchToFallbackStorage moves storage to local JSON files and reloads.
+func (m Model) switchToFallbackStorage() (tea.Model, tea.Cmd) {
+	if m.storage == nil || m.storage.IsFallback() {
+		return m.retryFailedOperation()
+	}
+
+	dir, err := storage.FallbackDir()
+	if err == nil {
+		err = m.storage.UseFallback(dir)
+	}
+	if err != nil {
+		m.err = fmt.Errorf("could not switch to fallback storage: %w", err)
+		return m, nil
+	}
+
+	m.addNotification(Notification{
+		Message:   "Using local fallback storage: " + dir,
+		Type:      NotificationInfo,
+		Duration:  4 * time.Second,
+		Timestamp: time.Now(),
+	})
+
+	m.errRetry = nil // Reload from the new backend rather than repeating the old write
+	notify := m.showNextNotification()
+	model, cmd := m.retryFailedOperation()
+	return model, tea.Batch(cmd, notify)
+}
+
+// continueWithCachedState dismisses the error and keeps playing with whatever
+// is already in memory. If nothing was loaded yet, a fresh character is used
+// for this session and isn't saved until storage works again.
+func (m Model) continueWithCachedState() (tea.Model, tea.Cmd) {
+	message := "Continuing with cached state - changes may not be saved"
+	if m.character == nil {
+		m.character = game.NewCharacter("Adventurer")
+		message = "Continuing without saved data - progress may not persist"
+	}
+
+	m.clearError()
+	m.stopLoading()
+	m.addNotification(Notification{
+		Message:   message,
+		Type:      NotificationWarning,
+		Duration:  4 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, m.showNextNotification()
+}
+
+// viewError renders the error recovery screen.
+func (m Model) viewError() string {
+	title := ErrorTextStyle.Render("⚠ Something went wrong")
+	errorText := TextStyle.Render(m.err.Error())
+
+	var status string
+	switch {
+	case m.retrying:
+		status = InfoTextStyle.Render("Retrying...")
+	case m.retryScheduled:
+		status = InfoTextStyle.Render(fmt.Sprintf("Retrying automatically (attempt %d of %d)...",
+			m.recoveryAttempts, maxAutoRetries))
+	case m.errRetry != nil:
+		status = MutedTextStyle.Render("Automatic retries stopped - choose an option below")
+	}
+	if m.storage != nil && m.storage.IsFallback() {
+		status = lipgloss.JoinVertical(lipgloss.Left, status, WarningTextStyle.Render("Using local fallback storage"))
+	}
+
+	options := lipgloss.JoinVertical(
+		lipgloss.Left,
+		RenderKeybind("R", "Retry now"),
+		RenderKeybind("F", "Switch to local fallback storage"),
+		RenderKeybind("D", "Run storage doctor"),
+		RenderKeybind("C", "Continue with cached state"),
+		RenderKeybind("Q", "Quit"),
+	)
+
+	sections := []string{title, "", errorText}
+	if status != "" {
+		sections = append(sections, "", status)
+	}
+	sections = append(sections, "", options)
+	if m.showingDoctor {
+		sections = append(sections, "", m.renderDoctorReport())
+	}
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+	return PlaceInCenter(m.width, m.height, box)
+}
+
+// renderDoctorReport renders storage diagnostics as a checklist.
+func (m Model) renderDoctorReport() string {
+	lines := []string{SubtitleStyle.Render("🩺 Storage Doctor")}
+	if m.doctorReport == nil {
+		return lipgloss.JoinVertical(lipgloss.Left, append(lines, MutedTextStyle.Render("Running checks..."))...)
+	}
+
+	for _, check := range m.doctorReport {
+		mark := SuccessTextStyle.Render("✓")
+		if !check.OK {
+			mark = ErrorTextStyle.Render("✗")
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", mark, check.Name, MutedTextStyle.Render(check.Detail)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestRetryDelay tests exponential backoff between automatic retries.
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 500 * time.Millisecond},
+		{1, 500 * time.Millisecond},
+		{2, time.Second},
+		{3, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempt); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// TestHandleErrorAutoRetry tests that transient errors are retried a bounded
+// number of times before waiting for the user.
+func TestHandleErrorAutoRetry(t *testing.T) {
+	retry := func() tea.Msg { return nil }
+
+	tests := []struct {
+		name          string
+		retry         tea.Cmd
+		priorAttempts int
+		wantScheduled bool
+		wantAttempts  int
+	}{
+		{"transient error schedules retry", retry, 0, true, 1},
+		{"retries stop at the limit", retry, maxAutoRetries, false, maxAutoRetries},
+		{"permanent error waits for user", nil, 0, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{recoveryAttempts: tt.priorAttempts}
+
+			model, cmd := m.handleError(errorMsg{err: errors.New("skate get failed"), retry: tt.retry})
+			got := model.(Model)
+
+			if got.err == nil {
+				t.Fatal("handleError() did not record the error")
+			}
+			if got.retryScheduled != tt.wantScheduled {
+				t.Errorf("retryScheduled = %v, want %v", got.retryScheduled, tt.wantScheduled)
+			}
+			if (cmd != nil) != tt.wantScheduled {
+				t.Errorf("handleError() cmd = %v, want scheduled %v", cmd != nil, tt.wantScheduled)
+			}
+			if got.recoveryAttempts != tt.wantAttempts {
+				t.Errorf("recoveryAttempts = %d, want %d", got.recoveryAttempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// TestContinueWithCachedState tests dismissing the error without storage.
+func TestContinueWithCachedState(t *testing.T) {
+	m := Model{err: errors.New("storage down"), loading: true, recoveryAttempts: 2}
+
+	model, _ := m.continueWithCachedState()
+	got := model.(Model)
+
+	if got.err != nil {
+		t.Errorf("err = %v, want nil", got.err)
+	}
+	if got.character == nil {
+		t.Error("character = nil, want a session-only character")
+	}
+	if got.loading {
+		t.Error("loading = true, want false")
+	}
+	if got.recoveryAttempts != 0 {
+		t.Errorf("recoveryAttempts = %d, want 0", got.recoveryAttempts)
+	}
+}
+
+// TestViewErrorOptions tests that the recovery screen offers every option.
+func TestViewErrorOptions(t *testing.T) {
+	m := Model{err: errors.New("skate get failed"), width: 100, height: 40}
+
+	output := m.viewError()
+	for _, want := range []string{"skate get failed", "Retry", "fallback storage", "doctor", "cached state", "Quit"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("viewError() output does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the broken streak toast that offers the Redemption
+// quest, which wins back half of the lost streak.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// streakBrokenMsg is sent when a long streak breaks and the game handler
+// offers the Redemption quest for it.
+type streakBrokenMsg struct {
+	lostDays       int // Length of the streak that broke
+	redeemableDays int // Days the Redemption quest restores
+}
+
+// offerRedemption queues the toast announcing the Redemption quest and logs
+// the broken streak in the activity feed.
+//
+// Parameters:
+//   - msg: The broken streak
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) offerRedemption(msg streakBrokenMsg) Model {
+	m.recordActivity("💔", fmt.Sprintf("%d-day streak broken", msg.lostDays))
+	m.addNotification(Notification{
+		Message: fmt.Sprintf("💔 STREAK BROKEN\nYour %d-day streak ended. Start the Redemption quest and commit %d days in a row to win back %d days.",
+			msg.lostDays, game.RedemptionDays, msg.redeemableDays),
+		Type:      NotificationWarning,
+		Duration:  6 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m
+}
@@ -0,0 +1,137 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements quest reminders in the UI: setting one from the
+// Quest Detail view (M), snoozing (Z) or switching it off (X), and the
+// minute-by-minute check that turns due reminders into nudges.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// reminderCheckInterval is how often quest reminders are checked
+const reminderCheckInterval = time.Minute
+
+// reminderTickMsg is sent when it's time to check quest reminders.
+type reminderTickMsg time.Time
+
+// reminderTick returns a command that sends a reminderTickMsg after
+// reminderCheckInterval.
+func reminderTick() tea.Cmd {
+	return tea.Tick(reminderCheckInterval, func(t time.Time) tea.Msg {
+		return reminderTickMsg(t)
+	})
+}
+
+// checkReminders nudges about every quest whose reminder is due, saves the
+// quests if any fired and schedules the next check.
+//
+// Parameters:
+//   - now: Current time
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The next check, plus save and notification commands
+func (m Model) checkReminders(now time.Time) (tea.Model, tea.Cmd) {
+	due := game.DueReminders(m.quests, now, game.StreakClockFromConfig(m.config))
+	if len(due) == 0 {
+		return m, reminderTick()
+	}
+
+	for _, quest := range due {
+		m.recordActivity("⏰", "Nudge: "+quest.Title)
+		m.addNotification(Notification{
+			Message: fmt.Sprintf("⏰ Nudge: %s is at %d%% (%d/%d)\nOpen it on the Quest Board: Z snoozes, X turns the reminder off",
+				quest.Title, int(quest.Progress*100), quest.Current, quest.Target),
+			Type:      NotificationInfo,
+			Duration:  5 * time.Second,
+			Timestamp: now,
+		})
+	}
+	return m, tea.Batch(reminderTick(), saveQuestsCmd(m.storage, m.quests), m.showNextNotification())
+}
+
+// editReminder opens the reminder input for the quest in the detail view,
+// filled with its current reminder.
+func (m Model) editReminder() (tea.Model, tea.Cmd) {
+	input := textinput.New()
+	input.Placeholder = "4pm <50%  (time, then nudge only while progress is under a %)"
+	input.CharLimit = 20
+	input.Width = max(min(m.width-20, 60), 20)
+	if m.questDetail.Reminder != nil {
+		input.SetValue(m.questDetail.Reminder.Spec())
+		input.CursorEnd()
+	}
+
+	m.editingReminder = true
+	m.reminderInput = input
+	return m, m.reminderInput.Focus()
+}
+
+// handleReminderEditKeys handles keys while a reminder is being edited:
+// Enter saves it (an empty input removes it), Esc discards the change and
+// anything else goes to the input.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands, or the input's command
+func (m Model) handleReminderEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Enter):
+		quest := m.questDetail
+		if err := quest.SetReminder(m.reminderInput.Value()); err != nil {
+			return m.notifyAction(fmt.Sprintf("Can't set reminder: %v", err), NotificationWarning)
+		}
+		m.editingReminder = false
+		m.reminderInput.Blur()
+
+		message := "⏰ Reminder removed"
+		if quest.Reminder != nil {
+			message = "⏰ Reminder set: " + quest.Reminder.String()
+		}
+		model, notify := m.notifyAction(message, NotificationSuccess)
+		return model, tea.Batch(saveQuestsCmd(m.storage, m.quests), notify)
+	case key.Matches(msg, m.keys.Esc):
+		m.editingReminder = false
+		m.reminderInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.reminderInput, cmd = m.reminderInput.Update(msg)
+	return m, cmd
+}
+
+// snoozeReminder puts off the detail view quest's nudge for
+// game.ReminderSnooze.
+func (m Model) snoozeReminder() (tea.Model, tea.Cmd) {
+	if err := m.questDetail.SnoozeReminder(time.Now()); err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't snooze: %v", err), NotificationWarning)
+	}
+	model, notify := m.notifyAction(fmt.Sprintf("💤 Reminder snoozed for %d minutes", int(game.ReminderSnooze.Minutes())), NotificationInfo)
+	return model, tea.Batch(saveQuestsCmd(m.storage, m.quests), notify)
+}
+
+// toggleReminder switches the detail view quest's reminder off or back on.
+func (m Model) toggleReminder() (tea.Model, tea.Cmd) {
+	on, err := m.questDetail.ToggleReminder()
+	if err != nil {
+		return m.notifyAction("No reminder set (M sets one)", NotificationWarning)
+	}
+
+	message := "🔕 Reminder off"
+	if on {
+		message = "⏰ Reminder on: " + m.questDetail.Reminder.String()
+	}
+	model, notify := m.notifyAction(message, NotificationInfo)
+	return model, tea.Batch(saveQuestsCmd(m.storage, m.quests), notify)
+}
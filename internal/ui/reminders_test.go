@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestQuestReminderKeys tests setting, snoozing and switching off a
+// reminder from the Quest Detail view.
+func TestQuestReminderKeys(t *testing.T) {
+	quest := game.NewQuest("Ship It", "", game.QuestTypeCommit, 10, 100, 1)
+	m := Model{
+		keys:          NewKeyMap(),
+		config:        config.DefaultConfig(),
+		character:     game.NewCharacter("Nudged"),
+		quests:        []*game.Quest{quest},
+		currentScreen: ScreenQuestBoard,
+		questDetail:   quest,
+		width:         100,
+		height:        40,
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	m = pressKey(t, m, runes("m"))
+	if !m.editingReminder {
+		t.Fatal("M didn't open the reminder input")
+	}
+	m = pressKey(t, m, runes("4pm <50%"))
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.editingReminder || quest.Reminder == nil || quest.Reminder.Spec() != "16:00 <50%" {
+		t.Fatalf("editing = %v, reminder = %+v; want 16:00 <50%% saved", m.editingReminder, quest.Reminder)
+	}
+
+	m = pressKey(t, m, runes("z"))
+	if !quest.Reminder.SnoozedUntil.After(time.Now()) {
+		t.Error("Z didn't snooze the reminder")
+	}
+	m = pressKey(t, m, runes("x"))
+	if !quest.Reminder.Disabled {
+		t.Error("X didn't switch the reminder off")
+	}
+}
+
+// TestCheckReminders tests that due reminders become nudges.
+func TestCheckReminders(t *testing.T) {
+	quest := game.NewQuest("Ship It", "", game.QuestTypeCommit, 10, 100, 1)
+	if err := quest.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	quest.Reminder = &game.QuestReminder{Below: 1}
+	m := Model{config: config.DefaultConfig(), quests: []*game.Quest{quest}}
+
+	model, _ := m.checkReminders(time.Now())
+	m = model.(Model)
+	nudge := m.currentNotification
+	if nudge == nil || !strings.Contains(nudge.Message, "Nudge: Ship It is at 0%") {
+		t.Fatalf("notification = %+v, want the nudge", nudge)
+	}
+
+	model, _ = m.checkReminders(time.Now())
+	if m = model.(Model); len(m.notifications) != 0 {
+		t.Errorf("nudged again the same day: %+v", m.notifications)
+	}
+}
@@ -0,0 +1,170 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the reward choice modal shown when a bigger quest
+// completes: the player picks XP, an XP boost, a trophy or a skill point.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// rewardChoice is the open reward choice modal.
+type rewardChoice struct {
+	questID  string // Completed quest waiting on a reward
+	selected int    // Highlighted option index
+}
+
+// pendingRewardQuest returns the first completed quest waiting on a reward.
+func (m Model) pendingRewardQuest() *game.Quest {
+	for _, quest := range m.quests {
+		if quest.Status == game.QuestCompleted && quest.RewardPending {
+			return quest
+		}
+	}
+	return nil
+}
+
+// openPendingReward opens the reward choice modal if a quest is waiting on a
+// reward and nothing else (tutorial, another choice, focus mode) is in the way.
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) openPendingReward() Model {
+	if m.rewardChoice != nil || m.showingTutorial || m.focus != nil || m.character == nil {
+		return m
+	}
+	if quest := m.pendingRewardQuest(); quest != nil {
+		m.rewardChoice = &rewardChoice{questID: quest.ID}
+	}
+	return m
+}
+
+// rewardChoiceQuest returns the quest the open modal is for.
+func (m Model) rewardChoiceQuest() *game.Quest {
+	if m.rewardChoice == nil {
+		return nil
+	}
+	for _, quest := range m.quests {
+		if quest.ID == m.rewardChoice.questID {
+			return quest
+		}
+	}
+	return nil
+}
+
+// handleRewardChoiceKeys moves the selection and claims the highlighted
+// reward. Esc puts the choice off; it reopens the next time quests load.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands after a claim
+func (m Model) handleRewardChoiceKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	quest := m.rewardChoiceQuest()
+	if quest == nil {
+		m.rewardChoice = nil
+		return m, nil
+	}
+	options := game.RewardOptions(quest, game.NewRewardEngine(m.config).QuestXP(quest, m.character))
+
+	switch msg.String() {
+	case "up", "k":
+		m.rewardChoice.selected = (m.rewardChoice.selected - 1 + len(options)) % len(options)
+	case "down", "j", "tab":
+		m.rewardChoice.selected = (m.rewardChoice.selected + 1) % len(options)
+	case "enter":
+		return m.claimReward(quest, options[m.rewardChoice.selected])
+	case "esc":
+		m.rewardChoice = nil
+	}
+	return m, nil
+}
+
+// claimReward pays out the chosen reward, records it on the quest and saves.
+//
+// Parameters:
+//   - quest: The completed quest
+//   - option: The reward the player picked
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) claimReward(quest *game.Quest, option game.RewardOption) (tea.Model, tea.Cmd) {
+	m.rewardChoice = nil
+
+	xp := game.NewRewardEngine(m.config).QuestXP(quest, m.character)
+	oldLevel := m.character.Level
+	leveledUp, err := m.character.ClaimQuestReward(quest, option.Kind, xp, time.Now())
+	if err != nil {
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("Couldn't claim reward: %v", err),
+			Type:      NotificationError,
+			Duration:  4 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m, m.showNextNotification()
+	}
+
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("🎁 REWARD CLAIMED!\n%s\n%s", quest.Title, option.Description),
+		Type:      NotificationQuestComplete,
+		Duration:  4 * time.Second,
+		Timestamp: time.Now(),
+	})
+	if option.Kind == game.RewardXP {
+		m.publishXPChange(xp.Total(), game.XPSourceReward)
+		if record := m.character.RecordDayXP(xp.Total(), time.Now()); record != nil {
+			m = m.celebrateRecord(*record)
+		}
+	}
+	var fanfareCmd tea.Cmd
+	if leveledUp {
+		m, fanfareCmd = m.celebrateLevelUp(oldLevel, m.character.Level)
+		m.announceUnlocks(oldLevel, m.character.Level)
+	}
+
+	// Another quest may be waiting on its reward too
+	m = m.openPendingReward()
+	return m, tea.Batch(m.saveStateCmd(), fanfareCmd, m.showNextNotification())
+}
+
+// viewRewardChoice renders the reward choice modal centered on screen.
+//
+// Returns:
+//   - string: The rendered modal
+func (m Model) viewRewardChoice() string {
+	quest := m.rewardChoiceQuest()
+	if quest == nil {
+		return ""
+	}
+	options := game.RewardOptions(quest, game.NewRewardEngine(m.config).QuestXP(quest, m.character))
+
+	lines := []string{
+		TitleStyle.Render("🎁 Choose Your Reward"),
+		SubtitleStyle.Render("Quest complete: " + quest.Title),
+		"",
+	}
+	for i, option := range options {
+		marker := "  "
+		label := lipgloss.NewStyle().Foreground(ColorBright).Render(option.Label)
+		if i == m.rewardChoice.selected {
+			marker = lipgloss.NewStyle().Foreground(ColorXP).Render("▸ ")
+			label = lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(option.Label)
+		}
+		lines = append(lines, marker+label+"  "+MutedTextStyle.Render(option.Description))
+	}
+	lines = append(lines,
+		"",
+		RenderKeybind("↑/↓", "choose")+"  "+RenderKeybind("Enter", "claim")+"  "+RenderKeybind("Esc", "decide later"),
+	)
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return PlaceInCenter(m.width, m.height, box)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRewardChoiceFlow tests that a quest waiting on a reward opens the
+// picker when quests load, and that the highlighted reward is claimed.
+func TestRewardChoiceFlow(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	quest := game.NewQuest("Marathon", "Make 50 commits", game.QuestTypeCommit, 50, 250, 1)
+	quest.Status = game.QuestCompleted
+	quest.RewardPending = true
+
+	m := Model{keys: NewKeyMap(), character: character, width: 100, height: 40}
+	model, _ := m.Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+	m = model.(Model)
+	if m.rewardChoice == nil || m.rewardChoice.questID != quest.ID {
+		t.Fatal("loading a quest with a pending reward should open the picker")
+	}
+	if view := m.View(); !strings.Contains(view, "Choose Your Reward") || !strings.Contains(view, "Skill Point") {
+		t.Error("view should show the reward options")
+	}
+
+	// Move down to the skill point (last option, wrapping up from the top)
+	model, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyUp})
+	model, cmd := model.(Model).handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	m = model.(Model)
+
+	if cmd == nil {
+		t.Error("claiming should save state")
+	}
+	if m.rewardChoice != nil {
+		t.Error("picker should close after claiming")
+	}
+	if character.SkillPoints != 1 || quest.Reward == nil || quest.Reward.Kind != game.RewardSkillPoint {
+		t.Errorf("SkillPoints = %d, reward = %+v; want skill point claimed", character.SkillPoints, quest.Reward)
+	}
+}
+
+// TestRewardChoiceDecideLater tests that Esc puts the choice off without
+// claiming, and the picker returns the next time quests load.
+func TestRewardChoiceDecideLater(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	quest := game.NewQuest("Marathon", "", game.QuestTypeCommit, 50, 250, 1)
+	quest.Status = game.QuestCompleted
+	quest.RewardPending = true
+
+	m := Model{keys: NewKeyMap(), character: character, quests: []*game.Quest{quest}}
+	m = m.openPendingReward()
+
+	model, _ := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	m = model.(Model)
+	if m.rewardChoice != nil || !quest.RewardPending {
+		t.Fatal("Esc should close the picker and leave the reward pending")
+	}
+
+	model, _ = m.Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+	if model.(Model).rewardChoice == nil {
+		t.Error("picker should reopen when quests reload")
+	}
+}
+
+// TestCommitToastXP tests that the commit toast shows the XP the game
+// handler awarded, and that held XP gets no "+N XP" toast.
+func TestCommitToastXP(t *testing.T) {
+	tests := []struct {
+		name      string
+		xpAwarded int
+		held      bool
+		wantToast string
+	}{
+		{"awarded", 37, false, "+37 XP"},
+		{"held for review", 0, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commit := game.NewCommitEvent("abc1234def", "feat: login", 2, 40, 10)
+			msg, ok := convertEventToMessage(game.NewCommitScoredEvent(commit, tt.xpAwarded, tt.held)).(commitDetectedMsg)
+			if !ok {
+				t.Fatal("convertEventToMessage() did not return a commitDetectedMsg")
+			}
+			if convertEventToMessage(commit) != nil {
+				t.Error("the unscored commit event should not reach the UI")
+			}
+
+			m := Model{keys: NewKeyMap(), config: config.DefaultConfig(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+			model, _ := m.Update(msg)
+			m = model.(Model)
+
+			switch {
+			case tt.wantToast == "" && m.currentNotification != nil:
+				t.Errorf("toast = %q, want none", m.currentNotification.Message)
+			case tt.wantToast != "" && (m.currentNotification == nil || !strings.Contains(m.currentNotification.Message, tt.wantToast)):
+				t.Errorf("toast = %+v, want %s", m.currentNotification, tt.wantToast)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the activity indicator, the one component behind all
+// async feedback: a spinner while operations (loading, saving, the mentor
+// thinking or running a snippet) run, and a progress bar for those that
+// report how far along they are (importing missed commits).
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// activityProgressWidth is the progress bar's width in characters.
+const activityProgressWidth = 30
+
+// ActivityKind identifies an async operation.
+type ActivityKind string
+
+const (
+	ActivityLoading   ActivityKind = "loading"   // Loading game data on startup
+	ActivitySaving    ActivityKind = "saving"    // Saving game state
+	ActivityThinking  ActivityKind = "thinking"  // Waiting for the AI mentor
+	ActivityRunning   ActivityKind = "running"   // Running a snippet from a mentor answer
+	ActivityImporting ActivityKind = "importing" // Importing commits made while CodeQuest wasn't running
+)
+
+// activityOrder is the order running activities are listed in.
+var activityOrder = map[ActivityKind]int{
+	ActivityLoading:   0,
+	ActivityImporting: 1,
+	ActivityThinking:  2,
+	ActivityRunning:   3,
+	ActivitySaving:    4,
+}
+
+// runningActivity is one operation in progress.
+type runningActivity struct {
+	label    string
+	done     int            // Steps done (progress bar shown when total > 0)
+	total    int            // Steps in all
+	progress progress.Model // Animated bar
+}
+
+// Activity tracks the async operations in progress and renders feedback
+// for them. One spinner animates while anything runs; its ticks stop once
+// everything has finished. Share one Activity between the screens that
+// show feedback and forward spinner.TickMsg and progress.FrameMsg to Update.
+// A nil Activity shows nothing.
+type Activity struct {
+	spinner spinner.Model
+	running map[ActivityKind]*runningActivity
+	ticking bool // A spinner tick is in flight
+}
+
+// NewActivity creates an idle activity indicator.
+//
+// Returns:
+//   - *Activity: The indicator, with nothing running
+func NewActivity() *Activity {
+	return &Activity{
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(lipgloss.NewStyle().Foreground(ColorAccent)),
+		),
+		running: make(map[ActivityKind]*runningActivity),
+	}
+}
+
+// Start marks an operation as running (or relabels it if it already is).
+//
+// Parameters:
+//   - kind: The operation
+//   - label: Text shown next to the spinner (e.g. "Saving...")
+//
+// Returns:
+//   - tea.Cmd: Starts the spinner if it isn't animating yet
+func (a *Activity) Start(kind ActivityKind, label string) tea.Cmd {
+	if a == nil {
+		return nil
+	}
+	if running, ok := a.running[kind]; ok {
+		running.label = label
+	} else {
+		a.running[kind] = &runningActivity{label: label}
+	}
+	return a.tick()
+}
+
+// SetProgress reports how far along an operation is, starting it if it
+// isn't running. The bar animates to the new position.
+//
+// Parameters:
+//   - kind: The operation
+//   - label: Text shown next to the spinner
+//   - done: Steps done
+//   - total: Steps in all (0 shows just the spinner)
+//
+// Returns:
+//   - tea.Cmd: Spinner and progress bar animation
+func (a *Activity) SetProgress(kind ActivityKind, label string, done, total int) tea.Cmd {
+	if a == nil {
+		return nil
+	}
+	cmd := a.Start(kind, label)
+	running := a.running[kind]
+	running.done, running.total = done, total
+	if total <= 0 {
+		return cmd
+	}
+	if running.progress.Width == 0 {
+		running.progress = progress.New(
+			progress.WithGradient("#5A56E0", "#5FD7AF"),
+			progress.WithWidth(activityProgressWidth),
+			progress.WithoutPercentage(),
+		)
+	}
+	return tea.Batch(cmd, running.progress.SetPercent(float64(done)/float64(total)))
+}
+
+// Stop marks an operation as finished.
+//
+// Parameters:
+//   - kind: The operation
+func (a *Activity) Stop(kind ActivityKind) {
+	if a == nil {
+		return
+	}
+	delete(a.running, kind)
+}
+
+// Running reports whether an operation is in progress.
+//
+// Parameters:
+//   - kind: The operation
+//
+// Returns:
+//   - bool: True while it runs
+func (a *Activity) Running(kind ActivityKind) bool {
+	if a == nil {
+		return false
+	}
+	_, ok := a.running[kind]
+	return ok
+}
+
+// Update animates the spinner and progress bars.
+//
+// Parameters:
+//   - msg: spinner.TickMsg or progress.FrameMsg (others are ignored)
+//
+// Returns:
+//   - tea.Cmd: The next animation frame, if anything is still running
+func (a *Activity) Update(msg tea.Msg) tea.Cmd {
+	if a == nil {
+		return nil
+	}
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if msg.ID != a.spinner.ID() {
+			return nil
+		}
+		if len(a.running) == 0 {
+			a.ticking = false
+			return nil
+		}
+		var cmd tea.Cmd
+		a.spinner, cmd = a.spinner.Update(msg)
+		return cmd
+
+	case progress.FrameMsg:
+		for _, running := range a.running {
+			if running.total <= 0 {
+				continue
+			}
+			model, cmd := running.progress.Update(msg)
+			running.progress = model.(progress.Model)
+			if cmd != nil {
+				return cmd
+			}
+		}
+	}
+	return nil
+}
+
+// View renders one operation: spinner, label and, when it reports
+// progress, the bar and step count.
+//
+// Parameters:
+//   - kind: The operation
+//
+// Returns:
+//   - string: The rendered indicator ("" if it isn't running)
+func (a *Activity) View(kind ActivityKind) string {
+	if !a.Running(kind) {
+		return ""
+	}
+	running := a.running[kind]
+	view := a.spinner.View() + " " + lipgloss.NewStyle().Foreground(ColorMuted).Render(running.label)
+	if running.total > 0 {
+		view += "\n" + running.progress.View() +
+			lipgloss.NewStyle().Foreground(ColorMuted).Render(fmt.Sprintf(" %d/%d", running.done, running.total))
+	}
+	return view
+}
+
+// ViewStatus renders every running operation except the given ones on one
+// line, for status bars (without progress bars).
+//
+// Parameters:
+//   - except: Operations shown elsewhere
+//
+// Returns:
+//   - string: The running operations ("" if none)
+func (a *Activity) ViewStatus(except ...ActivityKind) string {
+	if a == nil {
+		return ""
+	}
+	skip := make(map[ActivityKind]bool, len(except))
+	for _, kind := range except {
+		skip[kind] = true
+	}
+
+	kinds := make([]ActivityKind, 0, len(a.running))
+	for kind := range a.running {
+		if !skip[kind] {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool { return activityOrder[kinds[i]] < activityOrder[kinds[j]] })
+
+	parts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		running := a.running[kind]
+		label := running.label
+		if running.total > 0 {
+			label += fmt.Sprintf(" %d/%d", running.done, running.total)
+		}
+		parts = append(parts, a.spinner.View()+" "+lipgloss.NewStyle().Foreground(ColorMuted).Render(label))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// tick starts the spinner's animation unless a tick is already in flight.
+func (a *Activity) tick() tea.Cmd {
+	if a.ticking {
+		return nil
+	}
+	a.ticking = true
+	return a.spinner.Tick
+}
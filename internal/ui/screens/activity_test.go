@@ -0,0 +1,58 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/spinner"
+)
+
+// TestActivity tests starting, animating and stopping async feedback.
+func TestActivity(t *testing.T) {
+	activity := NewActivity()
+	if activity.View(ActivitySaving) != "" || activity.ViewStatus() != "" {
+		t.Fatal("idle activity should render nothing")
+	}
+
+	tick := activity.Start(ActivitySaving, "Saving...")
+	if tick == nil {
+		t.Fatal("Start() should start the spinner")
+	}
+	if activity.Start(ActivityThinking, "Thinking...") != nil {
+		t.Error("Start() with the spinner already ticking should not start another tick")
+	}
+	if view := activity.View(ActivitySaving); !strings.Contains(view, "Saving...") {
+		t.Errorf("View(saving) = %q, want the label", view)
+	}
+
+	msg := tick()
+	if _, ok := msg.(spinner.TickMsg); !ok {
+		t.Fatalf("tick message = %T, want spinner.TickMsg", msg)
+	}
+	if activity.Update(msg) == nil {
+		t.Error("Update() while running should schedule the next frame")
+	}
+
+	activity.SetProgress(ActivityImporting, "Importing missed commits", 3, 10)
+	if status := activity.ViewStatus(ActivityThinking); !strings.Contains(status, "Importing missed commits 3/10") || !strings.Contains(status, "Saving...") || strings.Contains(status, "Thinking") {
+		t.Errorf("ViewStatus(thinking) = %q, want importing and saving only", status)
+	}
+	if view := activity.View(ActivityImporting); !strings.Contains(view, "3/10") || strings.Count(view, "\n") != 1 {
+		t.Errorf("View(importing) = %q, want a progress bar line", view)
+	}
+
+	for _, kind := range []ActivityKind{ActivitySaving, ActivityThinking, ActivityImporting} {
+		activity.Stop(kind)
+	}
+	if activity.Update(spinner.TickMsg{ID: activity.spinner.ID()}) != nil {
+		t.Error("Update() with nothing running should stop the spinner")
+	}
+	if activity.Start(ActivitySaving, "Saving...") == nil {
+		t.Error("Start() after the spinner stopped should start it again")
+	}
+
+	var none *Activity
+	if none.Start(ActivitySaving, "Saving...") != nil || none.View(ActivitySaving) != "" || none.Running(ActivitySaving) {
+		t.Error("a nil activity should show nothing")
+	}
+}
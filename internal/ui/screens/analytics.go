@@ -0,0 +1,62 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the character sheet's Analytics section: totals
+// from the optional SQLite analytics database over a recent window, which
+// the key-value store can't answer without reading every event.
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
+)
+
+// AnalyticsSummary is what the Analytics section shows.
+type AnalyticsSummary struct {
+	Days    int                   // Length of the window in days
+	Events  []eventlog.EventCount // Events per type, most frequent first
+	XPByDay []eventlog.DayXP      // XP per day with XP, oldest first
+}
+
+// analyticsTopEvents is how many event types the section lists.
+const analyticsTopEvents = 3
+
+// renderAnalyticsSection renders XP totals and the most frequent events
+// over the summary's window. Returns "" without a summary.
+func renderAnalyticsSection(summary *AnalyticsSummary) string {
+	if summary == nil {
+		return ""
+	}
+
+	title := SubtitleStyle.Render(fmt.Sprintf("📈 Last %d Days", summary.Days))
+	if len(summary.Events) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", MutedTextStyle.Render("No events recorded yet"))
+	}
+
+	total := 0
+	best := eventlog.DayXP{}
+	for _, day := range summary.XPByDay {
+		total += day.XP
+		if day.XP > best.XP {
+			best = day
+		}
+	}
+	xp := StatLabelStyle.Render("XP: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d", total)) +
+		MutedTextStyle.Render(fmt.Sprintf(" over %d active days", len(summary.XPByDay)))
+	rows := []string{title, "", xp}
+	if best.XP > 0 {
+		rows = append(rows, StatLabelStyle.Render("Best day: ")+
+			StatValueStyle.Render(fmt.Sprintf("%d XP", best.XP))+MutedTextStyle.Render(" ("+best.Day+")"))
+	}
+
+	top := make([]string, 0, analyticsTopEvents)
+	for _, count := range summary.Events[:min(len(summary.Events), analyticsTopEvents)] {
+		top = append(top, fmt.Sprintf("%s %d", count.Type, count.Count))
+	}
+	rows = append(rows, StatLabelStyle.Render("Top events: ")+InfoTextStyle.Render(strings.Join(top, " • ")))
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,65 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/eventlog"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRenderAnalyticsSection tests that the section totals XP, names the
+// best day and lists the most frequent events, and is hidden without a
+// summary.
+func TestRenderAnalyticsSection(t *testing.T) {
+	tests := []struct {
+		name     string
+		summary  *AnalyticsSummary
+		contains []string
+		excludes []string
+	}{
+		{
+			name:    "no analytics database",
+			summary: nil,
+		},
+		{
+			name:     "nothing recorded",
+			summary:  &AnalyticsSummary{Days: 30},
+			contains: []string{"Last 30 Days", "No events recorded yet"},
+		},
+		{
+			name: "totals",
+			summary: &AnalyticsSummary{
+				Days: 30,
+				Events: []eventlog.EventCount{
+					{Type: game.EventXPChanged, Count: 12},
+					{Type: game.EventCommit, Count: 10},
+					{Type: game.EventLevelUp, Count: 2},
+					{Type: game.EventQuestDone, Count: 1},
+				},
+				XPByDay: []eventlog.DayXP{{Day: "2025-03-10", XP: 120}, {Day: "2025-03-11", XP: 40}},
+			},
+			contains: []string{"160", "2 active days", "120 XP", "2025-03-10", "xp_changed 12", "level_up 2"},
+			excludes: []string{"quest_done"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderAnalyticsSection(tt.summary)
+			if tt.summary == nil && got != "" {
+				t.Errorf("renderAnalyticsSection(nil) = %q, want empty", got)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("renderAnalyticsSection() missing %q in:\n%s", want, got)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("renderAnalyticsSection() should not contain %q", unwanted)
+				}
+			}
+		})
+	}
+}
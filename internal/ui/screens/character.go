@@ -17,12 +17,20 @@ import (
 //
 // The character screen shows:
 //   - Header with character name and level
-//   - Core stats (CodePower, Wisdom, Agility) with visual stat bars
+//   - Core stats (CodePower, Wisdom, Agility) with skill point allocation and respec
 //   - XP progress bar with detailed breakdown
 //   - Streak information (current and longest)
 //   - Lifetime statistics (commits, lines, quests)
+//   - Personal records (best XP day, longest session, biggest commit, fastest quest)
+//   - Project rollups (optionally filtered to one project)
+//   - Recent XP and event totals from the analytics database, when it's on
+//   - Energy check-ins and break reminder adherence (Wellness)
 //   - Session history (today's activity)
-//   - Future: Achievements section (post-MVP)
+//   - Achievements (Dependency Wrangler track progress)
+//
+// Tab moves keyboard focus between the Identity, XP, Stats, Streaks and
+// Lifetime sections (see CharacterFocus); the focused one is marked and can
+// be expanded.
 //
 // Layout Structure:
 //   - Header: Screen title with character info
@@ -30,19 +38,32 @@ import (
 //   - Right panel: History and achievements
 //   - Footer: Key bindings
 //
+// Panels sit side by side above 100 columns and stack below that. Under
+// compactCharacterWidth columns the screen switches to a single unboxed
+// column with abbreviated labels (see renderCharacterCompact).
+//
 // Parameters:
 //   - character: Player character to display (nil-safe)
+//   - projects: Per-project rollups in display order (empty hides the section)
+//   - projectFilter: Only show this project's rollup ("" for all)
+//   - analytics: Recent totals from the analytics database (nil hides the section)
+//   - focus: Focused section and whether it's expanded
 //   - width: Terminal width in characters
 //   - height: Terminal height in characters
 //
 // Returns:
 //   - string: Rendered character screen UI
-func RenderCharacter(character *game.Character, width, height int) string {
+func RenderCharacter(character *game.Character, projects []game.ProjectSummary, projectFilter string, analytics *AnalyticsSummary, focus CharacterFocus, width, height int) string {
 	// Handle nil character gracefully
 	if character == nil {
 		return renderNoCharacterScreen(width, height)
 	}
 
+	// Minimal terminals get one unboxed column, header and footer included
+	if width < compactCharacterWidth {
+		return renderCharacterCompact(character, projects, projectFilter, width)
+	}
+
 	// Render header (inline to avoid import cycle)
 	header := renderCharacterHeader(character, width)
 
@@ -51,13 +72,13 @@ func RenderCharacter(character *game.Character, width, height int) string {
 
 	var content string
 	if useWideLayout {
-		content = renderCharacterWide(character, width, height)
+		content = renderCharacterWide(character, projects, projectFilter, analytics, focus, width, height)
 	} else {
-		content = renderCharacterNarrow(character, width, height)
+		content = renderCharacterNarrow(character, projects, projectFilter, analytics, focus, width, height)
 	}
 
 	// Render footer with key bindings
-	footer := renderCharacterFooter(width)
+	footer := renderCharacterFooter(character, width)
 
 	// Assemble screen
 	screen := lipgloss.JoinVertical(
@@ -72,16 +93,16 @@ func RenderCharacter(character *game.Character, width, height int) string {
 }
 
 // renderCharacterWide renders character screen with side-by-side panels for wide terminals.
-func renderCharacterWide(character *game.Character, width, height int) string {
+func renderCharacterWide(character *game.Character, projects []game.ProjectSummary, projectFilter string, analytics *AnalyticsSummary, focus CharacterFocus, width, height int) string {
 	// Split width into two columns (55% left, 45% right)
 	leftWidth := int(float64(width) * 0.53)
 	rightWidth := width - leftWidth - 2 // Account for spacing
 
 	// Render left panel: Core stats and progression
-	leftPanel := renderStatsPanel(character, leftWidth)
+	leftPanel := renderStatsPanel(character, focus, leftWidth)
 
 	// Render right panel: History and activity
-	rightPanel := renderHistoryPanel(character, rightWidth)
+	rightPanel := renderHistoryPanel(character, projects, projectFilter, analytics, focus, rightWidth)
 
 	// Join panels horizontally
 	panels := lipgloss.JoinHorizontal(
@@ -94,13 +115,13 @@ func renderCharacterWide(character *game.Character, width, height int) string {
 }
 
 // renderCharacterNarrow renders character screen with stacked panels for narrow terminals.
-func renderCharacterNarrow(character *game.Character, width, height int) string {
+func renderCharacterNarrow(character *game.Character, projects []game.ProjectSummary, projectFilter string, analytics *AnalyticsSummary, focus CharacterFocus, width, height int) string {
 	// Full width for each panel
 	panelWidth := width
 
 	// Render panels vertically
-	statsPanel := renderStatsPanel(character, panelWidth)
-	historyPanel := renderHistoryPanel(character, panelWidth)
+	statsPanel := renderStatsPanel(character, focus, panelWidth)
+	historyPanel := renderHistoryPanel(character, projects, projectFilter, analytics, focus, panelWidth)
 
 	// Stack all panels
 	content := lipgloss.JoinVertical(
@@ -114,24 +135,24 @@ func renderCharacterNarrow(character *game.Character, width, height int) string
 }
 
 // renderStatsPanel renders the left panel with character stats and progression.
-func renderStatsPanel(character *game.Character, width int) string {
+func renderStatsPanel(character *game.Character, focus CharacterFocus, width int) string {
 	sections := make([]string, 0)
 
 	// Character Identity Section
 	identitySection := renderIdentitySection(character)
-	sections = append(sections, identitySection)
+	sections = append(sections, renderFocusable(identitySection, CharacterSectionIdentity, character, focus))
 
 	// XP Progress Section
 	xpSection := renderXPSection(character, width)
-	sections = append(sections, xpSection)
+	sections = append(sections, renderFocusable(xpSection, CharacterSectionXP, character, focus))
 
 	// Core Stats Section (using StatBar component)
 	statsSection := renderCoreStatsSection(character, width)
-	sections = append(sections, statsSection)
+	sections = append(sections, renderFocusable(statsSection, CharacterSectionStats, character, focus))
 
 	// Streak Section
 	streakSection := renderStreakSectionDetailed(character)
-	sections = append(sections, streakSection)
+	sections = append(sections, renderFocusable(streakSection, CharacterSectionStreaks, character, focus))
 
 	// Join all sections
 	content := lipgloss.JoinVertical(
@@ -144,7 +165,7 @@ func renderStatsPanel(character *game.Character, width int) string {
 }
 
 // renderHistoryPanel renders the right panel with history and activity.
-func renderHistoryPanel(character *game.Character, width int) string {
+func renderHistoryPanel(character *game.Character, projects []game.ProjectSummary, projectFilter string, analytics *AnalyticsSummary, focus CharacterFocus, width int) string {
 	sections := make([]string, 0)
 
 	// Today's Activity Section
@@ -153,10 +174,47 @@ func renderHistoryPanel(character *game.Character, width int) string {
 
 	// Lifetime Statistics Section
 	lifetimeSection := renderLifetimeStatsDetailed(character)
-	sections = append(sections, lifetimeSection)
+	sections = append(sections, renderFocusable(lifetimeSection, CharacterSectionLifetime, character, focus))
+
+	// Personal Records Section
+	sections = append(sections, renderRecordsSection(character))
+
+	// Quest Rewards Section (only once a reward choice has paid out)
+	if rewards := renderRewardsSection(character); rewards != "" {
+		sections = append(sections, rewards)
+	}
+
+	// Effort Section (only once Effort was earned or a freeze is held)
+	if effort := renderEffortSection(character); effort != "" {
+		sections = append(sections, effort)
+	}
+
+	// Loot Section (only once a commit has rolled for loot)
+	if loot := renderLootSection(character); loot != "" {
+		sections = append(sections, loot)
+	}
+
+	// Project Rollups Section (only when projects are configured)
+	if len(projects) > 0 {
+		sections = append(sections, renderProjectsSection(projects, projectFilter))
+	}
+
+	// Analytics Section (only with the analytics database)
+	if section := renderAnalyticsSection(analytics); section != "" {
+		sections = append(sections, section)
+	}
+
+	// Energy Correlation Section
+	energySection := renderEnergySection(character)
+	sections = append(sections, energySection)
+
+	// Wellness Section (only once a break reminder has been answered)
+	if wellness := renderWellnessSection(character); wellness != "" {
+		sections = append(sections, wellness)
+	}
 
-	// Achievements Section (placeholder)
-	achievementsSection := renderAchievementsPlaceholder()
+	// Achievements Section
+	achievementsSection := renderAchievementsSection(character)
 	sections = append(sections, achievementsSection)
 
 	// Join all sections
@@ -177,18 +235,20 @@ func renderHistoryPanel(character *game.Character, width int) string {
 func renderIdentitySection(character *game.Character) string {
 	title := SubtitleStyle.Render("⚔️ Character")
 
-	// Character name with emphasis
+	// Character name with emphasis, avatar and pronouns
 	nameLabel := StatLabelStyle.Render("Name: ")
-	nameValue := BoldTextStyle.Render(character.Name)
+	nameValue := renderAvatar(character) + " " + BoldTextStyle.Render(character.Name)
+	if character.Profile.Pronouns != "" {
+		nameValue += MutedTextStyle.Render(" (" + character.Profile.Pronouns + ")")
+	}
 	name := nameLabel + nameValue
+	if character.Title != "" {
+		name += InfoTextStyle.Render(", " + character.Title)
+	}
 
-	// Level display with badge
+	// Level display with the rank held at it
 	levelLabel := StatLabelStyle.Render("Level: ")
-	levelValue := lipgloss.NewStyle().
-		Foreground(ColorLevel).
-		Bold(true).
-		Render(fmt.Sprintf("%d", character.Level))
-	level := levelLabel + levelValue
+	level := levelLabel + renderLevelWithRank(character)
 
 	// Created date
 	createdLabel := StatLabelStyle.Render("Created: ")
@@ -201,15 +261,16 @@ func renderIdentitySection(character *game.Character) string {
 	daysValue := StatValueStyle.Render(fmt.Sprintf("%d", daysSinceCreation))
 	days := daysLabel + daysValue
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		name,
-		level,
-		created,
-		days,
-	)
+	lines := []string{title, ""}
+	if character.Profile.Motto != "" {
+		lines = append(lines, InfoTextStyle.Italic(true).Render("“"+character.Profile.Motto+"”"), "")
+	}
+	lines = append(lines, name, level, created, days)
+	if character.Profile.FavoriteLanguage != "" {
+		lines = append(lines, StatLabelStyle.Render("Favorite Language: ")+StatValueStyle.Render(character.Profile.FavoriteLanguage))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
 // renderXPSection renders XP progress with detailed breakdown.
@@ -256,6 +317,8 @@ func renderXPSection(character *game.Character, width int) string {
 		xpProgress,
 		remaining,
 		percent,
+		"",
+		renderRankProgress(character, barWidth),
 	)
 }
 
@@ -303,20 +366,55 @@ func renderCoreStatsSection(character *game.Character, width int) string {
 	)
 
 	// Add explanations for each stat
-	codePowerDesc := MutedTextStyle.Render("  ├─ Increases commit quality bonus")
-	wisdomDesc := MutedTextStyle.Render("  ├─ Multiplies XP gain from activities")
-	agilityDesc := MutedTextStyle.Render("  └─ Speeds up quest completion")
+	codePowerDesc := MutedTextStyle.Render("  ├─ CodePower: unlocks quests that require it")
+	wisdomDesc := MutedTextStyle.Render(fmt.Sprintf("  ├─ Wisdom: ×%.2f XP from commits and quests", game.WisdomMultiplier(character.Wisdom)))
+	agilityDesc := MutedTextStyle.Render("  └─ Agility: unlocks quests that require it")
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		statsLine,
-		"",
-		codePowerDesc,
-		wisdomDesc,
-		agilityDesc,
-	)
+	rows := []string{title, "", statsLine, "", codePowerDesc, wisdomDesc, agilityDesc, DimTextStyle.Render("I for exact effects")}
+
+	// Stat point allocation (points come from level-ups and quest rewards)
+	if character.SkillPoints > 0 {
+		points := StatValueStyle.Render(fmt.Sprintf("✨ %d skill points to spend", character.SkillPoints))
+		rows = append(rows, "", points+"  "+MutedTextStyle.Render("1 CodePower · 2 Wisdom · 3 Agility"))
+	}
+	if faded := character.DecayedPoints(); faded > 0 {
+		rows = append(rows, WarningTextStyle.Render(fmt.Sprintf("🍂 %d points faded while you were away; finish The Comeback to win them back", faded)))
+	}
+	if allocated := character.RespecRefund(); allocated > 0 {
+		rows = append(rows, DimTextStyle.Render(fmt.Sprintf("R to respec: refund %d points for %d XP",
+			allocated, character.RespecCost())))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// RenderStatDetails renders the stat details popover: each stat's exact
+// effect at its current value, worked out by the engine's own formulas.
+//
+// Parameters:
+//   - effects: Stat effects from game.StatEffects
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The popover, centered on the screen
+func RenderStatDetails(effects []game.StatEffect, width, height int) string {
+	rows := []string{TitleStyle.Render("📊 Stat Details"), ""}
+	for _, effect := range effects {
+		rows = append(rows, StatValueStyle.Render(effect.Summary))
+		for i, detail := range effect.Details {
+			branch := "├─"
+			if i == len(effect.Details)-1 {
+				branch = "└─"
+			}
+			rows = append(rows, MutedTextStyle.Render("  "+branch+" "+detail))
+		}
+		rows = append(rows, "")
+	}
+	rows = append(rows, DimTextStyle.Render("[1-3] Allocate  [I/Esc] Close"))
+
+	card := BoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
 }
 
 // renderStreakSectionDetailed renders detailed streak information.
@@ -358,16 +456,12 @@ func renderStreakSectionDetailed(character *game.Character) string {
 		motivation = SuccessTextStyle.Render("🌟 LEGENDARY STREAK!")
 	}
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		current,
-		longest,
-		lastActive,
-		"",
-		motivation,
-	)
+	rows := []string{title, "", current, longest, lastActive}
+	if character.StreakFreezes > 0 {
+		rows = append(rows, StatLabelStyle.Render("Freezes: ")+
+			InfoTextStyle.Render(fmt.Sprintf("%d 🧊 (cover missed days)", character.StreakFreezes)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, append(rows, "", motivation)...)
 }
 
 // renderTodayActivityDetailed renders detailed today's activity section.
@@ -464,6 +558,23 @@ func renderLifetimeStatsDetailed(character *game.Character) string {
 	questsIcon := "✅"
 	quests := questsIcon + " " + questsLabel + questsValue
 
+	// Reviews and pairing from commit trailers
+	reviewsLabel := StatLabelStyle.Render("Reviews Given/Received: ")
+	reviewsValue := StatValueStyle.Render(fmt.Sprintf("%d / %d", character.ReviewsGiven, character.ReviewsReceived))
+	reviewsIcon := "🔍"
+	reviews := reviewsIcon + " " + reviewsLabel + reviewsValue
+
+	pairedLabel := StatLabelStyle.Render("Co-authored Commits: ")
+	pairedValue := StatValueStyle.Render(fmt.Sprintf("%d", character.CoAuthoredCommits))
+	pairedIcon := "🤝"
+	paired := pairedIcon + " " + pairedLabel + pairedValue
+
+	// Conventional commit messages (Well-Formed quest line)
+	wellFormedLabel := StatLabelStyle.Render("Well-formed Commits: ")
+	wellFormedValue := StatValueStyle.Render(fmt.Sprintf("%d", character.WellFormedCommits))
+	wellFormedIcon := "📜"
+	wellFormed := wellFormedIcon + " " + wellFormedLabel + wellFormedValue
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		title,
@@ -474,25 +585,247 @@ func renderLifetimeStatsDetailed(character *game.Character) string {
 		net,
 		"",
 		quests,
+		reviews,
+		paired,
+		wellFormed,
+		"",
+		renderOpenSourceStat(character),
 	)
 }
 
-// renderAchievementsPlaceholder renders a placeholder for achievements (post-MVP).
-func renderAchievementsPlaceholder() string {
-	title := SubtitleStyle.Render("🏆 Achievements")
+// renderOpenSourceStat renders the highlighted Open Source stat: commits to
+// other people's repositories and how many projects they went to.
+func renderOpenSourceStat(character *game.Character) string {
+	label := "🌍 " + StatLabelStyle.Render("Open Source: ")
+	if character.OpenSourceCommits == 0 {
+		return label + MutedTextStyle.Render("no contributions yet")
+	}
+	projects := len(character.OpenSourceProjects)
+	plural := "s"
+	if projects == 1 {
+		plural = ""
+	}
+	return label + lipgloss.NewStyle().Foreground(ColorSuccess).Bold(true).
+		Render(fmt.Sprintf("%d commits to %d project%s", character.OpenSourceCommits, projects, plural))
+}
+
+// renderRecordsSection renders the player's personal bests. Records not
+// set yet show a dash.
+func renderRecordsSection(character *game.Character) string {
+	title := SubtitleStyle.Render("🏆 Records")
+	records := character.Records
+
+	row := func(icon string, kind game.RecordKind, value, detail string, set bool) string {
+		line := icon + " " + StatLabelStyle.Render(game.RecordLabel(kind)+": ")
+		if !set {
+			return line + MutedTextStyle.Render("—")
+		}
+		return line + StatValueStyle.Render(value) + MutedTextStyle.Render(" ("+detail+")")
+	}
 
-	message := MutedTextStyle.Render("Coming soon!")
-	hint := InfoTextStyle.Render("Unlock achievements by completing quests and reaching milestones.")
+	commitDetail := formatDate(records.BiggestCommitAt)
+	if len(records.BiggestCommitSHA) >= 7 {
+		commitDetail = records.BiggestCommitSHA[:7] + ", " + commitDetail
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		title,
 		"",
-		message,
-		hint,
+		row("⭐", game.RecordDayXP, fmt.Sprintf("%d XP", records.MostXPInDay),
+			formatDate(records.MostXPDate), records.MostXPInDay > 0),
+		row("⏱", game.RecordLongestSession, game.FormatRecordDuration(records.LongestSession),
+			formatDate(records.LongestSessionAt), records.LongestSession > 0),
+		row("💥", game.RecordBiggestCommit, fmt.Sprintf("%d lines", records.BiggestCommit),
+			commitDetail, records.BiggestCommit > 0),
+		row("🏃", game.RecordFastestQuest, game.FormatRecordDuration(records.FastestQuest),
+			records.FastestQuestTitle, records.FastestQuest > 0),
+	)
+}
+
+// renderRewardsSection renders rewards picked on quest completion: skill
+// points, trophies and any running XP boost. Returns "" if there are none.
+func renderRewardsSection(character *game.Character) string {
+	boosted := character.XPBoostActive(time.Now())
+	if character.SkillPoints == 0 && len(character.Items) == 0 && !boosted {
+		return ""
+	}
+
+	title := SubtitleStyle.Render("🎁 Quest Rewards")
+
+	skillLabel := StatLabelStyle.Render("Skill Points: ")
+	skillValue := StatValueStyle.Render(fmt.Sprintf("%d", character.SkillPoints))
+	skills := "✨ " + skillLabel + skillValue
+
+	trophyLabel := StatLabelStyle.Render("Trophies: ")
+	trophyValue := StatValueStyle.Render(fmt.Sprintf("%d", len(character.Items)))
+	trophies := "🏆 " + trophyLabel + trophyValue
+	if len(character.Items) > 0 {
+		latest := character.Items[len(character.Items)-1]
+		trophies += MutedTextStyle.Render(" (latest: " + latest.Name + ")")
+	}
+
+	rows := []string{title, "", skills, trophies}
+	if boosted {
+		remaining := time.Until(character.XPBoostUntil).Round(time.Minute)
+		rows = append(rows, "⚡ "+StatLabelStyle.Render("XP Boost: ")+
+			InfoTextStyle.Render(fmt.Sprintf("+%d%% commit XP for %s", game.XPBoostPercent, remaining)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderEffortSection renders the Effort balance, held streak freezes and
+// what Effort buys. Returns "" if the character has neither.
+func renderEffortSection(character *game.Character) string {
+	if character.Effort == 0 && character.StreakFreezes == 0 {
+		return ""
+	}
+
+	title := SubtitleStyle.Render("💠 Effort")
+
+	balance := StatLabelStyle.Render("Balance: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d", character.Effort))
+	freezes := StatLabelStyle.Render("Streak Freezes: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d/%d 🧊", character.StreakFreezes, game.MaxStreakFreezes))
+	shop := MutedTextStyle.Render(fmt.Sprintf("F buys a streak freeze (%d) • R rerolls a daily quest from its detail view (%d)",
+		game.StreakFreezeCost, game.RerollCost))
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", balance, freezes, shop)
+}
+
+// renderProjectsSection renders rollup stats per project. With a filter set,
+// only that project is shown.
+func renderProjectsSection(projects []game.ProjectSummary, projectFilter string) string {
+	title := SubtitleStyle.Render("📁 Projects")
+	if projectFilter != "" {
+		title += " " + InfoTextStyle.Render("("+projectFilter+")")
+	}
+
+	rows := []string{title, ""}
+	for _, project := range projects {
+		if projectFilter != "" && project.Name != projectFilter {
+			continue
+		}
+
+		name := BoldTextStyle.Render(project.Name)
+		stats := StatValueStyle.Render(fmt.Sprintf("%d commits • +%d/-%d lines • %d XP",
+			project.Stats.Commits, project.Stats.LinesAdded, project.Stats.LinesRemoved, project.Stats.XPEarned))
+		quests := MutedTextStyle.Render(fmt.Sprintf("   Quests: %d active, %d completed",
+			project.ActiveQuests, project.Stats.QuestsCompleted))
+		rows = append(rows, name+"  "+stats, quests)
+	}
+
+	rows = append(rows, DimTextStyle.Render("P to filter by project"))
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderEnergySection renders today's energy check-in and how energy
+// correlates with productivity across all check-ins.
+func renderEnergySection(character *game.Character) string {
+	title := SubtitleStyle.Render("⚡ Energy")
+
+	// Today's rating
+	todayLabel := StatLabelStyle.Render("Today: ")
+	todayCheckIn := character.TodayEnergy()
+	var todayValue string
+	if todayCheckIn == nil {
+		todayValue = MutedTextStyle.Render("Not checked in")
+	} else {
+		todayValue = StatValueStyle.Render(fmt.Sprintf("%s %d/%d",
+			strings.Repeat("⚡", todayCheckIn.Rating), todayCheckIn.Rating, game.MaxEnergyRating))
+	}
+	today := todayLabel + todayValue
+
+	// Correlation summary and tip
+	correlation := game.CalculateEnergyCorrelation(character.EnergyLog)
+	summary := InfoTextStyle.Render(correlation.Summary())
+	tip := MutedTextStyle.Render("💡 " + game.EnergyTip(todayCheckIn, correlation))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		today,
+		summary,
+		tip,
 	)
 }
 
+// renderWellnessSection renders the Wellness stat and how often break
+// reminders were taken this week and overall.
+func renderWellnessSection(character *game.Character) string {
+	if character.Wellness == 0 && len(character.BreakLog) == 0 {
+		return ""
+	}
+
+	title := SubtitleStyle.Render("🧘 Wellness")
+
+	wellness := StatLabelStyle.Render("Wellness: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d", character.Wellness))
+	week := StatLabelStyle.Render("This week: ") +
+		InfoTextStyle.Render(character.BreakAdherenceSince(time.Now().AddDate(0, 0, -7)).Summary())
+	overall := StatLabelStyle.Render("All time: ") +
+		MutedTextStyle.Render(character.BreakAdherenceSince(time.Time{}).Summary())
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", wellness, week, overall)
+}
+
+// renderAchievementsSection renders achievement tracks: unlocked tiers are
+// checked off and the next tier shows progress toward it.
+func renderAchievementsSection(character *game.Character) string {
+	title := SubtitleStyle.Render("🏆 Achievements")
+
+	lines := []string{title, ""}
+	for i, tier := range game.DependencyWranglerTiers {
+		if character.HasAchievement(tier.ID) {
+			lines = append(lines, renderBadge(i)+" "+SuccessTextStyle.Render(tier.Name))
+			continue
+		}
+		lines = append(lines, MutedTextStyle.Render(fmt.Sprintf("○ %s (%d/%d dependency bumps)",
+			tier.Name, min(character.DependencyBumps, tier.Threshold), tier.Threshold)))
+	}
+	lines = append(lines, InfoTextStyle.Render("Bump dependency versions in go.mod to climb the track."), "")
+
+	for i, tier := range game.QuestCrownTiers {
+		if character.HasAchievement(tier.ID) {
+			lines = append(lines, renderBadge(i)+" "+SuccessTextStyle.Render(tier.Name))
+			continue
+		}
+		lines = append(lines, MutedTextStyle.Render(fmt.Sprintf("○ %s (%d/%d crowns)",
+			tier.Name, min(character.Crowns, tier.Threshold), tier.Threshold)))
+	}
+	lines = append(lines, InfoTextStyle.Render("Complete a quest every day of a week to earn a crown 👑."), "")
+
+	rested := character.RestedDays(time.Now(), game.StreakClock{})
+	for i, tier := range game.WellRestedTiers {
+		if character.HasAchievement(tier.ID) {
+			lines = append(lines, renderBadge(i)+" "+SuccessTextStyle.Render(tier.Name))
+			continue
+		}
+		lines = append(lines, MutedTextStyle.Render(fmt.Sprintf("○ %s: no commits after bedtime for %d days (%d/%d)",
+			tier.Name, tier.Threshold, min(rested, tier.Threshold), tier.Threshold)))
+	}
+	if character.RestedSince.IsZero() {
+		lines = append(lines, InfoTextStyle.Render("Turn on late-night warnings in Settings to track restful nights 🌙."))
+	} else {
+		lines = append(lines, InfoTextStyle.Render("Put the keyboard down at bedtime to climb the track 🌙."))
+	}
+	lines = append(lines, "")
+
+	for i, tier := range game.OpenSourceTiers {
+		if character.HasAchievement(tier.ID) {
+			lines = append(lines, renderBadge(i)+" "+SuccessTextStyle.Render(tier.Name))
+			continue
+		}
+		lines = append(lines, MutedTextStyle.Render(fmt.Sprintf("○ %s (%d/%d open source commits)",
+			tier.Name, min(character.OpenSourceCommits, tier.Threshold), tier.Threshold)))
+	}
+	lines = append(lines, InfoTextStyle.Render("Commit to projects you don't own (see git.own_accounts) to climb the track 🌍."))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
 // renderNoCharacterScreen renders a message when no character is loaded.
 func renderNoCharacterScreen(width, height int) string {
 	// Render header even for nil character
@@ -517,11 +850,18 @@ func renderNoCharacterScreen(width, height int) string {
 	return header + "\n" + centered
 }
 
-// renderCharacterFooter renders the footer with key bindings.
-func renderCharacterFooter(width int) string {
+// renderCharacterFooter renders the footer with key bindings. Features the
+// character hasn't unlocked yet say when they open up.
+func renderCharacterFooter(character *game.Character, width int) string {
 	// Key bindings
 	dashboard := renderKeybind("Alt+Q", "Dashboard")
 	mentor := renderKeybind("Alt+M", "Mentor")
+	allocate := renderFeatureKeybind(character, game.FeatureSkills, "1-3", "Allocate")
+	respec := renderFeatureKeybind(character, game.FeatureSkills, "R", "Respec")
+	details := renderFeatureKeybind(character, game.FeatureStatDetails, "I", "Stat Details")
+	freeze := renderKeybind("F", "Streak Freeze")
+	scroll := renderKeybind("U", "XP Scroll")
+	sections := renderKeybind("Tab", "Sections")
 	esc := renderKeybind("Esc", "Back")
 	help := renderKeybind("?", "Help")
 
@@ -531,6 +871,18 @@ func renderCharacterFooter(width int) string {
 		"  ",
 		mentor,
 		"  ",
+		allocate,
+		"  ",
+		respec,
+		"  ",
+		details,
+		"  ",
+		freeze,
+		"  ",
+		scroll,
+		"  ",
+		sections,
+		"  ",
 		esc,
 		"  ",
 		help,
@@ -628,8 +980,11 @@ func renderCharacterHeader(char *game.Character, width int) string {
 		levelStyle := lipgloss.NewStyle().
 			Foreground(colorLevel).
 			Bold(true)
-		name := nameStyle.Render(char.Name)
-		level := levelStyle.Render(fmt.Sprintf("Lvl %d", char.Level))
+		name := renderAvatar(char) + " " + nameStyle.Render(char.Name)
+		if char.Profile.Pronouns != "" {
+			name += lipgloss.NewStyle().Foreground(colorDim).Render(" (" + char.Profile.Pronouns + ")")
+		}
+		level := levelStyle.Render(fmt.Sprintf("Lvl %d · %s", char.Level, char.Rank().Name))
 		rightSection = name + " " + level
 	}
 
@@ -658,6 +1013,17 @@ func renderCharacterHeader(char *game.Character, width int) string {
 		content = leftSection + spacer1 + centerSection + spacer2 + rightSection
 	}
 
+	// Motto on its own line, right-aligned under the character
+	if char != nil && char.Profile.Motto != "" {
+		motto := lipgloss.NewStyle().
+			Foreground(colorDim).
+			Italic(true).
+			Width(width - 6).
+			Align(lipgloss.Right).
+			Render("“" + char.Profile.Motto + "”")
+		content = lipgloss.JoinVertical(lipgloss.Left, content, motto)
+	}
+
 	// Wrap in styled box
 	style := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder(), false, false, true, false).
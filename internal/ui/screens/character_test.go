@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/charmbracelet/x/ansi"
+
 	"github.com/AutumnsGrove/codequest/internal/game"
 )
 
@@ -54,7 +56,7 @@ func TestRenderCharacter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderCharacter(tt.character, tt.width, tt.height)
+			result := RenderCharacter(tt.character, nil, "", nil, CharacterFocus{}, tt.width, tt.height)
 
 			// Should never return empty string
 			if result == "" && !tt.wantEmpty {
@@ -79,7 +81,7 @@ func TestRenderCharacter(t *testing.T) {
 // TestRenderCharacterWide tests the wide layout rendering.
 func TestRenderCharacterWide(t *testing.T) {
 	char := createTestCharacter()
-	result := renderCharacterWide(char, 120, 40)
+	result := renderCharacterWide(char, nil, "", nil, CharacterFocus{}, 120, 40)
 
 	if result == "" {
 		t.Error("renderCharacterWide() returned empty string")
@@ -107,7 +109,7 @@ func TestRenderCharacterWide(t *testing.T) {
 // TestRenderCharacterNarrow tests the narrow layout rendering.
 func TestRenderCharacterNarrow(t *testing.T) {
 	char := createTestCharacter()
-	result := renderCharacterNarrow(char, 80, 40)
+	result := renderCharacterNarrow(char, nil, "", nil, CharacterFocus{}, 80, 40)
 
 	if result == "" {
 		t.Error("renderCharacterNarrow() returned empty string")
@@ -122,7 +124,7 @@ func TestRenderCharacterNarrow(t *testing.T) {
 // TestRenderStatsPanel tests the stats panel rendering.
 func TestRenderStatsPanel(t *testing.T) {
 	char := createTestCharacter()
-	result := renderStatsPanel(char, 60)
+	result := renderStatsPanel(char, CharacterFocus{}, 60)
 
 	if result == "" {
 		t.Error("renderStatsPanel() returned empty string")
@@ -147,7 +149,7 @@ func TestRenderStatsPanel(t *testing.T) {
 // TestRenderHistoryPanel tests the history panel rendering.
 func TestRenderHistoryPanel(t *testing.T) {
 	char := createTestCharacter()
-	result := renderHistoryPanel(char, 60)
+	result := renderHistoryPanel(char, nil, "", nil, CharacterFocus{}, 60)
 
 	if result == "" {
 		t.Error("renderHistoryPanel() returned empty string")
@@ -334,23 +336,40 @@ func TestRenderLifetimeStatsDetailed(t *testing.T) {
 	}
 }
 
-// TestRenderAchievementsPlaceholder tests achievements placeholder rendering.
-func TestRenderAchievementsPlaceholder(t *testing.T) {
-	result := renderAchievementsPlaceholder()
-
-	if result == "" {
-		t.Error("renderAchievementsPlaceholder() returned empty string")
+// TestRenderRewardsSection tests the quest rewards section.
+func TestRenderRewardsSection(t *testing.T) {
+	char := createTestCharacter()
+	if result := renderRewardsSection(char); result != "" {
+		t.Errorf("renderRewardsSection() without rewards = %q, want empty", result)
 	}
 
-	// Should contain placeholder text
-	expectedStrings := []string{
-		"Achievements",
-		"Coming soon",
+	char.SkillPoints = 2
+	char.Items = []game.Item{{Name: "Refactor Raid Trophy", Source: "Refactor Raid"}}
+	char.XPBoostUntil = time.Now().Add(3 * time.Hour)
+
+	result := renderRewardsSection(char)
+	for _, expected := range []string{"Quest Rewards", "Skill Points:", "2", "Trophies:", "Refactor Raid Trophy", "XP Boost:"} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("renderRewardsSection() should contain %q", expected)
+		}
 	}
+}
 
-	for _, expected := range expectedStrings {
+// TestRenderAchievementsSection tests the Dependency Wrangler track:
+// unlocked tiers are checked off and locked ones show progress.
+func TestRenderAchievementsSection(t *testing.T) {
+	char := createTestCharacter()
+	char.RecordDependencyBumps(12)
+
+	result := renderAchievementsSection(char)
+	for _, expected := range []string{
+		"Achievements",
+		"✓ Dependency Wrangler I",
+		"○ Dependency Wrangler II (12/25 dependency bumps)",
+		"○ Dependency Wrangler III (12/100 dependency bumps)",
+	} {
 		if !strings.Contains(result, expected) {
-			t.Errorf("renderAchievementsPlaceholder() should contain %q", expected)
+			t.Errorf("renderAchievementsSection() should contain %q", expected)
 		}
 	}
 }
@@ -378,7 +397,9 @@ func TestRenderNoCharacterScreen(t *testing.T) {
 
 // TestRenderCharacterFooter tests footer rendering.
 func TestRenderCharacterFooter(t *testing.T) {
-	result := renderCharacterFooter(80)
+	character := game.NewCharacter("Tester")
+	character.Level = 5
+	result := renderCharacterFooter(character, 80)
 
 	if result == "" {
 		t.Error("renderCharacterFooter() returned empty string")
@@ -401,6 +422,39 @@ func TestRenderCharacterFooter(t *testing.T) {
 	}
 }
 
+// TestRenderCharacterFooterLocked tests that locked features say when they
+// unlock instead of showing their key.
+func TestRenderCharacterFooterLocked(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    int
+		want     []string
+		dontWant []string
+	}{
+		{"new character", 1, []string{"Allocate: Unlocks at level 2", "Stat Details: Unlocks at level 3"}, []string{"[I]"}},
+		{"skills unlocked", 2, []string{"[1-3]", "Stat Details: Unlocks at level 3"}, []string{"Allocate: Unlocks"}},
+		{"everything unlocked", 3, []string{"[1-3]", "[I]"}, []string{"Unlocks at level"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			character.Level = tt.level
+			result := renderCharacterFooter(character, 200)
+			for _, want := range tt.want {
+				if !strings.Contains(result, want) {
+					t.Errorf("footer should contain %q:\n%s", want, result)
+				}
+			}
+			for _, dontWant := range tt.dontWant {
+				if strings.Contains(result, dontWant) {
+					t.Errorf("footer should not contain %q:\n%s", dontWant, result)
+				}
+			}
+		})
+	}
+}
+
 // TestFormatDate tests date formatting function.
 func TestFormatDate(t *testing.T) {
 	now := time.Now()
@@ -499,3 +553,151 @@ func createTestCharacter() *game.Character {
 
 	return char
 }
+
+// TestRenderRecordsSection tests personal records rendering.
+func TestRenderRecordsSection(t *testing.T) {
+	char := createTestCharacter()
+	result := renderRecordsSection(char)
+	if !strings.Contains(result, "Records") || !strings.Contains(result, "—") {
+		t.Errorf("renderRecordsSection() without records should show placeholders, got %q", result)
+	}
+
+	char.Records = game.PersonalRecords{
+		MostXPInDay:       640,
+		MostXPDate:        time.Now(),
+		LongestSession:    2*time.Hour + 5*time.Minute,
+		LongestSessionAt:  time.Now(),
+		BiggestCommit:     812,
+		BiggestCommitSHA:  "abcdef0123",
+		BiggestCommitAt:   time.Now(),
+		FastestQuest:      12 * time.Minute,
+		FastestQuestTitle: "Bug Hunt",
+		FastestQuestAt:    time.Now(),
+	}
+	result = renderRecordsSection(char)
+	for _, expected := range []string{"640 XP", "2h 05m", "812 lines", "abcdef0", "12m 00s", "Bug Hunt"} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("renderRecordsSection() should contain %q", expected)
+		}
+	}
+}
+
+// TestRenderCharacterFitsTerminal tests that no line of the character
+// screen is wider than the terminal at standard sizes, with every optional
+// section showing.
+func TestRenderCharacterFitsTerminal(t *testing.T) {
+	character := createTestCharacter()
+	character.Name = "Sir Reginald the Unreasonably Long-Named"
+	character.Profile.Pronouns = "they/them"
+	character.Profile.Motto = "Ship small, ship often, and always write the failing test first"
+	character.SkillPoints = 3
+	character.Effort = 40
+	character.StreakFreezes = 2
+	character.Records = game.PersonalRecords{MostXPInDay: 1200, LongestSession: 3 * time.Hour, BiggestCommit: 4200, FastestQuest: 20 * time.Minute}
+	projects := []game.ProjectSummary{{Name: "work-monorepo", ActiveQuests: 2}}
+
+	tests := []struct {
+		name          string
+		width, height int
+		wantCompact   bool
+	}{
+		{"40x20", 40, 20, true},
+		{"60x20", 60, 20, true},
+		{"69x24", 69, 24, true},
+		{"70x24", 70, 24, false},
+		{"80x24", 80, 24, false},
+		{"100x30", 100, 30, false},
+		{"120x40", 120, 40, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := RenderCharacter(character, projects, "", nil, CharacterFocus{}, tt.width, tt.height)
+			lines := strings.Split(output, "\n")
+			for i, line := range lines {
+				if width := ansi.StringWidth(line); width > tt.width {
+					t.Errorf("line %d is %d columns wide (terminal %d): %q", i, width, tt.width, ansi.Strip(line))
+				}
+			}
+
+			// The compact layout has no boxes and fits a 24-row terminal
+			compact := !strings.Contains(output, "╭")
+			if compact != tt.wantCompact {
+				t.Errorf("compact layout = %v, want %v", compact, tt.wantCompact)
+			}
+			if tt.wantCompact && len(lines) > 24 {
+				t.Errorf("compact layout is %d lines, want at most 24", len(lines))
+			}
+		})
+	}
+}
+
+// TestCharacterFocusMove tests Tab order through the sections, wrapping at
+// either end.
+func TestCharacterFocusMove(t *testing.T) {
+	tests := []struct {
+		name string
+		from CharacterFocus
+		step int
+		want CharacterSection
+	}{
+		{"Tab from nothing", CharacterFocus{}, 1, CharacterSectionIdentity},
+		{"Shift+Tab from nothing", CharacterFocus{}, -1, CharacterSectionLifetime},
+		{"next section", CharacterFocus{Section: CharacterSectionXP}, 1, CharacterSectionStats},
+		{"previous section", CharacterFocus{Section: CharacterSectionXP}, -1, CharacterSectionIdentity},
+		{"wraps forward", CharacterFocus{Section: CharacterSectionLifetime}, 1, CharacterSectionIdentity},
+		{"wraps back", CharacterFocus{Section: CharacterSectionIdentity, Expanded: true}, -1, CharacterSectionLifetime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.from.Move(tt.step)
+			if got.Section != tt.want || got.Expanded {
+				t.Errorf("Move(%d) = %+v, want %v collapsed", tt.step, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRenderCharacterFocus tests that only the focused section is marked
+// and only an expanded one shows its extra detail, within the terminal
+// width.
+func TestRenderCharacterFocus(t *testing.T) {
+	character := game.NewCharacter("Focus")
+	character.Crowns = 2
+	character.Languages = map[string]int{"Go": 3, "Markdown": 1}
+
+	tests := []struct {
+		name     string
+		focus    CharacterFocus
+		want     []string
+		wantNone []string
+	}{
+		{"no focus", CharacterFocus{}, nil, []string{"┃", "Enter for more"}},
+		{"focused", CharacterFocus{Section: CharacterSectionStreaks}, []string{"┃", "Enter for more"}, []string{"Weekly crowns"}},
+		{"expanded", CharacterFocus{Section: CharacterSectionStreaks, Expanded: true}, []string{"Weekly crowns: 2 👑"}, []string{"Enter for more"}},
+		{"expanded lifetime", CharacterFocus{Section: CharacterSectionLifetime, Expanded: true}, []string{"Top languages: Go 75%, Markdown 25%"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := RenderCharacter(character, nil, "", nil, tt.focus, 120, 60)
+			plain := ansi.Strip(output)
+			for _, want := range tt.want {
+				if !strings.Contains(plain, want) {
+					t.Errorf("RenderCharacter() missing %q", want)
+				}
+			}
+			for _, unwanted := range tt.wantNone {
+				if strings.Contains(plain, unwanted) {
+					t.Errorf("RenderCharacter() should not contain %q", unwanted)
+				}
+			}
+			for i, line := range strings.Split(output, "\n") {
+				if width := ansi.StringWidth(line); width > 120 {
+					t.Errorf("line %d is %d columns wide", i, width)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Character screen's compact layout for terminals
+// narrower than compactCharacterWidth (e.g. 80x24 sessions over SSH with a
+// split pane): one column, abbreviated labels and no boxes, with every line
+// cut to the terminal width.
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// compactCharacterWidth is the narrowest terminal that gets the boxed
+// character layouts; anything narrower gets the compact one.
+const compactCharacterWidth = 70
+
+// renderCharacterCompact renders the whole character screen, header and
+// footer included, as short unboxed lines. Sections that are empty in the
+// full layouts (no rewards, no projects) are left out the same way.
+//
+// Parameters:
+//   - character: Player character to display (non-nil)
+//   - projects: Per-project rollups in display order (empty hides the line)
+//   - projectFilter: Only show this project's rollup ("" for all)
+//   - width: Terminal width in characters
+//
+// Returns:
+//   - string: Rendered character screen, no line wider than width
+func renderCharacterCompact(character *game.Character, projects []game.ProjectSummary, projectFilter string, width int) string {
+	label := func(text string) string { return StatLabelStyle.Render(text + " ") }
+	value := func(format string, args ...any) string { return StatValueStyle.Render(fmt.Sprintf(format, args...)) }
+
+	// Header: who and what level, motto underneath
+	name := renderAvatar(character) + " " + BoldTextStyle.Render(character.Name)
+	if character.Profile.Pronouns != "" {
+		name += MutedTextStyle.Render(" (" + character.Profile.Pronouns + ")")
+	}
+	lines := []string{
+		SubtitleStyle.UnsetMargins().Render("🎮 Character Sheet"),
+		name + " " + StatValueStyle.Render(fmt.Sprintf("Lvl %d · %s", character.Level, character.Rank().Name)),
+	}
+	if character.Profile.Motto != "" {
+		lines = append(lines, InfoTextStyle.Italic(true).Render("“"+character.Profile.Motto+"”"))
+	}
+	lines = append(lines, "")
+
+	// Progression: XP bar sized to what's left of the line
+	xpText := fmt.Sprintf(" %d/%d", character.XP, character.XPToNextLevel)
+	barWidth := max(width-len("XP ")-len(xpText)-len(" (100%)"), 5)
+	lines = append(lines, label("XP")+renderProgressBar(character.XP, character.XPToNextLevel, barWidth, "xp"))
+
+	stats := label("Stats") + fmt.Sprintf("CP %s  WIS %s  AGI %s",
+		value("%d", character.CodePower), value("%d", character.Wisdom), value("%d", character.Agility))
+	lines = append(lines, stats+MutedTextStyle.Render(fmt.Sprintf("  ×%.2f XP", game.WisdomMultiplier(character.Wisdom))))
+	if character.SkillPoints > 0 {
+		lines = append(lines, label("SP")+value("%d", character.SkillPoints)+MutedTextStyle.Render(" to spend (1 CP · 2 WIS · 3 AGI)"))
+	}
+	if faded := character.DecayedPoints(); faded > 0 {
+		lines = append(lines, WarningTextStyle.Render(fmt.Sprintf("🍂 %d pts faded, finish The Comeback", faded)))
+	}
+
+	streak := label("Streak") + value("%dd", character.CurrentStreak) + MutedTextStyle.Render(fmt.Sprintf(" (best %dd)", character.LongestStreak))
+	if character.StreakFreezes > 0 {
+		streak += MutedTextStyle.Render(fmt.Sprintf(" · %d 🧊", character.StreakFreezes))
+	}
+	lines = append(lines, streak, "")
+
+	// History: today, lifetime totals and personal bests
+	lines = append(lines,
+		label("Today")+fmt.Sprintf("%s commits · +%s lines · %s",
+			value("%d", character.TodayCommits), value("%d", character.TodayLinesAdded), value("%s", formatDuration(character.TodaySessionTime))),
+		label("Total")+fmt.Sprintf("%s commits · +%s/-%s · %s quests",
+			value("%d", character.TotalCommits), value("%d", character.TotalLinesAdded),
+			value("%d", character.TotalLinesRemoved), value("%d", character.QuestsCompleted)),
+		label("Team")+fmt.Sprintf("reviews %s/%s · paired %s · well-formed %s",
+			value("%d", character.ReviewsGiven), value("%d", character.ReviewsReceived),
+			value("%d", character.CoAuthoredCommits), value("%d", character.WellFormedCommits)),
+	)
+	if best := compactRecords(character.Records); best != "" {
+		lines = append(lines, label("Best")+best)
+	}
+
+	// Extras only shown once they apply, as in the full layouts
+	if character.Effort > 0 || character.StreakFreezes > 0 || len(character.Items) > 0 {
+		extras := label("Effort") + value("%d", character.Effort) +
+			MutedTextStyle.Render(fmt.Sprintf(" · trophies %d", len(character.Items)))
+		if character.XPBoostActive(time.Now()) {
+			extras += InfoTextStyle.Render(fmt.Sprintf(" · +%d%% XP boost", game.XPBoostPercent))
+		}
+		lines = append(lines, extras)
+	}
+	for _, project := range projects {
+		if projectFilter != "" && project.Name != projectFilter {
+			continue
+		}
+		lines = append(lines, label("📁 "+project.Name)+MutedTextStyle.Render(fmt.Sprintf("%d commits · %d XP · %d active",
+			project.Stats.Commits, project.Stats.XPEarned, project.ActiveQuests)))
+	}
+	energy := MutedTextStyle.Render("not checked in")
+	if checkIn := character.TodayEnergy(); checkIn != nil {
+		energy = value("%d/%d", checkIn.Rating, game.MaxEnergyRating)
+	}
+	lines = append(lines, label("Energy")+energy)
+	if character.Wellness > 0 || len(character.BreakLog) > 0 {
+		lines = append(lines, label("Wellness")+value("%d", character.Wellness)+
+			MutedTextStyle.Render(" · "+character.BreakAdherenceSince(time.Now().AddDate(0, 0, -7)).Summary()))
+	}
+	unlocked := 0
+	tiers := game.AchievementTiers()
+	for _, tier := range tiers {
+		if character.HasAchievement(tier.ID) {
+			unlocked++
+		}
+	}
+	lines = append(lines, label("Achievements")+value("%d/%d", unlocked, len(tiers)))
+
+	// Footer wraps between keybinds rather than cutting one off
+	lines = append(lines, "")
+	lines = append(lines, wrapKeybinds([]string{
+		renderCompactFeatureKeybind(character, game.FeatureSkills, "1-3", "Alloc"),
+		renderCompactFeatureKeybind(character, game.FeatureSkills, "R", "Respec"),
+		renderCompactFeatureKeybind(character, game.FeatureStatDetails, "I", "Info"),
+		renderKeybind("F", "Freeze"),
+		renderKeybind("Esc", "Back"),
+		renderKeybind("?", "Help"),
+	}, width)...)
+
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, width, "…")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// compactRecords summarizes the personal records that are set, in the
+// order the full layout lists them ("" if none are set yet).
+func compactRecords(records game.PersonalRecords) string {
+	var parts []string
+	if records.MostXPInDay > 0 {
+		parts = append(parts, fmt.Sprintf("%d XP/day", records.MostXPInDay))
+	}
+	if records.LongestSession > 0 {
+		parts = append(parts, game.FormatRecordDuration(records.LongestSession)+" session")
+	}
+	if records.BiggestCommit > 0 {
+		parts = append(parts, fmt.Sprintf("%d-line commit", records.BiggestCommit))
+	}
+	if records.FastestQuest > 0 {
+		parts = append(parts, game.FormatRecordDuration(records.FastestQuest)+" quest")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return MutedTextStyle.Render(strings.Join(parts, " · "))
+}
+
+// renderCompactFeatureKeybind is renderFeatureKeybind in short form: a
+// locked feature shows only its key and the level that unlocks it.
+func renderCompactFeatureKeybind(character *game.Character, feature, key, description string) string {
+	if game.IsFeatureUnlocked(feature, character.Level) {
+		return renderKeybind(key, description)
+	}
+	return DimTextStyle.Render(fmt.Sprintf("🔒 %s L%d", key, game.FeatureUnlockLevel(feature)))
+}
+
+// wrapKeybinds lays keybind hints out in as few lines as fit the width.
+func wrapKeybinds(keybinds []string, width int) []string {
+	var lines []string
+	line := ""
+	for _, keybind := range keybinds {
+		switch {
+		case line == "":
+			line = keybind
+		case ansi.StringWidth(line)+2+ansi.StringWidth(keybind) <= width:
+			line += "  " + keybind
+		default:
+			lines = append(lines, line)
+			line = keybind
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
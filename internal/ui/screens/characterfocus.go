@@ -0,0 +1,162 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements keyboard focus on the Character screen: Tab and
+// Shift+Tab move between the Identity, XP, Stats, Streaks and Lifetime
+// sections, the focused one is marked with a bar and Enter expands it with
+// extra detail. The compact layout has no sections to focus.
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// CharacterSection is a section of the character sheet that can take focus.
+type CharacterSection int
+
+const (
+	CharacterSectionNone     CharacterSection = iota // Nothing focused
+	CharacterSectionIdentity                         // Name, level and profile
+	CharacterSectionXP                               // Experience toward the next level
+	CharacterSectionStats                            // CodePower, Wisdom and Agility
+	CharacterSectionStreaks                          // Commit streak and freezes
+	CharacterSectionLifetime                         // Lifetime statistics
+)
+
+// CharacterSections lists the focusable sections in Tab order.
+var CharacterSections = []CharacterSection{
+	CharacterSectionIdentity,
+	CharacterSectionXP,
+	CharacterSectionStats,
+	CharacterSectionStreaks,
+	CharacterSectionLifetime,
+}
+
+// CharacterFocus is the character sheet's keyboard focus.
+type CharacterFocus struct {
+	Section  CharacterSection // Focused section (CharacterSectionNone when nothing is)
+	Expanded bool             // Whether the focused section shows its extra detail
+}
+
+// Move returns the focus moved along CharacterSections, wrapping around at
+// either end. Nothing focused moves to the first section (or the last, going
+// back). The new section starts collapsed.
+//
+// Parameters:
+//   - step: 1 for the next section (Tab), -1 for the previous one (Shift+Tab)
+//
+// Returns:
+//   - CharacterFocus: The moved focus
+func (f CharacterFocus) Move(step int) CharacterFocus {
+	count := len(CharacterSections)
+	index := slices.Index(CharacterSections, f.Section)
+	switch {
+	case index < 0 && step < 0:
+		index = count - 1
+	case index < 0:
+		index = 0
+	default:
+		index = ((index+step)%count + count) % count
+	}
+	return CharacterFocus{Section: CharacterSections[index]}
+}
+
+// renderFocusable marks a section when the sheet has focus: the focused
+// section gets a bar down its left side (and its extra detail when
+// expanded), the others an equal indent so nothing shifts as focus moves.
+// Without focus the section is unchanged.
+func renderFocusable(content string, section CharacterSection, character *game.Character, focus CharacterFocus) string {
+	if focus.Section == CharacterSectionNone {
+		return content
+	}
+	if focus.Section != section {
+		return lipgloss.NewStyle().PaddingLeft(2).Render(content)
+	}
+
+	hint := DimTextStyle.Render("Enter for more")
+	if focus.Expanded {
+		hint = lipgloss.JoinVertical(lipgloss.Left, renderSectionDetail(character, section)...)
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder(), false, false, false, true).
+		BorderForeground(ColorPrimary).
+		PaddingLeft(1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, content, "", hint))
+}
+
+// renderSectionDetail returns the extra lines an expanded section shows.
+func renderSectionDetail(character *game.Character, section CharacterSection) []string {
+	row := func(label, value string) string {
+		return MutedTextStyle.Render("  "+label+": ") + StatValueStyle.Render(value)
+	}
+	orNone := func(items []string) string {
+		if len(items) == 0 {
+			return "none yet"
+		}
+		return strings.Join(items, ", ")
+	}
+
+	switch section {
+	case CharacterSectionIdentity:
+		return []string{
+			row("Titles", orNone(character.Titles)),
+			row("Cosmetics", orNone(character.Cosmetics)),
+			row("Joined", character.CreatedAt.Format("2006-01-02")),
+		}
+	case CharacterSectionXP:
+		rank := "highest rank reached"
+		if next, ok := game.NextRank(character.Level); ok {
+			rank = fmt.Sprintf("%.0f%% toward %s (Lvl %d)", character.RankProgress()*100, next.Name, next.MinLevel)
+		}
+		return []string{
+			row("Total XP earned", fmt.Sprintf("%d", character.TotalXP())),
+			row("Season XP", fmt.Sprintf("%d", character.SeasonXP)),
+			row("Commit XP today", fmt.Sprintf("%d", character.TodayCommitXP)),
+			row("Rested XP", fmt.Sprintf("%d", character.RestBonusXP)),
+			row("Rank", fmt.Sprintf("%s, %s", character.Rank().Name, rank)),
+		}
+	case CharacterSectionStats:
+		respecs := 0
+		for _, entry := range character.StatAllocations {
+			if entry.Kind == game.StatRespec {
+				respecs++
+			}
+		}
+		return []string{
+			row("Allocated points", fmt.Sprintf("%d", character.AllocatedStatPoints())),
+			row("Unspent points", fmt.Sprintf("%d", character.SkillPoints)),
+			row("Respecs", fmt.Sprintf("%d", respecs)),
+			row("Wellness", fmt.Sprintf("%d", character.Wellness)),
+		}
+	case CharacterSectionStreaks:
+		lines := []string{
+			row("Quest streak", fmt.Sprintf("%d days (best %d)", character.QuestStreak, character.LongestQuestStreak)),
+			row("Weekly crowns", fmt.Sprintf("%d 👑", character.Crowns)),
+			row("Freezes", fmt.Sprintf("%d/%d 🧊", character.StreakFreezes, game.MaxStreakFreezes)),
+		}
+		if character.LostStreak > 0 {
+			lines = append(lines, row("Lost streak", fmt.Sprintf("%d days (redeemable)", character.LostStreak)))
+		}
+		return lines
+	case CharacterSectionLifetime:
+		var languages []string
+		for _, language := range character.TopLanguages(3) {
+			languages = append(languages, fmt.Sprintf("%s %.0f%%", language.Name, language.Share*100))
+		}
+		average := 0
+		if character.TotalCommits > 0 {
+			average = (character.TotalLinesAdded + character.TotalLinesRemoved) / character.TotalCommits
+		}
+		return []string{
+			row("Lines per commit", fmt.Sprintf("%d", average)),
+			row("Dependency bumps", fmt.Sprintf("%d", character.DependencyBumps)),
+			row("Open source", fmt.Sprintf("%d commits, %d projects", character.OpenSourceCommits, len(character.OpenSourceProjects))),
+			row("Top languages", orNone(languages)),
+		}
+	}
+	return nil
+}
@@ -121,105 +121,55 @@ var (
 
 	ProgressBarEmptyStyle = lipgloss.NewStyle().
 				Foreground(ColorDim)
+
+	QuestProgressHighlightStyle = lipgloss.NewStyle().
+					Foreground(ColorXP).
+					Bold(true)
 )
 
+// QuestProgressTick is the live animation state of the active quest card.
+// The bar shows Displayed instead of the quest's real progress while it
+// catches up, and is highlighted briefly after it moves.
+type QuestProgressTick struct {
+	QuestID   string // Quest being animated
+	Displayed int    // Progress value currently drawn
+	Highlight bool   // Whether to flash the bar
+}
+
 // RenderDashboard renders the main dashboard screen.
-// This is the primary screen players see, showing character overview, active quest, and quick actions.
+// This is the primary screen players see: the enabled widgets in a grid,
+// followed by the session timer and quick actions.
 //
-// The dashboard layout is responsive and adapts to terminal dimensions:
-//   - Wide terminals (>100 cols): Side-by-side panels with timer
-//   - Narrow terminals (≤100 cols): Stacked vertical layout
+// The grid is responsive: the enabled widgets fill one column on narrow
+// terminals (≤100 cols), two on wide ones and three above 160 cols, in
+// the configured order.
 //
 // Parameters:
-//   - character: Player character data (nil-safe)
-//   - quests: All quests (used to find active quest)
+//   - data: Character, quests and other state the widgets render from
+//   - widgets: Enabled widget IDs in display order (see config.DashboardWidgetIDs)
 //   - width: Terminal width in characters
 //   - height: Terminal height in characters
 //
 // Returns:
 //   - string: Rendered dashboard UI
-//
-// Note: The timer display is currently a placeholder showing character's TodaySessionTime.
-// Full timer state management will be added by Subagent 32.
-func RenderDashboard(character *game.Character, quests []*game.Quest, width, height int) string {
+func RenderDashboard(data DashboardData, widgets []string, width, height int) string {
 	// Handle nil character gracefully
-	if character == nil {
+	if data.Character == nil {
 		return renderNoCharacter(width, height)
 	}
 
-	// Determine layout based on terminal width
-	useWideLayout := width > 100
-
-	if useWideLayout {
-		return renderDashboardWide(character, quests, width, height)
-	}
-	return renderDashboardNarrow(character, quests, width, height)
-}
-
-// renderDashboardWide renders dashboard with side-by-side panels for wide terminals.
-func renderDashboardWide(character *game.Character, quests []*game.Quest, width, height int) string {
-	// Split width into two columns (60% left, 40% right)
-	leftWidth := int(float64(width) * 0.58)
-	rightWidth := width - leftWidth - 2 // Account for spacing
-
-	// Render left panel: Character overview and stats
-	leftPanel := renderCharacterPanel(character, leftWidth)
-
-	// Render right panel: Active quest, today's stats, and timer
-	activeQuest := findActiveQuest(quests)
-	rightPanel := renderActivityPanel(character, activeQuest, rightWidth)
-
-	// Join panels horizontally
-	panels := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		leftPanel,
-		rightPanel,
-	)
-
-	// Render timer section (inline display)
-	timerSection := renderTimerSection(character)
-
-	// Render quick actions menu at bottom
+	grid := renderWidgetGrid(data, widgets, width)
+	timerSection := renderTimerSection(data.Character)
 	quickActions := renderQuickActions(width)
 
-	// Join vertically with spacing
-	dashboard := lipgloss.JoinVertical(
+	return lipgloss.JoinVertical(
 		lipgloss.Left,
-		panels,
+		grid,
 		"",
 		timerSection,
 		"",
 		quickActions,
 	)
-
-	return dashboard
-}
-
-// renderDashboardNarrow renders dashboard with stacked panels for narrow terminals.
-func renderDashboardNarrow(character *game.Character, quests []*game.Quest, width, height int) string {
-	// Full width for each panel
-	panelWidth := width
-
-	// Render panels vertically
-	charPanel := renderCharacterPanel(character, panelWidth)
-	activeQuest := findActiveQuest(quests)
-	activityPanel := renderActivityPanel(character, activeQuest, panelWidth)
-	timerSection := renderTimerSection(character)
-	quickActions := renderQuickActions(width)
-
-	// Stack all panels
-	dashboard := lipgloss.JoinVertical(
-		lipgloss.Left,
-		charPanel,
-		"",
-		activityPanel,
-		"",
-		timerSection,
-		"",
-		quickActions,
-	)
-
-	return dashboard
 }
 
 // renderCharacterPanel renders the character overview panel.
@@ -233,13 +183,9 @@ func renderCharacterPanel(character *game.Character, width int) string {
 	nameValue := BoldTextStyle.Render(character.Name)
 	name := nameLabel + nameValue
 
-	// Level display
+	// Level display with the rank held at it
 	levelLabel := StatLabelStyle.Render("Level: ")
-	levelValue := lipgloss.NewStyle().
-		Foreground(ColorLevel).
-		Bold(true).
-		Render(fmt.Sprintf("%d", character.Level))
-	level := levelLabel + levelValue
+	level := levelLabel + renderLevelWithRank(character)
 
 	// XP progress bar
 	xpLabel := StatLabelStyle.Render("XP: ")
@@ -304,6 +250,7 @@ func renderCharacterPanel(character *game.Character, width int) string {
 		name,
 		level,
 		xp,
+		renderRankProgress(character, width-45),
 		"",
 		statsTitle,
 		codePower,
@@ -323,98 +270,94 @@ func renderCharacterPanel(character *game.Character, width int) string {
 	return BoxStyle.Width(width - 4).Render(content)
 }
 
-// renderActivityPanel renders the activity panel showing active quest and today's stats.
-func renderActivityPanel(character *game.Character, activeQuest *game.Quest, width int) string {
-	// Active quest section
-	var questSection string
-	if activeQuest != nil {
-		questSection = renderActiveQuestCard(activeQuest, width)
-	} else {
-		questSection = renderNoActiveQuest(width)
+// renderActiveQuests renders every active quest as a compact row with a
+// mini progress bar. The selected row has a cursor (Enter opens its detail
+// view) and the quest closest to completion is starred. While a progress
+// tick is animating a quest, its bar shows the animated value and is
+// highlighted.
+func renderActiveQuests(quests []*game.Quest, selected int, progressTick *QuestProgressTick, width int) string {
+	title := renderTitle(fmt.Sprintf("Active Quests (%d)", len(quests)), "📋")
+	selected = max(min(selected, len(quests)-1), 0)
+	closest := closestToCompletion(quests)
+
+	// Only a window of rows fits; scroll it to keep the selection visible
+	first := max(selected-maxActiveQuestRows+1, 0)
+	last := min(first+maxActiveQuestRows, len(quests))
+
+	innerWidth := width - 8 // Box border and padding
+	titleWidth := min(activeQuestTitleWidth, innerWidth/2)
+	barWidth := max(innerWidth-titleWidth-20, 5) // Cursor, star and " n/m (p%)"
+
+	rows := []string{title, ""}
+	if first > 0 {
+		rows = append(rows, MutedTextStyle.Render(fmt.Sprintf("  ↑ %d more", first)))
 	}
+	for i := first; i < last; i++ {
+		quest := quests[i]
 
-	// Today's activity section
-	todaySection := renderTodayActivity(character, width)
-
-	// Combine sections
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		questSection,
-		"",
-		todaySection,
-	)
-
-	return content
-}
-
-// renderActiveQuestCard renders the active quest card with progress.
-func renderActiveQuestCard(quest *game.Quest, width int) string {
-	title := renderTitle("Active Quest", "📋")
+		cursor, titleStyle := "  ", TextStyle
+		if i == selected {
+			cursor, titleStyle = "▸ ", BoldTextStyle.Foreground(ColorAccent)
+		}
+		star := "  "
+		if i == closest && len(quests) > 1 {
+			star = lipgloss.NewStyle().Foreground(ColorXP).Render("★ ")
+		}
 
-	// Quest title with type badge
-	questTitle := BoldTextStyle.Render(quest.Title)
-	typeBadge := renderQuestTypeBadge(quest.Type)
-	header := questTitle + " " + typeBadge
+		current, barType := quest.Current, "quest"
+		if progressTick != nil && progressTick.QuestID == quest.ID {
+			current = progressTick.Displayed
+			if progressTick.Highlight {
+				barType = "quest_highlight"
+			}
+		}
 
-	// Quest description (truncate if too long)
-	description := quest.Description
-	maxDescLen := width - 10
-	if len(description) > maxDescLen {
-		description = description[:maxDescLen-3] + "..."
+		name := titleStyle.Width(titleWidth).Render(truncateRunes(quest.Title, titleWidth-1))
+		rows = append(rows, cursor+star+name+renderProgressBar(current, quest.Target, barWidth, barType))
 	}
-	desc := TextStyle.Render(description)
-
-	// Progress bar
-	progressLabel := StatLabelStyle.Render("Progress: ")
-	progressBar := renderProgressBar(
-		quest.Current,
-		quest.Target,
-		width-30, // Account for label
-		"quest",
-	)
-	progress := progressLabel + progressBar
-
-	// Time tracking
-	timeLabel := StatLabelStyle.Render("Started: ")
-	var timeValue string
-	if quest.StartedAt != nil {
-		elapsed := time.Since(*quest.StartedAt)
-		timeValue = MutedTextStyle.Render(formatDuration(elapsed) + " ago")
-	} else {
-		timeValue = MutedTextStyle.Render("Unknown")
+	if last < len(quests) {
+		rows = append(rows, MutedTextStyle.Render(fmt.Sprintf("  ↓ %d more", len(quests)-last)))
 	}
-	timeInfo := timeLabel + timeValue
 
-	// XP reward
-	rewardLabel := StatLabelStyle.Render("Reward: ")
-	rewardValue := lipgloss.NewStyle().
-		Foreground(ColorXP).
-		Bold(true).
-		Render(fmt.Sprintf("%d XP ⭐", quest.XPReward))
-	reward := rewardLabel + rewardValue
+	if len(quests) > 1 {
+		quest := quests[closest]
+		rows = append(rows, "", MutedTextStyle.Render(fmt.Sprintf("★ Closest: %s (%d to go)", quest.Title, max(quest.Target-quest.Current, 0))))
+	}
+	rows = append(rows, "", renderKeybind("↑/↓", "Select")+"  "+renderKeybind("Enter", "Details"))
 
-	// Assemble card
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		header,
-		desc,
-		"",
-		progress,
-		timeInfo,
-		reward,
-	)
+	return BoxStyleFocused.Width(width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// closestToCompletion returns the index of the quest with the largest share
+// of its target done (the first one on a tie), or -1 for no quests.
+func closestToCompletion(quests []*game.Quest) int {
+	closest, best := -1, -1.0
+	for i, quest := range quests {
+		share := float64(quest.Current) / float64(max(quest.Target, 1))
+		if share > best {
+			closest, best = i, share
+		}
+	}
+	return closest
+}
 
-	// Wrap in focused box to emphasize
-	return BoxStyleFocused.Width(width - 4).Render(content)
+// truncateRunes shortens text to at most limit runes, ending in "…" when cut.
+func truncateRunes(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	if limit < 1 {
+		return ""
+	}
+	return string(runes[:limit-1]) + "…"
 }
 
 // renderNoActiveQuest renders a message when no quest is active.
 func renderNoActiveQuest(width int) string {
-	title := renderTitle("Active Quest", "📋")
+	title := renderTitle("Active Quests", "📋")
 
-	message := MutedTextStyle.Render("No active quest")
+	message := MutedTextStyle.Render("No active quests")
 	hint := InfoTextStyle.Render("Press [Q] to browse the Quest Board and start a new quest!")
 
 	content := lipgloss.JoinVertical(
@@ -430,7 +373,7 @@ func renderNoActiveQuest(width int) string {
 }
 
 // renderTodayActivity renders today's activity statistics.
-func renderTodayActivity(character *game.Character, width int) string {
+func renderTodayActivity(character *game.Character, budget game.XPBudget, width int) string {
 	title := renderTitle("Today's Activity", "📊")
 
 	// Commits today
@@ -448,6 +391,9 @@ func renderTodayActivity(character *game.Character, width int) string {
 	sessionValue := StatValueStyle.Render(formatDuration(character.TodaySessionTime))
 	session := sessionLabel + sessionValue
 
+	// Remaining bonus XP budget (only shown when a daily cap is configured)
+	budgetLine := renderXPBudget(character, budget)
+
 	// Motivational message based on activity
 	var motivation string
 	if character.TodayCommits == 0 {
@@ -461,20 +407,37 @@ func renderTodayActivity(character *game.Character, width int) string {
 	}
 
 	// Assemble content
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		commits,
-		lines,
-		session,
-		"",
-		motivation,
-	)
+	rows := []string{title, "", commits, lines, session}
+	if budgetLine != "" {
+		rows = append(rows, budgetLine)
+	}
+	rows = append(rows, "", motivation)
+	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
 
 	return BoxStyle.Width(width - 4).Render(content)
 }
 
+// renderXPBudget renders the remaining daily XP budget and any rested bonus.
+// Returns an empty string when no daily cap is configured.
+func renderXPBudget(character *game.Character, budget game.XPBudget) string {
+	remaining, rested := character.XPBudgetRemaining(budget, time.Now())
+	if remaining < 0 {
+		return ""
+	}
+
+	label := StatLabelStyle.Render("Bonus XP Budget: ")
+	value := StatValueStyle.Render(fmt.Sprintf("%d/%d left", remaining, budget.DailyCap))
+	if remaining == 0 {
+		value = WarningTextStyle.Render("spent - take a break!")
+	}
+
+	line := label + value
+	if rested > 0 {
+		line += "  " + InfoTextStyle.Render(fmt.Sprintf("😴 +%d rested", rested))
+	}
+	return line
+}
+
 // renderTimerSection renders the session timer display (inline to avoid import cycle).
 // This shows the current coding session time.
 //
@@ -536,6 +499,7 @@ func renderQuickActions(width int) string {
 	charKey := renderKeybind("C", "Character Sheet")
 	mentorKey := renderKeybind("M", "AI Mentor")
 	settingsKey := renderKeybind("S", "Settings")
+	seasonKey := renderKeybind("R", "Season Pass")
 
 	// Save and quit keys
 	saveKey := renderKeybind("Ctrl+S", "Save")
@@ -555,6 +519,8 @@ func renderQuickActions(width int) string {
 
 	row2 := lipgloss.JoinHorizontal(
 		lipgloss.Left,
+		seasonKey,
+		"  ",
 		saveKey,
 		"  ",
 		quitKey,
@@ -612,6 +578,16 @@ func renderKeybind(key, description string) string {
 	return KeybindStyle.Render("["+key+"]") + " " + KeybindDescStyle.Render(description)
 }
 
+// renderFeatureKeybind formats a keybind hint for a level-gated feature
+// (see game.IsFeatureUnlocked). Until the character unlocks it, the hint is
+// dimmed and says when it opens up (e.g., "🔒 Calendar: Unlocks at level 3").
+func renderFeatureKeybind(character *game.Character, feature, key, description string) string {
+	if character == nil || game.IsFeatureUnlocked(feature, character.Level) {
+		return renderKeybind(key, description)
+	}
+	return DimTextStyle.Render("🔒 " + description + ": " + game.FeatureLockedText(feature))
+}
+
 // renderProgressBar creates a progress bar with percentage
 func renderProgressBar(current, total, width int, barType string) string {
 	if total == 0 {
@@ -641,6 +617,16 @@ func renderProgressBar(current, total, width int, barType string) string {
 		emptyStyle = ProgressBarEmptyStyle
 		fillChar = "▰"
 		emptyChar = "▱"
+	case "quest_highlight":
+		filledStyle = QuestProgressHighlightStyle
+		emptyStyle = ProgressBarEmptyStyle
+		fillChar = "▰"
+		emptyChar = "▱"
+	case "rank":
+		filledStyle = RankStyle
+		emptyStyle = ProgressBarEmptyStyle
+		fillChar = "▰"
+		emptyChar = "▱"
 	default:
 		filledStyle = XPBarStyle
 		emptyStyle = ProgressBarEmptyStyle
@@ -653,6 +639,10 @@ func renderProgressBar(current, total, width int, barType string) string {
 
 	bar := filledStyle.Render(filled) + emptyStyle.Render(empty)
 	percentText := fmt.Sprintf(" %d/%d (%.0f%%)", current, total, percentage*100)
+	if percentage >= 1.0 {
+		// Mark a full bar with a check too, not just its color
+		percentText += " ✓"
+	}
 
 	return bar + DimTextStyle.Render(percentText)
 }
@@ -666,6 +656,24 @@ func placeInCenter(width, height int, content string) string {
 // Helper Functions - Business Logic
 // ============================================================================
 
+// ActiveQuests returns the active quests in quest list order, as the
+// dashboard's Active Quests widget lists them.
+//
+// Parameters:
+//   - quests: All quests
+//
+// Returns:
+//   - []*game.Quest: The active quests (empty if none)
+func ActiveQuests(quests []*game.Quest) []*game.Quest {
+	var active []*game.Quest
+	for _, quest := range quests {
+		if quest.Status == game.QuestActive {
+			active = append(active, quest)
+		}
+	}
+	return active
+}
+
 // findActiveQuest finds the first active quest from the quest list.
 func findActiveQuest(quests []*game.Quest) *game.Quest {
 	for _, quest := range quests {
@@ -703,6 +711,42 @@ func renderQuestTypeBadge(questType game.QuestType) string {
 	case game.QuestTypeStreak:
 		badge = "STREAK"
 		color = ColorMagic
+	case game.QuestTypeDocs:
+		badge = "DOCS"
+		color = ColorInfo
+	case game.QuestTypeReview:
+		badge = "REVIEW"
+		color = ColorPrimary
+	case game.QuestTypeDeps:
+		badge = "DEPS"
+		color = ColorWarning
+	case game.QuestTypeMonthly:
+		badge = "MONTHLY"
+		color = ColorXP
+	case game.QuestTypeTodo:
+		badge = "TODO"
+		color = ColorAccent
+	case game.QuestTypeTask:
+		badge = "TASK"
+		color = ColorAccent
+	case game.QuestTypeWIP:
+		badge = "WIP"
+		color = ColorWarning
+	case game.QuestTypePeerReview:
+		badge = "PEER REVIEW"
+		color = ColorPrimary
+	case game.QuestTypePairing:
+		badge = "PAIRING"
+		color = ColorMagic
+	case game.QuestTypeWellFormed:
+		badge = "WELL-FORMED"
+		color = ColorInfo
+	case game.QuestTypeQuestStreak:
+		badge = "QUEST STREAK"
+		color = ColorMagic
+	case game.QuestTypeOpenSource:
+		badge = "OPEN SOURCE"
+		color = ColorSuccess
 	default:
 		badge = "QUEST"
 		color = ColorDim
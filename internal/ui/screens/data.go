@@ -0,0 +1,135 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Data screen, an advanced view for
+// troubleshooting storage (shown in debug mode): every CodeQuest key in the
+// storage backend with its size and last change, the selected key's value
+// as pretty-printed JSON, and a confirmation before a key is deleted.
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/storage"
+)
+
+// DataView is what the Data screen shows.
+type DataView struct {
+	Keys     []storage.StoredKey // Keys in the backend (nil while listing)
+	Selected int                 // Index of the selected key
+	OpenKey  string              // Key whose value is shown ("" for the key list)
+	Value    string              // The open key's value, pretty-printed
+	Scroll   int                 // First value line shown
+	Confirm  bool                // Deleting the selected key awaits a second press
+	Err      string              // Why the last listing, read or delete failed
+}
+
+// RenderData renders the Data screen: the key list, or the open key's
+// value.
+//
+// Parameters:
+//   - view: Keys, selection and the open value
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The rendered screen
+func RenderData(view DataView, width, height int) string {
+	heading := TitleStyle.Render("🗄️  Stored Data")
+	lines := []string{heading, ""}
+
+	var footer string
+	if view.OpenKey != "" {
+		lines = append(lines, renderDataValue(view, width, height-10)...)
+		footer = lipgloss.JoinHorizontal(lipgloss.Left,
+			renderKeybind("↑/↓", "Scroll"), "  ",
+			renderKeybind("Esc", "Back to keys"),
+		)
+	} else {
+		lines = append(lines, renderDataKeys(view, width)...)
+		footer = lipgloss.JoinHorizontal(lipgloss.Left,
+			renderKeybind("↑/↓", "Select"), "  ",
+			renderKeybind("Enter", "View"), "  ",
+			renderKeybind("X", "Delete"), "  ",
+			renderKeybind("R", "Refresh"), "  ",
+			renderKeybind("Esc", "Back"),
+		)
+	}
+
+	if view.Err != "" {
+		lines = append(lines, "", ErrorTextStyle.Render("⚠️  "+view.Err))
+	}
+	lines = append(lines, "", footer)
+	return lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// renderDataKeys renders the key list with sizes and last changes, and the
+// delete confirmation for the selected key.
+func renderDataKeys(view DataView, width int) []string {
+	switch {
+	case view.Keys == nil && view.Err == "":
+		return []string{DimTextStyle.Render("Listing keys...")}
+	case len(view.Keys) == 0:
+		return []string{DimTextStyle.Render("No CodeQuest keys in storage")}
+	}
+
+	keyWidth := 0
+	for _, entry := range view.Keys {
+		keyWidth = max(keyWidth, len(entry.Key))
+	}
+	keyWidth = min(keyWidth, max(width-50, 20))
+
+	total := 0
+	lines := make([]string, 0, len(view.Keys)+4)
+	for i, entry := range view.Keys {
+		total += entry.Bytes
+		indicator := "  "
+		key := StatValueStyle.Render(fmt.Sprintf("%-*s", keyWidth, truncateRunes(entry.Key, keyWidth)))
+		if i == view.Selected {
+			indicator = KeybindStyle.Render("▶ ")
+			key = BoldTextStyle.Render(fmt.Sprintf("%-*s", keyWidth, truncateRunes(entry.Key, keyWidth)))
+		}
+
+		modified := "—"
+		if !entry.Modified.IsZero() {
+			modified = entry.Modified.Format("2006-01-02 15:04")
+		}
+		row := indicator + key + "  " +
+			InfoTextStyle.Render(fmt.Sprintf("%9s", formatBytes(entry.Bytes))) + "  " +
+			MutedTextStyle.Render(fmt.Sprintf("%-16s", modified))
+		if entry.Label != "" {
+			row += "  " + DimTextStyle.Render(entry.Label)
+		}
+		lines = append(lines, row)
+	}
+
+	lines = append(lines, "", StatLabelStyle.Render("Total: ")+
+		StatValueStyle.Render(fmt.Sprintf("%s in %d keys", formatBytes(total), len(view.Keys))))
+	if view.Confirm && view.Selected < len(view.Keys) {
+		lines = append(lines, "", WarningTextStyle.Render(fmt.Sprintf(
+			"Delete %s? Press X again to confirm (data CodeQuest has loaded is written back on its next save)",
+			view.Keys[view.Selected].Key)))
+	}
+	return lines
+}
+
+// renderDataValue renders the open key's value, scrolled to fit the height
+// with long lines cut at the width.
+func renderDataValue(view DataView, width, height int) []string {
+	valueLines := strings.Split(view.Value, "\n")
+	height = max(height, 5)
+	first := min(max(view.Scroll, 0), max(len(valueLines)-height, 0))
+	last := min(first+height, len(valueLines))
+
+	lines := []string{
+		BoldTextStyle.Render(view.OpenKey) + MutedTextStyle.Render(
+			fmt.Sprintf("  lines %d-%d of %d", first+1, last, len(valueLines))),
+		"",
+	}
+	for _, line := range valueLines[first:last] {
+		lines = append(lines, "  "+ansi.Truncate(line, max(width-8, 20), "…"))
+	}
+	return lines
+}
@@ -0,0 +1,61 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the focus mode dashboard: a calm card with the time
+// left and the quest being worked on, shown instead of the XP widgets while
+// the player is in deep work.
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// FocusData is what the focus mode dashboard shows.
+type FocusData struct {
+	Remaining time.Duration // Time left in the focus session
+	EndsAt    time.Time     // When the focus session ends
+	Quests    []*game.Quest // Quest log (the active quest's title is shown)
+}
+
+// RenderFocusMode renders the dashboard while focus mode is on. It leaves
+// out XP, levels and activity so nothing competes for attention.
+//
+// Parameters:
+//   - data: Time left and the quest log
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The centered focus card
+func RenderFocusMode(data FocusData, width, height int) string {
+	heading := lipgloss.NewStyle().Foreground(ColorMagic).Bold(true).Render("🎯 Focus Mode")
+	remaining := lipgloss.NewStyle().Foreground(ColorPrimary).Bold(true).
+		Render(formatFocusRemaining(data.Remaining) + " left")
+	endsAt := MutedTextStyle.Render("Until " + data.EndsAt.Format("15:04"))
+
+	lines := []string{heading, "", remaining, endsAt}
+	if quest := findActiveQuest(data.Quests); quest != nil {
+		lines = append(lines, "", TextStyle.Render("Working on: "+quest.Title))
+	}
+	lines = append(lines, "",
+		MutedTextStyle.Render("Notifications are held until focus ends"),
+		MutedTextStyle.Render("Alt+F to end focus early"))
+
+	card := BoxStyle.BorderForeground(ColorMagic).Padding(1, 4).Render(
+		lipgloss.JoinVertical(lipgloss.Center, lines...))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
+}
+
+// formatFocusRemaining formats time left as "1h 05m" or "24m", rounding up
+// so the last minute shows as "1m" rather than "0m".
+func formatFocusRemaining(d time.Duration) string {
+	minutes := int((max(d, 0) + time.Minute - 1) / time.Minute)
+	if minutes >= 60 {
+		return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
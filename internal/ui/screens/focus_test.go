@@ -0,0 +1,44 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRenderFocusMode tests the focus card's time left and active quest.
+func TestRenderFocusMode(t *testing.T) {
+	quest := game.NewQuest("Refactor Parser", "", game.QuestTypeCommit, 5, 100, 1)
+	quest.Status = game.QuestActive
+
+	tests := []struct {
+		name      string
+		remaining time.Duration
+		quests    []*game.Quest
+		want      []string
+	}{
+		{"minutes left", 24*time.Minute + 10*time.Second, nil, []string{"Focus Mode", "25m left"}},
+		{"over an hour", 65 * time.Minute, nil, []string{"1h 05m left"}},
+		{"active quest", 10 * time.Minute, []*game.Quest{quest}, []string{"Working on: Refactor Parser"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderFocusMode(FocusData{
+				Remaining: tt.remaining,
+				EndsAt:    time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC),
+				Quests:    tt.quests,
+			}, 100, 40)
+			for _, want := range append(tt.want, "Until 10:30", "Alt+F") {
+				if !strings.Contains(got, want) {
+					t.Errorf("RenderFocusMode() missing %q", want)
+				}
+			}
+			if strings.Contains(got, "XP") {
+				t.Error("RenderFocusMode() should not show XP")
+			}
+		})
+	}
+}
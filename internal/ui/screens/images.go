@@ -0,0 +1,159 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file draws the character avatar and achievement badges as inline
+// images in terminals that show them (see the termimage package), falling
+// back to the avatar emoji and a check mark everywhere else.
+package screens
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"sync"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/termimage"
+)
+
+const (
+	// imageSize is the avatar and badge size in pixels; terminals that scale
+	// images fit it to two cells, and it fits two cells as is for sixel
+	imageSize = 16
+
+	// imageCells is how many cells wide an inline image is (one row high)
+	imageCells = 2
+
+	// avatarImageID and badgeImageID start the kitty image ID ranges
+	avatarImageID = 0x100000
+	badgeImageID  = 0x200000
+)
+
+// badgeColors color badges by tier: bronze, silver, gold.
+var badgeColors = []color.RGBA{
+	{R: 0xCD, G: 0x7F, B: 0x32, A: 0xFF},
+	{R: 0xC0, G: 0xC8, B: 0xD0, A: 0xFF},
+	{R: 0xFF, G: 0xD7, B: 0x00, A: 0xFF},
+}
+
+var (
+	// imageProtocol is the protocol set with SetImageProtocol
+	imageProtocol = termimage.ProtocolNone
+
+	// inlineImages caches encoded images by protocol, kind and key, since
+	// screens are redrawn many times a second
+	inlineImages   = make(map[string]string)
+	inlineImagesMu sync.Mutex
+)
+
+// SetImageProtocol sets how avatars and badges are drawn. Call it before
+// rendering starts.
+//
+// Parameters:
+//   - protocol: Inline image protocol (ProtocolNone draws text)
+func SetImageProtocol(protocol termimage.Protocol) {
+	imageProtocol = protocol
+}
+
+// renderAvatar draws the character's avatar: an image generated from the
+// avatar seed where the terminal shows images, otherwise the avatar emoji.
+// Both are two cells wide.
+func renderAvatar(character *game.Character) string {
+	if imageProtocol == termimage.ProtocolNone {
+		return character.Avatar()
+	}
+	hash := character.AvatarHash()
+	return inlineImage(fmt.Sprintf("avatar/%d", hash), avatarImageID|hash&0xFFFFF, func() image.Image {
+		return avatarImage(hash)
+	})
+}
+
+// renderBadge draws an unlocked achievement tier: a medal colored by tier
+// where the terminal shows images, otherwise a check mark.
+//
+// Parameters:
+//   - tier: Tier index within its track (0 = first)
+func renderBadge(tier int) string {
+	if imageProtocol == termimage.ProtocolNone {
+		return SuccessTextStyle.Render("✓")
+	}
+	tier = min(max(tier, 0), len(badgeColors)-1)
+	return inlineImage(fmt.Sprintf("badge/%d", tier), badgeImageID+uint32(tier), func() image.Image {
+		return badgeImage(badgeColors[tier])
+	})
+}
+
+// inlineImage encodes an image for the current protocol, once per key.
+func inlineImage(key string, id uint32, draw func() image.Image) string {
+	key = string(imageProtocol) + "/" + key
+	inlineImagesMu.Lock()
+	defer inlineImagesMu.Unlock()
+	if encoded, ok := inlineImages[key]; ok {
+		return encoded
+	}
+	encoded := termimage.Inline(imageProtocol, draw(), id, imageCells)
+	inlineImages[key] = encoded
+	return encoded
+}
+
+// avatarImage draws a symmetric 5×5 identicon: the hash picks the color
+// and which cells of the left half (mirrored to the right) are filled.
+func avatarImage(hash uint32) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, imageSize, imageSize))
+	fill := hueColor(hash % 360)
+
+	const cell = 3 // Pixels per identicon cell, leaving a 1-pixel margin
+	bits := hash >> 9
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 3; x++ {
+			if bits&(1<<(y*3+x)) == 0 {
+				continue
+			}
+			for _, column := range []int{x, 4 - x} {
+				for dy := 0; dy < cell; dy++ {
+					for dx := 0; dx < cell; dx++ {
+						img.Set(1+column*cell+dx, 1+y*cell+dy, fill)
+					}
+				}
+			}
+		}
+	}
+	return img
+}
+
+// badgeImage draws a medal: a disc with a darker rim.
+func badgeImage(fill color.RGBA) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, imageSize, imageSize))
+	rim := color.RGBA{R: fill.R / 2, G: fill.G / 2, B: fill.B / 2, A: 0xFF}
+
+	center := float64(imageSize-1) / 2
+	for y := 0; y < imageSize; y++ {
+		for x := 0; x < imageSize; x++ {
+			dx, dy := float64(x)-center, float64(y)-center
+			switch distance := dx*dx + dy*dy; {
+			case distance <= (center-2)*(center-2):
+				img.Set(x, y, fill)
+			case distance <= center*center:
+				img.Set(x, y, rim)
+			}
+		}
+	}
+	return img
+}
+
+// hueColor makes a bright color from a hue in degrees.
+func hueColor(hue uint32) color.RGBA {
+	const high, low = 0xE0, 0x40
+	ramp := func(offset uint32) uint8 {
+		h := (hue + offset) % 360
+		switch {
+		case h < 60:
+			return uint8(low + (high-low)*h/60)
+		case h < 180:
+			return high
+		case h < 240:
+			return uint8(high - (high-low)*(h-180)/60)
+		default:
+			return low
+		}
+	}
+	return color.RGBA{R: ramp(120), G: ramp(0), B: ramp(240), A: 0xFF}
+}
@@ -0,0 +1,68 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file tests drawing avatars and badges as images, and the text fallback.
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/termimage"
+)
+
+// TestRenderAvatar tests that the avatar is an image in terminals that draw
+// them and the emoji elsewhere, two cells wide either way.
+func TestRenderAvatar(t *testing.T) {
+	defer SetImageProtocol(termimage.ProtocolNone)
+	character := game.NewCharacter("Tester")
+
+	tests := []struct {
+		protocol termimage.Protocol
+		want     string
+	}{
+		{termimage.ProtocolNone, character.Avatar()},
+		{termimage.ProtocolKitty, "\x1b_G"},
+		{termimage.ProtocolITerm2, "\x1b]1337;File="},
+		{termimage.ProtocolSixel, "\x1bP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.protocol), func(t *testing.T) {
+			SetImageProtocol(tt.protocol)
+			got := renderAvatar(character)
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("renderAvatar() = %q, want it to contain %q", got, tt.want)
+			}
+			if width := ansi.StringWidth(got); width != 2 {
+				t.Errorf("renderAvatar() is %d cells wide, want 2", width)
+			}
+			if tt.protocol != termimage.ProtocolNone && ansi.StringWidth(renderBadge(2)) != 2 {
+				t.Errorf("renderBadge() is %d cells wide, want 2", ansi.StringWidth(renderBadge(2)))
+			}
+		})
+	}
+
+	SetImageProtocol(termimage.ProtocolNone)
+	if got := ansi.Strip(renderBadge(0)); got != "✓" {
+		t.Errorf("renderBadge() without images = %q, want ✓", got)
+	}
+}
+
+// TestAvatarImage tests that identicons are symmetric and differ by seed.
+func TestAvatarImage(t *testing.T) {
+	first, second := avatarImage(0xDEADBEEF), avatarImage(0x12345678)
+	different := false
+	for y := 0; y < imageSize; y++ {
+		for x := 1; x < imageSize; x++ { // Column 0 is margin; x mirrors to imageSize-x
+			if first.At(x, y) != first.At(imageSize-x, y) {
+				t.Fatalf("pixel (%d,%d) isn't mirrored", x, y)
+			}
+			different = different || first.At(x, y) != second.At(x, y)
+		}
+	}
+	if !different {
+		t.Error("different seeds drew the same avatar")
+	}
+}
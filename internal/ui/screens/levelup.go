@@ -0,0 +1,185 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the level-up fanfare: a full-screen celebration with
+// the new level in big digits, falling confetti, before/after stat deltas
+// and the quests the new level unlocked. Reaching a new rank gets its own
+// heading and colors.
+package screens
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Confetti tuning
+const (
+	confettiRows    = 3  // Rows of confetti above and below the card
+	confettiDensity = 9  // Roughly one piece per this many cells
+	maxUnlockedList = 5  // Unlocked quests listed before "and N more"
+	bigDigitHeight  = 5  // Rows in a big digit glyph
+	bigDigitSpacing = 1  // Columns between big digits
+	minFanfareWidth = 40 // Narrower terminals skip the confetti
+)
+
+// bigDigits are 5-row block glyphs for 0-9.
+var bigDigits = [10][bigDigitHeight]string{
+	{"█████", "█   █", "█   █", "█   █", "█████"},
+	{"  █  ", " ██  ", "  █  ", "  █  ", " ███ "},
+	{"█████", "    █", "█████", "█    ", "█████"},
+	{"█████", "    █", " ████", "    █", "█████"},
+	{"█   █", "█   █", "█████", "    █", "    █"},
+	{"█████", "█    ", "█████", "    █", "█████"},
+	{"█████", "█    ", "█████", "█   █", "█████"},
+	{"█████", "    █", "   █ ", "  █  ", "  █  "},
+	{"█████", "█   █", "█████", "█   █", "█████"},
+	{"█████", "█   █", "█████", "    █", "█████"},
+}
+
+// confettiPieces and confettiColors are cycled to scatter confetti.
+var (
+	confettiPieces = []string{"*", "+", "•", "✦", "◆", "~"}
+	confettiColors = []lipgloss.Color{ColorXP, ColorPrimary, ColorAccent, ColorSuccess, ColorMagic, ColorWarning}
+)
+
+// StatDelta is one before/after row on the level-up card.
+type StatDelta struct {
+	Label  string // What changed (e.g. "Stat points")
+	Before int    // Value before the level-up
+	After  int    // Value after the level-up
+}
+
+// LevelUpFanfare is what the level-up celebration shows.
+type LevelUpFanfare struct {
+	OldLevel       int         // Level before the level-up
+	NewLevel       int         // Level reached
+	Deltas         []StatDelta // Before/after values that changed
+	UnlockedQuests []string    // Titles of quests the new level unlocked
+	RankUp         *game.Rank  // Rank reached (nil unless the level-up reached a new rank)
+}
+
+// RenderBigNumber renders a non-negative number in 5-row block digits.
+//
+// Parameters:
+//   - n: The number to render
+//
+// Returns:
+//   - string: The number as a multi-line block of digits
+func RenderBigNumber(n int) string {
+	digits := strconv.Itoa(max(n, 0))
+	rows := make([]string, bigDigitHeight)
+	spacing := strings.Repeat(" ", bigDigitSpacing)
+	for row := range rows {
+		glyphs := make([]string, len(digits))
+		for i, digit := range digits {
+			glyphs[i] = bigDigits[digit-'0'][row]
+		}
+		rows[row] = strings.Join(glyphs, spacing)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// RenderLevelUpFanfare renders the full-screen level-up celebration.
+// The confetti moves with the animation frame; frame 0 is a still picture
+// (used when animations are off).
+//
+// Parameters:
+//   - fanfare: Levels, deltas and unlocks to show
+//   - frame: Animation frame
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The rendered celebration filling the terminal
+func RenderLevelUpFanfare(fanfare LevelUpFanfare, frame, width, height int) string {
+	heading := lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render("⚡ LEVEL UP! ⚡")
+	number := lipgloss.NewStyle().Foreground(ColorLevel).Bold(true).Render(RenderBigNumber(fanfare.NewLevel))
+	subtitle := SubtitleStyle.Render(fmt.Sprintf("Level %d → Level %d", fanfare.OldLevel, fanfare.NewLevel))
+	border := ColorXP
+	if fanfare.RankUp != nil {
+		heading = RankStyle.Render("🎖️ RANK UP! 🎖️")
+		number = RankStyle.Render(RenderBigNumber(fanfare.NewLevel))
+		subtitle = lipgloss.JoinVertical(lipgloss.Center, subtitle,
+			RankStyle.Render("You've reached the rank of "+fanfare.RankUp.String()))
+		border = ColorMagic
+	}
+
+	lines := []string{heading, "", number, "", subtitle}
+	if len(fanfare.Deltas) > 0 {
+		lines = append(lines, "", renderStatDeltas(fanfare.Deltas))
+	}
+	if len(fanfare.UnlockedQuests) > 0 {
+		lines = append(lines, "", renderUnlockedQuests(fanfare.UnlockedQuests))
+	}
+	lines = append(lines, "", MutedTextStyle.Render("Press any key to continue"))
+
+	card := BoxStyle.BorderForeground(border).Padding(1, 4).Render(
+		lipgloss.JoinVertical(lipgloss.Center, lines...))
+
+	if width < minFanfareWidth {
+		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
+	}
+
+	rows := make([]string, 0, 2*confettiRows+1)
+	for row := 0; row < confettiRows; row++ {
+		rows = append(rows, renderConfettiRow(width, row, frame))
+	}
+	rows = append(rows, lipgloss.PlaceHorizontal(width, lipgloss.Center, card))
+	for row := confettiRows; row < 2*confettiRows; row++ {
+		rows = append(rows, renderConfettiRow(width, row, frame))
+	}
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// renderStatDeltas renders before → after rows, highlighting gains.
+func renderStatDeltas(deltas []StatDelta) string {
+	rows := make([]string, 0, len(deltas))
+	for _, delta := range deltas {
+		change := MutedTextStyle.Render("(no change)")
+		if diff := delta.After - delta.Before; diff != 0 {
+			change = SuccessTextStyle.Render(fmt.Sprintf("(%+d)", diff))
+		}
+		rows = append(rows, StatLabelStyle.Render(fmt.Sprintf("%-18s", delta.Label))+
+			MutedTextStyle.Render(fmt.Sprintf("%6d", delta.Before))+
+			TextStyle.Render("  →  ")+
+			StatValueStyle.Render(fmt.Sprintf("%-6d", delta.After))+
+			change)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderUnlockedQuests lists quests the new level unlocked.
+func renderUnlockedQuests(titles []string) string {
+	rows := []string{SubtitleStyle.Render("🔓 New quests unlocked")}
+	for i, title := range titles {
+		if i == maxUnlockedList {
+			rows = append(rows, MutedTextStyle.Render(fmt.Sprintf("   …and %d more", len(titles)-maxUnlockedList)))
+			break
+		}
+		rows = append(rows, TextStyle.Render("   • "+title))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderConfettiRow scatters confetti across one row. Pieces fall one row
+// per frame, so consecutive frames animate downward.
+func renderConfettiRow(width, row, frame int) string {
+	var line strings.Builder
+	for x := 0; x < width; x++ {
+		seed := x*31 + (row-frame)*17
+		if seed < 0 {
+			seed = -seed
+		}
+		if seed%confettiDensity != 0 {
+			line.WriteString(" ")
+			continue
+		}
+		piece := confettiPieces[(x+seed/confettiDensity)%len(confettiPieces)]
+		color := confettiColors[(x/2+seed)%len(confettiColors)]
+		line.WriteString(lipgloss.NewStyle().Foreground(color).Render(piece))
+	}
+	return line.String()
+}
@@ -0,0 +1,73 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRenderBigNumber tests the block digit renderer.
+func TestRenderBigNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		wantWidth int
+	}{
+		{"single digit", 7, 5},
+		{"two digits", 12, 11},
+		{"negative clamps to zero", -3, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderBigNumber(tt.n)
+			rows := strings.Split(got, "\n")
+			if len(rows) != bigDigitHeight {
+				t.Fatalf("RenderBigNumber(%d) has %d rows, want %d", tt.n, len(rows), bigDigitHeight)
+			}
+			if width := lipgloss.Width(got); width != tt.wantWidth {
+				t.Errorf("RenderBigNumber(%d) width = %d, want %d", tt.n, width, tt.wantWidth)
+			}
+		})
+	}
+}
+
+// TestRenderLevelUpFanfare tests the celebration content and that the
+// confetti moves between frames.
+func TestRenderLevelUpFanfare(t *testing.T) {
+	fanfare := LevelUpFanfare{
+		OldLevel:       4,
+		NewLevel:       5,
+		Deltas:         []StatDelta{{Label: "Stat points", Before: 1, After: 4}},
+		UnlockedQuests: []string{"Dependency Hygiene"},
+	}
+
+	first := RenderLevelUpFanfare(fanfare, 0, 100, 40)
+	for _, want := range []string{"LEVEL UP", "Level 4 → Level 5", "Stat points", "(+3)", "Dependency Hygiene", "Press any key"} {
+		if !strings.Contains(first, want) {
+			t.Errorf("fanfare missing %q", want)
+		}
+	}
+	if RenderLevelUpFanfare(fanfare, 1, 100, 40) == first {
+		t.Error("confetti should move between frames")
+	}
+	if lipgloss.Height(first) != 40 {
+		t.Errorf("fanfare height = %d, want to fill 40 rows", lipgloss.Height(first))
+	}
+
+	// Reaching a new rank gets its own heading
+	rank := game.RankForLevel(6)
+	fanfare.OldLevel, fanfare.NewLevel, fanfare.RankUp = 5, 6, &rank
+	ranked := RenderLevelUpFanfare(fanfare, 0, 100, 40)
+	for _, want := range []string{"RANK UP", "Level 5 → Level 6", "rank of 🛠️ Journeyman"} {
+		if !strings.Contains(ranked, want) {
+			t.Errorf("rank-up fanfare missing %q", want)
+		}
+	}
+	if strings.Contains(ranked, "LEVEL UP") {
+		t.Error("rank-up fanfare shouldn't use the level-up heading")
+	}
+}
@@ -0,0 +1,56 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file renders the Loot section of the character sheet: held XP
+// scrolls, how soon the pity timer guarantees a drop and the latest drops.
+package screens
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// lootRecentDrops is how many of the latest drops the character sheet lists
+const lootRecentDrops = 3
+
+// lootIcons shows what each kind of loot is.
+var lootIcons = map[game.LootKind]string{
+	game.LootXPScroll:     "📜",
+	game.LootStreakFreeze: "🧊",
+	game.LootCosmetic:     "✨",
+}
+
+// LootIcon returns the icon for a kind of loot.
+func LootIcon(kind game.LootKind) string {
+	if icon, ok := lootIcons[kind]; ok {
+		return icon
+	}
+	return "🎁"
+}
+
+// renderLootSection renders held XP scrolls, the pity timer and the latest
+// drops, newest first. Returns "" until a commit has rolled for loot.
+func renderLootSection(character *game.Character) string {
+	if character.LootPity == 0 && len(character.LootDrops) == 0 && character.XPScrolls == 0 {
+		return ""
+	}
+
+	title := SubtitleStyle.Render("🎲 Loot")
+	scrolls := "📜 " + StatLabelStyle.Render("XP Scrolls: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d", character.XPScrolls))
+	if character.XPScrolls > 0 {
+		scrolls += MutedTextStyle.Render(fmt.Sprintf(" (U reads one: +%d%% commit XP for %d hours)",
+			game.XPBoostPercent, int(game.XPScrollDuration.Hours())))
+	}
+	pity := "🍀 " + StatLabelStyle.Render("Guaranteed drop within: ") +
+		StatValueStyle.Render(fmt.Sprintf("%d commits", character.CommitsUntilPityDrop()))
+
+	rows := []string{title, "", scrolls, pity}
+	for i := len(character.LootDrops) - 1; i >= 0 && i >= len(character.LootDrops)-lootRecentDrops; i-- {
+		drop := character.LootDrops[i]
+		rows = append(rows, "  "+LootIcon(drop.Kind)+" "+BoldTextStyle.Render(drop.Name)+
+			MutedTextStyle.Render(fmt.Sprintf(" (%s, %s)", drop.Rarity, formatDate(drop.DroppedAt))))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,82 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file contains the shared markdown renderer built on glamour.
+package screens
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// markdownRenderers caches glamour renderers by wrap width.
+// Building a renderer parses a full style sheet, so reusing them keeps
+// re-renders on every View() call cheap.
+var (
+	markdownRenderers   = make(map[int]*glamour.TermRenderer)
+	markdownRenderersMu sync.Mutex
+)
+
+// RenderMarkdown renders markdown for display in the terminal.
+// Falls back to plain word-wrapped text if glamour fails.
+//
+// Parameters:
+//   - content: Markdown source
+//   - width: Maximum line width
+//
+// Returns:
+//   - string: Styled terminal output (without surrounding blank lines)
+func RenderMarkdown(content string, width int) string {
+	if width < 20 {
+		width = 20
+	}
+
+	// Renderers are not safe for concurrent use, so hold the lock while rendering
+	markdownRenderersMu.Lock()
+	defer markdownRenderersMu.Unlock()
+
+	renderer, err := markdownRenderer(width)
+	if err != nil {
+		return wrapText(content, width)
+	}
+
+	rendered, err := renderer.Render(content)
+	if err != nil {
+		return wrapText(content, width)
+	}
+
+	return trimTrailingPadding(strings.Trim(rendered, "\n"))
+}
+
+// trimTrailingPadding removes the space padding glamour adds to fill each
+// line to the wrap width, so rendered blocks size to their content.
+func trimTrailingPadding(rendered string) string {
+	lines := strings.Split(rendered, "\n")
+	for i, line := range lines {
+		visible := strings.TrimRight(ansi.Strip(line), " ")
+		lines[i] = ansi.Truncate(line, ansi.StringWidth(visible), "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownRenderer returns a cached renderer for the given width.
+// The dark style is used explicitly; auto-detection queries the terminal,
+// which interferes with a running Bubble Tea program.
+// Callers must hold markdownRenderersMu.
+func markdownRenderer(width int) (*glamour.TermRenderer, error) {
+	if renderer, ok := markdownRenderers[width]; ok {
+		return renderer, nil
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("dark"),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	markdownRenderers[width] = renderer
+	return renderer, nil
+}
@@ -6,7 +6,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -16,6 +15,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/AutumnsGrove/codequest/internal/ai"
+	"github.com/AutumnsGrove/codequest/internal/config"
 	"github.com/AutumnsGrove/codequest/internal/game"
 )
 
@@ -35,7 +35,7 @@ type AIProviderStatus struct {
 	Fallback  string // Fallback provider if primary unavailable
 }
 
-// MentorScreen represents the AI mentor screen state and components.
+// MentorScreen represents the AI mentor screen state and
 // This struct manages the interactive chat interface with AI providers.
 type MentorScreen struct {
 	aiManager *ai.AIManager   // AI manager for provider fallback
@@ -43,11 +43,37 @@ type MentorScreen struct {
 	input     textinput.Model // Text input component
 	viewport  viewport.Model  // Scrollable message history
 	loading   bool            // True while waiting for AI response
+	running   bool            // True while a snippet runs in the sandbox
+	activity  *Activity       // Spinner shown while loading or running
 	width     int             // Terminal width
 	height    int             // Terminal height
+
+	// Personas and quick prompts from config; persona is stored per thread
+	personas      []config.MentorPersona
+	templates     []config.PromptTemplate
+	persona       string         // Name of the persona used by this thread
+	dropdown      mentorDropdown // Which dropdown is open, if any
+	dropdownIndex int            // Highlighted dropdown entry
+
+	// Question last answered from the answer cache (Ctrl+G asks it anew)
+	cachedQuestion string
+
+	// Time limit for running snippets with Ctrl+E (0 = sandbox default)
+	runTimeout time.Duration
+
+	// Most messages kept in the saved chat history (0 = no limit)
+	historyLimit int
+
+	// Where the chat history is saved (nil = not saved)
+	store ChatStore
+
+	// Render cache - glamour rendering is expensive, so rendered messages are
+	// reused until the width changes (rendered[i] corresponds to messages[i])
+	rendered      []string
+	renderedWidth int
 }
 
-// NewMentorScreen creates a new mentor screen with initialized components.
+// NewMentorScreen creates a new mentor screen with initialized
 func NewMentorScreen(aiManager *ai.AIManager, width, height int) *MentorScreen {
 	// Create text input component
 	ti := textinput.New()
@@ -66,6 +92,7 @@ func NewMentorScreen(aiManager *ai.AIManager, width, height int) *MentorScreen {
 		input:     ti,
 		viewport:  vp,
 		loading:   false,
+		activity:  NewActivity(),
 		width:     width,
 		height:    height,
 	}
@@ -76,13 +103,64 @@ func (m *MentorScreen) SetAIManager(aiManager *ai.AIManager) {
 	m.aiManager = aiManager
 }
 
-// SetSize updates the screen dimensions and resizes components.
+// LastResponse returns the most recent assistant message, if any.
+func (m *MentorScreen) LastResponse() (Message, bool) {
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].Role == "assistant" {
+			return m.messages[i], true
+		}
+	}
+	return Message{}, false
+}
+
+// Messages returns the conversation history, oldest first.
+func (m *MentorScreen) Messages() []Message {
+	return append([]Message(nil), m.messages...)
+}
+
+// ScrollToMessage scrolls the history so a message is at the top (or as
+// near as the end of the history allows).
+//
+// Parameters:
+//   - index: Index into Messages
+func (m *MentorScreen) ScrollToMessage(index int) {
+	rendered := m.renderHistory()
+	if index < 0 || index >= len(rendered) {
+		return
+	}
+
+	offset := 0
+	for _, message := range rendered[:index] {
+		offset += lipgloss.Height(message) + 1 // Spacing line
+	}
+	m.viewport.SetContent(m.historyContent())
+	m.viewport.SetYOffset(offset)
+}
+
+// SetSize updates the screen dimensions and resizes
 func (m *MentorScreen) SetSize(width, height int) {
 	m.width = width
 	m.height = height
 	m.input.Width = width - 10
 	m.viewport.Width = width - 4
 	m.viewport.Height = height - 20
+
+	// Markdown wraps to the width, so re-render everything on resize
+	if width != m.renderedWidth {
+		m.rendered = nil
+	}
+}
+
+// SetActivity shares the app's activity indicator, so the mentor's
+// thinking spinner animates with the rest of the app's async feedback.
+func (m *MentorScreen) SetActivity(activity *Activity) {
+	m.activity = activity
+}
+
+// startThinking marks the mentor as waiting for an answer.
+func (m *MentorScreen) startThinking() tea.Cmd {
+	m.loading = true
+	return m.activity.Start(ActivityThinking, "Thinking...")
 }
 
 // Update handles Bubble Tea messages for the mentor screen.
@@ -91,9 +169,27 @@ func (m *MentorScreen) Update(msg tea.Msg) (*MentorScreen, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		// Don't handle input if loading
-		if m.loading {
+		// Don't handle input while waiting on the AI or a snippet
+		if m.loading || m.running {
+			return m, nil
+		}
+
+		// An open dropdown captures navigation keys
+		if m.dropdown != dropdownNone {
+			return m.handleDropdownKey(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+o":
+			m.openDropdown(dropdownPersona)
+			return m, nil
+		case "ctrl+r":
+			m.openDropdown(dropdownTemplate)
 			return m, nil
+		case "ctrl+g":
+			return m.regenerate()
+		case "ctrl+e":
+			return m.runSnippet()
 		}
 
 		// Handle Enter key to send message
@@ -111,12 +207,15 @@ func (m *MentorScreen) Update(msg tea.Msg) (*MentorScreen, tea.Cmd) {
 				Timestamp: time.Now(),
 			})
 
-			// Clear input and set loading state
 			m.input.SetValue("")
-			m.loading = true
+
+			// Repeat questions are answered instantly from the answer cache
+			if m.answerFromCache(question) {
+				return m, m.saveHistory()
+			}
 
 			// Ask AI asynchronously
-			return m, m.askAI(question)
+			return m, tea.Batch(m.startThinking(), m.askAI(question, false))
 		}
 
 		// Pass other keys to input component
@@ -126,6 +225,7 @@ func (m *MentorScreen) Update(msg tea.Msg) (*MentorScreen, tea.Cmd) {
 	case aiResponseMsg:
 		// Clear loading state
 		m.loading = false
+		m.activity.Stop(ActivityThinking)
 
 		if msg.err != nil {
 			// Show error message
@@ -149,6 +249,9 @@ func (m *MentorScreen) Update(msg tea.Msg) (*MentorScreen, tea.Cmd) {
 		// Save chat history to storage
 		return m, m.saveHistory()
 
+	case snippetRunMsg:
+		return m.handleSnippetRun(msg)
+
 	case historySavedMsg:
 		// History saved successfully, no action needed
 		return m, nil
@@ -156,6 +259,10 @@ func (m *MentorScreen) Update(msg tea.Msg) (*MentorScreen, tea.Cmd) {
 	case historyLoadedMsg:
 		// Chat history loaded from storage
 		m.messages = msg.messages
+		m.rendered = nil
+		if _, ok := m.findPersona(msg.persona); ok {
+			m.persona = msg.persona
+		}
 		return m, nil
 	}
 
@@ -175,33 +282,104 @@ type historySavedMsg struct{}
 // historyLoadedMsg is sent when chat history is loaded from storage.
 type historyLoadedMsg struct {
 	messages []Message
+	persona  string
+}
+
+// chatThread is the stored form of a mentor conversation.
+// Older saves hold only the message array; decodeChatThread handles both.
+type chatThread struct {
+	Persona  string    `json:"persona,omitempty"`
+	Messages []Message `json:"messages"`
+}
+
+// newRequest builds the AI request for a question in the current persona.
+func (m *MentorScreen) newRequest(question string) *ai.Request {
+	systemPrompt := ""
+	if persona, ok := m.Persona(); ok {
+		systemPrompt = personaSystemPrompt(persona)
+	}
+
+	return &ai.Request{
+		Prompt:       question,
+		SystemPrompt: systemPrompt,
+		MaxTokens:    800,
+		Temperature:  0.7,
+		Complexity:   detectComplexity(question),
+	}
+}
+
+// answerFromCache answers a question with a cached answer to the same or a
+// similar question, if there is one, and offers Ctrl+G to regenerate it.
+//
+// Returns:
+//   - bool: True if the question was answered from the cache
+func (m *MentorScreen) answerFromCache(question string) bool {
+	m.cachedQuestion = ""
+	if m.aiManager == nil {
+		return false
+	}
+
+	answer, similarity, ok := m.aiManager.FindCachedAnswer(m.newRequest(question))
+	if !ok {
+		return false
+	}
+
+	m.messages = append(m.messages,
+		Message{
+			Role:      "assistant",
+			Content:   answer.Answer,
+			Provider:  answer.Provider + " · cached",
+			Timestamp: time.Now(),
+		},
+		Message{
+			Role:      "system",
+			Content:   fmt.Sprintf("📚 Answered from a previous question (%.0f%% match). Press Ctrl+G to regenerate.", similarity*100),
+			Timestamp: time.Now(),
+		},
+	)
+	m.cachedQuestion = question
+	return true
+}
+
+// regenerate asks the AI again for the last question answered from the
+// cache, bypassing cached answers.
+func (m *MentorScreen) regenerate() (*MentorScreen, tea.Cmd) {
+	if m.cachedQuestion == "" || m.aiManager == nil {
+		return m, nil
+	}
+
+	question := m.cachedQuestion
+	m.cachedQuestion = ""
+	return m, tea.Batch(m.startThinking(), m.askAI(question, true))
 }
 
 // askAI sends a question to the AI manager and returns a command.
 // This runs asynchronously to keep the UI responsive.
-func (m *MentorScreen) askAI(question string) tea.Cmd {
+// With regenerate set, cached answers are skipped and replaced.
+func (m *MentorScreen) askAI(question string, regenerate bool) tea.Cmd {
+	req := m.newRequest(question)
+	if regenerate {
+		req.Metadata = map[string]string{"regenerate": "true"}
+	}
+
 	return func() tea.Msg {
 		// Create context with timeout
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		// Build AI request
-		req := &ai.Request{
-			Prompt:      question,
-			MaxTokens:   800,
-			Temperature: 0.7,
-			Complexity:  detectComplexity(question),
-		}
-
 		// Ask via AIManager (uses fallback chain)
 		resp, err := m.aiManager.Ask(ctx, req)
 		if err != nil {
 			return aiResponseMsg{err: err}
 		}
 
+		provider := resp.Provider
+		if resp.Cached {
+			provider += " · cached"
+		}
 		return aiResponseMsg{
 			content:  resp.Content,
-			provider: resp.Provider,
+			provider: provider,
 		}
 	}
 }
@@ -251,18 +429,51 @@ func formatErrorMessage(err error) string {
 	return fmt.Sprintf("Error: %v", err)
 }
 
-// saveHistory saves the chat history to storage via Skate.
+// ChatStore persists the chat thread between runs.
+// Every storage backend implements this interface.
+type ChatStore interface {
+	SaveChatHistory(thread []byte) error
+	LoadChatHistory() ([]byte, error)
+}
+
+// SetChatStore sets where the chat history is saved and loaded from.
+//
+// Parameters:
+//   - store: Storage for the chat thread
+func (m *MentorScreen) SetChatStore(store ChatStore) {
+	m.store = store
+}
+
+// SetHistoryLimit caps how many chat messages are kept; older ones are
+// dropped when the history is next saved.
+//
+// Parameters:
+//   - limit: Most messages to keep (0 = no limit)
+func (m *MentorScreen) SetHistoryLimit(limit int) {
+	m.historyLimit = limit
+}
+
+// saveHistory saves the chat thread (messages and persona) to storage,
+// dropping the oldest messages beyond the history limit. Without a chat
+// store the thread is only trimmed.
 func (m *MentorScreen) saveHistory() tea.Cmd {
+	if trimmed := trimChatHistory(m.messages, m.historyLimit); len(trimmed) < len(m.messages) {
+		m.messages = trimmed
+		m.rendered = nil
+	}
+	if m.store == nil {
+		return nil
+	}
+	store, thread := m.store, chatThread{Persona: m.persona, Messages: m.messages}
+
 	return func() tea.Msg {
-		// Convert messages to JSON
-		data, err := json.Marshal(m.messages)
+		// Convert thread to JSON
+		data, err := json.Marshal(thread)
 		if err != nil {
 			return aiResponseMsg{err: fmt.Errorf("marshaling chat history: %w", err)}
 		}
 
-		// Save via Skate
-		cmd := exec.Command("skate", "set", "codequest_chat_history", string(data))
-		if err := cmd.Run(); err != nil {
+		if err := store.SaveChatHistory(data); err != nil {
 			return aiResponseMsg{err: fmt.Errorf("saving chat history: %w", err)}
 		}
 
@@ -270,47 +481,93 @@ func (m *MentorScreen) saveHistory() tea.Cmd {
 	}
 }
 
-// LoadChatHistory loads chat history from storage.
-// Returns a command that sends historyLoadedMsg when complete.
-func LoadChatHistory() tea.Cmd {
+// LoadHistory loads chat history from storage. A saved history longer than
+// the history limit is pruned and written back, so it doesn't grow forever.
+// Returns a command that sends historyLoadedMsg when complete (nil without
+// a chat store).
+func (m *MentorScreen) LoadHistory() tea.Cmd {
+	if m.store == nil {
+		return nil
+	}
+	store, limit := m.store, m.historyLimit
+
 	return func() tea.Msg {
-		cmd := exec.Command("skate", "get", "codequest_chat_history")
-		output, err := cmd.Output()
+		output, err := store.LoadChatHistory()
 		if err != nil {
 			// No history found or error - return empty history
 			return historyLoadedMsg{messages: []Message{}}
 		}
 
-		var messages []Message
-		if err := json.Unmarshal(output, &messages); err != nil {
+		thread, err := decodeChatThread(output)
+		if err != nil {
 			// Invalid JSON - return empty history
 			return historyLoadedMsg{messages: []Message{}}
 		}
 
-		return historyLoadedMsg{messages: messages}
+		if trimmed := trimChatHistory(thread.Messages, limit); len(trimmed) < len(thread.Messages) {
+			thread.Messages = trimmed
+			if data, err := json.Marshal(thread); err == nil {
+				// Best effort: the trimmed history is saved again with the next message
+				_ = store.SaveChatHistory(data)
+			}
+		}
+
+		return historyLoadedMsg{messages: thread.Messages, persona: thread.Persona}
 	}
 }
 
-// View renders the mentor screen.
-func (m *MentorScreen) View() string {
-	// Render message history in viewport
-	var historyLines []string
+// trimChatHistory keeps the newest messages up to the limit.
+//
+// Parameters:
+//   - messages: Chat messages, oldest first
+//   - limit: Most messages to keep (0 = no limit)
+//
+// Returns:
+//   - []Message: The newest messages within the limit
+func trimChatHistory(messages []Message, limit int) []Message {
+	if limit <= 0 || len(messages) <= limit {
+		return messages
+	}
+	return append([]Message(nil), messages[len(messages)-limit:]...)
+}
 
-	for _, msg := range m.messages {
-		rendered := m.renderMessage(msg)
-		historyLines = append(historyLines, rendered)
-		historyLines = append(historyLines, "") // Spacing
+// decodeChatThread parses saved chat history, accepting both the current
+// thread object and the older bare message array.
+func decodeChatThread(data []byte) (chatThread, error) {
+	var thread chatThread
+	if err := json.Unmarshal(data, &thread); err == nil {
+		if thread.Messages == nil {
+			thread.Messages = []Message{}
+		}
+		return thread, nil
+	}
+
+	var messages []Message
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return chatThread{}, fmt.Errorf("decoding chat history: %w", err)
 	}
+	return chatThread{Messages: messages}, nil
+}
 
-	if len(historyLines) > 0 {
-		m.viewport.SetContent(strings.Join(historyLines, "\n"))
+// View renders the mentor screen.
+func (m *MentorScreen) View() string {
+	// Render message history in viewport (cached per width)
+	if content := m.historyContent(); content != "" {
+		m.viewport.SetContent(content)
 	}
 
 	// Build input view
 	inputView := m.input.View()
 	if m.loading {
-		loadingStyle := lipgloss.NewStyle().Foreground(ColorInfo).Bold(true)
-		inputView = loadingStyle.Render("⏳ Thinking...") + "\n" + inputView
+		inputView = m.activity.View(ActivityThinking) + "\n" + inputView
+	}
+	if m.running {
+		inputView = m.activity.View(ActivityRunning) + "\n" + inputView
+	}
+
+	// Open dropdown replaces the input while choosing
+	if m.dropdown != dropdownNone {
+		inputView = m.renderDropdown()
 	}
 
 	// Build provider status
@@ -321,11 +578,37 @@ func (m *MentorScreen) View() string {
 		m.viewport.View(),
 		"",
 		inputView,
+		m.renderPersonaBar(),
 		"",
 		providerStatus,
 	)
 }
 
+// historyContent joins the rendered messages into the viewport's content.
+func (m *MentorScreen) historyContent() string {
+	var historyLines []string
+	for _, rendered := range m.renderHistory() {
+		historyLines = append(historyLines, rendered)
+		historyLines = append(historyLines, "") // Spacing
+	}
+	return strings.Join(historyLines, "\n")
+}
+
+// renderHistory returns rendered messages, rendering only those not yet cached.
+// The cache is discarded if the width changed or history was replaced.
+func (m *MentorScreen) renderHistory() []string {
+	if m.renderedWidth != m.width || len(m.rendered) > len(m.messages) {
+		m.rendered = nil
+		m.renderedWidth = m.width
+	}
+
+	for i := len(m.rendered); i < len(m.messages); i++ {
+		m.rendered = append(m.rendered, m.renderMessage(m.messages[i]))
+	}
+
+	return m.rendered
+}
+
 // renderMessage renders a single message based on role.
 func (m *MentorScreen) renderMessage(msg Message) string {
 	timestamp := formatTime(msg.Timestamp)
@@ -337,6 +620,8 @@ func (m *MentorScreen) renderMessage(msg Message) string {
 		return renderAIMessage(msg.Provider, msg.Content, timestamp, m.width-8)
 	case "system":
 		return renderSystemMessage(msg.Content, timestamp, m.width-8)
+	case roleRun:
+		return renderRunMessage(msg.Content, timestamp, m.width-8)
 	default:
 		return renderSystemMessage(msg.Content, timestamp, m.width-8)
 	}
@@ -500,7 +785,7 @@ func RenderMentorHeader(char *game.Character, width int) string {
 			Foreground(colorLevel).
 			Bold(true)
 		name := nameStyle.Render(char.Name)
-		level := levelStyle.Render(fmt.Sprintf("Lvl %d", char.Level))
+		level := levelStyle.Render(fmt.Sprintf("Lvl %d · %s", char.Level, char.Rank().Name))
 		rightSection = name + " " + level
 	}
 
@@ -675,13 +960,13 @@ func renderUserMessage(content, timestamp string, maxWidth int) string {
 }
 
 // renderAIMessage renders an AI response (left-aligned, lavender theme).
+// Content is treated as markdown so code fences, lists and headings stay readable.
 func renderAIMessage(sender, content, timestamp string, maxWidth int) string {
-	// Wrap content if too long
-	wrappedContent := wrapText(content, maxWidth-20)
+	// Render markdown (glamour handles wrapping and syntax highlighting)
+	renderedContent := RenderMarkdown(content, maxWidth-4)
 
-	// AI message style (lavender/purple, left-aligned)
+	// AI message style (left-aligned)
 	contentStyle := lipgloss.NewStyle().
-		Foreground(ColorMagic).
 		Width(maxWidth)
 
 	timeStyle := lipgloss.NewStyle().
@@ -694,7 +979,7 @@ func renderAIMessage(sender, content, timestamp string, maxWidth int) string {
 		Foreground(ColorMagic).
 		Bold(true)
 
-	message := contentStyle.Render(wrappedContent)
+	message := contentStyle.Render(renderedContent)
 	senderLabel := senderStyle.Render(fmt.Sprintf("[%s]", senderName))
 	time := timeStyle.Render(timestamp)
 
@@ -837,6 +1122,10 @@ func renderInputArea(inputText string, width int) string {
 func RenderMentorFooter(width int) string {
 	// Key bindings
 	enterKey := renderKeybind("Enter", "Send Message")
+	personaKey := renderKeybind("Ctrl+O", "Persona")
+	templateKey := renderKeybind("Ctrl+R", "Templates")
+	regenerateKey := renderKeybind("Ctrl+G", "Regenerate")
+	runKey := renderKeybind("Ctrl+E", "Run Code")
 	escKey := renderKeybind("Esc", "Back")
 	ctrlC := renderKeybind("Ctrl+C", "Quit")
 
@@ -844,6 +1133,14 @@ func RenderMentorFooter(width int) string {
 		lipgloss.Left,
 		enterKey,
 		"  ",
+		personaKey,
+		"  ",
+		templateKey,
+		"  ",
+		regenerateKey,
+		"  ",
+		runKey,
+		"  ",
 		escKey,
 		"  ",
 		ctrlC,
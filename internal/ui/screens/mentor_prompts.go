@@ -0,0 +1,236 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements mentor personas and quick prompt templates for the
+// Mentor screen, selectable from dropdowns and remembered per chat thread.
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// mentorDropdown identifies which dropdown is open on the Mentor screen.
+type mentorDropdown int
+
+const (
+	dropdownNone     mentorDropdown = iota // No dropdown open
+	dropdownPersona                        // Choosing a mentor persona
+	dropdownTemplate                       // Choosing a quick prompt template
+)
+
+// SetMentorConfig loads personas, prompt templates and the snippet run
+// time limit from config.
+// If the thread has no persona yet (or it was removed from config), the
+// first configured persona becomes the active one.
+//
+// Parameters:
+//   - cfg: Mentor settings containing personas and templates
+func (m *MentorScreen) SetMentorConfig(cfg config.AIMentorConfig) {
+	m.personas = cfg.Personas
+	m.templates = cfg.Templates
+	m.runTimeout = time.Duration(cfg.RunTimeoutSecs) * time.Second
+
+	if _, ok := m.findPersona(m.persona); !ok {
+		m.persona = ""
+		if len(m.personas) > 0 {
+			m.persona = m.personas[0].Name
+		}
+	}
+}
+
+// Persona returns the persona used by the current thread.
+//
+// Returns:
+//   - config.MentorPersona: The active persona
+//   - bool: False if no personas are configured
+func (m *MentorScreen) Persona() (config.MentorPersona, bool) {
+	return m.findPersona(m.persona)
+}
+
+// DropdownOpen reports whether a persona or template dropdown is open.
+// The app uses this so Esc closes the dropdown instead of leaving the screen.
+func (m *MentorScreen) DropdownOpen() bool {
+	return m.dropdown != dropdownNone
+}
+
+// findPersona looks up a configured persona by name.
+func (m *MentorScreen) findPersona(name string) (config.MentorPersona, bool) {
+	for _, persona := range m.personas {
+		if persona.Name == name {
+			return persona, true
+		}
+	}
+	return config.MentorPersona{}, false
+}
+
+// openDropdown opens a dropdown with the current selection highlighted.
+// Dropdowns with nothing to choose from stay closed.
+func (m *MentorScreen) openDropdown(kind mentorDropdown) {
+	m.dropdownIndex = 0
+
+	switch kind {
+	case dropdownPersona:
+		if len(m.personas) == 0 {
+			return
+		}
+		for i, persona := range m.personas {
+			if persona.Name == m.persona {
+				m.dropdownIndex = i
+			}
+		}
+	case dropdownTemplate:
+		if len(m.templates) == 0 {
+			return
+		}
+	}
+
+	m.dropdown = kind
+}
+
+// dropdownLen returns the number of entries in the open dropdown.
+func (m *MentorScreen) dropdownLen() int {
+	switch m.dropdown {
+	case dropdownPersona:
+		return len(m.personas)
+	case dropdownTemplate:
+		return len(m.templates)
+	}
+	return 0
+}
+
+// handleDropdownKey handles keyboard input while a dropdown is open.
+//
+// Supports:
+//   - Up/Down (or k/j): Move the highlight
+//   - Enter: Pick the highlighted entry
+//   - Esc: Close without choosing
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - *MentorScreen: Updated screen
+//   - tea.Cmd: Command to save the thread when the persona changes
+func (m *MentorScreen) handleDropdownKey(msg tea.KeyMsg) (*MentorScreen, tea.Cmd) {
+	count := m.dropdownLen()
+
+	switch msg.String() {
+	case "up", "k", "shift+tab":
+		m.dropdownIndex = (m.dropdownIndex - 1 + count) % count
+	case "down", "j", "tab":
+		m.dropdownIndex = (m.dropdownIndex + 1) % count
+	case "esc":
+		m.dropdown = dropdownNone
+	case "enter":
+		kind := m.dropdown
+		m.dropdown = dropdownNone
+		if kind == dropdownPersona {
+			return m, m.selectPersona(m.personas[m.dropdownIndex].Name)
+		}
+		m.applyTemplate(m.templates[m.dropdownIndex])
+	}
+
+	return m, nil
+}
+
+// selectPersona switches the thread to another persona and saves the thread.
+func (m *MentorScreen) selectPersona(name string) tea.Cmd {
+	if name == m.persona {
+		return nil
+	}
+
+	m.persona = name
+	m.messages = append(m.messages, Message{
+		Role:      "system",
+		Content:   fmt.Sprintf("Persona switched to %s", name),
+		Timestamp: time.Now(),
+	})
+	return m.saveHistory()
+}
+
+// applyTemplate puts a template's prompt in the input so the user can
+// finish it (e.g. paste the code to review) before sending.
+func (m *MentorScreen) applyTemplate(template config.PromptTemplate) {
+	m.input.SetValue(template.Prompt + m.input.Value())
+	m.input.CursorEnd()
+}
+
+// personaSystemPrompt builds the AI instructions for a persona.
+//
+// Parameters:
+//   - persona: The persona to describe
+//
+// Returns:
+//   - string: System prompt including tone and focus hints
+func personaSystemPrompt(persona config.MentorPersona) string {
+	parts := []string{}
+	if persona.SystemPrompt != "" {
+		parts = append(parts, persona.SystemPrompt)
+	}
+	if persona.Tone != "" {
+		parts = append(parts, fmt.Sprintf("Use a %s tone.", persona.Tone))
+	}
+	if persona.Focus != "" {
+		parts = append(parts, fmt.Sprintf("Focus on %s and use it for examples unless asked otherwise.", persona.Focus))
+	}
+	return strings.Join(parts, " ")
+}
+
+// renderPersonaBar renders the active persona under the input field.
+func (m *MentorScreen) renderPersonaBar() string {
+	persona, ok := m.Persona()
+	if !ok {
+		return ""
+	}
+
+	label := lipgloss.NewStyle().Foreground(ColorMuted).Render("Persona: ")
+	name := lipgloss.NewStyle().Foreground(ColorMagic).Bold(true).Render(persona.Name)
+	if persona.Focus != "" {
+		name += DimTextStyle.Render(" (" + persona.Focus + ")")
+	}
+	return label + name
+}
+
+// renderDropdown renders the open persona or template dropdown.
+func (m *MentorScreen) renderDropdown() string {
+	title := "Choose a persona"
+	var labels []string
+
+	switch m.dropdown {
+	case dropdownPersona:
+		for _, persona := range m.personas {
+			label := persona.Name
+			if persona.Tone != "" {
+				label += DimTextStyle.Render(" · " + persona.Tone)
+			}
+			labels = append(labels, label)
+		}
+	case dropdownTemplate:
+		title = "Insert a prompt template"
+		for _, template := range m.templates {
+			labels = append(labels, template.Name)
+		}
+	}
+
+	selectedStyle := lipgloss.NewStyle().Foreground(ColorAccent).Bold(true)
+	lines := []string{SubtitleStyle.Render(title)}
+	for i, label := range labels {
+		if i == m.dropdownIndex {
+			lines = append(lines, selectedStyle.Render("▸ ")+label)
+		} else {
+			lines = append(lines, "  "+label)
+		}
+	}
+	lines = append(lines, DimTextStyle.Render("↑/↓ select • Enter choose • Esc cancel"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorSecondary).
+		Padding(0, 1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
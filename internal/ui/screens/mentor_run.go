@@ -0,0 +1,123 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements running Go snippets from mentor answers: Ctrl+E runs
+// the latest answer's Go program in the sandbox and shows its output inline.
+package screens
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/sandbox"
+)
+
+// roleRun marks a message holding a snippet's output.
+const roleRun = "run"
+
+// snippetRunMsg carries the result of running a snippet.
+type snippetRunMsg struct {
+	result sandbox.Result
+	err    error
+}
+
+// runSnippet runs the last Go program in the latest answer. Without one
+// (or without a go toolchain) a system message explains why nothing ran.
+func (m *MentorScreen) runSnippet() (*MentorScreen, tea.Cmd) {
+	response, ok := m.LastResponse()
+	code, runnable := sandbox.FindRunnable(response.Content)
+	switch {
+	case !ok || !runnable:
+		return m, m.addSystemMessage("▶ No runnable Go program in the last answer (it needs package main and func main).")
+	case !sandbox.Available():
+		return m, m.addSystemMessage("▶ Running snippets needs the go toolchain in your PATH.")
+	}
+
+	m.running = true
+	timeout := m.runTimeout
+	return m, tea.Batch(m.activity.Start(ActivityRunning, "Running snippet..."), func() tea.Msg {
+		result, err := sandbox.Run(context.Background(), code, timeout)
+		return snippetRunMsg{result: result, err: err}
+	})
+}
+
+// addSystemMessage appends a system message and saves the thread.
+func (m *MentorScreen) addSystemMessage(content string) tea.Cmd {
+	m.messages = append(m.messages, Message{
+		Role:      "system",
+		Content:   content,
+		Timestamp: time.Now(),
+	})
+	return m.saveHistory()
+}
+
+// handleSnippetRun shows a finished run's output in the conversation.
+func (m *MentorScreen) handleSnippetRun(msg snippetRunMsg) (*MentorScreen, tea.Cmd) {
+	m.running = false
+	m.activity.Stop(ActivityRunning)
+	if msg.err != nil {
+		return m, m.addSystemMessage("▶ Couldn't run snippet: " + msg.err.Error())
+	}
+
+	m.messages = append(m.messages, Message{
+		Role:      roleRun,
+		Content:   formatSnippetRun(msg.result),
+		Timestamp: time.Now(),
+	})
+	return m, m.saveHistory()
+}
+
+// formatSnippetRun turns a run result into the stored message text: a status
+// line followed by the program's output.
+func formatSnippetRun(result sandbox.Result) string {
+	var status string
+	switch {
+	case result.BuildFail:
+		status = "✗ Build failed"
+	case result.TimedOut:
+		status = "⏱ Timed out"
+	case result.ExitCode != 0:
+		status = fmt.Sprintf("✗ Exited with status %d (%s)", result.ExitCode, result.Duration.Round(time.Millisecond))
+	default:
+		status = fmt.Sprintf("✓ Finished in %s", result.Duration.Round(time.Millisecond))
+	}
+
+	output := strings.TrimRight(result.Stdout, "\n")
+	if stderr := strings.TrimRight(result.Stderr, "\n"); stderr != "" {
+		if output != "" {
+			output += "\n"
+		}
+		output += stderr
+	}
+	if output == "" {
+		output = "(no output)"
+	}
+	return status + "\n" + output
+}
+
+// renderRunMessage renders a snippet's output: the status line, then the
+// output in a bordered box kept as-is (not reflowed as markdown).
+func renderRunMessage(content, timestamp string, maxWidth int) string {
+	status, output, _ := strings.Cut(content, "\n")
+
+	statusColor := ColorSuccess
+	if !strings.HasPrefix(status, "✓") {
+		statusColor = ColorError
+	}
+	header := lipgloss.NewStyle().Foreground(ColorMagic).Bold(true).Render("[▶ Run]") + " " +
+		lipgloss.NewStyle().Foreground(statusColor).Render(status)
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(ColorDim).
+		Padding(0, 1).
+		Width(maxWidth - 4).
+		Render(output)
+
+	time := lipgloss.NewStyle().Foreground(ColorDim).Width(maxWidth).Render(timestamp)
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, box, time, "")
+}
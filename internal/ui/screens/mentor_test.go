@@ -3,11 +3,19 @@
 package screens
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/ai"
+	"github.com/AutumnsGrove/codequest/internal/config"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/sandbox"
 )
 
 // TestRenderMentor tests the main mentor screen rendering function.
@@ -240,7 +248,7 @@ func TestRenderConversation(t *testing.T) {
 			t.Error("Expected user message content")
 		}
 
-		if !strings.Contains(result, "Hello! How can I help?") {
+		if !strings.Contains(ansi.Strip(result), "Hello! How can I help?") {
 			t.Error("Expected AI response content")
 		}
 	})
@@ -297,7 +305,7 @@ func TestRenderMessage(t *testing.T) {
 
 		result := renderMessageStatic(msg, 80)
 
-		if !strings.Contains(result, "Test AI response") {
+		if !strings.Contains(ansi.Strip(result), "Test AI response") {
 			t.Error("Expected message content")
 		}
 
@@ -316,7 +324,7 @@ func TestRenderMessage(t *testing.T) {
 
 		result := renderMessageStatic(msg, 80)
 
-		if !strings.Contains(result, "Local AI response") {
+		if !strings.Contains(ansi.Strip(result), "Local AI response") {
 			t.Error("Expected message content")
 		}
 
@@ -335,7 +343,7 @@ func TestRenderMessage(t *testing.T) {
 
 		result := renderMessageStatic(msg, 80)
 
-		if !strings.Contains(result, "Claude AI response") {
+		if !strings.Contains(ansi.Strip(result), "Claude AI response") {
 			t.Error("Expected message content")
 		}
 
@@ -439,6 +447,10 @@ func TestRenderMentorFooter(t *testing.T) {
 		if !strings.Contains(result, "[Ctrl+C]") {
 			t.Error("Expected Ctrl+C key binding")
 		}
+
+		if !strings.Contains(result, "[Ctrl+G]") {
+			t.Error("Expected Ctrl+G regenerate key binding")
+		}
 	})
 }
 
@@ -654,3 +666,306 @@ func BenchmarkWrapText(b *testing.B) {
 		wrapText(text, 50)
 	}
 }
+
+// TestMentorScreenMarkdown tests that assistant messages render markdown and
+// re-render when the width changes.
+func TestMentorScreenMarkdown(t *testing.T) {
+	screen := NewMentorScreen(nil, 100, 40)
+	screen.messages = []Message{
+		{
+			Role:      "assistant",
+			Provider:  "crush",
+			Content:   "# Heading\n\n- first item\n- second item\n\n```go\nfmt.Println(\"hi\")\n```",
+			Timestamp: time.Now(),
+		},
+	}
+
+	history := screen.renderHistory()
+	if len(history) != 1 {
+		t.Fatalf("renderHistory() returned %d messages, want 1", len(history))
+	}
+
+	plain := ansi.Strip(history[0])
+	if strings.Contains(plain, "```") {
+		t.Error("code fence markers should be rendered, not shown literally")
+	}
+	for _, want := range []string{"Heading", "first item", "fmt.Println"} {
+		if !strings.Contains(plain, want) {
+			t.Errorf("rendered markdown missing %q", want)
+		}
+	}
+
+	// Resizing invalidates the cache so content re-wraps to the new width
+	screen.SetSize(60, 40)
+	resized := screen.renderHistory()
+	if screen.renderedWidth != 60 {
+		t.Errorf("renderedWidth = %d, want 60", screen.renderedWidth)
+	}
+	for _, line := range strings.Split(resized[0], "\n") {
+		if ansi.StringWidth(line) > 60 {
+			t.Errorf("line exceeds resized width: %q", ansi.Strip(line))
+		}
+	}
+}
+
+// TestDecodeChatThread tests loading both the thread format and the older
+// bare message array.
+func TestDecodeChatThread(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantPersona string
+		wantCount   int
+		wantErr     bool
+	}{
+		{
+			name:        "thread with persona",
+			data:        `{"persona":"Gopher","messages":[{"Role":"user","Content":"hi"}]}`,
+			wantPersona: "Gopher",
+			wantCount:   1,
+		},
+		{
+			name:      "legacy message array",
+			data:      `[{"Role":"user","Content":"hi"},{"Role":"assistant","Content":"hello"}]`,
+			wantCount: 2,
+		},
+		{
+			name:    "invalid json",
+			data:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thread, err := decodeChatThread([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeChatThread() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if thread.Persona != tt.wantPersona {
+				t.Errorf("Persona = %q, want %q", thread.Persona, tt.wantPersona)
+			}
+			if len(thread.Messages) != tt.wantCount {
+				t.Errorf("len(Messages) = %d, want %d", len(thread.Messages), tt.wantCount)
+			}
+		})
+	}
+}
+
+// TestTrimChatHistory tests keeping only the newest chat messages.
+func TestTrimChatHistory(t *testing.T) {
+	messages := []Message{{Content: "one"}, {Content: "two"}, {Content: "three"}}
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{"no limit", 0, []string{"one", "two", "three"}},
+		{"within limit", 5, []string{"one", "two", "three"}},
+		{"over limit keeps newest", 2, []string{"two", "three"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trimChatHistory(messages, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("trimChatHistory() kept %d messages, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].Content != want {
+					t.Errorf("message %d = %q, want %q", i, got[i].Content, want)
+				}
+			}
+		})
+	}
+}
+
+// TestPersonaSystemPrompt tests that tone and focus are added to the prompt.
+func TestPersonaSystemPrompt(t *testing.T) {
+	tests := []struct {
+		name    string
+		persona config.MentorPersona
+		want    []string
+	}{
+		{
+			name:    "prompt only",
+			persona: config.MentorPersona{SystemPrompt: "You are a mentor."},
+			want:    []string{"You are a mentor."},
+		},
+		{
+			name:    "tone and focus",
+			persona: config.MentorPersona{SystemPrompt: "You are a mentor.", Tone: "blunt", Focus: "Go"},
+			want:    []string{"You are a mentor.", "blunt tone", "Focus on Go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := personaSystemPrompt(tt.persona)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("personaSystemPrompt() = %q, missing %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+// memoryChatStore is an in-memory ChatStore.
+type memoryChatStore struct{ thread []byte }
+
+func (s *memoryChatStore) SaveChatHistory(thread []byte) error { s.thread = thread; return nil }
+func (s *memoryChatStore) LoadChatHistory() ([]byte, error) {
+	if s.thread == nil {
+		return nil, errors.New("not found")
+	}
+	return s.thread, nil
+}
+
+// TestMentorScreenDropdowns tests choosing a persona and inserting a template.
+func TestMentorScreenDropdowns(t *testing.T) {
+	store := &memoryChatStore{}
+	screen := NewMentorScreen(nil, 100, 40)
+	screen.SetChatStore(store)
+	screen.SetMentorConfig(config.DefaultConfig().AI.Mentor)
+
+	if persona, ok := screen.Persona(); !ok || persona.Name != "Mentor" {
+		t.Fatalf("default persona = %q, want Mentor", persona.Name)
+	}
+
+	// Pick the second persona
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if !screen.DropdownOpen() {
+		t.Fatal("Ctrl+O should open the persona dropdown")
+	}
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	screen, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.DropdownOpen() {
+		t.Error("Enter should close the dropdown")
+	}
+	if cmd == nil {
+		t.Fatal("switching persona should save the thread")
+	}
+	cmd()
+	if !strings.Contains(string(store.thread), `"persona":"Reviewer"`) {
+		t.Errorf("saved thread = %s, want the Reviewer persona", store.thread)
+	}
+	if persona, _ := screen.Persona(); persona.Name != "Reviewer" {
+		t.Errorf("persona = %q, want Reviewer", persona.Name)
+	}
+
+	// Insert the first template
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := screen.input.Value(); !strings.HasPrefix(got, "Review this code") {
+		t.Errorf("input = %q, want the review template", got)
+	}
+
+	// Esc closes without choosing
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.DropdownOpen() {
+		t.Error("Esc should close the dropdown")
+	}
+	if persona, _ := screen.Persona(); persona.Name != "Reviewer" {
+		t.Errorf("persona changed on cancel to %q", persona.Name)
+	}
+
+	// A loaded thread restores its persona
+	store.thread = []byte(`{"persona":"Gopher","messages":[]}`)
+	screen, _ = screen.Update(screen.LoadHistory()())
+	if persona, _ := screen.Persona(); persona.Name != "Gopher" {
+		t.Errorf("persona after load = %q, want Gopher", persona.Name)
+	}
+}
+
+// cannedProvider is an AI provider that always gives the same answer.
+type cannedProvider struct{ reply string }
+
+func (p *cannedProvider) Ask(ctx context.Context, req *ai.Request) (*ai.Response, error) {
+	return &ai.Response{Content: p.reply}, nil
+}
+func (p *cannedProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *cannedProvider) GetName() string                      { return "Canned" }
+func (p *cannedProvider) GetPriority() int                     { return 1 }
+func (p *cannedProvider) GetRateLimiter() *ai.RateLimiter      { return nil }
+
+// TestMentorScreenCachedAnswer tests that a repeat question is answered from
+// the cache instantly and that Ctrl+G asks the AI again.
+func TestMentorScreenCachedAnswer(t *testing.T) {
+	manager := ai.NewAIManager(config.DefaultConfig())
+	manager.RegisterProvider(&cannedProvider{reply: "Use a table-driven test."})
+	screen := NewMentorScreen(manager, 100, 40)
+
+	if _, err := manager.Ask(context.Background(), screen.newRequest("How should I test parsers?")); err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+
+	screen.input.SetValue("how should i test parsers")
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.loading {
+		t.Fatal("a cached answer should not wait on the AI")
+	}
+	last, ok := screen.LastResponse()
+	if !ok || last.Content != "Use a table-driven test." || !strings.Contains(last.Provider, "cached") {
+		t.Fatalf("LastResponse() = %+v, want the cached answer", last)
+	}
+
+	screen, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	if !screen.loading || cmd == nil {
+		t.Error("Ctrl+G should ask the AI again")
+	}
+}
+
+// TestMentorScreenRunSnippet tests Ctrl+E running the last answer's Go
+// program and showing the output inline.
+func TestMentorScreenRunSnippet(t *testing.T) {
+	screen := NewMentorScreen(nil, 100, 40)
+	screen.SetChatStore(&memoryChatStore{})
+	screen.messages = []Message{{Role: "assistant", Content: "Use a map.", Timestamp: time.Now()}}
+
+	// Nothing runnable explains why
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if screen.running {
+		t.Fatal("Ctrl+E without a program should not start a run")
+	}
+	if last := screen.messages[len(screen.messages)-1]; last.Role != "system" || !strings.Contains(last.Content, "No runnable Go program") {
+		t.Errorf("last message = %+v, want the no-program notice", last)
+	}
+
+	// A finished run is shown as a run message
+	screen.running = true
+	screen, cmd := screen.Update(snippetRunMsg{result: sandbox.Result{Stdout: "42\n", Duration: 3 * time.Millisecond}})
+	if screen.running || cmd == nil {
+		t.Errorf("running = %v, cmd = %v; want the run finished and the thread saved", screen.running, cmd)
+	}
+	last := screen.messages[len(screen.messages)-1]
+	if last.Role != roleRun || last.Content != "✓ Finished in 3ms\n42" {
+		t.Errorf("run message = %+v", last)
+	}
+	if rendered := screen.renderMessage(last); !strings.Contains(rendered, "42") || !strings.Contains(rendered, "Run") {
+		t.Errorf("rendered run message = %q, want the output", rendered)
+	}
+}
+
+// TestFormatSnippetRun tests the status line and output of run messages.
+func TestFormatSnippetRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		result sandbox.Result
+		want   string
+	}{
+		{"success", sandbox.Result{Stdout: "hi\n", Duration: time.Second}, "✓ Finished in 1s\nhi"},
+		{"build failure", sandbox.Result{Stderr: "undefined: x\n", ExitCode: 1, BuildFail: true}, "✗ Build failed\nundefined: x"},
+		{"exit status", sandbox.Result{Stdout: "a", Stderr: "boom", ExitCode: 2, Duration: time.Millisecond}, "✗ Exited with status 2 (1ms)\na\nboom"},
+		{"timeout", sandbox.Result{TimedOut: true, ExitCode: -1}, "⏱ Timed out\n(no output)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSnippetRun(tt.result); got != tt.want {
+				t.Errorf("formatSnippetRun() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements color palettes: the colors behind each semantic role
+// (success, warning, error, info, progress, reward), with palettes that stay
+// distinguishable for deuteranopia and protanopia. Screens that convey state
+// should pair the role's color with an icon or glyph, so nothing depends on
+// telling red from green.
+package screens
+
+import (
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Palette assigns a color to every semantic role.
+type Palette struct {
+	Name     string         // Config value (ui.palette)
+	Label    string         // Shown in settings
+	Success  lipgloss.Color // Completed, online, good streaks
+	Warning  lipgloss.Color // Needs attention
+	Error    lipgloss.Color // Failed, offline
+	Info     lipgloss.Color // Neutral information
+	Progress lipgloss.Color // Filled part of quest progress bars
+	Reward   lipgloss.Color // XP bars and rewards
+}
+
+// DefaultPaletteName is the palette used when none is configured.
+const DefaultPaletteName = "default"
+
+// Palettes lists the built-in palettes by name. The color-blind palettes
+// swap the red/green pair for blue/orange, which both deuteranopes and
+// protanopes tell apart; protanopia also brightens the error color, since
+// reds look dark to protanopes.
+var Palettes = map[string]Palette{
+	DefaultPaletteName: {
+		Name:     DefaultPaletteName,
+		Label:    "Default",
+		Success:  lipgloss.Color("42"),  // Green
+		Warning:  lipgloss.Color("214"), // Orange
+		Error:    lipgloss.Color("196"), // Red
+		Info:     lipgloss.Color("69"),  // Blue
+		Progress: lipgloss.Color("111"), // Light Blue
+		Reward:   lipgloss.Color("226"), // Gold/Yellow
+	},
+	"deuteranopia": {
+		Name:     "deuteranopia",
+		Label:    "Deuteranopia-safe",
+		Success:  lipgloss.Color("33"),  // Blue
+		Warning:  lipgloss.Color("220"), // Yellow
+		Error:    lipgloss.Color("208"), // Orange
+		Info:     lipgloss.Color("117"), // Sky Blue
+		Progress: lipgloss.Color("75"),  // Light Blue
+		Reward:   lipgloss.Color("226"), // Gold/Yellow
+	},
+	"protanopia": {
+		Name:     "protanopia",
+		Label:    "Protanopia-safe",
+		Success:  lipgloss.Color("39"),  // Bright Blue
+		Warning:  lipgloss.Color("228"), // Pale Yellow
+		Error:    lipgloss.Color("214"), // Bright Orange
+		Info:     lipgloss.Color("153"), // Pale Blue
+		Progress: lipgloss.Color("81"),  // Cyan
+		Reward:   lipgloss.Color("222"), // Light Gold
+	},
+}
+
+// activePalette is the palette last applied with ApplyPalette.
+var activePalette = Palettes[DefaultPaletteName]
+
+// PaletteNames lists the built-in palette names, default first.
+//
+// Returns:
+//   - []string: Palette names
+func PaletteNames() []string {
+	names := []string{DefaultPaletteName}
+	for name := range Palettes {
+		if name != DefaultPaletteName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// PaletteByName looks up a built-in palette.
+//
+// Parameters:
+//   - name: Palette name ("" means the default)
+//
+// Returns:
+//   - Palette: The palette, or the default palette if the name is unknown
+func PaletteByName(name string) Palette {
+	if palette, ok := Palettes[name]; ok {
+		return palette
+	}
+	return Palettes[DefaultPaletteName]
+}
+
+// ActivePalette returns the palette screens are currently drawn with.
+func ActivePalette() Palette {
+	return activePalette
+}
+
+// ApplyPalette recolors every semantic role: the Color* variables, and the
+// styles built from them. Call it before rendering starts.
+//
+// Parameters:
+//   - palette: The palette to use
+func ApplyPalette(palette Palette) {
+	activePalette = palette
+
+	ColorSuccess = palette.Success
+	ColorWarning = palette.Warning
+	ColorError = palette.Error
+	ColorInfo = palette.Info
+	ColorQuest = palette.Progress
+	ColorXP = palette.Reward
+
+	SuccessTextStyle = SuccessTextStyle.Foreground(ColorSuccess)
+	WarningTextStyle = WarningTextStyle.Foreground(ColorWarning)
+	ErrorTextStyle = ErrorTextStyle.Foreground(ColorError)
+	InfoTextStyle = InfoTextStyle.Foreground(ColorInfo)
+	QuestProgressBarStyle = QuestProgressBarStyle.Foreground(ColorQuest)
+	XPBarStyle = XPBarStyle.Foreground(ColorXP)
+	QuestProgressHighlightStyle = QuestProgressHighlightStyle.Foreground(ColorXP)
+	confettiColors = []lipgloss.Color{ColorXP, ColorPrimary, ColorAccent, ColorSuccess, ColorMagic, ColorWarning}
+}
@@ -0,0 +1,76 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file tests color palettes.
+package screens
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPaletteByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "", want: DefaultPaletteName},
+		{name: "deuteranopia", want: "deuteranopia"},
+		{name: "protanopia", want: "protanopia"},
+		{name: "sepia", want: DefaultPaletteName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PaletteByName(tt.name).Name; got != tt.want {
+				t.Errorf("PaletteByName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPalettesKeepRolesDistinct(t *testing.T) {
+	for _, name := range PaletteNames() {
+		palette := Palettes[name]
+		if palette.Success == palette.Error || palette.Success == palette.Warning || palette.Error == palette.Warning {
+			t.Errorf("palette %q reuses a color across success, warning and error", name)
+		}
+	}
+}
+
+func TestApplyPalette(t *testing.T) {
+	defer ApplyPalette(Palettes[DefaultPaletteName])
+
+	palette := Palettes["deuteranopia"]
+	ApplyPalette(palette)
+
+	if ColorSuccess != palette.Success || ColorError != palette.Error || ColorQuest != palette.Progress {
+		t.Errorf("colors not applied: success %v, error %v, progress %v", ColorSuccess, ColorError, ColorQuest)
+	}
+	if got := SuccessTextStyle.GetForeground(); got != palette.Success {
+		t.Errorf("SuccessTextStyle foreground = %v, want %v", got, palette.Success)
+	}
+	if ActivePalette().Name != "deuteranopia" {
+		t.Errorf("ActivePalette() = %q, want deuteranopia", ActivePalette().Name)
+	}
+}
+
+func TestRenderProgressBarMarksCompletion(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   int
+		total     int
+		wantCheck bool
+	}{
+		{name: "partial", current: 3, total: 5, wantCheck: false},
+		{name: "complete", current: 5, total: 5, wantCheck: true},
+		{name: "over target", current: 7, total: 5, wantCheck: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bar := renderProgressBar(tt.current, tt.total, 10, "quest")
+			if got := strings.Contains(bar, "✓"); got != tt.wantCheck {
+				t.Errorf("renderProgressBar(%d, %d) check mark = %v, want %v", tt.current, tt.total, got, tt.wantCheck)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements plain text versions of the dashboard, character sheet
+// and quest board for `codequest dump`: the same information as labelled
+// lines, with no colors, borders or box drawing, for screen readers and for
+// grepping in scripts.
+package screens
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// plainHeatmapDays is how many days of commits the plain heatmap lists.
+const plainHeatmapDays = 7
+
+// plainDateFormat is how dates are written in plain text (sortable and
+// unambiguous, unlike the "Yesterday" of the character sheet).
+const plainDateFormat = "2006-01-02"
+
+// plainText builds a plain text dump: sections of "Label: value" lines.
+type plainText struct {
+	strings.Builder
+}
+
+// heading starts the dump with its title.
+func (p *plainText) heading(title string) {
+	p.WriteString(strings.ToUpper(title) + "\n")
+}
+
+// section starts a new section after a blank line.
+func (p *plainText) section(title string) {
+	p.WriteString("\n" + title + "\n")
+}
+
+// field writes one labelled value in the current section.
+func (p *plainText) field(label string, value interface{}) {
+	fmt.Fprintf(&p.Builder, "  %s: %v\n", label, value)
+}
+
+// line writes an unlabelled line in the current section.
+func (p *plainText) line(text string) {
+	p.WriteString("  " + text + "\n")
+}
+
+// plainWidgets writes each dashboard widget's information, keyed by widget
+// ID (see dashboardWidgets).
+var plainWidgets = map[string]func(p *plainText, data DashboardData){
+	"character":      plainCharacterWidget,
+	"active_quest":   plainActiveQuestWidget,
+	"today":          plainTodayWidget,
+	"streak_heatmap": plainHeatmapWidget,
+	"activity_feed":  plainActivityWidget,
+	"tips": func(p *plainText, data DashboardData) {
+		p.section("Tip of the Day")
+		p.line(dashboardTips[data.Now.YearDay()%len(dashboardTips)])
+	},
+	"pet":      plainPetWidget,
+	"repo_map": plainRepoMapWidget,
+}
+
+// DumpDashboard writes the dashboard as plain text: one section per enabled
+// widget, in display order.
+//
+// Parameters:
+//   - data: Character, quests and other state the widgets show
+//   - widgets: Enabled widget IDs in display order (see config.DashboardWidgetIDs)
+//
+// Returns:
+//   - string: The dashboard as plain text
+func DumpDashboard(data DashboardData, widgets []string) string {
+	var p plainText
+	p.heading("Dashboard")
+	if data.Character == nil {
+		p.section("No character found")
+		return p.String()
+	}
+
+	for _, id := range widgets {
+		if write, ok := plainWidgets[id]; ok {
+			write(&p, data)
+		}
+	}
+	return p.String()
+}
+
+// plainCharacterWidget writes the character summary.
+func plainCharacterWidget(p *plainText, data DashboardData) {
+	c := data.Character
+	p.section("Character")
+	p.field("Name", c.Name)
+	if c.Title != "" {
+		p.field("Title", c.Title)
+	}
+	p.field("Level", fmt.Sprintf("%d (%s)", c.Level, c.Rank().Name))
+	p.field("XP", fmt.Sprintf("%d/%d", c.XP, c.XPToNextLevel))
+	p.field("CodePower", c.CodePower)
+	p.field("Wisdom", c.Wisdom)
+	p.field("Agility", c.Agility)
+	p.field("Total commits", c.TotalCommits)
+	p.field("Quests completed", c.QuestsCompleted)
+}
+
+// plainActiveQuestWidget writes every active quest's progress.
+func plainActiveQuestWidget(p *plainText, data DashboardData) {
+	p.section("Active Quests")
+	quests := ActiveQuests(data.Quests)
+	if len(quests) == 0 {
+		p.line("No active quests")
+		return
+	}
+	for _, quest := range quests {
+		p.field("Title", quest.Title)
+		p.field("Type", quest.Type)
+		p.field("Progress", plainProgress(quest))
+		p.field("Reward", fmt.Sprintf("%d XP", quest.XPReward))
+	}
+}
+
+// plainTodayWidget writes today's activity and XP budget.
+func plainTodayWidget(p *plainText, data DashboardData) {
+	c := data.Character
+	p.section("Today")
+	p.field("Commits", c.TodayCommits)
+	p.field("Lines added", c.TodayLinesAdded)
+	p.field("Session time", formatDuration(c.TodaySessionTime))
+
+	remaining, rested := c.XPBudgetRemaining(data.Budget, data.Now)
+	if remaining >= 0 {
+		p.field("Bonus XP budget", fmt.Sprintf("%d/%d left", remaining, data.Budget.DailyCap))
+	}
+	if rested > 0 {
+		p.field("Rested bonus", fmt.Sprintf("%d XP", rested))
+	}
+}
+
+// plainHeatmapWidget writes the streak and the last week's commits per day.
+func plainHeatmapWidget(p *plainText, data DashboardData) {
+	c := data.Character
+	p.section("Streak")
+	p.field("Current streak", fmt.Sprintf("%d days", c.CurrentStreak))
+	p.field("Longest streak", fmt.Sprintf("%d days", c.LongestStreak))
+	p.field("Quest streak", fmt.Sprintf("%d days", c.CurrentQuestStreak(data.Now, data.Clock)))
+	p.field("Crowns", c.Crowns)
+
+	today := data.Clock.Day(data.Now)
+	for i := plainHeatmapDays - 1; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		p.field(day.Format(plainDateFormat+" Mon"), fmt.Sprintf("%d commits", c.CommitsOnDay(day)))
+	}
+}
+
+// plainActivityWidget writes the recent activity, newest first.
+func plainActivityWidget(p *plainText, data DashboardData) {
+	p.section("Activity Feed")
+	if len(data.Activity) == 0 {
+		p.line("Nothing yet this session")
+	}
+	for i := len(data.Activity) - 1; i >= 0 && i >= len(data.Activity)-activityFeedSize; i-- {
+		item := data.Activity[i]
+		p.line(item.At.Format("15:04") + " " + item.Text)
+	}
+}
+
+// plainPetWidget writes the companion's stage, mood and growth.
+func plainPetWidget(p *plainText, data DashboardData) {
+	pet := data.Character.Pet
+	p.section("Companion")
+	p.field("Stage", game.PetStageName(pet.Stage))
+	p.field("Mood", pet.Mood(data.Now, data.Clock))
+	if progress, ok := pet.Progress(); ok {
+		p.field("Next stage", game.PetStageName(progress.Next))
+		p.field("Growth", fmt.Sprintf("%d/%d", min(progress.Growth, progress.GrowthNeeded), progress.GrowthNeeded))
+		p.field("Quest types", fmt.Sprintf("%d/%d", min(progress.Variety, progress.VarietyNeeded), progress.VarietyNeeded))
+	}
+}
+
+// plainRepoMapWidget writes each repository's commits this week, share and
+// change against last week.
+func plainRepoMapWidget(p *plainText, data DashboardData) {
+	p.section("Commit Geography")
+	geography := data.Character.RepoGeography(data.Now, data.Clock)
+	if len(geography) == 0 {
+		p.line("No commits this week or last")
+	}
+	for _, activity := range geography {
+		p.field(filepath.Base(activity.Repo), fmt.Sprintf("%d commits (%.0f%%), %+d vs last week",
+			activity.Commits, activity.Share*100, activity.Trend()))
+	}
+}
+
+// DumpCharacter writes the character sheet as plain text.
+//
+// Parameters:
+//   - character: Player character (nil-safe)
+//   - projects: Per-project rollups in display order (empty skips the section)
+//   - now: Current time (for a running XP boost)
+//
+// Returns:
+//   - string: The character sheet as plain text
+func DumpCharacter(character *game.Character, projects []game.ProjectSummary, now time.Time) string {
+	var p plainText
+	p.heading("Character")
+	if character == nil {
+		p.section("No character found")
+		return p.String()
+	}
+	c := character
+
+	p.section("Identity")
+	p.field("Name", c.Name)
+	p.field("Level", fmt.Sprintf("%d (%s)", c.Level, c.Rank().Name))
+	p.field("XP", fmt.Sprintf("%d/%d", c.XP, c.XPToNextLevel))
+	p.field("Created", c.CreatedAt.Format(plainDateFormat))
+	for _, field := range game.ProfileFields {
+		if value := c.Profile.Get(field); value != "" && field != game.ProfileAvatarSeed {
+			p.field(game.ProfileFieldLabel(field), value)
+		}
+	}
+
+	p.section("Stats")
+	p.field("CodePower", c.CodePower)
+	p.field("Wisdom", c.Wisdom)
+	p.field("Agility", c.Agility)
+	p.field("Wellness", c.Wellness)
+	p.field("Unspent skill points", c.SkillPoints)
+
+	p.section("Streak")
+	p.field("Current streak", fmt.Sprintf("%d days", c.CurrentStreak))
+	p.field("Longest streak", fmt.Sprintf("%d days", c.LongestStreak))
+	p.field("Streak freezes", c.StreakFreezes)
+
+	p.section("Lifetime")
+	p.field("Commits", c.TotalCommits)
+	p.field("Lines added", c.TotalLinesAdded)
+	p.field("Lines removed", c.TotalLinesRemoved)
+	p.field("Quests completed", c.QuestsCompleted)
+	p.field("Reviews given", c.ReviewsGiven)
+	p.field("Reviews received", c.ReviewsReceived)
+	p.field("Co-authored commits", c.CoAuthoredCommits)
+	p.field("Well-formed commits", c.WellFormedCommits)
+	p.field("Open source commits", fmt.Sprintf("%d (%d projects)", c.OpenSourceCommits, len(c.OpenSourceProjects)))
+	p.field("Effort", c.Effort)
+
+	records := c.Records
+	p.section("Records")
+	plainRecord(&p, game.RecordDayXP, records.MostXPInDay > 0,
+		fmt.Sprintf("%d XP on %s", records.MostXPInDay, records.MostXPDate.Format(plainDateFormat)))
+	plainRecord(&p, game.RecordLongestSession, records.LongestSession > 0,
+		fmt.Sprintf("%s on %s", game.FormatRecordDuration(records.LongestSession), records.LongestSessionAt.Format(plainDateFormat)))
+	plainRecord(&p, game.RecordBiggestCommit, records.BiggestCommit > 0,
+		fmt.Sprintf("%d lines in %.7s on %s", records.BiggestCommit, records.BiggestCommitSHA, records.BiggestCommitAt.Format(plainDateFormat)))
+	plainRecord(&p, game.RecordFastestQuest, records.FastestQuest > 0,
+		fmt.Sprintf("%s (%s)", game.FormatRecordDuration(records.FastestQuest), records.FastestQuestTitle))
+
+	if len(c.Items) > 0 || c.XPBoostActive(now) {
+		p.section("Quest Rewards")
+		for _, item := range c.Items {
+			p.field("Trophy", item.Name)
+		}
+		if c.XPBoostActive(now) {
+			p.field("XP boost", fmt.Sprintf("+%d%% until %s", game.XPBoostPercent, c.XPBoostUntil.Local().Format(plainDateFormat+" 15:04")))
+		}
+	}
+
+	if len(projects) > 0 {
+		p.section("Projects")
+		for _, project := range projects {
+			p.field(project.Name, fmt.Sprintf("%d commits, +%d/-%d lines, %d XP, %d active quests, %d completed",
+				project.Stats.Commits, project.Stats.LinesAdded, project.Stats.LinesRemoved, project.Stats.XPEarned,
+				project.ActiveQuests, project.Stats.QuestsCompleted))
+		}
+	}
+
+	p.section("Achievements")
+	for _, tier := range game.DependencyWranglerTiers {
+		status := fmt.Sprintf("%d/%d dependency bumps", min(c.DependencyBumps, tier.Threshold), tier.Threshold)
+		if c.HasAchievement(tier.ID) {
+			status = "unlocked"
+		}
+		p.field(tier.Name, status)
+	}
+	for _, tier := range game.QuestCrownTiers {
+		status := fmt.Sprintf("%d/%d crowns", min(c.Crowns, tier.Threshold), tier.Threshold)
+		if c.HasAchievement(tier.ID) {
+			status = "unlocked"
+		}
+		p.field(tier.Name, status)
+	}
+	rested := c.RestedDays(time.Now(), game.StreakClock{})
+	for _, tier := range game.WellRestedTiers {
+		status := fmt.Sprintf("%d/%d days without a late-night commit", min(rested, tier.Threshold), tier.Threshold)
+		if c.HasAchievement(tier.ID) {
+			status = "unlocked"
+		}
+		p.field(tier.Name, status)
+	}
+	for _, tier := range game.OpenSourceTiers {
+		status := fmt.Sprintf("%d/%d open source commits", min(c.OpenSourceCommits, tier.Threshold), tier.Threshold)
+		if c.HasAchievement(tier.ID) {
+			status = "unlocked"
+		}
+		p.field(tier.Name, status)
+	}
+
+	return p.String()
+}
+
+// plainRecord writes a personal record, or "none" if it isn't set yet.
+func plainRecord(p *plainText, kind game.RecordKind, set bool, value string) {
+	if !set {
+		value = "none"
+	}
+	p.field(game.RecordLabel(kind), value)
+}
+
+// DumpQuests writes every quest as plain text, active quests first, then
+// available, completed and failed ones.
+//
+// Parameters:
+//   - character: Player character, for lock reasons (nil skips them)
+//   - quests: All quests
+//
+// Returns:
+//   - string: The quest board as plain text
+func DumpQuests(character *game.Character, quests []*game.Quest) string {
+	var p plainText
+	p.heading("Quests")
+	if len(quests) == 0 {
+		p.section("No quests yet")
+		return p.String()
+	}
+
+	for _, status := range []game.QuestStatus{game.QuestActive, game.QuestAvailable, game.QuestCompleted, game.QuestFailed} {
+		for _, quest := range quests {
+			if quest.Status != status {
+				continue
+			}
+			p.section(quest.Title)
+			p.field("ID", quest.ID)
+			p.field("Status", plainQuestStatus(character, quest))
+			p.field("Type", quest.Type)
+			p.field("Progress", plainProgress(quest))
+			p.field("Reward", fmt.Sprintf("%d XP", quest.XPReward))
+			if reward := quest.Reward; reward != nil && reward.Kind == game.RewardXP {
+				earned := fmt.Sprintf("%d XP", reward.XP)
+				if reward.Breakdown != nil {
+					earned += " (" + reward.Breakdown.String() + ")"
+				}
+				p.field("Earned", earned)
+			}
+			if quest.Project != "" {
+				p.field("Project", quest.Project)
+			}
+			if quest.Description != "" {
+				p.field("Description", quest.Description)
+			}
+		}
+	}
+	return p.String()
+}
+
+// plainQuestStatus describes a quest's status, including why an available
+// quest is still locked.
+func plainQuestStatus(character *game.Character, quest *game.Quest) string {
+	status := string(quest.Status)
+	if quest.Status == game.QuestAvailable && character != nil {
+		if reasons := quest.LockReasons(character); len(reasons) > 0 {
+			status = "locked (" + strings.Join(reasons, "; ") + ")"
+		}
+	}
+	if quest.RewardPending {
+		status += ", reward waiting to be picked"
+	}
+	return status
+}
+
+// plainProgress formats a quest's progress as "current/target (percent)".
+func plainProgress(quest *game.Quest) string {
+	return fmt.Sprintf("%d/%d (%d%%)", quest.Current, quest.Target, int(quest.Progress*100))
+}
@@ -0,0 +1,82 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestPlainTextDumps tests that the plain text dumps carry the screens'
+// information without escape codes or box drawing.
+func TestPlainTextDumps(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	character.TotalCommits = 42
+	character.CurrentStreak = 3
+
+	active := game.NewQuest("First Steps", "Make a commit", game.QuestTypeCommit, 5, 100, 0)
+	if err := active.Start("", ""); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	active.UpdateProgress(2)
+	locked := game.NewQuest("Big Refactor", "Remove lines", game.QuestTypeLines, 100, 300, 5)
+
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.Local)
+	data := DashboardData{Character: character, Quests: []*game.Quest{active, locked}, Now: now}
+
+	tests := []struct {
+		name string
+		dump string
+		want []string
+	}{
+		{
+			name: "dashboard",
+			dump: DumpDashboard(data, config.DashboardWidgetIDs),
+			want: []string{"DASHBOARD", "  Name: Tester", "Active Quest", "  Title: First Steps", "  Progress: 2/5 (40%)", "  Current streak: 3 days", "2026-03-14 Sat", "Tip of the Day"},
+		},
+		{
+			name: "dashboard without widgets",
+			dump: DumpDashboard(data, []string{"character"}),
+			want: []string{"  Total commits: 42"},
+		},
+		{
+			name: "character",
+			dump: DumpCharacter(character, nil, now),
+			want: []string{"CHARACTER", "  Level: 1", "  Commits: 42", "Records", "none", "Achievements"},
+		},
+		{
+			name: "quests",
+			dump: DumpQuests(character, []*game.Quest{locked, active}),
+			want: []string{"QUESTS", "First Steps", "  Status: active", "  Status: locked (", "  Reward: 300 XP"},
+		},
+		{
+			name: "no character",
+			dump: DumpCharacter(nil, nil, now),
+			want: []string{"No character found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, want := range tt.want {
+				if !strings.Contains(tt.dump, want) {
+					t.Errorf("dump missing %q:\n%s", want, tt.dump)
+				}
+			}
+			if strings.Contains(tt.dump, "\x1b") || strings.ContainsAny(tt.dump, "╭╮╰╯│─█░") {
+				t.Errorf("dump contains escape codes or box drawing:\n%s", tt.dump)
+			}
+		})
+	}
+
+	// Active quests come before available ones
+	quests := DumpQuests(character, []*game.Quest{locked, active})
+	if strings.Index(quests, "First Steps") > strings.Index(quests, "Big Refactor") {
+		t.Errorf("active quest should be listed first:\n%s", quests)
+	}
+	if strings.Contains(DumpDashboard(data, []string{"character"}), "Tip of the Day") {
+		t.Error("disabled widgets should be left out")
+	}
+}
@@ -5,7 +5,6 @@ package screens
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
@@ -31,50 +30,60 @@ const (
 // This is the main quest management screen where players can browse and select quests.
 //
 // Features:
-//   - Lists all quests grouped by status (Available, Active, Completed)
+//   - Lists pinned quests first, then the rest grouped by status (Available, Active, Completed)
 //   - Supports quest filtering by status
 //   - Highlights selected quest for keyboard navigation
 //   - Shows quest details: title, description, type badge, progress, XP reward
 //   - Scrollable list if quests exceed screen height
+//   - Cards reused from the cache until they change (smooth cursor movement)
 //   - Responsive layout for different terminal sizes
 //   - Nil-safe: handles empty quest list gracefully
 //
 // Layout Structure:
 //   - Header: Screen title with character info
 //   - Filter tabs: Quick filter by status
-//   - Quest sections: Grouped by status (Available, Active, Completed)
+//   - Quest sections: Pinned, then grouped by status (Available, Active, Completed)
 //   - Footer: Key bindings and navigation help
 //
 // Parameters:
+//   - cache: Rendered quest cards to reuse (nil renders every card)
 //   - character: Player character (for header display)
 //   - quests: All quests to display
 //   - selectedIndex: Index of currently selected quest (-1 for none)
 //   - filter: Current filter setting
+//   - project: Only show quests scoped to this project ("" for all)
 //   - width: Terminal width in characters
 //   - height: Terminal height in characters
 //
 // Returns:
 //   - string: Rendered quest board UI
-func RenderQuestBoard(character *game.Character, quests []*game.Quest, selectedIndex int, filter QuestFilter, width, height int) string {
+func RenderQuestBoard(cache *QuestCardCache, character *game.Character, quests []*game.Quest, selectedIndex int, filter QuestFilter, project string, width, height int) string {
 	// Render header (inline to avoid import cycle)
 	header := renderQuestBoardHeader(character, width)
+	cache.prune(quests)
+
+	// Narrow to the selected project before status filtering and counts
+	quests = game.FilterQuestsByProject(quests, project)
 
 	// Filter quests based on current filter
 	filteredQuests := filterQuests(quests, filter)
 
 	// Render filter tabs
 	filterTabs := renderFilterTabs(filter, quests, width)
+	if project != "" {
+		filterTabs = lipgloss.JoinVertical(lipgloss.Left, filterTabs, renderProjectFilter(project))
+	}
 
 	// Render quest list
 	var questList string
 	if len(filteredQuests) == 0 {
 		questList = renderEmptyQuestList(filter, width)
 	} else {
-		questList = renderQuestList(filteredQuests, selectedIndex, width, height-15)
+		questList = renderQuestList(cache, character, filteredQuests, selectedIndex, width, height-15)
 	}
 
 	// Render footer with key bindings
-	footer := renderQuestBoardFooter(width)
+	footer := renderQuestBoardFooter(character, width)
 
 	// Assemble screen
 	screen := lipgloss.JoinVertical(
@@ -117,6 +126,11 @@ func filterQuests(quests []*game.Quest, filter QuestFilter) []*game.Quest {
 	return filtered
 }
 
+// renderProjectFilter renders the active project filter label.
+func renderProjectFilter(project string) string {
+	return InfoTextStyle.Render("📁 Project: "+project) + " " + DimTextStyle.Render("(P to change)")
+}
+
 // renderFilterTabs renders the filter tabs showing quest counts by status.
 func renderFilterTabs(currentFilter QuestFilter, quests []*game.Quest, width int) string {
 	// Count quests by status
@@ -186,70 +200,116 @@ func renderFilterTabs(currentFilter QuestFilter, quests []*game.Quest, width int
 	return tabBar
 }
 
-// renderQuestList renders the list of quests with the selected one highlighted.
-func renderQuestList(quests []*game.Quest, selectedIndex int, width, maxHeight int) string {
-	// Group quests by status
-	availableQuests := make([]*game.Quest, 0)
-	activeQuests := make([]*game.Quest, 0)
-	completedQuests := make([]*game.Quest, 0)
+// boardStatuses are the statuses the Quest Board lists, in section order.
+var boardStatuses = []game.QuestStatus{game.QuestAvailable, game.QuestActive, game.QuestCompleted}
 
-	for _, quest := range quests {
-		switch quest.Status {
-		case game.QuestAvailable:
-			availableQuests = append(availableQuests, quest)
-		case game.QuestActive:
-			activeQuests = append(activeQuests, quest)
-		case game.QuestCompleted:
-			completedQuests = append(completedQuests, quest)
-		}
+// BoardQuests returns the quests the Quest Board lists, in display order:
+// pinned quests first, then Available, Active and Completed quests. Each
+// section keeps the quest list's order, which the player can rearrange.
+//
+// Parameters:
+//   - quests: All quests (already narrowed to a project, if any)
+//   - filter: Current Quest Board filter
+//
+// Returns:
+//   - []*game.Quest: Quests in board order (selection indexes refer to this)
+func BoardQuests(quests []*game.Quest, filter QuestFilter) []*game.Quest {
+	sections := boardSections(filterQuests(quests, filter))
+	ordered := make([]*game.Quest, 0, len(quests))
+	for _, section := range sections {
+		ordered = append(ordered, section.quests...)
 	}
+	return ordered
+}
 
-	// Render each section
-	sections := make([]string, 0)
+// SameBoardSection reports whether two quests are listed in the same Quest
+// Board section, so moving one past the other keeps both in place.
+//
+// Parameters:
+//   - a, b: Quests to compare
+//
+// Returns:
+//   - bool: True if both are pinned, or neither is and they share a status
+func SameBoardSection(a, b *game.Quest) bool {
+	if a.Pinned || b.Pinned {
+		return a.Pinned == b.Pinned
+	}
+	return a.Status == b.Status
+}
+
+// boardSection is one titled group of quests on the board.
+type boardSection struct {
+	title  string
+	quests []*game.Quest
+}
+
+// boardSections groups quests into the board's sections, leaving out empty
+// ones and quests with statuses the board doesn't list.
+func boardSections(quests []*game.Quest) []boardSection {
+	pinned := boardSection{title: "📌 Pinned Quests"}
+	byStatus := map[game.QuestStatus]*boardSection{
+		game.QuestAvailable: {title: "📋 Available Quests"},
+		game.QuestActive:    {title: "⚡ Active Quests"},
+		game.QuestCompleted: {title: "✅ Completed Quests"},
+	}
 
-	if len(availableQuests) > 0 {
-		sections = append(sections, renderQuestSection("📋 Available Quests", availableQuests, selectedIndex, 0, width))
+	for _, quest := range quests {
+		section, listed := byStatus[quest.Status]
+		switch {
+		case !listed:
+			continue
+		case quest.Pinned:
+			pinned.quests = append(pinned.quests, quest)
+		default:
+			section.quests = append(section.quests, quest)
+		}
 	}
 
-	if len(activeQuests) > 0 {
-		offset := len(availableQuests)
-		sections = append(sections, renderQuestSection("⚡ Active Quests", activeQuests, selectedIndex, offset, width))
+	sections := make([]boardSection, 0, len(boardStatuses)+1)
+	if len(pinned.quests) > 0 {
+		sections = append(sections, pinned)
 	}
+	for _, status := range boardStatuses {
+		if section := byStatus[status]; len(section.quests) > 0 {
+			sections = append(sections, *section)
+		}
+	}
+	return sections
+}
 
-	if len(completedQuests) > 0 {
-		offset := len(availableQuests) + len(activeQuests)
-		sections = append(sections, renderQuestSection("✅ Completed Quests", completedQuests, selectedIndex, offset, width))
+// renderQuestList renders the list of quests with the selected one highlighted.
+func renderQuestList(cache *QuestCardCache, character *game.Character, quests []*game.Quest, selectedIndex int, width, maxHeight int) string {
+	// Render each section, pinned quests first
+	sections := make([]string, 0)
+	offset := 0
+	for _, section := range boardSections(quests) {
+		sections = append(sections, renderQuestSection(cache, character, section.title, section.quests, selectedIndex, offset, width))
+		offset += len(section.quests)
 	}
 
-	// Join sections vertically
-	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	// Join sections vertically (see renderQuestSection)
+	return strings.Join(sections, "\n")
 }
 
 // renderQuestSection renders a section of quests with a title.
-func renderQuestSection(title string, quests []*game.Quest, selectedIndex, offset int, width int) string {
+func renderQuestSection(cache *QuestCardCache, character *game.Character, title string, quests []*game.Quest, selectedIndex, offset int, width int) string {
 	sectionTitle := SubtitleStyle.Render(title)
 
 	questCards := make([]string, 0)
 	for i, quest := range quests {
 		globalIndex := offset + i
 		isSelected := globalIndex == selectedIndex
-		card := renderQuestCard(quest, isSelected, width-4)
+		card := cache.card(character, quest, isSelected, width-4)
 		questCards = append(questCards, card)
 	}
 
-	questList := lipgloss.JoinVertical(lipgloss.Left, questCards...)
-
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		sectionTitle,
-		"",
-		questList,
-		"",
-	)
+	// Cards share one width, so a plain join looks the same as
+	// lipgloss.JoinVertical without measuring every line of every card
+	return strings.Join([]string{sectionTitle, "", strings.Join(questCards, "\n"), ""}, "\n")
 }
 
 // renderQuestCard renders a single quest card.
-func renderQuestCard(quest *game.Quest, selected bool, width int) string {
+func renderQuestCard(character *game.Character, quest *game.Quest, selected bool, width int) string {
 	// Choose style based on selection
 	cardStyle := BoxStyle
 	if selected {
@@ -266,6 +326,9 @@ func renderQuestCard(quest *game.Quest, selected bool, width int) string {
 	questTitle := BoldTextStyle.Render(quest.Title)
 	typeBadge := renderQuestTypeBadge(quest.Type)
 	header := indicator + questTitle + " " + typeBadge
+	if quest.Project != "" {
+		header += " " + MutedTextStyle.Render("📁 "+quest.Project)
+	}
 
 	// Description (truncate if too long)
 	description := quest.Description
@@ -279,7 +342,7 @@ func renderQuestCard(quest *game.Quest, selected bool, width int) string {
 	var statusContent string
 	switch quest.Status {
 	case game.QuestAvailable:
-		statusContent = renderAvailableQuestInfo(quest)
+		statusContent = renderAvailableQuestInfo(character, quest)
 	case game.QuestActive:
 		// Ensure barWidth is at least 10 to prevent negative values
 		barWidth := width - 20
@@ -306,7 +369,8 @@ func renderQuestCard(quest *game.Quest, selected bool, width int) string {
 }
 
 // renderAvailableQuestInfo renders info for available quests.
-func renderAvailableQuestInfo(quest *game.Quest) string {
+// A nil character skips requirement checks (quest shown as unlocked).
+func renderAvailableQuestInfo(character *game.Character, quest *game.Quest) string {
 	// XP reward
 	rewardLabel := StatLabelStyle.Render("Reward: ")
 	rewardValue := lipgloss.NewStyle().
@@ -320,8 +384,21 @@ func renderAvailableQuestInfo(quest *game.Quest) string {
 	levelValue := StatValueStyle.Render(fmt.Sprintf("%d", quest.RequiredLevel))
 	level := levelLabel + levelValue
 
+	// Locked quests explain what's missing instead of offering to start
+	var reasons []string
+	if character != nil {
+		reasons = quest.LockReasons(character)
+	}
+	if len(reasons) > 0 {
+		lines := []string{reward, level, "", WarningTextStyle.Render("🔒 Locked")}
+		for _, reason := range reasons {
+			lines = append(lines, MutedTextStyle.Render("  • "+reason))
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
 	// Start hint
-	hint := InfoTextStyle.Render("Press [Enter] to start this quest")
+	hint := InfoTextStyle.Render("Press [Enter] to view this quest")
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -348,8 +425,7 @@ func renderActiveQuestInfo(quest *game.Quest, barWidth int) string {
 	timeLabel := StatLabelStyle.Render("Started: ")
 	var timeValue string
 	if quest.StartedAt != nil {
-		elapsed := time.Since(*quest.StartedAt)
-		timeValue = MutedTextStyle.Render(formatDuration(elapsed) + " ago")
+		timeValue = MutedTextStyle.Render(cardElapsed(*quest.StartedAt) + " ago")
 	} else {
 		timeValue = MutedTextStyle.Render("Unknown")
 	}
@@ -371,22 +447,29 @@ func renderActiveQuestInfo(quest *game.Quest, barWidth int) string {
 	)
 }
 
-// renderCompletedQuestInfo renders info for completed quests.
+// renderCompletedQuestInfo renders info for completed quests, with the XP
+// breakdown recorded at completion when there is one.
 func renderCompletedQuestInfo(quest *game.Quest) string {
-	// XP earned
+	// XP earned (quests completed before rewards were recorded show the base reward)
+	earned := quest.XPReward
+	if quest.Reward != nil && quest.Reward.Kind == game.RewardXP {
+		earned = quest.Reward.XP
+	}
 	xpLabel := StatLabelStyle.Render("XP Earned: ")
 	xpValue := lipgloss.NewStyle().
 		Foreground(ColorSuccess).
 		Bold(true).
-		Render(fmt.Sprintf("%d XP ✓", quest.XPReward))
+		Render(fmt.Sprintf("%d XP ✓", earned))
 	xpEarned := xpLabel + xpValue
+	if quest.Reward != nil && quest.Reward.Breakdown != nil {
+		xpEarned += MutedTextStyle.Render("  (" + quest.Reward.Breakdown.String() + ")")
+	}
 
 	// Completion time
 	timeLabel := StatLabelStyle.Render("Completed: ")
 	var timeValue string
 	if quest.CompletedAt != nil {
-		elapsed := time.Since(*quest.CompletedAt)
-		timeValue = MutedTextStyle.Render(formatDuration(elapsed) + " ago")
+		timeValue = MutedTextStyle.Render(cardElapsed(*quest.CompletedAt) + " ago")
 	} else {
 		timeValue = MutedTextStyle.Render("Unknown")
 	}
@@ -453,12 +536,17 @@ func renderEmptyQuestList(filter QuestFilter, width int) string {
 	return BoxStyleDim.Width(width - 8).Render(content)
 }
 
-// renderQuestBoardFooter renders the footer with key bindings.
-func renderQuestBoardFooter(width int) string {
+// renderQuestBoardFooter renders the footer with key bindings. Features the
+// character hasn't unlocked yet say when they open up.
+func renderQuestBoardFooter(character *game.Character, width int) string {
 	// Key bindings
 	upDown := renderKeybind("↑/↓", "Navigate")
 	enter := renderKeybind("Enter", "Start/View")
+	pin := renderKeybind("*", "Pin")
+	move := renderKeybind("Shift+↑/↓", "Move")
 	filter := renderKeybind("F", "Filter")
+	project := renderKeybind("P", "Project")
+	calendar := renderFeatureKeybind(character, game.FeatureQuestCalendar, "V", "Calendar")
 	esc := renderKeybind("Esc", "Back")
 
 	keybinds := lipgloss.JoinHorizontal(
@@ -467,8 +555,16 @@ func renderQuestBoardFooter(width int) string {
 		"  ",
 		enter,
 		"  ",
+		pin,
+		"  ",
+		move,
+		"  ",
 		filter,
 		"  ",
+		project,
+		"  ",
+		calendar,
+		"  ",
 		esc,
 	)
 
@@ -531,7 +627,7 @@ func renderQuestBoardHeader(char *game.Character, width int) string {
 			Foreground(colorLevel).
 			Bold(true)
 		name := nameStyle.Render(char.Name)
-		level := levelStyle.Render(fmt.Sprintf("Lvl %d", char.Level))
+		level := levelStyle.Render(fmt.Sprintf("Lvl %d · %s", char.Level, char.Rank().Name))
 		rightSection = name + " " + level
 	}
 
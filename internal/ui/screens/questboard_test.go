@@ -1,6 +1,7 @@
 package screens
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -145,7 +146,7 @@ func TestRenderQuestBoard(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := RenderQuestBoard(tt.character, tt.quests, tt.selectedIndex, tt.filter, tt.width, tt.height)
+			output := RenderQuestBoard(nil, tt.character, tt.quests, tt.selectedIndex, tt.filter, "", tt.width, tt.height)
 
 			// Check that output is not empty
 			if output == "" {
@@ -356,6 +357,14 @@ func TestRenderQuestList(t *testing.T) {
 			maxHeight:     30,
 			wantContains:  []string{"▶"},
 		},
+		{
+			name:          "pinned section",
+			quests:        append([]*game.Quest{{ID: "fav", Title: "Favorite", Status: game.QuestActive, Pinned: true}}, quests...),
+			selectedIndex: -1,
+			width:         80,
+			maxHeight:     30,
+			wantContains:  []string{"Pinned Quests", "Favorite"},
+		},
 		{
 			name:          "handles narrow width",
 			quests:        quests,
@@ -368,7 +377,7 @@ func TestRenderQuestList(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := renderQuestList(tt.quests, tt.selectedIndex, tt.width, tt.maxHeight)
+			output := renderQuestList(nil, nil, tt.quests, tt.selectedIndex, tt.width, tt.maxHeight)
 
 			if output == "" {
 				t.Error("renderQuestList returned empty string")
@@ -431,7 +440,7 @@ func TestRenderQuestCard(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := renderQuestCard(tt.quest, tt.selected, tt.width)
+			output := renderQuestCard(nil, tt.quest, tt.selected, tt.width)
 
 			if output == "" {
 				t.Error("renderQuestCard returned empty string")
@@ -501,24 +510,35 @@ func TestRenderEmptyQuestList(t *testing.T) {
 func TestRenderQuestBoardFooter(t *testing.T) {
 	tests := []struct {
 		name         string
+		level        int
 		width        int
 		wantContains []string
 	}{
 		{
 			name:         "shows all key bindings",
+			level:        5,
 			width:        80,
-			wantContains: []string{"Navigate", "Start/View", "Filter", "Back"},
+			wantContains: []string{"Navigate", "Start/View", "Pin", "Move", "Filter", "Back"},
 		},
 		{
 			name:         "handles narrow width",
+			level:        5,
 			width:        40,
 			wantContains: []string{"Navigate"},
 		},
+		{
+			name:         "locked calendar",
+			level:        1,
+			width:        160,
+			wantContains: []string{"Calendar: Unlocks at level 3"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := renderQuestBoardFooter(tt.width)
+			character := game.NewCharacter("Tester")
+			character.Level = tt.level
+			output := renderQuestBoardFooter(character, tt.width)
 
 			if output == "" {
 				t.Error("renderQuestBoardFooter returned empty string")
@@ -571,7 +591,7 @@ func TestRenderQuestSection(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := renderQuestSection(tt.title, tt.quests, tt.selectedIndex, tt.offset, tt.width)
+			output := renderQuestSection(nil, nil, tt.title, tt.quests, tt.selectedIndex, tt.offset, tt.width)
 
 			if output == "" {
 				t.Error("renderQuestSection returned empty string")
@@ -589,7 +609,7 @@ func TestRenderQuestSection(t *testing.T) {
 // TestRenderAvailableQuestInfo tests available quest info rendering.
 func TestRenderAvailableQuestInfo(t *testing.T) {
 	quest := createTestQuest("Test", game.QuestAvailable)
-	output := renderAvailableQuestInfo(quest)
+	output := renderAvailableQuestInfo(nil, quest)
 
 	if output == "" {
 		t.Error("renderAvailableQuestInfo returned empty string")
@@ -637,6 +657,71 @@ func TestRenderCompletedQuestInfo(t *testing.T) {
 	}
 }
 
+// TestRenderCompletedQuestInfoBreakdown tests that a recorded reward shows
+// the XP actually earned and how it was made up.
+func TestRenderCompletedQuestInfoBreakdown(t *testing.T) {
+	quest := createCompletedTestQuest("Test")
+	breakdown := game.XPBreakdown{Base: 100, Difficulty: 20, Wisdom: 12, Mode: game.DifficultyEasy}
+	quest.Reward = &game.QuestReward{Kind: game.RewardXP, XP: breakdown.Total(), Breakdown: &breakdown}
+
+	output := renderCompletedQuestInfo(quest)
+	for _, want := range []string{"132 XP", "100 base +20 easy +12 wisdom"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("renderCompletedQuestInfo output does not contain %q", want)
+		}
+	}
+}
+
+// TestQuestCardCache tests that cached cards render like uncached ones and
+// that only changed cards are rendered again.
+func TestQuestCardCache(t *testing.T) {
+	character := game.NewCharacter("Hero")
+	quests := make([]*game.Quest, 200)
+	for i := range quests {
+		quests[i] = createTestQuest(fmt.Sprintf("Quest %d", i), game.QuestAvailable)
+	}
+	quests[7].RequiredLevel = 10 // Locked until the level-up step
+	started := time.Now().Add(-time.Hour - 20*time.Second)
+	quests[3].Status = game.QuestActive
+	quests[3].StartedAt = &started
+	cache := NewQuestCardCache()
+
+	render := func(selected int) string {
+		return RenderQuestBoard(cache, character, quests, selected, FilterAll, "", 100, 40)
+	}
+	steps := []struct {
+		name    string
+		change  func() int // Returns the selected index
+		renders int        // Cards rendered by this step
+	}{
+		{"first render", func() int { return 0 }, 200},
+		{"unchanged", func() int { return 0 }, 0},
+		{"cursor down", func() int { return 1 }, 2},
+		{"progress changed", func() int { quests[5].Current = 3; return 1 }, 1},
+		{"level-up unlocks a quest", func() int { character.Level = 20; return 1 }, 1},
+		{"a second passes", func() int { started = started.Add(-time.Second); return 1 }, 0},
+	}
+
+	for _, step := range steps {
+		before := cache.renders
+		selected := step.change()
+		got := render(selected)
+		if cache.renders-before != step.renders {
+			t.Errorf("%s: rendered %d cards, want %d", step.name, cache.renders-before, step.renders)
+		}
+		if want := RenderQuestBoard(nil, character, quests, selected, FilterAll, "", 100, 40); got != want {
+			t.Errorf("%s: cached board differs from an uncached render", step.name)
+		}
+	}
+
+	// Removed quests are dropped from the cache
+	quests = quests[:10]
+	render(0)
+	if len(cache.cards) != 10 {
+		t.Errorf("cache holds %d cards after quests were removed, want 10", len(cache.cards))
+	}
+}
+
 // BenchmarkRenderQuestBoard benchmarks the main rendering function.
 func BenchmarkRenderQuestBoard(b *testing.B) {
 	character := game.NewCharacter("BenchHero")
@@ -647,7 +732,23 @@ func BenchmarkRenderQuestBoard(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		RenderQuestBoard(character, quests, 0, FilterAll, 80, 40)
+		RenderQuestBoard(nil, character, quests, 0, FilterAll, "", 80, 40)
+	}
+}
+
+// BenchmarkRenderQuestBoardCached benchmarks moving the cursor through a
+// long quest board with the card cache.
+func BenchmarkRenderQuestBoardCached(b *testing.B) {
+	character := game.NewCharacter("BenchHero")
+	quests := make([]*game.Quest, 300)
+	for i := range quests {
+		quests[i] = createTestQuest("Quest", game.QuestAvailable)
+	}
+	cache := NewQuestCardCache()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		RenderQuestBoard(cache, character, quests, i%len(quests), FilterAll, "", 80, 40)
 	}
 }
 
@@ -0,0 +1,189 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Quest Board's calendar view, which lays out
+// recurring quests over the next few weeks with a selectable day and quest.
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// calendarCellLines is how many event lines a calendar cell shows.
+const calendarCellLines = 2
+
+// calendarEventIcons marks each kind of calendar event.
+var calendarEventIcons = map[game.CalendarEventKind]string{
+	game.CalendarDaily:    "☀",
+	game.CalendarMonthly:  "↻",
+	game.CalendarDeadline: "⚑",
+}
+
+// calendarEventLabels describes each kind of calendar event.
+var calendarEventLabels = map[game.CalendarEventKind]string{
+	game.CalendarDaily:    "Daily",
+	game.CalendarMonthly:  "New month",
+	game.CalendarDeadline: "Due",
+}
+
+// RenderQuestCalendar renders the Quest Board's calendar view.
+//
+// Layout Structure:
+//   - Header: Screen title with character info
+//   - Grid: One row per week (Monday first), each cell listing its quests
+//   - Day panel: Every quest on the selected day, with the selected one marked
+//   - Footer: Key bindings
+//
+// Parameters:
+//   - character: Player character (for header display)
+//   - calendar: Weeks of days from game.QuestCalendar
+//   - today: Current day (as game.StreakClock.Day)
+//   - selectedDay: Index of the selected day, counting from the first cell
+//   - selectedEvent: Index of the selected quest within that day
+//   - project: Project the quests are narrowed to ("" for all)
+//   - width: Terminal width in characters
+//   - height: Terminal height in characters
+//
+// Returns:
+//   - string: Rendered calendar UI
+func RenderQuestCalendar(character *game.Character, calendar [][]game.CalendarDay, today time.Time, selectedDay, selectedEvent int, project string, width, height int) string {
+	header := renderQuestBoardHeader(character, width)
+
+	title := SubtitleStyle.Render(fmt.Sprintf("📅 Calendar · next %d weeks", len(calendar)))
+	if project != "" {
+		title = lipgloss.JoinVertical(lipgloss.Left, title, renderProjectFilter(project))
+	}
+
+	cellWidth := min(max((width-4)/7, 8), 20)
+	grid := renderCalendarGrid(calendar, today, selectedDay, cellWidth)
+
+	var panel string
+	if day := CalendarDayAt(calendar, selectedDay); day != nil {
+		panel = renderCalendarDay(*day, selectedEvent, cellWidth*7)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		title,
+		"",
+		grid,
+		"",
+		panel,
+		"",
+		renderQuestCalendarFooter(width),
+	)
+}
+
+// CalendarDayAt returns the day at an index counting from the first cell,
+// or nil if the index is outside the calendar.
+//
+// Parameters:
+//   - calendar: Weeks of days from game.QuestCalendar
+//   - index: Index of the day
+//
+// Returns:
+//   - *game.CalendarDay: The day (nil when out of range)
+func CalendarDayAt(calendar [][]game.CalendarDay, index int) *game.CalendarDay {
+	if index < 0 || index >= len(calendar)*7 {
+		return nil
+	}
+	return &calendar[index/7][index%7]
+}
+
+// renderCalendarGrid renders the weekday names and one row of cells per week.
+func renderCalendarGrid(calendar [][]game.CalendarDay, today time.Time, selectedDay, cellWidth int) string {
+	cell := lipgloss.NewStyle().Width(cellWidth)
+
+	weekdays := make([]string, 7)
+	for d := range weekdays {
+		weekdays[d] = cell.Render(MutedTextStyle.Render(time.Weekday((d + 1) % 7).String()[:3]))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, weekdays...)}
+
+	for w, week := range calendar {
+		cells := make([]string, len(week))
+		for d, day := range week {
+			cells[d] = renderCalendarCell(day, today, w*7+d == selectedDay, cellWidth)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderCalendarCell renders one day: its date and up to calendarCellLines
+// quests, with a count of the rest.
+func renderCalendarCell(day game.CalendarDay, today time.Time, selected bool, cellWidth int) string {
+	textWidth := cellWidth - 1
+
+	label := fmt.Sprint(day.Date.Day())
+	if day.Date.Day() == 1 {
+		label = day.Date.Format("Jan 2")
+	}
+	labelStyle := TextStyle
+	switch {
+	case day.Date.Equal(today):
+		label += " today"
+		labelStyle = BoldTextStyle.Foreground(ColorAccent)
+	case day.Date.Before(today):
+		labelStyle = DimTextStyle
+	}
+	lines := []string{labelStyle.Render(ansi.Truncate(label, textWidth, ""))}
+
+	for i, event := range day.Events {
+		if i == calendarCellLines-1 && len(day.Events) > calendarCellLines {
+			lines = append(lines, MutedTextStyle.Render(fmt.Sprintf("+%d more", len(day.Events)-i)))
+			break
+		}
+		line := calendarEventIcons[event.Kind] + " " + event.Quest.Title
+		lines = append(lines, ansi.Truncate(line, textWidth, "…"))
+	}
+	for len(lines) < calendarCellLines+1 {
+		lines = append(lines, "")
+	}
+
+	style := lipgloss.NewStyle().Width(cellWidth)
+	if selected {
+		style = style.Background(ColorDim)
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// renderCalendarDay renders every quest on the selected day.
+func renderCalendarDay(day game.CalendarDay, selectedEvent, width int) string {
+	lines := []string{HeadingStyle.Render(day.Date.Format("Monday, Jan 2"))}
+	if len(day.Events) == 0 {
+		lines = append(lines, DimTextStyle.Render("No recurring quests this day."))
+	}
+	for i, event := range day.Events {
+		line := fmt.Sprintf("%s %s: %s", calendarEventIcons[event.Kind], calendarEventLabels[event.Kind], event.Quest.Title)
+		if i == selectedEvent {
+			lines = append(lines, QuestProgressHighlightStyle.Render("▶ "+line))
+		} else {
+			lines = append(lines, TextStyle.Render("  "+line))
+		}
+	}
+	return BoxStyleDim.Width(width).Render(strings.Join(lines, "\n"))
+}
+
+// renderQuestCalendarFooter renders the footer with key bindings.
+func renderQuestCalendarFooter(width int) string {
+	keybinds := strings.Join([]string{
+		renderKeybind("←/→", "Day"),
+		renderKeybind("↑/↓", "Week"),
+		renderKeybind("Tab", "Quest"),
+		renderKeybind("Enter", "View"),
+		renderKeybind("V", "List"),
+		renderKeybind("Esc", "Back"),
+	}, "  ")
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(keybinds)
+}
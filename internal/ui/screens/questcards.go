@@ -0,0 +1,129 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Quest Board's card cache: rendered quest cards
+// are reused until something they show changes, so moving the cursor
+// through hundreds of quests only re-renders the two cards whose selection
+// changed.
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// QuestCardCache memoizes rendered quest cards by quest ID. A card is
+// re-rendered only when its key - everything the card shows, from status
+// and progress to selection, width and elapsed times - changes.
+// A nil cache renders every card every time.
+type QuestCardCache struct {
+	mu      sync.Mutex
+	cards   map[string]cachedQuestCard
+	renders int // Cards rendered (not reused), for tests
+}
+
+// cachedQuestCard is a rendered card and the key it was rendered for.
+type cachedQuestCard struct {
+	key  string
+	card string
+}
+
+// NewQuestCardCache creates an empty quest card cache.
+//
+// Returns:
+//   - *QuestCardCache: The cache, shared by every render of the board
+func NewQuestCardCache() *QuestCardCache {
+	return &QuestCardCache{cards: make(map[string]cachedQuestCard)}
+}
+
+// card returns a quest's rendered card, rendering it only if the cached
+// card is missing or out of date.
+func (c *QuestCardCache) card(character *game.Character, quest *game.Quest, selected bool, width int) string {
+	if c == nil {
+		return renderQuestCard(character, quest, selected, width)
+	}
+
+	key := questCardKey(character, quest, selected, width)
+	c.mu.Lock()
+	cached, ok := c.cards[quest.ID]
+	c.mu.Unlock()
+	if ok && cached.key == key {
+		return cached.card
+	}
+
+	card := renderQuestCard(character, quest, selected, width)
+	c.mu.Lock()
+	c.cards[quest.ID] = cachedQuestCard{key: key, card: card}
+	c.renders++
+	c.mu.Unlock()
+	return card
+}
+
+// prune drops the cards of quests that are gone from the quest log, so the
+// cache doesn't grow as quests are removed.
+func (c *QuestCardCache) prune(quests []*game.Quest) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.cards) <= len(quests) {
+		return
+	}
+	present := make(map[string]bool, len(quests))
+	for _, quest := range quests {
+		present[quest.ID] = true
+	}
+	for id := range c.cards {
+		if !present[id] {
+			delete(c.cards, id)
+		}
+	}
+}
+
+// cardElapsed formats the time since an instant as a quest card shows it:
+// to the second for the first minute, then to the minute, so a card's
+// cached render stays valid for a minute at a time.
+func cardElapsed(since time.Time) string {
+	elapsed := time.Since(since)
+	if elapsed >= time.Minute {
+		elapsed = elapsed.Truncate(time.Minute)
+	}
+	return formatDuration(elapsed)
+}
+
+// questCardKey describes everything a quest card shows. Elapsed times are
+// included as the card displays them (see cardElapsed).
+func questCardKey(character *game.Character, quest *game.Quest, selected bool, width int) string {
+	parts := []string{
+		fmt.Sprint(selected, width),
+		string(quest.Status),
+		string(quest.Type),
+		fmt.Sprint(quest.Current, quest.Target, quest.XPReward, quest.RequiredLevel),
+		quest.Title,
+		quest.Description,
+		quest.Project,
+	}
+	if quest.StartedAt != nil {
+		parts = append(parts, cardElapsed(*quest.StartedAt))
+	}
+	if quest.CompletedAt != nil {
+		parts = append(parts, cardElapsed(*quest.CompletedAt))
+	}
+	if quest.StartedAt != nil && quest.CompletedAt != nil {
+		parts = append(parts, formatDuration(quest.CompletedAt.Sub(*quest.StartedAt)))
+	}
+	if quest.Reward != nil {
+		parts = append(parts, fmt.Sprint(quest.Reward.Kind, quest.Reward.XP))
+		if quest.Reward.Breakdown != nil {
+			parts = append(parts, quest.Reward.Breakdown.String())
+		}
+	}
+	if quest.Status == game.QuestAvailable && character != nil {
+		parts = append(parts, quest.LockReasons(character)...)
+	}
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,223 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Quest Detail view, opened from the Quest Board,
+// which shows a single quest along with its reminder and the player's
+// markdown notes.
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// RenderQuestDetail renders the detail view for a single quest.
+//
+// Layout Structure:
+//   - Header: Screen title with character info
+//   - Quest summary: Title, type badge, description, status-specific info, reminder
+//   - Notes: Markdown notes rendered with glamour, or the inline editor
+//   - Footer: Key bindings (differs while editing)
+//
+// Parameters:
+//   - character: Player character (for header display)
+//   - quest: The quest to display (nil renders an empty state)
+//   - notesEditor: Rendered notes editor view; empty when not editing
+//   - reminderInput: Rendered reminder input; empty when not editing the reminder
+//   - width: Terminal width in characters
+//   - height: Terminal height in characters
+//
+// Returns:
+//   - string: Rendered quest detail UI
+func RenderQuestDetail(character *game.Character, quest *game.Quest, notesEditor, reminderInput string, width, height int) string {
+	header := renderQuestBoardHeader(character, width)
+
+	if quest == nil {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			header,
+			"",
+			placeInCenter(width, height-6, MutedTextStyle.Render("No quest selected")),
+		)
+	}
+
+	contentWidth := width - 6
+	if contentWidth < 30 {
+		contentWidth = 30
+	}
+
+	summary := renderQuestDetailSummary(character, quest, contentWidth)
+	if reminder := renderQuestReminder(quest, reminderInput); reminder != "" {
+		summary = lipgloss.JoinVertical(lipgloss.Left, summary, "", reminder)
+	}
+	notes := renderQuestNotes(quest, notesEditor, contentWidth)
+	footer := renderQuestDetailFooter(quest, notesEditor != "", reminderInput != "", width)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		"",
+		BoxStyle.Width(width-4).Render(summary),
+		BoxStyle.Width(width-4).Render(notes),
+		"",
+		footer,
+	)
+}
+
+// renderQuestDetailSummary renders the quest's title, description and status info.
+func renderQuestDetailSummary(character *game.Character, quest *game.Quest, width int) string {
+	title := TitleStyle.Render(quest.Title) + " " + renderQuestTypeBadge(quest.Type)
+	desc := TextStyle.Render(wrapText(quest.Description, width))
+
+	var statusContent string
+	switch quest.Status {
+	case game.QuestAvailable:
+		statusContent = renderAvailableQuestInfo(character, quest)
+	case game.QuestActive:
+		barWidth := width - 20
+		if barWidth < 10 {
+			barWidth = 10
+		}
+		statusContent = renderActiveQuestInfo(quest, barWidth)
+	case game.QuestCompleted:
+		statusContent = renderCompletedQuestInfo(quest)
+	default:
+		statusContent = MutedTextStyle.Render(fmt.Sprintf("Status: %s", quest.Status))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		desc,
+		"",
+		statusContent,
+	)
+}
+
+// renderQuestReminder renders the quest's reminder, or the input while
+// it's being edited. Returns "" for quests without a reminder.
+func renderQuestReminder(quest *game.Quest, reminderInput string) string {
+	label := "⏰ " + StatLabelStyle.Render("Reminder: ")
+	if reminderInput != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, label, reminderInput)
+	}
+
+	reminder := quest.Reminder
+	switch {
+	case reminder == nil:
+		return ""
+	case reminder.Disabled:
+		return label + MutedTextStyle.Render(reminder.String()+" (off)")
+	case reminder.SnoozedUntil.After(time.Now()):
+		return label + StatValueStyle.Render(reminder.String()) +
+			MutedTextStyle.Render(" · snoozed until "+reminder.SnoozedUntil.Format("15:04"))
+	}
+	return label + StatValueStyle.Render(reminder.String())
+}
+
+// renderQuestNotes renders the notes section, or the editor while editing.
+func renderQuestNotes(quest *game.Quest, notesEditor string, width int) string {
+	title := SubtitleStyle.Render("📓 Notes")
+
+	if notesEditor != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", notesEditor)
+	}
+
+	if quest.Notes == "" {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			"",
+			MutedTextStyle.Render("No notes yet."),
+			InfoTextStyle.Render("Jot down why this quest exists and what you learned."),
+		)
+	}
+
+	body := RenderMarkdown(quest.Notes, width)
+
+	updated := ""
+	if quest.NotesUpdatedAt != nil {
+		updated = MutedTextStyle.Render("Last edited " + formatTime(*quest.NotesUpdatedAt))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", body, updated)
+}
+
+// renderQuestDetailFooter renders key bindings for the detail view.
+// Start or abandon is offered depending on the quest's status, daily
+// quests can be rerolled, and a set reminder can be snoozed or turned off.
+func renderQuestDetailFooter(quest *game.Quest, editing, editingReminder bool, width int) string {
+	var keybinds string
+	switch {
+	case editingReminder:
+		keybinds = lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			renderKeybind("Enter", "Save Reminder (empty removes it)"),
+			"  ",
+			renderKeybind("Esc", "Cancel"),
+		)
+	case editing:
+		keybinds = lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			renderKeybind("Ctrl+S", "Save Notes"),
+			"  ",
+			renderKeybind("Esc", "Discard"),
+		)
+	default:
+		var lifecycle string
+		switch quest.Status {
+		case game.QuestAvailable:
+			lifecycle = renderKeybind("S", "Start") + "  "
+		case game.QuestActive:
+			lifecycle = renderKeybind("A", "Abandon") + "  "
+		}
+		if quest.Type == game.QuestTypeDaily && (quest.Status == game.QuestAvailable || quest.Status == game.QuestActive) {
+			lifecycle += renderKeybind("R", fmt.Sprintf("Reroll (%d Effort)", game.RerollCost)) + "  "
+		}
+		lifecycle += renderKeybind("M", "Reminder") + "  "
+		if quest.Reminder != nil {
+			if !quest.Reminder.Disabled {
+				lifecycle += renderKeybind("Z", "Snooze") + "  "
+			}
+			lifecycle += renderKeybind("X", "Reminder On/Off") + "  "
+		}
+
+		keybinds = lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			lifecycle,
+			renderKeybind("N", "Edit Notes"),
+			"  ",
+			renderKeybind("E", "$EDITOR"),
+			"  ",
+			renderKeybind("Esc", "Back"),
+		)
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(keybinds)
+}
+
+// SelectedQuest returns the quest shown at selectedIndex on the Quest Board.
+// The board lists pinned quests, then Available, Active and Completed
+// quests, so the index refers to that display order (see BoardQuests)
+// rather than the raw slice order.
+//
+// Parameters:
+//   - quests: All quests (already narrowed to a project, if any)
+//   - filter: Current Quest Board filter
+//   - selectedIndex: Highlighted row index
+//
+// Returns:
+//   - *game.Quest: The selected quest, or nil if the index is out of range
+func SelectedQuest(quests []*game.Quest, filter QuestFilter, selectedIndex int) *game.Quest {
+	ordered := BoardQuests(quests, filter)
+	if selectedIndex < 0 || selectedIndex >= len(ordered) {
+		return nil
+	}
+	return ordered[selectedIndex]
+}
@@ -0,0 +1,112 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRenderQuestDetail tests the quest detail view with and without notes
+// and reminders.
+func TestRenderQuestDetail(t *testing.T) {
+	character := game.NewCharacter("TestHero")
+
+	withNotes := createTestQuest("Refactor Parser", game.QuestActive)
+	withNotes.SetNotes("## Why\n\nThe parser is **slow**.")
+
+	withReminder := createTestQuest("Ship It", game.QuestActive)
+	if err := withReminder.SetReminder("4pm <50%"); err != nil {
+		t.Fatalf("SetReminder() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		quest        *game.Quest
+		editor       string
+		reminder     string
+		wantContains []string
+	}{
+		{
+			name:         "nil quest",
+			quest:        nil,
+			wantContains: []string{"No quest selected"},
+		},
+		{
+			name:         "no notes",
+			quest:        createTestQuest("Write Tests", game.QuestAvailable),
+			wantContains: []string{"Write Tests", "Notes", "No notes yet", "Edit Notes", "Start"},
+		},
+		{
+			name:         "markdown notes",
+			quest:        withNotes,
+			wantContains: []string{"Refactor Parser", "Why", "slow", "Last edited", "Abandon"},
+		},
+		{
+			name:         "editing",
+			quest:        withNotes,
+			editor:       "EDITOR-VIEW",
+			wantContains: []string{"EDITOR-VIEW", "Save Notes", "Discard"},
+		},
+		{
+			name:         "reminder",
+			quest:        withReminder,
+			wantContains: []string{"Reminder:", "16:00 if under 50%", "Snooze", "Reminder On/Off"},
+		},
+		{
+			name:         "editing reminder",
+			quest:        withReminder,
+			reminder:     "REMINDER-INPUT",
+			wantContains: []string{"REMINDER-INPUT", "Save Reminder", "Cancel"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RenderQuestDetail(character, tt.quest, tt.editor, tt.reminder, 100, 40)
+			for _, want := range tt.wantContains {
+				if !strings.Contains(result, want) {
+					t.Errorf("RenderQuestDetail() missing %q", want)
+				}
+			}
+		})
+	}
+}
+
+// TestSelectedQuest tests that selection follows the board's grouped order,
+// with pinned quests first.
+func TestSelectedQuest(t *testing.T) {
+	completed := createTestQuest("Done", game.QuestCompleted)
+	active := createTestQuest("Doing", game.QuestActive)
+	available := createTestQuest("Todo", game.QuestAvailable)
+	pinned := createTestQuest("Favorite", game.QuestCompleted)
+	pinned.Pinned = true
+	quests := []*game.Quest{completed, active, available}
+	withPinned := []*game.Quest{completed, active, available, pinned}
+
+	tests := []struct {
+		name   string
+		quests []*game.Quest
+		filter QuestFilter
+		index  int
+		want   *game.Quest
+	}{
+		{"first row is available", quests, FilterAll, 0, available},
+		{"second row is active", quests, FilterAll, 1, active},
+		{"third row is completed", quests, FilterAll, 2, completed},
+		{"filtered index", quests, FilterCompleted, 0, completed},
+		{"out of range", quests, FilterAll, 3, nil},
+		{"negative", quests, FilterAll, -1, nil},
+		{"pinned quest is first", withPinned, FilterAll, 0, pinned},
+		{"pinned quest leaves its section", withPinned, FilterAll, 3, completed},
+		{"pinned quest respects filter", withPinned, FilterCompleted, 0, pinned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SelectedQuest(tt.quests, tt.filter, tt.index); got != tt.want {
+				t.Errorf("SelectedQuest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
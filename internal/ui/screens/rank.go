@@ -0,0 +1,46 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file renders the character's rank, shown next to the level, and the
+// progress bar toward the next rank.
+package screens
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// RankStyle renders rank names and the rank progress bar
+var RankStyle = lipgloss.NewStyle().Foreground(ColorMagic).Bold(true)
+
+// renderLevelWithRank renders the level value followed by the rank held at
+// it, e.g. "6 · 🛠️ Journeyman".
+func renderLevelWithRank(character *game.Character) string {
+	level := lipgloss.NewStyle().
+		Foreground(ColorLevel).
+		Bold(true).
+		Render(fmt.Sprintf("%d", character.Level))
+	return level + MutedTextStyle.Render(" · ") + RankStyle.Render(character.Rank().String())
+}
+
+// renderRankProgress renders the bar toward the next rank with the levels
+// still to go, or a note once the final rank is reached.
+//
+// Parameters:
+//   - character: Player character
+//   - width: Bar width in characters
+//
+// Returns:
+//   - string: The rank progress line
+func renderRankProgress(character *game.Character, width int) string {
+	label := StatLabelStyle.Render("Rank: ")
+	next, ok := game.NextRank(character.Level)
+	if !ok {
+		return label + RankStyle.Render("Highest rank reached")
+	}
+
+	const scale = 1000
+	bar := renderProgressBar(int(character.RankProgress()*scale), scale, max(width, 10), "rank")
+	return label + bar + MutedTextStyle.Render(fmt.Sprintf(" %s at Lvl %d", next.String(), next.MinLevel))
+}
@@ -0,0 +1,245 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements global search (Ctrl+F): fuzzy matching across quest
+// titles, quest notes, the activity feed and the mentor chat, and the
+// results popover grouped by where each match was found.
+package screens
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// fuzzyMaxSpread bounds how spread out a fuzzy match may be, as a multiple
+// of the query length, so a short query doesn't match every long note.
+const fuzzyMaxSpread = 3
+
+// searchGroupLimit is the most results shown per group.
+const searchGroupLimit = 5
+
+// SearchKind is where a search result was found.
+type SearchKind int
+
+const (
+	// SearchQuest matches a quest title
+	SearchQuest SearchKind = iota
+	// SearchJournal matches a line of a quest's notes
+	SearchJournal
+	// SearchActivity matches an activity feed entry
+	SearchActivity
+	// SearchChat matches a line of a mentor chat message
+	SearchChat
+)
+
+// searchGroupTitles are the result group headings, by kind.
+var searchGroupTitles = map[SearchKind]string{
+	SearchQuest:    "📜 Quests",
+	SearchJournal:  "📓 Journal",
+	SearchActivity: "📰 Activity",
+	SearchChat:     "💬 Mentor Chat",
+}
+
+// SearchSources is everything global search looks through.
+type SearchSources struct {
+	Quests   []*game.Quest  // Quest titles and notes
+	Activity []ActivityItem // Activity feed, oldest first
+	Chat     []Message      // Mentor chat history, oldest first
+}
+
+// SearchResult is one match, with what's needed to jump to it.
+type SearchResult struct {
+	Kind    SearchKind
+	Title   string // What matched (quest title, activity text, chat line)
+	Detail  string // Context (matching notes line, when it happened, who said it)
+	QuestID string // Quest to open (quest and journal results)
+	Index   int    // Activity item or chat message index (activity and chat results)
+	score   int
+}
+
+// FuzzyScore scores how well a query matches text. Every query character
+// must appear in the text in order, ignoring case; consecutive characters
+// and characters at the start of a word score higher, and gaps cost a point
+// each. Matches spread wider than fuzzyMaxSpread times the query fail.
+//
+// Parameters:
+//   - query: What the player typed
+//   - text: Text to match against
+//
+// Returns:
+//   - int: Match quality (higher is better)
+//   - bool: False if the text doesn't match
+func FuzzyScore(query, text string) (int, bool) {
+	q := []rune(strings.ToLower(strings.TrimSpace(query)))
+	t := []rune(strings.ToLower(text))
+	if len(q) == 0 {
+		return 0, false
+	}
+
+	best, found := 0, false
+	for start := range t {
+		if t[start] != q[0] {
+			continue
+		}
+
+		score, matched, prev := 0, 0, -2
+		for i := start; i < len(t) && matched < len(q); i++ {
+			if t[i] != q[matched] {
+				continue
+			}
+			score++
+			if i == prev+1 {
+				score += 5
+			}
+			if i == 0 || !unicode.IsLetter(t[i-1]) && !unicode.IsDigit(t[i-1]) {
+				score += 3
+			}
+			prev = i
+			matched++
+		}
+		if matched < len(q) {
+			break // Later starts see even less of the text
+		}
+
+		span := prev - start + 1
+		if span > len(q)*fuzzyMaxSpread {
+			continue
+		}
+		score -= span - len(q)
+		if !found || score > best {
+			best, found = score, true
+		}
+	}
+	return best, found
+}
+
+// bestLine returns the best matching line of multi-line text.
+func bestLine(query, text string) (string, int, bool) {
+	bestText, best, found := "", 0, false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if score, ok := FuzzyScore(query, line); ok && (!found || score > best) {
+			bestText, best, found = line, score, true
+		}
+	}
+	return bestText, best, found
+}
+
+// Search finds matches for a query in every source. Results are grouped
+// as Quests, Journal, Activity, then Mentor Chat, best match first within
+// each group (most recent first on ties), at most searchGroupLimit each.
+//
+// Parameters:
+//   - query: What the player typed ("" finds nothing)
+//   - sources: Quests, activity and chat to search
+//
+// Returns:
+//   - []SearchResult: Matches in display order
+func Search(query string, sources SearchSources) []SearchResult {
+	if strings.TrimSpace(query) == "" {
+		return nil
+	}
+
+	groups := make(map[SearchKind][]SearchResult)
+	add := func(result SearchResult) {
+		groups[result.Kind] = append(groups[result.Kind], result)
+	}
+
+	for _, quest := range sources.Quests {
+		if score, ok := FuzzyScore(query, quest.Title); ok {
+			add(SearchResult{Kind: SearchQuest, Title: quest.Title, Detail: string(quest.Status), QuestID: quest.ID, score: score})
+		}
+		if line, score, ok := bestLine(query, quest.Notes); ok {
+			add(SearchResult{Kind: SearchJournal, Title: line, Detail: quest.Title, QuestID: quest.ID, score: score})
+		}
+	}
+
+	// Newest first, so the stable sort keeps recent entries ahead on ties
+	for i := len(sources.Activity) - 1; i >= 0; i-- {
+		item := sources.Activity[i]
+		if score, ok := FuzzyScore(query, item.Text); ok {
+			add(SearchResult{Kind: SearchActivity, Title: item.Icon + " " + item.Text, Detail: formatTime(item.At), Index: i, score: score})
+		}
+	}
+	for i := len(sources.Chat) - 1; i >= 0; i-- {
+		message := sources.Chat[i]
+		if line, score, ok := bestLine(query, message.Content); ok {
+			add(SearchResult{Kind: SearchChat, Title: line, Detail: chatSpeaker(message) + ", " + formatTime(message.Timestamp), Index: i, score: score})
+		}
+	}
+
+	var results []SearchResult
+	for _, kind := range []SearchKind{SearchQuest, SearchJournal, SearchActivity, SearchChat} {
+		group := groups[kind]
+		sort.SliceStable(group, func(i, j int) bool { return group[i].score > group[j].score })
+		results = append(results, group[:min(len(group), searchGroupLimit)]...)
+	}
+	return results
+}
+
+// chatSpeaker names who wrote a chat message.
+func chatSpeaker(message Message) string {
+	switch message.Role {
+	case "user":
+		return "You"
+	case "assistant":
+		if message.Provider != "" {
+			return message.Provider
+		}
+		return "Mentor"
+	default:
+		return "System"
+	}
+}
+
+// RenderSearch renders the global search popover: the query input and the
+// results grouped by where they were found.
+//
+// Parameters:
+//   - input: Rendered query input
+//   - query: Current query text
+//   - results: Matches in display order (from Search)
+//   - selected: Index of the highlighted result
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The popover, centered on the screen
+func RenderSearch(input, query string, results []SearchResult, selected, width, height int) string {
+	boxWidth := max(min(width-4, 90), 30)
+	textWidth := boxWidth - 4 // Inside the padding
+
+	rows := []string{TitleStyle.Render("🔍 Search"), input}
+	switch {
+	case strings.TrimSpace(query) == "":
+		rows = append(rows, "", MutedTextStyle.Render("Quests, journal notes, activity and mentor chat"))
+	case len(results) == 0:
+		rows = append(rows, "", MutedTextStyle.Render("No matches"))
+	}
+
+	for i, result := range results {
+		if i == 0 || results[i-1].Kind != result.Kind {
+			rows = append(rows, SubtitleStyle.Render(searchGroupTitles[result.Kind]))
+		}
+
+		marker, title := "  ", TextStyle.Render(result.Title)
+		if i == selected {
+			marker = lipgloss.NewStyle().Foreground(ColorXP).Render("▸ ")
+			title = lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(result.Title)
+		}
+		line := marker + title
+		if result.Detail != "" {
+			line += "  " + DimTextStyle.Render(result.Detail)
+		}
+		rows = append(rows, ansi.Truncate(line, textWidth, "…"))
+	}
+
+	rows = append(rows, "", DimTextStyle.Render("[↑/↓] Select  [Enter] Go to  [Esc] Close"))
+
+	card := BoxStyle.Width(boxWidth).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, card)
+}
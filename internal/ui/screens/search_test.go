@@ -0,0 +1,93 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file tests global search matching and result grouping.
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestFuzzyScore tests subsequence matching and how matches are ranked.
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		text      string
+		wantMatch bool
+	}{
+		{"substring", "bug", "Bug Hunt", true},
+		{"initials", "bh", "Bug Hunt", true},
+		{"out of order", "hb", "Bug Hunt", false},
+		{"too spread out", "ab", "a long way from here to b", false},
+		{"empty query", "  ", "Bug Hunt", false},
+		{"missing character", "bugz", "Bug Hunt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := FuzzyScore(tt.query, tt.text); ok != tt.wantMatch {
+				t.Errorf("FuzzyScore(%q, %q) matched = %v, want %v", tt.query, tt.text, ok, tt.wantMatch)
+			}
+		})
+	}
+
+	exact, _ := FuzzyScore("hunt", "Bug Hunt")
+	scattered, _ := FuzzyScore("hunt", "Hurry until noon tomorrow")
+	if exact <= scattered {
+		t.Errorf("consecutive match scored %d, want more than scattered %d", exact, scattered)
+	}
+}
+
+// TestSearch tests that results come from every source, grouped in order
+// and capped per group.
+func TestSearch(t *testing.T) {
+	now := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	quests := []*game.Quest{
+		{ID: "q1", Title: "Refactor parser", Status: game.QuestActive, Notes: "todo:\n- split the lexer\n- parser tests"},
+		{ID: "q2", Title: "Write docs", Status: game.QuestAvailable},
+	}
+	sources := SearchSources{
+		Quests:   quests,
+		Activity: []ActivityItem{{At: now, Icon: "📝", Text: "Commit: fix parser crash"}},
+		Chat: []Message{
+			{Role: "user", Content: "How do I test a parser?", Timestamp: now},
+			{Role: "assistant", Content: "Start small.\nTable-driven parser tests work well.", Provider: "Claude", Timestamp: now},
+		},
+	}
+
+	results := Search("parser", sources)
+	var got []string
+	for _, result := range results {
+		got = append(got, fmt.Sprintf("%d:%s", result.Kind, result.Title))
+	}
+	want := []string{
+		fmt.Sprintf("%d:Refactor parser", SearchQuest),
+		fmt.Sprintf("%d:- parser tests", SearchJournal),
+		fmt.Sprintf("%d:📝 Commit: fix parser crash", SearchActivity),
+		fmt.Sprintf("%d:Table-driven parser tests work well.", SearchChat),
+		fmt.Sprintf("%d:How do I test a parser?", SearchChat),
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Search() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if results[1].QuestID != "q1" || results[3].Index != 1 || results[3].Detail != "Claude, "+formatTime(now) {
+		t.Errorf("results don't point back at their source: %+v", results)
+	}
+
+	if results := Search("", sources); len(results) != 0 {
+		t.Errorf("empty query found %d results", len(results))
+	}
+
+	var many []ActivityItem
+	for i := range searchGroupLimit + 3 {
+		many = append(many, ActivityItem{At: now, Text: fmt.Sprintf("Commit %d", i)})
+	}
+	results = Search("commit", SearchSources{Activity: many})
+	if len(results) != searchGroupLimit || results[0].Index != len(many)-1 {
+		t.Errorf("got %d activity results starting at %d, want %d starting with the newest", len(results), results[0].Index, searchGroupLimit)
+	}
+}
@@ -0,0 +1,174 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the Season Pass screen: the season's reward tiers on
+// a horizontal track that scrolls to keep the selected tier in view, the
+// seasonal XP earned toward them and the selected tier's claim status.
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// seasonTierCardWidth is the width of one tier card on the track, border
+// included.
+const seasonTierCardWidth = 16
+
+// seasonRewardIcons marks each reward kind on the track.
+var seasonRewardIcons = map[string]string{
+	game.SeasonRewardTitle:        "📜",
+	game.SeasonRewardCosmetic:     "🎨",
+	game.SeasonRewardStreakFreeze: "🧊",
+}
+
+// seasonRewardLabels describes each reward kind under the track.
+var seasonRewardLabels = map[string]string{
+	game.SeasonRewardTitle:        "title",
+	game.SeasonRewardCosmetic:     "cosmetic",
+	game.SeasonRewardStreakFreeze: "streak freeze",
+}
+
+// RenderSeasonPass renders the Season Pass screen.
+//
+// Parameters:
+//   - pass: The character's progress on the current season's track
+//   - selected: Index of the selected tier in pass.Tiers
+//   - width: Terminal width
+//   - height: Terminal height
+//
+// Returns:
+//   - string: The rendered screen
+func RenderSeasonPass(pass game.SeasonPass, selected, width, height int) string {
+	heading := TitleStyle.Render("🎟️  Season Pass · " + pass.Season)
+	remaining := MutedTextStyle.Render(fmt.Sprintf("%d days left in the season", pass.DaysLeft))
+
+	lines := []string{heading, remaining, "", renderSeasonXP(pass, width), ""}
+	lines = append(lines, renderSeasonTrack(pass.Tiers, selected, width), "")
+	if selected >= 0 && selected < len(pass.Tiers) {
+		lines = append(lines, renderSeasonTierDetail(pass.Tiers[selected], pass.XP))
+	}
+	if claimable := pass.Claimable(); claimable > 0 {
+		lines = append(lines, "", SuccessTextStyle.Render(fmt.Sprintf("🎁 %d reward(s) ready to claim", claimable)))
+	}
+
+	footer := lipgloss.JoinHorizontal(lipgloss.Left,
+		renderKeybind("←/→", "Select tier"), "  ",
+		renderKeybind("Enter", "Claim/Equip"), "  ",
+		renderKeybind("Esc", "Back"),
+	)
+	lines = append(lines, "",
+		MutedTextStyle.Render("Seasonal XP resets each quarter; unclaimed rewards go with it."),
+		"", footer)
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// renderSeasonXP renders the seasonal XP bar toward the next tier.
+func renderSeasonXP(pass game.SeasonPass, width int) string {
+	label := StatLabelStyle.Render("Season XP: ")
+	for _, tier := range pass.Tiers {
+		if !tier.Unlocked {
+			return label + renderProgressBar(pass.XP, tier.XP, max(width-50, 10), "xp") +
+				MutedTextStyle.Render(fmt.Sprintf("  to tier %d", tier.Tier))
+		}
+	}
+	return label + StatValueStyle.Render(fmt.Sprintf("%d", pass.XP)) +
+		SuccessTextStyle.Render("  · every tier unlocked")
+}
+
+// renderSeasonTrack renders the tier cards side by side, scrolled so the
+// selected tier is in view, with arrows where more tiers are off screen.
+//
+// Parameters:
+//   - tiers: Every tier, in track order
+//   - selected: Index of the selected tier
+//   - width: Width available for the track
+//
+// Returns:
+//   - string: The rendered track
+func renderSeasonTrack(tiers []game.SeasonTierStatus, selected, width int) string {
+	if len(tiers) == 0 {
+		return ""
+	}
+	first, last := seasonTrackWindow(len(tiers), selected, width)
+
+	cards := make([]string, 0, last-first+2)
+	more := DimTextStyle.Render(" ◀ ")
+	if first == 0 {
+		more = "   "
+	}
+	cards = append(cards, lipgloss.PlaceVertical(6, lipgloss.Center, more))
+	for i := first; i < last; i++ {
+		cards = append(cards, renderSeasonTierCard(tiers[i], i == selected))
+	}
+	more = DimTextStyle.Render(" ▶ ")
+	if last == len(tiers) {
+		more = "   "
+	}
+	cards = append(cards, lipgloss.PlaceVertical(6, lipgloss.Center, more))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, cards...)
+}
+
+// seasonTrackWindow returns the range of tiers [first, last) that fit in
+// the width, centered on the selected tier where possible.
+func seasonTrackWindow(count, selected, width int) (int, int) {
+	visible := min(max((width-10)/seasonTierCardWidth, 1), count)
+	first := min(max(selected-visible/2, 0), count-visible)
+	return first, first + visible
+}
+
+// renderSeasonTierCard renders one tier: its number, reward, XP needed and
+// whether it is locked, claimable or claimed.
+func renderSeasonTierCard(tier game.SeasonTierStatus, selected bool) string {
+	border := ColorDim
+	var status string
+	switch {
+	case tier.Claimed:
+		status = SuccessTextStyle.Render("✓ Claimed")
+	case tier.Unlocked:
+		border = ColorXP
+		status = WarningTextStyle.Render("★ Claim")
+	default:
+		status = DimTextStyle.Render("🔒 Locked")
+	}
+	if selected {
+		border = ColorPrimary
+	}
+
+	name := truncateRunes(tier.Name, seasonTierCardWidth-7)
+	body := lipgloss.JoinVertical(lipgloss.Left,
+		BoldTextStyle.Render(fmt.Sprintf("Tier %d", tier.Tier)),
+		seasonRewardIcons[tier.Kind]+" "+name,
+		MutedTextStyle.Render(fmt.Sprintf("%d XP", tier.XP)),
+		status,
+	)
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Width(seasonTierCardWidth-2).
+		Padding(0, 1).
+		Render(body)
+}
+
+// renderSeasonTierDetail describes the selected tier and what Enter does.
+func renderSeasonTierDetail(tier game.SeasonTierStatus, xp int) string {
+	title := BoldTextStyle.Render(fmt.Sprintf("Tier %d: %s", tier.Tier, tier.Name)) +
+		MutedTextStyle.Render(" ("+seasonRewardLabels[tier.Kind]+")")
+
+	var hint string
+	switch {
+	case tier.Claimed && tier.Kind == game.SeasonRewardTitle:
+		hint = InfoTextStyle.Render("Claimed · Enter equips the title")
+	case tier.Claimed:
+		hint = SuccessTextStyle.Render("Claimed")
+	case tier.Unlocked:
+		hint = WarningTextStyle.Render("Unlocked · Enter claims it")
+	default:
+		hint = DimTextStyle.Render(fmt.Sprintf("%d more season XP to unlock", tier.XP-xp))
+	}
+	return strings.Join([]string{title, hint}, "\n")
+}
@@ -0,0 +1,65 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestRenderSeasonPass tests the track's tier states and the selected
+// tier's detail.
+func TestRenderSeasonPass(t *testing.T) {
+	clock := game.StreakClock{Location: time.UTC}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	c := game.NewCharacter("Tester")
+	c.Season, c.SeasonXP, c.SeasonClaimed = "2026-Q4", 300, []int{1}
+	pass := c.SeasonPassAt(now, clock)
+
+	tests := []struct {
+		name     string
+		selected int
+		want     []string
+	}{
+		{"claimable tier", 1, []string{"Season Pass · 2026-Q4", "✓ Claimed", "★ Claim", "Unlocked · Enter claims it", "1 reward(s) ready to claim"}},
+		{"locked tier", 2, []string{"🔒 Locked", "200 more season XP to unlock"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderSeasonPass(pass, tt.selected, 120, 40)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("RenderSeasonPass() missing %q", want)
+				}
+			}
+		})
+	}
+}
+
+// TestSeasonTrackWindow tests that the track scrolls to keep the selected
+// tier in view.
+func TestSeasonTrackWindow(t *testing.T) {
+	tests := []struct {
+		name                string
+		count, selected     int
+		width               int
+		wantFirst, wantLast int
+	}{
+		{"everything fits", 10, 9, 200, 0, 10},
+		{"start of the track", 10, 0, 60, 0, 3},
+		{"centered on the selection", 10, 5, 60, 4, 7},
+		{"end of the track", 10, 9, 60, 7, 10},
+		{"too narrow for more than one", 10, 4, 20, 4, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last := seasonTrackWindow(tt.count, tt.selected, tt.width)
+			if first != tt.wantFirst || last != tt.wantLast {
+				t.Errorf("seasonTrackWindow() = [%d, %d), want [%d, %d)", first, last, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
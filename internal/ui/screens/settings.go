@@ -4,11 +4,16 @@ package screens
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/AutumnsGrove/codequest/internal/config"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
 )
 
 // SettingsCategory represents different categories of settings.
@@ -27,36 +32,66 @@ const (
 	CategoryDebug
 )
 
+// StorageData is what the Settings screen shows about stored data.
+type StorageData struct {
+	Usage     []storage.KeyUsage     // Stored size per key (nil while loading)
+	Retention config.RetentionConfig // Retention limits, defaults filled in
+}
+
+// DiscordSettings is what the Settings screen shows about Discord sharing.
+type DiscordSettings struct {
+	Configured bool     // A webhook URL is set
+	Enabled    bool     // Events are shared as they happen
+	Events     []string // Events shared (quest_done, level_up)
+	Sending    bool     // A test message is on its way
+}
+
 // RenderSettings renders the complete settings screen.
-// This screen displays all configuration options in a read-only format.
+// The dashboard widget list, the character profile, the experimental
+// feature flags, the Discord test message, the XP rules tester and the
+// late-night warnings switch are interactive; other options are shown read-only and changed in the config
+// file.
 //
 // The settings screen shows:
 //   - Header with character info
-//   - Settings categories (Game, UI, AI, Git, Debug)
+//   - Dashboard widgets with their visibility and order
+//   - Character profile fields (motto, pronouns, avatar seed, favorite language)
+//   - Experimental feature flags
+//   - Integrations (Discord sharing and its test message)
+//   - Custom XP rules and their dry-run tester
+//   - Sleep hygiene (late-night warnings and the Well Rested track)
+//   - Settings categories (Game, UI, AI, Git, Storage, Debug)
 //   - Current values for all configuration options
-//   - Navigation hints for changing settings (future feature)
 //
 // Layout Structure:
 //   - Header: Screen title with character info
 //   - Main panel: All settings grouped by category
 //   - Footer: Key bindings
 //
-// Note: Settings modification is not yet implemented (read-only for MVP).
-// Future versions will add interactive editing capabilities.
-//
 // Parameters:
 //   - character: Player character (for header display)
+//   - watcherMetrics: Live git watcher telemetry for the debug section (nil hides it)
+//   - storageData: Storage usage and retention limits
+//   - widgets: Enabled dashboard widget IDs in display order
+//   - selected: Highlighted row: a widget (see WidgetSettingsOrder), then the
+//     profile fields (see game.ProfileFields), then the feature flags (see config.Features),
+//     then the Discord test message, then the XP rules tester, then the late-night warnings
+//   - profileEditor: Rendered input for the profile field being edited ("" when not editing)
+//   - experimental: Feature flags by ID (unset means off)
+//   - discord: Discord sharing status
+//   - xpRules: Custom XP rules and the last dry run
+//   - sleep: Late-night warnings and rested days
 //   - width: Terminal width in characters
 //   - height: Terminal height in characters
 //
 // Returns:
 //   - string: Rendered settings screen UI
-func RenderSettings(character *game.Character, width, height int) string {
+func RenderSettings(character *game.Character, watcherMetrics []watcher.WatcherMetrics, storageData StorageData, widgets []string, selected int, profileEditor string, experimental map[string]bool, discord DiscordSettings, xpRules XPRulesSettings, sleep SleepSettings, width, height int) string {
 	// Render header (inline to avoid import cycle)
 	header := renderSettingsHeader(character, width)
 
 	// Render main settings panel
-	settingsPanel := renderSettingsPanel(width)
+	settingsPanel := renderSettingsPanel(character, watcherMetrics, storageData, widgets, selected, profileEditor, experimental, discord, xpRules, sleep, width)
 
 	// Render footer with key bindings
 	footer := renderSettingsFooter(width)
@@ -74,9 +109,33 @@ func RenderSettings(character *game.Character, width, height int) string {
 }
 
 // renderSettingsPanel renders the main settings panel with all categories.
-func renderSettingsPanel(width int) string {
+func renderSettingsPanel(character *game.Character, watcherMetrics []watcher.WatcherMetrics, storageData StorageData, widgets []string, selected int, profileEditor string, experimental map[string]bool, discord DiscordSettings, xpRules XPRulesSettings, sleep SleepSettings, width int) string {
 	sections := make([]string, 0)
 
+	// Dashboard Widgets Section (interactive)
+	widgetSection := renderWidgetSettings(widgets, selected)
+	sections = append(sections, widgetSection)
+
+	// Profile Section (interactive, rows follow the widgets)
+	profileSection := renderProfileSettings(character, selected-len(dashboardWidgets), profileEditor)
+	sections = append(sections, profileSection)
+
+	// Experimental Section (interactive, rows follow the profile)
+	experimentalSection := renderExperimentalSettings(experimental, selected-len(dashboardWidgets)-len(game.ProfileFields))
+	sections = append(sections, experimentalSection)
+
+	// Integrations Section (interactive, the test message follows the features)
+	integrationsSection := renderIntegrationSettings(discord, selected == SettingsDiscordTestRow())
+	sections = append(sections, integrationsSection)
+
+	// XP Rules Section (interactive, the tester follows the Discord test message)
+	xpRulesSection := renderXPRulesSettings(xpRules, selected == SettingsXPRulesTestRow())
+	sections = append(sections, xpRulesSection)
+
+	// Sleep Hygiene Section (interactive, the switch follows the XP rules tester)
+	sleepSection := renderSleepSettings(sleep, selected == SettingsSleepRow())
+	sections = append(sections, sleepSection)
+
 	// Game Settings Section
 	gameSection := renderGameSettings()
 	sections = append(sections, gameSection)
@@ -93,8 +152,12 @@ func renderSettingsPanel(width int) string {
 	gitSection := renderGitSettings()
 	sections = append(sections, gitSection)
 
+	// Storage Section
+	storageSection := renderStorageSettings(storageData)
+	sections = append(sections, storageSection)
+
 	// Debug Settings Section
-	debugSection := renderDebugSettings()
+	debugSection := renderDebugSettings(watcherMetrics)
 	sections = append(sections, debugSection)
 
 	// Join all sections with spacing
@@ -111,6 +174,134 @@ func renderSettingsPanel(width int) string {
 // Settings Category Rendering Functions
 // ============================================================================
 
+// renderWidgetSettings renders the dashboard widget list: enabled widgets
+// numbered in display order, then hidden ones, with the selection marked.
+func renderWidgetSettings(widgets []string, selected int) string {
+	title := SubtitleStyle.Render("🧩 Dashboard Widgets")
+
+	rows := []string{title, ""}
+	for i, id := range WidgetSettingsOrder(widgets) {
+		widget, _ := findDashboardWidget(id)
+
+		indicator := "  "
+		if i == selected {
+			indicator = KeybindStyle.Render("▶ ")
+		}
+
+		var row string
+		if position := indexOf(widgets, id); position >= 0 {
+			row = SuccessTextStyle.Render("[x] ") + BoldTextStyle.Render(fmt.Sprintf("%d. %s", position+1, widget.Name))
+		} else {
+			row = DimTextStyle.Render("[ ] " + widget.Name)
+		}
+		rows = append(rows, indicator+row+MutedTextStyle.Render("  "+widget.Description))
+	}
+
+	hint := MutedTextStyle.Render("  (Space shows/hides the selected widget, Shift+↑↓ moves it)")
+	return lipgloss.JoinVertical(lipgloss.Left, append(rows, "", hint)...)
+}
+
+// renderProfileSettings renders the character's profile fields, with the
+// selection marked and the edited field replaced by its input.
+func renderProfileSettings(character *game.Character, selected int, editor string) string {
+	title := SubtitleStyle.Render("👤 Profile")
+
+	rows := []string{title, ""}
+	if character == nil {
+		character = &game.Character{}
+	}
+	for i, field := range game.ProfileFields {
+		indicator := "  "
+		if i == selected {
+			indicator = KeybindStyle.Render("▶ ")
+		}
+
+		label := StatLabelStyle.Render(game.ProfileFieldLabel(field) + ": ")
+		value := character.Profile.Get(field)
+		var shown string
+		switch {
+		case i == selected && editor != "":
+			shown = editor
+		case value == "":
+			shown = DimTextStyle.Render("(not set)")
+		default:
+			shown = StatValueStyle.Render(value)
+		}
+		if field == game.ProfileAvatarSeed && editor == "" {
+			shown += "  " + renderAvatar(character)
+		}
+		rows = append(rows, indicator+label+shown)
+	}
+
+	hint := MutedTextStyle.Render("  (Enter edits the selected field; Enter again saves, Esc cancels)")
+	return lipgloss.JoinVertical(lipgloss.Left, append(rows, "", hint)...)
+}
+
+// renderExperimentalSettings renders the experimental feature flags with
+// the selection marked.
+func renderExperimentalSettings(experimental map[string]bool, selected int) string {
+	title := SubtitleStyle.Render("🧪 Experimental")
+
+	rows := []string{title, ""}
+	for i, feature := range config.Features {
+		indicator := "  "
+		if i == selected {
+			indicator = KeybindStyle.Render("▶ ")
+		}
+
+		var row string
+		if experimental[feature.ID] {
+			row = SuccessTextStyle.Render("[x] ") + BoldTextStyle.Render(feature.Name)
+		} else {
+			row = DimTextStyle.Render("[ ] " + feature.Name)
+		}
+		rows = append(rows, indicator+row+MutedTextStyle.Render("  "+feature.Description))
+	}
+
+	hint := MutedTextStyle.Render("  (Unstable and may change or break. Space switches the selected feature on or off)")
+	return lipgloss.JoinVertical(lipgloss.Left, append(rows, "", hint)...)
+}
+
+// SettingsDiscordTestRow returns the Settings row of the Discord test
+// message, after the widgets, profile fields and feature flags.
+func SettingsDiscordTestRow() int {
+	return len(dashboardWidgets) + len(game.ProfileFields) + len(config.Features)
+}
+
+// renderIntegrationSettings renders Discord sharing's status and the test
+// message action.
+func renderIntegrationSettings(discord DiscordSettings, selected bool) string {
+	title := SubtitleStyle.Render("🔗 Integrations")
+
+	var status string
+	switch {
+	case !discord.Configured:
+		status = DimTextStyle.Render("not set up (add a webhook under [discord] in the config file)")
+	case discord.Enabled:
+		status = SuccessTextStyle.Render("sharing " + strings.Join(discord.Events, ", "))
+	default:
+		status = WarningTextStyle.Render("webhook set, sharing off (discord.enabled = false)")
+	}
+
+	indicator := "  "
+	if selected {
+		indicator = KeybindStyle.Render("▶ ")
+	}
+	action := BoldTextStyle.Render("Send test message")
+	if discord.Sending {
+		action = DimTextStyle.Render("Sending test message...")
+	}
+
+	rows := []string{
+		title, "",
+		"  " + StatLabelStyle.Render("Discord: ") + status,
+		indicator + action,
+		"",
+		MutedTextStyle.Render("  (Enter posts a test embed to the Discord webhook)"),
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
 // renderGameSettings renders game-related settings.
 func renderGameSettings() string {
 	title := SubtitleStyle.Render("🎮 Game Settings")
@@ -159,6 +350,11 @@ func renderUISettings() string {
 	themeValue := StatValueStyle.Render("Default (Charmbracelet)")
 	theme := themeLabel + themeValue
 
+	// Palette setting
+	paletteLabel := StatLabelStyle.Render("Color Palette: ")
+	paletteValue := StatValueStyle.Render(ActivePalette().Label)
+	palette := paletteLabel + paletteValue
+
 	// Animations setting
 	animationsLabel := StatLabelStyle.Render("Animations: ")
 	animationsValue := SuccessTextStyle.Render("Enabled ✓")
@@ -181,6 +377,7 @@ func renderUISettings() string {
 		title,
 		"",
 		theme,
+		palette,
 		animations,
 		compact,
 		helpHints,
@@ -273,8 +470,47 @@ func renderGitSettings() string {
 	)
 }
 
-// renderDebugSettings renders debug/developer settings.
-func renderDebugSettings() string {
+// renderStorageSettings renders the space used per stored key and the
+// retention limits applied at startup.
+func renderStorageSettings(data StorageData) string {
+	title := SubtitleStyle.Render("💾 Storage")
+
+	lines := []string{title, ""}
+	if data.Usage == nil {
+		lines = append(lines, DimTextStyle.Render("Measuring storage usage..."))
+	} else {
+		for _, entry := range data.Usage {
+			label := StatLabelStyle.Render(entry.Label + ": ")
+			lines = append(lines, label+InfoTextStyle.Render(formatBytes(entry.Bytes)))
+		}
+		total := StatLabelStyle.Render("Total: ")
+		lines = append(lines, total+StatValueStyle.Render(formatBytes(storage.TotalBytes(data.Usage))))
+	}
+
+	retention := StatLabelStyle.Render("Retention: ")
+	limits := InfoTextStyle.Render(fmt.Sprintf("%dd raw events · %dd daily history · %d chat messages",
+		data.Retention.RawEventDays, data.Retention.RollupDays, data.Retention.ChatHistoryMessages))
+	hint := MutedTextStyle.Render("  (Older data is pruned at startup; edit [retention] in the config file)")
+
+	lines = append(lines, "", retention+limits, "", hint)
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// formatBytes formats a size as "512 B", "3.4 KB" or "1.2 MB".
+func formatBytes(bytes int) string {
+	switch {
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(bytes)/(1<<20))
+	case bytes >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(bytes)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", bytes)
+	}
+}
+
+// renderDebugSettings renders debug/developer settings, followed by live
+// watcher telemetry when any repositories are being watched.
+func renderDebugSettings(watcherMetrics []watcher.WatcherMetrics) string {
 	title := SubtitleStyle.Render("🐛 Debug Settings")
 
 	// Log level
@@ -309,19 +545,62 @@ func renderDebugSettings() string {
 		perf,
 		"",
 		hint,
+		renderWatcherMetrics(watcherMetrics),
 	)
 }
 
+// renderWatcherMetrics renders one line of telemetry per watched repository:
+// goroutines, queue depth, event rate, diff latency and throttling or
+// polling state.
+func renderWatcherMetrics(watcherMetrics []watcher.WatcherMetrics) string {
+	if len(watcherMetrics) == 0 {
+		return ""
+	}
+
+	lines := []string{"", StatLabelStyle.Render("Git Watchers:")}
+	for _, m := range watcherMetrics {
+		state := DimTextStyle.Render("ok")
+		if m.Throttled {
+			state = WarningTextStyle.Render(fmt.Sprintf("throttled (%d pending)", m.PendingCommits))
+		} else if m.Polling {
+			state = DimTextStyle.Render(fmt.Sprintf("polling every %s", m.PollInterval.Round(time.Second)))
+		}
+
+		stats := fmt.Sprintf("  %d goroutines · queue %d/%d · %.2f ev/s · diff %s (avg %s) · ",
+			m.Goroutines, m.CommitQueueDepth, m.CommitQueueCapacity, m.EventsPerSec,
+			m.LastDiffLatency.Round(time.Millisecond), m.AvgDiffLatency.Round(time.Millisecond))
+
+		lines = append(lines,
+			"  "+StatValueStyle.Render(filepath.Base(m.RepoPath)),
+			MutedTextStyle.Render(stats)+state,
+		)
+		if m.AggregatedEvents > 0 {
+			lines = append(lines, MutedTextStyle.Render(fmt.Sprintf(
+				"  %d commits batched into %d aggregate events", m.AggregatedCommits, m.AggregatedEvents)))
+		}
+		if m.ForeignCommitsSkipped > 0 {
+			lines = append(lines, MutedTextStyle.Render(fmt.Sprintf(
+				"  %d commits by other authors skipped", m.ForeignCommitsSkipped)))
+		}
+		if m.BotCommitsSkipped > 0 || m.BotCommitsCounted > 0 {
+			lines = append(lines, MutedTextStyle.Render(fmt.Sprintf(
+				"  %d bot commits skipped, %d counted at a reduced rate", m.BotCommitsSkipped, m.BotCommitsCounted)))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
 // renderSettingsFooter renders the footer with key bindings.
 func renderSettingsFooter(width int) string {
 	// Info message about settings modification
-	infoMsg := InfoTextStyle.Render("ℹ️  Settings are currently read-only. Editing will be added in a future update.")
+	infoMsg := InfoTextStyle.Render("ℹ️  Dashboard widget, profile and experimental changes are saved automatically. Other settings are read-only here; edit them in the config file.")
 
 	// Key bindings
 	dashboard := renderKeybind("Alt+Q", "Dashboard")
 	esc := renderKeybind("Esc", "Back")
 	help := renderKeybind("?", "Help")
-	save := renderKeybind("Ctrl+S", "Save (future)")
+	save := renderKeybind("Ctrl+S", "Save")
 
 	keybinds := lipgloss.JoinHorizontal(
 		lipgloss.Left,
@@ -406,6 +685,15 @@ var settingsItems = []SettingItem{
 		Description: "UI color theme",
 		Category:    CategoryUI,
 	},
+	{
+		Label:       "Color Palette",
+		Key:         "ui.palette",
+		Value:       DefaultPaletteName,
+		ValueType:   "choice",
+		Choices:     PaletteNames(),
+		Description: "Status colors (color-blind safe palettes)",
+		Category:    CategoryUI,
+	},
 	{
 		Label:       "Animations",
 		Key:         "ui.animations",
@@ -519,7 +807,7 @@ func renderSettingsHeader(char *game.Character, width int) string {
 			Foreground(colorLevel).
 			Bold(true)
 		name := nameStyle.Render(char.Name)
-		level := levelStyle.Render(fmt.Sprintf("Lvl %d", char.Level))
+		level := levelStyle.Render(fmt.Sprintf("Lvl %d · %s", char.Level, char.Rank().Name))
 		rightSection = name + " " + level
 	}
 
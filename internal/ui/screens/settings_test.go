@@ -3,8 +3,12 @@ package screens
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/AutumnsGrove/codequest/internal/config"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
 )
 
 // TestRenderSettings tests the main settings screen rendering function.
@@ -41,7 +45,7 @@ func TestRenderSettings(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderSettings(tt.character, tt.width, tt.height)
+			result := RenderSettings(tt.character, nil, StorageData{}, nil, 0, "", nil, DiscordSettings{}, XPRulesSettings{}, SleepSettings{}, tt.width, tt.height)
 
 			// Should never return empty string
 			if result == "" && !tt.wantEmpty {
@@ -73,7 +77,7 @@ func TestRenderSettings(t *testing.T) {
 
 // TestRenderSettingsPanel tests the settings panel rendering.
 func TestRenderSettingsPanel(t *testing.T) {
-	result := renderSettingsPanel(100)
+	result := renderSettingsPanel(nil, nil, StorageData{}, nil, 0, "", nil, DiscordSettings{}, XPRulesSettings{}, SleepSettings{}, 100)
 
 	if result == "" {
 		t.Error("renderSettingsPanel() returned empty string")
@@ -86,6 +90,8 @@ func TestRenderSettingsPanel(t *testing.T) {
 		"AI Settings",
 		"Git Settings",
 		"Debug Settings",
+		"XP Rules",
+		"Sleep Hygiene",
 	}
 
 	for _, expected := range expectedStrings {
@@ -220,9 +226,47 @@ func TestRenderGitSettings(t *testing.T) {
 	}
 }
 
+// TestRenderStorageSettings tests the storage usage and retention section.
+func TestRenderStorageSettings(t *testing.T) {
+	retention := config.RetentionConfig{}.WithDefaults()
+	tests := []struct {
+		name string
+		data StorageData
+		want []string
+	}{
+		{
+			name: "still measuring",
+			data: StorageData{Retention: retention},
+			want: []string{"Storage", "Measuring storage usage", "90d raw events · 400d daily history · 200 chat messages"},
+		},
+		{
+			name: "measured",
+			data: StorageData{
+				Usage: []storage.KeyUsage{
+					{Key: storage.KeyCharacter, Label: "Character", Bytes: 2048},
+					{Key: storage.KeyChatHistory, Label: "Mentor chat", Bytes: 300},
+				},
+				Retention: retention,
+			},
+			want: []string{"Character: 2.0 KB", "Mentor chat: 300 B", "Total: 2.3 KB"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := renderStorageSettings(tt.data)
+			for _, want := range tt.want {
+				if !strings.Contains(result, want) {
+					t.Errorf("renderStorageSettings() should contain %q", want)
+				}
+			}
+		})
+	}
+}
+
 // TestRenderDebugSettings tests debug settings section rendering.
 func TestRenderDebugSettings(t *testing.T) {
-	result := renderDebugSettings()
+	result := renderDebugSettings(nil)
 
 	if result == "" {
 		t.Error("renderDebugSettings() returned empty string")
@@ -249,6 +293,29 @@ func TestRenderDebugSettings(t *testing.T) {
 	}
 }
 
+// TestRenderDebugSettingsWatcherMetrics tests the live watcher telemetry lines.
+func TestRenderDebugSettingsWatcherMetrics(t *testing.T) {
+	result := renderDebugSettings([]watcher.WatcherMetrics{{
+		RepoPath:            "/src/codequest",
+		Goroutines:          3,
+		CommitQueueDepth:    2,
+		CommitQueueCapacity: 10,
+		LastDiffLatency:     40 * time.Millisecond,
+		Throttled:           true,
+		PendingCommits:      4,
+	}})
+
+	for _, expected := range []string{"Git Watchers:", "codequest", "3 goroutines", "queue 2/10", "diff 40ms", "throttled (4 pending)"} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("renderDebugSettings() should contain %q", expected)
+		}
+	}
+
+	if strings.Contains(renderDebugSettings(nil), "Git Watchers:") {
+		t.Error("renderDebugSettings(nil) should not show watcher telemetry")
+	}
+}
+
 // TestRenderSettingsFooter tests footer rendering.
 func TestRenderSettingsFooter(t *testing.T) {
 	result := renderSettingsFooter(80)
@@ -258,8 +325,8 @@ func TestRenderSettingsFooter(t *testing.T) {
 	}
 
 	// Should contain info message
-	if !strings.Contains(result, "read-only") {
-		t.Error("renderSettingsFooter() should contain read-only info")
+	if !strings.Contains(result, "saved automatically") {
+		t.Error("renderSettingsFooter() should say widget changes are saved")
 	}
 
 	// Should contain key bindings
@@ -471,3 +538,25 @@ func TestSettingItemTypes(t *testing.T) {
 		t.Error("Choice item should have at least one choice")
 	}
 }
+
+// TestRenderXPRulesSettings tests listing the XP rules and a dry run's result.
+func TestRenderXPRulesSettings(t *testing.T) {
+	rules := XPRulesSettings{
+		Rules: []config.XPRuleConfig{
+			{Name: "day job", When: []string{"repo in ~/work", "hour >= 9"}, Multiplier: 0.5, Flags: []string{"work"}},
+		},
+		Sample: "repo=~/work/api hour=10",
+		Result: &game.CommitXPEstimate{Commit: 10, Lines: 30, Rules: -20, Total: 20, RuleNames: []string{"day job"}, Flags: []string{"work"}},
+	}
+
+	result := renderXPRulesSettings(rules, true)
+	for _, expected := range []string{"repo in ~/work, hour >= 9 → ×0.5 [work]", "matched day job · flags work", "-20 rules = 20 XP"} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("renderXPRulesSettings() should contain %q", expected)
+		}
+	}
+
+	if result := renderXPRulesSettings(XPRulesSettings{Err: "unknown field \"branch\""}, false); !strings.Contains(result, "branch") {
+		t.Error("renderXPRulesSettings() should show why a sample couldn't be tested")
+	}
+}
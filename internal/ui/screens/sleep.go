@@ -0,0 +1,57 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file renders the sleep hygiene section of the Settings screen: the
+// opt-in switch for late-night commit warnings, the bedtime they use and
+// progress toward the Well Rested track. Its row follows the XP rules
+// tester.
+package screens
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// SleepSettings is what the Settings screen shows about sleep hygiene.
+type SleepSettings struct {
+	Enabled    bool // Late-night warnings are on
+	Bedtime    int  // Bedtime hour (home timezone)
+	LateNights int  // Late nights in the past week
+	RestedDays int  // Days since the last late-night commit
+}
+
+// SettingsSleepRow returns the Settings row of the late-night warnings
+// switch, after the XP rules tester.
+func SettingsSleepRow() int {
+	return SettingsXPRulesTestRow() + 1
+}
+
+// renderSleepSettings renders the late-night warnings switch and, while it
+// is on, the bedtime and how the past nights went.
+func renderSleepSettings(sleep SleepSettings, selected bool) string {
+	title := SubtitleStyle.Render("🌙 Sleep Hygiene")
+
+	indicator := "  "
+	if selected {
+		indicator = KeybindStyle.Render("▶ ")
+	}
+	row := DimTextStyle.Render("[ ] Late-night warnings")
+	if sleep.Enabled {
+		row = SuccessTextStyle.Render("[x] ") + BoldTextStyle.Render("Late-night warnings")
+	}
+	lines := []string{title, "", indicator + row + MutedTextStyle.Render("  Nudge me when commits after bedtime become a habit")}
+
+	if sleep.Enabled {
+		lines = append(lines,
+			"  "+StatLabelStyle.Render("Bedtime: ")+StatValueStyle.Render(fmt.Sprintf("%02d:00", sleep.Bedtime))+
+				MutedTextStyle.Render(fmt.Sprintf(" until %02d:00", game.LateNightEnd)),
+			"  "+StatLabelStyle.Render("Late nights this week: ")+StatValueStyle.Render(fmt.Sprintf("%d", sleep.LateNights)),
+			"  "+StatLabelStyle.Render("Well rested for: ")+StatValueStyle.Render(fmt.Sprintf("%d days", sleep.RestedDays)),
+		)
+	}
+
+	lines = append(lines, "",
+		MutedTextStyle.Render("  (Space switches warnings on or off; set tracking.bedtime_hour in the config file)"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
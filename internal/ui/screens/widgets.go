@@ -0,0 +1,399 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file implements the dashboard widgets: self-contained panels the
+// dashboard lays out in a responsive grid, in the order (and subset)
+// configured under ui.dashboard_widgets.
+package screens
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Dashboard grid tuning
+const (
+	// maxActiveQuestRows is how many active quests the widget lists at once
+	maxActiveQuestRows = 6
+
+	// activeQuestTitleWidth is the widest the title column of a quest row gets
+	activeQuestTitleWidth = 28
+
+	// widgetGap is the horizontal space between grid columns
+	widgetGap = 2
+
+	// heatmapWeeks is how many weeks the streak heatmap shows at most
+	heatmapWeeks = 12
+
+	// activityFeedSize is how many recent events the activity feed shows
+	activityFeedSize = 5
+
+	// repoMapRows is how many repositories the commit geography widget lists
+	repoMapRows = 6
+
+	// repoNameWidth is the width of the repository name column
+	repoNameWidth = 14
+)
+
+// DashboardData is everything the dashboard widgets render from.
+type DashboardData struct {
+	Character     *game.Character    // Player character (nil shows the no-character screen)
+	Quests        []*game.Quest      // All quests (the active ones are listed)
+	SelectedQuest int                // Index of the selected active quest
+	Budget        game.XPBudget      // Daily XP budget rules
+	ProgressTick  *QuestProgressTick // Live progress animation (nil when idle)
+	Activity      []ActivityItem     // Recent events, oldest first
+	Clock         game.StreakClock   // Streak day boundaries for the heatmap
+	Now           time.Time          // Current time (tip of the day, heatmap range)
+}
+
+// ActivityItem is one entry in the dashboard's activity feed.
+type ActivityItem struct {
+	At   time.Time // When it happened
+	Icon string    // Emoji for the kind of event
+	Text string    // One-line description
+}
+
+// DashboardWidget is a panel the dashboard can show.
+type DashboardWidget struct {
+	ID          string                                     // Config ID (see config.DashboardWidgetIDs)
+	Name        string                                     // Display name in Settings
+	Description string                                     // What the widget shows
+	render      func(data DashboardData, width int) string // Renders the widget at a column width
+}
+
+// dashboardWidgets is the widget registry, in default display order.
+var dashboardWidgets = []DashboardWidget{
+	{ID: "character", Name: "Character", Description: "Level, XP, stats and lifetime totals", render: func(data DashboardData, width int) string {
+		return renderCharacterPanel(data.Character, width)
+	}},
+	{ID: "active_quest", Name: "Active Quests", Description: "Progress on every quest you're working on", render: func(data DashboardData, width int) string {
+		if quests := ActiveQuests(data.Quests); len(quests) > 0 {
+			return renderActiveQuests(quests, data.SelectedQuest, data.ProgressTick, width)
+		}
+		return renderNoActiveQuest(width)
+	}},
+	{ID: "today", Name: "Today", Description: "Today's commits, lines, session time and XP budget", render: func(data DashboardData, width int) string {
+		return renderTodayActivity(data.Character, data.Budget, width)
+	}},
+	{ID: "streak_heatmap", Name: "Streak Heatmap", Description: "Commits per day over recent weeks", render: renderStreakHeatmap},
+	{ID: "activity_feed", Name: "Activity Feed", Description: "Recent commits, quests and level-ups", render: renderActivityFeed},
+	{ID: "tips", Name: "Tips", Description: "A tip of the day and one for today's energy", render: renderTipWidget},
+	{ID: "pet", Name: "Companion", Description: "Your pet, growing with streaks and quest variety", render: renderPetWidget},
+	{ID: "repo_map", Name: "Commit Geography", Description: "Each repository's share of this week's commits", render: renderRepoMapWidget},
+}
+
+// DashboardWidgets returns every available widget, in default display order.
+//
+// Returns:
+//   - []DashboardWidget: The widget registry
+func DashboardWidgets() []DashboardWidget {
+	return dashboardWidgets
+}
+
+// findDashboardWidget looks a widget up by ID.
+func findDashboardWidget(id string) (DashboardWidget, bool) {
+	for _, widget := range dashboardWidgets {
+		if widget.ID == id {
+			return widget, true
+		}
+	}
+	return DashboardWidget{}, false
+}
+
+// WidgetSettingsOrder lists widgets as the Settings screen shows them:
+// enabled widgets in display order, then disabled ones in default order.
+//
+// Parameters:
+//   - enabled: Enabled widget IDs in display order
+//
+// Returns:
+//   - []string: Every widget ID
+func WidgetSettingsOrder(enabled []string) []string {
+	order := make([]string, 0, len(dashboardWidgets))
+	for _, id := range enabled {
+		if _, ok := findDashboardWidget(id); ok {
+			order = append(order, id)
+		}
+	}
+	for _, widget := range dashboardWidgets {
+		if indexOf(enabled, widget.ID) < 0 {
+			order = append(order, widget.ID)
+		}
+	}
+	return order
+}
+
+// dashboardColumns picks how many grid columns fit the terminal width.
+func dashboardColumns(width, widgets int) int {
+	columns := 1
+	switch {
+	case width > 160:
+		columns = 3
+	case width > 100:
+		columns = 2
+	}
+	return max(min(columns, widgets), 1)
+}
+
+// renderWidgetGrid lays out the enabled widgets row by row, as many per row
+// as the width allows. Unknown IDs are skipped.
+//
+// Parameters:
+//   - data: What the widgets render from
+//   - enabled: Widget IDs in display order
+//   - width: Terminal width in characters
+//
+// Returns:
+//   - string: The rendered grid (a hint when no widget is enabled)
+func renderWidgetGrid(data DashboardData, enabled []string, width int) string {
+	widgets := make([]DashboardWidget, 0, len(enabled))
+	for _, id := range enabled {
+		if widget, ok := findDashboardWidget(id); ok {
+			widgets = append(widgets, widget)
+		}
+	}
+	if len(widgets) == 0 {
+		return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(
+			MutedTextStyle.Render("All dashboard widgets are hidden. Press [S] to choose some in Settings."))
+	}
+
+	columns := dashboardColumns(width, len(widgets))
+	columnWidth := (width - widgetGap*(columns-1)) / columns
+	gap := strings.Repeat(" ", widgetGap)
+
+	rows := make([]string, 0, (len(widgets)+columns-1)/columns)
+	for start := 0; start < len(widgets); start += columns {
+		cells := make([]string, 0, 2*columns-1)
+		for i, widget := range widgets[start:min(start+columns, len(widgets))] {
+			if i > 0 {
+				cells = append(cells, gap)
+			}
+			cells = append(cells, widget.render(data, columnWidth))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderStreakHeatmap renders commits per day as a weekday × week grid,
+// oldest week on the left, shaded by how much was committed.
+func renderStreakHeatmap(data DashboardData, width int) string {
+	title := renderTitle("Streak Heatmap", "🔥")
+	character := data.Character
+
+	// Each week is one two-character column after the weekday labels
+	weeks := max(min(heatmapWeeks, (width-12)/2), 1)
+	today := data.Clock.Day(data.Now)
+	// Columns start on Monday so rows line up with the weekday labels
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	first := weekStart.AddDate(0, 0, -7*(weeks-1))
+
+	labels := []string{"Mon", "   ", "Wed", "   ", "Fri", "   ", "Sun"}
+	rows := make([]string, 7)
+	for weekday := 0; weekday < 7; weekday++ {
+		var row strings.Builder
+		row.WriteString(MutedTextStyle.Render(labels[weekday]) + " ")
+		for week := 0; week < weeks; week++ {
+			day := first.AddDate(0, 0, 7*week+weekday)
+			if day.After(today) {
+				row.WriteString("  ")
+				continue
+			}
+			row.WriteString(heatmapCell(character.CommitsOnDay(day)) + " ")
+		}
+		rows[weekday] = row.String()
+	}
+
+	legend := MutedTextStyle.Render("Less ") + heatmapCell(0) + heatmapCell(1) + heatmapCell(3) + heatmapCell(6) +
+		MutedTextStyle.Render(" More")
+	streak := StatLabelStyle.Render("Current Streak: ") +
+		lipgloss.NewStyle().Foreground(ColorSuccess).Bold(true).Render(fmt.Sprintf("%d days 🔥", character.CurrentStreak))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, append(append([]string{title, ""}, rows...), "", legend, streak, renderQuestStreak(data))...)
+	return BoxStyle.Width(width - 4).Render(content)
+}
+
+// renderQuestStreak renders the quest streak under the commit streak: days
+// in a row with a quest completed, this week's quest days toward a crown,
+// and crowns earned.
+func renderQuestStreak(data DashboardData) string {
+	character := data.Character
+	line := StatLabelStyle.Render("Quest Streak: ") +
+		lipgloss.NewStyle().Foreground(ColorMagic).Bold(true).
+			Render(fmt.Sprintf("%d days ⚔️", character.CurrentQuestStreak(data.Now, data.Clock)))
+	if character.Crowns > 0 {
+		line += lipgloss.NewStyle().Foreground(ColorXP).Render(fmt.Sprintf("  👑 ×%d", character.Crowns))
+	}
+
+	// One letter per weekday, lit when a quest was completed that day
+	week := MutedTextStyle.Render("This week: ")
+	for i, done := range character.WeekQuestDays(data.Now, data.Clock) {
+		letter := string("MTWTFSS"[i])
+		if done {
+			week += lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(letter)
+		} else {
+			week += DimTextStyle.Render(letter)
+		}
+	}
+	return line + "\n" + week
+}
+
+// heatmapCell shades one day by its commit count. Every level has its own
+// glyph as well as its own color, so the heatmap reads without color.
+func heatmapCell(commits int) string {
+	switch {
+	case commits == 0:
+		return DimTextStyle.Render("·")
+	case commits < 3:
+		return lipgloss.NewStyle().Foreground(ColorInfo).Render("▪")
+	case commits < 6:
+		return lipgloss.NewStyle().Foreground(ColorSuccess).Render("■")
+	default:
+		return lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render("█")
+	}
+}
+
+// renderActivityFeed renders the most recent events, newest first.
+func renderActivityFeed(data DashboardData, width int) string {
+	title := renderTitle("Activity Feed", "📰")
+
+	rows := []string{title, ""}
+	if len(data.Activity) == 0 {
+		rows = append(rows, MutedTextStyle.Render("Nothing yet this session. Make a commit!"))
+	}
+	for i := len(data.Activity) - 1; i >= 0 && i >= len(data.Activity)-activityFeedSize; i-- {
+		item := data.Activity[i]
+		text := item.Text
+		if maxLen := width - 18; maxLen > 3 && len(text) > maxLen {
+			text = text[:maxLen-3] + "..."
+		}
+		rows = append(rows, MutedTextStyle.Render(item.At.Format("15:04"))+" "+item.Icon+" "+TextStyle.Render(text))
+	}
+
+	return BoxStyle.Width(width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// renderPetWidget renders the companion pet: its art, stage, mood and what
+// it needs to grow into the next stage.
+func renderPetWidget(data DashboardData, width int) string {
+	title := renderTitle("Companion", "🐾")
+	pet := data.Character.Pet
+
+	art := make([]string, 0, len(game.PetArt(pet.Stage)))
+	for _, line := range game.PetArt(pet.Stage) {
+		art = append(art, lipgloss.NewStyle().Foreground(ColorAccent).Render(line))
+	}
+
+	info := []string{
+		BoldTextStyle.Render(game.PetStageName(pet.Stage)),
+		MutedTextStyle.Render("Mood: ") + TextStyle.Render(pet.Mood(data.Now, data.Clock)),
+	}
+	if progress, ok := pet.Progress(); ok {
+		info = append(info,
+			MutedTextStyle.Render(fmt.Sprintf("Growth %d/%d", min(progress.Growth, progress.GrowthNeeded), progress.GrowthNeeded)),
+			MutedTextStyle.Render(fmt.Sprintf("Quest types %d/%d", min(progress.Variety, progress.VarietyNeeded), progress.VarietyNeeded)),
+		)
+	} else {
+		info = append(info, SuccessTextStyle.Render("Fully grown"))
+	}
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top, lipgloss.JoinVertical(lipgloss.Left, art...), "  ", lipgloss.JoinVertical(lipgloss.Left, info...))
+	rows := []string{title, "", body}
+	if len(data.Character.Items) > 0 {
+		rows = append(rows, "", MutedTextStyle.Render(fmt.Sprintf("Press P to feed a trophy (%d)", len(data.Character.Items))))
+	}
+	return BoxStyle.Width(width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// renderRepoMapWidget renders commit geography: each repository's share of
+// this week's commits as a bar, its trend against last week, and a nudge
+// back to a repository that went quiet.
+func renderRepoMapWidget(data DashboardData, width int) string {
+	title := renderTitle("Commit Geography", "🗺")
+	geography := data.Character.RepoGeography(data.Now, data.Clock)
+
+	rows := []string{title, ""}
+	if len(geography) == 0 {
+		rows = append(rows, MutedTextStyle.Render("No commits this week or last."))
+	}
+	barWidth := max(width-repoNameWidth-19, 5) // Name, share and trend take the rest
+	for i, activity := range geography {
+		if i == repoMapRows {
+			rows = append(rows, MutedTextStyle.Render(fmt.Sprintf("…and %d more", len(geography)-repoMapRows)))
+			break
+		}
+		filled := int(activity.Share*float64(barWidth) + 0.5)
+		if activity.Commits > 0 {
+			filled = max(filled, 1) // Every active repo gets a sliver
+		}
+		name := ansi.Truncate(filepath.Base(activity.Repo), repoNameWidth, "…")
+		bar := lipgloss.NewStyle().Foreground(ColorAccent).Render(strings.Repeat("█", filled)) +
+			ProgressBarEmptyStyle.Render(strings.Repeat("░", barWidth-filled))
+		rows = append(rows, TextStyle.Render(fmt.Sprintf("%-*s ", repoNameWidth, name))+bar+
+			TextStyle.Render(fmt.Sprintf(" %3.0f%% ", activity.Share*100))+renderRepoTrend(activity.Trend()))
+	}
+
+	if neglected := game.NeglectedRepos(geography); len(neglected) > 0 {
+		quiet := neglected[0]
+		rows = append(rows, "", WarningTextStyle.Render(fmt.Sprintf("↩ Return to %s? %d commits last week, none since.",
+			filepath.Base(quiet.Repo), quiet.LastWeek)))
+	}
+	return BoxStyle.Width(width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// renderRepoTrend renders the change against last week with an arrow, so it
+// reads without color too.
+func renderRepoTrend(trend int) string {
+	switch {
+	case trend > 0:
+		return SuccessTextStyle.Render(fmt.Sprintf("▲%d", trend))
+	case trend < 0:
+		return WarningTextStyle.Render(fmt.Sprintf("▼%d", -trend))
+	default:
+		return DimTextStyle.Render("=")
+	}
+}
+
+// dashboardTips rotate daily in the tips widget.
+var dashboardTips = []string{
+	"Small, focused commits earn XP steadily and keep your history readable.",
+	"Press Ctrl+T to time a session. Break reminders nudge you to rest.",
+	"Spend skill points on the character sheet with 1, 2 and 3.",
+	"Wisdom multiplies all XP you earn. It's a good long-term investment.",
+	"Stuck? Press M and ask the AI Mentor.",
+	"Choose which dashboard widgets to show in Settings (S).",
+	"Quests progress automatically while they're active. Start one from the Quest Board.",
+	"A commit a day keeps your streak alive. Check the heatmap for gaps.",
+	"Your companion grows with daily activity and different kinds of quests. Don't leave it alone for days!",
+}
+
+// renderTipWidget renders the tip of the day, followed by the energy tip
+// from the character sheet (today's check-in against past check-ins).
+func renderTipWidget(data DashboardData, width int) string {
+	title := renderTitle("Tip of the Day", "💡")
+	tip := dashboardTips[data.Now.YearDay()%len(dashboardTips)]
+
+	rows := []string{title, "", InfoTextStyle.Width(max(width-8, 10)).Render(tip)}
+	if data.Character != nil {
+		correlation := game.CalculateEnergyCorrelation(data.Character.EnergyLog)
+		energyTip := game.EnergyTip(data.Character.TodayEnergy(), correlation)
+		rows = append(rows, "", MutedTextStyle.Width(max(width-8, 10)).Render("⚡ "+energyTip))
+	}
+	return BoxStyleDim.Width(width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// indexOf returns the position of value in list, or -1.
+func indexOf(list []string, value string) int {
+	for i, item := range list {
+		if item == value {
+			return i
+		}
+	}
+	return -1
+}
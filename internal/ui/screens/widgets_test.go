@@ -0,0 +1,146 @@
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestDashboardWidgetRegistry tests that every configurable widget can render.
+func TestDashboardWidgetRegistry(t *testing.T) {
+	for _, id := range config.DashboardWidgetIDs {
+		if _, ok := findDashboardWidget(id); !ok {
+			t.Errorf("widget %q has no renderer", id)
+		}
+	}
+	if len(DashboardWidgets()) != len(config.DashboardWidgetIDs) {
+		t.Errorf("registry has %d widgets, config knows %d", len(DashboardWidgets()), len(config.DashboardWidgetIDs))
+	}
+}
+
+// TestDashboardColumns tests the responsive column count.
+func TestDashboardColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		width   int
+		widgets int
+		want    int
+	}{
+		{"narrow", 80, 6, 1},
+		{"wide", 120, 6, 2},
+		{"very wide", 200, 6, 3},
+		{"fewer widgets than columns", 200, 2, 2},
+		{"no widgets", 200, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dashboardColumns(tt.width, tt.widgets); got != tt.want {
+				t.Errorf("dashboardColumns(%d, %d) = %d, want %d", tt.width, tt.widgets, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRenderDashboardWidgets tests that only enabled widgets are shown.
+func TestRenderDashboardWidgets(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	clock := game.StreakClock{Location: time.UTC}
+	character.RecordActivityDay(now, 4, clock)
+	character.RecordRepoActivity("/src/api", now, 3, clock)
+	character.RecordRepoActivity("/src/docs", now.AddDate(0, 0, -9), 2, clock)
+
+	data := DashboardData{
+		Character: character,
+		Activity:  []ActivityItem{{At: now, Icon: "📝", Text: "+10 XP: Fix parser"}},
+		Clock:     clock,
+		Now:       now,
+	}
+
+	tests := []struct {
+		name    string
+		widgets []string
+		want    []string
+		notWant []string
+	}{
+		{"heatmap and feed", []string{"streak_heatmap", "activity_feed"}, []string{"Streak Heatmap", "Fix parser"}, []string{"Lifetime Stats", "Tip of the Day"}},
+		{"tips with the energy tip", []string{"tips"}, []string{"Tip of the Day", "Check in with"}, []string{"Lifetime Stats"}},
+		{"character only", []string{"character"}, []string{"Lifetime Stats"}, []string{"Streak Heatmap"}},
+		{"commit geography", []string{"repo_map"}, []string{"Commit Geography", "api", "Return to docs"}, []string{"Lifetime Stats"}},
+		{"none", []string{}, []string{"All dashboard widgets are hidden"}, []string{"Lifetime Stats"}},
+	}
+
+	for _, width := range []int{80, 120, 200} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := RenderDashboard(data, tt.widgets, width, 40)
+				for _, want := range tt.want {
+					if !strings.Contains(result, want) {
+						t.Errorf("width %d: dashboard should contain %q", width, want)
+					}
+				}
+				for _, notWant := range tt.notWant {
+					if strings.Contains(result, notWant) {
+						t.Errorf("width %d: dashboard should not contain %q", width, notWant)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestWidgetSettingsOrder tests that enabled widgets come first in display order.
+func TestWidgetSettingsOrder(t *testing.T) {
+	got := WidgetSettingsOrder([]string{"tips", "character"})
+	want := []string{"tips", "character", "active_quest", "today", "streak_heatmap", "activity_feed", "pet", "repo_map"}
+	if !slices.Equal(got, want) {
+		t.Errorf("WidgetSettingsOrder() = %v, want %v", got, want)
+	}
+}
+
+// TestRenderActiveQuests tests that every active quest gets a row, the
+// closest to completion is starred and long lists scroll with the selection.
+func TestRenderActiveQuests(t *testing.T) {
+	quests := make([]*game.Quest, 0, maxActiveQuestRows+2)
+	for i := range maxActiveQuestRows + 2 {
+		quest := game.NewQuest(fmt.Sprintf("Quest %d", i), "", game.QuestTypeCommit, 10, 100, 1)
+		quest.Current = i % 4
+		quests = append(quests, quest)
+	}
+
+	tests := []struct {
+		name     string
+		selected int
+		want     []string
+		notWant  []string
+	}{
+		{"top", 0, []string{"Active Quests (8)", "▸ ", "Quest 0", "Quest 5", "↓ 2 more", "★ Closest: Quest 3"}, []string{"Quest 7", "↑ 2 more"}},
+		{"scrolled to the end", 7, []string{"Quest 7", "↑ 2 more"}, []string{"Quest 1 ", "↓ 2 more"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := renderActiveQuests(quests, tt.selected, nil, 80)
+			for _, want := range tt.want {
+				if !strings.Contains(result, want) {
+					t.Errorf("widget should contain %q:\n%s", want, result)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(result, notWant) {
+					t.Errorf("widget should not contain %q:\n%s", notWant, result)
+				}
+			}
+		})
+	}
+
+	if got := closestToCompletion(nil); got != -1 {
+		t.Errorf("closestToCompletion(nil) = %d, want -1", got)
+	}
+}
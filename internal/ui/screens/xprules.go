@@ -0,0 +1,100 @@
+// Package screens provides screen rendering functions for CodeQuest UI.
+// This file renders the custom XP rules section of the Settings screen:
+// the rules from the config file and the dry-run tester, which shows what
+// a sample commit would earn under them. Its row follows the Discord test
+// message.
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// XPRulesSettings is what the Settings screen shows about custom XP rules.
+type XPRulesSettings struct {
+	Rules  []config.XPRuleConfig  // Rules from the config file, in order
+	Editor string                 // Rendered sample input ("" when not testing)
+	Sample string                 // Sample commit of the last dry run ("" before one)
+	Result *game.CommitXPEstimate // Last dry run's XP (nil before one)
+	Err    string                 // Why the last sample couldn't be tested
+}
+
+// SettingsXPRulesTestRow returns the Settings row of the XP rules dry-run
+// tester, after the Discord test message.
+func SettingsXPRulesTestRow() int {
+	return SettingsDiscordTestRow() + 1
+}
+
+// renderXPRulesSettings renders the custom XP rules and the dry-run tester.
+func renderXPRulesSettings(rules XPRulesSettings, selected bool) string {
+	title := SubtitleStyle.Render("⚖️  XP Rules")
+	lines := []string{title, ""}
+
+	if len(rules.Rules) == 0 {
+		lines = append(lines, "  "+DimTextStyle.Render("none (add [[xp_rules]] to the config file)"))
+	}
+	for _, rule := range rules.Rules {
+		lines = append(lines, "  "+StatLabelStyle.Render(rule.Name+": ")+StatValueStyle.Render(describeXPRule(rule)))
+	}
+
+	indicator := "  "
+	if selected {
+		indicator = KeybindStyle.Render("▶ ")
+	}
+	lines = append(lines, "")
+	if rules.Editor != "" {
+		lines = append(lines, indicator+rules.Editor)
+	} else {
+		lines = append(lines, indicator+BoldTextStyle.Render("Test a commit"))
+	}
+
+	switch {
+	case rules.Err != "":
+		lines = append(lines, "  "+ErrorTextStyle.Render(rules.Err))
+	case rules.Result != nil:
+		matched := "no rules matched"
+		if len(rules.Result.RuleNames) > 0 {
+			matched = "matched " + strings.Join(rules.Result.RuleNames, ", ")
+		}
+		if len(rules.Result.Flags) > 0 {
+			matched += " · flags " + strings.Join(rules.Result.Flags, ", ")
+		}
+		lines = append(lines,
+			"  "+MutedTextStyle.Render(rules.Sample),
+			"  "+InfoTextStyle.Render(matched),
+			"  "+StatValueStyle.Render(rules.Result.String()),
+		)
+	}
+
+	lines = append(lines, "",
+		MutedTextStyle.Render("  (Enter tests a sample commit, e.g. repo=~/work/api hour=10 lines=40 message=\"WIP: spike\")"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// describeXPRule summarizes a rule, e.g. "repo in ~/work, hour >= 9 → ×0.5 +10 [review]".
+func describeXPRule(rule config.XPRuleConfig) string {
+	when := "every commit"
+	if len(rule.When) > 0 {
+		when = strings.Join(rule.When, ", ")
+	}
+
+	var effects []string
+	if rule.Multiplier > 0 && rule.Multiplier != 1 {
+		effects = append(effects, fmt.Sprintf("×%g", rule.Multiplier))
+	}
+	if rule.Bonus != 0 {
+		effects = append(effects, fmt.Sprintf("%+d XP", rule.Bonus))
+	}
+	if len(rule.Flags) > 0 {
+		effects = append(effects, "["+strings.Join(rule.Flags, ", ")+"]")
+	}
+	if len(effects) == 0 {
+		effects = append(effects, "no change")
+	}
+	return when + " → " + strings.Join(effects, " ")
+}
@@ -0,0 +1,129 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements global search (Ctrl+F): a popover that fuzzy-matches
+// quest titles, quest notes, the activity feed and the mentor chat as the
+// player types, and jumps to the screen a result came from on Enter.
+package ui
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// globalSearch is the open search popover.
+type globalSearch struct {
+	input    textinput.Model        // Query input
+	results  []screens.SearchResult // Matches for the current query
+	selected int                    // Highlighted result
+}
+
+// openSearch opens the search popover with an empty query.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Focuses the query input
+func (m Model) openSearch() (tea.Model, tea.Cmd) {
+	input := textinput.New()
+	input.Placeholder = "Search quests, notes, activity, chat…"
+	input.Prompt = "🔍 "
+	input.Width = max(min(m.width-20, 70), 20)
+
+	m.search = &globalSearch{input: input}
+	return m, m.search.input.Focus()
+}
+
+// handleSearchKeys handles keys while search is open: ↑/↓ pick a result,
+// Enter goes to it, Esc closes search and anything else edits the query.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The input's command, or the screen switch's
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	search := *m.search
+	m.search = &search
+
+	// Only the arrow keys select, so j and k can still be typed
+	switch {
+	case key.Matches(msg, m.keys.Esc):
+		m.search = nil
+		return m, nil
+	case key.Matches(msg, m.keys.Enter):
+		if len(search.results) == 0 {
+			return m, nil
+		}
+		result := search.results[search.selected]
+		m.search = nil
+		return m.openSearchResult(result)
+	case msg.Type == tea.KeyUp:
+		search.selected = max(search.selected-1, 0)
+		return m, nil
+	case msg.Type == tea.KeyDown:
+		search.selected = min(search.selected+1, max(len(search.results)-1, 0))
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	search.input, cmd = search.input.Update(msg)
+	search.results = screens.Search(search.input.Value(), m.searchSources())
+	search.selected = 0
+	return m, cmd
+}
+
+// searchSources gathers everything global search looks through.
+func (m Model) searchSources() screens.SearchSources {
+	sources := screens.SearchSources{Quests: m.quests, Activity: m.activityFeed}
+	if m.mentorScreen != nil {
+		sources.Chat = m.mentorScreen.Messages()
+	}
+	return sources
+}
+
+// openSearchResult goes to where a result was found: quests and journal
+// notes open the quest's detail view, activity opens the dashboard feed and
+// chat scrolls the mentor conversation to the message.
+//
+// Parameters:
+//   - result: The chosen result
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The screen switch's command
+func (m Model) openSearchResult(result screens.SearchResult) (tea.Model, tea.Cmd) {
+	switch result.Kind {
+	case screens.SearchQuest, screens.SearchJournal:
+		quest := m.findQuest(result.QuestID)
+		if quest == nil {
+			return m.notifyAction("That quest no longer exists", NotificationWarning)
+		}
+		updated, cmd := m.switchScreen(ScreenQuestBoard)
+		m = updated.(Model)
+		if i := slices.IndexFunc(m.boardQuests(), func(q *game.Quest) bool { return q.ID == quest.ID }); i >= 0 {
+			m.questBoardSelectedIndex = i
+		}
+		m.questDetail = quest
+		return m, cmd
+	case screens.SearchChat:
+		updated, cmd := m.switchScreen(ScreenMentor)
+		m = updated.(Model)
+		if m.mentorScreen != nil {
+			m.mentorScreen.ScrollToMessage(result.Index)
+		}
+		return m, cmd
+	default:
+		return m.switchScreen(ScreenDashboard)
+	}
+}
+
+// viewSearch renders the search popover in place of the current screen.
+func (m Model) viewSearch() string {
+	return screens.RenderSearch(m.search.input.View(), m.search.input.Value(),
+		m.search.results, m.search.selected, m.width, m.height)
+}
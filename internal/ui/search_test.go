@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestGlobalSearch tests searching with Ctrl+F and jumping to a result.
+func TestGlobalSearch(t *testing.T) {
+	quests := []*game.Quest{
+		{ID: "q1", Title: "Write docs", Status: game.QuestAvailable},
+		{ID: "q2", Title: "Refactor parser", Status: game.QuestAvailable, Notes: "split the lexer"},
+	}
+	typeQuery := func(t *testing.T, m Model, query string) Model {
+		for _, r := range query {
+			m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+		return m
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		keys       []tea.KeyMsg
+		wantScreen Screen
+		wantQuest  string
+	}{
+		{"quest title opens the quest", "parser", nil, ScreenQuestBoard, "q2"},
+		{"journal note opens the quest", "lexer", nil, ScreenQuestBoard, "q2"},
+		{"activity opens the dashboard", "commit", nil, ScreenDashboard, ""},
+		{"escape closes search", "parser", []tea.KeyMsg{{Type: tea.KeyEsc}}, ScreenCharacter, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), quests: quests, currentScreen: ScreenCharacter, width: 100, height: 40}
+			m.activityFeed = []screens.ActivityItem{{Icon: "📝", Text: "Commit: add README"}}
+
+			m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyCtrlF})
+			if m.search == nil {
+				t.Fatal("Ctrl+F should open search")
+			}
+			m = typeQuery(t, m, tt.query)
+			if !strings.Contains(m.View(), "🔍 Search") || len(m.search.results) == 0 {
+				t.Fatalf("search for %q should show results", tt.query)
+			}
+
+			keys := tt.keys
+			if keys == nil {
+				keys = []tea.KeyMsg{{Type: tea.KeyEnter}}
+			}
+			for _, msg := range keys {
+				m = pressKey(t, m, msg)
+			}
+
+			if m.search != nil {
+				t.Error("search should close")
+			}
+			if m.currentScreen != tt.wantScreen {
+				t.Errorf("screen = %v, want %v", m.currentScreen, tt.wantScreen)
+			}
+			if tt.wantQuest != "" && (m.questDetail == nil || m.questDetail.ID != tt.wantQuest) {
+				t.Errorf("quest detail = %+v, want %s", m.questDetail, tt.wantQuest)
+			}
+			if tt.wantQuest != "" && m.selectedBoardQuest().ID != tt.wantQuest {
+				t.Errorf("board selection = %s, want %s", m.selectedBoardQuest().ID, tt.wantQuest)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the Season Pass screen: ←/→ moves along the reward
+// track, Enter claims the selected tier (or equips an already claimed
+// title), and tiers unlocked by seasonal XP are announced as they happen.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// seasonTierMsg is sent when seasonal XP unlocks a season pass tier.
+type seasonTierMsg struct {
+	season string // The season (e.g. "2026-Q4")
+	tier   int    // The tier unlocked
+	reward string // The tier's reward name
+}
+
+// celebrateSeasonTier queues the tier unlocked toast and logs it in the
+// activity feed.
+//
+// Parameters:
+//   - msg: The unlocked tier
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) celebrateSeasonTier(msg seasonTierMsg) Model {
+	m.recordActivity("🎟️", fmt.Sprintf("Season pass tier %d unlocked: %s", msg.tier, msg.reward))
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("🎟️ SEASON TIER %d UNLOCKED!\n%s · press R on the dashboard to claim", msg.tier, msg.reward),
+		Type:      NotificationSuccess,
+		Duration:  4 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m
+}
+
+// seasonPass returns the character's progress on the current season pass.
+func (m Model) seasonPass(now time.Time) game.SeasonPass {
+	if m.character == nil {
+		return game.SeasonPass{}
+	}
+	return m.character.SeasonPassAt(now, game.StreakClockFromConfig(m.config))
+}
+
+// initialSeasonTier returns the tier to select when the season pass opens:
+// the first one waiting to be claimed, else the next one to unlock.
+func (m Model) initialSeasonTier() int {
+	pass := m.seasonPass(time.Now())
+	next := len(pass.Tiers) - 1
+	for i, tier := range pass.Tiers {
+		if tier.Claimable() {
+			return i
+		}
+		if !tier.Unlocked && i < next {
+			next = i
+		}
+	}
+	return max(next, 0)
+}
+
+// handleSeasonKeys handles keys on the Season Pass screen.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) handleSeasonKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Left):
+		m.seasonSelected = max(m.seasonSelected-1, 0)
+		return m, nil
+	case key.Matches(msg, m.keys.Right):
+		m.seasonSelected = min(m.seasonSelected+1, len(game.SeasonTiers)-1)
+		return m, nil
+	case key.Matches(msg, m.keys.Enter):
+		return m.claimSeasonTier()
+	case key.Matches(msg, m.keys.Esc):
+		return m.switchScreen(ScreenDashboard)
+	}
+	return m, nil
+}
+
+// claimSeasonTier claims the selected tier, or equips its title if it was
+// already claimed.
+func (m Model) claimSeasonTier() (tea.Model, tea.Cmd) {
+	if m.character == nil || m.seasonSelected >= len(game.SeasonTiers) {
+		return m, nil
+	}
+	now := time.Now()
+	selected := m.seasonPass(now).Tiers[m.seasonSelected]
+
+	if selected.Claimed {
+		if selected.Kind != game.SeasonRewardTitle || m.character.Title == selected.Name {
+			return m, nil
+		}
+		if err := m.character.EquipTitle(selected.Name); err != nil {
+			return m.notifyAction(fmt.Sprintf("Can't equip title: %v", err), NotificationWarning)
+		}
+		model, notify := m.notifyAction("📜 Title equipped: "+selected.Name, NotificationSuccess)
+		return model, tea.Batch(m.saveStateCmd(), notify)
+	}
+
+	tier, err := m.character.ClaimSeasonTier(selected.Tier, now, game.StreakClockFromConfig(m.config))
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't claim tier %d: %v", selected.Tier, err), NotificationWarning)
+	}
+	model, notify := m.notifyAction(describeSeasonClaim(tier, m.character), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
+
+// claimAllSeasonTiers claims every tier waiting to be claimed. Tiers that
+// can't be claimed yet (a streak freeze while the stock is full) are left
+// for later.
+func (m Model) claimAllSeasonTiers() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+	now := time.Now()
+	clock := game.StreakClockFromConfig(m.config)
+
+	claimed := 0
+	for _, tier := range m.seasonPass(now).Tiers {
+		if !tier.Claimable() {
+			continue
+		}
+		if _, err := m.character.ClaimSeasonTier(tier.Tier, now, clock); err == nil {
+			claimed++
+		}
+	}
+	if claimed == 0 {
+		return m.notifyAction("Nothing to claim right now", NotificationWarning)
+	}
+	model, notify := m.notifyAction(fmt.Sprintf("🎁 Claimed %d season reward(s)", claimed), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
+
+// describeSeasonClaim returns the toast for a claimed tier.
+func describeSeasonClaim(tier game.SeasonTier, character *game.Character) string {
+	switch tier.Kind {
+	case game.SeasonRewardTitle:
+		return "📜 Title unlocked and equipped: " + tier.Name
+	case game.SeasonRewardStreakFreeze:
+		return fmt.Sprintf("🧊 Streak freeze claimed (%d held)", character.StreakFreezes)
+	}
+	return "🎨 Cosmetic collected: " + tier.Name
+}
+
+// viewSeason renders the Season Pass screen.
+func (m Model) viewSeason() string {
+	return screens.RenderSeasonPass(m.seasonPass(time.Now()), m.seasonSelected, m.width, m.height)
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestSeasonPassClaim tests opening the season pass from the dashboard on
+// the first claimable tier, claiming it and re-equipping a claimed title.
+func TestSeasonPassClaim(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	character.AddSeasonXP(600, time.Now(), game.StreakClockFromConfig(nil))
+	character.SeasonClaimed = []int{1}
+	m := Model{keys: NewKeyMap(), character: character, width: 120, height: 40}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if m.currentScreen != ScreenSeason || m.seasonSelected != 1 {
+		t.Fatalf("screen %v, selected %d; want the season pass on tier 2", m.currentScreen, m.seasonSelected)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if character.StreakFreezes != 1 {
+		t.Errorf("StreakFreezes = %d, want the tier 2 freeze", character.StreakFreezes)
+	}
+
+	// Tier 3 is a title; after claiming another, Enter on it equips it again
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	character.Titles = append(character.Titles, "Bug Slayer")
+	character.Title = "Bug Slayer"
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if character.Title != "the Diligent" {
+		t.Errorf("Title = %q, want the Diligent re-equipped", character.Title)
+	}
+
+	// Locked tiers can't be claimed
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if len(character.Cosmetics) != 0 || len(character.SeasonClaimed) != 3 {
+		t.Errorf("cosmetics %v, claimed %v; a locked tier should stay unclaimed", character.Cosmetics, character.SeasonClaimed)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.currentScreen != ScreenDashboard {
+		t.Errorf("Esc left the screen on %v, want the dashboard", m.currentScreen)
+	}
+}
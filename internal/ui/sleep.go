@@ -0,0 +1,115 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements sleep hygiene in the UI: the gentle warning when
+// late-night commits become a habit and the Settings switch that opts in to
+// late-night warnings and the Well Rested track.
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// lateNightMsg is sent when late-night commits become a habit.
+type lateNightMsg struct {
+	nights int // Late nights in the past week, tonight included
+}
+
+// warnLateNight queues a gentle late-night toast and logs it in the
+// activity feed.
+//
+// Parameters:
+//   - msg: The late nights so far this week
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) warnLateNight(msg lateNightMsg) Model {
+	m.recordActivity("🌙", fmt.Sprintf("Late night %d this week", msg.nights))
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("🌙 That's %d late nights this week.\nThe code will still be here in the morning - sleep well!", msg.nights),
+		Type:      NotificationInfo,
+		Duration:  5 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m
+}
+
+// sleepSettings returns the sleep hygiene status for the Settings screen.
+func (m Model) sleepSettings() screens.SleepSettings {
+	if m.config == nil {
+		return screens.SleepSettings{}
+	}
+	settings := screens.SleepSettings{
+		Enabled: m.config.Tracking.LateNightWarnings,
+		Bedtime: m.config.Tracking.BedtimeHour,
+	}
+	if m.character != nil {
+		now, clock := time.Now(), game.StreakClockFromConfig(m.config)
+		settings.LateNights = m.character.RecentLateNights(now, clock)
+		settings.RestedDays = m.character.RestedDays(now, clock)
+	}
+	return settings
+}
+
+// toggleLateNightWarnings switches late-night warnings on or off and
+// records the change for undo.
+func (m Model) toggleLateNightWarnings() (tea.Model, tea.Cmd) {
+	if m.config == nil || m.character == nil {
+		return m, nil
+	}
+	action := lateNightToggleAction{
+		enabled:     !m.config.Tracking.LateNightWarnings,
+		lateNights:  slices.Clone(m.character.LateNights),
+		warned:      m.character.LateNightWarned,
+		restedSince: m.character.RestedSince,
+	}
+	cmd, _ := action.redo(&m)
+	m.history.record(action, 0, time.Now())
+
+	message := "🌙 Late-night warnings off"
+	if action.enabled {
+		message = fmt.Sprintf("🌙 Late-night warnings on - bedtime is %02d:00", m.config.Tracking.BedtimeHour)
+	}
+	model, notify := m.notifyAction(message, NotificationInfo)
+	return model, tea.Batch(cmd, notify)
+}
+
+// lateNightToggleAction is switching late-night warnings on or off. Turning
+// them on starts counting rested days; turning them off forgets the late
+// nights, so undo puts back what was tracked before.
+type lateNightToggleAction struct {
+	enabled     bool      // State after the change
+	lateNights  []string  // Character.LateNights before the change
+	warned      string    // Character.LateNightWarned before the change
+	restedSince time.Time // Character.RestedSince before the change
+}
+
+func (a lateNightToggleAction) describe() string {
+	if a.enabled {
+		return "enabling late-night warnings"
+	}
+	return "disabling late-night warnings"
+}
+
+func (a lateNightToggleAction) undo(m *Model) (tea.Cmd, error) {
+	m.config.Tracking.LateNightWarnings = !a.enabled
+	m.character.LateNights = slices.Clone(a.lateNights)
+	m.character.LateNightWarned = a.warned
+	m.character.RestedSince = a.restedSince
+	return tea.Batch(saveConfigCmd(m.config), m.saveStateCmd()), nil
+}
+
+func (a lateNightToggleAction) redo(m *Model) (tea.Cmd, error) {
+	m.config.Tracking.LateNightWarnings = a.enabled
+	if a.enabled {
+		m.character.StartSleepTracking(time.Now())
+	} else {
+		m.character.StopSleepTracking()
+	}
+	return tea.Batch(saveConfigCmd(m.config), m.saveStateCmd()), nil
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestSettingsLateNightToggle tests opting in to late-night warnings from
+// the Settings screen and undoing it.
+func TestSettingsLateNightToggle(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	m := Model{
+		keys:             NewKeyMap(),
+		config:           config.DefaultConfig(),
+		character:        character,
+		currentScreen:    ScreenSettings,
+		settingsSelected: screens.SettingsSleepRow(),
+		width:            100,
+		height:           40,
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeySpace})
+	if !m.config.Tracking.LateNightWarnings || character.RestedSince.IsZero() {
+		t.Fatal("Space on the sleep row should turn warnings on and start counting rested days")
+	}
+	if !strings.Contains(m.View(), "Late nights this week") {
+		t.Error("Settings should show the late nights once warnings are on")
+	}
+
+	m = pressKey(t, m, ctrlZ)
+	if m.config.Tracking.LateNightWarnings || !character.RestedSince.IsZero() {
+		t.Error("undo should turn warnings back off and stop tracking")
+	}
+}
+
+// TestLateNightWarning tests the gentle toast for a late-night habit.
+func TestLateNightWarning(t *testing.T) {
+	m := Model{}
+	m = m.warnLateNight(lateNightMsg{nights: 3})
+
+	if len(m.notifications) != 1 || !strings.Contains(m.notifications[0].Message, "3 late nights") {
+		t.Errorf("notifications = %+v, want a late-night warning", m.notifications)
+	}
+	if len(m.activityFeed) != 1 {
+		t.Errorf("activity feed has %d items, want 1", len(m.activityFeed))
+	}
+}
@@ -0,0 +1,137 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements stat point allocation on the character sheet:
+// 1/2/3 spend a skill point on CodePower, Wisdom or Agility, R (pressed
+// twice to confirm) respecs all allocated points for an XP cost, and I
+// shows what each stat does at its current value. Tab and Shift+Tab move
+// focus between sections, and Enter expands the focused one.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// statAllocationKeys maps the character sheet number keys to stat keys.
+var statAllocationKeys = map[string]string{
+	"1": "code_power",
+	"2": "wisdom",
+	"3": "agility",
+}
+
+// handleCharacterKeys handles keyboard input specific to the character sheet.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands after an allocation or respec
+func (m Model) handleCharacterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// A pending respec only survives until the next key
+	confirming := m.respecConfirm
+	m.respecConfirm = false
+
+	// The stat details popover closes with I or Esc; allocating updates it live
+	if m.statDetails && (key.Matches(msg, m.keys.StatDetails) || key.Matches(msg, m.keys.Esc)) {
+		m.statDetails = false
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.StatDetails) && !m.featureUnlocked(game.FeatureStatDetails):
+		return m.lockedFeature(game.FeatureStatDetails)
+	case (key.Matches(msg, m.keys.AllocateStat) || key.Matches(msg, m.keys.Respec)) && !m.featureUnlocked(game.FeatureSkills):
+		return m.lockedFeature(game.FeatureSkills)
+	case key.Matches(msg, m.keys.StatDetails):
+		m.statDetails = true
+		return m, nil
+	case key.Matches(msg, m.keys.Tab):
+		m.characterFocus = m.characterFocus.Move(1)
+		return m, nil
+	case key.Matches(msg, m.keys.ShiftTab):
+		m.characterFocus = m.characterFocus.Move(-1)
+		return m, nil
+	case key.Matches(msg, m.keys.Enter) && m.characterFocus.Section != screens.CharacterSectionNone:
+		m.characterFocus.Expanded = !m.characterFocus.Expanded
+		return m, nil
+	case key.Matches(msg, m.keys.Esc) && m.characterFocus.Expanded:
+		m.characterFocus.Expanded = false
+		return m, nil
+	case msg.String() == "p" || msg.String() == "P":
+		return m.cycleProjectFilter()
+	case msg.String() == "f" || msg.String() == "F":
+		return m.buyStreakFreeze()
+	case msg.String() == "u" || msg.String() == "U":
+		return m.useXPScroll()
+	case key.Matches(msg, m.keys.AllocateStat):
+		return m.allocateStatPoint(statAllocationKeys[msg.String()])
+	case key.Matches(msg, m.keys.Respec):
+		if confirming {
+			return m.respec()
+		}
+		return m.confirmRespec()
+	}
+
+	// Escape key - return to dashboard
+	if key.Matches(msg, m.keys.Esc) {
+		return m.switchScreen(ScreenDashboard)
+	}
+	return m, nil
+}
+
+// allocateStatPoint spends one skill point on a stat and saves.
+func (m Model) allocateStatPoint(stat string) (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+	if err := m.character.AllocateStatPoint(stat, time.Now()); err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't allocate: %v", err), NotificationWarning)
+	}
+
+	value, _ := m.character.StatValue(stat)
+	model, notify := m.notifyAction(fmt.Sprintf("✨ +1 %s (now %d)", game.StatLabel(stat), value), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
+
+// confirmRespec explains what a respec would cost and arms the confirmation.
+func (m Model) confirmRespec() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m, nil
+	}
+
+	refund, cost := m.character.RespecRefund(), m.character.RespecCost()
+	switch {
+	case refund == 0:
+		return m.notifyAction("No allocated points to respec", NotificationInfo)
+	case m.character.XP < cost:
+		return m.notifyAction(fmt.Sprintf("Respec costs %d XP, you have %d", cost, m.character.XP), NotificationWarning)
+	}
+
+	m.respecConfirm = true
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("Respec refunds %d points for %d XP.\nPress R again to confirm", refund, cost),
+		Type:      NotificationWarning,
+		Duration:  5 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, m.showNextNotification()
+}
+
+// respec refunds all allocated points and saves.
+func (m Model) respec() (tea.Model, tea.Cmd) {
+	cost := m.character.RespecCost()
+	refund, err := m.character.Respec(time.Now())
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't respec: %v", err), NotificationWarning)
+	}
+	m.publishXPChange(-cost, game.XPSourceRespec)
+
+	model, notify := m.notifyAction(fmt.Sprintf("🔄 Respec complete: %d points refunded for %d XP", refund, cost), NotificationSuccess)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestCharacterStatAllocation tests spending a skill point from the character sheet.
+func TestCharacterStatAllocation(t *testing.T) {
+	character := veteranCharacter()
+	character.SkillPoints = 2
+
+	m := Model{keys: NewKeyMap(), character: character, currentScreen: ScreenCharacter, width: 100, height: 40}
+	if view := m.View(); !strings.Contains(view, "2 skill points to spend") {
+		t.Error("character sheet should show unspent skill points")
+	}
+
+	_, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	if cmd == nil {
+		t.Error("allocating should save state")
+	}
+	if character.Wisdom != game.BaseStatValue+1 || character.SkillPoints != 1 {
+		t.Errorf("Wisdom = %d, SkillPoints = %d; want 11 and 1", character.Wisdom, character.SkillPoints)
+	}
+}
+
+// TestCharacterRespecConfirm tests that a respec needs R twice in a row.
+func TestCharacterRespecConfirm(t *testing.T) {
+	character := veteranCharacter()
+	character.Agility = game.BaseStatValue + 3
+	character.XP = character.RespecCost()
+
+	m := Model{keys: NewKeyMap(), character: character, currentScreen: ScreenCharacter, width: 100, height: 40}
+	respecKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
+
+	// R arms the respec, any other key disarms it
+	model, _ := m.handleKeyPress(respecKey)
+	if !model.(Model).respecConfirm {
+		t.Fatal("first R should ask for confirmation")
+	}
+	model, _ = model.(Model).handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if model.(Model).respecConfirm || character.Agility != game.BaseStatValue+3 {
+		t.Fatal("another key should cancel the respec")
+	}
+
+	// R, R respecs
+	model, _ = model.(Model).handleKeyPress(respecKey)
+	_, cmd := model.(Model).handleKeyPress(respecKey)
+	if cmd == nil {
+		t.Error("respec should save state")
+	}
+	if character.Agility != game.BaseStatValue || character.SkillPoints != 3 || character.XP != 0 {
+		t.Errorf("Agility = %d, SkillPoints = %d, XP = %d; want 10, 3, 0",
+			character.Agility, character.SkillPoints, character.XP)
+	}
+}
+
+// TestCharacterStatDetails tests the stat details popover: I opens it,
+// allocating updates it, and Esc closes it without leaving the sheet.
+func TestCharacterStatDetails(t *testing.T) {
+	character := veteranCharacter()
+	character.SkillPoints = 1
+
+	m := Model{keys: NewKeyMap(), character: character, currentScreen: ScreenCharacter, width: 100, height: 40}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	if view := m.View(); !strings.Contains(view, "Wisdom 10 → +0% XP") {
+		t.Errorf("stat details should show Wisdom's effect, got:\n%s", view)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	if view := m.View(); !strings.Contains(view, "Wisdom 11 → +1% XP") {
+		t.Errorf("stat details should update after allocating, got:\n%s", view)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.statDetails || m.currentScreen != ScreenCharacter {
+		t.Errorf("Esc should close the popover and stay on the character sheet (screen %v)", m.currentScreen)
+	}
+}
+
+// TestCharacterSectionFocus tests moving focus between character sheet
+// sections and expanding the focused one.
+func TestCharacterSectionFocus(t *testing.T) {
+	character := veteranCharacter()
+	m := Model{keys: NewKeyMap(), character: character, currentScreen: ScreenCharacter, width: 120, height: 60}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyShiftTab})
+	if m.characterFocus.Section != screens.CharacterSectionLifetime {
+		t.Fatalf("Shift+Tab focused %v, want Lifetime", m.characterFocus.Section)
+	}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyTab})
+	if m.characterFocus.Section != screens.CharacterSectionIdentity {
+		t.Fatalf("Tab after the last section focused %v, want Identity", m.characterFocus.Section)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.characterFocus.Expanded || !strings.Contains(m.View(), "Joined:") {
+		t.Error("Enter should expand the focused section with its extra detail")
+	}
+
+	// Esc collapses first, then leaves the screen
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.characterFocus.Expanded || m.currentScreen != ScreenCharacter {
+		t.Fatal("Esc should collapse the expanded section and stay on the sheet")
+	}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.currentScreen != ScreenDashboard || m.characterFocus.Section != screens.CharacterSectionNone {
+		t.Errorf("second Esc left screen %v with focus %v, want the dashboard and focus reset", m.currentScreen, m.characterFocus.Section)
+	}
+}
@@ -0,0 +1,60 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file wires retention limits and storage into the UI: the Mentor
+// screen's chat history, the session timer's storage and the Settings
+// storage section.
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+	"github.com/AutumnsGrove/codequest/internal/watcher"
+)
+
+// storageUsageMsg carries freshly measured storage usage.
+type storageUsageMsg struct {
+	usage []storage.KeyUsage
+}
+
+// loadStorageUsageCmd measures the stored size of every key in the
+// background (each read is a Skate call).
+func loadStorageUsageCmd(storageClient *storage.Client) tea.Cmd {
+	return func() tea.Msg {
+		return storageUsageMsg{usage: storageClient.Usage()}
+	}
+}
+
+// retention returns the configured retention limits with defaults filled in.
+func (m Model) retention() config.RetentionConfig {
+	if m.config == nil {
+		return config.RetentionConfig{}.WithDefaults()
+	}
+	return m.config.Retention.WithDefaults()
+}
+
+// loadChatHistoryCmd loads the Mentor screen's saved chat thread, capped
+// at the chat history limit.
+func (m Model) loadChatHistoryCmd() tea.Cmd {
+	if m.mentorScreen == nil {
+		return nil
+	}
+	m.mentorScreen.SetHistoryLimit(m.retention().ChatHistoryMessages)
+	return m.mentorScreen.LoadHistory()
+}
+
+// sessionStorage returns where the session timer saves its state: nil
+// without a storage client, rather than a nil *storage.Client the tracker
+// would call.
+func sessionStorage(storageClient *storage.Client) watcher.Storage {
+	if storageClient == nil {
+		return nil
+	}
+	return storageClient
+}
+
+// storageData returns what the Settings storage section shows.
+func (m Model) storageData() screens.StorageData {
+	return screens.StorageData{Usage: m.storageUsage, Retention: m.retention()}
+}
@@ -404,6 +404,10 @@ func RenderProgressBar(current, total, width int, barType string) string {
 
 	bar := filledStyle.Render(filled) + emptyStyle.Render(empty)
 	percentText := fmt.Sprintf(" %d/%d (%.0f%%)", current, total, percentage*100)
+	if percentage >= 1.0 {
+		// Mark a full bar with a check too, not just its color
+		percentText += " ✓"
+	}
 
 	return bar + DimTextStyle.Render(percentText)
 }
@@ -511,12 +515,3 @@ func RenderQuestCompleteMessage(questTitle string, xpReward int) string {
 
 	return style.Render(message)
 }
-
-// RenderLoadingSpinner returns a styled loading indicator
-func RenderLoadingSpinner(frame int, text string) string {
-	spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
-	spinner := spinners[frame%len(spinners)]
-
-	spinnerStyle := lipgloss.NewStyle().Foreground(ColorAccent)
-	return spinnerStyle.Render(spinner) + " " + MutedTextStyle.Render(text)
-}
@@ -0,0 +1,85 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the toasts for task list sync: announcing the quests
+// added from unchecked items, and how a completed task quest's item was
+// ticked (the commit made, or the patch to apply).
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// taskListSyncedMsg is sent when the game handler synced the task lists.
+type taskListSyncedMsg struct {
+	added []string // Titles of the quests added from unchecked items
+}
+
+// taskTickedMsg is sent when a completed task quest's item was ticked.
+type taskTickedMsg struct {
+	task   game.TaskItem // The item ticked
+	commit string        // SHA of the commit that ticked it ("" if a patch was written)
+	patch  string        // Path of the patch that ticks it ("" if committed)
+}
+
+// announceTaskQuests queues a toast naming the quests added from task list
+// items. Nothing is shown when the sync added none.
+//
+// Parameters:
+//   - msg: The sync result
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) announceTaskQuests(msg taskListSyncedMsg) Model {
+	if len(msg.added) == 0 {
+		return m
+	}
+
+	noun := "task"
+	if len(msg.added) != 1 {
+		noun = "tasks"
+	}
+	m.recordActivity("📋", fmt.Sprintf("%d %s became quests", len(msg.added), noun))
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("📋 %d %s became quests\n%s", len(msg.added), noun, strings.Join(msg.added, "\n")),
+		Type:      NotificationInfo,
+		Duration:  4 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m
+}
+
+// announceTaskTicked queues a toast saying how a task list item was ticked:
+// the short SHA of the commit, or the command that applies the patch.
+//
+// Parameters:
+//   - msg: The ticked item
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) announceTaskTicked(msg taskTickedMsg) Model {
+	if msg.commit != "" {
+		sha := msg.commit
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		m.recordActivity("☑", "Ticked off "+msg.task.Text)
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("☑ TICKED OFF\n%s\nCommitted %s in %s", msg.task.Text, sha, msg.task.File),
+			Type:      NotificationSuccess,
+			Duration:  4 * time.Second,
+			Timestamp: time.Now(),
+		})
+		return m
+	}
+
+	m.addNotification(Notification{
+		Message:   fmt.Sprintf("☑ READY TO TICK OFF\n%s\nApply with: git apply %s", msg.task.Text, msg.patch),
+		Type:      NotificationInfo,
+		Duration:  6 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m
+}
@@ -0,0 +1,131 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the time log: every stretch the session timer runs
+// (start or resume until pause or quit) is saved with the repository
+// committed to most and the quest in progress, for `codequest timesheet`.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+)
+
+// timeStretch is the session timer's current uninterrupted stretch.
+type timeStretch struct {
+	start   time.Time      // When the timer started or resumed (zero when not running)
+	commits map[string]int // Commits per repository during the stretch
+}
+
+// timeLogSavedMsg is sent after a stretch is added to the time log.
+type timeLogSavedMsg struct {
+	err error
+}
+
+// startTimeStretch begins a stretch when the timer starts or resumes.
+func (m *Model) startTimeStretch() {
+	m.stretch = timeStretch{start: time.Now(), commits: map[string]int{}}
+}
+
+// noteStretchCommit counts a commit toward the running stretch's repository.
+//
+// Parameters:
+//   - repoPath: Repository the commit was made in
+func (m *Model) noteStretchCommit(repoPath string) {
+	if m.stretch.start.IsZero() || repoPath == "" {
+		return
+	}
+	m.stretch.commits[repoPath]++
+}
+
+// endTimeStretch closes the running stretch when the timer pauses or stops.
+//
+// Returns:
+//   - tea.Cmd: Saves the stretch to the time log (nil if none was running)
+func (m *Model) endTimeStretch() tea.Cmd {
+	entry, ok := m.finishTimeStretch(time.Now())
+	if !ok || m.storage == nil {
+		return nil
+	}
+	storageClient, policy := m.storage, game.RetentionPolicyFromConfig(m.config)
+	return func() tea.Msg {
+		return timeLogSavedMsg{err: appendTimeLog(storageClient, entry, policy)}
+	}
+}
+
+// finishTimeStretch turns the running stretch into a time entry and clears it.
+//
+// Parameters:
+//   - now: When the stretch ended
+//
+// Returns:
+//   - game.TimeEntry: The stretch, attributed to its busiest repo and the quest in progress
+//   - bool: False if no stretch was running
+func (m *Model) finishTimeStretch(now time.Time) (game.TimeEntry, bool) {
+	stretch := m.stretch
+	m.stretch = timeStretch{}
+	if stretch.start.IsZero() {
+		return game.TimeEntry{}, false
+	}
+	return game.TimeEntry{
+		Start: stretch.start,
+		End:   now,
+		Repo:  game.BusiestRepo(stretch.commits),
+		Quest: m.questInProgress(),
+	}, true
+}
+
+// questInProgress returns the title of the most recently started active
+// quest ("" if none is active).
+func (m Model) questInProgress() string {
+	var latest *game.Quest
+	for _, quest := range m.quests {
+		if quest.Status != game.QuestActive || quest.StartedAt == nil {
+			continue
+		}
+		if latest == nil || quest.StartedAt.After(*latest.StartedAt) {
+			latest = quest
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Title
+}
+
+// appendTimeLog adds an entry to the stored time log.
+func appendTimeLog(storageClient *storage.Client, entry game.TimeEntry, policy game.RetentionPolicy) error {
+	entries, err := storageClient.LoadTimeLog()
+	if err != nil {
+		return err
+	}
+	return storageClient.SaveTimeLog(game.AppendTimeEntry(entries, entry, policy, time.Now()))
+}
+
+// handleTimeLogSaved reports a time log that couldn't be saved. The timer
+// itself is unaffected.
+//
+// Parameters:
+//   - msg: The save result
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Warning notification command (nil on success)
+func (m Model) handleTimeLogSaved(msg timeLogSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err == nil {
+		return m, nil
+	}
+	return m.notifyAction(fmt.Sprintf("Couldn't save time log: %v", msg.err), NotificationWarning)
+}
+
+// saveTimeStretchNow saves the running stretch synchronously, for quitting.
+func (m *Model) saveTimeStretchNow() {
+	entry, ok := m.finishTimeStretch(time.Now())
+	if !ok || m.storage == nil {
+		return
+	}
+	_ = appendTimeLog(m.storage, entry, game.RetentionPolicyFromConfig(m.config))
+}
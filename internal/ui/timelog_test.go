@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestFinishTimeStretch tests attributing a timer stretch to its busiest
+// repository and the quest in progress.
+func TestFinishTimeStretch(t *testing.T) {
+	earlier, later := time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour)
+	tests := []struct {
+		name      string
+		running   bool
+		commits   []string
+		quests    []*game.Quest
+		wantOK    bool
+		wantRepo  string
+		wantQuest string
+	}{
+		{"not running", false, []string{"/src/api"}, nil, false, "", ""},
+		{"no commits or quests", true, nil, nil, true, "", ""},
+		{
+			"busiest repo and latest quest", true,
+			[]string{"/src/api", "/src/web", "/src/web", ""},
+			[]*game.Quest{
+				{Title: "Old Quest", Status: game.QuestActive, StartedAt: &earlier},
+				{Title: "New Quest", Status: game.QuestActive, StartedAt: &later},
+				{Title: "Done Quest", Status: game.QuestCompleted, StartedAt: &later},
+			},
+			true, "/src/web", "New Quest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{quests: tt.quests}
+			if tt.running {
+				m.startTimeStretch()
+			}
+			for _, repo := range tt.commits {
+				m.noteStretchCommit(repo)
+			}
+
+			entry, ok := m.finishTimeStretch(time.Now().Add(time.Hour))
+			if ok != tt.wantOK {
+				t.Fatalf("finishTimeStretch() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if entry.Repo != tt.wantRepo || entry.Quest != tt.wantQuest {
+				t.Errorf("entry = %+v, want repo %q quest %q", entry, tt.wantRepo, tt.wantQuest)
+			}
+			if !m.stretch.start.IsZero() {
+				t.Error("stretch still running after finishTimeStretch()")
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements quest suggestions from TODO/FIXME comments: the
+// watcher's periodic scans are kept as suggestions, and a picker on the
+// quest board (T) turns the selected comments into todo quests.
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// todoPickerRows is how many comments the picker shows at once.
+const todoPickerRows = 12
+
+// todoSuggestionsMsg is sent when watched repositories have been scanned
+// for TODO/FIXME comments.
+type todoSuggestionsMsg struct {
+	todos []game.TodoComment // Comments found across watched repositories
+}
+
+// todoPicker is the open TODO suggestion picker.
+type todoPicker struct {
+	todos    []game.TodoComment // Suggestions grouped by package
+	cursor   int                // Highlighted suggestion
+	selected map[string]bool    // Chosen suggestions by TodoComment.Key
+}
+
+// handleTodoSuggestions keeps the comments that don't have a quest yet as
+// suggestions, and mentions them when new ones turn up.
+//
+// Parameters:
+//   - msg: The scan results
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) handleTodoSuggestions(msg todoSuggestionsMsg) Model {
+	known := make(map[string]bool, len(m.todoSuggestions))
+	for _, todo := range m.todoSuggestions {
+		known[todo.Key()] = true
+	}
+
+	m.todoSuggestions = game.UnclaimedTodos(msg.todos, m.quests)
+	fresh := 0
+	for _, todo := range m.todoSuggestions {
+		if !known[todo.Key()] {
+			fresh++
+		}
+	}
+	if fresh > 0 {
+		m.addNotification(Notification{
+			Message:   fmt.Sprintf("📝 %d new TODO/FIXME comment(s) could become quests\nPress T on the quest board", fresh),
+			Type:      NotificationInfo,
+			Duration:  3 * time.Second,
+			Timestamp: time.Now(),
+		})
+	}
+	return m
+}
+
+// openTodoPicker opens the suggestion picker, grouped by package.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Notification when there is nothing to suggest
+func (m Model) openTodoPicker() (tea.Model, tea.Cmd) {
+	if len(m.todoSuggestions) == 0 {
+		return m.notifyAction("No TODO/FIXME comments found in watched repositories", NotificationInfo)
+	}
+
+	var todos []game.TodoComment
+	for _, group := range game.GroupTodos(m.todoSuggestions) {
+		todos = append(todos, group.Todos...)
+	}
+	m.todoPicker = &todoPicker{todos: todos, selected: make(map[string]bool)}
+	return m, nil
+}
+
+// handleTodoPickerKeys moves through the suggestions (↑/↓), selects them
+// (Space) and turns the selection, or the highlighted comment if nothing is
+// selected, into quests (Enter). Esc closes the picker.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands after converting
+func (m Model) handleTodoPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.todoPicker
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		if picker.cursor > 0 {
+			picker.cursor--
+		}
+	case key.Matches(msg, m.keys.Down):
+		if picker.cursor < len(picker.todos)-1 {
+			picker.cursor++
+		}
+	case key.Matches(msg, m.keys.Space):
+		todoKey := picker.todos[picker.cursor].Key()
+		picker.selected[todoKey] = !picker.selected[todoKey]
+	case key.Matches(msg, m.keys.Enter):
+		return m.convertTodos()
+	case key.Matches(msg, m.keys.Esc):
+		m.todoPicker = nil
+	}
+	return m, nil
+}
+
+// convertTodos starts a todo quest for each chosen comment and drops it
+// from the suggestions.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands
+func (m Model) convertTodos() (tea.Model, tea.Cmd) {
+	picker := m.todoPicker
+	m.todoPicker = nil
+
+	var chosen []game.TodoComment
+	for _, todo := range picker.todos {
+		if picker.selected[todo.Key()] {
+			chosen = append(chosen, todo)
+		}
+	}
+	if len(chosen) == 0 {
+		chosen = []game.TodoComment{picker.todos[picker.cursor]}
+	}
+
+	for _, todo := range chosen {
+		quest := game.NewTodoQuest(todo)
+		if err := quest.Start("", ""); err != nil {
+			return m.notifyAction(fmt.Sprintf("Couldn't start quest: %v", err), NotificationError)
+		}
+		m.quests = append(m.quests, quest)
+	}
+	m.todoSuggestions = game.UnclaimedTodos(m.todoSuggestions, m.quests)
+
+	message := fmt.Sprintf("⚔️ Quest started: %s", chosen[0].Title())
+	if len(chosen) > 1 {
+		message = fmt.Sprintf("⚔️ %d TODO quests started", len(chosen))
+	}
+	model, notify := m.notifyAction(message+"\nCommit the fix that removes the comment to complete it", NotificationSuccess)
+	return model, tea.Batch(saveQuestStateCmd(m.storage, m.quests), notify)
+}
+
+// viewTodoPicker renders the suggestion picker centered on screen, showing
+// a window of comments around the highlighted one under package headings.
+//
+// Returns:
+//   - string: The rendered picker
+func (m Model) viewTodoPicker() string {
+	picker := m.todoPicker
+	start := max(0, min(picker.cursor-todoPickerRows/2, len(picker.todos)-todoPickerRows))
+	end := min(len(picker.todos), start+todoPickerRows)
+
+	lines := []string{
+		TitleStyle.Render("📝 Quests from TODO Comments"),
+		SubtitleStyle.Render(fmt.Sprintf("%d comment(s) in watched repositories", len(picker.todos))),
+		"",
+	}
+	for i := start; i < end; i++ {
+		todo := picker.todos[i]
+		if i == start || todo.Repo != picker.todos[i-1].Repo || todo.Package() != picker.todos[i-1].Package() {
+			heading := filepath.Base(todo.Repo)
+			if todo.Package() != "." {
+				heading += "/" + todo.Package()
+			}
+			lines = append(lines, HeadingStyle.Render(heading))
+		}
+
+		marker := "  "
+		if i == picker.cursor {
+			marker = lipgloss.NewStyle().Foreground(ColorXP).Render("▸ ")
+		}
+		check := "[ ] "
+		if picker.selected[todo.Key()] {
+			check = SuccessTextStyle.Render("[x] ")
+		}
+		label := todo.Title()
+		if i == picker.cursor {
+			label = lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(label)
+		}
+		location := MutedTextStyle.Render(fmt.Sprintf("%s:%d", filepath.Base(todo.Path), todo.Line))
+		lines = append(lines, marker+check+label+"  "+location)
+	}
+	lines = append(lines,
+		"",
+		RenderKeybind("↑/↓", "move")+"  "+RenderKeybind("Space", "select")+"  "+RenderKeybind("Enter", "start quests")+"  "+RenderKeybind("Esc", "close"),
+	)
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return PlaceInCenter(m.width, m.height, box)
+}
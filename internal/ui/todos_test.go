@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestTodoSuggestionsToQuests tests that scanned comments become
+// suggestions and that the picker starts quests for the selected ones.
+func TestTodoSuggestionsToQuests(t *testing.T) {
+	todos := []game.TodoComment{
+		{Repo: "/work/app", Path: "main.go", Line: 3, Kind: "TODO", Text: "parse flags"},
+		{Repo: "/work/app", Path: "db/db.go", Line: 8, Kind: "FIXME", Text: "close rows"},
+		{Repo: "/work/app", Path: "db/db.go", Line: 20, Kind: "TODO", Text: "add index"},
+	}
+	claimed := game.NewTodoQuest(todos[2])
+
+	m := Model{keys: NewKeyMap(), character: veteranCharacter(), quests: []*game.Quest{claimed}, width: 100, height: 40, currentScreen: ScreenQuestBoard}
+	model, _ := m.Update(todoSuggestionsMsg{todos: todos})
+	m = model.(Model)
+	if len(m.todoSuggestions) != 2 {
+		t.Fatalf("suggestions = %+v, want the two comments without a quest", m.todoSuggestions)
+	}
+	if m.currentNotification == nil || !strings.Contains(m.currentNotification.Message, "2 new TODO/FIXME") {
+		t.Errorf("notification = %+v, want the new suggestions mentioned", m.currentNotification)
+	}
+
+	// Grouped by package: the root package before db
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if m.todoPicker == nil || m.todoPicker.todos[0].Text != "parse flags" {
+		t.Fatalf("picker = %+v, want main.go first", m.todoPicker)
+	}
+	if view := m.View(); !strings.Contains(view, "Quests from TODO Comments") || !strings.Contains(view, "app/db") {
+		t.Error("view should show the picker grouped by package")
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyDown})
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	model, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	m = model.(Model)
+	if cmd == nil {
+		t.Error("starting quests should save them")
+	}
+	if m.todoPicker != nil || len(m.todoSuggestions) != 0 || len(m.quests) != 3 {
+		t.Fatalf("picker open = %v, suggestions = %d, quests = %d; want closed, 0, 3", m.todoPicker != nil, len(m.todoSuggestions), len(m.quests))
+	}
+	for _, quest := range m.quests[1:] {
+		if quest.Type != game.QuestTypeTodo || quest.Status != game.QuestActive || quest.Todo == nil {
+			t.Errorf("quest %q = %s %s, want an active todo quest", quest.Title, quest.Type, quest.Status)
+		}
+	}
+
+	// The same scan again brings nothing new
+	m.currentNotification = nil
+	m.notifications = nil
+	model, _ = m.Update(todoSuggestionsMsg{todos: todos})
+	m = model.(Model)
+	if m.currentNotification != nil {
+		t.Errorf("notification = %q, want none for known comments", m.currentNotification.Message)
+	}
+}
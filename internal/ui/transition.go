@@ -0,0 +1,148 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements lightweight frame-based transitions used when
+// switching screens and opening modals.
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Transition timing - short enough to feel snappy, long enough to be noticed
+const (
+	transitionFrames        = 6                     // Number of interpolation frames
+	transitionFrameInterval = 25 * time.Millisecond // Delay between frames (~150ms total)
+	slideDistanceDivisor    = 6                     // Slide starts width/6 columns away
+)
+
+// TransitionKind identifies which visual effect a transition uses.
+type TransitionKind int
+
+const (
+	// TransitionNone means no transition is running
+	TransitionNone TransitionKind = iota
+
+	// TransitionSlide slides screen content in horizontally
+	TransitionSlide
+
+	// TransitionFade fades modal content in from dim to full color
+	TransitionFade
+)
+
+// Transition tracks the state of a running transition animation.
+// Each transition has a sequence ID so stale frame ticks from an earlier
+// transition are ignored when a new one starts.
+type Transition struct {
+	Kind      TransitionKind // Effect being played
+	ID        int            // Sequence number of this transition
+	Frame     int            // Current frame (0..transitionFrames)
+	Direction int            // Slide direction: 1 = from the right, -1 = from the left
+}
+
+// transitionFrameMsg advances the transition with the matching ID by one frame.
+type transitionFrameMsg struct {
+	id int
+}
+
+// newTransition creates a transition following the previous one in sequence.
+//
+// Parameters:
+//   - previous: The last transition (used to derive the next sequence ID)
+//   - kind: The effect to play
+//   - direction: Slide direction (ignored for fades)
+//
+// Returns:
+//   - Transition: A transition positioned at its first frame
+func newTransition(previous Transition, kind TransitionKind, direction int) Transition {
+	return Transition{
+		Kind:      kind,
+		ID:        previous.ID + 1,
+		Frame:     0,
+		Direction: direction,
+	}
+}
+
+// transitionTick returns a command that advances the given transition.
+func transitionTick(id int) tea.Cmd {
+	return tea.Tick(transitionFrameInterval, func(time.Time) tea.Msg {
+		return transitionFrameMsg{id: id}
+	})
+}
+
+// Active reports whether the transition still has frames to play.
+func (t Transition) Active() bool {
+	return t.Kind != TransitionNone && t.Frame < transitionFrames
+}
+
+// Progress returns the eased completion of the transition in [0, 1].
+// An ease-out cubic curve makes motion start fast and settle gently.
+func (t Transition) Progress() float64 {
+	if !t.Active() {
+		return 1
+	}
+	linear := float64(t.Frame) / float64(transitionFrames)
+	inverse := 1 - linear
+	return 1 - inverse*inverse*inverse
+}
+
+// Apply renders content as it should appear at the current frame.
+// Inactive transitions return the content unchanged.
+//
+// Parameters:
+//   - content: The fully rendered content
+//   - width: Available width in columns (used to clip slid content)
+//
+// Returns:
+//   - string: The content with the transition effect applied
+func (t Transition) Apply(content string, width int) string {
+	if !t.Active() {
+		return content
+	}
+
+	switch t.Kind {
+	case TransitionSlide:
+		return slideContent(content, width, t.Progress(), t.Direction)
+	case TransitionFade:
+		return fadeContent(content, t.Progress())
+	default:
+		return content
+	}
+}
+
+// slideContent offsets every line horizontally based on progress.
+// Content sliding in from the right is padded on the left; content
+// sliding in from the left has its leading columns cut off.
+func slideContent(content string, width int, progress float64, direction int) string {
+	offset := int(float64(width/slideDistanceDivisor) * (1 - progress))
+	if offset <= 0 || width <= 0 {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if direction >= 0 {
+			lines[i] = ansi.Truncate(strings.Repeat(" ", offset)+line, width, "")
+		} else {
+			lines[i] = ansi.TruncateLeft(line, offset, "")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fadeContent approximates a fade-in on terminals that lack transparency:
+// early frames render the content without styling in a dim color, later
+// frames show the fully styled content.
+func fadeContent(content string, progress float64) string {
+	if progress >= 0.5 {
+		return content
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(ColorDim).
+		Faint(progress < 0.25).
+		Render(ansi.Strip(content))
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestTransitionProgress tests that progress eases from 0 to 1 across frames
+func TestTransitionProgress(t *testing.T) {
+	tr := newTransition(Transition{}, TransitionSlide, 1)
+
+	if !tr.Active() {
+		t.Fatal("new transition should be active")
+	}
+	if tr.Progress() != 0 {
+		t.Errorf("Progress() at frame 0 = %v, want 0", tr.Progress())
+	}
+
+	last := tr.Progress()
+	for tr.Active() {
+		tr.Frame++
+		if tr.Progress() < last {
+			t.Errorf("Progress() decreased at frame %d: %v < %v", tr.Frame, tr.Progress(), last)
+		}
+		last = tr.Progress()
+	}
+
+	if tr.Progress() != 1 {
+		t.Errorf("Progress() after final frame = %v, want 1", tr.Progress())
+	}
+}
+
+// TestTransitionApply tests slide and fade effects
+func TestTransitionApply(t *testing.T) {
+	content := "hello\nworld"
+
+	tests := []struct {
+		name      string
+		kind      TransitionKind
+		direction int
+		frame     int
+		check     func(string) bool
+	}{
+		{
+			name:  "no transition leaves content unchanged",
+			kind:  TransitionNone,
+			check: func(s string) bool { return s == content },
+		},
+		{
+			name:      "slide from right pads lines",
+			kind:      TransitionSlide,
+			direction: 1,
+			check:     func(s string) bool { return strings.HasPrefix(s, " ") && strings.Contains(s, "hello") },
+		},
+		{
+			name:      "slide from left clips leading columns",
+			kind:      TransitionSlide,
+			direction: -1,
+			check:     func(s string) bool { return !strings.Contains(s, "hello") },
+		},
+		{
+			name:  "finished slide leaves content unchanged",
+			kind:  TransitionSlide,
+			frame: transitionFrames,
+			check: func(s string) bool { return s == content },
+		},
+		{
+			name:  "fade keeps text",
+			kind:  TransitionFade,
+			check: func(s string) bool { return ansi.Strip(s) == content },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := Transition{Kind: tt.kind, Direction: tt.direction, Frame: tt.frame}
+			got := tr.Apply(content, 60)
+			if !tt.check(got) {
+				t.Errorf("Apply() = %q", got)
+			}
+		})
+	}
+}
+
+// TestStartTransitionReducedMotion tests that reduced motion disables animations
+func TestStartTransitionReducedMotion(t *testing.T) {
+	tests := []struct {
+		name          string
+		showAnimation bool
+		reducedMotion bool
+		wantActive    bool
+	}{
+		{"animations on", true, false, true},
+		{"reduced motion", true, true, false},
+		{"animations off", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.UI.ShowAnimations = tt.showAnimation
+			cfg.UI.ReducedMotion = tt.reducedMotion
+
+			m := Model{config: cfg}
+			cmd := m.startTransition(TransitionSlide, 1)
+
+			if m.transition.Active() != tt.wantActive {
+				t.Errorf("transition active = %v, want %v", m.transition.Active(), tt.wantActive)
+			}
+			if (cmd != nil) != tt.wantActive {
+				t.Errorf("startTransition() cmd = %v, want non-nil %v", cmd != nil, tt.wantActive)
+			}
+		})
+	}
+}
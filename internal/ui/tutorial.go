@@ -0,0 +1,191 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the guided tutorial: a short tour that walks through
+// each screen with a callout pointing at the part being explained.
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tutorialPlacement says where a callout's target sits on screen, so the
+// callout can be drawn next to it with an arrow pointing the right way.
+type tutorialPlacement int
+
+const (
+	tutorialTop    tutorialPlacement = iota // Target is near the top (header, XP bar)
+	tutorialBottom                          // Target is near the bottom (key hints, input)
+)
+
+// tutorialStep is one callout in the guided tour.
+type tutorialStep struct {
+	screen    Screen            // Screen shown while the callout is up
+	placement tutorialPlacement // Where the highlighted element is
+	title     string            // Short callout heading
+	body      string            // What the element does
+}
+
+// tutorialSteps builds the tour. Key names come from the key map so the
+// text matches any rebound keys.
+func tutorialSteps(keys *KeyMap) []tutorialStep {
+	return []tutorialStep{
+		{
+			screen:    ScreenDashboard,
+			placement: tutorialTop,
+			title:     "Welcome to CodeQuest!",
+			body:      "Your commits earn XP, level up your character and complete quests.\nThis quick tour shows you around.",
+		},
+		{
+			screen:    ScreenDashboard,
+			placement: tutorialTop,
+			title:     "This is your XP bar",
+			body:      "Every commit fills it. When it's full you level up and earn skill points.",
+		},
+		{
+			screen:    ScreenDashboard,
+			placement: tutorialBottom,
+			title:     fmt.Sprintf("Press %s to open quests", keys.DashboardQuests.Help().Key),
+			body:      "The dashboard keys below jump to every screen. Let's look at quests next.",
+		},
+		{
+			screen:    ScreenQuestBoard,
+			placement: tutorialTop,
+			title:     "The Quest Board",
+			body: fmt.Sprintf("Pick a quest with %s/%s, press %s to open it and S to start it.\nActive quests progress automatically as you commit.",
+				keys.Up.Help().Key, keys.Down.Help().Key, keys.Enter.Help().Key),
+		},
+		{
+			screen:    ScreenCharacter,
+			placement: tutorialTop,
+			title:     "Your Character Sheet",
+			body:      "Stats, streaks and lifetime history live here.\nCode every day to keep your streak alive.",
+		},
+		{
+			screen:    ScreenMentor,
+			placement: tutorialBottom,
+			title:     "Ask the AI Mentor",
+			body:      "Type a question below for help with code, reviews or bugs.",
+		},
+		{
+			screen:    ScreenDashboard,
+			placement: tutorialBottom,
+			title:     "You're ready!",
+			body: fmt.Sprintf("Press %s on any screen for help, and %s from help to replay this tour.\nNow go make a commit!",
+				keys.HelpOverlay.Help().Key, keys.Tutorial.Help().Key),
+		},
+	}
+}
+
+// startTutorial opens the guided tour at its first step.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Screen transition to the first step
+func (m Model) startTutorial() (tea.Model, tea.Cmd) {
+	m.showingTutorial = true
+	m.tutorialStep = 0
+	m.showingHelp = false
+	return m.showTutorialStep()
+}
+
+// showTutorialStep switches to the screen the current step talks about.
+func (m Model) showTutorialStep() (tea.Model, tea.Cmd) {
+	steps := tutorialSteps(m.keys)
+	return m.switchScreen(steps[m.tutorialStep].screen)
+}
+
+// handleTutorialKeys advances the tour with Enter and skips it with Esc.
+// All other keys are swallowed so the tour can't be left half-way by accident.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Screen transition or save command
+func (m Model) handleTutorialKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		if m.tutorialStep+1 < len(tutorialSteps(m.keys)) {
+			m.tutorialStep++
+			return m.showTutorialStep()
+		}
+		return m.finishTutorial()
+	case "esc":
+		return m.finishTutorial()
+	}
+	return m, nil
+}
+
+// finishTutorial closes the tour, returns to the dashboard and records the
+// tour as completed (finished or skipped) so it doesn't start on its own again.
+func (m Model) finishTutorial() (tea.Model, tea.Cmd) {
+	m.showingTutorial = false
+	m.tutorialStep = 0
+
+	var save tea.Cmd
+	if m.character != nil && !m.character.TutorialCompleted {
+		m.character.TutorialCompleted = true
+		save = m.saveStateCmd()
+	}
+
+	// Rewards that arrived during the tour can be picked now
+	model, cmd := m.switchScreen(ScreenDashboard)
+	model = model.(Model).openPendingReward().openFlaggedReview()
+	return model, tea.Batch(cmd, save)
+}
+
+// viewTutorial draws the current step's callout next to the element it
+// explains: above the screen for top elements, below it for bottom ones.
+//
+// Parameters:
+//   - mainContent: The rendered screen being toured
+//
+// Returns:
+//   - string: The screen with the callout attached
+func (m Model) viewTutorial(mainContent string) string {
+	steps := tutorialSteps(m.keys)
+	if m.tutorialStep >= len(steps) {
+		return mainContent
+	}
+	step := steps[m.tutorialStep]
+
+	progress := fmt.Sprintf("Step %d/%d", m.tutorialStep+1, len(steps))
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render("✨ "+step.title),
+		"",
+		step.body,
+		"",
+		MutedTextStyle.Render(progress+" · ")+
+			RenderKeybind("Enter", "next")+MutedTextStyle.Render(" · ")+
+			RenderKeybind("Esc", "skip tour"),
+	)
+
+	callout := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorXP).
+		Padding(0, 2).
+		Render(content)
+
+	arrowStyle := lipgloss.NewStyle().Foreground(ColorXP).Bold(true)
+	center := lipgloss.NewStyle().Width(m.width).Align(lipgloss.Center)
+
+	// Point the arrow at the highlighted element
+	if step.placement == tutorialTop {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			center.Render(callout),
+			center.Render(arrowStyle.Render("▼")),
+			mainContent,
+		)
+	}
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		mainContent,
+		center.Render(arrowStyle.Render("▲")),
+		center.Render(callout),
+	)
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestTutorialStartsOnFirstRun tests that only a newly created character
+// triggers the tour, and never once it has been completed.
+func TestTutorialStartsOnFirstRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		firstRun  bool
+		completed bool
+		want      bool
+	}{
+		{name: "new character", firstRun: true, want: true},
+		{name: "returning player", firstRun: false, want: false},
+		{name: "already completed", firstRun: true, completed: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			character.TutorialCompleted = tt.completed
+
+			m := Model{keys: NewKeyMap(), loading: true}
+			model, _ := m.Update(characterLoadedMsg{character: character, firstRun: tt.firstRun})
+			if got := model.(Model).showingTutorial; got != tt.want {
+				t.Errorf("showingTutorial = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTutorialFlow tests advancing through the tour's screens and finishing it.
+func TestTutorialFlow(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	m := Model{keys: NewKeyMap(), character: character, width: 100, height: 40}
+
+	model, _ := m.startTutorial()
+	m = model.(Model)
+	steps := tutorialSteps(m.keys)
+
+	for i := range steps {
+		if m.tutorialStep != i || m.currentScreen != steps[i].screen {
+			t.Fatalf("step %d: at step %d on screen %v, want screen %v",
+				i, m.tutorialStep, m.currentScreen, steps[i].screen)
+		}
+		if !strings.Contains(m.View(), steps[i].title) {
+			t.Errorf("step %d: view should show callout %q", i, steps[i].title)
+		}
+
+		// Other keys don't leak through to the screen underneath
+		model, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+		if model.(Model).currentScreen != m.currentScreen {
+			t.Fatalf("step %d: stray key changed the screen", i)
+		}
+
+		model, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+		m = model.(Model)
+	}
+
+	if m.showingTutorial || m.currentScreen != ScreenDashboard {
+		t.Errorf("after last step: showingTutorial = %v, screen = %v; want closed on dashboard",
+			m.showingTutorial, m.currentScreen)
+	}
+	if !character.TutorialCompleted {
+		t.Error("finishing the tour should mark it completed")
+	}
+}
+
+// TestTutorialSkipAndReplay tests skipping with Esc and replaying from help.
+func TestTutorialSkipAndReplay(t *testing.T) {
+	character := game.NewCharacter("Tester")
+	m := Model{keys: NewKeyMap(), character: character, width: 100, height: 40}
+
+	model, _ := m.startTutorial()
+	model, _ = model.(Model).handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	m = model.(Model)
+	if m.showingTutorial || !character.TutorialCompleted {
+		t.Fatal("Esc should skip the tour and mark it completed")
+	}
+
+	m.showingHelp = true
+	model, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	m = model.(Model)
+	if !m.showingTutorial || m.showingHelp || m.tutorialStep != 0 {
+		t.Errorf("T in help should restart the tour (tutorial = %v, help = %v, step = %d)",
+			m.showingTutorial, m.showingHelp, m.tutorialStep)
+	}
+}
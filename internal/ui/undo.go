@@ -0,0 +1,383 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements global undo/redo (Ctrl+Z / Ctrl+Y) for reversible UI
+// actions: quest board and project filter changes, pinning and moving
+// quests on the board, and starting or abandoning a quest within a short
+// grace period.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/storage"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// undoGracePeriod is how long a quest start or abandon can be undone.
+// After that, commits may have been counted toward the quest, so reverting
+// it would be surprising rather than forgiving.
+const undoGracePeriod = 30 * time.Second
+
+// maxUndoHistory caps how many actions are remembered for undo.
+const maxUndoHistory = 50
+
+// uiAction is a reversible UI action (command pattern).
+// Actions capture both the before and after state, so undo and redo are
+// plain state swaps that don't depend on what else the model did since.
+type uiAction interface {
+	// describe names the action for undo/redo notifications
+	describe() string
+
+	// undo reverts the action on the model
+	undo(m *Model) (tea.Cmd, error)
+
+	// redo re-applies the action on the model
+	redo(m *Model) (tea.Cmd, error)
+}
+
+// undoEntry is one action in the undo history.
+type undoEntry struct {
+	action  uiAction
+	expires time.Time // Undo/redo refused after this (zero = never expires)
+}
+
+// undoHistory holds the undo and redo stacks.
+type undoHistory struct {
+	done   []undoEntry // Actions that can be undone (most recent last)
+	undone []undoEntry // Actions that can be redone (most recent last)
+}
+
+// record pushes a new action onto the undo stack. A new action invalidates
+// anything that was undone before it.
+//
+// Parameters:
+//   - action: The action that was just performed
+//   - grace: How long it stays undoable (0 = no limit)
+//   - now: When the action was performed
+func (h *undoHistory) record(action uiAction, grace time.Duration, now time.Time) {
+	entry := undoEntry{action: action}
+	if grace > 0 {
+		entry.expires = now.Add(grace)
+	}
+
+	h.done = append(h.done, entry)
+	if len(h.done) > maxUndoHistory {
+		h.done = h.done[len(h.done)-maxUndoHistory:]
+	}
+	h.undone = nil
+}
+
+// filterState is the quest list filtering that filter actions swap between.
+type filterState struct {
+	questFilter screens.QuestFilter
+	project     string
+	selected    int
+}
+
+// filterAction is a change to the quest board or project filter.
+type filterAction struct {
+	label  string
+	before filterState
+	after  filterState
+}
+
+func (a filterAction) describe() string { return a.label }
+
+func (a filterAction) undo(m *Model) (tea.Cmd, error) {
+	m.setFilterState(a.before)
+	return nil, nil
+}
+
+func (a filterAction) redo(m *Model) (tea.Cmd, error) {
+	m.setFilterState(a.after)
+	return nil, nil
+}
+
+// questState is the part of a quest that starting or abandoning changes.
+type questState struct {
+	status     game.QuestStatus
+	startedAt  *time.Time
+	current    int
+	progress   float64
+	gitRepo    string
+	gitBaseSHA string
+}
+
+// captureQuestState snapshots a quest's lifecycle fields.
+func captureQuestState(quest *game.Quest) questState {
+	return questState{
+		status:     quest.Status,
+		startedAt:  quest.StartedAt,
+		current:    quest.Current,
+		progress:   quest.Progress,
+		gitRepo:    quest.GitRepo,
+		gitBaseSHA: quest.GitBaseSHA,
+	}
+}
+
+// restore writes the snapshot back onto the quest.
+func (s questState) restore(quest *game.Quest) {
+	quest.Status = s.status
+	quest.StartedAt = s.startedAt
+	quest.Current = s.current
+	quest.Progress = s.progress
+	quest.GitRepo = s.gitRepo
+	quest.GitBaseSHA = s.gitBaseSHA
+}
+
+// questAction is a quest being started or abandoned from the quest detail view.
+type questAction struct {
+	label   string
+	questID string
+	before  questState
+	after   questState
+	effort  int // Effort refunded by the action (abandoning with progress)
+}
+
+func (a questAction) describe() string { return a.label }
+
+func (a questAction) undo(m *Model) (tea.Cmd, error) {
+	// The refund is taken back, so it can't have been spent in the meantime
+	if a.effort > 0 && (m.character == nil || m.character.Effort < a.effort) {
+		return nil, fmt.Errorf("the refunded effort was already spent")
+	}
+
+	cmd, err := m.swapQuestState(a.questID, a.after, a.before)
+	if err != nil || a.effort == 0 {
+		return cmd, err
+	}
+	m.character.Effort -= a.effort
+	return m.saveStateCmd(), nil
+}
+
+func (a questAction) redo(m *Model) (tea.Cmd, error) {
+	cmd, err := m.swapQuestState(a.questID, a.before, a.after)
+	if err != nil || a.effort == 0 || m.character == nil {
+		return cmd, err
+	}
+	m.character.EarnEffort(a.effort)
+	return m.saveStateCmd(), nil
+}
+
+// swapQuestState moves a quest from one snapshot to another, refusing if the
+// quest changed in the meantime (e.g. a commit counted toward it).
+func (m *Model) swapQuestState(questID string, from, to questState) (tea.Cmd, error) {
+	quest := m.findQuest(questID)
+	if quest == nil {
+		return nil, fmt.Errorf("quest no longer exists")
+	}
+	if captureQuestState(quest) != from {
+		return nil, fmt.Errorf("%s has changed since", quest.Title)
+	}
+
+	to.restore(quest)
+	return saveQuestStateCmd(m.storage, m.quests), nil
+}
+
+// findQuest looks a quest up by ID.
+func (m Model) findQuest(questID string) *game.Quest {
+	for _, quest := range m.quests {
+		if quest.ID == questID {
+			return quest
+		}
+	}
+	return nil
+}
+
+// currentFilterState returns the filter state the quest lists use now.
+func (m Model) currentFilterState() filterState {
+	return filterState{
+		questFilter: m.questBoardFilter,
+		project:     m.projectFilter,
+		selected:    m.questBoardSelectedIndex,
+	}
+}
+
+// setFilterState restores a filter state.
+func (m *Model) setFilterState(state filterState) {
+	m.questBoardFilter = state.questFilter
+	m.projectFilter = state.project
+	m.questBoardSelectedIndex = state.selected
+}
+
+// recordFilterChange records a filter change for undo if anything changed.
+//
+// Parameters:
+//   - label: What changed, for notifications (e.g. "quest filter")
+//   - before: Filter state before the change
+func (m *Model) recordFilterChange(label string, before filterState) {
+	after := m.currentFilterState()
+	if after == before {
+		return
+	}
+	m.history.record(filterAction{label: label, before: before, after: after}, 0, time.Now())
+}
+
+// startQuest starts the quest shown in the detail view, or the one selected
+// on the quest board when no quest is open. It can be undone within the
+// grace period.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command and notification
+func (m Model) startQuest() (tea.Model, tea.Cmd) {
+	quest := m.questDetail
+	if quest == nil {
+		quest = m.selectedBoardQuest()
+	}
+	return m.beginQuest(quest)
+}
+
+// beginQuest starts an available quest, if the character meets its
+// requirements. It can be undone within the grace period.
+//
+// Parameters:
+//   - quest: The quest to start (nil does nothing)
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command and notification
+func (m Model) beginQuest(quest *game.Quest) (tea.Model, tea.Cmd) {
+	if quest == nil || quest.Status != game.QuestAvailable {
+		return m, nil
+	}
+	if m.character != nil && !quest.IsAvailable(m.character) {
+		return m.notifyAction(fmt.Sprintf("🔒 %s is still locked", quest.Title), NotificationWarning)
+	}
+
+	before := captureQuestState(quest)
+	if err := quest.Start("", ""); err != nil {
+		return m.notifyAction(fmt.Sprintf("Couldn't start quest: %v", err), NotificationError)
+	}
+	m.history.record(questAction{
+		label:   "start " + quest.Title,
+		questID: quest.ID,
+		before:  before,
+		after:   captureQuestState(quest),
+	}, undoGracePeriod, time.Now())
+
+	model, notify := m.notifyAction(fmt.Sprintf("⚔️ Quest started: %s\nCtrl+Z to undo", quest.Title), NotificationSuccess)
+	return model, tea.Batch(saveQuestStateCmd(m.storage, m.quests), notify)
+}
+
+// abandonQuest gives up the active quest shown in the detail view, clearing
+// its progress. Part of the progress is refunded as Effort. It can be undone
+// within the grace period.
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command and notification
+func (m Model) abandonQuest() (tea.Model, tea.Cmd) {
+	quest := m.questDetail
+	if quest.Status != game.QuestActive {
+		return m, nil
+	}
+
+	refund := 0
+	if m.character != nil {
+		refund = game.AbandonRefund(quest)
+	}
+
+	before := captureQuestState(quest)
+	quest.Reset()
+	m.history.record(questAction{
+		label:   "abandon " + quest.Title,
+		questID: quest.ID,
+		before:  before,
+		after:   captureQuestState(quest),
+		effort:  refund,
+	}, undoGracePeriod, time.Now())
+
+	if refund == 0 {
+		model, notify := m.notifyAction(fmt.Sprintf("🏳️ Quest abandoned: %s\nCtrl+Z to undo", quest.Title), NotificationWarning)
+		return model, tea.Batch(saveQuestStateCmd(m.storage, m.quests), notify)
+	}
+
+	balance := m.character.EarnEffort(refund)
+	m.publishEffort(game.EventEffortEarned, refund, balance, "Abandoned "+quest.Title)
+	model, notify := m.notifyAction(fmt.Sprintf("🏳️ Quest abandoned: %s\n+%d Effort from your progress. Ctrl+Z to undo", quest.Title, refund), NotificationWarning)
+	return model, tea.Batch(m.saveStateCmd(), notify)
+}
+
+// undo reverts the most recent action (Ctrl+Z).
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command (quest actions) and notification
+func (m Model) undo() (tea.Model, tea.Cmd) {
+	if len(m.history.done) == 0 {
+		return m.notifyAction("Nothing to undo", NotificationInfo)
+	}
+
+	entry := m.history.done[len(m.history.done)-1]
+	m.history.done = m.history.done[:len(m.history.done)-1]
+
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		return m.notifyAction(fmt.Sprintf("Too late to undo %s", entry.action.describe()), NotificationWarning)
+	}
+
+	cmd, err := entry.action.undo(&m)
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't undo %s: %v", entry.action.describe(), err), NotificationWarning)
+	}
+
+	m.history.undone = append(m.history.undone, entry)
+	model, notify := m.notifyAction("↶ Undid "+entry.action.describe(), NotificationInfo)
+	return model, tea.Batch(cmd, notify)
+}
+
+// redo re-applies the most recently undone action (Ctrl+Y).
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save command (quest actions) and notification
+func (m Model) redo() (tea.Model, tea.Cmd) {
+	if len(m.history.undone) == 0 {
+		return m.notifyAction("Nothing to redo", NotificationInfo)
+	}
+
+	entry := m.history.undone[len(m.history.undone)-1]
+	m.history.undone = m.history.undone[:len(m.history.undone)-1]
+
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		return m.notifyAction(fmt.Sprintf("Too late to redo %s", entry.action.describe()), NotificationWarning)
+	}
+
+	cmd, err := entry.action.redo(&m)
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't redo %s: %v", entry.action.describe(), err), NotificationWarning)
+	}
+
+	m.history.done = append(m.history.done, entry)
+	model, notify := m.notifyAction("↷ Redid "+entry.action.describe(), NotificationInfo)
+	return model, tea.Batch(cmd, notify)
+}
+
+// notifyAction shows a short notification about an undoable action.
+func (m Model) notifyAction(message string, kind NotificationType) (tea.Model, tea.Cmd) {
+	m.addNotification(Notification{
+		Message:   message,
+		Type:      kind,
+		Duration:  2 * time.Second,
+		Timestamp: time.Now(),
+	})
+	return m, m.showNextNotification()
+}
+
+// questStateSavedMsg is sent after a quest start, abandon or undo is persisted.
+type questStateSavedMsg struct {
+	err error
+}
+
+// saveQuestStateCmd persists quests after a lifecycle change.
+func saveQuestStateCmd(storageClient *storage.Client, quests []*game.Quest) tea.Cmd {
+	return func() tea.Msg {
+		if storageClient == nil {
+			return questStateSavedMsg{err: fmt.Errorf("storage not available")}
+		}
+		return questStateSavedMsg{err: storageClient.SaveQuests(quests)}
+	}
+}
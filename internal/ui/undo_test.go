@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// pressKey sends a key to the model and returns the updated model.
+func pressKey(t *testing.T, m Model, msg tea.KeyMsg) Model {
+	t.Helper()
+	model, _ := m.handleKeyPress(msg)
+	return model.(Model)
+}
+
+var (
+	ctrlZ = tea.KeyMsg{Type: tea.KeyCtrlZ}
+	ctrlY = tea.KeyMsg{Type: tea.KeyCtrlY}
+)
+
+// TestUndoRedoFilterChange tests that quest board filter changes can be
+// undone and redone, and that a new change clears the redo stack.
+func TestUndoRedoFilterChange(t *testing.T) {
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), currentScreen: ScreenQuestBoard}
+	m.questBoardSelectedIndex = 2
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if m.questBoardFilter == screens.FilterAll {
+		t.Fatal("F should change the filter")
+	}
+	changed := m.questBoardFilter
+
+	m = pressKey(t, m, ctrlZ)
+	if m.questBoardFilter != screens.FilterAll || m.questBoardSelectedIndex != 2 {
+		t.Errorf("after undo filter = %v, selected = %d; want all, 2", m.questBoardFilter, m.questBoardSelectedIndex)
+	}
+
+	m = pressKey(t, m, ctrlY)
+	if m.questBoardFilter != changed || m.questBoardSelectedIndex != 0 {
+		t.Errorf("after redo filter = %v, selected = %d; want %v, 0", m.questBoardFilter, m.questBoardSelectedIndex, changed)
+	}
+
+	m = pressKey(t, m, ctrlZ)
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if len(m.history.undone) != 0 {
+		t.Error("a new action should clear the redo stack")
+	}
+}
+
+// TestUndoQuestStartAndAbandon tests undoing quest lifecycle actions from
+// the quest detail view, including the grace period and conflict checks.
+func TestUndoQuestStartAndAbandon(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   game.QuestStatus
+		key      string
+		after    game.QuestStatus
+		age      time.Duration // How long ago the action happened
+		progress bool          // Quest progressed after the action
+		want     game.QuestStatus
+	}{
+		{name: "undo start", status: game.QuestAvailable, key: "s", after: game.QuestActive, want: game.QuestAvailable},
+		{name: "undo abandon", status: game.QuestActive, key: "a", after: game.QuestAvailable, want: game.QuestActive},
+		{name: "grace period over", status: game.QuestAvailable, key: "s", after: game.QuestActive, age: time.Minute, want: game.QuestActive},
+		{name: "quest progressed", status: game.QuestAvailable, key: "s", after: game.QuestActive, progress: true, want: game.QuestActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quest := game.NewQuest("Marathon", "", game.QuestTypeCommit, 10, 100, 1)
+			if tt.status == game.QuestActive {
+				if err := quest.Start("", ""); err != nil {
+					t.Fatal(err)
+				}
+				quest.UpdateProgress(3)
+			}
+
+			m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), currentScreen: ScreenQuestBoard}
+			m.quests = []*game.Quest{quest}
+			m.questDetail = quest
+
+			m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+			if quest.Status != tt.after {
+				t.Fatalf("status after %q = %s, want %s", tt.key, quest.Status, tt.after)
+			}
+
+			m.history.done[0].expires = m.history.done[0].expires.Add(-tt.age)
+			if tt.progress {
+				quest.UpdateProgress(1)
+			}
+
+			m = pressKey(t, m, ctrlZ)
+			if quest.Status != tt.want {
+				t.Errorf("status after undo = %s, want %s", quest.Status, tt.want)
+			}
+			if tt.name == "undo abandon" && quest.Current != 3 {
+				t.Errorf("Current after undoing abandon = %d, want 3", quest.Current)
+			}
+			if m.character.Effort != 0 {
+				t.Errorf("Effort after undo = %d, want 0 (refund taken back)", m.character.Effort)
+			}
+		})
+	}
+}
+
+// TestUndoDisabledOnMentor tests that Ctrl+Y keeps copying responses on the
+// mentor screen instead of redoing.
+func TestUndoDisabledOnMentor(t *testing.T) {
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), currentScreen: ScreenQuestBoard}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	m = pressKey(t, m, ctrlZ)
+
+	m.currentScreen = ScreenMentor
+	m = pressKey(t, m, ctrlY)
+	if len(m.history.undone) != 1 {
+		t.Error("Ctrl+Y on the mentor screen should not redo")
+	}
+}
@@ -0,0 +1,58 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements level-gated features (see game.IsFeatureUnlocked):
+// locked keys explain when they open up instead of acting, and a level-up
+// announces the features it unlocked.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// featureUnlocked reports whether the character has unlocked a feature.
+// Everything is available until a character is loaded.
+//
+// Parameters:
+//   - id: Feature ID (one of the game.Feature* constants)
+//
+// Returns:
+//   - bool: True if the feature can be used
+func (m Model) featureUnlocked(id string) bool {
+	return m.character == nil || game.IsFeatureUnlocked(id, m.character.Level)
+}
+
+// lockedFeature tells the player when a locked feature opens up.
+//
+// Parameters:
+//   - id: Feature ID
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Notification command
+func (m Model) lockedFeature(id string) (tea.Model, tea.Cmd) {
+	feature, _ := game.LookupFeatureUnlock(id)
+	return m.notifyAction(fmt.Sprintf("🔒 %s: %s", feature.Name, game.FeatureLockedText(id)), NotificationInfo)
+}
+
+// announceUnlocks queues a notification for each feature a level-up
+// unlocked. Like the level-up itself, they're held back by celebration limits.
+//
+// Parameters:
+//   - oldLevel: Level before the level-up
+//   - newLevel: Level reached
+func (m *Model) announceUnlocks(oldLevel, newLevel int) {
+	for _, feature := range game.FeaturesUnlockedBetween(oldLevel, newLevel) {
+		m.recordActivity("🔓", "Unlocked "+feature.Name)
+		m.addNotification(Notification{
+			Message:     fmt.Sprintf("🔓 Unlocked: %s\n%s", feature.Name, feature.Hint),
+			Type:        NotificationSuccess,
+			Duration:    5 * time.Second,
+			Timestamp:   time.Now(),
+			Celebration: true,
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// veteranCharacter returns a character past every feature unlock level.
+func veteranCharacter() *game.Character {
+	character := game.NewCharacter("Tester")
+	character.Level = 10
+	return character
+}
+
+// TestLockedFeatureKeys tests that keys for locked features explain when
+// they unlock instead of opening the feature.
+func TestLockedFeatureKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		screen Screen
+		key    rune
+		want   string
+	}{
+		{"quest calendar", ScreenQuestBoard, 'v', "Quest Calendar: Unlocks at level 3"},
+		{"todo quests", ScreenQuestBoard, 't', "TODO Quests: Unlocks at level 4"},
+		{"stat details", ScreenCharacter, 'i', "Stat Details: Unlocks at level 3"},
+		{"skill points", ScreenCharacter, '1', "Skill Points: Unlocks at level 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := game.NewCharacter("Tester")
+			character.SkillPoints = 1
+			m := Model{keys: NewKeyMap(), character: character, currentScreen: tt.screen, width: 100, height: 40}
+
+			m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{tt.key}})
+			if m.showingCalendar || m.todoPicker != nil || m.statDetails || character.SkillPoints != 1 {
+				t.Error("a locked feature should not open or act")
+			}
+			if m.currentNotification == nil || !strings.Contains(m.currentNotification.Message, tt.want) {
+				t.Errorf("notification = %+v, want it to say %q", m.currentNotification, tt.want)
+			}
+		})
+	}
+}
+
+// TestLevelUpAnnouncesUnlocks tests that reaching an unlock level
+// announces the features it opened up.
+func TestLevelUpAnnouncesUnlocks(t *testing.T) {
+	m := Model{keys: NewKeyMap(), character: game.NewCharacter("Tester"), width: 100, height: 40}
+
+	model, _ := m.Update(levelUpMsg{oldLevel: 2, newLevel: 3})
+	m = model.(Model)
+
+	var messages []string
+	if m.currentNotification != nil {
+		messages = append(messages, m.currentNotification.Message)
+	}
+	for _, notification := range m.notifications {
+		messages = append(messages, notification.Message)
+	}
+	all := strings.Join(messages, "\n")
+	for _, want := range []string{"Unlocked: Stat Details", "Unlocked: Quest Calendar", "Press V on the quest board"} {
+		if !strings.Contains(all, want) {
+			t.Errorf("notifications %q should contain %q", messages, want)
+		}
+	}
+	if strings.Contains(all, "Skill Points") {
+		t.Error("features unlocked at earlier levels should not be announced again")
+	}
+}
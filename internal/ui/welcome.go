@@ -0,0 +1,126 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the welcome-back screen: after a few days away, the
+// player sees what happened to their streak and quests and is offered a
+// quest to get going again before the dashboard resumes.
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// openWelcomeBack opens the welcome-back screen once per absence and saves
+// that it was shown (and the recurring quests it reset). It waits until
+// both the character and the quests are loaded, and stays out of the
+// tutorial's way.
+//
+// Returns:
+//   - Model: Updated model
+//   - tea.Cmd: Save command when the screen was opened (nil otherwise)
+func (m Model) openWelcomeBack() (Model, tea.Cmd) {
+	if m.welcomeBack != nil || m.showingTutorial || m.character == nil || m.quests == nil {
+		return m, nil
+	}
+	clock := game.StreakClockFromConfig(m.config)
+	welcome, ok := m.character.TakeWelcomeBack(m.quests, time.Now(), clock)
+	if !ok {
+		return m, nil
+	}
+	m.welcomeBack = &welcome
+	return m, m.saveStateCmd()
+}
+
+// handleWelcomeBackKeys starts the recommended quest (Enter) or just closes
+// the screen (Esc or any other key).
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Save and notification commands when a quest was started
+func (m Model) handleWelcomeBackKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	recommended := m.welcomeBack.Recommended
+	m.welcomeBack = nil
+	if key.Matches(msg, m.keys.Enter) && recommended != nil {
+		return m.beginQuest(recommended)
+	}
+	return m, nil
+}
+
+// viewWelcomeBack renders the welcome-back screen centered on screen.
+//
+// Returns:
+//   - string: The rendered screen
+func (m Model) viewWelcomeBack() string {
+	welcome := m.welcomeBack
+	name := "adventurer"
+	if m.character != nil && m.character.Name != "" {
+		name = m.character.Name
+	}
+
+	lines := []string{
+		TitleStyle.Render("👋 Welcome back, " + name + "!"),
+		SubtitleStyle.Render(fmt.Sprintf("You've been away %d days. Here's what happened:", welcome.DaysAway)),
+		"",
+	}
+
+	switch {
+	case welcome.Streak == 0:
+		lines = append(lines, MutedTextStyle.Render("🔥 No streak running - your next commit starts one"))
+	case welcome.StreakKept:
+		lines = append(lines, SuccessTextStyle.Render(fmt.Sprintf("🧊 Your %d-day streak is safe: your next commit uses %d streak freeze(s)",
+			welcome.Streak, welcome.FreezesNeeded)))
+	default:
+		lines = append(lines, WarningTextStyle.Render(fmt.Sprintf("💔 Your %d-day streak lapsed - your next commit starts a new one", welcome.Streak)))
+	}
+
+	if len(welcome.MissedDailies) > 0 {
+		lines = append(lines, WarningTextStyle.Render(fmt.Sprintf("📅 %d recurring quest(s) expired unfinished and were reset: %s",
+			len(welcome.MissedDailies), questTitles(welcome.MissedDailies))))
+	}
+	if len(welcome.ExpiredQuests) > 0 {
+		lines = append(lines, WarningTextStyle.Render(fmt.Sprintf("⏰ %d quest(s) passed their deadline: %s",
+			len(welcome.ExpiredQuests), questTitles(welcome.ExpiredQuests))))
+	}
+	if len(welcome.MissedDailies) == 0 && len(welcome.ExpiredQuests) == 0 {
+		lines = append(lines, MutedTextStyle.Render("📜 Your quests waited for you"))
+	}
+
+	lines = append(lines, "")
+	help := RenderKeybind("Esc", "continue")
+	if quest := welcome.Recommended; quest != nil {
+		title := lipgloss.NewStyle().Foreground(ColorXP).Bold(true).Render(quest.Title)
+		lines = append(lines, HeadingStyle.Render("Ease back in"), fmt.Sprintf("%s (%d XP)", title, quest.XPReward))
+		if quest.Description != "" {
+			lines = append(lines, MutedTextStyle.Render(quest.Description))
+		}
+		lines = append(lines, "")
+		help = RenderKeybind("Enter", "start this quest") + "  " + help
+	}
+	lines = append(lines, help)
+
+	box := ModalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return PlaceInCenter(m.width, m.height, box)
+}
+
+// questTitles lists quest titles, a few at most.
+func questTitles(quests []*game.Quest) string {
+	const shown = 3
+	titles := make([]string, 0, shown)
+	for i, quest := range quests {
+		if i == shown {
+			titles = append(titles, fmt.Sprintf("and %d more", len(quests)-shown))
+			break
+		}
+		titles = append(titles, quest.Title)
+	}
+	return strings.Join(titles, ", ")
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestWelcomeBack tests that the welcome-back screen opens once both the
+// character and quests load after a long absence, and that Enter starts
+// the recommended quest.
+func TestWelcomeBack(t *testing.T) {
+	character := veteranCharacter()
+	character.CurrentStreak = 8
+	character.LastActiveDate = time.Now().AddDate(0, 0, -5)
+	quest := game.NewQuestBuilder().Title("Warm-up").Type(game.QuestTypeCommit).Target(1).XPReward(40).MustBuild()
+
+	m := Model{keys: NewKeyMap(), width: 100, height: 40}
+	model, _ := m.Update(characterLoadedMsg{character: character})
+	m = model.(Model)
+	if m.welcomeBack != nil {
+		t.Fatal("the welcome back should wait for the quests")
+	}
+	model, _ = m.Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+	m = model.(Model)
+	if m.welcomeBack == nil {
+		t.Fatal("welcome-back screen should open after five days away")
+	}
+
+	view := m.View()
+	for _, want := range []string{"Welcome back, Tester", "away 5 days", "8-day streak lapsed", "Warm-up"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view is missing %q", want)
+		}
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.welcomeBack != nil || quest.Status != game.QuestActive {
+		t.Errorf("welcome open = %v, quest %s; want closed and the quest started", m.welcomeBack != nil, quest.Status)
+	}
+
+	// Loading again doesn't welcome the player back twice
+	model, _ = m.Update(questsLoadedMsg{quests: []*game.Quest{quest}})
+	if model.(Model).welcomeBack != nil {
+		t.Error("the welcome back should be shown once per absence")
+	}
+}
@@ -0,0 +1,204 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements dashboard widget settings: choosing which widgets
+// the dashboard shows and in what order, from the Settings screen. Changes
+// are undoable and saved to the config file.
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// maxActivityFeed caps how many events the dashboard activity feed remembers.
+const maxActivityFeed = 20
+
+// dashboardWidgets returns the enabled dashboard widgets in display order.
+func (m Model) dashboardWidgets() []string {
+	if m.config == nil {
+		return config.DashboardWidgetIDs
+	}
+	return m.config.UI.EnabledDashboardWidgets()
+}
+
+// recordActivity adds an event to the dashboard activity feed.
+//
+// Parameters:
+//   - icon: Emoji for the kind of event
+//   - text: One-line description
+func (m *Model) recordActivity(icon, text string) {
+	m.activityFeed = append(m.activityFeed, screens.ActivityItem{At: time.Now(), Icon: icon, Text: text})
+	if len(m.activityFeed) > maxActivityFeed {
+		m.activityFeed = m.activityFeed[len(m.activityFeed)-maxActivityFeed:]
+	}
+}
+
+// firstLine returns the first line of a commit message.
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return line
+}
+
+// handleSettingsKeys handles the Settings screen: Up/Down select a dashboard
+// widget, profile field, experimental feature, action or switch, Space/Enter
+// show or hide the widget (or edit the field, switch the feature, run the
+// action or flip the switch)
+// and Shift+Up/Down move the widget.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: Config save and notification commands after a change
+func (m Model) handleSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	order := screens.WidgetSettingsOrder(m.dashboardWidgets())
+	rows := len(order) + len(game.ProfileFields) + len(config.Features) + 3 // + the Discord test message, XP rules tester and late-night warnings
+	m.settingsSelected = max(min(m.settingsSelected, rows-1), 0)
+	field, onProfile := profileFieldAt(m.settingsSelected)
+	feature, onFeature := featureAt(m.settingsSelected)
+	onDiscord := m.settingsSelected == screens.SettingsDiscordTestRow()
+	onXPRules := m.settingsSelected == screens.SettingsXPRulesTestRow()
+	onSleep := m.settingsSelected == screens.SettingsSleepRow()
+	onWidget := !onProfile && !onFeature && !onDiscord && !onXPRules && !onSleep
+
+	switch {
+	case key.Matches(msg, m.keys.MoveWidgetUp):
+		if !onWidget {
+			return m, nil
+		}
+		return m.moveWidget(order, -1)
+	case key.Matches(msg, m.keys.MoveWidgetDown):
+		if !onWidget {
+			return m, nil
+		}
+		return m.moveWidget(order, 1)
+	case key.Matches(msg, m.keys.Up):
+		m.settingsSelected = max(m.settingsSelected-1, 0)
+		return m, nil
+	case key.Matches(msg, m.keys.Down):
+		m.settingsSelected = min(m.settingsSelected+1, rows-1)
+		return m, nil
+	case key.Matches(msg, m.keys.Space), key.Matches(msg, m.keys.Enter):
+		if onProfile {
+			return m.editProfileField(field)
+		}
+		if onFeature {
+			return m.toggleFeature(feature)
+		}
+		if onDiscord {
+			return m.sendDiscordTest()
+		}
+		if onXPRules {
+			return m.editXPRuleSample()
+		}
+		if onSleep {
+			return m.toggleLateNightWarnings()
+		}
+		return m.toggleWidget(order[m.settingsSelected])
+	case key.Matches(msg, m.keys.Esc):
+		return m.switchScreen(ScreenDashboard)
+	}
+	return m, nil
+}
+
+// toggleWidget shows or hides a dashboard widget. A widget that's shown
+// again goes to the end of the dashboard.
+func (m Model) toggleWidget(id string) (tea.Model, tea.Cmd) {
+	before := m.dashboardWidgets()
+	after := slices.DeleteFunc(slices.Clone(before), func(enabled string) bool { return enabled == id })
+
+	label := "hiding the " + widgetName(id) + " widget"
+	if len(after) == len(before) {
+		after = append(after, id)
+		label = "showing the " + widgetName(id) + " widget"
+	}
+
+	// The toggled widget stays selected wherever it moved to
+	m.settingsSelected = slices.Index(screens.WidgetSettingsOrder(after), id)
+	return m.applyWidgetLayout(widgetLayoutAction{label: label, before: before, after: after})
+}
+
+// moveWidget moves the selected dashboard widget up or down the display
+// order. Only enabled widgets have an order, so hidden ones don't move.
+func (m Model) moveWidget(order []string, delta int) (tea.Model, tea.Cmd) {
+	before := m.dashboardWidgets()
+	id := order[m.settingsSelected]
+	from := slices.Index(before, id)
+	to := from + delta
+	if from < 0 || to < 0 || to >= len(before) {
+		return m, nil
+	}
+
+	after := slices.Clone(before)
+	after[from], after[to] = after[to], after[from]
+	m.settingsSelected = to
+	return m.applyWidgetLayout(widgetLayoutAction{label: "moving the " + widgetName(id) + " widget", before: before, after: after})
+}
+
+// applyWidgetLayout performs a layout change and records it for undo.
+func (m Model) applyWidgetLayout(action widgetLayoutAction) (tea.Model, tea.Cmd) {
+	cmd, err := action.redo(&m)
+	if err != nil {
+		return m.notifyAction(fmt.Sprintf("Can't change the dashboard: %v", err), NotificationWarning)
+	}
+	m.history.record(action, 0, time.Now())
+	return m, cmd
+}
+
+// widgetName returns a widget's display name.
+func widgetName(id string) string {
+	for _, widget := range screens.DashboardWidgets() {
+		if widget.ID == id {
+			return widget.Name
+		}
+	}
+	return id
+}
+
+// widgetLayoutAction is a change to the dashboard's widgets or their order.
+type widgetLayoutAction struct {
+	label  string
+	before []string
+	after  []string
+}
+
+func (a widgetLayoutAction) describe() string { return a.label }
+
+func (a widgetLayoutAction) undo(m *Model) (tea.Cmd, error) {
+	return m.setDashboardWidgets(a.before)
+}
+
+func (a widgetLayoutAction) redo(m *Model) (tea.Cmd, error) {
+	return m.setDashboardWidgets(a.after)
+}
+
+// setDashboardWidgets stores the widget layout in the config and saves it.
+// The list is never nil, so hiding every widget survives a restart.
+func (m *Model) setDashboardWidgets(widgets []string) (tea.Cmd, error) {
+	if m.config == nil {
+		return nil, fmt.Errorf("config not loaded")
+	}
+	m.config.UI.DashboardWidgets = append([]string{}, widgets...)
+	return saveConfigCmd(m.config), nil
+}
+
+// configSavedMsg is sent after the config file is written.
+type configSavedMsg struct {
+	err error
+}
+
+// saveConfigCmd writes the config file.
+func saveConfigCmd(cfg *config.Config) tea.Cmd {
+	return func() tea.Msg {
+		return configSavedMsg{err: cfg.Save()}
+	}
+}
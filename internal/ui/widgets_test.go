@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestSettingsWidgetLayout tests hiding, reordering and undoing dashboard widgets.
+// Commands are not run, since they would write the real config file.
+func TestSettingsWidgetLayout(t *testing.T) {
+	cfg := config.DefaultConfig()
+	m := Model{
+		keys:          NewKeyMap(),
+		config:        cfg,
+		character:     game.NewCharacter("Tester"),
+		currentScreen: ScreenSettings,
+		width:         100,
+		height:        40,
+	}
+	press := func(m Model, msg tea.KeyMsg) Model {
+		t.Helper()
+		model, cmd := m.handleKeyPress(msg)
+		if cmd == nil {
+			t.Fatalf("%q should return a command", msg.String())
+		}
+		return model.(Model)
+	}
+
+	// Space hides the selected (first) widget
+	m = press(m, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if slices.Contains(cfg.UI.DashboardWidgets, "character") {
+		t.Fatalf("character widget should be hidden, got %v", cfg.UI.DashboardWidgets)
+	}
+
+	// Shift+Down moves the new first widget down
+	m.settingsSelected = 0
+	m = press(m, tea.KeyMsg{Type: tea.KeyShiftDown})
+	want := []string{"today", "active_quest", "streak_heatmap", "activity_feed", "tips", "pet", "repo_map"}
+	if !slices.Equal(cfg.UI.DashboardWidgets, want) {
+		t.Fatalf("DashboardWidgets = %v, want %v", cfg.UI.DashboardWidgets, want)
+	}
+	if m.settingsSelected != 1 {
+		t.Errorf("settingsSelected = %d, want 1 (follows the moved widget)", m.settingsSelected)
+	}
+
+	// Undo twice restores the default layout
+	m = press(m, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	press(m, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if !slices.Equal(cfg.UI.DashboardWidgets, config.DashboardWidgetIDs) {
+		t.Errorf("DashboardWidgets after undo = %v, want defaults", cfg.UI.DashboardWidgets)
+	}
+}
+
+// TestSettingsFeatureToggle tests switching an experimental feature from
+// Settings and undoing it.
+func TestSettingsFeatureToggle(t *testing.T) {
+	cfg := config.DefaultConfig()
+	m := Model{
+		keys:          NewKeyMap(),
+		config:        cfg,
+		character:     game.NewCharacter("Tester"),
+		currentScreen: ScreenSettings,
+		width:         100,
+		height:        40,
+	}
+	m.settingsSelected = len(screens.DashboardWidgets()) + len(game.ProfileFields)
+
+	feature, ok := featureAt(m.settingsSelected)
+	if !ok || feature.ID != config.Features[0].ID {
+		t.Fatalf("featureAt(%d) = %v, %v; want the first feature", m.settingsSelected, feature.ID, ok)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if !cfg.FeatureEnabled(feature.ID) {
+		t.Fatalf("%s should be enabled after Space", feature.ID)
+	}
+	if !slices.Equal(cfg.UI.DashboardWidgets, config.DashboardWidgetIDs) {
+		t.Errorf("switching a feature changed the widgets: %v", cfg.UI.DashboardWidgets)
+	}
+
+	// Shift+Up on a feature row doesn't move any widget
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyShiftUp})
+	if !slices.Equal(cfg.UI.DashboardWidgets, config.DashboardWidgetIDs) {
+		t.Errorf("Shift+Up on a feature moved a widget: %v", cfg.UI.DashboardWidgets)
+	}
+
+	pressKey(t, m, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if cfg.FeatureEnabled(feature.ID) {
+		t.Errorf("%s should be disabled after undo", feature.ID)
+	}
+}
+
+// TestDashboardQuestSelection tests moving through the Active Quests widget
+// and jumping to the selected quest's detail view.
+func TestDashboardQuestSelection(t *testing.T) {
+	quests := make([]*game.Quest, 0, 3)
+	for _, title := range []string{"First", "Locked", "Second"} {
+		quest := game.NewQuest(title, "", game.QuestTypeCommit, 5, 100, 1)
+		if title != "Locked" {
+			if err := quest.Start("", ""); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+		}
+		quests = append(quests, quest)
+	}
+	m := Model{keys: NewKeyMap(), quests: quests, currentScreen: ScreenDashboard}
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	m = pressKey(t, m, down)
+	m = pressKey(t, m, down)
+	if m.dashboardQuest != 1 {
+		t.Errorf("selection = %d after moving past the end, want 1", m.dashboardQuest)
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.currentScreen != ScreenQuestBoard || m.questDetail != quests[2] {
+		t.Errorf("Enter opened screen %v, quest %v; want the Second quest's detail", m.currentScreen, m.questDetail)
+	}
+}
@@ -0,0 +1,46 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the nudge about uncommitted work left sitting in a
+// watched repository, which the game has turned into a quest to commit or
+// stash it.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// wipNudgeMsg is sent when uncommitted work became quests, or wip quests
+// completed.
+type wipNudgeMsg struct {
+	work []game.UncommittedWork // Work the quests added are for
+}
+
+// announceWIP queues a gentle toast for each repository whose uncommitted
+// work became a quest. Nothing is shown when only quests completed.
+//
+// Parameters:
+//   - msg: The nudge
+//   - now: Current time
+//
+// Returns:
+//   - Model: Updated model
+func (m Model) announceWIP(msg wipNudgeMsg, now time.Time) Model {
+	for _, work := range msg.work {
+		days := max(int(now.Sub(work.Since).Hours()/24), 1)
+		noun := "days"
+		if days == 1 {
+			noun = "day"
+		}
+		m.recordActivity("📦", fmt.Sprintf("%d uncommitted lines in %s", work.Lines(), work.RepoName()))
+		m.addNotification(Notification{
+			Message: fmt.Sprintf("📦 You have %d uncommitted lines in %s\nThey've been sitting there %d %s: commit or stash them to complete the new quest",
+				work.Lines(), work.RepoName(), days, noun),
+			Type:      NotificationInfo,
+			Duration:  5 * time.Second,
+			Timestamp: now,
+		})
+	}
+	return m
+}
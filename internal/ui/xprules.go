@@ -0,0 +1,107 @@
+// Package ui provides the terminal user interface for CodeQuest.
+// This file implements the XP rules dry-run tester on the Settings screen:
+// type a sample commit as field=value pairs and see which custom XP rules
+// match and what the commit would earn, without awarding anything. The row
+// follows the Discord test message.
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// xpRuleDryRun is the outcome of the last XP rules dry run.
+type xpRuleDryRun struct {
+	sample string                 // Sample as typed
+	result *game.CommitXPEstimate // XP the sample would earn (nil if it didn't parse)
+	err    string                 // Why the sample couldn't be tested
+}
+
+// editXPRuleSample opens the sample input, filled with the last sample.
+func (m Model) editXPRuleSample() (tea.Model, tea.Cmd) {
+	if m.character == nil {
+		return m.notifyAction("No character to test XP rules for", NotificationWarning)
+	}
+
+	input := textinput.New()
+	input.Placeholder = `repo=~/work/api hour=10 lines=40 message="WIP: spike"`
+	input.Prompt = "Sample: "
+	input.CharLimit = 200
+	input.Width = max(m.width-30, 20)
+	if m.xpRuleDryRun != nil {
+		input.SetValue(m.xpRuleDryRun.sample)
+		input.CursorEnd()
+	}
+
+	m.testingXPRules = true
+	m.xpRuleInput = input
+	return m, m.xpRuleInput.Focus()
+}
+
+// handleXPRuleSampleKeys handles keys while a sample is being typed: Enter
+// runs the dry run (the input stays open for another try), Esc closes it,
+// anything else goes to the input.
+//
+// Parameters:
+//   - msg: The key press message
+//
+// Returns:
+//   - tea.Model: Updated model
+//   - tea.Cmd: The input's command
+func (m Model) handleXPRuleSampleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Enter):
+		m.xpRuleDryRun = m.dryRunXPRules(m.xpRuleInput.Value(), time.Now())
+		return m, nil
+	case key.Matches(msg, m.keys.Esc):
+		m.testingXPRules = false
+		m.xpRuleInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.xpRuleInput, cmd = m.xpRuleInput.Update(msg)
+	return m, cmd
+}
+
+// dryRunXPRules works out what a sample commit would earn right now,
+// leaving the character untouched.
+//
+// Parameters:
+//   - sample: field=value pairs (see game.ParseXPRuleSample)
+//   - now: When the sample commit is made
+//
+// Returns:
+//   - *xpRuleDryRun: The XP and matching rules, or why the sample is invalid
+func (m Model) dryRunXPRules(sample string, now time.Time) *xpRuleDryRun {
+	event, err := game.ParseXPRuleSample(sample, m.config, now)
+	if err != nil {
+		return &xpRuleDryRun{sample: sample, err: err.Error()}
+	}
+	estimate := game.NewRewardEngine(m.config).CommitXP(event, m.character, now)
+	return &xpRuleDryRun{sample: sample, result: &estimate}
+}
+
+// xpRulesSettings returns the XP rules and last dry run for the Settings
+// screen.
+func (m Model) xpRulesSettings() screens.XPRulesSettings {
+	var settings screens.XPRulesSettings
+	if m.config != nil {
+		settings.Rules = m.config.XPRules
+	}
+	if m.testingXPRules {
+		settings.Editor = m.xpRuleInput.View()
+	}
+	if m.xpRuleDryRun != nil {
+		settings.Sample = m.xpRuleDryRun.sample
+		settings.Result = m.xpRuleDryRun.result
+		settings.Err = m.xpRuleDryRun.err
+	}
+	return settings
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/ui/screens"
+)
+
+// TestSettingsXPRulesDryRun tests testing a sample commit against the XP
+// rules from the Settings screen, without awarding anything.
+func TestSettingsXPRulesDryRun(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.XPRules = []config.XPRuleConfig{
+		{Name: "wip", When: []string{"message matches wip*"}, Multiplier: 0.5, Flags: []string{"review"}},
+	}
+	character := game.NewCharacter("Tester")
+	m := Model{
+		keys:             NewKeyMap(),
+		config:           cfg,
+		character:        character,
+		currentScreen:    ScreenSettings,
+		settingsSelected: screens.SettingsXPRulesTestRow(),
+		width:            100,
+		height:           40,
+	}
+
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.testingXPRules {
+		t.Fatal("Enter on the XP rules row should open the sample input")
+	}
+	m.xpRuleInput.SetValue(`lines=30 message="WIP: spike"`)
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	run := m.xpRuleDryRun
+	if run == nil || run.result == nil {
+		t.Fatalf("dry run = %+v, want a result", run)
+	}
+	if run.result.Total != 20 || !strings.Contains(strings.Join(run.result.Flags, ","), "review") {
+		t.Errorf("dry run = %s (flags %v), want 20 XP flagged for review", run.result, run.result.Flags)
+	}
+	if character.XP != 0 || character.TotalCommits != 0 || character.TodayCommitXP != 0 {
+		t.Error("a dry run should not change the character")
+	}
+
+	// A bad sample explains itself; Esc closes the input
+	m.xpRuleInput.SetValue("branch=main")
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.xpRuleDryRun.err == "" {
+		t.Error("an unknown field should be reported")
+	}
+	m = pressKey(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.testingXPRules || m.currentScreen != ScreenSettings {
+		t.Error("Esc should close the sample input and stay on Settings")
+	}
+}
@@ -0,0 +1,62 @@
+package watcher
+
+import (
+	"path"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// dependencyChanges classifies the go.mod requirement changes between two
+// commits, for every go.mod among the changed paths (nested modules
+// included). go.sum changes alone only update checksums, so versions are
+// read from go.mod.
+//
+// Parameters:
+//   - from: The earlier commit (nil for a root commit)
+//   - to: The later commit
+//   - paths: Paths of the files changed between them
+//
+// Returns:
+//   - []game.DependencyChange: Changes across all go.mod files (nil if none changed)
+func dependencyChanges(from, to *object.Commit, paths []string) []game.DependencyChange {
+	var changes []game.DependencyChange
+	for _, changed := range paths {
+		if path.Base(changed) != "go.mod" {
+			continue
+		}
+		changes = append(changes, game.DiffGoMod(fileContents(from, changed), fileContents(to, changed))...)
+	}
+	return changes
+}
+
+// firstParent returns a commit's first parent, or nil for a root commit or
+// an unreadable parent.
+func firstParent(commit *object.Commit) *object.Commit {
+	if commit.NumParents() == 0 {
+		return nil
+	}
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil
+	}
+	return parent
+}
+
+// fileContents returns a file's contents at a commit, or "" if the commit
+// is nil or doesn't have the file.
+func fileContents(commit *object.Commit, name string) string {
+	if commit == nil {
+		return ""
+	}
+	file, err := commit.File(name)
+	if err != nil {
+		return ""
+	}
+	contents, err := file.Contents()
+	if err != nil {
+		return ""
+	}
+	return contents
+}
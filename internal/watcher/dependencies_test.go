@@ -0,0 +1,52 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestCommitDependencyChanges tests that commits touching go.mod (including
+// nested modules) carry their classified requirement changes.
+func TestCommitDependencyChanges(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+	if err := os.MkdirAll(filepath.Join(repoPath, "tools"), 0755); err != nil {
+		t.Fatalf("Failed to create tools dir: %v", err)
+	}
+
+	makeCommit(t, repoPath, "Add modules", map[string]string{
+		"go.mod":       "module example.com/app\n\nrequire github.com/google/uuid v1.5.0\n",
+		"tools/go.mod": "module example.com/tools\n\nrequire golang.org/x/tools v0.20.0\n",
+	})
+	bump := makeCommit(t, repoPath, "Refresh modules", map[string]string{
+		"go.mod":       "module example.com/app\n\nrequire github.com/google/uuid v1.6.0\n",
+		"tools/go.mod": "module example.com/tools\n\nrequire golang.org/x/tools v0.21.0\n",
+	})
+	docs := makeCommit(t, repoPath, "Docs", map[string]string{"README.md": "# Changed\n"})
+
+	gw, err := NewGitWatcher(repoPath)
+	if err != nil {
+		t.Fatalf("NewGitWatcher() error = %v", err)
+	}
+
+	event, err := gw.extractCommitData(plumbing.NewHash(bump))
+	if err != nil {
+		t.Fatalf("extractCommitData() error = %v", err)
+	}
+	if len(event.DependencyChanges) != 2 || game.CountDependencyBumps(event.DependencyChanges) != 2 {
+		t.Errorf("DependencyChanges = %+v, want two bumps", event.DependencyChanges)
+	}
+
+	event, err = gw.extractCommitData(plumbing.NewHash(docs))
+	if err != nil {
+		t.Fatalf("extractCommitData() error = %v", err)
+	}
+	if len(event.DependencyChanges) != 0 {
+		t.Errorf("DependencyChanges = %+v for a docs commit, want none", event.DependencyChanges)
+	}
+}
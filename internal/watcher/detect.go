@@ -0,0 +1,46 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file detects the repository a path belongs to and the git user
+// configured for it, so `codequest --quick` can start watching the current
+// project without any setup.
+package watcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+)
+
+// DetectedRepository is the repository around a path.
+type DetectedRepository struct {
+	Root     string // Worktree root (the path to watch)
+	UserName string // git user.name (repository config, then global; "" if unset)
+}
+
+// DetectRepository finds the repository containing a path (searching parent
+// directories) and the git user configured for it.
+//
+// Parameters:
+//   - path: Path inside the repository (e.g. the working directory)
+//
+// Returns:
+//   - DetectedRepository: The repository's root and user name
+//   - error: An error if the path isn't inside a git repository with a worktree
+func DetectRepository(path string) (DetectedRepository, error) {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return DetectedRepository{}, fmt.Errorf("%s is not inside a git repository: %w", path, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return DetectedRepository{}, fmt.Errorf("failed to access worktree: %w", err)
+	}
+
+	detected := DetectedRepository{Root: worktree.Filesystem.Root()}
+	// The global scope includes the repository's own config, which wins
+	if cfg, err := repo.ConfigScoped(gitconfig.GlobalScope); err == nil {
+		detected.UserName = strings.TrimSpace(cfg.User.Name)
+	}
+	return detected, nil
+}
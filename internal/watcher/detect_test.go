@@ -0,0 +1,56 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestDetectRepository tests finding the repository and git user around a path.
+func TestDetectRepository(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // No global git config
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	repoPath, cleanup := createEmptyGitRepo(t)
+	defer cleanup()
+	nested := filepath.Join(repoPath, "src", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		gitConfig  string // Repository .git/config additions
+		path       string
+		wantRoot   string
+		wantUser   string
+		wantErrNil bool
+	}{
+		{"root without a user", "", repoPath, repoPath, "", true},
+		{"nested directory with a user", "[user]\n\tname = Ada Lovelace\n", nested, repoPath, "Ada Lovelace", true},
+		{"outside any repository", "", t.TempDir(), "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.gitConfig != "" {
+				configPath := filepath.Join(repoPath, ".git", "config")
+				existing, err := os.ReadFile(configPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(configPath, append(existing, tt.gitConfig...), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			detected, err := DetectRepository(tt.path)
+			if (err == nil) != tt.wantErrNil {
+				t.Fatalf("DetectRepository() error = %v", err)
+			}
+			if detected.Root != tt.wantRoot || detected.UserName != tt.wantUser {
+				t.Errorf("DetectRepository() = %+v, want root %q and user %q", detected, tt.wantRoot, tt.wantUser)
+			}
+		})
+	}
+}
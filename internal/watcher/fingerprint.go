@@ -0,0 +1,50 @@
+package watcher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+)
+
+// diffFingerprint hashes what a diff changes (each file's path and its
+// added and removed lines), so the same change committed twice gets the
+// same fingerprint whatever the commit message, author or time. Unchanged
+// context lines are left out. Diffs with no line changes (empty commits,
+// binary-only changes) have no fingerprint.
+//
+// Parameters:
+//   - filePatches: Per-file patches from a commit or range diff
+//
+// Returns:
+//   - string: Hex SHA-256 of the changes ("" if nothing changed)
+func diffFingerprint(filePatches []fdiff.FilePatch) string {
+	hash := sha256.New()
+	changed := false
+
+	for _, fp := range filePatches {
+		wroteName := false
+		for _, chunk := range fp.Chunks() {
+			var marker string
+			switch chunk.Type() {
+			case fdiff.Add:
+				marker = "+"
+			case fdiff.Delete:
+				marker = "-"
+			default:
+				continue
+			}
+			if !wroteName {
+				hash.Write([]byte("\x00" + filePatchName(fp) + "\x00"))
+				wroteName = true
+			}
+			hash.Write([]byte(marker + chunk.Content()))
+			changed = true
+		}
+	}
+
+	if !changed {
+		return ""
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
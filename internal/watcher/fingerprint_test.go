@@ -0,0 +1,47 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// TestDiffFingerprint tests that re-applying the same change gives the same
+// fingerprint, while its revert and other changes don't.
+func TestDiffFingerprint(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	makeCommit(t, repoPath, "Add file", map[string]string{"file.txt": "one\n"})
+	applied := makeCommit(t, repoPath, "Add line", map[string]string{"file.txt": "one\ntwo\n"})
+	reverted := makeCommit(t, repoPath, "Revert", map[string]string{"file.txt": "one\n"})
+	reapplied := makeCommit(t, repoPath, "Add line again", map[string]string{"file.txt": "one\ntwo\n"})
+	other := makeCommit(t, repoPath, "Add line elsewhere", map[string]string{"other.txt": "two\n"})
+
+	gw, err := NewGitWatcher(repoPath)
+	if err != nil {
+		t.Fatalf("NewGitWatcher() error = %v", err)
+	}
+	fingerprint := func(sha string) string {
+		t.Helper()
+		event, err := gw.extractCommitData(plumbing.NewHash(sha))
+		if err != nil {
+			t.Fatalf("extractCommitData() error = %v", err)
+		}
+		return event.DiffHash
+	}
+
+	first := fingerprint(applied)
+	if first == "" {
+		t.Fatal("a commit with line changes should have a fingerprint")
+	}
+	if got := fingerprint(reapplied); got != first {
+		t.Errorf("re-applied change fingerprint = %s, want %s", got, first)
+	}
+	if fingerprint(reverted) == first {
+		t.Error("a revert should not match the change it reverts")
+	}
+	if fingerprint(other) == first {
+		t.Error("the same line in another file should not match")
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"fmt"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
 )
 
 // CommitEvent represents a detected Git commit with full metadata.
@@ -35,6 +38,43 @@ type CommitEvent struct {
 	TotalAdded   int          `json:"total_added"`   // Total lines added
 	TotalRemoved int          `json:"total_removed"` // Total lines removed
 	TotalFiles   int          `json:"total_files"`   // Number of files changed
+
+	// Retroactive is true for commits replayed after downtime
+	// (made while CodeQuest wasn't running)
+	Retroactive bool `json:"retroactive,omitempty"`
+
+	// CommitCount is the number of commits the event covers. It is above 1
+	// for aggregate events emitted while the watcher is throttled, in which
+	// case the change statistics span all of the covered commits.
+	CommitCount int `json:"commit_count,omitempty"`
+
+	// SquashCommits is the number of commits behind a squash-merged pull
+	// request, as reported by the GitHub integration (0 if not looked up).
+	SquashCommits int `json:"squash_commits,omitempty"`
+
+	// DependencyChanges are the go.mod requirements the commit added,
+	// removed, bumped or downgraded.
+	DependencyChanges []game.DependencyChange `json:"dependency_changes,omitempty"`
+
+	// DiffHash fingerprints the lines the commit changed, so the same diff
+	// committed again can be spotted ("" when no lines changed).
+	DiffHash string `json:"diff_hash,omitempty"`
+
+	// Upstream is the open source project ("owner/name") the commit
+	// contributes to, for repositories the player doesn't own (see
+	// config.Git.OwnAccounts); "" otherwise.
+	Upstream string `json:"upstream,omitempty"`
+
+	// ResolvedTodos are the TODO/FIXME comments the commit removed.
+	ResolvedTodos []game.TodoComment `json:"resolved_todos,omitempty"`
+
+	// Trailers are the reviewers and co-authors named in the commit's
+	// message trailers and git note (the newest commit's, for aggregates).
+	Trailers game.CommitTrailers `json:"trailers"`
+
+	// Bot is true for commits matching the bot patterns (see
+	// config.GitConfig.IsBotCommit) that are counted at a reduced rate.
+	Bot bool `json:"bot,omitempty"`
 }
 
 // FileChange represents changes to a single file in a commit.
@@ -45,9 +85,19 @@ type FileChange struct {
 	Removed int    `json:"removed"` // Lines removed in this file
 }
 
+// filePaths returns the paths of the files the commit changed.
+func (c CommitEvent) filePaths() []string {
+	paths := make([]string, len(c.FilesChanged))
+	for i, change := range c.FilesChanged {
+		paths[i] = change.Path
+	}
+	return paths
+}
+
 // GitWatcher monitors a Git repository for new commits using fsnotify.
 // It watches the .git/refs/heads directory and emits CommitEvent objects
-// when new commits are detected.
+// when new commits are detected. Where notifications are unavailable it
+// polls HEAD instead (see poll.go).
 //
 // Thread Safety:
 // GitWatcher is thread-safe and can be started/stopped from different goroutines.
@@ -59,16 +109,23 @@ type FileChange struct {
 //  3. Read from CommitChannel() to receive events
 //  4. Call Stop() when done to clean up resources
 type GitWatcher struct {
-	repoPath      string            // Absolute path to repository
-	repo          *git.Repository   // go-git repository handle
-	watcher       *fsnotify.Watcher // File system watcher
-	commits       chan CommitEvent  // Channel for commit events
-	errors        chan error        // Channel for error reporting
-	done          chan struct{}     // Signal channel for shutdown
-	lastCommitSHA plumbing.Hash     // Track last seen commit to avoid duplicates
-	mu            sync.RWMutex      // Protects lastCommitSHA
-	running       bool              // Track running state
-	runningMu     sync.Mutex        // Protects running flag
+	repoPath      string                            // Absolute path to repository
+	repo          *git.Repository                   // go-git repository handle
+	watcher       *fsnotify.Watcher                 // File system watcher
+	commits       chan CommitEvent                  // Channel for commit events
+	errors        chan error                        // Channel for error reporting
+	done          chan struct{}                     // Signal channel for shutdown
+	lastCommitSHA plumbing.Hash                     // Track last seen commit to avoid duplicates
+	emittedSHA    plumbing.Hash                     // Last commit sent as an event (base for aggregate diffs)
+	pendingCount  int                               // Commits batched while throttled, not yet emitted
+	mu            sync.RWMutex                      // Protects lastCommitSHA, emittedSHA and pendingCount
+	running       bool                              // Track running state
+	runningMu     sync.Mutex                        // Protects running flag
+	telemetry     watcherTelemetry                  // Goroutine, throughput and diff latency metrics
+	mode          string                            // Watch mode: WatchModeAuto, WatchModeNotify or WatchModePoll
+	polling       atomic.Bool                       // Polling HEAD instead of watching (watcher is nil)
+	pollInterval  atomic.Int64                      // Current unscaled polling interval (time.Duration)
+	pollScale     func(time.Duration) time.Duration // Adjusts polling intervals (nil = unchanged)
 }
 
 // NewGitWatcher creates a new Git repository watcher.
@@ -98,6 +155,28 @@ type GitWatcher struct {
 //	}
 //	defer watcher.Stop()
 func NewGitWatcher(repoPath string) (*GitWatcher, error) {
+	return NewGitWatcherWithMode(repoPath, WatchModeAuto)
+}
+
+// NewGitWatcherWithMode creates a Git repository watcher that watches
+// through filesystem notifications, polls HEAD, or (in auto mode) falls
+// back to polling when notifications can't be set up.
+//
+// Parameters:
+//   - repoPath: Absolute path to Git repository root (directory containing .git)
+//   - mode: WatchModeAuto, WatchModeNotify or WatchModePoll ("" = auto)
+//
+// Returns:
+//   - *GitWatcher: Configured watcher instance
+//   - error: Validation error, or notifications unavailable in notify mode
+func NewGitWatcherWithMode(repoPath, mode string) (*GitWatcher, error) {
+	if mode == "" {
+		mode = WatchModeAuto
+	}
+	if mode != WatchModeAuto && mode != WatchModeNotify && mode != WatchModePoll {
+		return nil, fmt.Errorf("unknown watch mode %q", mode)
+	}
+
 	// Validate and open the Git repository
 	repo, err := git.PlainOpen(repoPath)
 	if err != nil {
@@ -116,10 +195,17 @@ func NewGitWatcher(repoPath string) (*GitWatcher, error) {
 		}
 	}
 
-	// Create fsnotify watcher
-	fsWatcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create file system watcher: %w", err)
+	// Create fsnotify watcher (unless polling; auto mode falls back to it)
+	var fsWatcher *fsnotify.Watcher
+	var notifyErr error
+	if mode != WatchModePoll {
+		fsWatcher, err = fsnotify.NewWatcher()
+		if err != nil {
+			if mode == WatchModeNotify {
+				return nil, fmt.Errorf("failed to create file system watcher: %w", err)
+			}
+			notifyErr = fmt.Errorf("failed to create file system watcher: %w", err)
+		}
 	}
 
 	// Get current HEAD commit SHA if it exists (for duplicate detection)
@@ -137,7 +223,15 @@ func NewGitWatcher(repoPath string) (*GitWatcher, error) {
 		errors:        make(chan error, 10),       // Buffer for error reporting
 		done:          make(chan struct{}),
 		lastCommitSHA: lastSHA,
+		emittedSHA:    lastSHA,
 		running:       false,
+		mode:          mode,
+	}
+	watcher.pollInterval.Store(int64(minPollInterval))
+	if mode == WatchModePoll {
+		watcher.polling.Store(true)
+	} else if notifyErr != nil {
+		watcher.fallBackToPolling(notifyErr)
 	}
 
 	return watcher, nil
@@ -187,20 +281,27 @@ func (gw *GitWatcher) Start(ctx context.Context) error {
 	gw.running = true
 	gw.runningMu.Unlock()
 
-	// Watch .git/refs/heads directory for commit changes
-	refsPath := filepath.Join(gw.repoPath, ".git", "refs", "heads")
-	if err := gw.watcher.Add(refsPath); err != nil {
-		gw.runningMu.Lock()
-		gw.running = false
-		gw.runningMu.Unlock()
-		return fmt.Errorf("failed to watch %s: %w", refsPath, err)
+	if !gw.Polling() {
+		// Watch .git/refs/heads directory for commit changes
+		refsPath := filepath.Join(gw.repoPath, ".git", "refs", "heads")
+		if err := gw.watcher.Add(refsPath); err != nil {
+			// Out of inotify watches, unsupported filesystem and the like
+			if err := gw.fallBackToPolling(fmt.Errorf("failed to watch %s: %w", refsPath, err)); err != nil {
+				gw.runningMu.Lock()
+				gw.running = false
+				gw.runningMu.Unlock()
+				return err
+			}
+		}
 	}
 
-	// Also watch .git/HEAD for branch switches
-	headPath := filepath.Join(gw.repoPath, ".git", "HEAD")
-	if err := gw.watcher.Add(headPath); err != nil {
-		// Non-critical, continue anyway
-		gw.errors <- fmt.Errorf("warning: failed to watch HEAD file: %w", err)
+	if !gw.Polling() {
+		// Also watch .git/HEAD for branch switches
+		headPath := filepath.Join(gw.repoPath, ".git", "HEAD")
+		if err := gw.watcher.Add(headPath); err != nil {
+			// Non-critical, continue anyway
+			gw.errors <- fmt.Errorf("warning: failed to watch HEAD file: %w", err)
+		}
 	}
 
 	// Start the monitoring goroutine
@@ -210,8 +311,24 @@ func (gw *GitWatcher) Start(ctx context.Context) error {
 }
 
 // watch is the main monitoring loop (runs in goroutine).
-// It listens for file system events and processes commits.
+// It listens for file system events, or polls HEAD, and processes commits.
+// While throttled, batched commits are flushed as one aggregate event after
+// throttleDelay.
 func (gw *GitWatcher) watch(ctx context.Context) {
+	defer gw.trackGoroutine()()
+	var flush <-chan time.Time // Armed while commits are batched
+
+	// Exactly one of notifications and the poll timer is live
+	var events <-chan fsnotify.Event
+	var watchErrors <-chan error
+	var poll <-chan time.Time
+	interval := minPollInterval
+	if gw.Polling() {
+		poll = time.After(gw.pollWait(interval))
+	} else {
+		events, watchErrors = gw.watcher.Events, gw.watcher.Errors
+	}
+
 	defer func() {
 		gw.runningMu.Lock()
 		gw.running = false
@@ -230,26 +347,46 @@ func (gw *GitWatcher) watch(ctx context.Context) {
 			// Manual stop requested
 			return
 
-		case event, ok := <-gw.watcher.Events:
+		case <-poll:
+			changed, err := gw.pollHead()
+			if err != nil {
+				gw.reportError(err)
+			}
+			if flush == nil && gw.pendingCommits() > 0 {
+				flush = time.After(throttleDelay)
+			}
+			interval = nextPollInterval(interval, changed)
+			poll = time.After(gw.pollWait(interval))
+
+		case event, ok := <-events:
 			if !ok {
 				// Watcher closed
 				return
 			}
 
-			// Only care about write events (new commits)
-			if event.Op&fsnotify.Write == fsnotify.Write {
+			// Only care about ref updates (new commits)
+			if isRefUpdate(event) {
 				// Process the potential new commit
 				if err := gw.processCommit(); err != nil {
 					// Send error but don't crash
-					select {
-					case gw.errors <- err:
-					default:
-						// Error channel full, drop error
-					}
+					gw.reportError(err)
+				}
+				if flush == nil && gw.pendingCommits() > 0 {
+					flush = time.After(throttleDelay)
 				}
 			}
 
-		case err, ok := <-gw.watcher.Errors:
+		case <-flush:
+			flush = nil
+			if err := gw.flushPending(); err != nil {
+				gw.reportError(err)
+			}
+			if gw.pendingCommits() > 0 {
+				// Consumer still behind, keep batching
+				flush = time.After(throttleDelay)
+			}
+
+		case err, ok := <-watchErrors:
 			if !ok {
 				// Watcher error channel closed
 				return
@@ -264,6 +401,14 @@ func (gw *GitWatcher) watch(ctx context.Context) {
 	}
 }
 
+// isRefUpdate reports whether a filesystem event may mean a ref moved.
+// Git updates refs by writing a lock file and renaming it into place: Linux
+// and macOS report writes, while Windows reports the rename as a create or
+// rename event and may never send a write for the ref itself.
+func isRefUpdate(event fsnotify.Event) bool {
+	return event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0
+}
+
 // processCommit checks for a new commit and extracts its metadata.
 // This is called when fsnotify detects a file change in .git/refs/heads.
 func (gw *GitWatcher) processCommit() error {
@@ -288,24 +433,93 @@ func (gw *GitWatcher) processCommit() error {
 	// Update last seen commit
 	gw.mu.Lock()
 	gw.lastCommitSHA = currentSHA
+	throttled := gw.pendingCount > 0 || gw.telemetry.diffBehind() ||
+		len(gw.commits) >= cap(gw.commits)/2
+	if throttled {
+		// Diffs are falling behind: batch instead of queueing more work
+		gw.pendingCount++
+	}
 	gw.mu.Unlock()
 
+	if throttled {
+		return nil
+	}
+
 	// Extract commit metadata
 	commitEvent, err := gw.extractCommitData(currentSHA)
 	if err != nil {
 		return fmt.Errorf("failed to extract commit data: %w", err)
 	}
+	commitEvent.CommitCount = 1
 
 	// Send commit event (non-blocking)
+	if !gw.emit(*commitEvent) {
+		// Channel full: fold the commit into a delayed aggregate event
+		gw.mu.Lock()
+		gw.pendingCount++
+		gw.mu.Unlock()
+	}
+
+	return nil
+}
+
+// flushPending emits one aggregate event covering every commit batched
+// while throttled. The event's statistics are the diff from the last emitted
+// commit to the newest one. If the consumer is still behind (commit channel
+// full), the batch is kept for the next flush rather than queued.
+func (gw *GitWatcher) flushPending() error {
+	gw.mu.RLock()
+	count, base, head := gw.pendingCount, gw.emittedSHA, gw.lastCommitSHA
+	gw.mu.RUnlock()
+
+	if count == 0 || len(gw.commits) == cap(gw.commits) {
+		return nil
+	}
+
+	event, err := gw.extractAggregateData(base, head)
+	if err != nil {
+		return fmt.Errorf("failed to extract aggregate commit data: %w", err)
+	}
+	event.CommitCount = count
+
+	if gw.emit(*event) {
+		gw.mu.Lock()
+		gw.pendingCount -= count // Commits batched during the diff stay pending
+		gw.mu.Unlock()
+	}
+	return nil
+}
+
+// emit sends a commit event without blocking and records it as emitted.
+// Returns false if the commit channel is full.
+func (gw *GitWatcher) emit(event CommitEvent) bool {
 	select {
-	case gw.commits <- *commitEvent:
-		// Successfully sent
+	case gw.commits <- event:
 	default:
-		// Channel full, log warning but don't block
-		gw.errors <- fmt.Errorf("commit channel full, dropping commit %s", currentSHA.String())
+		return false
 	}
 
-	return nil
+	gw.mu.Lock()
+	gw.emittedSHA = plumbing.NewHash(event.SHA)
+	gw.mu.Unlock()
+	gw.telemetry.recordEvent(time.Now(), event.CommitCount)
+	return true
+}
+
+// pendingCommits returns the number of commits batched while throttled.
+func (gw *GitWatcher) pendingCommits() int {
+	gw.mu.RLock()
+	defer gw.mu.RUnlock()
+	return gw.pendingCount
+}
+
+// reportError forwards a non-fatal error, dropping it if the channel is full.
+func (gw *GitWatcher) reportError(err error) {
+	select {
+	case gw.errors <- err:
+	default:
+		// Error channel full, drop error
+	}
 }
 
 // extractCommitData retrieves full metadata for a commit using go-git.
@@ -330,21 +544,74 @@ func (gw *GitWatcher) extractCommitData(sha plumbing.Hash) (*CommitEvent, error)
 	// Calculate diff statistics
 	if err := gw.calculateDiffStats(commit, event); err != nil {
 		// Non-critical error, continue with basic info
-		gw.errors <- fmt.Errorf("warning: failed to calculate diff stats: %w", err)
+		gw.reportError(fmt.Errorf("warning: failed to calculate diff stats: %w", err))
 	}
+	event.DependencyChanges = dependencyChanges(firstParent(commit), commit, event.filePaths())
+	event.ResolvedTodos = resolvedTodos(gw.repoPath, firstParent(commit), commit, event.filePaths())
+	event.Trailers = commitTrailers(gw.repo, commit)
+
+	return event, nil
+}
+
+// extractAggregateData builds an event for head whose statistics cover every
+// change since base, so several commits are diffed in a single pass. If base
+// is unknown or unreadable, only head's own changes are counted.
+func (gw *GitWatcher) extractAggregateData(base, head plumbing.Hash) (*CommitEvent, error) {
+	baseCommit, err := gw.repo.CommitObject(base)
+	if base.IsZero() || err != nil {
+		return gw.extractCommitData(head)
+	}
+
+	commit, err := gw.repo.CommitObject(head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit object: %w", err)
+	}
+
+	event := &CommitEvent{
+		RepoPath:  gw.repoPath,
+		SHA:       head.String(),
+		Timestamp: commit.Author.When,
+		Author:    commit.Author.Name,
+		Email:     commit.Author.Email,
+		Message:   commit.Message,
+		Trailers:  commitTrailers(gw.repo, commit),
+	}
+
+	start := time.Now()
+	patch, err := baseCommit.Patch(commit)
+	gw.telemetry.recordDiff(time.Since(start))
+	if err != nil {
+		gw.reportError(fmt.Errorf("warning: failed to calculate aggregate diff stats: %w", err))
+		return event, nil
+	}
+	applyFileStats(event, lineEndingAwareStats(patch.FilePatches()))
+	event.DiffHash = diffFingerprint(patch.FilePatches())
+	event.DependencyChanges = dependencyChanges(baseCommit, commit, event.filePaths())
+	event.ResolvedTodos = resolvedTodos(gw.repoPath, baseCommit, commit, event.filePaths())
 
 	return event, nil
 }
 
 // calculateDiffStats computes lines added/removed for a commit.
-// This uses go-git's Stats() method to get per-file changes.
+// This diffs the commit against its parent, counts per-file changes
+// (ignoring line-ending-only changes), and records how long the diff took
+// for throttling decisions.
 func (gw *GitWatcher) calculateDiffStats(commit *object.Commit, event *CommitEvent) error {
 	// Get commit stats (files changed with line counts)
-	stats, err := commit.Stats()
+	start := time.Now()
+	patch, err := commitPatch(commit)
+	gw.telemetry.recordDiff(time.Since(start))
 	if err != nil {
 		return fmt.Errorf("failed to get commit stats: %w", err)
 	}
 
+	applyFileStats(event, lineEndingAwareStats(patch.FilePatches()))
+	event.DiffHash = diffFingerprint(patch.FilePatches())
+	return nil
+}
+
+// applyFileStats fills an event's change statistics from per-file stats.
+func applyFileStats(event *CommitEvent, stats object.FileStats) {
 	// Process each file's statistics
 	event.FilesChanged = make([]FileChange, 0, len(stats))
 	var totalAdded, totalRemoved int
@@ -363,8 +630,77 @@ func (gw *GitWatcher) calculateDiffStats(commit *object.Commit, event *CommitEve
 	event.TotalAdded = totalAdded
 	event.TotalRemoved = totalRemoved
 	event.TotalFiles = len(stats)
+}
 
-	return nil
+// CommitsSince walks the current branch back from HEAD and returns the
+// commits made after sinceSHA, oldest first, flagged as retroactive.
+// This is used to replay commits made while CodeQuest wasn't running.
+//
+// The walk stops at sinceSHA, at the first commit older than cutoff, or
+// after limit commits, whichever comes first. If sinceSHA is not an
+// ancestor of HEAD (e.g. after a rebase), cutoff and limit bound the walk.
+//
+// Parameters:
+//   - sinceSHA: Last commit already processed (exclusive)
+//   - cutoff: Ignore commits authored before this time
+//   - limit: Maximum number of commits to return (<= 0 means no limit)
+//
+// Returns:
+//   - []CommitEvent: Missed commits in chronological order
+//   - error: Failed to read repository history
+func (gw *GitWatcher) CommitsSince(sinceSHA string, cutoff time.Time, limit int) ([]CommitEvent, error) {
+	head, err := gw.repo.Head()
+	if err != nil {
+		if err == plumbing.ErrReferenceNotFound {
+			return []CommitEvent{}, nil // Empty repository, nothing to replay
+		}
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+
+	since := plumbing.NewHash(sinceSHA)
+	if head.Hash() == since {
+		return []CommitEvent{}, nil
+	}
+
+	iter, err := gw.repo.Log(&git.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit log: %w", err)
+	}
+	defer iter.Close()
+
+	missed := make([]plumbing.Hash, 0)
+	for {
+		commit, err := iter.Next()
+		if err != nil {
+			break // io.EOF or unreadable history, replay what we have
+		}
+		if commit.Hash == since || commit.Author.When.Before(cutoff) {
+			break
+		}
+		missed = append(missed, commit.Hash)
+		if limit > 0 && len(missed) >= limit {
+			break
+		}
+	}
+
+	// Log walks newest-first; replay in the order the commits were made
+	events := make([]CommitEvent, 0, len(missed))
+	for i := len(missed) - 1; i >= 0; i-- {
+		event, err := gw.extractCommitData(missed[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract commit data: %w", err)
+		}
+		event.Retroactive = true
+		events = append(events, *event)
+	}
+
+	// Don't re-emit HEAD when the fsnotify loop next fires
+	gw.mu.Lock()
+	gw.lastCommitSHA = head.Hash()
+	gw.emittedSHA = head.Hash()
+	gw.mu.Unlock()
+
+	return events, nil
 }
 
 // Stop gracefully shuts down the watcher.
@@ -393,9 +729,11 @@ func (gw *GitWatcher) Stop() error {
 	// Signal goroutine to stop
 	close(gw.done)
 
-	// Close fsnotify watcher
-	if err := gw.watcher.Close(); err != nil {
-		return fmt.Errorf("failed to close watcher: %w", err)
+	// Close fsnotify watcher (there is none while polling)
+	if gw.watcher != nil {
+		if err := gw.watcher.Close(); err != nil {
+			return fmt.Errorf("failed to close watcher: %w", err)
+		}
 	}
 
 	// Wait for goroutine to finish (channels will be closed)
@@ -479,3 +817,15 @@ func (gw *GitWatcher) GetLastCommitSHA() string {
 	defer gw.mu.RUnlock()
 	return gw.lastCommitSHA.String()
 }
+
+// RemoteURL returns the first URL of the repository's "origin" remote.
+//
+// Returns:
+//   - string: Remote URL (empty string if there is no origin)
+func (gw *GitWatcher) RemoteURL() string {
+	remote, err := gw.repo.Remote("origin")
+	if err != nil || len(remote.Config().URLs) == 0 {
+		return ""
+	}
+	return remote.Config().URLs[0]
+}
@@ -729,3 +729,84 @@ func TestGitWatcher_MultipleCommits(t *testing.T) {
 		t.Errorf("Expected %d commits, received %d", numCommits, receivedCount)
 	}
 }
+
+// TestGitWatcher_CommitsSince tests walking history for missed commits.
+func TestGitWatcher_CommitsSince(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	gw, err := NewGitWatcher(repoPath)
+	if err != nil {
+		t.Fatalf("NewGitWatcher() error = %v", err)
+	}
+	baseSHA := gw.GetLastCommitSHA()
+
+	first := makeCommit(t, repoPath, "feat: first", map[string]string{"a.go": "package a\n"})
+	second := makeCommit(t, repoPath, "feat: second", map[string]string{"b.go": "package b\n"})
+	third := makeCommit(t, repoPath, "feat: third", map[string]string{"c.go": "package c\n"})
+
+	tests := []struct {
+		name     string
+		sinceSHA string
+		cutoff   time.Time
+		limit    int
+		wantSHAs []string
+	}{
+		{
+			name:     "returns missed commits oldest first",
+			sinceSHA: baseSHA,
+			cutoff:   time.Now().Add(-time.Hour),
+			wantSHAs: []string{first, second, third},
+		},
+		{
+			name:     "stops at checkpoint",
+			sinceSHA: second,
+			cutoff:   time.Now().Add(-time.Hour),
+			wantSHAs: []string{third},
+		},
+		{
+			name:     "nothing missed at HEAD",
+			sinceSHA: third,
+			cutoff:   time.Now().Add(-time.Hour),
+			wantSHAs: []string{},
+		},
+		{
+			name:     "limit keeps most recent commits",
+			sinceSHA: baseSHA,
+			cutoff:   time.Now().Add(-time.Hour),
+			limit:    2,
+			wantSHAs: []string{second, third},
+		},
+		{
+			name:     "cutoff excludes older commits",
+			sinceSHA: baseSHA,
+			cutoff:   time.Now().Add(time.Hour),
+			wantSHAs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := gw.CommitsSince(tt.sinceSHA, tt.cutoff, tt.limit)
+			if err != nil {
+				t.Fatalf("CommitsSince() error = %v", err)
+			}
+
+			if len(events) != len(tt.wantSHAs) {
+				t.Fatalf("CommitsSince() returned %d commits, want %d", len(events), len(tt.wantSHAs))
+			}
+			for i, event := range events {
+				if event.SHA != tt.wantSHAs[i] {
+					t.Errorf("commit[%d].SHA = %s, want %s", i, event.SHA, tt.wantSHAs[i])
+				}
+				if !event.Retroactive {
+					t.Errorf("commit[%d].Retroactive = false, want true", i)
+				}
+			}
+		})
+	}
+
+	if gw.GetLastCommitSHA() != third {
+		t.Errorf("GetLastCommitSHA() = %s after replay, want HEAD %s", gw.GetLastCommitSHA(), third)
+	}
+}
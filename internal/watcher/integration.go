@@ -6,13 +6,37 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing"
+
 	"github.com/AutumnsGrove/codequest/internal/config"
 	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/power"
 )
 
+// maxReplayCommits caps how many missed commits are replayed per repository
+// on startup, so a long absence can't flood the event bus.
+const maxReplayCommits = 100
+
+// CheckpointStore persists the last processed commit SHA for each repository,
+// so commits made while CodeQuest wasn't running can be replayed on startup.
+// Every storage backend implements this interface.
+type CheckpointStore interface {
+	LoadRepoCheckpoints() (map[string]string, error)
+	SaveRepoCheckpoints(checkpoints map[string]string) error
+}
+
+// ReplayProgress reports how far replaying one repository's missed commits
+// has got, for progress feedback in the UI.
+type ReplayProgress struct {
+	Repo  string // Repository path
+	Done  int    // Missed commits processed so far
+	Total int    // Missed commits to process
+}
+
 // WatcherManager manages multiple GitWatcher instances and integrates them with the game's EventBus.
 // It handles the lifecycle of watchers, converts commit events to game events, and provides
 // dynamic repository management.
@@ -62,6 +86,27 @@ type WatcherManager struct {
 	// Map of repository path -> cancel function for that watcher's goroutine
 	cancelFuncs map[string]context.CancelFunc
 
+	// Replay checkpoints
+	// Map of repository path -> last processed commit SHA
+	checkpointStore CheckpointStore
+	checkpoints     map[string]string
+	checkpointMu    sync.Mutex // Protects checkpoints map and store writes
+
+	// Replay progress reports (nil = nobody is listening)
+	replayProgress func(ReplayProgress)
+
+	// Squash merge lookups (nil = don't ask GitHub, rely on commit messages)
+	prLookup PullRequestLookup
+
+	// Pull request approvals for review quests (nil = don't poll GitHub)
+	reviewLookup ReviewLookup
+
+	// Custom quest template directory to load and watch ("" = none)
+	questTemplateDir string
+
+	// Low-power mode, which stretches polling intervals (nil = never)
+	power *power.Monitor
+
 	// Thread safety
 	mu sync.RWMutex // Protects watchers and cancelFuncs maps
 
@@ -110,10 +155,35 @@ func NewWatcherManager(eventBus *game.EventBus, config *config.Config) (*Watcher
 		config:      config,
 		watchers:    make(map[string]*GitWatcher),
 		cancelFuncs: make(map[string]context.CancelFunc),
+		checkpoints: make(map[string]string),
 		running:     false,
 	}, nil
 }
 
+// SetCheckpointStore enables commit replay by giving the manager somewhere to
+// persist the last processed commit per repository. Call before Start().
+// Without a store, commits made while CodeQuest wasn't running are not replayed.
+//
+// Parameters:
+//   - store: Persistence for per-repository checkpoints
+func (wm *WatcherManager) SetCheckpointStore(store CheckpointStore) {
+	wm.checkpointMu.Lock()
+	defer wm.checkpointMu.Unlock()
+	wm.checkpointStore = store
+}
+
+// SetReplayProgress registers a callback told how far replaying each
+// repository's missed commits has got: once before the first commit, after
+// every commit and once more (Done == Total) when the replay ends. It is
+// called from the replaying goroutines, so it must not block. Call it
+// before Start.
+//
+// Parameters:
+//   - report: Progress callback
+func (wm *WatcherManager) SetReplayProgress(report func(ReplayProgress)) {
+	wm.replayProgress = report
+}
+
 // Start begins monitoring all configured Git repositories.
 // It spawns a goroutine for each repository to listen for commit events.
 //
@@ -152,6 +222,9 @@ func (wm *WatcherManager) Start(ctx context.Context) error {
 	wm.running = true
 	wm.runningMu.Unlock()
 
+	// Load replay checkpoints before any watcher starts
+	wm.loadCheckpoints()
+
 	// Expand configured watch paths (handle ~ expansion)
 	watchPaths, err := config.ExpandPaths(wm.config.Git.WatchPaths)
 	if err != nil {
@@ -172,6 +245,27 @@ func (wm *WatcherManager) Start(ctx context.Context) error {
 	// Spawn a monitoring goroutine that handles global context cancellation
 	go wm.monitorContext(ctx)
 
+	if wm.reviewLookup != nil {
+		go wm.pollReviews(ctx)
+	}
+
+	if wm.config.Todos.Enabled {
+		go wm.pollTodos(ctx, wm.config.Todos.WithDefaults())
+	}
+
+	if wm.config.WIP.Enabled {
+		go wm.pollUncommitted(ctx, wm.config.WIP.WithDefaults())
+	}
+
+	if wm.config.TaskList.Enabled {
+		wm.eventBus.Subscribe(game.EventTaskDone, wm.tickCompletedTask)
+		go wm.syncTaskLists(ctx, wm.config.TaskList.WithDefaults())
+	}
+
+	if wm.questTemplateDir != "" {
+		go wm.watchQuestTemplates(ctx, wm.questTemplateDir)
+	}
+
 	return nil
 }
 
@@ -249,6 +343,8 @@ func (wm *WatcherManager) Stop() error {
 //	    log.Printf("Failed to add repository: %v", err)
 //	}
 func (wm *WatcherManager) AddRepository(repoPath string) error {
+	repoPath = config.NormalizePath(repoPath) // One watcher and checkpoint per repo, however it's spelled
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
@@ -257,11 +353,12 @@ func (wm *WatcherManager) AddRepository(repoPath string) error {
 		return nil // Already watching, no-op
 	}
 
-	// Create new GitWatcher
-	watcher, err := NewGitWatcher(repoPath)
+	// Create new GitWatcher (polling where notifications don't work)
+	watcher, err := NewGitWatcherWithMode(repoPath, wm.config.Git.WatchModeFor(repoPath))
 	if err != nil {
 		return fmt.Errorf("failed to create git watcher for %s: %w", repoPath, err)
 	}
+	watcher.SetPollScale(wm.power.Interval) // Poll less often in low-power mode
 
 	// Create a context for this watcher
 	ctx, cancel := context.WithCancel(context.Background())
@@ -282,7 +379,14 @@ func (wm *WatcherManager) AddRepository(repoPath string) error {
 	// Spawn goroutine to listen for errors from this watcher
 	go wm.listenForErrors(ctx, repoPath, watcher)
 
-	log.Printf("Now watching repository: %s", repoPath)
+	// Catch up on commits made while CodeQuest wasn't running
+	go wm.replayMissedCommits(ctx, repoPath, watcher)
+
+	if watcher.Polling() {
+		log.Printf("Now watching repository: %s (polling)", repoPath)
+	} else {
+		log.Printf("Now watching repository: %s", repoPath)
+	}
 	return nil
 }
 
@@ -303,6 +407,8 @@ func (wm *WatcherManager) AddRepository(repoPath string) error {
 //	    log.Printf("Failed to remove repository: %v", err)
 //	}
 func (wm *WatcherManager) RemoveRepository(repoPath string) error {
+	repoPath = config.NormalizePath(repoPath)
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
@@ -337,6 +443,8 @@ func (wm *WatcherManager) RemoveRepository(repoPath string) error {
 //   - The context is cancelled
 //   - The watcher's commit channel is closed
 func (wm *WatcherManager) listenForCommits(ctx context.Context, repoPath string, watcher *GitWatcher) {
+	defer watcher.trackGoroutine()()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -349,11 +457,24 @@ func (wm *WatcherManager) listenForCommits(ctx context.Context, repoPath string,
 				return
 			}
 
+			if wm.skipForeignCommit(repoPath, watcher, commitEvent) {
+				wm.creditForeignCommit(commitEvent, false)
+				wm.saveCheckpoint(repoPath, commitEvent.SHA)
+				continue
+			}
+			if wm.skipBotCommit(repoPath, watcher, &commitEvent) {
+				wm.saveCheckpoint(repoPath, commitEvent.SHA)
+				continue
+			}
+
 			// Convert watcher.CommitEvent to game.Event
+			wm.annotateSquashMerge(ctx, watcher, &commitEvent)
+			wm.annotateOpenSource(watcher, &commitEvent)
 			gameEvent := wm.convertCommitToEvent(commitEvent)
 
 			// Publish to EventBus asynchronously (non-blocking)
 			wm.eventBus.PublishAsync(gameEvent)
+			wm.saveCheckpoint(repoPath, commitEvent.SHA)
 
 			// Log the commit for debugging
 			if wm.config.Debug.Enabled {
@@ -369,6 +490,158 @@ func (wm *WatcherManager) listenForCommits(ctx context.Context, repoPath string,
 	}
 }
 
+// replayMissedCommits publishes retroactive events for commits made since
+// the repository's last checkpoint, bounded by config.Git.ReplayWindowDays.
+// On the first run for a repository there is no checkpoint, so nothing is
+// replayed and the current HEAD becomes the checkpoint.
+//
+// Replayed events are published synchronously and in commit order so quest
+// progress and streaks are applied in the order the work happened.
+func (wm *WatcherManager) replayMissedCommits(ctx context.Context, repoPath string, watcher *GitWatcher) {
+	wm.checkpointMu.Lock()
+	hasStore := wm.checkpointStore != nil
+	sinceSHA, hasCheckpoint := wm.checkpoints[repoPath]
+	wm.checkpointMu.Unlock()
+
+	if !hasStore {
+		return
+	}
+
+	headSHA := watcher.GetLastCommitSHA()
+	if !wm.config.Git.ReplayOnStartup || !hasCheckpoint {
+		wm.saveCheckpoint(repoPath, headSHA)
+		return
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -wm.config.Git.ReplayWindowDays)
+	missed, err := watcher.CommitsSince(sinceSHA, cutoff, maxReplayCommits)
+	if err != nil {
+		log.Printf("Warning: Failed to replay commits for %s: %v", repoPath, err)
+		return
+	}
+
+	if len(missed) > 0 && wm.replayProgress != nil {
+		wm.replayProgress(ReplayProgress{Repo: repoPath, Total: len(missed)})
+		defer wm.replayProgress(ReplayProgress{Repo: repoPath, Done: len(missed), Total: len(missed)})
+	}
+
+	replayed := 0
+	for i, commitEvent := range missed {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if wm.skipForeignCommit(repoPath, watcher, commitEvent) {
+			wm.creditForeignCommit(commitEvent, true)
+		} else if !wm.skipBotCommit(repoPath, watcher, &commitEvent) {
+			wm.annotateSquashMerge(ctx, watcher, &commitEvent)
+			wm.annotateOpenSource(watcher, &commitEvent)
+			wm.eventBus.Publish(wm.convertCommitToEvent(commitEvent))
+			replayed++
+		}
+		wm.saveCheckpoint(repoPath, commitEvent.SHA)
+		if wm.replayProgress != nil && i+1 < len(missed) {
+			wm.replayProgress(ReplayProgress{Repo: repoPath, Done: i + 1, Total: len(missed)})
+		}
+	}
+
+	if replayed > 0 {
+		log.Printf("Replayed %d missed commit(s) in %s", replayed, repoPath)
+	}
+	wm.saveCheckpoint(repoPath, watcher.GetLastCommitSHA())
+}
+
+// skipForeignCommit reports whether a commit was authored by someone other
+// than the player (see config.Git.AuthorEmails), e.g. a teammate's commit
+// pulled in by a fetch or merge. Skipped commits are counted in the
+// watcher's metrics and logged when debugging is enabled. An aggregate event
+// is judged by its newest commit's author.
+func (wm *WatcherManager) skipForeignCommit(repoPath string, watcher *GitWatcher, commit CommitEvent) bool {
+	if wm.config.Git.IsOwnAuthor(commit.Email) {
+		return false
+	}
+
+	skipped := watcher.telemetry.foreignSkipped.Add(int64(max(commit.CommitCount, 1)))
+	if wm.config.Debug.Enabled {
+		log.Printf("Skipped commit in %s: %s by %s <%s> is not one of your author emails (%d foreign commit(s) skipped)",
+			repoPath,
+			commit.SHA[:7],
+			commit.Author,
+			commit.Email,
+			skipped,
+		)
+	}
+	return true
+}
+
+// skipBotCommit reports whether a commit by a bot (a dependency updater or
+// CI auto-formatter, see config.Git.IsBotCommit) should be skipped. Bot
+// commits are always logged and counted in the watcher's metrics; with
+// git.count_bot_commits on they are flagged as bot commits, to earn the
+// reduced bot rate, instead of being skipped. CodeQuest's own task list
+// commits are always skipped.
+func (wm *WatcherManager) skipBotCommit(repoPath string, watcher *GitWatcher, commit *CommitEvent) bool {
+	if isTaskTickCommit(commit.Message) {
+		log.Printf("Task list commit in %s: %s (skipped, no XP)", repoPath, commit.SHA[:7])
+		return true
+	}
+	if !wm.config.Git.IsBotCommit(commit.Author, commit.Email, commit.Message) {
+		return false
+	}
+
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+	commits := int64(max(commit.CommitCount, 1))
+	if wm.config.Git.CountBotCommits {
+		commit.Bot = true
+		watcher.telemetry.botCounted.Add(commits)
+		log.Printf("Bot commit in %s: %s by %s %q (counted at %.2f XP rate)",
+			repoPath, commit.SHA[:7], commit.Author, subject, wm.config.Git.BotXPRate)
+		return false
+	}
+
+	watcher.telemetry.botSkipped.Add(commits)
+	log.Printf("Bot commit in %s: %s by %s %q (skipped, no XP)",
+		repoPath, commit.SHA[:7], commit.Author, subject)
+	return true
+}
+
+// loadCheckpoints reads replay checkpoints from the store, if one is set.
+func (wm *WatcherManager) loadCheckpoints() {
+	wm.checkpointMu.Lock()
+	defer wm.checkpointMu.Unlock()
+
+	if wm.checkpointStore == nil {
+		return
+	}
+
+	checkpoints, err := wm.checkpointStore.LoadRepoCheckpoints()
+	if err != nil {
+		log.Printf("Warning: Failed to load watcher checkpoints: %v", err)
+		return
+	}
+	for repoPath, sha := range checkpoints {
+		wm.checkpoints[repoPath] = sha
+	}
+}
+
+// saveCheckpoint records the last processed commit for a repository.
+// Failures are logged; the worst case is a commit being replayed twice.
+func (wm *WatcherManager) saveCheckpoint(repoPath, sha string) {
+	wm.checkpointMu.Lock()
+	defer wm.checkpointMu.Unlock()
+
+	if wm.checkpointStore == nil || sha == "" || sha == plumbing.ZeroHash.String() || wm.checkpoints[repoPath] == sha {
+		return
+	}
+
+	wm.checkpoints[repoPath] = sha
+	if err := wm.checkpointStore.SaveRepoCheckpoints(wm.checkpoints); err != nil {
+		log.Printf("Warning: Failed to save watcher checkpoint for %s: %v", repoPath, err)
+	}
+}
+
 // listenForErrors runs in a goroutine and listens for errors from a GitWatcher.
 // It logs errors with context about which repository generated them.
 //
@@ -376,6 +649,8 @@ func (wm *WatcherManager) listenForCommits(ctx context.Context, repoPath string,
 //   - The context is cancelled
 //   - The watcher's error channel is closed
 func (wm *WatcherManager) listenForErrors(ctx context.Context, repoPath string, watcher *GitWatcher) {
+	defer watcher.trackGoroutine()()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -408,6 +683,14 @@ func (wm *WatcherManager) listenForErrors(ctx context.Context, repoPath string,
 //   - "lines_removed": int - Total lines removed
 //   - "repo_path": string - Absolute repository path
 //   - "file_details": []FileChange - Per-file change details
+//   - "file_paths": []string - Paths of the changed files
+//   - "retroactive": bool - true if replayed after downtime
+//   - "bot": bool - true for bot commits counted at the reduced bot rate
+//   - "commit_count": int - Commits covered (above 1 for throttled aggregates)
+//   - "diff_hash": string - Fingerprint of the changed lines ("" if none)
+//   - "resolved_todos": []game.TodoComment - TODO/FIXME comments removed
+//   - "collaboration": game.CommitCollaboration - Review and pairing credit from trailers
+//   - "upstream": string - Open source project contributed to ("" for the player's own repos)
 //
 // This data can be used by game logic handlers to:
 //   - Calculate XP rewards (based on lines changed)
@@ -441,6 +724,34 @@ func (wm *WatcherManager) convertCommitToEvent(commit CommitEvent) game.Event {
 
 			// Detailed file changes (for advanced quest tracking)
 			"file_details": commit.FilesChanged,
+			"file_paths":   commit.filePaths(),
+
+			// Replay flag (reduced XP for commits made while offline)
+			"retroactive": commit.Retroactive,
+
+			// Bot commit counted at a reduced rate (git.count_bot_commits)
+			"bot": commit.Bot,
+
+			// Commits covered (above 1 for throttled aggregate events)
+			"commit_count": max(1, commit.CommitCount),
+
+			// Commits behind a squash-merged PR (0 if not looked up)
+			"squash_commits": commit.SquashCommits,
+
+			// go.mod requirement changes (dependency quests and achievements)
+			"dependency_changes": commit.DependencyChanges,
+
+			// Fingerprint of the changed lines (repeated diff detection)
+			"diff_hash": commit.DiffHash,
+
+			// TODO/FIXME comments the commit removed (todo quests)
+			"resolved_todos": commit.ResolvedTodos,
+
+			// Reviewers and co-authors from trailers and git notes (collaboration quests)
+			"collaboration": game.CreditCollaboration(commit.Trailers, commit.Email, wm.config.Git.AuthorEmails),
+
+			// Open source project the commit contributes to (Open Source track)
+			"upstream": commit.Upstream,
 		},
 	}
 }
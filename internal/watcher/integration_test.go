@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -654,6 +655,10 @@ func TestWatcherManager_EventPublishing(t *testing.T) {
 		if len(fileDetails) != 2 {
 			t.Errorf("Expected 2 file details, got %d", len(fileDetails))
 		}
+		filePaths, ok := gameEvent.Data["file_paths"].([]string)
+		if !ok || len(filePaths) != 2 || filePaths[1] != "file2.go" {
+			t.Errorf("file_paths = %v, want [file1.go file2.go]", gameEvent.Data["file_paths"])
+		}
 	})
 }
 
@@ -885,3 +890,188 @@ func TestWatcherManager_ThreadSafety(t *testing.T) {
 		// If we get here without panic, thread safety is maintained
 	})
 }
+
+// memoryCheckpointStore is an in-memory CheckpointStore for testing replay.
+type memoryCheckpointStore struct {
+	checkpoints map[string]string
+	mu          sync.Mutex
+}
+
+// LoadRepoCheckpoints returns a copy of the stored checkpoints.
+func (s *memoryCheckpointStore) LoadRepoCheckpoints() (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints := make(map[string]string, len(s.checkpoints))
+	for repoPath, sha := range s.checkpoints {
+		checkpoints[repoPath] = sha
+	}
+	return checkpoints, nil
+}
+
+// SaveRepoCheckpoints replaces the stored checkpoints with a copy.
+func (s *memoryCheckpointStore) SaveRepoCheckpoints(checkpoints map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints = make(map[string]string, len(checkpoints))
+	for repoPath, sha := range checkpoints {
+		s.checkpoints[repoPath] = sha
+	}
+	return nil
+}
+
+// get returns the stored checkpoint for a repository.
+func (s *memoryCheckpointStore) get(repoPath string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[repoPath]
+}
+
+// TestWatcherManager_Replay tests replaying commits made while offline.
+func TestWatcherManager_Replay(t *testing.T) {
+	tests := []struct {
+		name            string
+		replayOnStartup bool
+		hasCheckpoint   bool
+		authorEmails    []string
+		botMessages     []string
+		countBots       bool
+		wantReplayed    int
+		wantSkipped     int64
+		wantBotSkipped  int64
+		wantBotCounted  int64
+	}{
+		{"replays commits since checkpoint", true, true, nil, nil, false, 2, 0, 0, 0},
+		{"first run only records checkpoint", true, false, nil, nil, false, 0, 0, 0, 0},
+		{"replay disabled", false, true, nil, nil, false, 0, 0, 0, 0},
+		{"replays own commits", true, true, []string{"other@example.com", "TEST@example.com"}, nil, false, 2, 0, 0, 0},
+		{"skips other authors' commits", true, true, []string{"me@example.com"}, nil, false, 0, 2, 0, 0},
+		{"skips bot commits", true, true, nil, []string{"feat: offline one"}, false, 1, 0, 1, 0},
+		{"counts bot commits when enabled", true, true, nil, []string{"feat: offline*"}, true, 2, 0, 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := createTestRepoWithInitialCommit(t)
+
+			gw, err := NewGitWatcher(repo)
+			if err != nil {
+				t.Fatalf("NewGitWatcher() error = %v", err)
+			}
+			checkpointSHA := gw.GetLastCommitSHA()
+
+			// Commits made "while CodeQuest wasn't running"
+			makeCommitInRepo(t, repo, "feat: offline one", map[string]string{"a.go": "package a\n"})
+			makeCommitInRepo(t, repo, "feat: offline two", map[string]string{"b.go": "package b\n"})
+
+			store := &memoryCheckpointStore{checkpoints: map[string]string{}}
+			if tt.hasCheckpoint {
+				store.checkpoints[repo] = checkpointSHA
+			}
+
+			cfg := &config.Config{
+				Git: config.GitConfig{
+					WatchPaths:       []string{repo},
+					ReplayOnStartup:  tt.replayOnStartup,
+					ReplayWindowDays: 7,
+					ReplayXPRate:     0.5,
+					AuthorEmails:     tt.authorEmails,
+					BotMessages:      tt.botMessages,
+					CountBotCommits:  tt.countBots,
+				},
+			}
+
+			eventBus := game.NewEventBus()
+			var replayed []game.Event
+			var mu sync.Mutex
+			eventBus.Subscribe(game.EventCommit, func(e game.Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				replayed = append(replayed, e)
+			})
+
+			manager, err := NewWatcherManager(eventBus, cfg)
+			if err != nil {
+				t.Fatalf("Failed to create manager: %v", err)
+			}
+			manager.SetCheckpointStore(store)
+			var progress []ReplayProgress
+			manager.SetReplayProgress(func(p ReplayProgress) {
+				mu.Lock()
+				defer mu.Unlock()
+				progress = append(progress, p)
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			if err := manager.Start(ctx); err != nil {
+				t.Fatalf("Failed to start manager: %v", err)
+			}
+			defer manager.Stop()
+
+			// Replay runs in the background; wait for the checkpoint to reach HEAD
+			headSHA := getRepoHead(t, repo)
+			deadline := time.Now().Add(5 * time.Second)
+			for store.get(repo) != headSHA && time.Now().Before(deadline) {
+				time.Sleep(50 * time.Millisecond)
+			}
+			if store.get(repo) != headSHA {
+				t.Fatalf("checkpoint = %q, want HEAD %q", store.get(repo), headSHA)
+			}
+
+			// Progress is reported before, during and after a replay
+			var wantProgress []ReplayProgress
+			if tt.replayOnStartup && tt.hasCheckpoint {
+				wantProgress = []ReplayProgress{{repo, 0, 2}, {repo, 1, 2}, {repo, 2, 2}}
+			}
+			for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+				mu.Lock()
+				reported := len(progress)
+				mu.Unlock()
+				if reported >= len(wantProgress) {
+					break
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !reflect.DeepEqual(progress, wantProgress) {
+				t.Errorf("replay progress = %+v, want %+v", progress, wantProgress)
+			}
+			if len(replayed) != tt.wantReplayed {
+				t.Fatalf("replayed %d events, want %d", len(replayed), tt.wantReplayed)
+			}
+			for _, event := range replayed {
+				if retroactive, _ := event.Data["retroactive"].(bool); !retroactive {
+					t.Errorf("replayed event %v not flagged retroactive", event.Data["sha"])
+				}
+				if bot, _ := event.Data["bot"].(bool); bot != tt.countBots {
+					t.Errorf("replayed event %v bot = %v, want %v", event.Data["sha"], bot, tt.countBots)
+				}
+			}
+			metrics := manager.Metrics()[0]
+			if metrics.ForeignCommitsSkipped != tt.wantSkipped {
+				t.Errorf("ForeignCommitsSkipped = %d, want %d", metrics.ForeignCommitsSkipped, tt.wantSkipped)
+			}
+			if metrics.BotCommitsSkipped != tt.wantBotSkipped || metrics.BotCommitsCounted != tt.wantBotCounted {
+				t.Errorf("bot commits skipped/counted = %d/%d, want %d/%d",
+					metrics.BotCommitsSkipped, metrics.BotCommitsCounted, tt.wantBotSkipped, tt.wantBotCounted)
+			}
+		})
+	}
+}
+
+// getRepoHead returns the HEAD commit SHA of a test repository.
+func getRepoHead(t *testing.T, repoPath string) string {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("Failed to open repo: %v", err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatalf("Failed to get HEAD: %v", err)
+	}
+	return head.Hash().String()
+}
@@ -0,0 +1,134 @@
+package watcher
+
+import (
+	"fmt"
+	"strings"
+
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// commitPatch diffs a commit against its first parent (or an empty tree for
+// the root commit), the same comparison commit.Stats() makes.
+//
+// Parameters:
+//   - commit: The commit to diff
+//
+// Returns:
+//   - *object.Patch: The commit's changes
+//   - error: Failed to read the trees
+func commitPatch(commit *object.Commit) (*object.Patch, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit tree: %w", err)
+	}
+
+	parentTree := &object.Tree{}
+	if commit.NumParents() != 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent commit: %w", err)
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, fmt.Errorf("failed to get parent tree: %w", err)
+		}
+	}
+
+	return parentTree.Patch(tree)
+}
+
+// lineEndingAwareStats counts lines added and removed per file like
+// go-git's FileStats, except that a line whose only change is its ending
+// (CRLF ↔ LF) isn't counted. Without this, a Windows checkout that
+// normalizes line endings reports every line of the file as rewritten.
+//
+// Parameters:
+//   - filePatches: Per-file patches from a commit or range diff
+//
+// Returns:
+//   - object.FileStats: Lines added and removed per file
+func lineEndingAwareStats(filePatches []fdiff.FilePatch) object.FileStats {
+	var stats object.FileStats
+
+	for _, fp := range filePatches {
+		// Skip empty patches (binary files, submodule ref updates)
+		chunks := fp.Chunks()
+		if len(chunks) == 0 {
+			continue
+		}
+
+		stat := object.FileStat{Name: filePatchName(fp)}
+
+		// Deleted lines by content (ending stripped) and whether they ended in CR
+		deleted := make(map[string][2]int)
+		var added []string
+		for _, chunk := range chunks {
+			switch chunk.Type() {
+			case fdiff.Delete:
+				for _, line := range splitLines(chunk.Content()) {
+					content, crlf := trimCR(line)
+					counts := deleted[content]
+					counts[crIndex(crlf)]++
+					deleted[content] = counts
+					stat.Deletion++
+				}
+			case fdiff.Add:
+				added = append(added, splitLines(chunk.Content())...)
+			}
+		}
+
+		// An added line cancels a deleted line with the same content but the
+		// other line ending: the pair is an ending conversion, not an edit
+		for _, line := range added {
+			content, crlf := trimCR(line)
+			counts := deleted[content]
+			if other := crIndex(!crlf); counts[other] > 0 {
+				counts[other]--
+				deleted[content] = counts
+				stat.Deletion--
+				continue
+			}
+			stat.Addition++
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats
+}
+
+// filePatchName names a file the way go-git's FileStats does ("a => b" for renames).
+func filePatchName(fp fdiff.FilePatch) string {
+	from, to := fp.Files()
+	switch {
+	case from == nil:
+		return to.Path()
+	case to == nil, from.Path() == to.Path():
+		return from.Path()
+	default:
+		return fmt.Sprintf("%s => %s", from.Path(), to.Path())
+	}
+}
+
+// splitLines splits chunk content into lines, keeping any trailing CR.
+// A final line without a newline still counts as a line.
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+// trimCR strips a trailing carriage return and reports whether there was one.
+func trimCR(line string) (string, bool) {
+	trimmed, crlf := strings.CutSuffix(line, "\r")
+	return trimmed, crlf
+}
+
+// crIndex maps a line ending to its slot in the deleted-line counts.
+func crIndex(crlf bool) int {
+	if crlf {
+		return 1
+	}
+	return 0
+}
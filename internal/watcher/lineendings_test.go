@@ -0,0 +1,52 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// TestLineEndingAwareStats tests that converting line endings isn't counted
+// as rewriting every line, while real edits in the same commit still are.
+func TestLineEndingAwareStats(t *testing.T) {
+	tests := []struct {
+		name        string
+		before      string
+		after       string
+		wantAdded   int
+		wantRemoved int
+	}{
+		{"LF to CRLF only", "a\nb\nc\n", "a\r\nb\r\nc\r\n", 0, 0},
+		{"CRLF to LF only", "a\r\nb\r\nc\r\n", "a\nb\nc\n", 0, 0},
+		{"conversion plus edits", "a\nb\nc\n", "a\r\nB\r\nc\r\nd\r\n", 2, 1},
+		{"CRLF edit", "a\r\nb\r\n", "a\r\nchanged\r\n", 1, 1},
+		{"moved line still counts", "a\nb\n", "b\na\n", 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoPath, cleanup := createTestRepo(t)
+			defer cleanup()
+
+			makeCommit(t, repoPath, "Add file", map[string]string{"file.txt": tt.before})
+			sha := makeCommit(t, repoPath, "Change file", map[string]string{"file.txt": tt.after})
+
+			gw, err := NewGitWatcher(repoPath)
+			if err != nil {
+				t.Fatalf("NewGitWatcher() error = %v", err)
+			}
+			event, err := gw.extractCommitData(plumbing.NewHash(sha))
+			if err != nil {
+				t.Fatalf("extractCommitData() error = %v", err)
+			}
+
+			if event.TotalAdded != tt.wantAdded || event.TotalRemoved != tt.wantRemoved {
+				t.Errorf("lines = +%d/-%d, want +%d/-%d",
+					event.TotalAdded, event.TotalRemoved, tt.wantAdded, tt.wantRemoved)
+			}
+			if event.TotalFiles != 1 || event.FilesChanged[0].Path != "file.txt" {
+				t.Errorf("FilesChanged = %+v, want file.txt", event.FilesChanged)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Package watcher provides file system monitoring capabilities for CodeQuest.
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Throttling thresholds. When computing commit diffs falls behind (slow diffs
+// or a backed-up commit channel), the watcher stops emitting one event per
+// commit and instead folds new commits into a single delayed aggregate event.
+const (
+	diffLatencyBudget = 250 * time.Millisecond // Diffs slower than this trigger throttling
+	throttleDelay     = 2 * time.Second        // How long commits are batched while throttled
+	eventRateWindow   = time.Minute            // Window for the events/sec rate
+	maxRateSamples    = 256                    // Cap on remembered event timestamps
+)
+
+// WatcherMetrics is a point-in-time snapshot of one GitWatcher's internals.
+// Snapshots are cheap to take and safe to read from any goroutine.
+type WatcherMetrics struct {
+	RepoPath   string // Absolute path to the watched repository
+	Goroutines int    // Goroutines currently working for this watcher
+
+	// Channel depths
+	CommitQueueDepth    int // Commit events waiting to be consumed
+	CommitQueueCapacity int // Buffer size of the commit channel
+	ErrorQueueDepth     int // Errors waiting to be consumed
+
+	// Throughput
+	EventsEmitted int64   // Commit events sent since the watcher started
+	EventsPerSec  float64 // Commit events per second over the last minute
+
+	// Diff computation
+	DiffsComputed   int64         // Number of diffs calculated
+	LastDiffLatency time.Duration // Duration of the most recent diff
+	AvgDiffLatency  time.Duration // Mean diff duration
+	MaxDiffLatency  time.Duration // Slowest diff seen
+
+	// Throttling
+	Throttled         bool  // True while commits are being batched
+	PendingCommits    int   // Commits waiting in the current batch
+	AggregatedEvents  int64 // Aggregate events emitted (each covering 2+ commits)
+	AggregatedCommits int64 // Commits folded into aggregate events
+
+	// Author filtering
+	ForeignCommitsSkipped int64 // Commits by other authors that earned no XP
+	BotCommitsSkipped     int64 // Bot commits that earned no XP
+	BotCommitsCounted     int64 // Bot commits counted at the reduced bot rate
+
+	// Polling fallback
+	Polling      bool          // True while HEAD is polled instead of watched
+	PollInterval time.Duration // Current polling interval (before low-power scaling)
+}
+
+// watcherTelemetry collects the measurements behind WatcherMetrics.
+// Counters are atomic; the latency and rate samples are guarded by mu.
+type watcherTelemetry struct {
+	goroutines        atomic.Int32
+	eventsEmitted     atomic.Int64
+	aggregatedEvents  atomic.Int64
+	aggregatedCommits atomic.Int64
+	foreignSkipped    atomic.Int64
+	botSkipped        atomic.Int64
+	botCounted        atomic.Int64
+
+	mu            sync.Mutex
+	eventTimes    []time.Time   // Recent emit times (bounded by maxRateSamples)
+	diffsComputed int64         // Number of diff samples
+	diffTotal     time.Duration // Sum of diff durations
+	lastDiff      time.Duration // Most recent diff duration
+	maxDiff       time.Duration // Slowest diff duration
+}
+
+// recordDiff stores how long a diff computation took.
+func (t *watcherTelemetry) recordDiff(elapsed time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.diffsComputed++
+	t.diffTotal += elapsed
+	t.lastDiff = elapsed
+	t.maxDiff = max(t.maxDiff, elapsed)
+}
+
+// recordEvent counts a commit event sent to the commit channel.
+// Aggregate events also record how many commits they cover.
+func (t *watcherTelemetry) recordEvent(at time.Time, commits int) {
+	t.eventsEmitted.Add(1)
+	if commits > 1 {
+		t.aggregatedEvents.Add(1)
+		t.aggregatedCommits.Add(int64(commits))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.eventTimes = append(t.eventTimes, at)
+	if len(t.eventTimes) > maxRateSamples {
+		t.eventTimes = t.eventTimes[len(t.eventTimes)-maxRateSamples:]
+	}
+}
+
+// diffBehind reports whether the latest diff exceeded the latency budget.
+func (t *watcherTelemetry) diffBehind() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lastDiff > diffLatencyBudget
+}
+
+// fill copies the telemetry into a metrics snapshot.
+func (t *watcherTelemetry) fill(m *WatcherMetrics, now time.Time) {
+	m.Goroutines = int(t.goroutines.Load())
+	m.EventsEmitted = t.eventsEmitted.Load()
+	m.AggregatedEvents = t.aggregatedEvents.Load()
+	m.AggregatedCommits = t.aggregatedCommits.Load()
+	m.ForeignCommitsSkipped = t.foreignSkipped.Load()
+	m.BotCommitsSkipped = t.botSkipped.Load()
+	m.BotCommitsCounted = t.botCounted.Load()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m.DiffsComputed = t.diffsComputed
+	m.LastDiffLatency = t.lastDiff
+	m.MaxDiffLatency = t.maxDiff
+	if t.diffsComputed > 0 {
+		m.AvgDiffLatency = t.diffTotal / time.Duration(t.diffsComputed)
+	}
+
+	recent := 0
+	for _, at := range t.eventTimes {
+		if now.Sub(at) <= eventRateWindow {
+			recent++
+		}
+	}
+	m.EventsPerSec = float64(recent) / eventRateWindow.Seconds()
+}
+
+// trackGoroutine counts a goroutine working on behalf of the watcher.
+// Call the returned function when the goroutine exits.
+func (gw *GitWatcher) trackGoroutine() func() {
+	gw.telemetry.goroutines.Add(1)
+	return func() { gw.telemetry.goroutines.Add(-1) }
+}
+
+// Metrics returns a snapshot of the watcher's telemetry.
+//
+// Thread Safety:
+// This method is thread-safe and can be called concurrently.
+//
+// Returns:
+//   - WatcherMetrics: Current goroutine, queue, throughput and diff metrics
+func (gw *GitWatcher) Metrics() WatcherMetrics {
+	m := WatcherMetrics{
+		RepoPath:            gw.repoPath,
+		CommitQueueDepth:    len(gw.commits),
+		CommitQueueCapacity: cap(gw.commits),
+		ErrorQueueDepth:     len(gw.errors),
+	}
+	gw.telemetry.fill(&m, time.Now())
+
+	gw.mu.RLock()
+	m.Throttled = gw.pendingCount > 0
+	m.PendingCommits = gw.pendingCount
+	gw.mu.RUnlock()
+
+	if gw.Polling() {
+		m.Polling = true
+		m.PollInterval = time.Duration(gw.pollInterval.Load())
+	}
+
+	return m
+}
+
+// Metrics returns telemetry snapshots for every watched repository,
+// sorted by repository path.
+//
+// Thread Safety:
+// This method acquires a read lock and is safe to call concurrently.
+//
+// Returns:
+//   - []WatcherMetrics: One snapshot per watcher
+func (wm *WatcherManager) Metrics() []WatcherMetrics {
+	wm.mu.RLock()
+	metrics := make([]WatcherMetrics, 0, len(wm.watchers))
+	for _, watcher := range wm.watchers {
+		metrics = append(metrics, watcher.Metrics())
+	}
+	wm.mu.RUnlock()
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].RepoPath < metrics[j].RepoPath
+	})
+	return metrics
+}
+
+// MetricsHandler returns an http.Handler that serves watcher metrics in the
+// Prometheus text exposition format, for mounting at /metrics on a local API.
+//
+// Returns:
+//   - http.Handler: Handler writing the current metrics on GET
+func (wm *WatcherManager) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := WriteMetrics(w, wm.Metrics()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// ServeMetrics listens on addr and serves MetricsHandler at /metrics until
+// ctx is cancelled. The listener is opened before returning, so a busy or
+// invalid address is reported to the caller instead of failing silently.
+//
+// Parameters:
+//   - ctx: Context whose cancellation shuts the server down
+//   - addr: Listen address, such as "127.0.0.1:9464"
+//
+// Returns:
+//   - net.Addr: Address actually listened on (useful with port 0)
+//   - error: Listen error
+func (wm *WatcherManager) ServeMetrics(ctx context.Context, addr string) (net.Addr, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for metrics on %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", wm.MetricsHandler())
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	go server.Serve(listener) // Returns http.ErrServerClosed once ctx is cancelled
+
+	return listener.Addr(), nil
+}
+
+// WriteMetrics writes metrics snapshots in the Prometheus text format,
+// one sample per watcher labelled with its repository path.
+//
+// Parameters:
+//   - w: Destination writer
+//   - metrics: Snapshots to write
+//
+// Returns:
+//   - error: Write error
+func WriteMetrics(w io.Writer, metrics []WatcherMetrics) error {
+	families := []struct {
+		name, kind, help string
+		value            func(WatcherMetrics) float64
+	}{
+		{"codequest_watcher_goroutines", "gauge", "Goroutines working for the watcher.",
+			func(m WatcherMetrics) float64 { return float64(m.Goroutines) }},
+		{"codequest_watcher_commit_queue_depth", "gauge", "Commit events waiting to be consumed.",
+			func(m WatcherMetrics) float64 { return float64(m.CommitQueueDepth) }},
+		{"codequest_watcher_error_queue_depth", "gauge", "Errors waiting to be consumed.",
+			func(m WatcherMetrics) float64 { return float64(m.ErrorQueueDepth) }},
+		{"codequest_watcher_events_total", "counter", "Commit events emitted.",
+			func(m WatcherMetrics) float64 { return float64(m.EventsEmitted) }},
+		{"codequest_watcher_events_per_second", "gauge", "Commit events per second over the last minute.",
+			func(m WatcherMetrics) float64 { return m.EventsPerSec }},
+		{"codequest_watcher_diff_latency_seconds", "gauge", "Duration of the most recent diff computation.",
+			func(m WatcherMetrics) float64 { return m.LastDiffLatency.Seconds() }},
+		{"codequest_watcher_diff_latency_avg_seconds", "gauge", "Mean diff computation duration.",
+			func(m WatcherMetrics) float64 { return m.AvgDiffLatency.Seconds() }},
+		{"codequest_watcher_throttled", "gauge", "1 while commits are batched into aggregate events.",
+			func(m WatcherMetrics) float64 {
+				if m.Throttled {
+					return 1
+				}
+				return 0
+			}},
+		{"codequest_watcher_polling", "gauge", "1 while HEAD is polled because file notifications are unavailable.",
+			func(m WatcherMetrics) float64 {
+				if m.Polling {
+					return 1
+				}
+				return 0
+			}},
+		{"codequest_watcher_aggregated_commits_total", "counter", "Commits folded into aggregate events.",
+			func(m WatcherMetrics) float64 { return float64(m.AggregatedCommits) }},
+		{"codequest_watcher_foreign_commits_skipped_total", "counter", "Commits by other authors skipped for XP.",
+			func(m WatcherMetrics) float64 { return float64(m.ForeignCommitsSkipped) }},
+		{"codequest_watcher_bot_commits_skipped_total", "counter", "Bot commits skipped for XP.",
+			func(m WatcherMetrics) float64 { return float64(m.BotCommitsSkipped) }},
+		{"codequest_watcher_bot_commits_counted_total", "counter", "Bot commits counted at the reduced bot rate.",
+			func(m WatcherMetrics) float64 { return float64(m.BotCommitsCounted) }},
+	}
+
+	for _, family := range families {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n",
+			family.name, family.help, family.name, family.kind); err != nil {
+			return fmt.Errorf("writing metrics: %w", err)
+		}
+		for _, m := range metrics {
+			if _, err := fmt.Fprintf(w, "%s{repo=%q} %g\n",
+				family.name, m.RepoPath, family.value(m)); err != nil {
+				return fmt.Errorf("writing metrics: %w", err)
+			}
+		}
+	}
+	return nil
+}
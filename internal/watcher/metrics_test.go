@@ -0,0 +1,143 @@
+package watcher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TestGitWatcher_ThrottleAggregates tests that slow diffs switch the watcher
+// to batching, and that the batch is flushed as one aggregate event whose
+// statistics cover every batched commit.
+func TestGitWatcher_ThrottleAggregates(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	gw, err := NewGitWatcher(repoPath)
+	if err != nil {
+		t.Fatalf("NewGitWatcher() error = %v", err)
+	}
+
+	// A fast diff emits one event per commit
+	first := makeCommit(t, repoPath, "feat: first", map[string]string{"a.go": "package a\n"})
+	if err := gw.processCommit(); err != nil {
+		t.Fatalf("processCommit() error = %v", err)
+	}
+	event := <-gw.commits
+	if event.SHA != first || event.CommitCount != 1 {
+		t.Fatalf("event = %s x%d, want %s x1", event.SHA, event.CommitCount, first)
+	}
+
+	// Simulate diff computation falling behind
+	gw.telemetry.recordDiff(diffLatencyBudget + time.Second)
+
+	makeCommit(t, repoPath, "feat: second", map[string]string{"b.go": "package b\n\nfunc B() {}\n"})
+	if err := gw.processCommit(); err != nil {
+		t.Fatalf("processCommit() error = %v", err)
+	}
+	third := makeCommit(t, repoPath, "feat: third", map[string]string{"c.go": "package c\n"})
+	if err := gw.processCommit(); err != nil {
+		t.Fatalf("processCommit() error = %v", err)
+	}
+
+	metrics := gw.Metrics()
+	if len(gw.commits) != 0 || !metrics.Throttled || metrics.PendingCommits != 2 {
+		t.Fatalf("throttled watcher queued %d events, metrics = %+v; want 0 queued, 2 pending",
+			len(gw.commits), metrics)
+	}
+
+	if err := gw.flushPending(); err != nil {
+		t.Fatalf("flushPending() error = %v", err)
+	}
+	aggregate := <-gw.commits
+	if aggregate.SHA != third || aggregate.CommitCount != 2 {
+		t.Errorf("aggregate = %s x%d, want %s x2", aggregate.SHA, aggregate.CommitCount, third)
+	}
+	if aggregate.TotalFiles != 2 || aggregate.TotalAdded != 4 {
+		t.Errorf("aggregate stats = %d files +%d, want 2 files +4", aggregate.TotalFiles, aggregate.TotalAdded)
+	}
+
+	metrics = gw.Metrics()
+	if metrics.Throttled || metrics.EventsEmitted != 2 || metrics.AggregatedCommits != 2 {
+		t.Errorf("metrics after flush = %+v, want unthrottled, 2 events, 2 aggregated commits", metrics)
+	}
+	if metrics.DiffsComputed != 3 || metrics.MaxDiffLatency < diffLatencyBudget {
+		t.Errorf("diff metrics = %d diffs (max %v), want 3 including the slow one",
+			metrics.DiffsComputed, metrics.MaxDiffLatency)
+	}
+}
+
+// TestWriteMetrics tests the Prometheus text output.
+func TestWriteMetrics(t *testing.T) {
+	var out strings.Builder
+	err := WriteMetrics(&out, []WatcherMetrics{{
+		RepoPath:         "/src/app",
+		Goroutines:       3,
+		CommitQueueDepth: 4,
+		LastDiffLatency:  1500 * time.Millisecond,
+		Throttled:        true,
+	}})
+	if err != nil {
+		t.Fatalf("WriteMetrics() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# TYPE codequest_watcher_goroutines gauge",
+		`codequest_watcher_goroutines{repo="/src/app"} 3`,
+		`codequest_watcher_commit_queue_depth{repo="/src/app"} 4`,
+		`codequest_watcher_diff_latency_seconds{repo="/src/app"} 1.5`,
+		`codequest_watcher_throttled{repo="/src/app"} 1`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("WriteMetrics() output missing %q", want)
+		}
+	}
+}
+
+// TestWatcherManager_ServeMetrics tests that the metrics listener serves
+// /metrics and stops when its context is cancelled.
+func TestWatcherManager_ServeMetrics(t *testing.T) {
+	manager, err := NewWatcherManager(game.NewEventBus(), &config.Config{})
+	if err != nil {
+		t.Fatalf("NewWatcherManager() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	addr, err := manager.ServeMetrics(ctx, "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ServeMetrics() error = %v", err)
+	}
+
+	resp, err := http.Get("http://" + addr.String() + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "# TYPE codequest_watcher_goroutines gauge") {
+		t.Errorf("GET /metrics = %d %q, want 200 with metric families", resp.StatusCode, body)
+	}
+
+	// A second listener on the same address fails up front
+	if _, err := manager.ServeMetrics(ctx, addr.String()); err == nil {
+		t.Error("ServeMetrics() on a busy address error = nil, want error")
+	}
+
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := http.Get("http://" + addr.String() + "/metrics"); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("metrics listener still serving after cancel")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
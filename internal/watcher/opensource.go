@@ -0,0 +1,37 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file spots open source contributions: commits to repositories whose
+// origin remote is owned by someone other than the player.
+package watcher
+
+import (
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/github"
+)
+
+// annotateOpenSource sets commit.Upstream for commits to repositories the
+// player doesn't own.
+func (wm *WatcherManager) annotateOpenSource(watcher *GitWatcher, commit *CommitEvent) {
+	commit.Upstream = openSourceUpstream(watcher.RemoteURL(), wm.config.Git)
+}
+
+// openSourceUpstream returns the project ("owner/name") a repository's
+// origin remote points at if it's owned by someone outside
+// git.own_accounts. Detection is off until own_accounts is set, and only
+// GitHub remotes are recognised.
+//
+// Parameters:
+//   - remoteURL: The repository's origin remote ("" if it has none)
+//   - git: Git settings naming the player's own accounts
+//
+// Returns:
+//   - string: The upstream project, or "" for the player's own repositories
+func openSourceUpstream(remoteURL string, git config.GitConfig) string {
+	if len(git.OwnAccounts) == 0 {
+		return ""
+	}
+	owner, repo, ok := github.ParseRemoteURL(remoteURL)
+	if !ok || git.IsOwnAccount(owner) {
+		return ""
+	}
+	return owner + "/" + repo
+}
@@ -0,0 +1,36 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+)
+
+// TestOpenSourceUpstream tests telling open source contributions from the
+// player's own repositories by their origin remote.
+func TestOpenSourceUpstream(t *testing.T) {
+	own := config.GitConfig{OwnAccounts: []string{"ada", "Ada-Labs"}}
+
+	tests := []struct {
+		name   string
+		remote string
+		git    config.GitConfig
+		want   string
+	}{
+		{"someone else's repo", "git@github.com:golang/go.git", own, "golang/go"},
+		{"https remote", "https://github.com/charmbracelet/bubbletea", own, "charmbracelet/bubbletea"},
+		{"own user", "git@github.com:ada/dotfiles.git", own, ""},
+		{"own org, any case", "https://github.com/ada-labs/engine.git", own, ""},
+		{"no origin", "", own, ""},
+		{"not on GitHub", "git@gitlab.com:someone/project.git", own, ""},
+		{"detection off without own accounts", "git@github.com:golang/go.git", config.GitConfig{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := openSourceUpstream(tt.remote, tt.git); got != tt.want {
+				t.Errorf("openSourceUpstream(%q) = %q, want %q", tt.remote, got, tt.want)
+			}
+		})
+	}
+}
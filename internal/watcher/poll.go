@@ -0,0 +1,113 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file implements the polling fallback: where filesystem notifications
+// are unavailable (NFS and other network mounts, some containers, exhausted
+// inotify limits) the watcher checks HEAD on a timer instead. The interval
+// starts short and backs off while nothing changes, so an idle repository
+// costs a HEAD read a minute.
+package watcher
+
+import (
+	"fmt"
+	"time"
+)
+
+// Watch modes (config git.watch_mode and git.watch_modes).
+const (
+	WatchModeAuto   = "auto"   // Notifications, polling if they're unavailable
+	WatchModeNotify = "notify" // Notifications only (fail if unavailable)
+	WatchModePoll   = "poll"   // Polling only
+)
+
+// Polling intervals. After a commit the watcher polls every minPollInterval,
+// then waits pollBackoff times longer after each poll that finds nothing,
+// up to maxPollInterval. Low-power mode stretches every interval.
+const (
+	minPollInterval = 2 * time.Second
+	maxPollInterval = time.Minute
+	pollBackoff     = 1.5
+)
+
+// nextPollInterval returns how long to wait before the next poll.
+//
+// Parameters:
+//   - current: The interval before the poll that just ran
+//   - changed: Whether that poll found a new commit
+//
+// Returns:
+//   - time.Duration: The next interval, between minPollInterval and maxPollInterval
+func nextPollInterval(current time.Duration, changed bool) time.Duration {
+	if changed {
+		return minPollInterval
+	}
+	next := time.Duration(float64(current) * pollBackoff)
+	return max(min(next, maxPollInterval), minPollInterval)
+}
+
+// SetPollScale adjusts polling intervals, e.g. stretching them in
+// low-power mode. Call before Start().
+//
+// Parameters:
+//   - scale: Maps a polling interval to the one to wait (nil = unchanged)
+func (gw *GitWatcher) SetPollScale(scale func(time.Duration) time.Duration) {
+	gw.pollScale = scale
+}
+
+// Polling reports whether the watcher polls HEAD instead of receiving
+// filesystem notifications.
+//
+// Thread Safety:
+// This method is thread-safe and can be called concurrently.
+func (gw *GitWatcher) Polling() bool {
+	return gw.polling.Load()
+}
+
+// fallBackToPolling switches a watcher whose notifications failed to
+// polling, in auto mode. In notify mode the failure is returned instead.
+//
+// Parameters:
+//   - cause: Why notifications are unavailable
+//
+// Returns:
+//   - error: cause, if the watch mode doesn't allow polling
+func (gw *GitWatcher) fallBackToPolling(cause error) error {
+	if gw.mode == WatchModeNotify {
+		return cause
+	}
+	if gw.watcher != nil {
+		gw.watcher.Close()
+		gw.watcher = nil
+	}
+	gw.polling.Store(true)
+	gw.reportError(fmt.Errorf("warning: file notifications unavailable, polling instead: %w", cause))
+	return nil
+}
+
+// pollWait returns the time to wait for the next poll, scaled.
+func (gw *GitWatcher) pollWait(interval time.Duration) time.Duration {
+	gw.pollInterval.Store(int64(interval))
+	if gw.pollScale == nil {
+		return interval
+	}
+	return gw.pollScale(interval)
+}
+
+// pollHead checks HEAD for a new commit and processes it like a ref
+// change notification would.
+//
+// Returns:
+//   - bool: Whether HEAD moved since the last check
+//   - error: Failed to read HEAD or process the commit
+func (gw *GitWatcher) pollHead() (bool, error) {
+	head, err := gw.repo.Head()
+	if err != nil {
+		return false, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+
+	gw.mu.RLock()
+	changed := head.Hash() != gw.lastCommitSHA
+	gw.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+	return true, gw.processCommit()
+}
@@ -0,0 +1,149 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestNextPollInterval tests the polling backoff.
+func TestNextPollInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		current time.Duration
+		changed bool
+		want    time.Duration
+	}{
+		{"commit resets", maxPollInterval, true, minPollInterval},
+		{"idle backs off", 4 * time.Second, false, 6 * time.Second},
+		{"capped", 50 * time.Second, false, maxPollInterval},
+		{"floored", 0, false, minPollInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPollInterval(tt.current, tt.changed); got != tt.want {
+				t.Errorf("nextPollInterval(%s, %v) = %s, want %s", tt.current, tt.changed, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGitWatcher_Polling tests that a polling watcher detects commits.
+func TestGitWatcher_Polling(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	watcher, err := NewGitWatcherWithMode(repoPath, WatchModePoll)
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	watcher.SetPollScale(func(d time.Duration) time.Duration { return d / 100 })
+	if !watcher.Polling() {
+		t.Fatal("Expected a poll-mode watcher to be polling")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := watcher.Start(ctx); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer watcher.Stop()
+
+	expectedSHA := makeCommit(t, repoPath, "feat: polled", map[string]string{"poll.go": "package poll\n"})
+
+	select {
+	case event := <-watcher.CommitChannel():
+		if event.SHA != expectedSHA {
+			t.Errorf("Expected SHA %s, got %s", expectedSHA, event.SHA)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timeout waiting for polled commit event")
+	}
+
+	if m := watcher.Metrics(); !m.Polling || m.PollInterval != minPollInterval {
+		t.Errorf("Metrics() polling = %v every %s, want polling every %s", m.Polling, m.PollInterval, minPollInterval)
+	}
+}
+
+// TestGitWatcher_PollingFallback tests that auto mode polls when the refs
+// can't be watched, while notify mode fails.
+func TestGitWatcher_PollingFallback(t *testing.T) {
+	tests := []struct {
+		mode        string
+		wantErr     bool
+		wantPolling bool
+	}{
+		{WatchModeAuto, false, true},
+		{WatchModeNotify, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			repoPath, cleanup := createTestRepo(t)
+			defer cleanup()
+			packRefs(t, repoPath)
+
+			watcher, err := NewGitWatcherWithMode(repoPath, tt.mode)
+			if err != nil {
+				t.Fatalf("Failed to create watcher: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			err = watcher.Start(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Start() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			defer watcher.Stop()
+
+			if watcher.Polling() != tt.wantPolling {
+				t.Errorf("Polling() = %v, want %v", watcher.Polling(), tt.wantPolling)
+			}
+			if tt.wantPolling {
+				select {
+				case warning := <-watcher.ErrorChannel():
+					if !strings.Contains(warning.Error(), "polling instead") {
+						t.Errorf("Expected a polling warning, got %v", warning)
+					}
+				default:
+					t.Error("Expected a polling warning on the error channel")
+				}
+			}
+		})
+	}
+
+	if _, err := NewGitWatcherWithMode(t.TempDir(), "sometimes"); err == nil {
+		t.Error("Expected an error for an unknown watch mode")
+	}
+}
+
+// packRefs moves the repository's branch refs into packed-refs and removes
+// .git/refs/heads, so there's no directory to watch.
+func packRefs(t *testing.T, repoPath string) {
+	t.Helper()
+
+	headsPath := filepath.Join(repoPath, ".git", "refs", "heads")
+	entries, err := os.ReadDir(headsPath)
+	if err != nil {
+		t.Fatalf("Failed to read refs: %v", err)
+	}
+	var packed strings.Builder
+	for _, entry := range entries {
+		sha, err := os.ReadFile(filepath.Join(headsPath, entry.Name()))
+		if err != nil {
+			t.Fatalf("Failed to read ref %s: %v", entry.Name(), err)
+		}
+		fmt.Fprintf(&packed, "%s refs/heads/%s\n", strings.TrimSpace(string(sha)), entry.Name())
+	}
+	if err := os.WriteFile(filepath.Join(repoPath, ".git", "packed-refs"), []byte(packed.String()), 0644); err != nil {
+		t.Fatalf("Failed to write packed-refs: %v", err)
+	}
+	if err := os.RemoveAll(headsPath); err != nil {
+		t.Fatalf("Failed to remove refs: %v", err)
+	}
+}
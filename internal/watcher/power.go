@@ -0,0 +1,44 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file implements low-power mode for the watcher: the periodic polls
+// (GitHub approvals, TODO scans, task lists) wait longer between runs while
+// low-power mode is on, so a laptop on battery wakes up less.
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/power"
+)
+
+// SetPowerMonitor enables low-power mode: while the monitor reports low
+// power, polling intervals are stretched by power.Slowdown. Call before
+// Start().
+//
+// Parameters:
+//   - monitor: Low-power mode state
+func (wm *WatcherManager) SetPowerMonitor(monitor *power.Monitor) {
+	wm.power = monitor
+}
+
+// waitPoll waits until the next poll is due, interval from now (longer in
+// low-power mode). The mode is checked each time, so plugging in or
+// unplugging takes effect from the next poll.
+//
+// Parameters:
+//   - ctx: Context that cancels the wait
+//   - interval: Normal time between polls
+//
+// Returns:
+//   - bool: False if the context was cancelled
+func (wm *WatcherManager) waitPoll(ctx context.Context, interval time.Duration) bool {
+	timer := time.NewTimer(wm.power.Interval(interval))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,181 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file loads custom quest templates from the quest template directory
+// (~/.config/codequest/quests/*.toml) and watches it, publishing the
+// templates again whenever a file is added, changed or removed, so custom
+// quests can be iterated on without restarting.
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// customTemplatePrefix starts the IDs of templates loaded from files, so
+// they never collide with the built-in templates.
+const customTemplatePrefix = "custom/"
+
+// templateReloadDelay lets a burst of file events (editors often write a
+// file in several steps) settle into a single reload.
+const templateReloadDelay = 250 * time.Millisecond
+
+// questTemplateFile is the layout of a custom quest template file:
+//
+//	title = "Bug Bash"
+//	description = "Squash 10 bugs before the release."
+//	type = "commit"
+//	target = 10
+//	xp_reward = 300
+//	required_level = 2
+type questTemplateFile struct {
+	Title         string `toml:"title"`
+	Description   string `toml:"description"`
+	Type          string `toml:"type"`
+	Target        int    `toml:"target"`
+	XPReward      int    `toml:"xp_reward"`
+	RequiredLevel int    `toml:"required_level"`
+}
+
+// LoadQuestTemplate reads and validates one custom quest template file. The
+// template's ID is customTemplatePrefix plus the file name without ".toml".
+//
+// Parameters:
+//   - path: Path to the .toml file
+//
+// Returns:
+//   - game.QuestTemplate: The template
+//   - error: An error if the file can't be read or parsed, or the quest is invalid
+func LoadQuestTemplate(path string) (game.QuestTemplate, error) {
+	var file questTemplateFile
+	meta, err := toml.DecodeFile(path, &file)
+	if err != nil {
+		return game.QuestTemplate{}, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return game.QuestTemplate{}, fmt.Errorf("%s: unknown field %q", filepath.Base(path), undecoded[0].String())
+	}
+
+	template := game.QuestTemplate{
+		ID:            customTemplatePrefix + strings.TrimSuffix(filepath.Base(path), ".toml"),
+		Title:         file.Title,
+		Description:   file.Description,
+		Type:          game.QuestType(file.Type),
+		Target:        file.Target,
+		XPReward:      file.XPReward,
+		RequiredLevel: file.RequiredLevel,
+	}
+	if err := template.Validate(); err != nil {
+		return game.QuestTemplate{}, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return template, nil
+}
+
+// LoadQuestTemplates loads every .toml file in the template directory, in
+// file name order. A missing directory has no templates.
+//
+// Parameters:
+//   - dir: The quest template directory
+//
+// Returns:
+//   - []game.QuestTemplate: Valid templates
+//   - map[string]error: Why each invalid file (by path) couldn't be loaded
+func LoadQuestTemplates(dir string) ([]game.QuestTemplate, map[string]error) {
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.toml")) // Only fails on a bad pattern
+	sort.Strings(paths)
+
+	var templates []game.QuestTemplate
+	problems := make(map[string]error)
+	for _, path := range paths {
+		template, err := LoadQuestTemplate(path)
+		if err != nil {
+			problems[path] = err
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates, problems
+}
+
+// SetQuestTemplateDir enables loading custom quest templates from a
+// directory and watching it for changes; they are published as
+// EventQuestTemplates. The directory is created if it doesn't exist. Call
+// before Start().
+//
+// Parameters:
+//   - dir: The quest template directory (see config.QuestTemplateDir)
+func (wm *WatcherManager) SetQuestTemplateDir(dir string) {
+	wm.questTemplateDir = dir
+}
+
+// watchQuestTemplates publishes the custom templates right away and again
+// after every change to the template directory until the context is
+// cancelled. Every invalid file is reported at first, then only the files
+// that changed, so a broken file isn't reported again and again.
+func (wm *WatcherManager) watchQuestTemplates(ctx context.Context, dir string) {
+	publish := func(changed map[string]bool) {
+		templates, problems := LoadQuestTemplates(dir)
+		var messages []string
+		for path, err := range problems {
+			if changed == nil || changed[path] {
+				messages = append(messages, err.Error())
+			}
+		}
+		sort.Strings(messages)
+		wm.eventBus.PublishAsync(game.NewQuestTemplatesEvent(templates, messages))
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Printf("Warning: Failed to create quest template directory %s: %v", dir, err)
+		return
+	}
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("Warning: Failed to watch quest templates: %v", err)
+		return
+	}
+	defer fsWatcher.Close()
+	if err := fsWatcher.Add(dir); err != nil {
+		log.Printf("Warning: Failed to watch quest template directory %s: %v", dir, err)
+		return
+	}
+
+	publish(nil)
+
+	changed := make(map[string]bool)
+	reload := time.NewTimer(templateReloadDelay)
+	reload.Stop()
+	defer reload.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-fsWatcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Ext(event.Name) != ".toml" || event.Op == fsnotify.Chmod {
+				continue
+			}
+			changed[event.Name] = true
+			reload.Reset(templateReloadDelay)
+		case err, ok := <-fsWatcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("Warning: Quest template watcher error: %v", err)
+		case <-reload.C:
+			publish(changed)
+			changed = make(map[string]bool)
+		}
+	}
+}
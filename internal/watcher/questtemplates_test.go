@@ -0,0 +1,107 @@
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// writeTemplate writes a quest template file for a test.
+func writeTemplate(t *testing.T, dir, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+// TestLoadQuestTemplates tests loading template files and the problems
+// reported for invalid ones.
+func TestLoadQuestTemplates(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "bug-bash.toml", "title = \"Bug Bash\"\ntype = \"commit\"\ntarget = 10\nxp_reward = 300\n")
+	writeTemplate(t, dir, "notes.txt", "not a template")
+
+	tests := []struct {
+		file     string
+		contents string
+		wantErr  string
+	}{
+		{"dance.toml", "title = \"Dance\"\ntype = \"dance\"\n", `unknown type "dance"`},
+		{"typo.toml", "title = \"Typo\"\ntype = \"commit\"\ntargte = 3\n", `unknown field "targte"`},
+		{"broken.toml", "title = \"Broken\n", "broken.toml"},
+		{"empty.toml", "", "title is required"},
+	}
+	for _, tt := range tests {
+		writeTemplate(t, dir, tt.file, tt.contents)
+	}
+
+	templates, problems := LoadQuestTemplates(dir)
+	if len(templates) != 1 || templates[0].ID != "custom/bug-bash" || templates[0].Target != 10 || templates[0].XPReward != 300 {
+		t.Errorf("LoadQuestTemplates() = %+v, want only custom/bug-bash", templates)
+	}
+	for _, tt := range tests {
+		err := problems[filepath.Join(dir, tt.file)]
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.HasPrefix(err.Error(), tt.file) {
+			t.Errorf("%s: problem = %v, want one naming the file and mentioning %q", tt.file, err, tt.wantErr)
+		}
+	}
+
+	if templates, problems := LoadQuestTemplates(filepath.Join(dir, "missing")); len(templates)+len(problems) != 0 {
+		t.Errorf("missing directory: %d templates, %d problems; want none", len(templates), len(problems))
+	}
+}
+
+// TestWatchQuestTemplates tests that templates are published at startup
+// and again when a file changes, reporting only the changed file's problems.
+func TestWatchQuestTemplates(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "quests")
+	bus := game.NewEventBus()
+	reloads := make(chan game.Event, 4)
+	bus.Subscribe(game.EventQuestTemplates, func(event game.Event) { reloads <- event })
+
+	manager, err := NewWatcherManager(bus, &config.Config{})
+	if err != nil {
+		t.Fatalf("NewWatcherManager() error = %v", err)
+	}
+	manager.SetQuestTemplateDir(dir)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := manager.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	next := func() ([]game.QuestTemplate, []string) {
+		t.Helper()
+		select {
+		case event := <-reloads:
+			templates, _ := event.Data["templates"].([]game.QuestTemplate)
+			problems, _ := event.Data["problems"].([]string)
+			return templates, problems
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for quest templates")
+			return nil, nil
+		}
+	}
+
+	if templates, problems := next(); len(templates)+len(problems) != 0 {
+		t.Fatalf("startup: %d templates, %v; want none from an empty directory", len(templates), problems)
+	}
+
+	writeTemplate(t, dir, "bug-bash.toml", "title = \"Bug Bash\"\ntype = \"commit\"\ntarget = 10\n")
+	if templates, problems := next(); len(templates) != 1 || len(problems) != 0 {
+		t.Fatalf("after adding a file: %+v, %v; want the Bug Bash template", templates, problems)
+	}
+
+	writeTemplate(t, dir, "bug-bash.toml", "title = \"Bug Bash\"\ntype = \"commit\"\ntarget = 0\n")
+	if templates, problems := next(); len(templates) != 0 || len(problems) != 1 || !strings.Contains(problems[0], "target") {
+		t.Errorf("after breaking the file: %+v, %v; want its problem reported", templates, problems)
+	}
+}
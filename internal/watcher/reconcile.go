@@ -0,0 +1,67 @@
+package watcher
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// maxReconcileCommits bounds the history walked when recounting lines, so a
+// base commit that is far back (or missing) can't stall the command.
+const maxReconcileCommits = 10000
+
+// LinesChangedSince recounts the lines changed on the current branch since a
+// base commit, straight from git history. It walks first parents back from
+// HEAD to the base and sums each commit's own diff (like `git log
+// --first-parent --numstat base..HEAD`), ignoring line-ending-only changes,
+// so the total matches what the watcher counts commit by commit.
+//
+// Parameters:
+//   - repoPath: Path inside the repository
+//   - baseSHA: Commit HEAD was at when counting started (exclusive)
+//
+// Returns:
+//   - int: Lines added plus lines removed since the base
+//   - error: An error if the repository can't be read or the base isn't
+//     on the current branch (e.g. after a rebase)
+func LinesChangedSince(repoPath, baseSHA string) (int, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return 0, fmt.Errorf("failed to access repository HEAD: %w", err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+
+	base := plumbing.NewHash(baseSHA)
+	total := 0
+	for walked := 0; commit.Hash != base; walked++ {
+		if walked == maxReconcileCommits {
+			return 0, fmt.Errorf("base commit %.7s not found in the last %d commits", baseSHA, maxReconcileCommits)
+		}
+
+		patch, err := commitPatch(commit)
+		if err != nil {
+			return 0, fmt.Errorf("failed to diff commit %.7s: %w", commit.Hash.String(), err)
+		}
+		for _, stat := range lineEndingAwareStats(patch.FilePatches()) {
+			total += stat.Addition + stat.Deletion
+		}
+
+		if commit.NumParents() == 0 {
+			return 0, fmt.Errorf("base commit %.7s is not on the current branch", baseSHA)
+		}
+		if commit, err = commit.Parent(0); err != nil {
+			return 0, fmt.Errorf("failed to get parent commit: %w", err)
+		}
+	}
+
+	return total, nil
+}
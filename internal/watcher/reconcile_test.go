@@ -0,0 +1,46 @@
+package watcher
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestLinesChangedSince tests recounting lines changed since a base commit
+// from git history, and that a base not on the branch is an error.
+func TestLinesChangedSince(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	base := makeCommit(t, repoPath, "Base", map[string]string{"main.go": "package main\n"})
+	makeCommit(t, repoPath, "Add lines", map[string]string{"main.go": "package main\n\nfunc a() {}\nfunc b() {}\n"})
+	head := makeCommit(t, repoPath, "Change a line", map[string]string{"main.go": "package main\n\nfunc a() {}\nfunc c() {}\n"})
+
+	tests := []struct {
+		name    string
+		baseSHA string
+		want    int
+		wantErr string
+	}{
+		{"counts every commit since the base", base, 3 + 2, ""},
+		{"nothing since HEAD", head, 0, ""},
+		{"unknown base", strings.Repeat("0", 40), 0, "not on the current branch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LinesChangedSince(repoPath, tt.baseSHA)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("LinesChangedSince() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LinesChangedSince() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("LinesChangedSince() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
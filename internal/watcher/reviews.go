@@ -0,0 +1,55 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file polls GitHub for the player's pull request approvals so review
+// quests can progress from work that never produces a local commit.
+package watcher
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// Review polling tuning
+const (
+	reviewPollInterval = 5 * time.Minute     // How often approvals are fetched
+	reviewLookback     = 30 * 24 * time.Hour // How far back approvals are counted
+)
+
+// ReviewLookup lists the player's pull request approvals.
+// The github.Client implements this interface.
+type ReviewLookup interface {
+	Approvals(ctx context.Context, since time.Time) ([]time.Time, error)
+}
+
+// SetReviewLookup enables polling GitHub for pull request approvals, which
+// are published as EventReviewApprovals for review quests. Call before
+// Start().
+//
+// Parameters:
+//   - lookup: GitHub API client
+func (wm *WatcherManager) SetReviewLookup(lookup ReviewLookup) {
+	wm.reviewLookup = lookup
+}
+
+// pollReviews publishes the player's recent approvals immediately and then
+// every reviewPollInterval (longer in low-power mode) until the context is
+// cancelled. Lookup failures are logged and retried on the next poll.
+func (wm *WatcherManager) pollReviews(ctx context.Context) {
+	for {
+		approvals, err := wm.reviewLookup.Approvals(ctx, time.Now().Add(-reviewLookback))
+		if err != nil {
+			log.Printf("Warning: Failed to fetch pull request approvals: %v", err)
+		} else {
+			if wm.config.Debug.Enabled {
+				log.Printf("Fetched %d pull request approval(s) from GitHub", len(approvals))
+			}
+			wm.eventBus.PublishAsync(game.NewReviewApprovalsEvent(approvals))
+		}
+
+		if !wm.waitPoll(ctx, reviewPollInterval) {
+			return
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os/exec"
 	"sync"
 	"time"
 
@@ -49,9 +48,8 @@ func (s SessionState) String() string {
 // All public methods use mutex protection for concurrent access.
 //
 // Persistence:
-// The tracker saves its state to Skate (encrypted key-value store) every 60 seconds
-// and on every state change (pause, stop). This allows sessions to survive app crashes
-// and restarts.
+// The tracker saves its state to storage every 60 seconds and on every state
+// change (pause, stop). This allows sessions to survive app crashes and restarts.
 //
 // Usage Pattern:
 //  1. Create tracker with NewSessionTracker()
@@ -105,14 +103,20 @@ type SessionTracker struct {
 }
 
 // Storage defines the interface for persisting session state.
-// This abstraction allows for different storage backends (Skate, JSON files, etc.).
+// Every storage backend implements this interface.
 type Storage interface {
 	// SaveCharacter persists the character data
 	SaveCharacter(char *game.Character) error
+
+	// SaveSessionState, LoadSessionState and DeleteSessionState persist the
+	// timer itself (JSON-encoded sessionStateData)
+	SaveSessionState(state []byte) error
+	LoadSessionState() ([]byte, error)
+	DeleteSessionState() error
 }
 
 // sessionStateData is the internal structure for persistence.
-// This is what gets serialized to JSON and stored in Skate.
+// This is what gets serialized to JSON and stored.
 type sessionStateData struct {
 	StartTime    time.Time     `json:"start_time"`    // When session started (adjusted for pause)
 	TotalElapsed time.Duration `json:"total_elapsed"` // Accumulated time
@@ -122,14 +126,14 @@ type sessionStateData struct {
 }
 
 // NewSessionTracker creates a new session tracker for the given character.
-// It attempts to load any previously saved session state from Skate.
+// It attempts to load any previously saved session state from storage.
 //
 // If a previous session exists and was running/paused, it will be restored.
 // If loading fails or no previous session exists, starts fresh.
 //
 // Parameters:
 //   - char: Character to track session time for (updates TodaySessionTime)
-//   - storage: Storage backend for character persistence (can be nil)
+//   - storage: Storage backend for character and session persistence (can be nil)
 //
 // Returns:
 //   - *SessionTracker: Initialized tracker (state loaded if available)
@@ -193,7 +197,7 @@ func (s *SessionTracker) Start() error {
 
 	// Start update ticker (60 second intervals)
 	s.ticker = time.NewTicker(60 * time.Second)
-	go s.updateLoop()
+	go s.updateLoop(s.ticker.C)
 
 	// Save initial state
 	_ = s.saveState()
@@ -265,7 +269,7 @@ func (s *SessionTracker) Resume() error {
 
 	// Restart ticker
 	s.ticker = time.NewTicker(60 * time.Second)
-	go s.updateLoop()
+	go s.updateLoop(s.ticker.C)
 
 	// Save state
 	return s.saveState()
@@ -363,11 +367,13 @@ func (s *SessionTracker) GetState() SessionState {
 }
 
 // updateLoop runs in a goroutine and performs periodic character updates.
-// It ticks every 60 seconds while the session is running.
-func (s *SessionTracker) updateLoop() {
+// It ticks every 60 seconds while the session is running. The ticker's
+// channel is passed in, since Pause and Stop clear s.ticker, possibly
+// before the goroutine has started.
+func (s *SessionTracker) updateLoop(ticks <-chan time.Time) {
 	for {
 		select {
-		case <-s.ticker.C:
+		case <-ticks:
 			// Periodic update (every 60 seconds)
 			s.mu.Lock()
 			if s.state == SessionRunning {
@@ -409,14 +415,19 @@ func (s *SessionTracker) updateCharacterTimeUnsafe() {
 	}
 }
 
-// saveState persists the current session state to Skate.
+// saveState persists the current session state to storage.
 // This allows sessions to survive app restarts and crashes.
+// Without a storage backend the state isn't saved.
 //
-// Storage format: JSON in Skate with key "codequest_session_state"
+// Storage format: JSON under the key "codequest_session_state"
 //
 // Returns:
-//   - error: If Skate command fails or JSON encoding fails
+//   - error: If storage fails or JSON encoding fails
 func (s *SessionTracker) saveState() error {
+	if s.storage == nil {
+		return nil
+	}
+
 	// Build state data structure
 	stateData := sessionStateData{
 		StartTime:    s.startTime,
@@ -437,26 +448,26 @@ func (s *SessionTracker) saveState() error {
 		return fmt.Errorf("failed to marshal session state: %w", err)
 	}
 
-	// Save to Skate
-	cmd := exec.Command("skate", "set", "codequest_session_state", string(data))
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to save session state to skate: %w", err)
+	if err := s.storage.SaveSessionState(data); err != nil {
+		return fmt.Errorf("failed to save session state: %w", err)
 	}
 
 	return nil
 }
 
-// loadState attempts to restore session state from Skate.
+// loadState attempts to restore session state from storage.
 // If successful, it resumes the previous session where it left off.
 //
 // Returns:
-//   - error: If Skate read fails, JSON parsing fails, or no saved state exists
+//   - error: If there's no storage, the read fails, JSON parsing fails, or no
+//     saved state exists
 //
 // Note: This is called automatically by NewSessionTracker().
 func (s *SessionTracker) loadState() error {
-	// Retrieve from Skate
-	cmd := exec.Command("skate", "get", "codequest_session_state")
-	output, err := cmd.Output()
+	if s.storage == nil {
+		return errors.New("no storage to load session state from")
+	}
+	output, err := s.storage.LoadSessionState()
 	if err != nil {
 		return fmt.Errorf("no previous session state found: %w", err)
 	}
@@ -485,7 +496,7 @@ func (s *SessionTracker) loadState() error {
 
 		// Start ticker
 		s.ticker = time.NewTicker(60 * time.Second)
-		go s.updateLoop()
+		go s.updateLoop(s.ticker.C)
 
 	case "paused":
 		// Resume paused session
@@ -507,14 +518,16 @@ func (s *SessionTracker) loadState() error {
 	return nil
 }
 
-// ClearSavedState removes any saved session state from Skate.
+// ClearSavedState removes any saved session state from storage.
 // This is useful for debugging or resetting to a clean state.
 //
 // Returns:
-//   - error: If Skate delete command fails
+//   - error: If the delete fails
 func (s *SessionTracker) ClearSavedState() error {
-	cmd := exec.Command("skate", "delete", "codequest_session_state")
-	if err := cmd.Run(); err != nil {
+	if s.storage == nil {
+		return nil
+	}
+	if err := s.storage.DeleteSessionState(); err != nil {
 		return fmt.Errorf("failed to clear session state: %w", err)
 	}
 	return nil
@@ -0,0 +1,74 @@
+package watcher
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// memorySessionStorage is an in-memory Storage.
+type memorySessionStorage struct {
+	state []byte
+}
+
+func (s *memorySessionStorage) SaveCharacter(char *game.Character) error { return nil }
+func (s *memorySessionStorage) SaveSessionState(state []byte) error      { s.state = state; return nil }
+func (s *memorySessionStorage) DeleteSessionState() error                { s.state = nil; return nil }
+func (s *memorySessionStorage) LoadSessionState() ([]byte, error) {
+	if s.state == nil {
+		return nil, errors.New("not found")
+	}
+	return s.state, nil
+}
+
+// TestSessionStatePersisted tests that the timer's state goes through
+// storage, so a paused session is restored for the same character only.
+func TestSessionStatePersisted(t *testing.T) {
+	store := &memorySessionStorage{}
+	character := game.NewCharacter("Timer")
+
+	tracker := NewSessionTracker(character, store)
+	if err := tracker.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := tracker.Pause(); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	if store.state == nil {
+		t.Fatal("Pause() didn't save the session state")
+	}
+
+	tests := []struct {
+		name      string
+		character *game.Character
+		want      SessionState
+	}{
+		{"same character resumes", character, SessionPaused},
+		{"other character starts fresh", game.NewCharacter("Other"), SessionStopped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSessionTracker(tt.character, store).GetState(); got != tt.want {
+				t.Errorf("restored state = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := tracker.ClearSavedState(); err != nil {
+		t.Fatalf("ClearSavedState() error = %v", err)
+	}
+	if got := NewSessionTracker(character, store).GetState(); got != SessionStopped {
+		t.Errorf("state after clearing = %v, want stopped", got)
+	}
+
+	// Without storage the timer still runs, it just isn't saved
+	unsaved := NewSessionTracker(character, nil)
+	if err := unsaved.Start(); err != nil {
+		t.Fatalf("Start() without storage error = %v", err)
+	}
+	if err := unsaved.Stop(); err != nil {
+		t.Fatalf("Stop() without storage error = %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file looks up the commits behind squash-merged pull requests so they
+// can be scored as the work they contain rather than as one huge commit.
+package watcher
+
+import (
+	"context"
+	"log"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+	"github.com/AutumnsGrove/codequest/internal/github"
+)
+
+// PullRequestLookup lists the commits on a pull request.
+// The github.Client implements this interface.
+type PullRequestLookup interface {
+	PullRequestCommits(ctx context.Context, owner, repo string, number int) ([]github.PullRequestCommit, error)
+}
+
+// SetPullRequestLookup enables GitHub lookups for squash merges: when a
+// commit references a PR, the PR's commit count is attached to its event.
+// Without a lookup, squash merges are split using the commit list in their
+// message alone. Call before Start(); the lookup is read without locking
+// once watchers are running.
+//
+// Parameters:
+//   - lookup: GitHub API client
+func (wm *WatcherManager) SetPullRequestLookup(lookup PullRequestLookup) {
+	wm.prLookup = lookup
+}
+
+// annotateSquashMerge sets commit.SquashCommits for squash merges of GitHub
+// pull requests. Lookup failures are logged and leave the commit unchanged.
+func (wm *WatcherManager) annotateSquashMerge(ctx context.Context, watcher *GitWatcher, commit *CommitEvent) {
+	if wm.prLookup == nil {
+		return
+	}
+
+	squash, ok := game.DetectSquashMerge(commit.Message)
+	if !ok || squash.PRNumber == 0 {
+		return
+	}
+	owner, repo, ok := github.ParseRemoteURL(watcher.RemoteURL())
+	if !ok {
+		return
+	}
+
+	commits, err := wm.prLookup.PullRequestCommits(ctx, owner, repo, squash.PRNumber)
+	if err != nil {
+		log.Printf("Warning: Failed to look up PR #%d for squash merge %s: %v", squash.PRNumber, commit.SHA[:7], err)
+		return
+	}
+	commit.SquashCommits = len(commits)
+}
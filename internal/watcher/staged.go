@@ -0,0 +1,203 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file reads a repository's staged-but-uncommitted changes, so the XP
+// the next commit would earn can be shown before it is made (and the mentor
+// can suggest its commit message).
+package watcher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// maxStagedFileBytes bounds the files whose lines are counted; bigger ones
+// (generated code, data) are listed without line counts.
+const maxStagedFileBytes = 1 << 20
+
+// StagedFile is one file's staged change.
+type StagedFile struct {
+	Path    string // Path relative to the repository root
+	Added   int    // Lines added
+	Removed int    // Lines removed
+	Binary  bool   // Binary or oversized file (no line counts)
+	Patch   string // Changed lines, prefixed with + or - (empty for binary files)
+}
+
+// StagedChanges is everything staged in a repository.
+type StagedChanges struct {
+	Repo         string       // Repository root
+	Files        []StagedFile // Staged files, sorted by path
+	LinesAdded   int          // Total lines added
+	LinesRemoved int          // Total lines removed
+}
+
+// ScanStaged compares a repository's index with HEAD and counts the lines
+// each staged file adds and removes, as the next commit would. Unstaged and
+// untracked files are ignored.
+//
+// Parameters:
+//   - repoPath: Path inside the repository
+//
+// Returns:
+//   - StagedChanges: The staged files (none if nothing is staged)
+//   - error: An error if the repository, its index or HEAD can't be read
+func ScanStaged(repoPath string) (StagedChanges, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return StagedChanges{}, fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return StagedChanges{}, fmt.Errorf("failed to access worktree: %w", err)
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return StagedChanges{}, fmt.Errorf("failed to read repository status: %w", err)
+	}
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return StagedChanges{}, fmt.Errorf("failed to read index: %w", err)
+	}
+
+	// A repository with no commits yet compares against an empty tree
+	var tree *object.Tree
+	if head, err := repo.Head(); err == nil {
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			return StagedChanges{}, fmt.Errorf("failed to get HEAD commit: %w", err)
+		}
+		if tree, err = commit.Tree(); err != nil {
+			return StagedChanges{}, fmt.Errorf("failed to get commit tree: %w", err)
+		}
+	} else if err != plumbing.ErrReferenceNotFound {
+		return StagedChanges{}, fmt.Errorf("failed to access repository HEAD: %w", err)
+	}
+
+	changes := StagedChanges{Repo: worktree.Filesystem.Root()}
+	for path, fileStatus := range status {
+		if fileStatus.Staging == git.Unmodified || fileStatus.Staging == git.Untracked {
+			continue
+		}
+
+		before, beforeOK := treeFileContents(tree, path)
+		after, afterOK := "", true
+		if fileStatus.Staging != git.Deleted {
+			after, afterOK = indexFileContents(repo, idx.Entries, path)
+		}
+
+		file := StagedFile{Path: path}
+		if !beforeOK || !afterOK {
+			file.Binary = true
+		} else {
+			file.Added, file.Removed, file.Patch = lineChanges(before, after)
+		}
+		changes.Files = append(changes.Files, file)
+		changes.LinesAdded += file.Added
+		changes.LinesRemoved += file.Removed
+	}
+
+	sort.Slice(changes.Files, func(i, j int) bool { return changes.Files[i].Path < changes.Files[j].Path })
+	return changes, nil
+}
+
+// treeFileContents reads a file at HEAD. A file that isn't there reads as
+// empty; false means it is binary or too big to count.
+func treeFileContents(tree *object.Tree, path string) (string, bool) {
+	if tree == nil {
+		return "", true
+	}
+	file, err := tree.File(path)
+	if err != nil {
+		return "", true
+	}
+	if file.Size > maxStagedFileBytes {
+		return "", false
+	}
+	if binary, err := file.IsBinary(); err != nil || binary {
+		return "", false
+	}
+	contents, err := file.Contents()
+	return contents, err == nil
+}
+
+// indexFileContents reads a file's staged version from the index; false
+// means it is binary, too big to count or can't be read.
+func indexFileContents(repo *git.Repository, entries []*index.Entry, path string) (string, bool) {
+	for _, entry := range entries {
+		if entry.Name != path {
+			continue
+		}
+		blob, err := repo.BlobObject(entry.Hash)
+		if err != nil || blob.Size > maxStagedFileBytes {
+			return "", false
+		}
+		file := object.NewFile(path, entry.Mode, blob)
+		if binary, err := file.IsBinary(); err != nil || binary {
+			return "", false
+		}
+		contents, err := file.Contents()
+		return contents, err == nil
+	}
+	return "", true
+}
+
+// Diff renders the staged changes as a compact patch: a header per file
+// followed by its changed lines. Unchanged lines are left out to keep it
+// small enough to send to the mentor.
+//
+// Parameters:
+//   - maxBytes: Size limit; files past it are listed without their lines (0 = no limit)
+//
+// Returns:
+//   - string: The patch
+func (c StagedChanges) Diff(maxBytes int) string {
+	var b strings.Builder
+	for _, file := range c.Files {
+		header := fmt.Sprintf("--- %s (+%d -%d)\n", file.Path, file.Added, file.Removed)
+		if file.Binary {
+			header = fmt.Sprintf("--- %s (binary)\n", file.Path)
+		}
+		b.WriteString(header)
+		if maxBytes > 0 && b.Len()+len(file.Patch) > maxBytes {
+			b.WriteString("(changes omitted)\n")
+			continue
+		}
+		b.WriteString(file.Patch)
+	}
+	return b.String()
+}
+
+// lineChanges counts the lines a change adds and removes, and lists them
+// prefixed with + or -.
+func lineChanges(before, after string) (added, removed int, patch string) {
+	var b strings.Builder
+	for _, change := range diff.Do(before, after) {
+		text := strings.TrimSuffix(change.Text, "\n")
+		lines := strings.Count(change.Text, "\n")
+		if !strings.HasSuffix(change.Text, "\n") {
+			lines++ // Last line without a newline
+		}
+		prefix := ""
+		switch change.Type {
+		case diffmatchpatch.DiffInsert:
+			added += lines
+			prefix = "+"
+		case diffmatchpatch.DiffDelete:
+			removed += lines
+			prefix = "-"
+		default:
+			continue
+		}
+		for _, line := range strings.Split(text, "\n") {
+			b.WriteString(prefix + line + "\n")
+		}
+	}
+	return added, removed, b.String()
+}
@@ -0,0 +1,73 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// TestScanStaged tests that staged changes are counted against HEAD while
+// unstaged edits and untracked files are ignored.
+func TestScanStaged(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+	makeCommit(t, repoPath, "Add code", map[string]string{
+		"main.go": "package main\n\nfunc main() {\n}\n",
+	})
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("PlainOpen() error = %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree() error = %v", err)
+	}
+
+	// Staged: one line changed in main.go, a new two-line file
+	write("main.go", "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n")
+	write("util.go", "package main\n// helpers\n")
+	for _, name := range []string{"main.go", "util.go"} {
+		if _, err := worktree.Add(name); err != nil {
+			t.Fatalf("Add(%s) error = %v", name, err)
+		}
+	}
+	// Not staged: a later edit to main.go and an untracked file
+	write("main.go", "package main\n\nfunc main() {\n\tprintln(\"hi\")\n\tprintln(\"unstaged\")\n}\n")
+	write("scratch.go", "package main\n")
+
+	changes, err := ScanStaged(repoPath)
+	if err != nil {
+		t.Fatalf("ScanStaged() error = %v", err)
+	}
+	if len(changes.Files) != 2 || changes.Files[0].Path != "main.go" || changes.Files[1].Path != "util.go" {
+		t.Fatalf("Files = %+v, want main.go and util.go", changes.Files)
+	}
+	if changes.Files[0].Added != 1 || changes.Files[0].Removed != 0 {
+		t.Errorf("main.go = +%d -%d, want +1 -0", changes.Files[0].Added, changes.Files[0].Removed)
+	}
+	if changes.LinesAdded != 3 || changes.LinesRemoved != 0 {
+		t.Errorf("total = +%d -%d, want +3 -0", changes.LinesAdded, changes.LinesRemoved)
+	}
+	wantDiff := "--- main.go (+1 -0)\n+\tprintln(\"hi\")\n--- util.go (+2 -0)\n+package main\n+// helpers\n"
+	if got := changes.Diff(0); got != wantDiff {
+		t.Errorf("Diff(0) = %q, want %q", got, wantDiff)
+	}
+	if got, want := changes.Diff(40), "--- main.go (+1 -0)\n+\tprintln(\"hi\")\n--- util.go (+2 -0)\n(changes omitted)\n"; got != want {
+		t.Errorf("Diff(40) = %q, want %q", got, want)
+	}
+
+	// Nothing staged after committing
+	makeCommit(t, repoPath, "Commit staged", nil)
+	if changes, err := ScanStaged(repoPath); err != nil || len(changes.Files) != 0 {
+		t.Errorf("ScanStaged() after commit = %+v, %v; want nothing staged", changes.Files, err)
+	}
+}
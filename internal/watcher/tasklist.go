@@ -0,0 +1,272 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file syncs quests with markdown task lists: each watched repository's
+// task list (TODO.md by default) is read periodically and published, and
+// when a task quest completes its item is ticked, either in a commit or as
+// a patch written to .git/codequest/ for the player to apply.
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// taskTickSubject starts the message of the commits that tick task list
+// items. Those commits are CodeQuest's own bookkeeping, so they earn no XP.
+const taskTickSubject = "chore(tasks): tick off "
+
+// patchContext is how many unchanged lines surround the change in a patch.
+const patchContext = 3
+
+// isTaskTickCommit reports whether a commit was made by CodeQuest to tick a
+// task list item.
+func isTaskTickCommit(message string) bool {
+	return strings.HasPrefix(message, taskTickSubject)
+}
+
+// repoRoot returns the root of the worktree a path is in.
+func repoRoot(repoPath string) (*git.Repository, string, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to access worktree: %w", err)
+	}
+	return repo, worktree.Filesystem.Root(), nil
+}
+
+// ReadTaskList reads the items in a repository's task list. A repository
+// without one has no items.
+//
+// Parameters:
+//   - repoPath: Path inside the repository
+//   - file: Task list path relative to the repository root
+//
+// Returns:
+//   - []game.TaskItem: Items in line order
+//   - error: An error if the repository or task list can't be read
+func ReadTaskList(repoPath, file string) ([]game.TaskItem, error) {
+	_, root, err := repoRoot(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filepath.Join(root, file))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task list: %w", err)
+	}
+
+	tasks := game.ParseTaskList(filepath.ToSlash(file), string(content))
+	for i := range tasks {
+		tasks[i].Repo = repoPath
+	}
+	return tasks, nil
+}
+
+// TickTaskList ticks a task list item. In commit mode the change is
+// committed, unless the task list has other uncommitted changes (which the
+// commit would sweep up); then, and in patch mode, a patch that ticks the
+// item is written to .git/codequest/ instead, to apply with `git apply`.
+//
+// Parameters:
+//   - task: The item to tick
+//   - mode: "commit" or "patch"
+//
+// Returns:
+//   - string: SHA of the commit ("" if a patch was written)
+//   - string: Path of the patch ("" if the change was committed)
+//   - error: An error if the item isn't unchecked in the list or the change can't be made
+func TickTaskList(task game.TaskItem, mode string) (string, string, error) {
+	repo, root, err := repoRoot(task.Repo)
+	if err != nil {
+		return "", "", err
+	}
+	path := filepath.Join(root, filepath.FromSlash(task.File))
+	before, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read task list: %w", err)
+	}
+	after, ok := game.TickTask(string(before), task)
+	if !ok {
+		return "", "", fmt.Errorf("%s has no unchecked item %q", task.File, task.Text)
+	}
+
+	if mode == "commit" && committedContents(repo, task.File) == string(before) {
+		sha, err := commitTick(repo, path, task, after)
+		if err == nil {
+			return sha, "", nil
+		}
+		log.Printf("Warning: Failed to commit ticked task %q, writing a patch instead: %v", task.Text, err)
+	}
+
+	patch, err := writeTickPatch(root, task, string(before), after)
+	if err != nil {
+		return "", "", err
+	}
+	return "", patch, nil
+}
+
+// committedContents returns a file's contents at HEAD ("" if unknown).
+func committedContents(repo *git.Repository, file string) string {
+	head, err := repo.Head()
+	if err != nil {
+		return ""
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return ""
+	}
+	return fileContents(commit, file)
+}
+
+// commitTick writes the ticked task list and commits it alone, as the
+// author in the repository's git config. Nothing is written if git has no
+// author configured.
+func commitTick(repo *git.Repository, path string, task game.TaskItem, after string) (string, error) {
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to access worktree: %w", err)
+	}
+	options := &git.CommitOptions{}
+	if err := options.Validate(repo); err != nil {
+		return "", fmt.Errorf("failed to load git author: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(after), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write task list: %w", err)
+	}
+	if _, err := worktree.Add(task.File); err != nil {
+		return "", fmt.Errorf("failed to stage task list: %w", err)
+	}
+	hash, err := worktree.Commit(taskTickSubject+fmt.Sprintf("%q", task.Text), options)
+	if err != nil {
+		return "", fmt.Errorf("failed to commit task list: %w", err)
+	}
+	return hash.String(), nil
+}
+
+// writeTickPatch writes a patch that turns before into after to
+// .git/codequest/, named after the task.
+func writeTickPatch(root string, task game.TaskItem, before, after string) (string, error) {
+	dir := filepath.Join(root, ".git", "codequest")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create patch directory: %w", err)
+	}
+	path := filepath.Join(dir, "tick-"+taskSlug(task.Text)+".patch")
+	if err := os.WriteFile(path, []byte(unifiedDiff(task.File, before, after)), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write patch: %w", err)
+	}
+	return path, nil
+}
+
+// taskSlug turns item text into a short file name part ("fix-login-bug").
+func taskSlug(text string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			slug.WriteRune(r)
+			dash = false
+		case !dash && slug.Len() > 0:
+			slug.WriteByte('-')
+			dash = true
+		}
+		if slug.Len() >= 40 {
+			break
+		}
+	}
+	if s := strings.TrimSuffix(slug.String(), "-"); s != "" {
+		return s
+	}
+	return "task"
+}
+
+// unifiedDiff builds a git-style patch for a change to one file. Ticking an
+// item changes exactly one line, so the patch is a single hunk around the
+// first line that differs.
+func unifiedDiff(file, before, after string) string {
+	eol := strings.HasSuffix(before, "\n")
+	old := strings.Split(strings.TrimSuffix(before, "\n"), "\n")
+	changed := strings.Split(strings.TrimSuffix(after, "\n"), "\n")
+	line := 0
+	for line < len(old) && line < len(changed) && old[line] == changed[line] {
+		line++
+	}
+	if line == len(old) || line == len(changed) {
+		return ""
+	}
+
+	start := max(line-patchContext, 0)
+	end := min(line+patchContext+1, len(old))
+
+	var patch strings.Builder
+	write := func(prefix, text string, i int) {
+		patch.WriteString(prefix + text + "\n")
+		if i == len(old)-1 && !eol {
+			patch.WriteString("\\ No newline at end of file\n")
+		}
+	}
+	fmt.Fprintf(&patch, "--- a/%s\n+++ b/%s\n", file, file)
+	fmt.Fprintf(&patch, "@@ -%d,%d +%d,%d @@\n", start+1, end-start, start+1, end-start)
+	for i := start; i < end; i++ {
+		if i == line {
+			write("-", old[i], i)
+			write("+", changed[i], i)
+			continue
+		}
+		write(" ", old[i], i)
+	}
+	return patch.String()
+}
+
+// syncTaskLists reads every watched repository's task list right away and
+// then on the configured interval (longer in low-power mode) until the
+// context is cancelled, publishing the items as EventTaskList. Repositories
+// that fail are logged and skipped.
+func (wm *WatcherManager) syncTaskLists(ctx context.Context, settings config.TaskListConfig) {
+	for {
+		var tasks []game.TaskItem
+		for _, repoPath := range wm.GetWatchedRepositories() {
+			found, err := ReadTaskList(repoPath, settings.File)
+			if err != nil {
+				log.Printf("Warning: Failed to read the task list in %s: %v", repoPath, err)
+				continue
+			}
+			tasks = append(tasks, found...)
+		}
+		wm.eventBus.PublishAsync(game.NewTaskListEvent(tasks))
+
+		if !wm.waitPoll(ctx, time.Duration(settings.SyncIntervalMinutes)*time.Minute) {
+			return
+		}
+	}
+}
+
+// tickCompletedTask ticks the task list item of a task quest that completed
+// in CodeQuest, publishing EventTaskTicked with the commit or patch.
+func (wm *WatcherManager) tickCompletedTask(event game.Event) {
+	task, ok := event.Data["task"].(game.TaskItem)
+	if !ok {
+		return
+	}
+	commit, patch, err := TickTaskList(task, wm.config.TaskList.WithDefaults().Mode)
+	if err != nil {
+		log.Printf("Warning: Failed to tick task %q: %v", task.Text, err)
+		return
+	}
+	wm.eventBus.PublishAsync(game.NewTaskTickedEvent(task, commit, patch))
+}
@@ -0,0 +1,118 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// TestUnifiedDiff tests the patch written for a ticked item.
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   string
+	}{
+		{
+			name:   "context is clipped",
+			before: "a\n- [ ] b\nc\n",
+			after:  "a\n- [x] b\nc\n",
+			want:   "--- a/TODO.md\n+++ b/TODO.md\n@@ -1,3 +1,3 @@\n a\n-- [ ] b\n+- [x] b\n c\n",
+		},
+		{
+			name:   "no newline at end",
+			before: "1\n2\n3\n4\n- [ ] b",
+			after:  "1\n2\n3\n4\n- [x] b",
+			want: "--- a/TODO.md\n+++ b/TODO.md\n@@ -2,4 +2,4 @@\n 2\n 3\n 4\n-- [ ] b\n\\ No newline at end of file\n" +
+				"+- [x] b\n\\ No newline at end of file\n",
+		},
+		{
+			name:   "unchanged",
+			before: "a\n",
+			after:  "a\n",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unifiedDiff("TODO.md", tt.before, tt.after); got != tt.want {
+				t.Errorf("unifiedDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTickTaskList tests ticking an item by commit, and falling back to a
+// patch when the task list has uncommitted changes.
+func TestTickTaskList(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("PlainOpen() error = %v", err)
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		t.Fatalf("Config() error = %v", err)
+	}
+	cfg.User.Name = "Test User"
+	cfg.User.Email = "test@example.com"
+	if err := repo.SetConfig(cfg); err != nil {
+		t.Fatalf("SetConfig() error = %v", err)
+	}
+	makeCommit(t, repoPath, "Add tasks", map[string]string{
+		"TODO.md": "# Tasks\n- [ ] Fix login bug\n- [ ] Write docs\n",
+	})
+
+	tasks, err := ReadTaskList(repoPath, "TODO.md")
+	if err != nil {
+		t.Fatalf("ReadTaskList() error = %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].Text != "Fix login bug" || tasks[0].Repo != repoPath {
+		t.Fatalf("ReadTaskList() = %+v, want the two items", tasks)
+	}
+	if missing, err := ReadTaskList(repoPath, "TASKS.md"); err != nil || missing != nil {
+		t.Errorf("ReadTaskList() for a missing file = %+v, %v, want none", missing, err)
+	}
+
+	sha, patch, err := TickTaskList(tasks[0], "commit")
+	if err != nil || sha == "" || patch != "" {
+		t.Fatalf("TickTaskList(commit) = %q, %q, %v, want a commit", sha, patch, err)
+	}
+	head, _ := repo.Head()
+	commit, _ := repo.CommitObject(head.Hash())
+	if head.Hash().String() != sha || !isTaskTickCommit(commit.Message) {
+		t.Errorf("HEAD = %s %q, want the tick commit %s", head.Hash(), commit.Message, sha)
+	}
+
+	// A local edit to the task list must not be swept into a commit
+	path := filepath.Join(repoPath, "TODO.md")
+	edited := "# Tasks\n- [x] Fix login bug\n- [ ] Write docs\n- [ ] New idea\n"
+	if err := os.WriteFile(path, []byte(edited), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	sha, patch, err = TickTaskList(tasks[1], "commit")
+	if err != nil || sha != "" || patch == "" {
+		t.Fatalf("TickTaskList() with local edits = %q, %q, %v, want a patch", sha, patch, err)
+	}
+	contents, err := os.ReadFile(patch)
+	if err != nil {
+		t.Fatalf("ReadFile(patch) error = %v", err)
+	}
+	if !strings.Contains(string(contents), "-- [ ] Write docs\n+- [x] Write docs\n") {
+		t.Errorf("patch = %q, want Write docs ticked", contents)
+	}
+	if current, _ := os.ReadFile(path); string(current) != edited {
+		t.Errorf("task list = %q, want it left alone in patch mode", current)
+	}
+
+	if _, _, err := TickTaskList(tasks[0], "patch"); err == nil {
+		t.Error("TickTaskList() for a ticked item: expected error")
+	}
+}
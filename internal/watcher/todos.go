@@ -0,0 +1,162 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file scans watched repositories for TODO/FIXME comments to suggest as
+// quests, and spots the comments a commit removes so todo quests complete.
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// TODO scan bounds, so a huge repository can't stall a scan
+const (
+	maxTodoFileBytes = 256 << 10 // Larger files (generated code, data) are skipped
+	maxTodosPerRepo  = 200       // Comments suggested per repository
+)
+
+// skippedTodoDirs are directories of third-party code, whose comments aren't
+// the player's to resolve.
+var skippedTodoDirs = map[string]bool{
+	"vendor":       true,
+	"node_modules": true,
+	"third_party":  true,
+}
+
+// ScanTodos finds the TODO and FIXME comments in a repository's files at
+// HEAD. Only tracked files are read, so everything .gitignore excludes (build
+// output, local files) is skipped along with binary files, files over 256KB
+// and vendored directories.
+//
+// Parameters:
+//   - repoPath: Path inside the repository
+//   - maxFiles: Most files to read (<= 0 means no limit)
+//
+// Returns:
+//   - []game.TodoComment: Comments found (at most 200)
+//   - error: An error if the repository or its HEAD can't be read
+func ScanTodos(repoPath string, maxFiles int) ([]game.TodoComment, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to access repository HEAD: %w", err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit tree: %w", err)
+	}
+
+	var todos []game.TodoComment
+	read := 0
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if maxFiles > 0 && read >= maxFiles || len(todos) >= maxTodosPerRepo {
+			return storer.ErrStop
+		}
+		if inSkippedTodoDir(file.Name) || file.Size > maxTodoFileBytes {
+			return nil
+		}
+		if binary, err := file.IsBinary(); err != nil || binary {
+			return nil
+		}
+		contents, err := file.Contents()
+		if err != nil {
+			return nil
+		}
+		read++
+
+		for _, todo := range game.ParseTodos(file.Name, contents) {
+			todo.Repo = repoPath
+			todos = append(todos, todo)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, storer.ErrStop) {
+		return nil, fmt.Errorf("failed to read repository files: %w", err)
+	}
+
+	if len(todos) > maxTodosPerRepo {
+		todos = todos[:maxTodosPerRepo]
+	}
+	return todos, nil
+}
+
+// inSkippedTodoDir reports whether a file is inside a vendored directory.
+func inSkippedTodoDir(name string) bool {
+	for _, dir := range strings.Split(path.Dir(name), "/") {
+		if skippedTodoDirs[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvedTodos lists the TODO and FIXME comments removed between two
+// commits in the changed files.
+//
+// Parameters:
+//   - repoPath: Repository the commits belong to
+//   - from: The earlier commit (nil for a root commit)
+//   - to: The later commit
+//   - paths: Paths of the files changed between them
+//
+// Returns:
+//   - []game.TodoComment: Removed comments (nil if none)
+func resolvedTodos(repoPath string, from, to *object.Commit, paths []string) []game.TodoComment {
+	var resolved []game.TodoComment
+	for _, changed := range paths {
+		before := fileContents(from, changed)
+		if len(before) > maxTodoFileBytes || !strings.Contains(before, "TODO") && !strings.Contains(before, "FIXME") {
+			continue
+		}
+		for _, todo := range game.ResolvedTodos(changed, before, fileContents(to, changed)) {
+			todo.Repo = repoPath
+			resolved = append(resolved, todo)
+		}
+	}
+	return resolved
+}
+
+// pollTodos scans every watched repository for TODO/FIXME comments right
+// away and then on the configured interval (longer in low-power mode) until
+// the context is cancelled, publishing the comments found as
+// EventTodoSuggestions. Repositories that fail to scan are logged and
+// skipped.
+func (wm *WatcherManager) pollTodos(ctx context.Context, settings config.TodoScanConfig) {
+	for {
+		var todos []game.TodoComment
+		for _, repoPath := range wm.GetWatchedRepositories() {
+			found, err := ScanTodos(repoPath, settings.MaxFiles)
+			if err != nil {
+				log.Printf("Warning: Failed to scan %s for TODO comments: %v", repoPath, err)
+				continue
+			}
+			todos = append(todos, found...)
+		}
+		if wm.config.Debug.Enabled {
+			log.Printf("Found %d TODO/FIXME comment(s) in watched repositories", len(todos))
+		}
+		wm.eventBus.PublishAsync(game.NewTodoSuggestionsEvent(todos))
+
+		if !wm.waitPoll(ctx, time.Duration(settings.ScanIntervalMinutes)*time.Minute) {
+			return
+		}
+	}
+}
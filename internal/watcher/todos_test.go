@@ -0,0 +1,51 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// TestScanTodos tests that only tracked, non-vendored files are scanned and
+// that comments a commit removes are reported.
+func TestScanTodos(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	if err := os.MkdirAll(filepath.Join(repoPath, "vendor"), 0755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	makeCommit(t, repoPath, "Add code", map[string]string{
+		"main.go":       "package main\n\n// TODO: parse flags\n// FIXME: exit code\n",
+		"vendor/lib.go": "// TODO: not ours\n",
+	})
+	// Untracked (e.g. gitignored) files aren't scanned
+	if err := os.WriteFile(filepath.Join(repoPath, "scratch.go"), []byte("// TODO: local only\n"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	todos, err := ScanTodos(repoPath, 0)
+	if err != nil {
+		t.Fatalf("ScanTodos() error = %v", err)
+	}
+	if len(todos) != 2 || todos[0].Text != "parse flags" || todos[1].Kind != "FIXME" || todos[0].Repo != repoPath {
+		t.Errorf("ScanTodos() = %+v, want the two comments in main.go", todos)
+	}
+
+	fixed := makeCommit(t, repoPath, "Set exit code", map[string]string{
+		"main.go": "package main\n\n// TODO: parse flags\n",
+	})
+	gw, err := NewGitWatcher(repoPath)
+	if err != nil {
+		t.Fatalf("NewGitWatcher() error = %v", err)
+	}
+	event, err := gw.extractCommitData(plumbing.NewHash(fixed))
+	if err != nil {
+		t.Fatalf("extractCommitData() error = %v", err)
+	}
+	if len(event.ResolvedTodos) != 1 || event.ResolvedTodos[0].Text != "exit code" || event.ResolvedTodos[0].Repo != repoPath {
+		t.Errorf("ResolvedTodos = %+v, want the FIXME", event.ResolvedTodos)
+	}
+}
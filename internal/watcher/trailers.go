@@ -0,0 +1,78 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file reads the review and co-author credit a commit carries in its
+// trailers and git note, and credits the player for someone else's commit
+// they reviewed or co-wrote.
+package watcher
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// notesRef is where git notes add and review tools keep commit notes.
+const notesRef = plumbing.ReferenceName("refs/notes/commits")
+
+// commitTrailers reads the Reviewed-by and Co-authored-by trailers from a
+// commit's message and its git note.
+func commitTrailers(repo *git.Repository, commit *object.Commit) game.CommitTrailers {
+	return game.ParseCommitTrailers(commit.Message, commitNote(repo, commit.Hash))
+}
+
+// commitNote returns a commit's git note, or "" if it has none. Notes are
+// stored under the commit hash, either as one file or fanned out into
+// directories by its leading characters (e.g. "ab/cdef..."), as git does
+// once there are many notes.
+func commitNote(repo *git.Repository, sha plumbing.Hash) string {
+	ref, err := repo.Reference(notesRef, true)
+	if err != nil {
+		return ""
+	}
+	notes, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return ""
+	}
+	tree, err := notes.Tree()
+	if err != nil {
+		return ""
+	}
+
+	name := sha.String()
+	for _, path := range []string{name, name[:2] + "/" + name[2:], name[:2] + "/" + name[2:4] + "/" + name[4:]} {
+		file, err := tree.File(path)
+		if err != nil {
+			continue
+		}
+		contents, err := file.Contents()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(contents)
+	}
+	return ""
+}
+
+// creditForeignCommit publishes collaboration credit for a commit by
+// someone else that names the player as a reviewer or co-author. The commit
+// itself earns no XP.
+//
+// Parameters:
+//   - commit: The skipped foreign commit
+//   - sync: Publish synchronously (commit replay) instead of asynchronously
+func (wm *WatcherManager) creditForeignCommit(commit CommitEvent, sync bool) {
+	credit := game.CreditCollaboration(commit.Trailers, commit.Email, wm.config.Git.AuthorEmails)
+	if !credit.Any() {
+		return
+	}
+
+	event := game.NewCollaborationEvent(commit.SHA, commit.RepoPath, credit)
+	if sync {
+		wm.eventBus.Publish(event)
+	} else {
+		wm.eventBus.PublishAsync(event)
+	}
+}
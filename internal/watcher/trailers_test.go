@@ -0,0 +1,90 @@
+package watcher
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// storeObject encodes an object into the repository and returns its hash.
+func storeObject(t *testing.T, s storer.EncodedObjectStorer, encode func(plumbing.EncodedObject) error) plumbing.Hash {
+	t.Helper()
+	obj := s.NewEncodedObject()
+	if err := encode(obj); err != nil {
+		t.Fatalf("failed to encode object: %v", err)
+	}
+	hash, err := s.SetEncodedObject(obj)
+	if err != nil {
+		t.Fatalf("failed to store object: %v", err)
+	}
+	return hash
+}
+
+// addFanoutNote writes a git note for a commit the way git does once notes
+// are fanned out ("ab/cdef..."), as refs/notes/commits.
+func addFanoutNote(t *testing.T, repo *git.Repository, sha, note string) {
+	t.Helper()
+	blob := storeObject(t, repo.Storer, func(obj plumbing.EncodedObject) error {
+		obj.SetType(plumbing.BlobObject)
+		w, err := obj.Writer()
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+		_, err = w.Write([]byte(note))
+		return err
+	})
+	subtree := storeObject(t, repo.Storer, (&object.Tree{Entries: []object.TreeEntry{
+		{Name: sha[2:], Mode: filemode.Regular, Hash: blob},
+	}}).Encode)
+	tree := storeObject(t, repo.Storer, (&object.Tree{Entries: []object.TreeEntry{
+		{Name: sha[:2], Mode: filemode.Dir, Hash: subtree},
+	}}).Encode)
+
+	signature := object.Signature{Name: "Ada", Email: "ada@example.com", When: time.Now()}
+	commit := storeObject(t, repo.Storer, (&object.Commit{
+		Author:    signature,
+		Committer: signature,
+		Message:   "Notes added by 'git notes add'",
+		TreeHash:  tree,
+	}).Encode)
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(notesRef, commit)); err != nil {
+		t.Fatalf("failed to set notes ref: %v", err)
+	}
+}
+
+// TestCommitTrailers tests reading reviewers and co-authors from a commit's
+// message and its git note.
+func TestCommitTrailers(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+
+	sha := makeCommit(t, repoPath, "Fix login\n\nCo-authored-by: Bob <bob@example.com>\n", map[string]string{"login.go": "package login\n"})
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("Failed to open repo: %v", err)
+	}
+	commit, err := repo.CommitObject(plumbing.NewHash(sha))
+	if err != nil {
+		t.Fatalf("Failed to read commit: %v", err)
+	}
+
+	want := game.CommitTrailers{CoAuthoredBy: []string{"Bob <bob@example.com>"}}
+	if got := commitTrailers(repo, commit); !reflect.DeepEqual(got, want) {
+		t.Errorf("commitTrailers() without a note = %+v, want %+v", got, want)
+	}
+
+	addFanoutNote(t, repo, sha, "Reviewed-by: Ada <ada@example.com>\n")
+	want.ReviewedBy = []string{"Ada <ada@example.com>"}
+	if got := commitTrailers(repo, commit); !reflect.DeepEqual(got, want) {
+		t.Errorf("commitTrailers() with a note = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,134 @@
+// Package watcher provides Git repository monitoring and integration with the game event system.
+// This file checks the working trees of watched repositories for
+// uncommitted changes, so work left sitting for days can be nudged about
+// (see game.TrackUncommittedWork).
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/AutumnsGrove/codequest/internal/config"
+	"github.com/AutumnsGrove/codequest/internal/game"
+)
+
+// ScanUncommitted counts the lines the working tree adds and removes
+// compared with HEAD, staged or not. Untracked files count as added;
+// ignored files, binary files and files bigger than maxStagedFileBytes are
+// counted as changed files without lines.
+//
+// Parameters:
+//   - repoPath: Path inside the repository
+//
+// Returns:
+//   - game.UncommittedWork: The uncommitted changes (none if the working tree is clean)
+//   - error: An error if the repository, its status or HEAD can't be read
+func ScanUncommitted(repoPath string) (game.UncommittedWork, error) {
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return game.UncommittedWork{}, fmt.Errorf("failed to open git repository at %s: %w", repoPath, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return game.UncommittedWork{}, fmt.Errorf("failed to access worktree: %w", err)
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return game.UncommittedWork{}, fmt.Errorf("failed to read repository status: %w", err)
+	}
+
+	// A repository with no commits yet compares against an empty tree
+	var tree *object.Tree
+	if head, err := repo.Head(); err == nil {
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			return game.UncommittedWork{}, fmt.Errorf("failed to get HEAD commit: %w", err)
+		}
+		if tree, err = commit.Tree(); err != nil {
+			return game.UncommittedWork{}, fmt.Errorf("failed to get commit tree: %w", err)
+		}
+	} else if err != plumbing.ErrReferenceNotFound {
+		return game.UncommittedWork{}, fmt.Errorf("failed to access repository HEAD: %w", err)
+	}
+
+	work := game.UncommittedWork{Repo: worktree.Filesystem.Root()}
+	for path, fileStatus := range status {
+		if fileStatus.Staging == git.Unmodified && fileStatus.Worktree == git.Unmodified {
+			continue
+		}
+		work.Files++
+
+		before, beforeOK := treeFileContents(tree, path)
+		after, afterOK := "", true
+		if fileStatus.Worktree != git.Deleted {
+			after, afterOK = worktreeFileContents(worktree, path)
+		}
+		if !beforeOK || !afterOK {
+			continue
+		}
+		added, removed, _ := lineChanges(before, after)
+		work.LinesAdded += added
+		work.LinesRemoved += removed
+	}
+	return work, nil
+}
+
+// worktreeFileContents reads a file from the working tree. A file that
+// isn't there reads as empty; false means it is binary, too big to count or
+// can't be read.
+func worktreeFileContents(worktree *git.Worktree, path string) (string, bool) {
+	info, err := worktree.Filesystem.Lstat(path)
+	if err != nil {
+		return "", true
+	}
+	if !info.Mode().IsRegular() || info.Size() > maxStagedFileBytes {
+		return "", false
+	}
+
+	file, err := worktree.Filesystem.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil || bytes.IndexByte(data, 0) >= 0 {
+		return "", false
+	}
+	return string(data), true
+}
+
+// pollUncommitted checks every watched repository's working tree right
+// away and then on the configured interval (longer in low-power mode) until
+// the context is cancelled, publishing the changes found as
+// EventUncommittedWork. Repositories that fail to check are logged and
+// left out.
+func (wm *WatcherManager) pollUncommitted(ctx context.Context, settings config.WIPConfig) {
+	for {
+		var work []game.UncommittedWork
+		for _, repoPath := range wm.GetWatchedRepositories() {
+			found, err := ScanUncommitted(repoPath)
+			if err != nil {
+				log.Printf("Warning: Failed to check %s for uncommitted changes: %v", repoPath, err)
+				continue
+			}
+			found.Repo = repoPath // Keyed like the other per-repository state
+			work = append(work, found)
+		}
+		if wm.config.Debug.Enabled {
+			log.Printf("Checked %d watched repositories for uncommitted changes", len(work))
+		}
+		wm.eventBus.PublishAsync(game.NewUncommittedWorkEvent(work))
+
+		if !wm.waitPoll(ctx, time.Duration(settings.CheckIntervalMinutes)*time.Minute) {
+			return
+		}
+	}
+}
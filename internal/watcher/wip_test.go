@@ -0,0 +1,65 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// TestScanUncommitted tests that staged, unstaged and untracked changes are
+// all counted against HEAD.
+func TestScanUncommitted(t *testing.T) {
+	repoPath, cleanup := createTestRepo(t)
+	defer cleanup()
+	makeCommit(t, repoPath, "Add code", map[string]string{
+		"main.go":  "package main\n\nfunc main() {\n}\n",
+		"old.go":   "package main\n// old\n",
+		"image.go": "package main\n",
+	})
+
+	work, err := ScanUncommitted(repoPath)
+	if err != nil {
+		t.Fatalf("ScanUncommitted() error = %v", err)
+	}
+	if work.Files != 0 || work.Lines() != 0 {
+		t.Errorf("clean tree = %+v, want no changes", work)
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	// Staged edit, unstaged deletion, untracked file and a binary file
+	write("main.go", "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n")
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("PlainOpen() error = %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree() error = %v", err)
+	}
+	if _, err := worktree.Add("main.go"); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := os.Remove(filepath.Join(repoPath, "old.go")); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	write("scratch.go", "package main\n\nvar x = 1\n")
+	write("image.go", "\x00\x01binary")
+
+	work, err = ScanUncommitted(repoPath)
+	if err != nil {
+		t.Fatalf("ScanUncommitted() error = %v", err)
+	}
+	if work.Files != 4 {
+		t.Errorf("Files = %d, want 4", work.Files)
+	}
+	if work.LinesAdded != 4 || work.LinesRemoved != 2 {
+		t.Errorf("lines = +%d -%d, want +4 -2", work.LinesAdded, work.LinesRemoved)
+	}
+}
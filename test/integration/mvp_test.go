@@ -390,10 +390,13 @@ func TestMVP_LevelUpFlow(t *testing.T) {
 		eventBus.Publish(game.NewLevelUpEvent(char.ID, oldLevel, char.Level))
 	}
 
-	// Verify stats increased
-	if char.CodePower != initialStats["CodePower"]+1 {
-		t.Errorf("CodePower = %d, want %d",
-			char.CodePower, initialStats["CodePower"]+1)
+	// Verify stat points were granted (stats themselves are allocated by the player)
+	if char.SkillPoints != game.StatPointsPerLevel {
+		t.Errorf("SkillPoints = %d, want %d", char.SkillPoints, game.StatPointsPerLevel)
+	}
+	if char.CodePower != initialStats["CodePower"] {
+		t.Errorf("CodePower = %d, want %d (unchanged until allocated)",
+			char.CodePower, initialStats["CodePower"])
 	}
 
 	// Verify level-up event was fired